@@ -2962,29 +2962,31 @@ func (x *fastReflection_EventConvertCoinToEvm) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_EventTransfer           protoreflect.MessageDescriptor
-	fd_EventTransfer_sender    protoreflect.FieldDescriptor
-	fd_EventTransfer_recipient protoreflect.FieldDescriptor
-	fd_EventTransfer_amount    protoreflect.FieldDescriptor
+	md_EventConvertEvmToCoin                        protoreflect.MessageDescriptor
+	fd_EventConvertEvmToCoin_sender                 protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_erc20_contract_address protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_to_addr                protoreflect.FieldDescriptor
+	fd_EventConvertEvmToCoin_bank_coin              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_events_proto_init()
-	md_EventTransfer = File_eth_evm_v1_events_proto.Messages().ByName("EventTransfer")
-	fd_EventTransfer_sender = md_EventTransfer.Fields().ByName("sender")
-	fd_EventTransfer_recipient = md_EventTransfer.Fields().ByName("recipient")
-	fd_EventTransfer_amount = md_EventTransfer.Fields().ByName("amount")
+	md_EventConvertEvmToCoin = File_eth_evm_v1_events_proto.Messages().ByName("EventConvertEvmToCoin")
+	fd_EventConvertEvmToCoin_sender = md_EventConvertEvmToCoin.Fields().ByName("sender")
+	fd_EventConvertEvmToCoin_erc20_contract_address = md_EventConvertEvmToCoin.Fields().ByName("erc20_contract_address")
+	fd_EventConvertEvmToCoin_to_addr = md_EventConvertEvmToCoin.Fields().ByName("to_addr")
+	fd_EventConvertEvmToCoin_bank_coin = md_EventConvertEvmToCoin.Fields().ByName("bank_coin")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventTransfer)(nil)
+var _ protoreflect.Message = (*fastReflection_EventConvertEvmToCoin)(nil)
 
-type fastReflection_EventTransfer EventTransfer
+type fastReflection_EventConvertEvmToCoin EventConvertEvmToCoin
 
-func (x *EventTransfer) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventTransfer)(x)
+func (x *EventConvertEvmToCoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventConvertEvmToCoin)(x)
 }
 
-func (x *EventTransfer) slowProtoReflect() protoreflect.Message {
+func (x *EventConvertEvmToCoin) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2996,43 +2998,43 @@ func (x *EventTransfer) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventTransfer_messageType fastReflection_EventTransfer_messageType
-var _ protoreflect.MessageType = fastReflection_EventTransfer_messageType{}
+var _fastReflection_EventConvertEvmToCoin_messageType fastReflection_EventConvertEvmToCoin_messageType
+var _ protoreflect.MessageType = fastReflection_EventConvertEvmToCoin_messageType{}
 
-type fastReflection_EventTransfer_messageType struct{}
+type fastReflection_EventConvertEvmToCoin_messageType struct{}
 
-func (x fastReflection_EventTransfer_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventTransfer)(nil)
+func (x fastReflection_EventConvertEvmToCoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventConvertEvmToCoin)(nil)
 }
-func (x fastReflection_EventTransfer_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventTransfer)
+func (x fastReflection_EventConvertEvmToCoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventConvertEvmToCoin)
 }
-func (x fastReflection_EventTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTransfer
+func (x fastReflection_EventConvertEvmToCoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertEvmToCoin
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventTransfer) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTransfer
+func (x *fastReflection_EventConvertEvmToCoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertEvmToCoin
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventTransfer) Type() protoreflect.MessageType {
-	return _fastReflection_EventTransfer_messageType
+func (x *fastReflection_EventConvertEvmToCoin) Type() protoreflect.MessageType {
+	return _fastReflection_EventConvertEvmToCoin_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventTransfer) New() protoreflect.Message {
-	return new(fastReflection_EventTransfer)
+func (x *fastReflection_EventConvertEvmToCoin) New() protoreflect.Message {
+	return new(fastReflection_EventConvertEvmToCoin)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventTransfer) Interface() protoreflect.ProtoMessage {
-	return (*EventTransfer)(x)
+func (x *fastReflection_EventConvertEvmToCoin) Interface() protoreflect.ProtoMessage {
+	return (*EventConvertEvmToCoin)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3040,22 +3042,28 @@ func (x *fastReflection_EventTransfer) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventConvertEvmToCoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Sender != "" {
 		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_EventTransfer_sender, value) {
+		if !f(fd_EventConvertEvmToCoin_sender, value) {
 			return
 		}
 	}
-	if x.Recipient != "" {
-		value := protoreflect.ValueOfString(x.Recipient)
-		if !f(fd_EventTransfer_recipient, value) {
+	if x.Erc20ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.Erc20ContractAddress)
+		if !f(fd_EventConvertEvmToCoin_erc20_contract_address, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_EventTransfer_amount, value) {
+	if x.ToAddr != "" {
+		value := protoreflect.ValueOfString(x.ToAddr)
+		if !f(fd_EventConvertEvmToCoin_to_addr, value) {
+			return
+		}
+	}
+	if x.BankCoin != nil {
+		value := protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+		if !f(fd_EventConvertEvmToCoin_bank_coin, value) {
 			return
 		}
 	}
@@ -3072,19 +3080,21 @@ func (x *fastReflection_EventTransfer) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventConvertEvmToCoin) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventTransfer.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		return x.Sender != ""
-	case "eth.evm.v1.EventTransfer.recipient":
-		return x.Recipient != ""
-	case "eth.evm.v1.EventTransfer.amount":
-		return x.Amount != ""
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		return x.Erc20ContractAddress != ""
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		return x.ToAddr != ""
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		return x.BankCoin != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3094,19 +3104,21 @@ func (x *fastReflection_EventTransfer) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTransfer) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventConvertEvmToCoin) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventTransfer.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		x.Sender = ""
-	case "eth.evm.v1.EventTransfer.recipient":
-		x.Recipient = ""
-	case "eth.evm.v1.EventTransfer.amount":
-		x.Amount = ""
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		x.Erc20ContractAddress = ""
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		x.ToAddr = ""
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		x.BankCoin = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3116,22 +3128,25 @@ func (x *fastReflection_EventTransfer) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventConvertEvmToCoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.EventTransfer.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.EventTransfer.recipient":
-		value := x.Recipient
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		value := x.Erc20ContractAddress
 		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.EventTransfer.amount":
-		value := x.Amount
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		value := x.ToAddr
 		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		value := x.BankCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3145,19 +3160,21 @@ func (x *fastReflection_EventTransfer) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventConvertEvmToCoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventTransfer.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		x.Sender = value.Interface().(string)
-	case "eth.evm.v1.EventTransfer.recipient":
-		x.Recipient = value.Interface().(string)
-	case "eth.evm.v1.EventTransfer.amount":
-		x.Amount = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		x.Erc20ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		x.ToAddr = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		x.BankCoin = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3171,48 +3188,56 @@ func (x *fastReflection_EventTransfer) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventConvertEvmToCoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventTransfer.sender":
-		panic(fmt.Errorf("field sender of message eth.evm.v1.EventTransfer is not mutable"))
-	case "eth.evm.v1.EventTransfer.recipient":
-		panic(fmt.Errorf("field recipient of message eth.evm.v1.EventTransfer is not mutable"))
-	case "eth.evm.v1.EventTransfer.amount":
-		panic(fmt.Errorf("field amount of message eth.evm.v1.EventTransfer is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		if x.BankCoin == nil {
+			x.BankCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
+		panic(fmt.Errorf("field erc20_contract_address of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
+		panic(fmt.Errorf("field to_addr of message eth.evm.v1.EventConvertEvmToCoin is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventConvertEvmToCoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventTransfer.sender":
+	case "eth.evm.v1.EventConvertEvmToCoin.sender":
 		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.EventTransfer.recipient":
+	case "eth.evm.v1.EventConvertEvmToCoin.erc20_contract_address":
 		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.EventTransfer.amount":
+	case "eth.evm.v1.EventConvertEvmToCoin.to_addr":
 		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToCoin.bank_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToCoin"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventConvertEvmToCoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventTransfer", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventConvertEvmToCoin", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3220,7 +3245,7 @@ func (x *fastReflection_EventTransfer) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventTransfer) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventConvertEvmToCoin) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3231,7 +3256,7 @@ func (x *fastReflection_EventTransfer) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTransfer) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventConvertEvmToCoin) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3243,7 +3268,7 @@ func (x *fastReflection_EventTransfer) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventTransfer) IsValid() bool {
+func (x *fastReflection_EventConvertEvmToCoin) IsValid() bool {
 	return x != nil
 }
 
@@ -3253,9 +3278,9 @@ func (x *fastReflection_EventTransfer) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventConvertEvmToCoin) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventTransfer)
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3271,14 +3296,18 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Recipient)
+		l = len(x.Erc20ContractAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
+		l = len(x.ToAddr)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.BankCoin != nil {
+			l = options.Size(x.BankCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3289,7 +3318,7 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventTransfer)
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3308,17 +3337,31 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+		if x.BankCoin != nil {
+			encoded, err := options.Marshal(x.BankCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ToAddr) > 0 {
+			i -= len(x.ToAddr)
+			copy(dAtA[i:], x.ToAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddr)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Recipient) > 0 {
-			i -= len(x.Recipient)
-			copy(dAtA[i:], x.Recipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+		if len(x.Erc20ContractAddress) > 0 {
+			i -= len(x.Erc20ContractAddress)
+			copy(dAtA[i:], x.Erc20ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20ContractAddress)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -3340,7 +3383,7 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventTransfer)
+		x := input.Message.Interface().(*EventConvertEvmToCoin)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3372,10 +3415,10 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTransfer: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertEvmToCoin: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3412,7 +3455,7 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3440,11 +3483,11 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Recipient = string(dAtA[iNdEx:postIndex])
+				x.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3472,7 +3515,43 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.ToAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BankCoin == nil {
+					x.BankCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BankCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3510,27 +3589,29 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventContractDeployed               protoreflect.MessageDescriptor
-	fd_EventContractDeployed_sender        protoreflect.FieldDescriptor
-	fd_EventContractDeployed_contract_addr protoreflect.FieldDescriptor
+	md_EventFunTokenEscrowReconciled           protoreflect.MessageDescriptor
+	fd_EventFunTokenEscrowReconciled_sender    protoreflect.FieldDescriptor
+	fd_EventFunTokenEscrowReconciled_fun_token protoreflect.FieldDescriptor
+	fd_EventFunTokenEscrowReconciled_escrow    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_events_proto_init()
-	md_EventContractDeployed = File_eth_evm_v1_events_proto.Messages().ByName("EventContractDeployed")
-	fd_EventContractDeployed_sender = md_EventContractDeployed.Fields().ByName("sender")
-	fd_EventContractDeployed_contract_addr = md_EventContractDeployed.Fields().ByName("contract_addr")
+	md_EventFunTokenEscrowReconciled = File_eth_evm_v1_events_proto.Messages().ByName("EventFunTokenEscrowReconciled")
+	fd_EventFunTokenEscrowReconciled_sender = md_EventFunTokenEscrowReconciled.Fields().ByName("sender")
+	fd_EventFunTokenEscrowReconciled_fun_token = md_EventFunTokenEscrowReconciled.Fields().ByName("fun_token")
+	fd_EventFunTokenEscrowReconciled_escrow = md_EventFunTokenEscrowReconciled.Fields().ByName("escrow")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventContractDeployed)(nil)
+var _ protoreflect.Message = (*fastReflection_EventFunTokenEscrowReconciled)(nil)
 
-type fastReflection_EventContractDeployed EventContractDeployed
+type fastReflection_EventFunTokenEscrowReconciled EventFunTokenEscrowReconciled
 
-func (x *EventContractDeployed) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventContractDeployed)(x)
+func (x *EventFunTokenEscrowReconciled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFunTokenEscrowReconciled)(x)
 }
 
-func (x *EventContractDeployed) slowProtoReflect() protoreflect.Message {
+func (x *EventFunTokenEscrowReconciled) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_events_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3542,43 +3623,43 @@ func (x *EventContractDeployed) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventContractDeployed_messageType fastReflection_EventContractDeployed_messageType
-var _ protoreflect.MessageType = fastReflection_EventContractDeployed_messageType{}
+var _fastReflection_EventFunTokenEscrowReconciled_messageType fastReflection_EventFunTokenEscrowReconciled_messageType
+var _ protoreflect.MessageType = fastReflection_EventFunTokenEscrowReconciled_messageType{}
 
-type fastReflection_EventContractDeployed_messageType struct{}
+type fastReflection_EventFunTokenEscrowReconciled_messageType struct{}
 
-func (x fastReflection_EventContractDeployed_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventContractDeployed)(nil)
+func (x fastReflection_EventFunTokenEscrowReconciled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFunTokenEscrowReconciled)(nil)
 }
-func (x fastReflection_EventContractDeployed_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventContractDeployed)
+func (x fastReflection_EventFunTokenEscrowReconciled_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenEscrowReconciled)
 }
-func (x fastReflection_EventContractDeployed_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventContractDeployed
+func (x fastReflection_EventFunTokenEscrowReconciled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenEscrowReconciled
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventContractDeployed) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventContractDeployed
+func (x *fastReflection_EventFunTokenEscrowReconciled) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunTokenEscrowReconciled
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventContractDeployed) Type() protoreflect.MessageType {
-	return _fastReflection_EventContractDeployed_messageType
+func (x *fastReflection_EventFunTokenEscrowReconciled) Type() protoreflect.MessageType {
+	return _fastReflection_EventFunTokenEscrowReconciled_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventContractDeployed) New() protoreflect.Message {
-	return new(fastReflection_EventContractDeployed)
+func (x *fastReflection_EventFunTokenEscrowReconciled) New() protoreflect.Message {
+	return new(fastReflection_EventFunTokenEscrowReconciled)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventContractDeployed) Interface() protoreflect.ProtoMessage {
-	return (*EventContractDeployed)(x)
+func (x *fastReflection_EventFunTokenEscrowReconciled) Interface() protoreflect.ProtoMessage {
+	return (*EventFunTokenEscrowReconciled)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3586,16 +3667,22 @@ func (x *fastReflection_EventContractDeployed) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventContractDeployed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventFunTokenEscrowReconciled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Sender != "" {
 		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_EventContractDeployed_sender, value) {
+		if !f(fd_EventFunTokenEscrowReconciled_sender, value) {
 			return
 		}
 	}
-	if x.ContractAddr != "" {
-		value := protoreflect.ValueOfString(x.ContractAddr)
-		if !f(fd_EventContractDeployed_contract_addr, value) {
+	if x.FunToken != nil {
+		value := protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
+		if !f(fd_EventFunTokenEscrowReconciled_fun_token, value) {
+			return
+		}
+	}
+	if x.Escrow != nil {
+		value := protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+		if !f(fd_EventFunTokenEscrowReconciled_escrow, value) {
 			return
 		}
 	}
@@ -3612,17 +3699,19 @@ func (x *fastReflection_EventContractDeployed) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventContractDeployed) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventFunTokenEscrowReconciled) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractDeployed.sender":
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.sender":
 		return x.Sender != ""
-	case "eth.evm.v1.EventContractDeployed.contract_addr":
-		return x.ContractAddr != ""
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.fun_token":
+		return x.FunToken != nil
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.escrow":
+		return x.Escrow != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenEscrowReconciled"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenEscrowReconciled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3632,17 +3721,19 @@ func (x *fastReflection_EventContractDeployed) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventContractDeployed) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventFunTokenEscrowReconciled) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractDeployed.sender":
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.sender":
 		x.Sender = ""
-	case "eth.evm.v1.EventContractDeployed.contract_addr":
-		x.ContractAddr = ""
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.fun_token":
+		x.FunToken = nil
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.escrow":
+		x.Escrow = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenEscrowReconciled"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenEscrowReconciled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3652,19 +3743,22 @@ func (x *fastReflection_EventContractDeployed) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventContractDeployed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventFunTokenEscrowReconciled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.EventContractDeployed.sender":
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.EventContractDeployed.contract_addr":
-		value := x.ContractAddr
-		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.fun_token":
+		value := x.FunToken
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.escrow":
+		value := x.Escrow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenEscrowReconciled"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenEscrowReconciled does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3678,17 +3772,19 @@ func (x *fastReflection_EventContractDeployed) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventContractDeployed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventFunTokenEscrowReconciled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractDeployed.sender":
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.sender":
 		x.Sender = value.Interface().(string)
-	case "eth.evm.v1.EventContractDeployed.contract_addr":
-		x.ContractAddr = value.Interface().(string)
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.fun_token":
+		x.FunToken = value.Message().Interface().(*FunToken)
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.escrow":
+		x.Escrow = value.Message().Interface().(*FunTokenEscrow)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenEscrowReconciled"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenEscrowReconciled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3702,44 +3798,56 @@ func (x *fastReflection_EventContractDeployed) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventContractDeployed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventFunTokenEscrowReconciled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractDeployed.sender":
-		panic(fmt.Errorf("field sender of message eth.evm.v1.EventContractDeployed is not mutable"))
-	case "eth.evm.v1.EventContractDeployed.contract_addr":
-		panic(fmt.Errorf("field contract_addr of message eth.evm.v1.EventContractDeployed is not mutable"))
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.fun_token":
+		if x.FunToken == nil {
+			x.FunToken = new(FunToken)
+		}
+		return protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.escrow":
+		if x.Escrow == nil {
+			x.Escrow = new(FunTokenEscrow)
+		}
+		return protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventFunTokenEscrowReconciled is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenEscrowReconciled"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenEscrowReconciled does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventContractDeployed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventFunTokenEscrowReconciled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractDeployed.sender":
-		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.EventContractDeployed.contract_addr":
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.sender":
 		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.fun_token":
+		m := new(FunToken)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.EventFunTokenEscrowReconciled.escrow":
+		m := new(FunTokenEscrow)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunTokenEscrowReconciled"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunTokenEscrowReconciled does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventContractDeployed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventFunTokenEscrowReconciled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventContractDeployed", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventFunTokenEscrowReconciled", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3747,7 +3855,7 @@ func (x *fastReflection_EventContractDeployed) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventContractDeployed) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventFunTokenEscrowReconciled) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3758,7 +3866,7 @@ func (x *fastReflection_EventContractDeployed) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventContractDeployed) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventFunTokenEscrowReconciled) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3770,7 +3878,7 @@ func (x *fastReflection_EventContractDeployed) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventContractDeployed) IsValid() bool {
+func (x *fastReflection_EventFunTokenEscrowReconciled) IsValid() bool {
 	return x != nil
 }
 
@@ -3780,9 +3888,9 @@ func (x *fastReflection_EventContractDeployed) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventFunTokenEscrowReconciled) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventContractDeployed)
+		x := input.Message.Interface().(*EventFunTokenEscrowReconciled)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3798,8 +3906,12 @@ func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ContractAddr)
-		if l > 0 {
+		if x.FunToken != nil {
+			l = options.Size(x.FunToken)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Escrow != nil {
+			l = options.Size(x.Escrow)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3812,7 +3924,7 @@ func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventContractDeployed)
+		x := input.Message.Interface().(*EventFunTokenEscrowReconciled)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3831,10 +3943,31 @@ func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ContractAddr) > 0 {
-			i -= len(x.ContractAddr)
-			copy(dAtA[i:], x.ContractAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddr)))
+		if x.Escrow != nil {
+			encoded, err := options.Marshal(x.Escrow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.FunToken != nil {
+			encoded, err := options.Marshal(x.FunToken)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -3856,7 +3989,7 @@ func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventContractDeployed)
+		x := input.Message.Interface().(*EventFunTokenEscrowReconciled)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3888,10 +4021,10 @@ func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractDeployed: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenEscrowReconciled: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractDeployed: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunTokenEscrowReconciled: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3928,9 +4061,9 @@ func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Method
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3940,23 +4073,63 @@ func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ContractAddr = string(dAtA[iNdEx:postIndex])
+				if x.FunToken == nil {
+					x.FunToken = &FunToken{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FunToken); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Escrow == nil {
+					x.Escrow = &FunTokenEscrow{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3994,27 +4167,29 @@ func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_EventContractExecuted               protoreflect.MessageDescriptor
-	fd_EventContractExecuted_sender        protoreflect.FieldDescriptor
-	fd_EventContractExecuted_contract_addr protoreflect.FieldDescriptor
+	md_EventFunNFTCreated                         protoreflect.MessageDescriptor
+	fd_EventFunNFTCreated_class_id                protoreflect.FieldDescriptor
+	fd_EventFunNFTCreated_erc721_contract_address protoreflect.FieldDescriptor
+	fd_EventFunNFTCreated_creator                 protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_events_proto_init()
-	md_EventContractExecuted = File_eth_evm_v1_events_proto.Messages().ByName("EventContractExecuted")
-	fd_EventContractExecuted_sender = md_EventContractExecuted.Fields().ByName("sender")
-	fd_EventContractExecuted_contract_addr = md_EventContractExecuted.Fields().ByName("contract_addr")
+	md_EventFunNFTCreated = File_eth_evm_v1_events_proto.Messages().ByName("EventFunNFTCreated")
+	fd_EventFunNFTCreated_class_id = md_EventFunNFTCreated.Fields().ByName("class_id")
+	fd_EventFunNFTCreated_erc721_contract_address = md_EventFunNFTCreated.Fields().ByName("erc721_contract_address")
+	fd_EventFunNFTCreated_creator = md_EventFunNFTCreated.Fields().ByName("creator")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventContractExecuted)(nil)
+var _ protoreflect.Message = (*fastReflection_EventFunNFTCreated)(nil)
 
-type fastReflection_EventContractExecuted EventContractExecuted
+type fastReflection_EventFunNFTCreated EventFunNFTCreated
 
-func (x *EventContractExecuted) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventContractExecuted)(x)
+func (x *EventFunNFTCreated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFunNFTCreated)(x)
 }
 
-func (x *EventContractExecuted) slowProtoReflect() protoreflect.Message {
+func (x *EventFunNFTCreated) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4026,43 +4201,43 @@ func (x *EventContractExecuted) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventContractExecuted_messageType fastReflection_EventContractExecuted_messageType
-var _ protoreflect.MessageType = fastReflection_EventContractExecuted_messageType{}
+var _fastReflection_EventFunNFTCreated_messageType fastReflection_EventFunNFTCreated_messageType
+var _ protoreflect.MessageType = fastReflection_EventFunNFTCreated_messageType{}
 
-type fastReflection_EventContractExecuted_messageType struct{}
+type fastReflection_EventFunNFTCreated_messageType struct{}
 
-func (x fastReflection_EventContractExecuted_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventContractExecuted)(nil)
+func (x fastReflection_EventFunNFTCreated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFunNFTCreated)(nil)
 }
-func (x fastReflection_EventContractExecuted_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventContractExecuted)
+func (x fastReflection_EventFunNFTCreated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFunNFTCreated)
 }
-func (x fastReflection_EventContractExecuted_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventContractExecuted
+func (x fastReflection_EventFunNFTCreated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunNFTCreated
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventContractExecuted) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventContractExecuted
+func (x *fastReflection_EventFunNFTCreated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFunNFTCreated
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventContractExecuted) Type() protoreflect.MessageType {
-	return _fastReflection_EventContractExecuted_messageType
+func (x *fastReflection_EventFunNFTCreated) Type() protoreflect.MessageType {
+	return _fastReflection_EventFunNFTCreated_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventContractExecuted) New() protoreflect.Message {
-	return new(fastReflection_EventContractExecuted)
+func (x *fastReflection_EventFunNFTCreated) New() protoreflect.Message {
+	return new(fastReflection_EventFunNFTCreated)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventContractExecuted) Interface() protoreflect.ProtoMessage {
-	return (*EventContractExecuted)(x)
+func (x *fastReflection_EventFunNFTCreated) Interface() protoreflect.ProtoMessage {
+	return (*EventFunNFTCreated)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4070,16 +4245,22 @@ func (x *fastReflection_EventContractExecuted) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventContractExecuted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Sender != "" {
-		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_EventContractExecuted_sender, value) {
+func (x *fastReflection_EventFunNFTCreated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventFunNFTCreated_class_id, value) {
 			return
 		}
 	}
-	if x.ContractAddr != "" {
-		value := protoreflect.ValueOfString(x.ContractAddr)
-		if !f(fd_EventContractExecuted_contract_addr, value) {
+	if x.Erc721ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.Erc721ContractAddress)
+		if !f(fd_EventFunNFTCreated_erc721_contract_address, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EventFunNFTCreated_creator, value) {
 			return
 		}
 	}
@@ -4096,17 +4277,19 @@ func (x *fastReflection_EventContractExecuted) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventContractExecuted) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventFunNFTCreated) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractExecuted.sender":
-		return x.Sender != ""
-	case "eth.evm.v1.EventContractExecuted.contract_addr":
-		return x.ContractAddr != ""
+	case "eth.evm.v1.EventFunNFTCreated.class_id":
+		return x.ClassId != ""
+	case "eth.evm.v1.EventFunNFTCreated.erc721_contract_address":
+		return x.Erc721ContractAddress != ""
+	case "eth.evm.v1.EventFunNFTCreated.creator":
+		return x.Creator != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunNFTCreated"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunNFTCreated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4116,17 +4299,19 @@ func (x *fastReflection_EventContractExecuted) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventContractExecuted) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventFunNFTCreated) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractExecuted.sender":
-		x.Sender = ""
-	case "eth.evm.v1.EventContractExecuted.contract_addr":
-		x.ContractAddr = ""
+	case "eth.evm.v1.EventFunNFTCreated.class_id":
+		x.ClassId = ""
+	case "eth.evm.v1.EventFunNFTCreated.erc721_contract_address":
+		x.Erc721ContractAddress = ""
+	case "eth.evm.v1.EventFunNFTCreated.creator":
+		x.Creator = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunNFTCreated"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunNFTCreated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4136,19 +4321,22 @@ func (x *fastReflection_EventContractExecuted) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventContractExecuted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventFunNFTCreated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.EventContractExecuted.sender":
-		value := x.Sender
+	case "eth.evm.v1.EventFunNFTCreated.class_id":
+		value := x.ClassId
 		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.EventContractExecuted.contract_addr":
-		value := x.ContractAddr
+	case "eth.evm.v1.EventFunNFTCreated.erc721_contract_address":
+		value := x.Erc721ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventFunNFTCreated.creator":
+		value := x.Creator
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunNFTCreated"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunNFTCreated does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4162,17 +4350,19 @@ func (x *fastReflection_EventContractExecuted) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventContractExecuted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventFunNFTCreated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractExecuted.sender":
-		x.Sender = value.Interface().(string)
-	case "eth.evm.v1.EventContractExecuted.contract_addr":
-		x.ContractAddr = value.Interface().(string)
+	case "eth.evm.v1.EventFunNFTCreated.class_id":
+		x.ClassId = value.Interface().(string)
+	case "eth.evm.v1.EventFunNFTCreated.erc721_contract_address":
+		x.Erc721ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventFunNFTCreated.creator":
+		x.Creator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunNFTCreated"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunNFTCreated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4186,44 +4376,48 @@ func (x *fastReflection_EventContractExecuted) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventContractExecuted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventFunNFTCreated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractExecuted.sender":
-		panic(fmt.Errorf("field sender of message eth.evm.v1.EventContractExecuted is not mutable"))
-	case "eth.evm.v1.EventContractExecuted.contract_addr":
-		panic(fmt.Errorf("field contract_addr of message eth.evm.v1.EventContractExecuted is not mutable"))
+	case "eth.evm.v1.EventFunNFTCreated.class_id":
+		panic(fmt.Errorf("field class_id of message eth.evm.v1.EventFunNFTCreated is not mutable"))
+	case "eth.evm.v1.EventFunNFTCreated.erc721_contract_address":
+		panic(fmt.Errorf("field erc721_contract_address of message eth.evm.v1.EventFunNFTCreated is not mutable"))
+	case "eth.evm.v1.EventFunNFTCreated.creator":
+		panic(fmt.Errorf("field creator of message eth.evm.v1.EventFunNFTCreated is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunNFTCreated"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunNFTCreated does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventContractExecuted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventFunNFTCreated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EventContractExecuted.sender":
+	case "eth.evm.v1.EventFunNFTCreated.class_id":
 		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.EventContractExecuted.contract_addr":
+	case "eth.evm.v1.EventFunNFTCreated.erc721_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventFunNFTCreated.creator":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventFunNFTCreated"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EventFunNFTCreated does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventContractExecuted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventFunNFTCreated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventContractExecuted", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventFunNFTCreated", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4231,7 +4425,7 @@ func (x *fastReflection_EventContractExecuted) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventContractExecuted) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventFunNFTCreated) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4242,7 +4436,7 @@ func (x *fastReflection_EventContractExecuted) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventContractExecuted) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventFunNFTCreated) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4254,7 +4448,7 @@ func (x *fastReflection_EventContractExecuted) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventContractExecuted) IsValid() bool {
+func (x *fastReflection_EventFunNFTCreated) IsValid() bool {
 	return x != nil
 }
 
@@ -4264,9 +4458,9 @@ func (x *fastReflection_EventContractExecuted) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventFunNFTCreated) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventContractExecuted)
+		x := input.Message.Interface().(*EventFunNFTCreated)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4278,11 +4472,15 @@ func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Sender)
+		l = len(x.ClassId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ContractAddr)
+		l = len(x.Erc721ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4296,7 +4494,7 @@ func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventContractExecuted)
+		x := input.Message.Interface().(*EventFunNFTCreated)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4315,17 +4513,24 @@ func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ContractAddr) > 0 {
-			i -= len(x.ContractAddr)
-			copy(dAtA[i:], x.ContractAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddr)))
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc721ContractAddress) > 0 {
+			i -= len(x.Erc721ContractAddress)
+			copy(dAtA[i:], x.Erc721ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc721ContractAddress)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4340,7 +4545,7 @@ func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventContractExecuted)
+		x := input.Message.Interface().(*EventFunNFTCreated)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4372,15 +4577,15 @@ func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractExecuted: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunNFTCreated: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFunNFTCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4408,11 +4613,11 @@ func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Sender = string(dAtA[iNdEx:postIndex])
+				x.ClassId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc721ContractAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4440,7 +4645,39 @@ func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ContractAddr = string(dAtA[iNdEx:postIndex])
+				x.Erc721ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4477,7 +4714,2875 @@ func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Method
 	}
 }
 
-// Copyright (c) 2023-2024 Nibi, Inc.
+var (
+	md_EventConvertEvmToNFT                         protoreflect.MessageDescriptor
+	fd_EventConvertEvmToNFT_sender                  protoreflect.FieldDescriptor
+	fd_EventConvertEvmToNFT_erc721_contract_address protoreflect.FieldDescriptor
+	fd_EventConvertEvmToNFT_to_addr                 protoreflect.FieldDescriptor
+	fd_EventConvertEvmToNFT_class_id                protoreflect.FieldDescriptor
+	fd_EventConvertEvmToNFT_nft_id                  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventConvertEvmToNFT = File_eth_evm_v1_events_proto.Messages().ByName("EventConvertEvmToNFT")
+	fd_EventConvertEvmToNFT_sender = md_EventConvertEvmToNFT.Fields().ByName("sender")
+	fd_EventConvertEvmToNFT_erc721_contract_address = md_EventConvertEvmToNFT.Fields().ByName("erc721_contract_address")
+	fd_EventConvertEvmToNFT_to_addr = md_EventConvertEvmToNFT.Fields().ByName("to_addr")
+	fd_EventConvertEvmToNFT_class_id = md_EventConvertEvmToNFT.Fields().ByName("class_id")
+	fd_EventConvertEvmToNFT_nft_id = md_EventConvertEvmToNFT.Fields().ByName("nft_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventConvertEvmToNFT)(nil)
+
+type fastReflection_EventConvertEvmToNFT EventConvertEvmToNFT
+
+func (x *EventConvertEvmToNFT) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventConvertEvmToNFT)(x)
+}
+
+func (x *EventConvertEvmToNFT) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventConvertEvmToNFT_messageType fastReflection_EventConvertEvmToNFT_messageType
+var _ protoreflect.MessageType = fastReflection_EventConvertEvmToNFT_messageType{}
+
+type fastReflection_EventConvertEvmToNFT_messageType struct{}
+
+func (x fastReflection_EventConvertEvmToNFT_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventConvertEvmToNFT)(nil)
+}
+func (x fastReflection_EventConvertEvmToNFT_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventConvertEvmToNFT)
+}
+func (x fastReflection_EventConvertEvmToNFT_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertEvmToNFT
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventConvertEvmToNFT) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertEvmToNFT
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventConvertEvmToNFT) Type() protoreflect.MessageType {
+	return _fastReflection_EventConvertEvmToNFT_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventConvertEvmToNFT) New() protoreflect.Message {
+	return new(fastReflection_EventConvertEvmToNFT)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventConvertEvmToNFT) Interface() protoreflect.ProtoMessage {
+	return (*EventConvertEvmToNFT)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventConvertEvmToNFT) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventConvertEvmToNFT_sender, value) {
+			return
+		}
+	}
+	if x.Erc721ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.Erc721ContractAddress)
+		if !f(fd_EventConvertEvmToNFT_erc721_contract_address, value) {
+			return
+		}
+	}
+	if x.ToAddr != "" {
+		value := protoreflect.ValueOfString(x.ToAddr)
+		if !f(fd_EventConvertEvmToNFT_to_addr, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventConvertEvmToNFT_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_EventConvertEvmToNFT_nft_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventConvertEvmToNFT) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToNFT.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventConvertEvmToNFT.erc721_contract_address":
+		return x.Erc721ContractAddress != ""
+	case "eth.evm.v1.EventConvertEvmToNFT.to_addr":
+		return x.ToAddr != ""
+	case "eth.evm.v1.EventConvertEvmToNFT.class_id":
+		return x.ClassId != ""
+	case "eth.evm.v1.EventConvertEvmToNFT.nft_id":
+		return x.NftId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertEvmToNFT) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToNFT.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventConvertEvmToNFT.erc721_contract_address":
+		x.Erc721ContractAddress = ""
+	case "eth.evm.v1.EventConvertEvmToNFT.to_addr":
+		x.ToAddr = ""
+	case "eth.evm.v1.EventConvertEvmToNFT.class_id":
+		x.ClassId = ""
+	case "eth.evm.v1.EventConvertEvmToNFT.nft_id":
+		x.NftId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventConvertEvmToNFT) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventConvertEvmToNFT.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToNFT.erc721_contract_address":
+		value := x.Erc721ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToNFT.to_addr":
+		value := x.ToAddr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToNFT.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertEvmToNFT.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToNFT does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertEvmToNFT) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToNFT.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToNFT.erc721_contract_address":
+		x.Erc721ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToNFT.to_addr":
+		x.ToAddr = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToNFT.class_id":
+		x.ClassId = value.Interface().(string)
+	case "eth.evm.v1.EventConvertEvmToNFT.nft_id":
+		x.NftId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertEvmToNFT) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToNFT.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventConvertEvmToNFT is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToNFT.erc721_contract_address":
+		panic(fmt.Errorf("field erc721_contract_address of message eth.evm.v1.EventConvertEvmToNFT is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToNFT.to_addr":
+		panic(fmt.Errorf("field to_addr of message eth.evm.v1.EventConvertEvmToNFT is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToNFT.class_id":
+		panic(fmt.Errorf("field class_id of message eth.evm.v1.EventConvertEvmToNFT is not mutable"))
+	case "eth.evm.v1.EventConvertEvmToNFT.nft_id":
+		panic(fmt.Errorf("field nft_id of message eth.evm.v1.EventConvertEvmToNFT is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventConvertEvmToNFT) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertEvmToNFT.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToNFT.erc721_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToNFT.to_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToNFT.class_id":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertEvmToNFT.nft_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventConvertEvmToNFT) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventConvertEvmToNFT", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventConvertEvmToNFT) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertEvmToNFT) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventConvertEvmToNFT) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventConvertEvmToNFT) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventConvertEvmToNFT)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc721ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventConvertEvmToNFT)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ToAddr) > 0 {
+			i -= len(x.ToAddr)
+			copy(dAtA[i:], x.ToAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddr)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc721ContractAddress) > 0 {
+			i -= len(x.Erc721ContractAddress)
+			copy(dAtA[i:], x.Erc721ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc721ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventConvertEvmToNFT)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertEvmToNFT: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertEvmToNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc721ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc721ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventConvertNFTToEvm                         protoreflect.MessageDescriptor
+	fd_EventConvertNFTToEvm_sender                  protoreflect.FieldDescriptor
+	fd_EventConvertNFTToEvm_erc721_contract_address protoreflect.FieldDescriptor
+	fd_EventConvertNFTToEvm_to_eth_addr             protoreflect.FieldDescriptor
+	fd_EventConvertNFTToEvm_class_id                protoreflect.FieldDescriptor
+	fd_EventConvertNFTToEvm_nft_id                  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventConvertNFTToEvm = File_eth_evm_v1_events_proto.Messages().ByName("EventConvertNFTToEvm")
+	fd_EventConvertNFTToEvm_sender = md_EventConvertNFTToEvm.Fields().ByName("sender")
+	fd_EventConvertNFTToEvm_erc721_contract_address = md_EventConvertNFTToEvm.Fields().ByName("erc721_contract_address")
+	fd_EventConvertNFTToEvm_to_eth_addr = md_EventConvertNFTToEvm.Fields().ByName("to_eth_addr")
+	fd_EventConvertNFTToEvm_class_id = md_EventConvertNFTToEvm.Fields().ByName("class_id")
+	fd_EventConvertNFTToEvm_nft_id = md_EventConvertNFTToEvm.Fields().ByName("nft_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventConvertNFTToEvm)(nil)
+
+type fastReflection_EventConvertNFTToEvm EventConvertNFTToEvm
+
+func (x *EventConvertNFTToEvm) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventConvertNFTToEvm)(x)
+}
+
+func (x *EventConvertNFTToEvm) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventConvertNFTToEvm_messageType fastReflection_EventConvertNFTToEvm_messageType
+var _ protoreflect.MessageType = fastReflection_EventConvertNFTToEvm_messageType{}
+
+type fastReflection_EventConvertNFTToEvm_messageType struct{}
+
+func (x fastReflection_EventConvertNFTToEvm_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventConvertNFTToEvm)(nil)
+}
+func (x fastReflection_EventConvertNFTToEvm_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventConvertNFTToEvm)
+}
+func (x fastReflection_EventConvertNFTToEvm_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertNFTToEvm
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventConvertNFTToEvm) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventConvertNFTToEvm
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventConvertNFTToEvm) Type() protoreflect.MessageType {
+	return _fastReflection_EventConvertNFTToEvm_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventConvertNFTToEvm) New() protoreflect.Message {
+	return new(fastReflection_EventConvertNFTToEvm)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventConvertNFTToEvm) Interface() protoreflect.ProtoMessage {
+	return (*EventConvertNFTToEvm)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventConvertNFTToEvm) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventConvertNFTToEvm_sender, value) {
+			return
+		}
+	}
+	if x.Erc721ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.Erc721ContractAddress)
+		if !f(fd_EventConvertNFTToEvm_erc721_contract_address, value) {
+			return
+		}
+	}
+	if x.ToEthAddr != "" {
+		value := protoreflect.ValueOfString(x.ToEthAddr)
+		if !f(fd_EventConvertNFTToEvm_to_eth_addr, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventConvertNFTToEvm_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_EventConvertNFTToEvm_nft_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventConvertNFTToEvm) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertNFTToEvm.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventConvertNFTToEvm.erc721_contract_address":
+		return x.Erc721ContractAddress != ""
+	case "eth.evm.v1.EventConvertNFTToEvm.to_eth_addr":
+		return x.ToEthAddr != ""
+	case "eth.evm.v1.EventConvertNFTToEvm.class_id":
+		return x.ClassId != ""
+	case "eth.evm.v1.EventConvertNFTToEvm.nft_id":
+		return x.NftId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertNFTToEvm) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertNFTToEvm.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventConvertNFTToEvm.erc721_contract_address":
+		x.Erc721ContractAddress = ""
+	case "eth.evm.v1.EventConvertNFTToEvm.to_eth_addr":
+		x.ToEthAddr = ""
+	case "eth.evm.v1.EventConvertNFTToEvm.class_id":
+		x.ClassId = ""
+	case "eth.evm.v1.EventConvertNFTToEvm.nft_id":
+		x.NftId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventConvertNFTToEvm) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventConvertNFTToEvm.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertNFTToEvm.erc721_contract_address":
+		value := x.Erc721ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertNFTToEvm.to_eth_addr":
+		value := x.ToEthAddr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertNFTToEvm.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventConvertNFTToEvm.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertNFTToEvm does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertNFTToEvm) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertNFTToEvm.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventConvertNFTToEvm.erc721_contract_address":
+		x.Erc721ContractAddress = value.Interface().(string)
+	case "eth.evm.v1.EventConvertNFTToEvm.to_eth_addr":
+		x.ToEthAddr = value.Interface().(string)
+	case "eth.evm.v1.EventConvertNFTToEvm.class_id":
+		x.ClassId = value.Interface().(string)
+	case "eth.evm.v1.EventConvertNFTToEvm.nft_id":
+		x.NftId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertNFTToEvm) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertNFTToEvm.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventConvertNFTToEvm is not mutable"))
+	case "eth.evm.v1.EventConvertNFTToEvm.erc721_contract_address":
+		panic(fmt.Errorf("field erc721_contract_address of message eth.evm.v1.EventConvertNFTToEvm is not mutable"))
+	case "eth.evm.v1.EventConvertNFTToEvm.to_eth_addr":
+		panic(fmt.Errorf("field to_eth_addr of message eth.evm.v1.EventConvertNFTToEvm is not mutable"))
+	case "eth.evm.v1.EventConvertNFTToEvm.class_id":
+		panic(fmt.Errorf("field class_id of message eth.evm.v1.EventConvertNFTToEvm is not mutable"))
+	case "eth.evm.v1.EventConvertNFTToEvm.nft_id":
+		panic(fmt.Errorf("field nft_id of message eth.evm.v1.EventConvertNFTToEvm is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventConvertNFTToEvm) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventConvertNFTToEvm.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertNFTToEvm.erc721_contract_address":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertNFTToEvm.to_eth_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertNFTToEvm.class_id":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventConvertNFTToEvm.nft_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventConvertNFTToEvm) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventConvertNFTToEvm", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventConvertNFTToEvm) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventConvertNFTToEvm) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventConvertNFTToEvm) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventConvertNFTToEvm) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventConvertNFTToEvm)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc721ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToEthAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventConvertNFTToEvm)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ToEthAddr) > 0 {
+			i -= len(x.ToEthAddr)
+			copy(dAtA[i:], x.ToEthAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToEthAddr)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc721ContractAddress) > 0 {
+			i -= len(x.Erc721ContractAddress)
+			copy(dAtA[i:], x.Erc721ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc721ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventConvertNFTToEvm)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertNFTToEvm: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventConvertNFTToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc721ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc721ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToEthAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTransfer           protoreflect.MessageDescriptor
+	fd_EventTransfer_sender    protoreflect.FieldDescriptor
+	fd_EventTransfer_recipient protoreflect.FieldDescriptor
+	fd_EventTransfer_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventTransfer = File_eth_evm_v1_events_proto.Messages().ByName("EventTransfer")
+	fd_EventTransfer_sender = md_EventTransfer.Fields().ByName("sender")
+	fd_EventTransfer_recipient = md_EventTransfer.Fields().ByName("recipient")
+	fd_EventTransfer_amount = md_EventTransfer.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTransfer)(nil)
+
+type fastReflection_EventTransfer EventTransfer
+
+func (x *EventTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTransfer)(x)
+}
+
+func (x *EventTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTransfer_messageType fastReflection_EventTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_EventTransfer_messageType{}
+
+type fastReflection_EventTransfer_messageType struct{}
+
+func (x fastReflection_EventTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTransfer)(nil)
+}
+func (x fastReflection_EventTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTransfer)
+}
+func (x fastReflection_EventTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_EventTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTransfer) New() protoreflect.Message {
+	return new(fastReflection_EventTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTransfer) Interface() protoreflect.ProtoMessage {
+	return (*EventTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventTransfer_sender, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_EventTransfer_recipient, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventTransfer_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventTransfer.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventTransfer.recipient":
+		return x.Recipient != ""
+	case "eth.evm.v1.EventTransfer.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventTransfer.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventTransfer.recipient":
+		x.Recipient = ""
+	case "eth.evm.v1.EventTransfer.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventTransfer.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventTransfer.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventTransfer.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventTransfer.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventTransfer.recipient":
+		x.Recipient = value.Interface().(string)
+	case "eth.evm.v1.EventTransfer.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventTransfer.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventTransfer is not mutable"))
+	case "eth.evm.v1.EventTransfer.recipient":
+		panic(fmt.Errorf("field recipient of message eth.evm.v1.EventTransfer is not mutable"))
+	case "eth.evm.v1.EventTransfer.amount":
+		panic(fmt.Errorf("field amount of message eth.evm.v1.EventTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventTransfer.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventTransfer.recipient":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventTransfer.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventTransfer"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventContractDeployed               protoreflect.MessageDescriptor
+	fd_EventContractDeployed_sender        protoreflect.FieldDescriptor
+	fd_EventContractDeployed_contract_addr protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventContractDeployed = File_eth_evm_v1_events_proto.Messages().ByName("EventContractDeployed")
+	fd_EventContractDeployed_sender = md_EventContractDeployed.Fields().ByName("sender")
+	fd_EventContractDeployed_contract_addr = md_EventContractDeployed.Fields().ByName("contract_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventContractDeployed)(nil)
+
+type fastReflection_EventContractDeployed EventContractDeployed
+
+func (x *EventContractDeployed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventContractDeployed)(x)
+}
+
+func (x *EventContractDeployed) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventContractDeployed_messageType fastReflection_EventContractDeployed_messageType
+var _ protoreflect.MessageType = fastReflection_EventContractDeployed_messageType{}
+
+type fastReflection_EventContractDeployed_messageType struct{}
+
+func (x fastReflection_EventContractDeployed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventContractDeployed)(nil)
+}
+func (x fastReflection_EventContractDeployed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventContractDeployed)
+}
+func (x fastReflection_EventContractDeployed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractDeployed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventContractDeployed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractDeployed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventContractDeployed) Type() protoreflect.MessageType {
+	return _fastReflection_EventContractDeployed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventContractDeployed) New() protoreflect.Message {
+	return new(fastReflection_EventContractDeployed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventContractDeployed) Interface() protoreflect.ProtoMessage {
+	return (*EventContractDeployed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventContractDeployed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventContractDeployed_sender, value) {
+			return
+		}
+	}
+	if x.ContractAddr != "" {
+		value := protoreflect.ValueOfString(x.ContractAddr)
+		if !f(fd_EventContractDeployed_contract_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventContractDeployed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractDeployed.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventContractDeployed.contract_addr":
+		return x.ContractAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractDeployed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractDeployed.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventContractDeployed.contract_addr":
+		x.ContractAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventContractDeployed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventContractDeployed.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventContractDeployed.contract_addr":
+		value := x.ContractAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractDeployed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractDeployed.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventContractDeployed.contract_addr":
+		x.ContractAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractDeployed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractDeployed.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventContractDeployed is not mutable"))
+	case "eth.evm.v1.EventContractDeployed.contract_addr":
+		panic(fmt.Errorf("field contract_addr of message eth.evm.v1.EventContractDeployed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventContractDeployed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractDeployed.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventContractDeployed.contract_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractDeployed"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractDeployed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventContractDeployed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventContractDeployed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventContractDeployed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractDeployed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventContractDeployed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventContractDeployed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventContractDeployed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractDeployed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ContractAddr) > 0 {
+			i -= len(x.ContractAddr)
+			copy(dAtA[i:], x.ContractAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractDeployed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractDeployed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractDeployed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventContractExecuted               protoreflect.MessageDescriptor
+	fd_EventContractExecuted_sender        protoreflect.FieldDescriptor
+	fd_EventContractExecuted_contract_addr protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_events_proto_init()
+	md_EventContractExecuted = File_eth_evm_v1_events_proto.Messages().ByName("EventContractExecuted")
+	fd_EventContractExecuted_sender = md_EventContractExecuted.Fields().ByName("sender")
+	fd_EventContractExecuted_contract_addr = md_EventContractExecuted.Fields().ByName("contract_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventContractExecuted)(nil)
+
+type fastReflection_EventContractExecuted EventContractExecuted
+
+func (x *EventContractExecuted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventContractExecuted)(x)
+}
+
+func (x *EventContractExecuted) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_events_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventContractExecuted_messageType fastReflection_EventContractExecuted_messageType
+var _ protoreflect.MessageType = fastReflection_EventContractExecuted_messageType{}
+
+type fastReflection_EventContractExecuted_messageType struct{}
+
+func (x fastReflection_EventContractExecuted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventContractExecuted)(nil)
+}
+func (x fastReflection_EventContractExecuted_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventContractExecuted)
+}
+func (x fastReflection_EventContractExecuted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractExecuted
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventContractExecuted) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractExecuted
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventContractExecuted) Type() protoreflect.MessageType {
+	return _fastReflection_EventContractExecuted_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventContractExecuted) New() protoreflect.Message {
+	return new(fastReflection_EventContractExecuted)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventContractExecuted) Interface() protoreflect.ProtoMessage {
+	return (*EventContractExecuted)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventContractExecuted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventContractExecuted_sender, value) {
+			return
+		}
+	}
+	if x.ContractAddr != "" {
+		value := protoreflect.ValueOfString(x.ContractAddr)
+		if !f(fd_EventContractExecuted_contract_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventContractExecuted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractExecuted.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.EventContractExecuted.contract_addr":
+		return x.ContractAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractExecuted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractExecuted.sender":
+		x.Sender = ""
+	case "eth.evm.v1.EventContractExecuted.contract_addr":
+		x.ContractAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventContractExecuted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.EventContractExecuted.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.EventContractExecuted.contract_addr":
+		value := x.ContractAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractExecuted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractExecuted.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.EventContractExecuted.contract_addr":
+		x.ContractAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractExecuted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractExecuted.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.EventContractExecuted is not mutable"))
+	case "eth.evm.v1.EventContractExecuted.contract_addr":
+		panic(fmt.Errorf("field contract_addr of message eth.evm.v1.EventContractExecuted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventContractExecuted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.EventContractExecuted.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.EventContractExecuted.contract_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EventContractExecuted"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.EventContractExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventContractExecuted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EventContractExecuted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventContractExecuted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractExecuted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventContractExecuted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventContractExecuted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventContractExecuted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractExecuted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ContractAddr) > 0 {
+			i -= len(x.ContractAddr)
+			copy(dAtA[i:], x.ContractAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractExecuted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractExecuted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright (c) 2023-2024 Nibi, Inc.
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
@@ -4514,267 +7619,570 @@ type EventEthereumTx struct {
 	VmError string `protobuf:"bytes,7,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
 }
 
-func (x *EventEthereumTx) Reset() {
-	*x = EventEthereumTx{}
+func (x *EventEthereumTx) Reset() {
+	*x = EventEthereumTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventEthereumTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEthereumTx) ProtoMessage() {}
+
+// Deprecated: Use EventEthereumTx.ProtoReflect.Descriptor instead.
+func (*EventEthereumTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventEthereumTx) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetEthHash() string {
+	if x != nil {
+		return x.EthHash
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetGasUsed() string {
+	if x != nil {
+		return x.GasUsed
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EventEthereumTx) GetVmError() string {
+	if x != nil {
+		return x.VmError
+	}
+	return ""
+}
+
+// EventTxLog defines the event for an Ethereum transaction log
+type EventTxLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx_logs is an array of transaction logs
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *EventTxLog) Reset() {
+	*x = EventTxLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTxLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTxLog) ProtoMessage() {}
+
+// Deprecated: Use EventTxLog.ProtoReflect.Descriptor instead.
+func (*EventTxLog) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventTxLog) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// EventBlockBloom defines an Ethereum block bloom filter event
+type EventBlockBloom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bloom is the bloom filter of the block
+	Bloom string `protobuf:"bytes,1,opt,name=bloom,proto3" json:"bloom,omitempty"`
+}
+
+func (x *EventBlockBloom) Reset() {
+	*x = EventBlockBloom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventBlockBloom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBlockBloom) ProtoMessage() {}
+
+// Deprecated: Use EventBlockBloom.ProtoReflect.Descriptor instead.
+func (*EventBlockBloom) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventBlockBloom) GetBloom() string {
+	if x != nil {
+		return x.Bloom
+	}
+	return ""
+}
+
+// EventFunTokenCreated defines a fun token creation event.
+type EventFunTokenCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankDenom            string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Erc20ContractAddress string `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	Creator              string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	IsMadeFromCoin       bool   `protobuf:"varint,4,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+}
+
+func (x *EventFunTokenCreated) Reset() {
+	*x = EventFunTokenCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFunTokenCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFunTokenCreated) ProtoMessage() {}
+
+// Deprecated: Use EventFunTokenCreated.ProtoReflect.Descriptor instead.
+func (*EventFunTokenCreated) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventFunTokenCreated) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *EventFunTokenCreated) GetErc20ContractAddress() string {
+	if x != nil {
+		return x.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (x *EventFunTokenCreated) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *EventFunTokenCreated) GetIsMadeFromCoin() bool {
+	if x != nil {
+		return x.IsMadeFromCoin
+	}
+	return false
+}
+
+// ConvertCoinToEvm defines sending fun token to erc20 event.
+type EventConvertCoinToEvm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender               string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string        `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToEthAddr            string        `protobuf:"bytes,3,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
+	BankCoin             *v1beta1.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+}
+
+func (x *EventConvertCoinToEvm) Reset() {
+	*x = EventConvertCoinToEvm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[0]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventEthereumTx) String() string {
+func (x *EventConvertCoinToEvm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventEthereumTx) ProtoMessage() {}
+func (*EventConvertCoinToEvm) ProtoMessage() {}
 
-// Deprecated: Use EventEthereumTx.ProtoReflect.Descriptor instead.
-func (*EventEthereumTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use EventConvertCoinToEvm.ProtoReflect.Descriptor instead.
+func (*EventConvertCoinToEvm) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *EventEthereumTx) GetAmount() string {
+func (x *EventConvertCoinToEvm) GetSender() string {
 	if x != nil {
-		return x.Amount
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *EventEthereumTx) GetEthHash() string {
+func (x *EventConvertCoinToEvm) GetErc20ContractAddress() string {
 	if x != nil {
-		return x.EthHash
+		return x.Erc20ContractAddress
 	}
 	return ""
 }
 
-func (x *EventEthereumTx) GetIndex() string {
+func (x *EventConvertCoinToEvm) GetToEthAddr() string {
 	if x != nil {
-		return x.Index
+		return x.ToEthAddr
 	}
 	return ""
 }
 
-func (x *EventEthereumTx) GetGasUsed() string {
+func (x *EventConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
 	if x != nil {
-		return x.GasUsed
+		return x.BankCoin
 	}
-	return ""
+	return nil
 }
 
-func (x *EventEthereumTx) GetHash() string {
+// EventConvertEvmToCoin defines sending erc20 to fun token event.
+type EventConvertEvmToCoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender               string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string        `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToAddr               string        `protobuf:"bytes,3,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	BankCoin             *v1beta1.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+}
+
+func (x *EventConvertEvmToCoin) Reset() {
+	*x = EventConvertEvmToCoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventConvertEvmToCoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventConvertEvmToCoin) ProtoMessage() {}
+
+// Deprecated: Use EventConvertEvmToCoin.ProtoReflect.Descriptor instead.
+func (*EventConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventConvertEvmToCoin) GetSender() string {
 	if x != nil {
-		return x.Hash
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *EventEthereumTx) GetRecipient() string {
+func (x *EventConvertEvmToCoin) GetErc20ContractAddress() string {
 	if x != nil {
-		return x.Recipient
+		return x.Erc20ContractAddress
 	}
 	return ""
 }
 
-func (x *EventEthereumTx) GetVmError() string {
+func (x *EventConvertEvmToCoin) GetToAddr() string {
 	if x != nil {
-		return x.VmError
+		return x.ToAddr
 	}
 	return ""
 }
 
-// EventTxLog defines the event for an Ethereum transaction log
-type EventTxLog struct {
+func (x *EventConvertEvmToCoin) GetBankCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.BankCoin
+	}
+	return nil
+}
+
+// EventFunTokenEscrowReconciled defines the event for the reconciliation of
+// the escrow of a `FunToken` created from an ERC20.
+type EventFunTokenEscrowReconciled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tx_logs is an array of transaction logs
-	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Sender   string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	FunToken *FunToken       `protobuf:"bytes,2,opt,name=fun_token,json=funToken,proto3" json:"fun_token,omitempty"`
+	Escrow   *FunTokenEscrow `protobuf:"bytes,3,opt,name=escrow,proto3" json:"escrow,omitempty"`
 }
 
-func (x *EventTxLog) Reset() {
-	*x = EventTxLog{}
+func (x *EventFunTokenEscrowReconciled) Reset() {
+	*x = EventFunTokenEscrowReconciled{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[1]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventTxLog) String() string {
+func (x *EventFunTokenEscrowReconciled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventTxLog) ProtoMessage() {}
+func (*EventFunTokenEscrowReconciled) ProtoMessage() {}
 
-// Deprecated: Use EventTxLog.ProtoReflect.Descriptor instead.
-func (*EventTxLog) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EventFunTokenEscrowReconciled.ProtoReflect.Descriptor instead.
+func (*EventFunTokenEscrowReconciled) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *EventTxLog) GetLogs() []*Log {
+func (x *EventFunTokenEscrowReconciled) GetSender() string {
 	if x != nil {
-		return x.Logs
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventFunTokenEscrowReconciled) GetFunToken() *FunToken {
+	if x != nil {
+		return x.FunToken
 	}
 	return nil
 }
 
-// EventBlockBloom defines an Ethereum block bloom filter event
-type EventBlockBloom struct {
+func (x *EventFunTokenEscrowReconciled) GetEscrow() *FunTokenEscrow {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+// EventFunNFTCreated defines the event for the creation of a `FunNFT` mapping.
+type EventFunNFTCreated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// bloom is the bloom filter of the block
-	Bloom string `protobuf:"bytes,1,opt,name=bloom,proto3" json:"bloom,omitempty"`
+	ClassId               string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Erc721ContractAddress string `protobuf:"bytes,2,opt,name=erc721_contract_address,json=erc721ContractAddress,proto3" json:"erc721_contract_address,omitempty"`
+	Creator               string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (x *EventBlockBloom) Reset() {
-	*x = EventBlockBloom{}
+func (x *EventFunNFTCreated) Reset() {
+	*x = EventFunNFTCreated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[2]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventBlockBloom) String() string {
+func (x *EventFunNFTCreated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventBlockBloom) ProtoMessage() {}
+func (*EventFunNFTCreated) ProtoMessage() {}
 
-// Deprecated: Use EventBlockBloom.ProtoReflect.Descriptor instead.
-func (*EventBlockBloom) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EventFunNFTCreated.ProtoReflect.Descriptor instead.
+func (*EventFunNFTCreated) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *EventBlockBloom) GetBloom() string {
+func (x *EventFunNFTCreated) GetClassId() string {
 	if x != nil {
-		return x.Bloom
+		return x.ClassId
 	}
 	return ""
 }
 
-// EventFunTokenCreated defines a fun token creation event.
-type EventFunTokenCreated struct {
+func (x *EventFunNFTCreated) GetErc721ContractAddress() string {
+	if x != nil {
+		return x.Erc721ContractAddress
+	}
+	return ""
+}
+
+func (x *EventFunNFTCreated) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+// EventConvertEvmToNFT defines the event for sending an ERC-721 token to its
+// "x/nft" representation.
+type EventConvertEvmToNFT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BankDenom            string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	Erc20ContractAddress string `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
-	Creator              string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
-	IsMadeFromCoin       bool   `protobuf:"varint,4,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	Sender                string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc721ContractAddress string `protobuf:"bytes,2,opt,name=erc721_contract_address,json=erc721ContractAddress,proto3" json:"erc721_contract_address,omitempty"`
+	ToAddr                string `protobuf:"bytes,3,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	ClassId               string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId                 string `protobuf:"bytes,5,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func (x *EventFunTokenCreated) Reset() {
-	*x = EventFunTokenCreated{}
+func (x *EventConvertEvmToNFT) Reset() {
+	*x = EventConvertEvmToNFT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[3]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventFunTokenCreated) String() string {
+func (x *EventConvertEvmToNFT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventFunTokenCreated) ProtoMessage() {}
+func (*EventConvertEvmToNFT) ProtoMessage() {}
 
-// Deprecated: Use EventFunTokenCreated.ProtoReflect.Descriptor instead.
-func (*EventFunTokenCreated) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EventConvertEvmToNFT.ProtoReflect.Descriptor instead.
+func (*EventConvertEvmToNFT) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EventFunTokenCreated) GetBankDenom() string {
+func (x *EventConvertEvmToNFT) GetSender() string {
 	if x != nil {
-		return x.BankDenom
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *EventFunTokenCreated) GetErc20ContractAddress() string {
+func (x *EventConvertEvmToNFT) GetErc721ContractAddress() string {
 	if x != nil {
-		return x.Erc20ContractAddress
+		return x.Erc721ContractAddress
 	}
 	return ""
 }
 
-func (x *EventFunTokenCreated) GetCreator() string {
+func (x *EventConvertEvmToNFT) GetToAddr() string {
 	if x != nil {
-		return x.Creator
+		return x.ToAddr
 	}
 	return ""
 }
 
-func (x *EventFunTokenCreated) GetIsMadeFromCoin() bool {
+func (x *EventConvertEvmToNFT) GetClassId() string {
 	if x != nil {
-		return x.IsMadeFromCoin
+		return x.ClassId
 	}
-	return false
+	return ""
 }
 
-// ConvertCoinToEvm defines sending fun token to erc20 event.
-type EventConvertCoinToEvm struct {
+func (x *EventConvertEvmToNFT) GetNftId() string {
+	if x != nil {
+		return x.NftId
+	}
+	return ""
+}
+
+// EventConvertNFTToEvm defines the event for sending an "x/nft" token to its
+// ERC-721 representation.
+type EventConvertNFTToEvm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender               string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Erc20ContractAddress string        `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
-	ToEthAddr            string        `protobuf:"bytes,3,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
-	BankCoin             *v1beta1.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+	Sender                string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc721ContractAddress string `protobuf:"bytes,2,opt,name=erc721_contract_address,json=erc721ContractAddress,proto3" json:"erc721_contract_address,omitempty"`
+	ToEthAddr             string `protobuf:"bytes,3,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
+	ClassId               string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId                 string `protobuf:"bytes,5,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func (x *EventConvertCoinToEvm) Reset() {
-	*x = EventConvertCoinToEvm{}
+func (x *EventConvertNFTToEvm) Reset() {
+	*x = EventConvertNFTToEvm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[4]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventConvertCoinToEvm) String() string {
+func (x *EventConvertNFTToEvm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventConvertCoinToEvm) ProtoMessage() {}
+func (*EventConvertNFTToEvm) ProtoMessage() {}
 
-// Deprecated: Use EventConvertCoinToEvm.ProtoReflect.Descriptor instead.
-func (*EventConvertCoinToEvm) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use EventConvertNFTToEvm.ProtoReflect.Descriptor instead.
+func (*EventConvertNFTToEvm) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EventConvertCoinToEvm) GetSender() string {
+func (x *EventConvertNFTToEvm) GetSender() string {
 	if x != nil {
 		return x.Sender
 	}
 	return ""
 }
 
-func (x *EventConvertCoinToEvm) GetErc20ContractAddress() string {
+func (x *EventConvertNFTToEvm) GetErc721ContractAddress() string {
 	if x != nil {
-		return x.Erc20ContractAddress
+		return x.Erc721ContractAddress
 	}
 	return ""
 }
 
-func (x *EventConvertCoinToEvm) GetToEthAddr() string {
+func (x *EventConvertNFTToEvm) GetToEthAddr() string {
 	if x != nil {
 		return x.ToEthAddr
 	}
 	return ""
 }
 
-func (x *EventConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
+func (x *EventConvertNFTToEvm) GetClassId() string {
 	if x != nil {
-		return x.BankCoin
+		return x.ClassId
 	}
-	return nil
+	return ""
+}
+
+func (x *EventConvertNFTToEvm) GetNftId() string {
+	if x != nil {
+		return x.NftId
+	}
+	return ""
 }
 
 // EventTransfer defines event for EVM transfer
@@ -4791,7 +8199,7 @@ type EventTransfer struct {
 func (x *EventTransfer) Reset() {
 	*x = EventTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[5]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4805,7 +8213,7 @@ func (*EventTransfer) ProtoMessage() {}
 
 // Deprecated: Use EventTransfer.ProtoReflect.Descriptor instead.
 func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{5}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EventTransfer) GetSender() string {
@@ -4842,7 +8250,7 @@ type EventContractDeployed struct {
 func (x *EventContractDeployed) Reset() {
 	*x = EventContractDeployed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[6]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4856,7 +8264,7 @@ func (*EventContractDeployed) ProtoMessage() {}
 
 // Deprecated: Use EventContractDeployed.ProtoReflect.Descriptor instead.
 func (*EventContractDeployed) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{6}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EventContractDeployed) GetSender() string {
@@ -4886,7 +8294,7 @@ type EventContractExecuted struct {
 func (x *EventContractExecuted) Reset() {
 	*x = EventContractExecuted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_events_proto_msgTypes[7]
+		mi := &file_eth_evm_v1_events_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4900,7 +8308,7 @@ func (*EventContractExecuted) ProtoMessage() {}
 
 // Deprecated: Use EventContractExecuted.ProtoReflect.Descriptor instead.
 func (*EventContractExecuted) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{7}
+	return file_eth_evm_v1_events_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EventContractExecuted) GetSender() string {
@@ -4970,33 +8378,88 @@ var file_eth_evm_v1_events_proto_rawDesc = []byte{
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
 	0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e,
-	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0x5d, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x42, 0x8a, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74,
-	0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45,
-	0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0xd0, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x12, 0x50, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08,
+	0x62, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x1d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46,
+	0x75, 0x6e, 0x4e, 0x46, 0x54, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x72, 0x63, 0x37, 0x32,
+	0x31, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x65, 0x72, 0x63, 0x37, 0x32, 0x31,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xb1, 0x01, 0x0a, 0x14, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x4e,
+	0x46, 0x54, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x72,
+	0x63, 0x37, 0x32, 0x31, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x65, 0x72, 0x63,
+	0x37, 0x32, 0x31, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x22, 0xb8, 0x01,
+	0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4e, 0x46,
+	0x54, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x17, 0x65, 0x72, 0x63, 0x37, 0x32, 0x31, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x65, 0x72, 0x63, 0x37, 0x32, 0x31, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x65, 0x74, 0x68,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x45,
+	0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49,
+	0x64, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x54, 0x0a,
+	0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x42, 0x8a, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
+	0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02,
+	0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74,
+	0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45,
+	0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5011,27 +8474,37 @@ func file_eth_evm_v1_events_proto_rawDescGZIP() []byte {
 	return file_eth_evm_v1_events_proto_rawDescData
 }
 
-var file_eth_evm_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_eth_evm_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_eth_evm_v1_events_proto_goTypes = []interface{}{
-	(*EventEthereumTx)(nil),       // 0: eth.evm.v1.EventEthereumTx
-	(*EventTxLog)(nil),            // 1: eth.evm.v1.EventTxLog
-	(*EventBlockBloom)(nil),       // 2: eth.evm.v1.EventBlockBloom
-	(*EventFunTokenCreated)(nil),  // 3: eth.evm.v1.EventFunTokenCreated
-	(*EventConvertCoinToEvm)(nil), // 4: eth.evm.v1.EventConvertCoinToEvm
-	(*EventTransfer)(nil),         // 5: eth.evm.v1.EventTransfer
-	(*EventContractDeployed)(nil), // 6: eth.evm.v1.EventContractDeployed
-	(*EventContractExecuted)(nil), // 7: eth.evm.v1.EventContractExecuted
-	(*Log)(nil),                   // 8: eth.evm.v1.Log
-	(*v1beta1.Coin)(nil),          // 9: cosmos.base.v1beta1.Coin
+	(*EventEthereumTx)(nil),               // 0: eth.evm.v1.EventEthereumTx
+	(*EventTxLog)(nil),                    // 1: eth.evm.v1.EventTxLog
+	(*EventBlockBloom)(nil),               // 2: eth.evm.v1.EventBlockBloom
+	(*EventFunTokenCreated)(nil),          // 3: eth.evm.v1.EventFunTokenCreated
+	(*EventConvertCoinToEvm)(nil),         // 4: eth.evm.v1.EventConvertCoinToEvm
+	(*EventConvertEvmToCoin)(nil),         // 5: eth.evm.v1.EventConvertEvmToCoin
+	(*EventFunTokenEscrowReconciled)(nil), // 6: eth.evm.v1.EventFunTokenEscrowReconciled
+	(*EventFunNFTCreated)(nil),            // 7: eth.evm.v1.EventFunNFTCreated
+	(*EventConvertEvmToNFT)(nil),          // 8: eth.evm.v1.EventConvertEvmToNFT
+	(*EventConvertNFTToEvm)(nil),          // 9: eth.evm.v1.EventConvertNFTToEvm
+	(*EventTransfer)(nil),                 // 10: eth.evm.v1.EventTransfer
+	(*EventContractDeployed)(nil),         // 11: eth.evm.v1.EventContractDeployed
+	(*EventContractExecuted)(nil),         // 12: eth.evm.v1.EventContractExecuted
+	(*Log)(nil),                           // 13: eth.evm.v1.Log
+	(*v1beta1.Coin)(nil),                  // 14: cosmos.base.v1beta1.Coin
+	(*FunToken)(nil),                      // 15: eth.evm.v1.FunToken
+	(*FunTokenEscrow)(nil),                // 16: eth.evm.v1.FunTokenEscrow
 }
 var file_eth_evm_v1_events_proto_depIdxs = []int32{
-	8, // 0: eth.evm.v1.EventTxLog.logs:type_name -> eth.evm.v1.Log
-	9, // 1: eth.evm.v1.EventConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	13, // 0: eth.evm.v1.EventTxLog.logs:type_name -> eth.evm.v1.Log
+	14, // 1: eth.evm.v1.EventConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	14, // 2: eth.evm.v1.EventConvertEvmToCoin.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	15, // 3: eth.evm.v1.EventFunTokenEscrowReconciled.fun_token:type_name -> eth.evm.v1.FunToken
+	16, // 4: eth.evm.v1.EventFunTokenEscrowReconciled.escrow:type_name -> eth.evm.v1.FunTokenEscrow
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_eth_evm_v1_events_proto_init() }
@@ -5102,7 +8575,7 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTransfer); i {
+			switch v := v.(*EventConvertEvmToCoin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5114,7 +8587,7 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventContractDeployed); i {
+			switch v := v.(*EventFunTokenEscrowReconciled); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5126,6 +8599,66 @@ func file_eth_evm_v1_events_proto_init() {
 			}
 		}
 		file_eth_evm_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFunNFTCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventConvertEvmToNFT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventConvertNFTToEvm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventContractDeployed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventContractExecuted); i {
 			case 0:
 				return &v.state
@@ -5144,7 +8677,7 @@ func file_eth_evm_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eth_evm_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
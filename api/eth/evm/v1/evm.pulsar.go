@@ -18,6 +18,8 @@ var (
 	fd_FunToken_erc20_addr        protoreflect.FieldDescriptor
 	fd_FunToken_bank_denom        protoreflect.FieldDescriptor
 	fd_FunToken_is_made_from_coin protoreflect.FieldDescriptor
+	fd_FunToken_scaling_exponent  protoreflect.FieldDescriptor
+	fd_FunToken_is_rebasing       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +28,8 @@ func init() {
 	fd_FunToken_erc20_addr = md_FunToken.Fields().ByName("erc20_addr")
 	fd_FunToken_bank_denom = md_FunToken.Fields().ByName("bank_denom")
 	fd_FunToken_is_made_from_coin = md_FunToken.Fields().ByName("is_made_from_coin")
+	fd_FunToken_scaling_exponent = md_FunToken.Fields().ByName("scaling_exponent")
+	fd_FunToken_is_rebasing = md_FunToken.Fields().ByName("is_rebasing")
 }
 
 var _ protoreflect.Message = (*fastReflection_FunToken)(nil)
@@ -111,6 +115,18 @@ func (x *fastReflection_FunToken) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.ScalingExponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ScalingExponent)
+		if !f(fd_FunToken_scaling_exponent, value) {
+			return
+		}
+	}
+	if x.IsRebasing != false {
+		value := protoreflect.ValueOfBool(x.IsRebasing)
+		if !f(fd_FunToken_is_rebasing, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -132,6 +148,10 @@ func (x *fastReflection_FunToken) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BankDenom != ""
 	case "eth.evm.v1.FunToken.is_made_from_coin":
 		return x.IsMadeFromCoin != false
+	case "eth.evm.v1.FunToken.scaling_exponent":
+		return x.ScalingExponent != uint32(0)
+	case "eth.evm.v1.FunToken.is_rebasing":
+		return x.IsRebasing != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunToken"))
@@ -154,6 +174,10 @@ func (x *fastReflection_FunToken) Clear(fd protoreflect.FieldDescriptor) {
 		x.BankDenom = ""
 	case "eth.evm.v1.FunToken.is_made_from_coin":
 		x.IsMadeFromCoin = false
+	case "eth.evm.v1.FunToken.scaling_exponent":
+		x.ScalingExponent = uint32(0)
+	case "eth.evm.v1.FunToken.is_rebasing":
+		x.IsRebasing = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunToken"))
@@ -179,6 +203,12 @@ func (x *fastReflection_FunToken) Get(descriptor protoreflect.FieldDescriptor) p
 	case "eth.evm.v1.FunToken.is_made_from_coin":
 		value := x.IsMadeFromCoin
 		return protoreflect.ValueOfBool(value)
+	case "eth.evm.v1.FunToken.scaling_exponent":
+		value := x.ScalingExponent
+		return protoreflect.ValueOfUint32(value)
+	case "eth.evm.v1.FunToken.is_rebasing":
+		value := x.IsRebasing
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunToken"))
@@ -205,6 +235,10 @@ func (x *fastReflection_FunToken) Set(fd protoreflect.FieldDescriptor, value pro
 		x.BankDenom = value.Interface().(string)
 	case "eth.evm.v1.FunToken.is_made_from_coin":
 		x.IsMadeFromCoin = value.Bool()
+	case "eth.evm.v1.FunToken.scaling_exponent":
+		x.ScalingExponent = uint32(value.Uint())
+	case "eth.evm.v1.FunToken.is_rebasing":
+		x.IsRebasing = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunToken"))
@@ -231,6 +265,10 @@ func (x *fastReflection_FunToken) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field bank_denom of message eth.evm.v1.FunToken is not mutable"))
 	case "eth.evm.v1.FunToken.is_made_from_coin":
 		panic(fmt.Errorf("field is_made_from_coin of message eth.evm.v1.FunToken is not mutable"))
+	case "eth.evm.v1.FunToken.scaling_exponent":
+		panic(fmt.Errorf("field scaling_exponent of message eth.evm.v1.FunToken is not mutable"))
+	case "eth.evm.v1.FunToken.is_rebasing":
+		panic(fmt.Errorf("field is_rebasing of message eth.evm.v1.FunToken is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunToken"))
@@ -250,6 +288,10 @@ func (x *fastReflection_FunToken) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "eth.evm.v1.FunToken.is_made_from_coin":
 		return protoreflect.ValueOfBool(false)
+	case "eth.evm.v1.FunToken.scaling_exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "eth.evm.v1.FunToken.is_rebasing":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunToken"))
@@ -330,6 +372,12 @@ func (x *fastReflection_FunToken) ProtoMethods() *protoiface.Methods {
 		if x.IsMadeFromCoin {
 			n += 2
 		}
+		if x.ScalingExponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScalingExponent))
+		}
+		if x.IsRebasing {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -359,6 +407,21 @@ func (x *fastReflection_FunToken) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.IsRebasing {
+			i--
+			if x.IsRebasing {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ScalingExponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScalingExponent))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.IsMadeFromCoin {
 			i--
 			if x.IsMadeFromCoin {
@@ -376,10 +439,1135 @@ func (x *fastReflection_FunToken) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Erc20Addr) > 0 {
-			i -= len(x.Erc20Addr)
-			copy(dAtA[i:], x.Erc20Addr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Addr)))
+		if len(x.Erc20Addr) > 0 {
+			i -= len(x.Erc20Addr)
+			copy(dAtA[i:], x.Erc20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Addr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FunToken)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunToken: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsMadeFromCoin", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsMadeFromCoin = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScalingExponent", wireType)
+				}
+				x.ScalingExponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScalingExponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsRebasing", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsRebasing = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FunNFT             protoreflect.MessageDescriptor
+	fd_FunNFT_erc721_addr protoreflect.FieldDescriptor
+	fd_FunNFT_class_id    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_evm_proto_init()
+	md_FunNFT = File_eth_evm_v1_evm_proto.Messages().ByName("FunNFT")
+	fd_FunNFT_erc721_addr = md_FunNFT.Fields().ByName("erc721_addr")
+	fd_FunNFT_class_id = md_FunNFT.Fields().ByName("class_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_FunNFT)(nil)
+
+type fastReflection_FunNFT FunNFT
+
+func (x *FunNFT) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FunNFT)(x)
+}
+
+func (x *FunNFT) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_evm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FunNFT_messageType fastReflection_FunNFT_messageType
+var _ protoreflect.MessageType = fastReflection_FunNFT_messageType{}
+
+type fastReflection_FunNFT_messageType struct{}
+
+func (x fastReflection_FunNFT_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FunNFT)(nil)
+}
+func (x fastReflection_FunNFT_messageType) New() protoreflect.Message {
+	return new(fastReflection_FunNFT)
+}
+func (x fastReflection_FunNFT_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FunNFT
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FunNFT) Descriptor() protoreflect.MessageDescriptor {
+	return md_FunNFT
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FunNFT) Type() protoreflect.MessageType {
+	return _fastReflection_FunNFT_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FunNFT) New() protoreflect.Message {
+	return new(fastReflection_FunNFT)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FunNFT) Interface() protoreflect.ProtoMessage {
+	return (*FunNFT)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FunNFT) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Erc721Addr != "" {
+		value := protoreflect.ValueOfString(x.Erc721Addr)
+		if !f(fd_FunNFT_erc721_addr, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_FunNFT_class_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FunNFT) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunNFT.erc721_addr":
+		return x.Erc721Addr != ""
+	case "eth.evm.v1.FunNFT.class_id":
+		return x.ClassId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunNFT) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunNFT.erc721_addr":
+		x.Erc721Addr = ""
+	case "eth.evm.v1.FunNFT.class_id":
+		x.ClassId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FunNFT) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.FunNFT.erc721_addr":
+		value := x.Erc721Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.FunNFT.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunNFT does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunNFT) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunNFT.erc721_addr":
+		x.Erc721Addr = value.Interface().(string)
+	case "eth.evm.v1.FunNFT.class_id":
+		x.ClassId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunNFT) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunNFT.erc721_addr":
+		panic(fmt.Errorf("field erc721_addr of message eth.evm.v1.FunNFT is not mutable"))
+	case "eth.evm.v1.FunNFT.class_id":
+		panic(fmt.Errorf("field class_id of message eth.evm.v1.FunNFT is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FunNFT) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunNFT.erc721_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.FunNFT.class_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FunNFT) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.FunNFT", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FunNFT) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunNFT) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FunNFT) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FunNFT) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FunNFT)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Erc721Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FunNFT)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Erc721Addr) > 0 {
+			i -= len(x.Erc721Addr)
+			copy(dAtA[i:], x.Erc721Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc721Addr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FunNFT)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunNFT: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc721Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc721Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FunTokenEscrow                 protoreflect.MessageDescriptor
+	fd_FunTokenEscrow_escrow_balance  protoreflect.FieldDescriptor
+	fd_FunTokenEscrow_bank_supply     protoreflect.FieldDescriptor
+	fd_FunTokenEscrow_expected_escrow protoreflect.FieldDescriptor
+	fd_FunTokenEscrow_drift           protoreflect.FieldDescriptor
+	fd_FunTokenEscrow_invariant_holds protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_evm_proto_init()
+	md_FunTokenEscrow = File_eth_evm_v1_evm_proto.Messages().ByName("FunTokenEscrow")
+	fd_FunTokenEscrow_escrow_balance = md_FunTokenEscrow.Fields().ByName("escrow_balance")
+	fd_FunTokenEscrow_bank_supply = md_FunTokenEscrow.Fields().ByName("bank_supply")
+	fd_FunTokenEscrow_expected_escrow = md_FunTokenEscrow.Fields().ByName("expected_escrow")
+	fd_FunTokenEscrow_drift = md_FunTokenEscrow.Fields().ByName("drift")
+	fd_FunTokenEscrow_invariant_holds = md_FunTokenEscrow.Fields().ByName("invariant_holds")
+}
+
+var _ protoreflect.Message = (*fastReflection_FunTokenEscrow)(nil)
+
+type fastReflection_FunTokenEscrow FunTokenEscrow
+
+func (x *FunTokenEscrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FunTokenEscrow)(x)
+}
+
+func (x *FunTokenEscrow) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_evm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FunTokenEscrow_messageType fastReflection_FunTokenEscrow_messageType
+var _ protoreflect.MessageType = fastReflection_FunTokenEscrow_messageType{}
+
+type fastReflection_FunTokenEscrow_messageType struct{}
+
+func (x fastReflection_FunTokenEscrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FunTokenEscrow)(nil)
+}
+func (x fastReflection_FunTokenEscrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_FunTokenEscrow)
+}
+func (x fastReflection_FunTokenEscrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FunTokenEscrow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FunTokenEscrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_FunTokenEscrow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FunTokenEscrow) Type() protoreflect.MessageType {
+	return _fastReflection_FunTokenEscrow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FunTokenEscrow) New() protoreflect.Message {
+	return new(fastReflection_FunTokenEscrow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FunTokenEscrow) Interface() protoreflect.ProtoMessage {
+	return (*FunTokenEscrow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FunTokenEscrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EscrowBalance != "" {
+		value := protoreflect.ValueOfString(x.EscrowBalance)
+		if !f(fd_FunTokenEscrow_escrow_balance, value) {
+			return
+		}
+	}
+	if x.BankSupply != "" {
+		value := protoreflect.ValueOfString(x.BankSupply)
+		if !f(fd_FunTokenEscrow_bank_supply, value) {
+			return
+		}
+	}
+	if x.ExpectedEscrow != "" {
+		value := protoreflect.ValueOfString(x.ExpectedEscrow)
+		if !f(fd_FunTokenEscrow_expected_escrow, value) {
+			return
+		}
+	}
+	if x.Drift != "" {
+		value := protoreflect.ValueOfString(x.Drift)
+		if !f(fd_FunTokenEscrow_drift, value) {
+			return
+		}
+	}
+	if x.InvariantHolds != false {
+		value := protoreflect.ValueOfBool(x.InvariantHolds)
+		if !f(fd_FunTokenEscrow_invariant_holds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FunTokenEscrow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunTokenEscrow.escrow_balance":
+		return x.EscrowBalance != ""
+	case "eth.evm.v1.FunTokenEscrow.bank_supply":
+		return x.BankSupply != ""
+	case "eth.evm.v1.FunTokenEscrow.expected_escrow":
+		return x.ExpectedEscrow != ""
+	case "eth.evm.v1.FunTokenEscrow.drift":
+		return x.Drift != ""
+	case "eth.evm.v1.FunTokenEscrow.invariant_holds":
+		return x.InvariantHolds != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunTokenEscrow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunTokenEscrow.escrow_balance":
+		x.EscrowBalance = ""
+	case "eth.evm.v1.FunTokenEscrow.bank_supply":
+		x.BankSupply = ""
+	case "eth.evm.v1.FunTokenEscrow.expected_escrow":
+		x.ExpectedEscrow = ""
+	case "eth.evm.v1.FunTokenEscrow.drift":
+		x.Drift = ""
+	case "eth.evm.v1.FunTokenEscrow.invariant_holds":
+		x.InvariantHolds = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FunTokenEscrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.FunTokenEscrow.escrow_balance":
+		value := x.EscrowBalance
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.FunTokenEscrow.bank_supply":
+		value := x.BankSupply
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.FunTokenEscrow.expected_escrow":
+		value := x.ExpectedEscrow
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.FunTokenEscrow.drift":
+		value := x.Drift
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.FunTokenEscrow.invariant_holds":
+		value := x.InvariantHolds
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunTokenEscrow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunTokenEscrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunTokenEscrow.escrow_balance":
+		x.EscrowBalance = value.Interface().(string)
+	case "eth.evm.v1.FunTokenEscrow.bank_supply":
+		x.BankSupply = value.Interface().(string)
+	case "eth.evm.v1.FunTokenEscrow.expected_escrow":
+		x.ExpectedEscrow = value.Interface().(string)
+	case "eth.evm.v1.FunTokenEscrow.drift":
+		x.Drift = value.Interface().(string)
+	case "eth.evm.v1.FunTokenEscrow.invariant_holds":
+		x.InvariantHolds = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunTokenEscrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunTokenEscrow.escrow_balance":
+		panic(fmt.Errorf("field escrow_balance of message eth.evm.v1.FunTokenEscrow is not mutable"))
+	case "eth.evm.v1.FunTokenEscrow.bank_supply":
+		panic(fmt.Errorf("field bank_supply of message eth.evm.v1.FunTokenEscrow is not mutable"))
+	case "eth.evm.v1.FunTokenEscrow.expected_escrow":
+		panic(fmt.Errorf("field expected_escrow of message eth.evm.v1.FunTokenEscrow is not mutable"))
+	case "eth.evm.v1.FunTokenEscrow.drift":
+		panic(fmt.Errorf("field drift of message eth.evm.v1.FunTokenEscrow is not mutable"))
+	case "eth.evm.v1.FunTokenEscrow.invariant_holds":
+		panic(fmt.Errorf("field invariant_holds of message eth.evm.v1.FunTokenEscrow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FunTokenEscrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.FunTokenEscrow.escrow_balance":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.FunTokenEscrow.bank_supply":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.FunTokenEscrow.expected_escrow":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.FunTokenEscrow.drift":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.FunTokenEscrow.invariant_holds":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.FunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.FunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FunTokenEscrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.FunTokenEscrow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FunTokenEscrow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunTokenEscrow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FunTokenEscrow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FunTokenEscrow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FunTokenEscrow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.EscrowBalance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BankSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpectedEscrow)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Drift)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InvariantHolds {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FunTokenEscrow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InvariantHolds {
+			i--
+			if x.InvariantHolds {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Drift) > 0 {
+			i -= len(x.Drift)
+			copy(dAtA[i:], x.Drift)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Drift)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ExpectedEscrow) > 0 {
+			i -= len(x.ExpectedEscrow)
+			copy(dAtA[i:], x.ExpectedEscrow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpectedEscrow)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BankSupply) > 0 {
+			i -= len(x.BankSupply)
+			copy(dAtA[i:], x.BankSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankSupply)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.EscrowBalance) > 0 {
+			i -= len(x.EscrowBalance)
+			copy(dAtA[i:], x.EscrowBalance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EscrowBalance)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -394,7 +1582,7 @@ func (x *fastReflection_FunToken) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FunToken)
+		x := input.Message.Interface().(*FunTokenEscrow)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -426,15 +1614,15 @@ func (x *fastReflection_FunToken) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunToken: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunTokenEscrow: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunToken: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunTokenEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -462,11 +1650,11 @@ func (x *fastReflection_FunToken) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Erc20Addr = string(dAtA[iNdEx:postIndex])
+				x.EscrowBalance = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankSupply", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -494,11 +1682,75 @@ func (x *fastReflection_FunToken) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				x.BankSupply = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedEscrow", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpectedEscrow = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Drift", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Drift = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsMadeFromCoin", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvariantHolds", wireType)
 				}
 				var v int
 				for shift := uint(0); ; shift += 7 {
@@ -515,7 +1767,7 @@ func (x *fastReflection_FunToken) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-				x.IsMadeFromCoin = bool(v != 0)
+				x.InvariantHolds = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -667,7 +1919,7 @@ func (x *Params) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_evm_proto_msgTypes[1]
+	mi := &file_eth_evm_v1_evm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +2550,7 @@ func (x *State) ProtoReflect() protoreflect.Message {
 }
 
 func (x *State) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_evm_proto_msgTypes[2]
+	mi := &file_eth_evm_v1_evm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1842,7 +3094,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Log) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_evm_proto_msgTypes[3]
+	mi := &file_eth_evm_v1_evm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2764,7 +4016,7 @@ func (x *AccessTuple) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_evm_proto_msgTypes[4]
+	mi := &file_eth_evm_v1_evm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3243,12 +4495,14 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 var (
 	md_TracerConfig               protoreflect.MessageDescriptor
 	fd_TracerConfig_only_top_call protoreflect.FieldDescriptor
+	fd_TracerConfig_diff_mode     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_evm_proto_init()
 	md_TracerConfig = File_eth_evm_v1_evm_proto.Messages().ByName("TracerConfig")
 	fd_TracerConfig_only_top_call = md_TracerConfig.Fields().ByName("only_top_call")
+	fd_TracerConfig_diff_mode = md_TracerConfig.Fields().ByName("diff_mode")
 }
 
 var _ protoreflect.Message = (*fastReflection_TracerConfig)(nil)
@@ -3260,7 +4514,7 @@ func (x *TracerConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TracerConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_evm_proto_msgTypes[5]
+	mi := &file_eth_evm_v1_evm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3322,6 +4576,12 @@ func (x *fastReflection_TracerConfig) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.DiffMode != false {
+		value := protoreflect.ValueOfBool(x.DiffMode)
+		if !f(fd_TracerConfig_diff_mode, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3339,6 +4599,8 @@ func (x *fastReflection_TracerConfig) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "eth.evm.v1.TracerConfig.only_top_call":
 		return x.OnlyTopCall != false
+	case "eth.evm.v1.TracerConfig.diff_mode":
+		return x.DiffMode != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.TracerConfig"))
@@ -3357,6 +4619,8 @@ func (x *fastReflection_TracerConfig) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "eth.evm.v1.TracerConfig.only_top_call":
 		x.OnlyTopCall = false
+	case "eth.evm.v1.TracerConfig.diff_mode":
+		x.DiffMode = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.TracerConfig"))
@@ -3376,6 +4640,9 @@ func (x *fastReflection_TracerConfig) Get(descriptor protoreflect.FieldDescripto
 	case "eth.evm.v1.TracerConfig.only_top_call":
 		value := x.OnlyTopCall
 		return protoreflect.ValueOfBool(value)
+	case "eth.evm.v1.TracerConfig.diff_mode":
+		value := x.DiffMode
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.TracerConfig"))
@@ -3398,6 +4665,8 @@ func (x *fastReflection_TracerConfig) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "eth.evm.v1.TracerConfig.only_top_call":
 		x.OnlyTopCall = value.Bool()
+	case "eth.evm.v1.TracerConfig.diff_mode":
+		x.DiffMode = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.TracerConfig"))
@@ -3420,6 +4689,8 @@ func (x *fastReflection_TracerConfig) Mutable(fd protoreflect.FieldDescriptor) p
 	switch fd.FullName() {
 	case "eth.evm.v1.TracerConfig.only_top_call":
 		panic(fmt.Errorf("field only_top_call of message eth.evm.v1.TracerConfig is not mutable"))
+	case "eth.evm.v1.TracerConfig.diff_mode":
+		panic(fmt.Errorf("field diff_mode of message eth.evm.v1.TracerConfig is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.TracerConfig"))
@@ -3435,6 +4706,8 @@ func (x *fastReflection_TracerConfig) NewField(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "eth.evm.v1.TracerConfig.only_top_call":
 		return protoreflect.ValueOfBool(false)
+	case "eth.evm.v1.TracerConfig.diff_mode":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.TracerConfig"))
@@ -3507,6 +4780,9 @@ func (x *fastReflection_TracerConfig) ProtoMethods() *protoiface.Methods {
 		if x.OnlyTopCall {
 			n += 2
 		}
+		if x.DiffMode {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3536,6 +4812,16 @@ func (x *fastReflection_TracerConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DiffMode {
+			i--
+			if x.DiffMode {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.OnlyTopCall {
 			i--
 			if x.OnlyTopCall {
@@ -3615,6 +4901,26 @@ func (x *fastReflection_TracerConfig) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.OnlyTopCall = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DiffMode", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DiffMode = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3688,7 +4994,7 @@ func (x *TraceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_evm_proto_msgTypes[6]
+	mi := &file_eth_evm_v1_evm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4611,6 +5917,19 @@ type FunToken struct {
 	// the ERC-20 contract gets deployed by the module account. False if the
 	// mapping was created from an externally owned ERC-20 contract.
 	IsMadeFromCoin bool `protobuf:"varint,3,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	// scaling_exponent: Number of ERC-20 decimals dropped in the Bank Coin
+	// representation. One base unit of the Bank Coin is worth
+	// 10^scaling_exponent base units of the ERC-20, so that, for example, an
+	// ERC-20 with 18 decimals can map to a Bank Coin with 6 decimals. Zero
+	// converts amounts 1:1. Only mappings created from an ERC-20 can be scaled,
+	// and the scaling can't change after the mapping is created.
+	ScalingExponent uint32 `protobuf:"varint,4,opt,name=scaling_exponent,json=scalingExponent,proto3" json:"scaling_exponent,omitempty"`
+	// is_rebasing: True if the ERC-20 balance escrowed by the EVM module changes
+	// on its own, as with rebasing tokens. The Bank Coins of a rebasing
+	// `FunToken` are shares of the escrow, so conversions are pro-rated by the
+	// ratio of the escrowed balance to the Bank Coin supply instead of using a
+	// fixed rate. Set by `MsgReconcileFunTokenEscrow`.
+	IsRebasing bool `protobuf:"varint,5,opt,name=is_rebasing,json=isRebasing,proto3" json:"is_rebasing,omitempty"`
 }
 
 func (x *FunToken) Reset() {
@@ -4654,6 +5973,155 @@ func (x *FunToken) GetIsMadeFromCoin() bool {
 	return false
 }
 
+func (x *FunToken) GetScalingExponent() uint32 {
+	if x != nil {
+		return x.ScalingExponent
+	}
+	return 0
+}
+
+func (x *FunToken) GetIsRebasing() bool {
+	if x != nil {
+		return x.IsRebasing
+	}
+	return false
+}
+
+// FunNFT is a non-fungible token mapping between an ERC-721 collection and a
+// corresponding class of the Cosmos SDK NFT Module ("x/nft"). An ERC-721
+// token converted to its "x/nft" representation is escrowed by the EVM module
+// account, and the "x/nft" token of the same ID is minted in its place.
+//
+// Mappings are only created from existing ERC-721 collections. Classes created
+// in "x/nft" have no ERC-721 representation: unlike a `FunToken` created from
+// a bank coin, there is no ERC-721 contract owned by the EVM module to mint
+// them from.
+type FunNFT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hexadecimal address of the ERC-721 collection to which the `FunNFT` maps
+	Erc721Addr string `protobuf:"bytes,1,opt,name=erc721_addr,json=erc721Addr,proto3" json:"erc721_addr,omitempty"`
+	// class_id: ID of the class in the NFT Module. The ID of each "x/nft" token
+	// in the class is the decimal token ID of the ERC-721 token.
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *FunNFT) Reset() {
+	*x = FunNFT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_evm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunNFT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunNFT) ProtoMessage() {}
+
+// Deprecated: Use FunNFT.ProtoReflect.Descriptor instead.
+func (*FunNFT) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FunNFT) GetErc721Addr() string {
+	if x != nil {
+		return x.Erc721Addr
+	}
+	return ""
+}
+
+func (x *FunNFT) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// FunTokenEscrow: Supply invariant of a `FunToken` created from an ERC20. The
+// ERC20 tokens escrowed by the EVM module back the supply of the Bank Coin.
+type FunTokenEscrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// escrow_balance: ERC20 balance of the EVM module account
+	EscrowBalance string `protobuf:"bytes,1,opt,name=escrow_balance,json=escrowBalance,proto3" json:"escrow_balance,omitempty"`
+	// bank_supply: Supply of the Bank Coin
+	BankSupply string `protobuf:"bytes,2,opt,name=bank_supply,json=bankSupply,proto3" json:"bank_supply,omitempty"`
+	// expected_escrow: ERC20 tokens needed to convert the whole Bank Coin supply
+	// at the scaling factor of the mapping
+	ExpectedEscrow string `protobuf:"bytes,3,opt,name=expected_escrow,json=expectedEscrow,proto3" json:"expected_escrow,omitempty"`
+	// drift: Escrow balance minus the expected escrow. Positive drift comes from
+	// dust and fees left in escrow or from positive rebases. Negative drift
+	// means the escrow shrank on its own.
+	Drift string `protobuf:"bytes,4,opt,name=drift,proto3" json:"drift,omitempty"`
+	// invariant_holds: False if conversions at a fixed rate could no longer be
+	// honored, meaning the escrow is short of the expected escrow for a mapping
+	// that isn't flagged as rebasing. Rebasing mappings are pro-rated and hold
+	// as long as any escrow backs a nonzero supply.
+	InvariantHolds bool `protobuf:"varint,5,opt,name=invariant_holds,json=invariantHolds,proto3" json:"invariant_holds,omitempty"`
+}
+
+func (x *FunTokenEscrow) Reset() {
+	*x = FunTokenEscrow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_evm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunTokenEscrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunTokenEscrow) ProtoMessage() {}
+
+// Deprecated: Use FunTokenEscrow.ProtoReflect.Descriptor instead.
+func (*FunTokenEscrow) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FunTokenEscrow) GetEscrowBalance() string {
+	if x != nil {
+		return x.EscrowBalance
+	}
+	return ""
+}
+
+func (x *FunTokenEscrow) GetBankSupply() string {
+	if x != nil {
+		return x.BankSupply
+	}
+	return ""
+}
+
+func (x *FunTokenEscrow) GetExpectedEscrow() string {
+	if x != nil {
+		return x.ExpectedEscrow
+	}
+	return ""
+}
+
+func (x *FunTokenEscrow) GetDrift() string {
+	if x != nil {
+		return x.Drift
+	}
+	return ""
+}
+
+func (x *FunTokenEscrow) GetInvariantHolds() bool {
+	if x != nil {
+		return x.InvariantHolds
+	}
+	return false
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	state         protoimpl.MessageState
@@ -4672,7 +6140,7 @@ type Params struct {
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_evm_proto_msgTypes[1]
+		mi := &file_eth_evm_v1_evm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4686,7 +6154,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{1}
+	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Params) GetExtraEips() []int64 {
@@ -4725,7 +6193,7 @@ type State struct {
 func (x *State) Reset() {
 	*x = State{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_evm_proto_msgTypes[2]
+		mi := &file_eth_evm_v1_evm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4739,7 +6207,7 @@ func (*State) ProtoMessage() {}
 
 // Deprecated: Use State.ProtoReflect.Descriptor instead.
 func (*State) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{2}
+	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *State) GetKey() string {
@@ -4792,7 +6260,7 @@ type Log struct {
 func (x *Log) Reset() {
 	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_evm_proto_msgTypes[3]
+		mi := &file_eth_evm_v1_evm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4806,7 +6274,7 @@ func (*Log) ProtoMessage() {}
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{3}
+	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Log) GetAddress() string {
@@ -4887,7 +6355,7 @@ type AccessTuple struct {
 func (x *AccessTuple) Reset() {
 	*x = AccessTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_evm_proto_msgTypes[4]
+		mi := &file_eth_evm_v1_evm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4901,7 +6369,7 @@ func (*AccessTuple) ProtoMessage() {}
 
 // Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{4}
+	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AccessTuple) GetAddress() string {
@@ -4918,20 +6386,23 @@ func (x *AccessTuple) GetStorageKeys() []string {
 	return nil
 }
 
-// TracerConfig stores additional tracer args. For geth it's only one attr:
-// onlyTopCall
+// TracerConfig stores additional tracer args:
+//   - onlyTopCall: for the "callTracer", only trace the top-level call.
+//   - diffMode: for the "prestateTracer", return the pre and post state of the
+//     accounts modified by the tx instead of only the pre state.
 type TracerConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	OnlyTopCall bool `protobuf:"varint,1,opt,name=only_top_call,json=onlyTopCall,proto3" json:"only_top_call,omitempty"`
+	DiffMode    bool `protobuf:"varint,2,opt,name=diff_mode,json=diffMode,proto3" json:"diff_mode,omitempty"`
 }
 
 func (x *TracerConfig) Reset() {
 	*x = TracerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_evm_proto_msgTypes[5]
+		mi := &file_eth_evm_v1_evm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4945,7 +6416,7 @@ func (*TracerConfig) ProtoMessage() {}
 
 // Deprecated: Use TracerConfig.ProtoReflect.Descriptor instead.
 func (*TracerConfig) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{5}
+	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TracerConfig) GetOnlyTopCall() bool {
@@ -4955,6 +6426,13 @@ func (x *TracerConfig) GetOnlyTopCall() bool {
 	return false
 }
 
+func (x *TracerConfig) GetDiffMode() bool {
+	if x != nil {
+		return x.DiffMode
+	}
+	return false
+}
+
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
 	state         protoimpl.MessageState
@@ -4987,7 +6465,7 @@ type TraceConfig struct {
 func (x *TraceConfig) Reset() {
 	*x = TraceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_evm_proto_msgTypes[6]
+		mi := &file_eth_evm_v1_evm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5001,7 +6479,7 @@ func (*TraceConfig) ProtoMessage() {}
 
 // Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{6}
+	return file_eth_evm_v1_evm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TraceConfig) GetTracer() string {
@@ -5080,7 +6558,7 @@ var file_eth_evm_v1_evm_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x6d,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
 	0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e,
 	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x55, 0x0a, 0x0a, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61,
 	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda,
 	0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69,
@@ -5091,98 +6569,133 @@ var file_eth_evm_v1_evm_proto_rawDesc = []byte{
 	0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x11, 0x69,
 	0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x4d, 0x61, 0x64, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0xf8, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x03, 0x42, 0x22, 0xe2, 0xde, 0x1f, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x45, 0x49, 0x50, 0x73, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x22, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x45, 0x69, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x65, 0x76, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b,
-	0x45, 0x56, 0x4d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x0b, 0x65, 0x76, 0x6d,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x4d, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x65, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x4a, 0x04, 0x08,
-	0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a,
-	0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10,
-	0x08, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0xca, 0x02, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x32, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22,
-	0x61, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f,
-	0xea, 0xde, 0x1f, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52,
-	0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0,
-	0x1f, 0x00, 0x22, 0x43, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x33, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x63,
-	0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x6f,
-	0x6e, 0x6c, 0x79, 0x54, 0x6f, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79,
-	0x54, 0x6f, 0x70, 0x43, 0x61, 0x6c, 0x6c, 0x22, 0xfa, 0x03, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65,
-	0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65,
-	0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x0d,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x10, 0xea,
-	0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04, 0x08,
-	0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x52,
-	0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
-	0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x42, 0x87, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31,
-	0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45,
-	0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c,
-	0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x73, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x62, 0x61, 0x73, 0x69,
+	0x6e, 0x67, 0x22, 0x7c, 0x0a, 0x06, 0x46, 0x75, 0x6e, 0x4e, 0x46, 0x54, 0x12, 0x57, 0x0a, 0x0b,
+	0x65, 0x72, 0x63, 0x37, 0x32, 0x31, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e,
+	0x45, 0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0a, 0x65, 0x72, 0x63, 0x37, 0x32,
+	0x31, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64,
+	0x22, 0xbc, 0x02, 0x0a, 0x0e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x12, 0x44, 0x0a, 0x0e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0d, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x62, 0x61, 0x6e,
+	0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0a, 0x62,
+	0x61, 0x6e, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x05, 0x64, 0x72, 0x69, 0x66, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x22,
+	0xf8, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x42, 0x22,
+	0xe2, 0xde, 0x1f, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x49, 0x50, 0x73, 0xf2, 0xde, 0x1f,
+	0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65, 0x69, 0x70,
+	0x73, 0x22, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x45, 0x69, 0x70, 0x73, 0x12, 0x32, 0x0a,
+	0x0c, 0x65, 0x76, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x56, 0x4d, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x52, 0x0b, 0x65, 0x76, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x4d, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x65, 0x65,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02,
+	0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04,
+	0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xca, 0x02, 0x0a, 0x03,
+	0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a,
+	0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13,
+	0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08, 0x74,
+	0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xea,
+	0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x6c,
+	0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x61, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x6e, 0x0a, 0x0c, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a, 0x0d, 0x6f,
+	0x6e, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x54, 0x6f, 0x70, 0x43,
+	0x61, 0x6c, 0x6c, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x54, 0x6f, 0x70, 0x43, 0x61, 0x6c, 0x6c,
+	0x12, 0x29, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x64, 0x69, 0x66, 0x66, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x08, 0x64, 0x69, 0x66, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xfa, 0x03, 0x0a, 0x0b,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72,
+	0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde,
+	0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0f,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde,
+	0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x12,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x4f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08,
+	0x0a, 0x10, 0x0b, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x87, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58,
+	0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a,
+	0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68,
+	0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5197,18 +6710,20 @@ func file_eth_evm_v1_evm_proto_rawDescGZIP() []byte {
 	return file_eth_evm_v1_evm_proto_rawDescData
 }
 
-var file_eth_evm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_eth_evm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_eth_evm_v1_evm_proto_goTypes = []interface{}{
-	(*FunToken)(nil),     // 0: eth.evm.v1.FunToken
-	(*Params)(nil),       // 1: eth.evm.v1.Params
-	(*State)(nil),        // 2: eth.evm.v1.State
-	(*Log)(nil),          // 3: eth.evm.v1.Log
-	(*AccessTuple)(nil),  // 4: eth.evm.v1.AccessTuple
-	(*TracerConfig)(nil), // 5: eth.evm.v1.TracerConfig
-	(*TraceConfig)(nil),  // 6: eth.evm.v1.TraceConfig
+	(*FunToken)(nil),       // 0: eth.evm.v1.FunToken
+	(*FunNFT)(nil),         // 1: eth.evm.v1.FunNFT
+	(*FunTokenEscrow)(nil), // 2: eth.evm.v1.FunTokenEscrow
+	(*Params)(nil),         // 3: eth.evm.v1.Params
+	(*State)(nil),          // 4: eth.evm.v1.State
+	(*Log)(nil),            // 5: eth.evm.v1.Log
+	(*AccessTuple)(nil),    // 6: eth.evm.v1.AccessTuple
+	(*TracerConfig)(nil),   // 7: eth.evm.v1.TracerConfig
+	(*TraceConfig)(nil),    // 8: eth.evm.v1.TraceConfig
 }
 var file_eth_evm_v1_evm_proto_depIdxs = []int32{
-	5, // 0: eth.evm.v1.TraceConfig.tracer_config:type_name -> eth.evm.v1.TracerConfig
+	7, // 0: eth.evm.v1.TraceConfig.tracer_config:type_name -> eth.evm.v1.TracerConfig
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -5235,7 +6750,7 @@ func file_eth_evm_v1_evm_proto_init() {
 			}
 		}
 		file_eth_evm_v1_evm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+			switch v := v.(*FunNFT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5247,7 +6762,7 @@ func file_eth_evm_v1_evm_proto_init() {
 			}
 		}
 		file_eth_evm_v1_evm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
+			switch v := v.(*FunTokenEscrow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5259,7 +6774,7 @@ func file_eth_evm_v1_evm_proto_init() {
 			}
 		}
 		file_eth_evm_v1_evm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5271,7 +6786,7 @@ func file_eth_evm_v1_evm_proto_init() {
 			}
 		}
 		file_eth_evm_v1_evm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessTuple); i {
+			switch v := v.(*State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5283,7 +6798,7 @@ func file_eth_evm_v1_evm_proto_init() {
 			}
 		}
 		file_eth_evm_v1_evm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TracerConfig); i {
+			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5295,6 +6810,30 @@ func file_eth_evm_v1_evm_proto_init() {
 			}
 		}
 		file_eth_evm_v1_evm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_evm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_evm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TraceConfig); i {
 			case 0:
 				return &v.state
@@ -5313,7 +6852,7 @@ func file_eth_evm_v1_evm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eth_evm_v1_evm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3904,26 +3904,74 @@ func (x *fastReflection_QueryStorageResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_QueryStorageProofRequest_2_list)(nil)
+
+type _QueryStorageProofRequest_2_list struct {
+	list *[]string
+}
+
+func (x *_QueryStorageProofRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryStorageProofRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryStorageProofRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryStorageProofRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryStorageProofRequest_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryStorageProofRequest at list field Keys as it is not of Message kind"))
+}
+
+func (x *_QueryStorageProofRequest_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryStorageProofRequest_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryStorageProofRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCodeRequest         protoreflect.MessageDescriptor
-	fd_QueryCodeRequest_address protoreflect.FieldDescriptor
+	md_QueryStorageProofRequest         protoreflect.MessageDescriptor
+	fd_QueryStorageProofRequest_address protoreflect.FieldDescriptor
+	fd_QueryStorageProofRequest_keys    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryCodeRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryCodeRequest")
-	fd_QueryCodeRequest_address = md_QueryCodeRequest.Fields().ByName("address")
+	md_QueryStorageProofRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryStorageProofRequest")
+	fd_QueryStorageProofRequest_address = md_QueryStorageProofRequest.Fields().ByName("address")
+	fd_QueryStorageProofRequest_keys = md_QueryStorageProofRequest.Fields().ByName("keys")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCodeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryStorageProofRequest)(nil)
 
-type fastReflection_QueryCodeRequest QueryCodeRequest
+type fastReflection_QueryStorageProofRequest QueryStorageProofRequest
 
-func (x *QueryCodeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCodeRequest)(x)
+func (x *QueryStorageProofRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStorageProofRequest)(x)
 }
 
-func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryStorageProofRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3935,43 +3983,43 @@ func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCodeRequest_messageType fastReflection_QueryCodeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCodeRequest_messageType{}
+var _fastReflection_QueryStorageProofRequest_messageType fastReflection_QueryStorageProofRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStorageProofRequest_messageType{}
 
-type fastReflection_QueryCodeRequest_messageType struct{}
+type fastReflection_QueryStorageProofRequest_messageType struct{}
 
-func (x fastReflection_QueryCodeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCodeRequest)(nil)
+func (x fastReflection_QueryStorageProofRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStorageProofRequest)(nil)
 }
-func (x fastReflection_QueryCodeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeRequest)
+func (x fastReflection_QueryStorageProofRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageProofRequest)
 }
-func (x fastReflection_QueryCodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeRequest
+func (x fastReflection_QueryStorageProofRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageProofRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCodeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeRequest
+func (x *fastReflection_QueryStorageProofRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageProofRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCodeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCodeRequest_messageType
+func (x *fastReflection_QueryStorageProofRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStorageProofRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCodeRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeRequest)
+func (x *fastReflection_QueryStorageProofRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageProofRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCodeRequest)(x)
+func (x *fastReflection_QueryStorageProofRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryStorageProofRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3979,10 +4027,16 @@ func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryStorageProofRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryCodeRequest_address, value) {
+		if !f(fd_QueryStorageProofRequest_address, value) {
+			return
+		}
+	}
+	if len(x.Keys) != 0 {
+		value := protoreflect.ValueOfList(&_QueryStorageProofRequest_2_list{list: &x.Keys})
+		if !f(fd_QueryStorageProofRequest_keys, value) {
 			return
 		}
 	}
@@ -3999,15 +4053,17 @@ func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryStorageProofRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeRequest.address":
+	case "eth.evm.v1.QueryStorageProofRequest.address":
 		return x.Address != ""
+	case "eth.evm.v1.QueryStorageProofRequest.keys":
+		return len(x.Keys) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4017,15 +4073,17 @@ func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryStorageProofRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeRequest.address":
+	case "eth.evm.v1.QueryStorageProofRequest.address":
 		x.Address = ""
+	case "eth.evm.v1.QueryStorageProofRequest.keys":
+		x.Keys = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4035,16 +4093,22 @@ func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryCodeRequest.address":
+	case "eth.evm.v1.QueryStorageProofRequest.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.QueryStorageProofRequest.keys":
+		if len(x.Keys) == 0 {
+			return protoreflect.ValueOfList(&_QueryStorageProofRequest_2_list{})
+		}
+		listValue := &_QueryStorageProofRequest_2_list{list: &x.Keys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4058,15 +4122,19 @@ func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryStorageProofRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeRequest.address":
+	case "eth.evm.v1.QueryStorageProofRequest.address":
 		x.Address = value.Interface().(string)
+	case "eth.evm.v1.QueryStorageProofRequest.keys":
+		lv := value.List()
+		clv := lv.(*_QueryStorageProofRequest_2_list)
+		x.Keys = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4080,40 +4148,49 @@ func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeRequest.address":
-		panic(fmt.Errorf("field address of message eth.evm.v1.QueryCodeRequest is not mutable"))
+	case "eth.evm.v1.QueryStorageProofRequest.keys":
+		if x.Keys == nil {
+			x.Keys = []string{}
+		}
+		value := &_QueryStorageProofRequest_2_list{list: &x.Keys}
+		return protoreflect.ValueOfList(value)
+	case "eth.evm.v1.QueryStorageProofRequest.address":
+		panic(fmt.Errorf("field address of message eth.evm.v1.QueryStorageProofRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeRequest.address":
+	case "eth.evm.v1.QueryStorageProofRequest.address":
 		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.QueryStorageProofRequest.keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryStorageProofRequest_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryStorageProofRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryCodeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryStorageProofRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4121,7 +4198,7 @@ func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryStorageProofRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4132,7 +4209,7 @@ func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryStorageProofRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4144,7 +4221,7 @@ func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCodeRequest) IsValid() bool {
+func (x *fastReflection_QueryStorageProofRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4154,9 +4231,9 @@ func (x *fastReflection_QueryCodeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryStorageProofRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryStorageProofRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4172,6 +4249,12 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Keys) > 0 {
+			for _, s := range x.Keys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4182,7 +4265,7 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryStorageProofRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4201,6 +4284,15 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Keys) > 0 {
+			for iNdEx := len(x.Keys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Keys[iNdEx])
+				copy(dAtA[i:], x.Keys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Keys[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.Address) > 0 {
 			i -= len(x.Address)
 			copy(dAtA[i:], x.Address)
@@ -4219,7 +4311,7 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryStorageProofRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4251,10 +4343,10 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageProofRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4289,6 +4381,38 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Keys = append(x.Keys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4324,26 +4448,79 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryStorageProofResponse_2_list)(nil)
+
+type _QueryStorageProofResponse_2_list struct {
+	list *[]*StorageSlotProof
+}
+
+func (x *_QueryStorageProofResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryStorageProofResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryStorageProofResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageSlotProof)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryStorageProofResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageSlotProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryStorageProofResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(StorageSlotProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryStorageProofResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryStorageProofResponse_2_list) NewElement() protoreflect.Value {
+	v := new(StorageSlotProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryStorageProofResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCodeResponse      protoreflect.MessageDescriptor
-	fd_QueryCodeResponse_code protoreflect.FieldDescriptor
+	md_QueryStorageProofResponse              protoreflect.MessageDescriptor
+	fd_QueryStorageProofResponse_storage_root protoreflect.FieldDescriptor
+	fd_QueryStorageProofResponse_proofs       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryCodeResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryCodeResponse")
-	fd_QueryCodeResponse_code = md_QueryCodeResponse.Fields().ByName("code")
+	md_QueryStorageProofResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryStorageProofResponse")
+	fd_QueryStorageProofResponse_storage_root = md_QueryStorageProofResponse.Fields().ByName("storage_root")
+	fd_QueryStorageProofResponse_proofs = md_QueryStorageProofResponse.Fields().ByName("proofs")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCodeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryStorageProofResponse)(nil)
 
-type fastReflection_QueryCodeResponse QueryCodeResponse
+type fastReflection_QueryStorageProofResponse QueryStorageProofResponse
 
-func (x *QueryCodeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCodeResponse)(x)
+func (x *QueryStorageProofResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStorageProofResponse)(x)
 }
 
-func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryStorageProofResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4355,43 +4532,43 @@ func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCodeResponse_messageType fastReflection_QueryCodeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCodeResponse_messageType{}
+var _fastReflection_QueryStorageProofResponse_messageType fastReflection_QueryStorageProofResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStorageProofResponse_messageType{}
 
-type fastReflection_QueryCodeResponse_messageType struct{}
+type fastReflection_QueryStorageProofResponse_messageType struct{}
 
-func (x fastReflection_QueryCodeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCodeResponse)(nil)
+func (x fastReflection_QueryStorageProofResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStorageProofResponse)(nil)
 }
-func (x fastReflection_QueryCodeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeResponse)
+func (x fastReflection_QueryStorageProofResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageProofResponse)
 }
-func (x fastReflection_QueryCodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeResponse
+func (x fastReflection_QueryStorageProofResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageProofResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCodeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeResponse
+func (x *fastReflection_QueryStorageProofResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageProofResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCodeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCodeResponse_messageType
+func (x *fastReflection_QueryStorageProofResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStorageProofResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCodeResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeResponse)
+func (x *fastReflection_QueryStorageProofResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageProofResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCodeResponse)(x)
+func (x *fastReflection_QueryStorageProofResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryStorageProofResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4399,10 +4576,16 @@ func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Code) != 0 {
-		value := protoreflect.ValueOfBytes(x.Code)
-		if !f(fd_QueryCodeResponse_code, value) {
+func (x *fastReflection_QueryStorageProofResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StorageRoot != "" {
+		value := protoreflect.ValueOfString(x.StorageRoot)
+		if !f(fd_QueryStorageProofResponse_storage_root, value) {
+			return
+		}
+	}
+	if len(x.Proofs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryStorageProofResponse_2_list{list: &x.Proofs})
+		if !f(fd_QueryStorageProofResponse_proofs, value) {
 			return
 		}
 	}
@@ -4419,15 +4602,17 @@ func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryStorageProofResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeResponse.code":
-		return len(x.Code) != 0
+	case "eth.evm.v1.QueryStorageProofResponse.storage_root":
+		return x.StorageRoot != ""
+	case "eth.evm.v1.QueryStorageProofResponse.proofs":
+		return len(x.Proofs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4437,15 +4622,17 @@ func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryStorageProofResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeResponse.code":
-		x.Code = nil
+	case "eth.evm.v1.QueryStorageProofResponse.storage_root":
+		x.StorageRoot = ""
+	case "eth.evm.v1.QueryStorageProofResponse.proofs":
+		x.Proofs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4455,16 +4642,22 @@ func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryCodeResponse.code":
-		value := x.Code
-		return protoreflect.ValueOfBytes(value)
+	case "eth.evm.v1.QueryStorageProofResponse.storage_root":
+		value := x.StorageRoot
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.QueryStorageProofResponse.proofs":
+		if len(x.Proofs) == 0 {
+			return protoreflect.ValueOfList(&_QueryStorageProofResponse_2_list{})
+		}
+		listValue := &_QueryStorageProofResponse_2_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4478,15 +4671,19 @@ func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryStorageProofResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeResponse.code":
-		x.Code = value.Bytes()
+	case "eth.evm.v1.QueryStorageProofResponse.storage_root":
+		x.StorageRoot = value.Interface().(string)
+	case "eth.evm.v1.QueryStorageProofResponse.proofs":
+		lv := value.List()
+		clv := lv.(*_QueryStorageProofResponse_2_list)
+		x.Proofs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4500,40 +4697,49 @@ func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeResponse.code":
-		panic(fmt.Errorf("field code of message eth.evm.v1.QueryCodeResponse is not mutable"))
+	case "eth.evm.v1.QueryStorageProofResponse.proofs":
+		if x.Proofs == nil {
+			x.Proofs = []*StorageSlotProof{}
+		}
+		value := &_QueryStorageProofResponse_2_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(value)
+	case "eth.evm.v1.QueryStorageProofResponse.storage_root":
+		panic(fmt.Errorf("field storage_root of message eth.evm.v1.QueryStorageProofResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageProofResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryCodeResponse.code":
-		return protoreflect.ValueOfBytes(nil)
+	case "eth.evm.v1.QueryStorageProofResponse.storage_root":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.QueryStorageProofResponse.proofs":
+		list := []*StorageSlotProof{}
+		return protoreflect.ValueOfList(&_QueryStorageProofResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryStorageProofResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryStorageProofResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryStorageProofResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryCodeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryStorageProofResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4541,7 +4747,7 @@ func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryStorageProofResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4552,7 +4758,7 @@ func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryStorageProofResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4564,7 +4770,7 @@ func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCodeResponse) IsValid() bool {
+func (x *fastReflection_QueryStorageProofResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4574,9 +4780,9 @@ func (x *fastReflection_QueryCodeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryStorageProofResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*QueryStorageProofResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4588,10 +4794,16 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Code)
+		l = len(x.StorageRoot)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Proofs) > 0 {
+			for _, e := range x.Proofs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4602,7 +4814,7 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*QueryStorageProofResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4621,10 +4833,26 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Code) > 0 {
-			i -= len(x.Code)
-			copy(dAtA[i:], x.Code)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+		if len(x.Proofs) > 0 {
+			for iNdEx := len(x.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proofs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.StorageRoot) > 0 {
+			i -= len(x.StorageRoot)
+			copy(dAtA[i:], x.StorageRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageRoot)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4639,7 +4867,7 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*QueryStorageProofResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4671,17 +4899,17 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageProofResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4691,24 +4919,56 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
-				if x.Code == nil {
-					x.Code = []byte{}
+				x.StorageRoot = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proofs = append(x.Proofs, &StorageSlotProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proofs[len(x.Proofs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -4746,28 +5006,76 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_StorageSlotProof_3_list)(nil)
+
+type _StorageSlotProof_3_list struct {
+	list *[][]byte
+}
+
+func (x *_StorageSlotProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StorageSlotProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_StorageSlotProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StorageSlotProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StorageSlotProof_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StorageSlotProof at list field Proof as it is not of Message kind"))
+}
+
+func (x *_StorageSlotProof_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StorageSlotProof_3_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_StorageSlotProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryTxLogsRequest            protoreflect.MessageDescriptor
-	fd_QueryTxLogsRequest_hash       protoreflect.FieldDescriptor
-	fd_QueryTxLogsRequest_pagination protoreflect.FieldDescriptor
+	md_StorageSlotProof       protoreflect.MessageDescriptor
+	fd_StorageSlotProof_key   protoreflect.FieldDescriptor
+	fd_StorageSlotProof_value protoreflect.FieldDescriptor
+	fd_StorageSlotProof_proof protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryTxLogsRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryTxLogsRequest")
-	fd_QueryTxLogsRequest_hash = md_QueryTxLogsRequest.Fields().ByName("hash")
-	fd_QueryTxLogsRequest_pagination = md_QueryTxLogsRequest.Fields().ByName("pagination")
+	md_StorageSlotProof = File_eth_evm_v1_query_proto.Messages().ByName("StorageSlotProof")
+	fd_StorageSlotProof_key = md_StorageSlotProof.Fields().ByName("key")
+	fd_StorageSlotProof_value = md_StorageSlotProof.Fields().ByName("value")
+	fd_StorageSlotProof_proof = md_StorageSlotProof.Fields().ByName("proof")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTxLogsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_StorageSlotProof)(nil)
 
-type fastReflection_QueryTxLogsRequest QueryTxLogsRequest
+type fastReflection_StorageSlotProof StorageSlotProof
 
-func (x *QueryTxLogsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsRequest)(x)
+func (x *StorageSlotProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StorageSlotProof)(x)
 }
 
-func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
+func (x *StorageSlotProof) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4779,43 +5087,43 @@ func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTxLogsRequest_messageType fastReflection_QueryTxLogsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTxLogsRequest_messageType{}
+var _fastReflection_StorageSlotProof_messageType fastReflection_StorageSlotProof_messageType
+var _ protoreflect.MessageType = fastReflection_StorageSlotProof_messageType{}
 
-type fastReflection_QueryTxLogsRequest_messageType struct{}
+type fastReflection_StorageSlotProof_messageType struct{}
 
-func (x fastReflection_QueryTxLogsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsRequest)(nil)
+func (x fastReflection_StorageSlotProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StorageSlotProof)(nil)
 }
-func (x fastReflection_QueryTxLogsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsRequest)
+func (x fastReflection_StorageSlotProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_StorageSlotProof)
 }
-func (x fastReflection_QueryTxLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsRequest
+func (x fastReflection_StorageSlotProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageSlotProof
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTxLogsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsRequest
+func (x *fastReflection_StorageSlotProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageSlotProof
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTxLogsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTxLogsRequest_messageType
+func (x *fastReflection_StorageSlotProof) Type() protoreflect.MessageType {
+	return _fastReflection_StorageSlotProof_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTxLogsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsRequest)
+func (x *fastReflection_StorageSlotProof) New() protoreflect.Message {
+	return new(fastReflection_StorageSlotProof)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTxLogsRequest)(x)
+func (x *fastReflection_StorageSlotProof) Interface() protoreflect.ProtoMessage {
+	return (*StorageSlotProof)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4823,16 +5131,22 @@ func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_QueryTxLogsRequest_hash, value) {
+func (x *fastReflection_StorageSlotProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_StorageSlotProof_key, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTxLogsRequest_pagination, value) {
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_StorageSlotProof_value, value) {
+			return
+		}
+	}
+	if len(x.Proof) != 0 {
+		value := protoreflect.ValueOfList(&_StorageSlotProof_3_list{list: &x.Proof})
+		if !f(fd_StorageSlotProof_proof, value) {
 			return
 		}
 	}
@@ -4849,17 +5163,19 @@ func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StorageSlotProof) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsRequest.hash":
-		return x.Hash != ""
-	case "eth.evm.v1.QueryTxLogsRequest.pagination":
-		return x.Pagination != nil
+	case "eth.evm.v1.StorageSlotProof.key":
+		return x.Key != ""
+	case "eth.evm.v1.StorageSlotProof.value":
+		return x.Value != ""
+	case "eth.evm.v1.StorageSlotProof.proof":
+		return len(x.Proof) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.StorageSlotProof"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.StorageSlotProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4869,17 +5185,19 @@ func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StorageSlotProof) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsRequest.hash":
-		x.Hash = ""
-	case "eth.evm.v1.QueryTxLogsRequest.pagination":
-		x.Pagination = nil
+	case "eth.evm.v1.StorageSlotProof.key":
+		x.Key = ""
+	case "eth.evm.v1.StorageSlotProof.value":
+		x.Value = ""
+	case "eth.evm.v1.StorageSlotProof.proof":
+		x.Proof = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.StorageSlotProof"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.StorageSlotProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4889,19 +5207,25 @@ func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageSlotProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryTxLogsRequest.hash":
-		value := x.Hash
+	case "eth.evm.v1.StorageSlotProof.key":
+		value := x.Key
 		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.QueryTxLogsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.StorageSlotProof.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.StorageSlotProof.proof":
+		if len(x.Proof) == 0 {
+			return protoreflect.ValueOfList(&_StorageSlotProof_3_list{})
+		}
+		listValue := &_StorageSlotProof_3_list{list: &x.Proof}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.StorageSlotProof"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.StorageSlotProof does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4915,17 +5239,21 @@ func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StorageSlotProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsRequest.hash":
-		x.Hash = value.Interface().(string)
-	case "eth.evm.v1.QueryTxLogsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "eth.evm.v1.StorageSlotProof.key":
+		x.Key = value.Interface().(string)
+	case "eth.evm.v1.StorageSlotProof.value":
+		x.Value = value.Interface().(string)
+	case "eth.evm.v1.StorageSlotProof.proof":
+		lv := value.List()
+		clv := lv.(*_StorageSlotProof_3_list)
+		x.Proof = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.StorageSlotProof"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.StorageSlotProof does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4939,48 +5267,53 @@ func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageSlotProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
+	case "eth.evm.v1.StorageSlotProof.proof":
+		if x.Proof == nil {
+			x.Proof = [][]byte{}
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "eth.evm.v1.QueryTxLogsRequest.hash":
-		panic(fmt.Errorf("field hash of message eth.evm.v1.QueryTxLogsRequest is not mutable"))
+		value := &_StorageSlotProof_3_list{list: &x.Proof}
+		return protoreflect.ValueOfList(value)
+	case "eth.evm.v1.StorageSlotProof.key":
+		panic(fmt.Errorf("field key of message eth.evm.v1.StorageSlotProof is not mutable"))
+	case "eth.evm.v1.StorageSlotProof.value":
+		panic(fmt.Errorf("field value of message eth.evm.v1.StorageSlotProof is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.StorageSlotProof"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.StorageSlotProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTxLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StorageSlotProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsRequest.hash":
+	case "eth.evm.v1.StorageSlotProof.key":
 		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.QueryTxLogsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.StorageSlotProof.value":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.StorageSlotProof.proof":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_StorageSlotProof_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.StorageSlotProof"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.StorageSlotProof does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StorageSlotProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTxLogsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.StorageSlotProof", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4988,7 +5321,7 @@ func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StorageSlotProof) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4999,7 +5332,7 @@ func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StorageSlotProof) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5011,7 +5344,7 @@ func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
+func (x *fastReflection_StorageSlotProof) IsValid() bool {
 	return x != nil
 }
 
@@ -5021,9 +5354,9 @@ func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StorageSlotProof) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*StorageSlotProof)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5035,14 +5368,20 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.Key)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Value)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Proof) > 0 {
+			for _, b := range x.Proof {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5053,7 +5392,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*StorageSlotProof)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5072,24 +5411,26 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Proof) > 0 {
+			for iNdEx := len(x.Proof) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proof[iNdEx])
+				copy(dAtA[i:], x.Proof[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proof[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5104,7 +5445,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*StorageSlotProof)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5136,15 +5477,15 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageSlotProof: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageSlotProof: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5172,13 +5513,13 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.Key = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5188,29 +5529,57 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proof = append(x.Proof, make([]byte, postIndex-iNdEx))
+				copy(x.Proof[len(x.Proof)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
 				if err != nil {
@@ -5245,79 +5614,26 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryTxLogsResponse_1_list)(nil)
-
-type _QueryTxLogsResponse_1_list struct {
-	list *[]*Log
-}
-
-func (x *_QueryTxLogsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryTxLogsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryTxLogsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryTxLogsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Log)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTxLogsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Log)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryTxLogsResponse            protoreflect.MessageDescriptor
-	fd_QueryTxLogsResponse_logs       protoreflect.FieldDescriptor
-	fd_QueryTxLogsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryCodeRequest         protoreflect.MessageDescriptor
+	fd_QueryCodeRequest_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryTxLogsResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryTxLogsResponse")
-	fd_QueryTxLogsResponse_logs = md_QueryTxLogsResponse.Fields().ByName("logs")
-	fd_QueryTxLogsResponse_pagination = md_QueryTxLogsResponse.Fields().ByName("pagination")
+	md_QueryCodeRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryCodeRequest")
+	fd_QueryCodeRequest_address = md_QueryCodeRequest.Fields().ByName("address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTxLogsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCodeRequest)(nil)
 
-type fastReflection_QueryTxLogsResponse QueryTxLogsResponse
+type fastReflection_QueryCodeRequest QueryCodeRequest
 
-func (x *QueryTxLogsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsResponse)(x)
+func (x *QueryCodeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCodeRequest)(x)
 }
 
-func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5329,43 +5645,43 @@ func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTxLogsResponse_messageType fastReflection_QueryTxLogsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTxLogsResponse_messageType{}
+var _fastReflection_QueryCodeRequest_messageType fastReflection_QueryCodeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCodeRequest_messageType{}
 
-type fastReflection_QueryTxLogsResponse_messageType struct{}
+type fastReflection_QueryCodeRequest_messageType struct{}
 
-func (x fastReflection_QueryTxLogsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsResponse)(nil)
+func (x fastReflection_QueryCodeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCodeRequest)(nil)
 }
-func (x fastReflection_QueryTxLogsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsResponse)
+func (x fastReflection_QueryCodeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeRequest)
 }
-func (x fastReflection_QueryTxLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsResponse
+func (x fastReflection_QueryCodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTxLogsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsResponse
+func (x *fastReflection_QueryCodeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTxLogsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTxLogsResponse_messageType
+func (x *fastReflection_QueryCodeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCodeRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTxLogsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsResponse)
+func (x *fastReflection_QueryCodeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTxLogsResponse)(x)
+func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCodeRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5373,16 +5689,10 @@ func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Logs) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &x.Logs})
-		if !f(fd_QueryTxLogsResponse_logs, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTxLogsResponse_pagination, value) {
+func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryCodeRequest_address, value) {
 			return
 		}
 	}
@@ -5399,17 +5709,15 @@ func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsResponse.logs":
-		return len(x.Logs) != 0
-	case "eth.evm.v1.QueryTxLogsResponse.pagination":
-		return x.Pagination != nil
+	case "eth.evm.v1.QueryCodeRequest.address":
+		return x.Address != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5419,17 +5727,15 @@ func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsResponse.logs":
-		x.Logs = nil
-	case "eth.evm.v1.QueryTxLogsResponse.pagination":
-		x.Pagination = nil
+	case "eth.evm.v1.QueryCodeRequest.address":
+		x.Address = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5439,22 +5745,16 @@ func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryTxLogsResponse.logs":
-		if len(x.Logs) == 0 {
-			return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{})
-		}
-		listValue := &_QueryTxLogsResponse_1_list{list: &x.Logs}
-		return protoreflect.ValueOfList(listValue)
-	case "eth.evm.v1.QueryTxLogsResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.QueryCodeRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5468,19 +5768,15 @@ func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsResponse.logs":
-		lv := value.List()
-		clv := lv.(*_QueryTxLogsResponse_1_list)
-		x.Logs = *clv.list
-	case "eth.evm.v1.QueryTxLogsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "eth.evm.v1.QueryCodeRequest.address":
+		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5494,53 +5790,40 @@ func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsResponse.logs":
-		if x.Logs == nil {
-			x.Logs = []*Log{}
-		}
-		value := &_QueryTxLogsResponse_1_list{list: &x.Logs}
-		return protoreflect.ValueOfList(value)
-	case "eth.evm.v1.QueryTxLogsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "eth.evm.v1.QueryCodeRequest.address":
+		panic(fmt.Errorf("field address of message eth.evm.v1.QueryCodeRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTxLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTxLogsResponse.logs":
-		list := []*Log{}
-		return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &list})
-	case "eth.evm.v1.QueryTxLogsResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.QueryCodeRequest.address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTxLogsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryCodeRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5548,7 +5831,7 @@ func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5559,7 +5842,7 @@ func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5571,7 +5854,7 @@ func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
+func (x *fastReflection_QueryCodeRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5581,9 +5864,9 @@ func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5595,14 +5878,8 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Logs) > 0 {
-			for _, e := range x.Logs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Address)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5615,7 +5892,7 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5634,35 +5911,12 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Logs) > 0 {
-			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Logs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5675,7 +5929,7 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5707,51 +5961,17 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Logs = append(x.Logs, &Log{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5761,27 +5981,23 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5819,23 +6035,25 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryCodeResponse      protoreflect.MessageDescriptor
+	fd_QueryCodeResponse_code protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryParamsRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryCodeResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryCodeResponse")
+	fd_QueryCodeResponse_code = md_QueryCodeResponse.Fields().ByName("code")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCodeResponse)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryCodeResponse QueryCodeResponse
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryCodeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCodeResponse)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5847,43 +6065,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryCodeResponse_messageType fastReflection_QueryCodeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCodeResponse_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryCodeResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryCodeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCodeResponse)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryCodeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeResponse)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryCodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryCodeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryCodeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCodeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryCodeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCodeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5891,7 +6109,13 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Code) != 0 {
+		value := protoreflect.ValueOfBytes(x.Code)
+		if !f(fd_QueryCodeResponse_code, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5905,13 +6129,15 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryCodeResponse.code":
+		return len(x.Code) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5921,13 +6147,15 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryCodeResponse.code":
+		x.Code = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5937,13 +6165,16 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "eth.evm.v1.QueryCodeResponse.code":
+		value := x.Code
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5957,13 +6188,15 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryCodeResponse.code":
+		x.Code = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5977,36 +6210,40 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryCodeResponse.code":
+		panic(fmt.Errorf("field code of message eth.evm.v1.QueryCodeResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryCodeResponse.code":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryCodeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6014,7 +6251,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6025,7 +6262,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6037,7 +6274,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryCodeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6047,9 +6284,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6061,6 +6298,10 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Code)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6071,7 +6312,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6090,6 +6331,13 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6101,7 +6349,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6133,26 +6381,60 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
+				if x.Code == nil {
+					x.Code = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -6175,25 +6457,27 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_QueryTxLogsRequest            protoreflect.MessageDescriptor
+	fd_QueryTxLogsRequest_hash       protoreflect.FieldDescriptor
+	fd_QueryTxLogsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryParamsResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	md_QueryTxLogsRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryTxLogsRequest")
+	fd_QueryTxLogsRequest_hash = md_QueryTxLogsRequest.Fields().ByName("hash")
+	fd_QueryTxLogsRequest_pagination = md_QueryTxLogsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxLogsRequest)(nil)
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+type fastReflection_QueryTxLogsRequest QueryTxLogsRequest
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *QueryTxLogsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsRequest)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6205,43 +6489,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_QueryTxLogsRequest_messageType fastReflection_QueryTxLogsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxLogsRequest_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_QueryTxLogsRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_QueryTxLogsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsRequest)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_QueryTxLogsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsRequest)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_QueryTxLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_QueryTxLogsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_QueryTxLogsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxLogsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_QueryTxLogsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxLogsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6249,10 +6533,16 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_QueryTxLogsRequest_hash, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTxLogsRequest_pagination, value) {
 			return
 		}
 	}
@@ -6269,15 +6559,17 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "eth.evm.v1.QueryTxLogsRequest.hash":
+		return x.Hash != ""
+	case "eth.evm.v1.QueryTxLogsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6287,15 +6579,17 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryParamsResponse.params":
-		x.Params = nil
+	case "eth.evm.v1.QueryTxLogsRequest.hash":
+		x.Hash = ""
+	case "eth.evm.v1.QueryTxLogsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6305,16 +6599,19 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryParamsResponse.params":
-		value := x.Params
+	case "eth.evm.v1.QueryTxLogsRequest.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.QueryTxLogsRequest.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6328,15 +6625,17 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "eth.evm.v1.QueryTxLogsRequest.hash":
+		x.Hash = value.Interface().(string)
+	case "eth.evm.v1.QueryTxLogsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6350,44 +6649,48 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "eth.evm.v1.QueryTxLogsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "eth.evm.v1.QueryTxLogsRequest.hash":
+		panic(fmt.Errorf("field hash of message eth.evm.v1.QueryTxLogsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryParamsResponse.params":
-		m := new(Params)
+	case "eth.evm.v1.QueryTxLogsRequest.hash":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.QueryTxLogsRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTxLogsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6395,7 +6698,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6406,7 +6709,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6418,7 +6721,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6428,9 +6731,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6442,8 +6745,12 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6456,7 +6763,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6475,8 +6782,8 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6487,6 +6794,13 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -6500,7 +6814,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6532,17 +6846,17 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6552,35 +6866,67 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -6609,32 +6955,79 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_QueryTxLogsResponse_1_list)(nil)
+
+type _QueryTxLogsResponse_1_list struct {
+	list *[]*Log
+}
+
+func (x *_QueryTxLogsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTxLogsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTxLogsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTxLogsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTxLogsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Log)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTxLogsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTxLogsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Log)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTxLogsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_EthCallRequest                  protoreflect.MessageDescriptor
-	fd_EthCallRequest_args             protoreflect.FieldDescriptor
-	fd_EthCallRequest_gas_cap          protoreflect.FieldDescriptor
-	fd_EthCallRequest_proposer_address protoreflect.FieldDescriptor
-	fd_EthCallRequest_chain_id         protoreflect.FieldDescriptor
+	md_QueryTxLogsResponse            protoreflect.MessageDescriptor
+	fd_QueryTxLogsResponse_logs       protoreflect.FieldDescriptor
+	fd_QueryTxLogsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_EthCallRequest = File_eth_evm_v1_query_proto.Messages().ByName("EthCallRequest")
-	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
-	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
-	fd_EthCallRequest_proposer_address = md_EthCallRequest.Fields().ByName("proposer_address")
-	fd_EthCallRequest_chain_id = md_EthCallRequest.Fields().ByName("chain_id")
+	md_QueryTxLogsResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryTxLogsResponse")
+	fd_QueryTxLogsResponse_logs = md_QueryTxLogsResponse.Fields().ByName("logs")
+	fd_QueryTxLogsResponse_pagination = md_QueryTxLogsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxLogsResponse)(nil)
 
-type fastReflection_EthCallRequest EthCallRequest
+type fastReflection_QueryTxLogsResponse QueryTxLogsResponse
 
-func (x *EthCallRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EthCallRequest)(x)
+func (x *QueryTxLogsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsResponse)(x)
 }
 
-func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6646,43 +7039,43 @@ func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EthCallRequest_messageType fastReflection_EthCallRequest_messageType
-var _ protoreflect.MessageType = fastReflection_EthCallRequest_messageType{}
+var _fastReflection_QueryTxLogsResponse_messageType fastReflection_QueryTxLogsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxLogsResponse_messageType{}
 
-type fastReflection_EthCallRequest_messageType struct{}
+type fastReflection_QueryTxLogsResponse_messageType struct{}
 
-func (x fastReflection_EthCallRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EthCallRequest)(nil)
+func (x fastReflection_QueryTxLogsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsResponse)(nil)
 }
-func (x fastReflection_EthCallRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_EthCallRequest)
+func (x fastReflection_QueryTxLogsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsResponse)
 }
-func (x fastReflection_EthCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EthCallRequest
+func (x fastReflection_QueryTxLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EthCallRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_EthCallRequest
+func (x *fastReflection_QueryTxLogsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EthCallRequest) Type() protoreflect.MessageType {
-	return _fastReflection_EthCallRequest_messageType
+func (x *fastReflection_QueryTxLogsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxLogsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EthCallRequest) New() protoreflect.Message {
-	return new(fastReflection_EthCallRequest)
+func (x *fastReflection_QueryTxLogsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
-	return (*EthCallRequest)(x)
+func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxLogsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6690,28 +7083,16 @@ func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Args) != 0 {
-		value := protoreflect.ValueOfBytes(x.Args)
-		if !f(fd_EthCallRequest_args, value) {
-			return
-		}
-	}
-	if x.GasCap != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasCap)
-		if !f(fd_EthCallRequest_gas_cap, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_EthCallRequest_proposer_address, value) {
+func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Logs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &x.Logs})
+		if !f(fd_QueryTxLogsResponse_logs, value) {
 			return
 		}
 	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_EthCallRequest_chain_id, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTxLogsResponse_pagination, value) {
 			return
 		}
 	}
@@ -6728,21 +7109,17 @@ func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.EthCallRequest.args":
-		return len(x.Args) != 0
-	case "eth.evm.v1.EthCallRequest.gas_cap":
-		return x.GasCap != uint64(0)
-	case "eth.evm.v1.EthCallRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "eth.evm.v1.EthCallRequest.chain_id":
-		return x.ChainId != int64(0)
+	case "eth.evm.v1.QueryTxLogsResponse.logs":
+		return len(x.Logs) != 0
+	case "eth.evm.v1.QueryTxLogsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6752,21 +7129,17 @@ func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EthCallRequest.args":
-		x.Args = nil
-	case "eth.evm.v1.EthCallRequest.gas_cap":
-		x.GasCap = uint64(0)
-	case "eth.evm.v1.EthCallRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "eth.evm.v1.EthCallRequest.chain_id":
-		x.ChainId = int64(0)
+	case "eth.evm.v1.QueryTxLogsResponse.logs":
+		x.Logs = nil
+	case "eth.evm.v1.QueryTxLogsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6776,25 +7149,22 @@ func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.EthCallRequest.args":
-		value := x.Args
-		return protoreflect.ValueOfBytes(value)
-	case "eth.evm.v1.EthCallRequest.gas_cap":
-		value := x.GasCap
-		return protoreflect.ValueOfUint64(value)
-	case "eth.evm.v1.EthCallRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "eth.evm.v1.EthCallRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
+	case "eth.evm.v1.QueryTxLogsResponse.logs":
+		if len(x.Logs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{})
+		}
+		listValue := &_QueryTxLogsResponse_1_list{list: &x.Logs}
+		return protoreflect.ValueOfList(listValue)
+	case "eth.evm.v1.QueryTxLogsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6808,21 +7178,19 @@ func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EthCallRequest.args":
-		x.Args = value.Bytes()
-	case "eth.evm.v1.EthCallRequest.gas_cap":
-		x.GasCap = value.Uint()
-	case "eth.evm.v1.EthCallRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "eth.evm.v1.EthCallRequest.chain_id":
-		x.ChainId = value.Int()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
+	case "eth.evm.v1.QueryTxLogsResponse.logs":
+		lv := value.List()
+		clv := lv.(*_QueryTxLogsResponse_1_list)
+		x.Logs = *clv.list
+	case "eth.evm.v1.QueryTxLogsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6836,52 +7204,53 @@ func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EthCallRequest.args":
-		panic(fmt.Errorf("field args of message eth.evm.v1.EthCallRequest is not mutable"))
-	case "eth.evm.v1.EthCallRequest.gas_cap":
-		panic(fmt.Errorf("field gas_cap of message eth.evm.v1.EthCallRequest is not mutable"))
-	case "eth.evm.v1.EthCallRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message eth.evm.v1.EthCallRequest is not mutable"))
-	case "eth.evm.v1.EthCallRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message eth.evm.v1.EthCallRequest is not mutable"))
+	case "eth.evm.v1.QueryTxLogsResponse.logs":
+		if x.Logs == nil {
+			x.Logs = []*Log{}
+		}
+		value := &_QueryTxLogsResponse_1_list{list: &x.Logs}
+		return protoreflect.ValueOfList(value)
+	case "eth.evm.v1.QueryTxLogsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EthCallRequest.args":
-		return protoreflect.ValueOfBytes(nil)
-	case "eth.evm.v1.EthCallRequest.gas_cap":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "eth.evm.v1.EthCallRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "eth.evm.v1.EthCallRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "eth.evm.v1.QueryTxLogsResponse.logs":
+		list := []*Log{}
+		return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &list})
+	case "eth.evm.v1.QueryTxLogsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EthCallRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTxLogsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6889,7 +7258,7 @@ func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6900,7 +7269,7 @@ func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6912,7 +7281,7 @@ func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EthCallRequest) IsValid() bool {
+func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6922,9 +7291,9 @@ func (x *fastReflection_EthCallRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6936,20 +7305,16 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Args)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GasCap != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasCap))
+		if len(x.Logs) > 0 {
+			for _, e := range x.Logs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6960,7 +7325,7 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6979,29 +7344,35 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.GasCap != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Args) > 0 {
-			i -= len(x.Args)
-			copy(dAtA[i:], x.Args)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Logs) > 0 {
+			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Logs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7014,7 +7385,7 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7046,17 +7417,17 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7066,50 +7437,31 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
-				if x.Args == nil {
-					x.Args = []byte{}
+				x.Logs = append(x.Logs, &Log{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
-				}
-				x.GasCap = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GasCap |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7119,45 +7471,28 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7194,25 +7529,23 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EstimateGasResponse     protoreflect.MessageDescriptor
-	fd_EstimateGasResponse_gas protoreflect.FieldDescriptor
+	md_QueryParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_EstimateGasResponse = File_eth_evm_v1_query_proto.Messages().ByName("EstimateGasResponse")
-	fd_EstimateGasResponse_gas = md_EstimateGasResponse.Fields().ByName("gas")
+	md_QueryParamsRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_EstimateGasResponse EstimateGasResponse
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EstimateGasResponse)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7224,43 +7557,43 @@ func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
-var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_EstimateGasResponse_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EstimateGasResponse)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_EstimateGasResponse)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EstimateGasResponse
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_EstimateGasResponse
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
-	return _fastReflection_EstimateGasResponse_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
-	return new(fastReflection_EstimateGasResponse)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
-	return (*EstimateGasResponse)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7268,13 +7601,7 @@ func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Gas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Gas)
-		if !f(fd_EstimateGasResponse_gas, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7288,15 +7615,13 @@ func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.EstimateGasResponse.gas":
-		return x.Gas != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7306,15 +7631,13 @@ func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EstimateGasResponse.gas":
-		x.Gas = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7324,16 +7647,13 @@ func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.EstimateGasResponse.gas":
-		value := x.Gas
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7347,15 +7667,13 @@ func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.EstimateGasResponse.gas":
-		x.Gas = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7369,40 +7687,36 @@ func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EstimateGasResponse.gas":
-		panic(fmt.Errorf("field gas of message eth.evm.v1.EstimateGasResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.EstimateGasResponse.gas":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EstimateGasResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7410,7 +7724,7 @@ func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7421,7 +7735,7 @@ func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7433,7 +7747,7 @@ func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EstimateGasResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7443,9 +7757,9 @@ func (x *fastReflection_EstimateGasResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7457,9 +7771,6 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Gas != 0 {
-			n += 1 + runtime.Sov(uint64(x.Gas))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7470,7 +7781,7 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7489,11 +7800,6 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Gas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7505,7 +7811,7 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7537,31 +7843,12 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-				}
-				x.Gas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Gas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7597,93 +7884,26 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryTraceTxRequest_4_list)(nil)
-
-type _QueryTraceTxRequest_4_list struct {
-	list *[]*MsgEthereumTx
-}
-
-func (x *_QueryTraceTxRequest_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryTraceTxRequest_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryTraceTxRequest_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryTraceTxRequest_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryTraceTxRequest_4_list) AppendMutable() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceTxRequest_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTraceTxRequest_4_list) NewElement() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceTxRequest_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryTraceTxRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceTxRequest_msg              protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_predecessors     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_chain_id         protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_max_gas    protoreflect.FieldDescriptor
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryTraceTxRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryTraceTxRequest")
-	fd_QueryTraceTxRequest_msg = md_QueryTraceTxRequest.Fields().ByName("msg")
-	fd_QueryTraceTxRequest_trace_config = md_QueryTraceTxRequest.Fields().ByName("trace_config")
-	fd_QueryTraceTxRequest_predecessors = md_QueryTraceTxRequest.Fields().ByName("predecessors")
-	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
-	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
-	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
-	fd_QueryTraceTxRequest_proposer_address = md_QueryTraceTxRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceTxRequest_chain_id = md_QueryTraceTxRequest.Fields().ByName("chain_id")
-	fd_QueryTraceTxRequest_block_max_gas = md_QueryTraceTxRequest.Fields().ByName("block_max_gas")
+	md_QueryParamsResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-type fastReflection_QueryTraceTxRequest QueryTraceTxRequest
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxRequest)(x)
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7695,43 +7915,43 @@ func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceTxRequest_messageType fastReflection_QueryTraceTxRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceTxRequest_messageType{}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-type fastReflection_QueryTraceTxRequest_messageType struct{}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceTxRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxRequest)(nil)
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-func (x fastReflection_QueryTraceTxRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxRequest)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_QueryTraceTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxRequest
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceTxRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxRequest
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceTxRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceTxRequest_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceTxRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxRequest)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceTxRequest)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7739,58 +7959,10 @@ func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Msg != nil {
-		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_msg, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_trace_config, value) {
-			return
-		}
-	}
-	if len(x.Predecessors) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &x.Predecessors})
-		if !f(fd_QueryTraceTxRequest_predecessors, value) {
-			return
-		}
-	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceTxRequest_block_number, value) {
-			return
-		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceTxRequest_block_hash, value) {
-			return
-		}
-	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_block_time, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceTxRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceTxRequest_chain_id, value) {
-			return
-		}
-	}
-	if x.BlockMaxGas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
-		if !f(fd_QueryTraceTxRequest_block_max_gas, value) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
 			return
 		}
 	}
@@ -7807,31 +7979,15 @@ func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxRequest.msg":
-		return x.Msg != nil
-	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
-		return x.TraceConfig != nil
-	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
-		return len(x.Predecessors) != 0
-	case "eth.evm.v1.QueryTraceTxRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
-		return x.BlockHash != ""
-	case "eth.evm.v1.QueryTraceTxRequest.block_time":
-		return x.BlockTime != nil
-	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
-		return x.ChainId != int64(0)
-	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
-		return x.BlockMaxGas != int64(0)
+	case "eth.evm.v1.QueryParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7841,31 +7997,15 @@ func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxRequest.msg":
-		x.Msg = nil
-	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
-		x.TraceConfig = nil
-	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
-		x.Predecessors = nil
-	case "eth.evm.v1.QueryTraceTxRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
-		x.BlockHash = ""
-	case "eth.evm.v1.QueryTraceTxRequest.block_time":
-		x.BlockTime = nil
-	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
-		x.ChainId = int64(0)
-	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
-		x.BlockMaxGas = int64(0)
+	case "eth.evm.v1.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7875,43 +8015,16 @@ func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryTraceTxRequest.msg":
-		value := x.Msg
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
-		value := x.TraceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
-		if len(x.Predecessors) == 0 {
-			return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{})
-		}
-		listValue := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
-		return protoreflect.ValueOfList(listValue)
-	case "eth.evm.v1.QueryTraceTxRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.QueryTraceTxRequest.block_time":
-		value := x.BlockTime
+	case "eth.evm.v1.QueryParamsResponse.params":
+		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
-	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
-		value := x.BlockMaxGas
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7925,33 +8038,15 @@ func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxRequest.msg":
-		x.Msg = value.Message().Interface().(*MsgEthereumTx)
-	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
-		lv := value.List()
-		clv := lv.(*_QueryTraceTxRequest_4_list)
-		x.Predecessors = *clv.list
-	case "eth.evm.v1.QueryTraceTxRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "eth.evm.v1.QueryTraceTxRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
-		x.ChainId = value.Int()
-	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
-		x.BlockMaxGas = value.Int()
+	case "eth.evm.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7965,89 +8060,44 @@ func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxRequest.msg":
-		if x.Msg == nil {
-			x.Msg = new(MsgEthereumTx)
-		}
-		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
-		if x.Predecessors == nil {
-			x.Predecessors = []*MsgEthereumTx{}
-		}
-		value := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
-		return protoreflect.ValueOfList(value)
-	case "eth.evm.v1.QueryTraceTxRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
+	case "eth.evm.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
 		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.block_number":
-		panic(fmt.Errorf("field block_number of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
-	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
-	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
-	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
-	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
-		panic(fmt.Errorf("field block_max_gas of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxRequest.msg":
-		m := new(MsgEthereumTx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
-		m := new(TraceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
-		list := []*MsgEthereumTx{}
-		return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &list})
-	case "eth.evm.v1.QueryTraceTxRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
-		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.QueryTraceTxRequest.block_time":
-		m := new(timestamppb.Timestamp)
+	case "eth.evm.v1.QueryParamsResponse.params":
+		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTraceTxRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8055,7 +8105,7 @@ func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8066,7 +8116,7 @@ func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8078,7 +8128,7 @@ func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8088,9 +8138,9 @@ func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8102,52 +8152,21 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Msg != nil {
-			l = options.Size(x.Msg)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Predecessors) > 0 {
-			for _, e := range x.Predecessors {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.BlockHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
-		if x.BlockMaxGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
 		}
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8166,81 +8185,8 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockMaxGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x48
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Predecessors) > 0 {
-			for iNdEx := len(x.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Predecessors[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.Msg != nil {
-			encoded, err := options.Marshal(x.Msg)
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8264,7 +8210,7 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8296,51 +8242,15 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Msg == nil {
-					x.Msg = &MsgEthereumTx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8367,220 +8277,27 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Predecessors = append(x.Predecessors, &MsgEthereumTx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Predecessors[len(x.Predecessors)-1]); err != nil {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-				}
-				x.BlockMaxGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockMaxGas |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -8603,25 +8320,31 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryTraceTxResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceTxResponse_data protoreflect.FieldDescriptor
+	md_EthCallRequest                  protoreflect.MessageDescriptor
+	fd_EthCallRequest_args             protoreflect.FieldDescriptor
+	fd_EthCallRequest_gas_cap          protoreflect.FieldDescriptor
+	fd_EthCallRequest_proposer_address protoreflect.FieldDescriptor
+	fd_EthCallRequest_chain_id         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryTraceTxResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryTraceTxResponse")
-	fd_QueryTraceTxResponse_data = md_QueryTraceTxResponse.Fields().ByName("data")
+	md_EthCallRequest = File_eth_evm_v1_query_proto.Messages().ByName("EthCallRequest")
+	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
+	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
+	fd_EthCallRequest_proposer_address = md_EthCallRequest.Fields().ByName("proposer_address")
+	fd_EthCallRequest_chain_id = md_EthCallRequest.Fields().ByName("chain_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceTxResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
 
-type fastReflection_QueryTraceTxResponse QueryTraceTxResponse
+type fastReflection_EthCallRequest EthCallRequest
 
-func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxResponse)(x)
+func (x *EthCallRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EthCallRequest)(x)
 }
 
-func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
+func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8633,43 +8356,43 @@ func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceTxResponse_messageType fastReflection_QueryTraceTxResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceTxResponse_messageType{}
+var _fastReflection_EthCallRequest_messageType fastReflection_EthCallRequest_messageType
+var _ protoreflect.MessageType = fastReflection_EthCallRequest_messageType{}
 
-type fastReflection_QueryTraceTxResponse_messageType struct{}
+type fastReflection_EthCallRequest_messageType struct{}
 
-func (x fastReflection_QueryTraceTxResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxResponse)(nil)
+func (x fastReflection_EthCallRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EthCallRequest)(nil)
 }
-func (x fastReflection_QueryTraceTxResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxResponse)
+func (x fastReflection_EthCallRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_EthCallRequest)
 }
-func (x fastReflection_QueryTraceTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxResponse
+func (x fastReflection_EthCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceTxResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxResponse
+func (x *fastReflection_EthCallRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceTxResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceTxResponse_messageType
+func (x *fastReflection_EthCallRequest) Type() protoreflect.MessageType {
+	return _fastReflection_EthCallRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceTxResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxResponse)
+func (x *fastReflection_EthCallRequest) New() protoreflect.Message {
+	return new(fastReflection_EthCallRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceTxResponse)(x)
+func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
+	return (*EthCallRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8677,10 +8400,28 @@ func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceTxResponse_data, value) {
+func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Args) != 0 {
+		value := protoreflect.ValueOfBytes(x.Args)
+		if !f(fd_EthCallRequest_args, value) {
+			return
+		}
+	}
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_EthCallRequest_gas_cap, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_EthCallRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_EthCallRequest_chain_id, value) {
 			return
 		}
 	}
@@ -8697,15 +8438,21 @@ func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxResponse.data":
-		return len(x.Data) != 0
+	case "eth.evm.v1.EthCallRequest.args":
+		return len(x.Args) != 0
+	case "eth.evm.v1.EthCallRequest.gas_cap":
+		return x.GasCap != uint64(0)
+	case "eth.evm.v1.EthCallRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "eth.evm.v1.EthCallRequest.chain_id":
+		return x.ChainId != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8715,15 +8462,21 @@ func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxResponse.data":
-		x.Data = nil
+	case "eth.evm.v1.EthCallRequest.args":
+		x.Args = nil
+	case "eth.evm.v1.EthCallRequest.gas_cap":
+		x.GasCap = uint64(0)
+	case "eth.evm.v1.EthCallRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "eth.evm.v1.EthCallRequest.chain_id":
+		x.ChainId = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8733,16 +8486,25 @@ func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryTraceTxResponse.data":
-		value := x.Data
+	case "eth.evm.v1.EthCallRequest.args":
+		value := x.Args
+		return protoreflect.ValueOfBytes(value)
+	case "eth.evm.v1.EthCallRequest.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "eth.evm.v1.EthCallRequest.proposer_address":
+		value := x.ProposerAddress
 		return protoreflect.ValueOfBytes(value)
+	case "eth.evm.v1.EthCallRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8756,15 +8518,21 @@ func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxResponse.data":
-		x.Data = value.Bytes()
+	case "eth.evm.v1.EthCallRequest.args":
+		x.Args = value.Bytes()
+	case "eth.evm.v1.EthCallRequest.gas_cap":
+		x.GasCap = value.Uint()
+	case "eth.evm.v1.EthCallRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "eth.evm.v1.EthCallRequest.chain_id":
+		x.ChainId = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8778,40 +8546,52 @@ func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxResponse.data":
-		panic(fmt.Errorf("field data of message eth.evm.v1.QueryTraceTxResponse is not mutable"))
+	case "eth.evm.v1.EthCallRequest.args":
+		panic(fmt.Errorf("field args of message eth.evm.v1.EthCallRequest is not mutable"))
+	case "eth.evm.v1.EthCallRequest.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message eth.evm.v1.EthCallRequest is not mutable"))
+	case "eth.evm.v1.EthCallRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message eth.evm.v1.EthCallRequest is not mutable"))
+	case "eth.evm.v1.EthCallRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message eth.evm.v1.EthCallRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceTxResponse.data":
+	case "eth.evm.v1.EthCallRequest.args":
+		return protoreflect.ValueOfBytes(nil)
+	case "eth.evm.v1.EthCallRequest.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "eth.evm.v1.EthCallRequest.proposer_address":
 		return protoreflect.ValueOfBytes(nil)
+	case "eth.evm.v1.EthCallRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTraceTxResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EthCallRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8819,7 +8599,7 @@ func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8830,7 +8610,7 @@ func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8842,7 +8622,7 @@ func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
+func (x *fastReflection_EthCallRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8852,9 +8632,9 @@ func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8866,10 +8646,20 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
+		l = len(x.Args)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
+		}
+		l = len(x.ProposerAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8880,7 +8670,7 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8899,10 +8689,27 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Args) > 0 {
+			i -= len(x.Args)
+			copy(dAtA[i:], x.Args)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8917,7 +8724,7 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8949,15 +8756,15 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -8984,11 +8791,83 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
+				if x.Args == nil {
+					x.Args = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				}
+				x.GasCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9024,91 +8903,26 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryTraceBlockRequest_1_list)(nil)
-
-type _QueryTraceBlockRequest_1_list struct {
-	list *[]*MsgEthereumTx
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryTraceBlockRequest_1_list) AppendMutable() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTraceBlockRequest_1_list) NewElement() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryTraceBlockRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceBlockRequest_txs              protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_chain_id         protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_max_gas    protoreflect.FieldDescriptor
+	md_EstimateGasResponse     protoreflect.MessageDescriptor
+	fd_EstimateGasResponse_gas protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryTraceBlockRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryTraceBlockRequest")
-	fd_QueryTraceBlockRequest_txs = md_QueryTraceBlockRequest.Fields().ByName("txs")
-	fd_QueryTraceBlockRequest_trace_config = md_QueryTraceBlockRequest.Fields().ByName("trace_config")
-	fd_QueryTraceBlockRequest_block_number = md_QueryTraceBlockRequest.Fields().ByName("block_number")
-	fd_QueryTraceBlockRequest_block_hash = md_QueryTraceBlockRequest.Fields().ByName("block_hash")
-	fd_QueryTraceBlockRequest_block_time = md_QueryTraceBlockRequest.Fields().ByName("block_time")
-	fd_QueryTraceBlockRequest_proposer_address = md_QueryTraceBlockRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceBlockRequest_chain_id = md_QueryTraceBlockRequest.Fields().ByName("chain_id")
-	fd_QueryTraceBlockRequest_block_max_gas = md_QueryTraceBlockRequest.Fields().ByName("block_max_gas")
+	md_EstimateGasResponse = File_eth_evm_v1_query_proto.Messages().ByName("EstimateGasResponse")
+	fd_EstimateGasResponse_gas = md_EstimateGasResponse.Fields().ByName("gas")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceBlockRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
 
-type fastReflection_QueryTraceBlockRequest QueryTraceBlockRequest
+type fastReflection_EstimateGasResponse EstimateGasResponse
 
-func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockRequest)(x)
+func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(x)
 }
 
-func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
+func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_eth_evm_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9120,43 +8934,43 @@ func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceBlockRequest_messageType fastReflection_QueryTraceBlockRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceBlockRequest_messageType{}
-
-type fastReflection_QueryTraceBlockRequest_messageType struct{}
+var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
+var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
 
-func (x fastReflection_QueryTraceBlockRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockRequest)(nil)
+type fastReflection_EstimateGasResponse_messageType struct{}
+
+func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(nil)
 }
-func (x fastReflection_QueryTraceBlockRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockRequest)
+func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
 }
-func (x fastReflection_QueryTraceBlockRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockRequest
+func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceBlockRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockRequest
+func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceBlockRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceBlockRequest_messageType
+func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
+	return _fastReflection_EstimateGasResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceBlockRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockRequest)
+func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceBlockRequest)(x)
+func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
+	return (*EstimateGasResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9164,52 +8978,10 @@ func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &x.Txs})
-		if !f(fd_QueryTraceBlockRequest_txs, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceBlockRequest_trace_config, value) {
-			return
-		}
-	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceBlockRequest_block_number, value) {
-			return
-		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceBlockRequest_block_hash, value) {
-			return
-		}
-	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceBlockRequest_block_time, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceBlockRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceBlockRequest_chain_id, value) {
-			return
-		}
-	}
-	if x.BlockMaxGas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
-		if !f(fd_QueryTraceBlockRequest_block_max_gas, value) {
+func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Gas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Gas)
+		if !f(fd_EstimateGasResponse_gas, value) {
 			return
 		}
 	}
@@ -9226,29 +8998,15 @@ func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockRequest.txs":
-		return len(x.Txs) != 0
-	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
-		return x.TraceConfig != nil
-	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
-		return x.BlockHash != ""
-	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
-		return x.BlockTime != nil
-	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
-		return x.ChainId != int64(0)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
-		return x.BlockMaxGas != int64(0)
+	case "eth.evm.v1.EstimateGasResponse.gas":
+		return x.Gas != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9258,29 +9016,15 @@ func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockRequest.txs":
-		x.Txs = nil
-	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
-		x.TraceConfig = nil
-	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
-		x.BlockHash = ""
-	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
-		x.BlockTime = nil
-	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
-		x.ChainId = int64(0)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
-		x.BlockMaxGas = int64(0)
+	case "eth.evm.v1.EstimateGasResponse.gas":
+		x.Gas = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9290,40 +9034,16 @@ func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryTraceBlockRequest.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{})
-		}
-		listValue := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(listValue)
-	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
-		value := x.TraceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
-		value := x.BlockTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
-		value := x.BlockMaxGas
-		return protoreflect.ValueOfInt64(value)
+	case "eth.evm.v1.EstimateGasResponse.gas":
+		value := x.Gas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9337,31 +9057,15 @@ func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockRequest.txs":
-		lv := value.List()
-		clv := lv.(*_QueryTraceBlockRequest_1_list)
-		x.Txs = *clv.list
-	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
-		x.ChainId = value.Int()
-	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
-		x.BlockMaxGas = value.Int()
+	case "eth.evm.v1.EstimateGasResponse.gas":
+		x.Gas = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9375,81 +9079,40 @@ func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockRequest.txs":
-		if x.Txs == nil {
-			x.Txs = []*MsgEthereumTx{}
-		}
-		value := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(value)
-	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
-		panic(fmt.Errorf("field block_number of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
-	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
-	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
-	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
-	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
-		panic(fmt.Errorf("field block_max_gas of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
+	case "eth.evm.v1.EstimateGasResponse.gas":
+		panic(fmt.Errorf("field gas of message eth.evm.v1.EstimateGasResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceBlockRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockRequest.txs":
-		list := []*MsgEthereumTx{}
-		return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &list})
-	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
-		m := new(TraceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
-		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "eth.evm.v1.EstimateGasResponse.gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTraceBlockRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.EstimateGasResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9457,7 +9120,7 @@ func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9468,7 +9131,7 @@ func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9480,7 +9143,7 @@ func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
+func (x *fastReflection_EstimateGasResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9490,9 +9153,9 @@ func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9504,36 +9167,8 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Txs) > 0 {
-			for _, e := range x.Txs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.BlockHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
-		if x.BlockMaxGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		if x.Gas != 0 {
+			n += 1 + runtime.Sov(uint64(x.Gas))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9545,7 +9180,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9564,78 +9199,10 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockMaxGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x48
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Gas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Txs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9648,7 +9215,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9680,106 +9247,17 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Txs = append(x.Txs, &MsgEthereumTx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 				}
-				var stringLen uint64
+				x.Gas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9789,65 +9267,3599 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Gas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTraceTxRequest_4_list)(nil)
+
+type _QueryTraceTxRequest_4_list struct {
+	list *[]*MsgEthereumTx
+}
+
+func (x *_QueryTraceTxRequest_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTraceTxRequest_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTraceTxRequest_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTraceTxRequest_4_list) AppendMutable() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTraceTxRequest_4_list) NewElement() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTraceTxRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceTxRequest_msg              protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_predecessors     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_query_proto_init()
+	md_QueryTraceTxRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryTraceTxRequest")
+	fd_QueryTraceTxRequest_msg = md_QueryTraceTxRequest.Fields().ByName("msg")
+	fd_QueryTraceTxRequest_trace_config = md_QueryTraceTxRequest.Fields().ByName("trace_config")
+	fd_QueryTraceTxRequest_predecessors = md_QueryTraceTxRequest.Fields().ByName("predecessors")
+	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
+	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
+	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
+	fd_QueryTraceTxRequest_proposer_address = md_QueryTraceTxRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceTxRequest_chain_id = md_QueryTraceTxRequest.Fields().ByName("chain_id")
+	fd_QueryTraceTxRequest_block_max_gas = md_QueryTraceTxRequest.Fields().ByName("block_max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
+
+type fastReflection_QueryTraceTxRequest QueryTraceTxRequest
+
+func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxRequest)(x)
+}
+
+func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceTxRequest_messageType fastReflection_QueryTraceTxRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceTxRequest_messageType{}
+
+type fastReflection_QueryTraceTxRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceTxRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxRequest)(nil)
+}
+func (x fastReflection_QueryTraceTxRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxRequest)
+}
+func (x fastReflection_QueryTraceTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceTxRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceTxRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceTxRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceTxRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceTxRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_msg, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_trace_config, value) {
+			return
+		}
+	}
+	if len(x.Predecessors) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &x.Predecessors})
+		if !f(fd_QueryTraceTxRequest_predecessors, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceTxRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceTxRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_block_time, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceTxRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceTxRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockMaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
+		if !f(fd_QueryTraceTxRequest_block_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxRequest.msg":
+		return x.Msg != nil
+	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
+		return x.TraceConfig != nil
+	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
+		return len(x.Predecessors) != 0
+	case "eth.evm.v1.QueryTraceTxRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
+		return x.BlockHash != ""
+	case "eth.evm.v1.QueryTraceTxRequest.block_time":
+		return x.BlockTime != nil
+	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
+		return x.BlockMaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxRequest.msg":
+		x.Msg = nil
+	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
+		x.TraceConfig = nil
+	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
+		x.Predecessors = nil
+	case "eth.evm.v1.QueryTraceTxRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
+		x.BlockHash = ""
+	case "eth.evm.v1.QueryTraceTxRequest.block_time":
+		x.BlockTime = nil
+	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
+		x.ChainId = int64(0)
+	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
+		x.BlockMaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.QueryTraceTxRequest.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
+		if len(x.Predecessors) == 0 {
+			return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{})
+		}
+		listValue := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		return protoreflect.ValueOfList(listValue)
+	case "eth.evm.v1.QueryTraceTxRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.QueryTraceTxRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
+		value := x.BlockMaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxRequest.msg":
+		x.Msg = value.Message().Interface().(*MsgEthereumTx)
+	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
+		lv := value.List()
+		clv := lv.(*_QueryTraceTxRequest_4_list)
+		x.Predecessors = *clv.list
+	case "eth.evm.v1.QueryTraceTxRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "eth.evm.v1.QueryTraceTxRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
+		x.ChainId = value.Int()
+	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
+		x.BlockMaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxRequest.msg":
+		if x.Msg == nil {
+			x.Msg = new(MsgEthereumTx)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
+		if x.Predecessors == nil {
+			x.Predecessors = []*MsgEthereumTx{}
+		}
+		value := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		return protoreflect.ValueOfList(value)
+	case "eth.evm.v1.QueryTraceTxRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.block_number":
+		panic(fmt.Errorf("field block_number of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
+	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
+	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
+	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
+	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
+		panic(fmt.Errorf("field block_max_gas of message eth.evm.v1.QueryTraceTxRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxRequest.msg":
+		m := new(MsgEthereumTx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.predecessors":
+		list := []*MsgEthereumTx{}
+		return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &list})
+	case "eth.evm.v1.QueryTraceTxRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "eth.evm.v1.QueryTraceTxRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.QueryTraceTxRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.QueryTraceTxRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "eth.evm.v1.QueryTraceTxRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "eth.evm.v1.QueryTraceTxRequest.block_max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTraceTxRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Predecessors) > 0 {
+			for _, e := range x.Predecessors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.BlockMaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockMaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Predecessors) > 0 {
+			for iNdEx := len(x.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Predecessors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Msg == nil {
+					x.Msg = &MsgEthereumTx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Predecessors = append(x.Predecessors, &MsgEthereumTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Predecessors[len(x.Predecessors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				}
+				x.BlockMaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockMaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceTxResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceTxResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_query_proto_init()
+	md_QueryTraceTxResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryTraceTxResponse")
+	fd_QueryTraceTxResponse_data = md_QueryTraceTxResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceTxResponse)(nil)
+
+type fastReflection_QueryTraceTxResponse QueryTraceTxResponse
+
+func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxResponse)(x)
+}
+
+func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceTxResponse_messageType fastReflection_QueryTraceTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceTxResponse_messageType{}
+
+type fastReflection_QueryTraceTxResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxResponse)(nil)
+}
+func (x fastReflection_QueryTraceTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxResponse)
+}
+func (x fastReflection_QueryTraceTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceTxResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceTxResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceTxResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceTxResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.QueryTraceTxResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxResponse.data":
+		panic(fmt.Errorf("field data of message eth.evm.v1.QueryTraceTxResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceTxResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTraceTxResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTraceBlockRequest_1_list)(nil)
+
+type _QueryTraceBlockRequest_1_list struct {
+	list *[]*MsgEthereumTx
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTraceBlockRequest_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTraceBlockRequest_1_list) NewElement() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTraceBlockRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceBlockRequest_txs              protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_query_proto_init()
+	md_QueryTraceBlockRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryTraceBlockRequest")
+	fd_QueryTraceBlockRequest_txs = md_QueryTraceBlockRequest.Fields().ByName("txs")
+	fd_QueryTraceBlockRequest_trace_config = md_QueryTraceBlockRequest.Fields().ByName("trace_config")
+	fd_QueryTraceBlockRequest_block_number = md_QueryTraceBlockRequest.Fields().ByName("block_number")
+	fd_QueryTraceBlockRequest_block_hash = md_QueryTraceBlockRequest.Fields().ByName("block_hash")
+	fd_QueryTraceBlockRequest_block_time = md_QueryTraceBlockRequest.Fields().ByName("block_time")
+	fd_QueryTraceBlockRequest_proposer_address = md_QueryTraceBlockRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceBlockRequest_chain_id = md_QueryTraceBlockRequest.Fields().ByName("chain_id")
+	fd_QueryTraceBlockRequest_block_max_gas = md_QueryTraceBlockRequest.Fields().ByName("block_max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceBlockRequest)(nil)
+
+type fastReflection_QueryTraceBlockRequest QueryTraceBlockRequest
+
+func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockRequest)(x)
+}
+
+func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceBlockRequest_messageType fastReflection_QueryTraceBlockRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceBlockRequest_messageType{}
+
+type fastReflection_QueryTraceBlockRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceBlockRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockRequest)(nil)
+}
+func (x fastReflection_QueryTraceBlockRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockRequest)
+}
+func (x fastReflection_QueryTraceBlockRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceBlockRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceBlockRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceBlockRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceBlockRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceBlockRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &x.Txs})
+		if !f(fd_QueryTraceBlockRequest_txs, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceBlockRequest_trace_config, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceBlockRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceBlockRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceBlockRequest_block_time, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceBlockRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceBlockRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockMaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
+		if !f(fd_QueryTraceBlockRequest_block_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockRequest.txs":
+		return len(x.Txs) != 0
+	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
+		return x.TraceConfig != nil
+	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
+		return x.BlockHash != ""
+	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
+		return x.BlockTime != nil
+	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
+		return x.BlockMaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockRequest.txs":
+		x.Txs = nil
+	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
+		x.TraceConfig = nil
+	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
+		x.BlockHash = ""
+	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
+		x.BlockTime = nil
+	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
+		x.ChainId = int64(0)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
+		x.BlockMaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.QueryTraceBlockRequest.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{})
+		}
+		listValue := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
+		value := x.BlockMaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockRequest.txs":
+		lv := value.List()
+		clv := lv.(*_QueryTraceBlockRequest_1_list)
+		x.Txs = *clv.list
+	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
+		x.ChainId = value.Int()
+	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
+		x.BlockMaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockRequest.txs":
+		if x.Txs == nil {
+			x.Txs = []*MsgEthereumTx{}
+		}
+		value := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
+		panic(fmt.Errorf("field block_number of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
+	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
+	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
+	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
+	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
+		panic(fmt.Errorf("field block_max_gas of message eth.evm.v1.QueryTraceBlockRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceBlockRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockRequest.txs":
+		list := []*MsgEthereumTx{}
+		return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &list})
+	case "eth.evm.v1.QueryTraceBlockRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.QueryTraceBlockRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "eth.evm.v1.QueryTraceBlockRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.QueryTraceBlockRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.QueryTraceBlockRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "eth.evm.v1.QueryTraceBlockRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "eth.evm.v1.QueryTraceBlockRequest.block_max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTraceBlockRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.BlockMaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockMaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txs = append(x.Txs, &MsgEthereumTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
 				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				}
+				x.BlockMaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockMaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceBlockResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceBlockResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_query_proto_init()
+	md_QueryTraceBlockResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryTraceBlockResponse")
+	fd_QueryTraceBlockResponse_data = md_QueryTraceBlockResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceBlockResponse)(nil)
+
+type fastReflection_QueryTraceBlockResponse QueryTraceBlockResponse
+
+func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockResponse)(x)
+}
+
+func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceBlockResponse_messageType fastReflection_QueryTraceBlockResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceBlockResponse_messageType{}
+
+type fastReflection_QueryTraceBlockResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceBlockResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockResponse)(nil)
+}
+func (x fastReflection_QueryTraceBlockResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockResponse)
+}
+func (x fastReflection_QueryTraceBlockResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceBlockResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceBlockResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceBlockResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceBlockResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceBlockResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceBlockResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.QueryTraceBlockResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockResponse.data":
+		panic(fmt.Errorf("field data of message eth.evm.v1.QueryTraceBlockResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceBlockResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryTraceBlockResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTraceBlockResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBaseFeeRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_query_proto_init()
+	md_QueryBaseFeeRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryBaseFeeRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBaseFeeRequest)(nil)
+
+type fastReflection_QueryBaseFeeRequest QueryBaseFeeRequest
+
+func (x *QueryBaseFeeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeRequest)(x)
+}
+
+func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBaseFeeRequest_messageType fastReflection_QueryBaseFeeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBaseFeeRequest_messageType{}
+
+type fastReflection_QueryBaseFeeRequest_messageType struct{}
+
+func (x fastReflection_QueryBaseFeeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeRequest)(nil)
+}
+func (x fastReflection_QueryBaseFeeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeRequest)
+}
+func (x fastReflection_QueryBaseFeeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBaseFeeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBaseFeeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBaseFeeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBaseFeeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBaseFeeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBaseFeeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryBaseFeeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBaseFeeResponse                protoreflect.MessageDescriptor
+	fd_QueryBaseFeeResponse_base_fee       protoreflect.FieldDescriptor
+	fd_QueryBaseFeeResponse_base_fee_unibi protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_query_proto_init()
+	md_QueryBaseFeeResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryBaseFeeResponse")
+	fd_QueryBaseFeeResponse_base_fee = md_QueryBaseFeeResponse.Fields().ByName("base_fee")
+	fd_QueryBaseFeeResponse_base_fee_unibi = md_QueryBaseFeeResponse.Fields().ByName("base_fee_unibi")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBaseFeeResponse)(nil)
+
+type fastReflection_QueryBaseFeeResponse QueryBaseFeeResponse
+
+func (x *QueryBaseFeeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeResponse)(x)
+}
+
+func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBaseFeeResponse_messageType fastReflection_QueryBaseFeeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBaseFeeResponse_messageType{}
+
+type fastReflection_QueryBaseFeeResponse_messageType struct{}
+
+func (x fastReflection_QueryBaseFeeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeResponse)(nil)
+}
+func (x fastReflection_QueryBaseFeeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeResponse)
+}
+func (x fastReflection_QueryBaseFeeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBaseFeeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBaseFeeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBaseFeeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBaseFeeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBaseFeeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseFee != "" {
+		value := protoreflect.ValueOfString(x.BaseFee)
+		if !f(fd_QueryBaseFeeResponse_base_fee, value) {
+			return
+		}
+	}
+	if x.BaseFeeUnibi != "" {
+		value := protoreflect.ValueOfString(x.BaseFeeUnibi)
+		if !f(fd_QueryBaseFeeResponse_base_fee_unibi, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
+		return x.BaseFee != ""
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
+		return x.BaseFeeUnibi != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
+		x.BaseFee = ""
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
+		x.BaseFeeUnibi = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
+		value := x.BaseFee
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
+		value := x.BaseFeeUnibi
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
+		x.BaseFee = value.Interface().(string)
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
+		x.BaseFeeUnibi = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
+		panic(fmt.Errorf("field base_fee of message eth.evm.v1.QueryBaseFeeResponse is not mutable"))
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
+		panic(fmt.Errorf("field base_fee_unibi of message eth.evm.v1.QueryBaseFeeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBaseFeeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryBaseFeeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseFeeUnibi)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BaseFeeUnibi) > 0 {
+			i -= len(x.BaseFeeUnibi)
+			copy(dAtA[i:], x.BaseFeeUnibi)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFeeUnibi)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BaseFee) > 0 {
+			i -= len(x.BaseFee)
+			copy(dAtA[i:], x.BaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.BaseFee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUnibi", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9857,50 +12869,417 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFeeUnibi = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryFunTokenMappingRequest       protoreflect.MessageDescriptor
+	fd_QueryFunTokenMappingRequest_token protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_query_proto_init()
+	md_QueryFunTokenMappingRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryFunTokenMappingRequest")
+	fd_QueryFunTokenMappingRequest_token = md_QueryFunTokenMappingRequest.Fields().ByName("token")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryFunTokenMappingRequest)(nil)
+
+type fastReflection_QueryFunTokenMappingRequest QueryFunTokenMappingRequest
+
+func (x *QueryFunTokenMappingRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFunTokenMappingRequest)(x)
+}
+
+func (x *QueryFunTokenMappingRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFunTokenMappingRequest_messageType fastReflection_QueryFunTokenMappingRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFunTokenMappingRequest_messageType{}
+
+type fastReflection_QueryFunTokenMappingRequest_messageType struct{}
+
+func (x fastReflection_QueryFunTokenMappingRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFunTokenMappingRequest)(nil)
+}
+func (x fastReflection_QueryFunTokenMappingRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFunTokenMappingRequest)
+}
+func (x fastReflection_QueryFunTokenMappingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunTokenMappingRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFunTokenMappingRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunTokenMappingRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFunTokenMappingRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFunTokenMappingRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFunTokenMappingRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFunTokenMappingRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFunTokenMappingRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFunTokenMappingRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFunTokenMappingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Token != "" {
+		value := protoreflect.ValueOfString(x.Token)
+		if !f(fd_QueryFunTokenMappingRequest_token, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFunTokenMappingRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+		return x.Token != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFunTokenMappingRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+		x.Token = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFunTokenMappingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+		value := x.Token
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFunTokenMappingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+		x.Token = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFunTokenMappingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+		panic(fmt.Errorf("field token of message eth.evm.v1.QueryFunTokenMappingRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFunTokenMappingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFunTokenMappingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryFunTokenMappingRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFunTokenMappingRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFunTokenMappingRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFunTokenMappingRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFunTokenMappingRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFunTokenMappingRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Token)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFunTokenMappingRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Token) > 0 {
+			i -= len(x.Token)
+			copy(dAtA[i:], x.Token)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFunTokenMappingRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenMappingRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 				}
-				x.BlockMaxGas = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9910,11 +13289,24 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockMaxGas |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Token = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9951,26 +13343,26 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryTraceBlockResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceBlockResponse_data protoreflect.FieldDescriptor
+	md_QueryFunTokenMappingResponse           protoreflect.MessageDescriptor
+	fd_QueryFunTokenMappingResponse_fun_token protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryTraceBlockResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryTraceBlockResponse")
-	fd_QueryTraceBlockResponse_data = md_QueryTraceBlockResponse.Fields().ByName("data")
+	md_QueryFunTokenMappingResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryFunTokenMappingResponse")
+	fd_QueryFunTokenMappingResponse_fun_token = md_QueryFunTokenMappingResponse.Fields().ByName("fun_token")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceBlockResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFunTokenMappingResponse)(nil)
 
-type fastReflection_QueryTraceBlockResponse QueryTraceBlockResponse
+type fastReflection_QueryFunTokenMappingResponse QueryFunTokenMappingResponse
 
-func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockResponse)(x)
+func (x *QueryFunTokenMappingResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFunTokenMappingResponse)(x)
 }
 
-func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_query_proto_msgTypes[19]
+func (x *QueryFunTokenMappingResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9981,43 +13373,43 @@ func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceBlockResponse_messageType fastReflection_QueryTraceBlockResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceBlockResponse_messageType{}
+var _fastReflection_QueryFunTokenMappingResponse_messageType fastReflection_QueryFunTokenMappingResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFunTokenMappingResponse_messageType{}
 
-type fastReflection_QueryTraceBlockResponse_messageType struct{}
+type fastReflection_QueryFunTokenMappingResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceBlockResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockResponse)(nil)
+func (x fastReflection_QueryFunTokenMappingResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFunTokenMappingResponse)(nil)
 }
-func (x fastReflection_QueryTraceBlockResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockResponse)
+func (x fastReflection_QueryFunTokenMappingResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFunTokenMappingResponse)
 }
-func (x fastReflection_QueryTraceBlockResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockResponse
+func (x fastReflection_QueryFunTokenMappingResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunTokenMappingResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceBlockResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockResponse
+func (x *fastReflection_QueryFunTokenMappingResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunTokenMappingResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceBlockResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceBlockResponse_messageType
+func (x *fastReflection_QueryFunTokenMappingResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFunTokenMappingResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceBlockResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockResponse)
+func (x *fastReflection_QueryFunTokenMappingResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFunTokenMappingResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceBlockResponse)(x)
+func (x *fastReflection_QueryFunTokenMappingResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFunTokenMappingResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10025,10 +13417,10 @@ func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceBlockResponse_data, value) {
+func (x *fastReflection_QueryFunTokenMappingResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FunToken != nil {
+		value := protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
+		if !f(fd_QueryFunTokenMappingResponse_fun_token, value) {
 			return
 		}
 	}
@@ -10045,15 +13437,15 @@ func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFunTokenMappingResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockResponse.data":
-		return len(x.Data) != 0
+	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
+		return x.FunToken != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10063,15 +13455,15 @@ func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFunTokenMappingResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockResponse.data":
-		x.Data = nil
+	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
+		x.FunToken = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10081,16 +13473,16 @@ func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenMappingResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryTraceBlockResponse.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
+	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
+		value := x.FunToken
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10104,15 +13496,15 @@ func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFunTokenMappingResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockResponse.data":
-		x.Data = value.Bytes()
+	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
+		x.FunToken = value.Message().Interface().(*FunToken)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10126,40 +13518,44 @@ func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenMappingResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockResponse.data":
-		panic(fmt.Errorf("field data of message eth.evm.v1.QueryTraceBlockResponse is not mutable"))
+	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
+		if x.FunToken == nil {
+			x.FunToken = new(FunToken)
+		}
+		return protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceBlockResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenMappingResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryTraceBlockResponse.data":
-		return protoreflect.ValueOfBytes(nil)
+	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
+		m := new(FunToken)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFunTokenMappingResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryTraceBlockResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryFunTokenMappingResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10167,7 +13563,7 @@ func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFunTokenMappingResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10178,7 +13574,7 @@ func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFunTokenMappingResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10190,7 +13586,7 @@ func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
+func (x *fastReflection_QueryFunTokenMappingResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10200,9 +13596,9 @@ func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFunTokenMappingResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryFunTokenMappingResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10214,8 +13610,8 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
-		if l > 0 {
+		if x.FunToken != nil {
+			l = options.Size(x.FunToken)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -10228,7 +13624,7 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryFunTokenMappingResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10247,10 +13643,17 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if x.FunToken != nil {
+			encoded, err := options.Marshal(x.FunToken)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -10265,7 +13668,7 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryFunTokenMappingResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10297,17 +13700,17 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenMappingResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10317,24 +13720,26 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				if x.FunToken == nil {
+					x.FunToken = &FunToken{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FunToken); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -10373,24 +13778,26 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryBaseFeeRequest protoreflect.MessageDescriptor
+	md_QueryFunTokenEscrowRequest       protoreflect.MessageDescriptor
+	fd_QueryFunTokenEscrowRequest_token protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryBaseFeeRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryBaseFeeRequest")
+	md_QueryFunTokenEscrowRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryFunTokenEscrowRequest")
+	fd_QueryFunTokenEscrowRequest_token = md_QueryFunTokenEscrowRequest.Fields().ByName("token")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBaseFeeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFunTokenEscrowRequest)(nil)
 
-type fastReflection_QueryBaseFeeRequest QueryBaseFeeRequest
+type fastReflection_QueryFunTokenEscrowRequest QueryFunTokenEscrowRequest
 
-func (x *QueryBaseFeeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeRequest)(x)
+func (x *QueryFunTokenEscrowRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFunTokenEscrowRequest)(x)
 }
 
-func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_query_proto_msgTypes[20]
+func (x *QueryFunTokenEscrowRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10401,43 +13808,43 @@ func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBaseFeeRequest_messageType fastReflection_QueryBaseFeeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBaseFeeRequest_messageType{}
+var _fastReflection_QueryFunTokenEscrowRequest_messageType fastReflection_QueryFunTokenEscrowRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFunTokenEscrowRequest_messageType{}
 
-type fastReflection_QueryBaseFeeRequest_messageType struct{}
+type fastReflection_QueryFunTokenEscrowRequest_messageType struct{}
 
-func (x fastReflection_QueryBaseFeeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeRequest)(nil)
+func (x fastReflection_QueryFunTokenEscrowRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFunTokenEscrowRequest)(nil)
 }
-func (x fastReflection_QueryBaseFeeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeRequest)
+func (x fastReflection_QueryFunTokenEscrowRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFunTokenEscrowRequest)
 }
-func (x fastReflection_QueryBaseFeeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeRequest
+func (x fastReflection_QueryFunTokenEscrowRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunTokenEscrowRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBaseFeeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeRequest
+func (x *fastReflection_QueryFunTokenEscrowRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunTokenEscrowRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBaseFeeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBaseFeeRequest_messageType
+func (x *fastReflection_QueryFunTokenEscrowRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFunTokenEscrowRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBaseFeeRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeRequest)
+func (x *fastReflection_QueryFunTokenEscrowRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFunTokenEscrowRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryBaseFeeRequest)(x)
+func (x *fastReflection_QueryFunTokenEscrowRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFunTokenEscrowRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10445,7 +13852,13 @@ func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryFunTokenEscrowRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Token != "" {
+		value := protoreflect.ValueOfString(x.Token)
+		if !f(fd_QueryFunTokenEscrowRequest_token, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -10459,13 +13872,15 @@ func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFunTokenEscrowRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenEscrowRequest.token":
+		return x.Token != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10475,13 +13890,15 @@ func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFunTokenEscrowRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenEscrowRequest.token":
+		x.Token = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10491,13 +13908,16 @@ func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenEscrowRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "eth.evm.v1.QueryFunTokenEscrowRequest.token":
+		value := x.Token
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10511,13 +13931,15 @@ func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFunTokenEscrowRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenEscrowRequest.token":
+		x.Token = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10531,36 +13953,40 @@ func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenEscrowRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenEscrowRequest.token":
+		panic(fmt.Errorf("field token of message eth.evm.v1.QueryFunTokenEscrowRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBaseFeeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenEscrowRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "eth.evm.v1.QueryFunTokenEscrowRequest.token":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFunTokenEscrowRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryBaseFeeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryFunTokenEscrowRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10568,7 +13994,7 @@ func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFunTokenEscrowRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10579,7 +14005,7 @@ func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFunTokenEscrowRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10591,7 +14017,7 @@ func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
+func (x *fastReflection_QueryFunTokenEscrowRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10601,9 +14027,9 @@ func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFunTokenEscrowRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryFunTokenEscrowRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10615,6 +14041,10 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
+		l = len(x.Token)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10625,7 +14055,7 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryFunTokenEscrowRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10644,6 +14074,13 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Token) > 0 {
+			i -= len(x.Token)
+			copy(dAtA[i:], x.Token)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -10655,7 +14092,7 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryFunTokenEscrowRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10687,12 +14124,44 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenEscrowRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Token = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10729,28 +14198,28 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryBaseFeeResponse                protoreflect.MessageDescriptor
-	fd_QueryBaseFeeResponse_base_fee       protoreflect.FieldDescriptor
-	fd_QueryBaseFeeResponse_base_fee_unibi protoreflect.FieldDescriptor
+	md_QueryFunTokenEscrowResponse           protoreflect.MessageDescriptor
+	fd_QueryFunTokenEscrowResponse_fun_token protoreflect.FieldDescriptor
+	fd_QueryFunTokenEscrowResponse_escrow    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryBaseFeeResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryBaseFeeResponse")
-	fd_QueryBaseFeeResponse_base_fee = md_QueryBaseFeeResponse.Fields().ByName("base_fee")
-	fd_QueryBaseFeeResponse_base_fee_unibi = md_QueryBaseFeeResponse.Fields().ByName("base_fee_unibi")
+	md_QueryFunTokenEscrowResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryFunTokenEscrowResponse")
+	fd_QueryFunTokenEscrowResponse_fun_token = md_QueryFunTokenEscrowResponse.Fields().ByName("fun_token")
+	fd_QueryFunTokenEscrowResponse_escrow = md_QueryFunTokenEscrowResponse.Fields().ByName("escrow")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBaseFeeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFunTokenEscrowResponse)(nil)
 
-type fastReflection_QueryBaseFeeResponse QueryBaseFeeResponse
+type fastReflection_QueryFunTokenEscrowResponse QueryFunTokenEscrowResponse
 
-func (x *QueryBaseFeeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeResponse)(x)
+func (x *QueryFunTokenEscrowResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFunTokenEscrowResponse)(x)
 }
 
-func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_query_proto_msgTypes[21]
+func (x *QueryFunTokenEscrowResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10761,43 +14230,43 @@ func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBaseFeeResponse_messageType fastReflection_QueryBaseFeeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBaseFeeResponse_messageType{}
+var _fastReflection_QueryFunTokenEscrowResponse_messageType fastReflection_QueryFunTokenEscrowResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFunTokenEscrowResponse_messageType{}
 
-type fastReflection_QueryBaseFeeResponse_messageType struct{}
+type fastReflection_QueryFunTokenEscrowResponse_messageType struct{}
 
-func (x fastReflection_QueryBaseFeeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeResponse)(nil)
+func (x fastReflection_QueryFunTokenEscrowResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFunTokenEscrowResponse)(nil)
 }
-func (x fastReflection_QueryBaseFeeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeResponse)
+func (x fastReflection_QueryFunTokenEscrowResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFunTokenEscrowResponse)
 }
-func (x fastReflection_QueryBaseFeeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeResponse
+func (x fastReflection_QueryFunTokenEscrowResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunTokenEscrowResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBaseFeeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeResponse
+func (x *fastReflection_QueryFunTokenEscrowResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunTokenEscrowResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBaseFeeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBaseFeeResponse_messageType
+func (x *fastReflection_QueryFunTokenEscrowResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFunTokenEscrowResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBaseFeeResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeResponse)
+func (x *fastReflection_QueryFunTokenEscrowResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFunTokenEscrowResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryBaseFeeResponse)(x)
+func (x *fastReflection_QueryFunTokenEscrowResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFunTokenEscrowResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10805,16 +14274,16 @@ func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseFee != "" {
-		value := protoreflect.ValueOfString(x.BaseFee)
-		if !f(fd_QueryBaseFeeResponse_base_fee, value) {
+func (x *fastReflection_QueryFunTokenEscrowResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FunToken != nil {
+		value := protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
+		if !f(fd_QueryFunTokenEscrowResponse_fun_token, value) {
 			return
 		}
 	}
-	if x.BaseFeeUnibi != "" {
-		value := protoreflect.ValueOfString(x.BaseFeeUnibi)
-		if !f(fd_QueryBaseFeeResponse_base_fee_unibi, value) {
+	if x.Escrow != nil {
+		value := protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+		if !f(fd_QueryFunTokenEscrowResponse_escrow, value) {
 			return
 		}
 	}
@@ -10831,17 +14300,17 @@ func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFunTokenEscrowResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
-		return x.BaseFee != ""
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
-		return x.BaseFeeUnibi != ""
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.fun_token":
+		return x.FunToken != nil
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.escrow":
+		return x.Escrow != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10851,17 +14320,17 @@ func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFunTokenEscrowResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
-		x.BaseFee = ""
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
-		x.BaseFeeUnibi = ""
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.fun_token":
+		x.FunToken = nil
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.escrow":
+		x.Escrow = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10871,19 +14340,19 @@ func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenEscrowResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
-		value := x.BaseFee
-		return protoreflect.ValueOfString(value)
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
-		value := x.BaseFeeUnibi
-		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.fun_token":
+		value := x.FunToken
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.escrow":
+		value := x.Escrow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10897,17 +14366,17 @@ func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFunTokenEscrowResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
-		x.BaseFee = value.Interface().(string)
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
-		x.BaseFeeUnibi = value.Interface().(string)
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.fun_token":
+		x.FunToken = value.Message().Interface().(*FunToken)
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.escrow":
+		x.Escrow = value.Message().Interface().(*FunTokenEscrow)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10921,44 +14390,52 @@ func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenEscrowResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
-		panic(fmt.Errorf("field base_fee of message eth.evm.v1.QueryBaseFeeResponse is not mutable"))
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
-		panic(fmt.Errorf("field base_fee_unibi of message eth.evm.v1.QueryBaseFeeResponse is not mutable"))
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.fun_token":
+		if x.FunToken == nil {
+			x.FunToken = new(FunToken)
+		}
+		return protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.escrow":
+		if x.Escrow == nil {
+			x.Escrow = new(FunTokenEscrow)
+		}
+		return protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBaseFeeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunTokenEscrowResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee":
-		return protoreflect.ValueOfString("")
-	case "eth.evm.v1.QueryBaseFeeResponse.base_fee_unibi":
-		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.fun_token":
+		m := new(FunToken)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.QueryFunTokenEscrowResponse.escrow":
+		m := new(FunTokenEscrow)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenEscrowResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenEscrowResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFunTokenEscrowResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryBaseFeeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryFunTokenEscrowResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10966,7 +14443,7 @@ func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFunTokenEscrowResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10977,7 +14454,7 @@ func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFunTokenEscrowResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10989,7 +14466,7 @@ func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
+func (x *fastReflection_QueryFunTokenEscrowResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10999,9 +14476,9 @@ func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFunTokenEscrowResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryFunTokenEscrowResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11013,12 +14490,12 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.BaseFee)
-		if l > 0 {
+		if x.FunToken != nil {
+			l = options.Size(x.FunToken)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BaseFeeUnibi)
-		if l > 0 {
+		if x.Escrow != nil {
+			l = options.Size(x.Escrow)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -11031,7 +14508,7 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryFunTokenEscrowResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11050,17 +14527,31 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BaseFeeUnibi) > 0 {
-			i -= len(x.BaseFeeUnibi)
-			copy(dAtA[i:], x.BaseFeeUnibi)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFeeUnibi)))
+		if x.Escrow != nil {
+			encoded, err := options.Marshal(x.Escrow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.BaseFee) > 0 {
-			i -= len(x.BaseFee)
-			copy(dAtA[i:], x.BaseFee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+		if x.FunToken != nil {
+			encoded, err := options.Marshal(x.FunToken)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -11075,7 +14566,7 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryFunTokenEscrowResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11107,17 +14598,17 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenEscrowResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11127,29 +14618,33 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				if x.FunToken == nil {
+					x.FunToken = &FunToken{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FunToken); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUnibi", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11159,23 +14654,27 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseFeeUnibi = string(dAtA[iNdEx:postIndex])
+				if x.Escrow == nil {
+					x.Escrow = &FunTokenEscrow{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -11213,26 +14712,26 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryFunTokenMappingRequest       protoreflect.MessageDescriptor
-	fd_QueryFunTokenMappingRequest_token protoreflect.FieldDescriptor
+	md_QueryFunNFTMappingRequest       protoreflect.MessageDescriptor
+	fd_QueryFunNFTMappingRequest_token protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryFunTokenMappingRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryFunTokenMappingRequest")
-	fd_QueryFunTokenMappingRequest_token = md_QueryFunTokenMappingRequest.Fields().ByName("token")
+	md_QueryFunNFTMappingRequest = File_eth_evm_v1_query_proto.Messages().ByName("QueryFunNFTMappingRequest")
+	fd_QueryFunNFTMappingRequest_token = md_QueryFunNFTMappingRequest.Fields().ByName("token")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFunTokenMappingRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFunNFTMappingRequest)(nil)
 
-type fastReflection_QueryFunTokenMappingRequest QueryFunTokenMappingRequest
+type fastReflection_QueryFunNFTMappingRequest QueryFunNFTMappingRequest
 
-func (x *QueryFunTokenMappingRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFunTokenMappingRequest)(x)
+func (x *QueryFunNFTMappingRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFunNFTMappingRequest)(x)
 }
 
-func (x *QueryFunTokenMappingRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_query_proto_msgTypes[22]
+func (x *QueryFunNFTMappingRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11243,43 +14742,43 @@ func (x *QueryFunTokenMappingRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFunTokenMappingRequest_messageType fastReflection_QueryFunTokenMappingRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFunTokenMappingRequest_messageType{}
-
-type fastReflection_QueryFunTokenMappingRequest_messageType struct{}
+var _fastReflection_QueryFunNFTMappingRequest_messageType fastReflection_QueryFunNFTMappingRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFunNFTMappingRequest_messageType{}
 
-func (x fastReflection_QueryFunTokenMappingRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFunTokenMappingRequest)(nil)
+type fastReflection_QueryFunNFTMappingRequest_messageType struct{}
+
+func (x fastReflection_QueryFunNFTMappingRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFunNFTMappingRequest)(nil)
 }
-func (x fastReflection_QueryFunTokenMappingRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFunTokenMappingRequest)
+func (x fastReflection_QueryFunNFTMappingRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFunNFTMappingRequest)
 }
-func (x fastReflection_QueryFunTokenMappingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFunTokenMappingRequest
+func (x fastReflection_QueryFunNFTMappingRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunNFTMappingRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFunTokenMappingRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFunTokenMappingRequest
+func (x *fastReflection_QueryFunNFTMappingRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunNFTMappingRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFunTokenMappingRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFunTokenMappingRequest_messageType
+func (x *fastReflection_QueryFunNFTMappingRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFunNFTMappingRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFunTokenMappingRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryFunTokenMappingRequest)
+func (x *fastReflection_QueryFunNFTMappingRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFunNFTMappingRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFunTokenMappingRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryFunTokenMappingRequest)(x)
+func (x *fastReflection_QueryFunNFTMappingRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFunNFTMappingRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11287,10 +14786,10 @@ func (x *fastReflection_QueryFunTokenMappingRequest) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFunTokenMappingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryFunNFTMappingRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Token != "" {
 		value := protoreflect.ValueOfString(x.Token)
-		if !f(fd_QueryFunTokenMappingRequest_token, value) {
+		if !f(fd_QueryFunNFTMappingRequest_token, value) {
 			return
 		}
 	}
@@ -11307,15 +14806,15 @@ func (x *fastReflection_QueryFunTokenMappingRequest) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFunTokenMappingRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFunNFTMappingRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+	case "eth.evm.v1.QueryFunNFTMappingRequest.token":
 		return x.Token != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11325,15 +14824,15 @@ func (x *fastReflection_QueryFunTokenMappingRequest) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFunTokenMappingRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFunNFTMappingRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+	case "eth.evm.v1.QueryFunNFTMappingRequest.token":
 		x.Token = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11343,16 +14842,16 @@ func (x *fastReflection_QueryFunTokenMappingRequest) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFunTokenMappingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunNFTMappingRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+	case "eth.evm.v1.QueryFunNFTMappingRequest.token":
 		value := x.Token
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11366,15 +14865,15 @@ func (x *fastReflection_QueryFunTokenMappingRequest) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFunTokenMappingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFunNFTMappingRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+	case "eth.evm.v1.QueryFunNFTMappingRequest.token":
 		x.Token = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11388,40 +14887,40 @@ func (x *fastReflection_QueryFunTokenMappingRequest) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFunTokenMappingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunNFTMappingRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
-		panic(fmt.Errorf("field token of message eth.evm.v1.QueryFunTokenMappingRequest is not mutable"))
+	case "eth.evm.v1.QueryFunNFTMappingRequest.token":
+		panic(fmt.Errorf("field token of message eth.evm.v1.QueryFunNFTMappingRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFunTokenMappingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunNFTMappingRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingRequest.token":
+	case "eth.evm.v1.QueryFunNFTMappingRequest.token":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingRequest"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFunTokenMappingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFunNFTMappingRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryFunTokenMappingRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryFunNFTMappingRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11429,7 +14928,7 @@ func (x *fastReflection_QueryFunTokenMappingRequest) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFunTokenMappingRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFunNFTMappingRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11440,7 +14939,7 @@ func (x *fastReflection_QueryFunTokenMappingRequest) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFunTokenMappingRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFunNFTMappingRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11452,7 +14951,7 @@ func (x *fastReflection_QueryFunTokenMappingRequest) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFunTokenMappingRequest) IsValid() bool {
+func (x *fastReflection_QueryFunNFTMappingRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -11462,9 +14961,9 @@ func (x *fastReflection_QueryFunTokenMappingRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFunTokenMappingRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFunNFTMappingRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFunTokenMappingRequest)
+		x := input.Message.Interface().(*QueryFunNFTMappingRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11490,7 +14989,7 @@ func (x *fastReflection_QueryFunTokenMappingRequest) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFunTokenMappingRequest)
+		x := input.Message.Interface().(*QueryFunNFTMappingRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11527,7 +15026,7 @@ func (x *fastReflection_QueryFunTokenMappingRequest) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFunTokenMappingRequest)
+		x := input.Message.Interface().(*QueryFunNFTMappingRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11559,10 +15058,10 @@ func (x *fastReflection_QueryFunTokenMappingRequest) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenMappingRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunNFTMappingRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunNFTMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -11633,26 +15132,26 @@ func (x *fastReflection_QueryFunTokenMappingRequest) ProtoMethods() *protoiface.
 }
 
 var (
-	md_QueryFunTokenMappingResponse           protoreflect.MessageDescriptor
-	fd_QueryFunTokenMappingResponse_fun_token protoreflect.FieldDescriptor
+	md_QueryFunNFTMappingResponse         protoreflect.MessageDescriptor
+	fd_QueryFunNFTMappingResponse_fun_nft protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_eth_evm_v1_query_proto_init()
-	md_QueryFunTokenMappingResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryFunTokenMappingResponse")
-	fd_QueryFunTokenMappingResponse_fun_token = md_QueryFunTokenMappingResponse.Fields().ByName("fun_token")
+	md_QueryFunNFTMappingResponse = File_eth_evm_v1_query_proto.Messages().ByName("QueryFunNFTMappingResponse")
+	fd_QueryFunNFTMappingResponse_fun_nft = md_QueryFunNFTMappingResponse.Fields().ByName("fun_nft")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFunTokenMappingResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFunNFTMappingResponse)(nil)
 
-type fastReflection_QueryFunTokenMappingResponse QueryFunTokenMappingResponse
+type fastReflection_QueryFunNFTMappingResponse QueryFunNFTMappingResponse
 
-func (x *QueryFunTokenMappingResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFunTokenMappingResponse)(x)
+func (x *QueryFunNFTMappingResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFunNFTMappingResponse)(x)
 }
 
-func (x *QueryFunTokenMappingResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_eth_evm_v1_query_proto_msgTypes[23]
+func (x *QueryFunNFTMappingResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_query_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11663,43 +15162,43 @@ func (x *QueryFunTokenMappingResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFunTokenMappingResponse_messageType fastReflection_QueryFunTokenMappingResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFunTokenMappingResponse_messageType{}
+var _fastReflection_QueryFunNFTMappingResponse_messageType fastReflection_QueryFunNFTMappingResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFunNFTMappingResponse_messageType{}
 
-type fastReflection_QueryFunTokenMappingResponse_messageType struct{}
+type fastReflection_QueryFunNFTMappingResponse_messageType struct{}
 
-func (x fastReflection_QueryFunTokenMappingResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFunTokenMappingResponse)(nil)
+func (x fastReflection_QueryFunNFTMappingResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFunNFTMappingResponse)(nil)
 }
-func (x fastReflection_QueryFunTokenMappingResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFunTokenMappingResponse)
+func (x fastReflection_QueryFunNFTMappingResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFunNFTMappingResponse)
 }
-func (x fastReflection_QueryFunTokenMappingResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFunTokenMappingResponse
+func (x fastReflection_QueryFunNFTMappingResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunNFTMappingResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFunTokenMappingResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFunTokenMappingResponse
+func (x *fastReflection_QueryFunNFTMappingResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFunNFTMappingResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFunTokenMappingResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFunTokenMappingResponse_messageType
+func (x *fastReflection_QueryFunNFTMappingResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFunNFTMappingResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFunTokenMappingResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryFunTokenMappingResponse)
+func (x *fastReflection_QueryFunNFTMappingResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFunNFTMappingResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFunTokenMappingResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryFunTokenMappingResponse)(x)
+func (x *fastReflection_QueryFunNFTMappingResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFunNFTMappingResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11707,10 +15206,10 @@ func (x *fastReflection_QueryFunTokenMappingResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFunTokenMappingResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.FunToken != nil {
-		value := protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
-		if !f(fd_QueryFunTokenMappingResponse_fun_token, value) {
+func (x *fastReflection_QueryFunNFTMappingResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FunNft != nil {
+		value := protoreflect.ValueOfMessage(x.FunNft.ProtoReflect())
+		if !f(fd_QueryFunNFTMappingResponse_fun_nft, value) {
 			return
 		}
 	}
@@ -11727,15 +15226,15 @@ func (x *fastReflection_QueryFunTokenMappingResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFunTokenMappingResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFunNFTMappingResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
-		return x.FunToken != nil
+	case "eth.evm.v1.QueryFunNFTMappingResponse.fun_nft":
+		return x.FunNft != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11745,15 +15244,15 @@ func (x *fastReflection_QueryFunTokenMappingResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFunTokenMappingResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFunNFTMappingResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
-		x.FunToken = nil
+	case "eth.evm.v1.QueryFunNFTMappingResponse.fun_nft":
+		x.FunNft = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11763,16 +15262,16 @@ func (x *fastReflection_QueryFunTokenMappingResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFunTokenMappingResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunNFTMappingResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
-		value := x.FunToken
+	case "eth.evm.v1.QueryFunNFTMappingResponse.fun_nft":
+		value := x.FunNft
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11786,15 +15285,15 @@ func (x *fastReflection_QueryFunTokenMappingResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFunTokenMappingResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFunNFTMappingResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
-		x.FunToken = value.Message().Interface().(*FunToken)
+	case "eth.evm.v1.QueryFunNFTMappingResponse.fun_nft":
+		x.FunNft = value.Message().Interface().(*FunNFT)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11808,44 +15307,44 @@ func (x *fastReflection_QueryFunTokenMappingResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFunTokenMappingResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunNFTMappingResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
-		if x.FunToken == nil {
-			x.FunToken = new(FunToken)
+	case "eth.evm.v1.QueryFunNFTMappingResponse.fun_nft":
+		if x.FunNft == nil {
+			x.FunNft = new(FunNFT)
 		}
-		return protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.FunNft.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFunTokenMappingResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFunNFTMappingResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "eth.evm.v1.QueryFunTokenMappingResponse.fun_token":
-		m := new(FunToken)
+	case "eth.evm.v1.QueryFunNFTMappingResponse.fun_nft":
+		m := new(FunNFT)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunTokenMappingResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.QueryFunNFTMappingResponse"))
 		}
-		panic(fmt.Errorf("message eth.evm.v1.QueryFunTokenMappingResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message eth.evm.v1.QueryFunNFTMappingResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFunTokenMappingResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFunNFTMappingResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryFunTokenMappingResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.QueryFunNFTMappingResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11853,7 +15352,7 @@ func (x *fastReflection_QueryFunTokenMappingResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFunTokenMappingResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFunNFTMappingResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11864,7 +15363,7 @@ func (x *fastReflection_QueryFunTokenMappingResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFunTokenMappingResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFunNFTMappingResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11876,7 +15375,7 @@ func (x *fastReflection_QueryFunTokenMappingResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFunTokenMappingResponse) IsValid() bool {
+func (x *fastReflection_QueryFunNFTMappingResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11886,9 +15385,9 @@ func (x *fastReflection_QueryFunTokenMappingResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFunTokenMappingResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFunNFTMappingResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFunTokenMappingResponse)
+		x := input.Message.Interface().(*QueryFunNFTMappingResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11900,8 +15399,8 @@ func (x *fastReflection_QueryFunTokenMappingResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
-		if x.FunToken != nil {
-			l = options.Size(x.FunToken)
+		if x.FunNft != nil {
+			l = options.Size(x.FunNft)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -11914,7 +15413,7 @@ func (x *fastReflection_QueryFunTokenMappingResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFunTokenMappingResponse)
+		x := input.Message.Interface().(*QueryFunNFTMappingResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11933,8 +15432,8 @@ func (x *fastReflection_QueryFunTokenMappingResponse) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.FunToken != nil {
-			encoded, err := options.Marshal(x.FunToken)
+		if x.FunNft != nil {
+			encoded, err := options.Marshal(x.FunNft)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11958,7 +15457,7 @@ func (x *fastReflection_QueryFunTokenMappingResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFunTokenMappingResponse)
+		x := input.Message.Interface().(*QueryFunNFTMappingResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11990,15 +15489,15 @@ func (x *fastReflection_QueryFunTokenMappingResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenMappingResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunNFTMappingResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFunNFTMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunNft", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -12025,10 +15524,10 @@ func (x *fastReflection_QueryFunTokenMappingResponse) ProtoMethods() *protoiface
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.FunToken == nil {
-					x.FunToken = &FunToken{}
+				if x.FunNft == nil {
+					x.FunNft = &FunNFT{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FunToken); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FunNft); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -12464,6 +15963,159 @@ func (x *QueryStorageResponse) GetValue() string {
 	return ""
 }
 
+// QueryStorageProofRequest is the request type for the Query/StorageProof RPC
+// method.
+type QueryStorageProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the ethereum hex address to query the storage proofs for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// keys defines the keys of the storage slots to prove.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *QueryStorageProofRequest) Reset() {
+	*x = QueryStorageProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStorageProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStorageProofRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryStorageProofRequest.ProtoReflect.Descriptor instead.
+func (*QueryStorageProofRequest) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryStorageProofRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryStorageProofRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// QueryStorageProofResponse is the response type for the Query/StorageProof
+// RPC method.
+type QueryStorageProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// storage_root is the hex-encoded root hash of the Ethereum storage trie of
+	// the account.
+	StorageRoot string `protobuf:"bytes,1,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+	// proofs defines the proofs of the storage slots, in the order of the keys
+	// of the request.
+	Proofs []*StorageSlotProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *QueryStorageProofResponse) Reset() {
+	*x = QueryStorageProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStorageProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStorageProofResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryStorageProofResponse.ProtoReflect.Descriptor instead.
+func (*QueryStorageProofResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryStorageProofResponse) GetStorageRoot() string {
+	if x != nil {
+		return x.StorageRoot
+	}
+	return ""
+}
+
+func (x *QueryStorageProofResponse) GetProofs() []*StorageSlotProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+// StorageSlotProof is the value of a storage slot and its Merkle proof against
+// the storage root of the account.
+type StorageSlotProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key defines the key of the storage slot.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value defines the storage state value hash of the slot.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// proof is the list of RLP-encoded trie nodes on the path from the storage
+	// root to the slot.
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *StorageSlotProof) Reset() {
+	*x = StorageSlotProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageSlotProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageSlotProof) ProtoMessage() {}
+
+// Deprecated: Use StorageSlotProof.ProtoReflect.Descriptor instead.
+func (*StorageSlotProof) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StorageSlotProof) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StorageSlotProof) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *StorageSlotProof) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	state         protoimpl.MessageState
@@ -12477,7 +16129,7 @@ type QueryCodeRequest struct {
 func (x *QueryCodeRequest) Reset() {
 	*x = QueryCodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[8]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12491,7 +16143,7 @@ func (*QueryCodeRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryCodeRequest.ProtoReflect.Descriptor instead.
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryCodeRequest) GetAddress() string {
@@ -12515,7 +16167,7 @@ type QueryCodeResponse struct {
 func (x *QueryCodeResponse) Reset() {
 	*x = QueryCodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[9]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12529,7 +16181,7 @@ func (*QueryCodeResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryCodeResponse.ProtoReflect.Descriptor instead.
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryCodeResponse) GetCode() []byte {
@@ -12554,7 +16206,7 @@ type QueryTxLogsRequest struct {
 func (x *QueryTxLogsRequest) Reset() {
 	*x = QueryTxLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[10]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12568,7 +16220,7 @@ func (*QueryTxLogsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTxLogsRequest.ProtoReflect.Descriptor instead.
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryTxLogsRequest) GetHash() string {
@@ -12600,7 +16252,7 @@ type QueryTxLogsResponse struct {
 func (x *QueryTxLogsResponse) Reset() {
 	*x = QueryTxLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[11]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12614,7 +16266,7 @@ func (*QueryTxLogsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTxLogsResponse.ProtoReflect.Descriptor instead.
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryTxLogsResponse) GetLogs() []*Log {
@@ -12641,7 +16293,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[12]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12655,7 +16307,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 // QueryParamsResponse defines the response type for querying x/evm parameters.
@@ -12671,7 +16323,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[13]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12685,7 +16337,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -12714,7 +16366,7 @@ type EthCallRequest struct {
 func (x *EthCallRequest) Reset() {
 	*x = EthCallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[14]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12728,7 +16380,7 @@ func (*EthCallRequest) ProtoMessage() {}
 
 // Deprecated: Use EthCallRequest.ProtoReflect.Descriptor instead.
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *EthCallRequest) GetArgs() []byte {
@@ -12772,7 +16424,7 @@ type EstimateGasResponse struct {
 func (x *EstimateGasResponse) Reset() {
 	*x = EstimateGasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[15]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12786,7 +16438,7 @@ func (*EstimateGasResponse) ProtoMessage() {}
 
 // Deprecated: Use EstimateGasResponse.ProtoReflect.Descriptor instead.
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *EstimateGasResponse) GetGas() uint64 {
@@ -12826,7 +16478,7 @@ type QueryTraceTxRequest struct {
 func (x *QueryTraceTxRequest) Reset() {
 	*x = QueryTraceTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[16]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12840,7 +16492,7 @@ func (*QueryTraceTxRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceTxRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
@@ -12919,7 +16571,7 @@ type QueryTraceTxResponse struct {
 func (x *QueryTraceTxResponse) Reset() {
 	*x = QueryTraceTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[17]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12933,7 +16585,7 @@ func (*QueryTraceTxResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceTxResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryTraceTxResponse) GetData() []byte {
@@ -12970,7 +16622,7 @@ type QueryTraceBlockRequest struct {
 func (x *QueryTraceBlockRequest) Reset() {
 	*x = QueryTraceBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[18]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12984,7 +16636,7 @@ func (*QueryTraceBlockRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryTraceBlockRequest) GetTxs() []*MsgEthereumTx {
@@ -13056,7 +16708,7 @@ type QueryTraceBlockResponse struct {
 func (x *QueryTraceBlockResponse) Reset() {
 	*x = QueryTraceBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[19]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13070,7 +16722,7 @@ func (*QueryTraceBlockResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryTraceBlockResponse) GetData() []byte {
@@ -13091,7 +16743,7 @@ type QueryBaseFeeRequest struct {
 func (x *QueryBaseFeeRequest) Reset() {
 	*x = QueryBaseFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[20]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13105,7 +16757,7 @@ func (*QueryBaseFeeRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeRequest.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
 // QueryBaseFeeResponse returns the EIP1559 base fee.
@@ -13124,7 +16776,7 @@ type QueryBaseFeeResponse struct {
 func (x *QueryBaseFeeResponse) Reset() {
 	*x = QueryBaseFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[21]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13138,7 +16790,7 @@ func (*QueryBaseFeeResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeResponse.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QueryBaseFeeResponse) GetBaseFee() string {
@@ -13168,7 +16820,7 @@ type QueryFunTokenMappingRequest struct {
 func (x *QueryFunTokenMappingRequest) Reset() {
 	*x = QueryFunTokenMappingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[22]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13182,7 +16834,7 @@ func (*QueryFunTokenMappingRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryFunTokenMappingRequest.ProtoReflect.Descriptor instead.
 func (*QueryFunTokenMappingRequest) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryFunTokenMappingRequest) GetToken() string {
@@ -13204,7 +16856,7 @@ type QueryFunTokenMappingResponse struct {
 func (x *QueryFunTokenMappingResponse) Reset() {
 	*x = QueryFunTokenMappingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_query_proto_msgTypes[23]
+		mi := &file_eth_evm_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13218,7 +16870,7 @@ func (*QueryFunTokenMappingResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryFunTokenMappingResponse.ProtoReflect.Descriptor instead.
 func (*QueryFunTokenMappingResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QueryFunTokenMappingResponse) GetFunToken() *FunToken {
@@ -13228,6 +16880,163 @@ func (x *QueryFunTokenMappingResponse) GetFunToken() *FunToken {
 	return nil
 }
 
+type QueryFunTokenEscrowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Either the hexadecimal-encoded ERC20 contract address or denomination of the
+	// Bank Coin.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *QueryFunTokenEscrowRequest) Reset() {
+	*x = QueryFunTokenEscrowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_query_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFunTokenEscrowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFunTokenEscrowRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryFunTokenEscrowRequest.ProtoReflect.Descriptor instead.
+func (*QueryFunTokenEscrowRequest) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QueryFunTokenEscrowRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type QueryFunTokenEscrowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fun_token is a mapping between the Bank Coin and the ERC20 contract address
+	FunToken *FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token,omitempty"`
+	// escrow compares the ERC20 tokens escrowed for the mapping with the supply
+	// of its Bank Coin
+	Escrow *FunTokenEscrow `protobuf:"bytes,2,opt,name=escrow,proto3" json:"escrow,omitempty"`
+}
+
+func (x *QueryFunTokenEscrowResponse) Reset() {
+	*x = QueryFunTokenEscrowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_query_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFunTokenEscrowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFunTokenEscrowResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryFunTokenEscrowResponse.ProtoReflect.Descriptor instead.
+func (*QueryFunTokenEscrowResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueryFunTokenEscrowResponse) GetFunToken() *FunToken {
+	if x != nil {
+		return x.FunToken
+	}
+	return nil
+}
+
+func (x *QueryFunTokenEscrowResponse) GetEscrow() *FunTokenEscrow {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+type QueryFunNFTMappingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Either the hexadecimal-encoded ERC-721 contract address or ID of the
+	// "x/nft" class.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *QueryFunNFTMappingRequest) Reset() {
+	*x = QueryFunNFTMappingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_query_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFunNFTMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFunNFTMappingRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryFunNFTMappingRequest.ProtoReflect.Descriptor instead.
+func (*QueryFunNFTMappingRequest) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QueryFunNFTMappingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type QueryFunNFTMappingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fun_nft is a mapping between the "x/nft" class and the ERC-721 contract
+	// address
+	FunNft *FunNFT `protobuf:"bytes,1,opt,name=fun_nft,json=funNft,proto3" json:"fun_nft,omitempty"`
+}
+
+func (x *QueryFunNFTMappingResponse) Reset() {
+	*x = QueryFunNFTMappingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_query_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFunNFTMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFunNFTMappingResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryFunNFTMappingResponse.ProtoReflect.Descriptor instead.
+func (*QueryFunNFTMappingResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_query_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QueryFunNFTMappingResponse) GetFunNft() *FunNFT {
+	if x != nil {
+		return x.FunNft
+	}
+	return nil
+}
+
 var File_eth_evm_v1_query_proto protoreflect.FileDescriptor
 
 var file_eth_evm_v1_query_proto_rawDesc = []byte{
@@ -13291,242 +17100,309 @@ var file_eth_evm_v1_query_proto_rawDesc = []byte{
 	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x2c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
 	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
 	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x27, 0x0a, 0x11,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78,
-	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
-	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
-	0x00, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x6c, 0x6f, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x47,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a,
-	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61,
-	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x67, 0x61, 0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
-	0x22, 0x27, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x22, 0xf2, 0x03, 0x0a, 0x13, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45,
-	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x3a,
-	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x72,
-	0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x3a, 0x08,
+	0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x7a, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x6f,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x22, 0x50, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x6c, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x36, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x27,
+	0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x00, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x6c,
+	0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x47, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x67, 0x61, 0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x22, 0x27, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x22, 0xf2, 0x03, 0x0a, 0x13,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x0c, 0x70, 0x72, 0x65,
-	0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
-	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x4a, 0x04,
-	0x08, 0x02, 0x10, 0x03, 0x52, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2a,
-	0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa6, 0x03, 0x0a, 0x16, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3d, 0x0a, 0x0c,
+	0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x74,
-	0x78, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21,
-	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x43, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78,
-	0x47, 0x61, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46,
-	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52,
-	0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x62, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x62, 0x61, 0x73,
-	0x65, 0x46, 0x65, 0x65, 0x55, 0x6e, 0x69, 0x62, 0x69, 0x22, 0x3d, 0x0a, 0x1b, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x08,
-	0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x5b, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x74,
-	0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f,
-	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x32, 0xb6, 0x0c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x83, 0x01, 0x0a, 0x0a, 0x45, 0x74, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22,
-	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x45, 0x74, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x74, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12,
-	0x24, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x65, 0x74, 0x68, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xa0, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x65, 0x74, 0x68,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
-	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x77, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21,
-	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x7d, 0x12, 0x7c, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x65,
-	0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
-	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12,
-	0x6b, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64,
-	0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x68, 0x0a, 0x06,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
-	0x15, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x69, 0x0a, 0x07, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c,
-	0x6c, 0x12, 0x1a, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x63, 0x61, 0x6c,
-	0x6c, 0x12, 0x6f, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73,
-	0x12, 0x1a, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74,
-	0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65,
-	0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65,
-	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x67,
-	0x61, 0x73, 0x12, 0x6d, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x12, 0x1f, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde,
+	0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x2a, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa6, 0x03, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52,
+	0x03, 0x74, 0x78, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67,
+	0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d,
+	0x61, 0x78, 0x47, 0x61, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73,
+	0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x14,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x62, 0x69, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x62,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x55, 0x6e, 0x69, 0x62, 0x69, 0x22, 0x3d, 0x0a, 0x1b, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x5b, 0x0a, 0x1c, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x66, 0x75,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x08, 0x88,
+	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x3c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x9a, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46,
+	0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38,
+	0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x22, 0x3b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x4e, 0x46,
+	0x54, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
+	0x53, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x4e, 0x46, 0x54, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x07, 0x66, 0x75, 0x6e, 0x5f, 0x6e, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x4e,
+	0x46, 0x54, 0x52, 0x06, 0x66, 0x75, 0x6e, 0x4e, 0x66, 0x74, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x32, 0xe0, 0x0f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x83,
+	0x01, 0x0a, 0x0a, 0x45, 0x74, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e,
 	0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x74,
-	0x78, 0x12, 0x79, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x22, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
-	0x12, 0x1a, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x71, 0x0a, 0x09,
-	0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e,
-	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x74, 0x68,
+	0x45, 0x74, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x45, 0x74, 0x68, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24,
+	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x74, 0x68, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x12, 0xa0, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x77, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0x7c, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x7b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x8b,
+	0x01, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x24, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x6b, 0x0a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2f,
+	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x68, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x69, 0x0a, 0x07, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1a,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43,
+	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x6f,
+	0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1a, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x12,
+	0x6d, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68,
 	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61,
-	0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76,
-	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x12,
-	0x6d, 0x0a, 0x07, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73,
-	0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x74,
-	0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61,
-	0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x74,
+	0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
 	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65,
-	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x8d,
-	0x01, 0x0a, 0x0f, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x12, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x74,
-	0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x75,
-	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f,
-	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x75,
-	0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x42, 0x89,
-	0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45,
-	0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45, 0x74,
-	0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x78, 0x12, 0x79,
+	0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x71, 0x0a, 0x09, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x12, 0x19, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x6d, 0x0a, 0x07,
+	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x19, 0x12, 0x17, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x0f,
+	0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12,
+	0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x75, 0x6e, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x12, 0x91, 0x01, 0x0a, 0x0e,
+	0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x26,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x12,
+	0x85, 0x01, 0x0a, 0x0d, 0x46, 0x75, 0x6e, 0x4e, 0x46, 0x54, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x25, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x4e, 0x46, 0x54, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x75, 0x6e, 0x4e, 0x46,
+	0x54, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x75, 0x6e, 0x6e, 0x66, 0x74, 0x2f,
+	0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x42, 0x89, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45,
+	0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74,
+	0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -13541,7 +17417,7 @@ func file_eth_evm_v1_query_proto_rawDescGZIP() []byte {
 	return file_eth_evm_v1_query_proto_rawDescData
 }
 
-var file_eth_evm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_eth_evm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_eth_evm_v1_query_proto_goTypes = []interface{}{
 	(*QueryEthAccountRequest)(nil),        // 0: eth.evm.v1.QueryEthAccountRequest
 	(*QueryEthAccountResponse)(nil),       // 1: eth.evm.v1.QueryEthAccountResponse
@@ -13551,76 +17427,95 @@ var file_eth_evm_v1_query_proto_goTypes = []interface{}{
 	(*QueryBalanceResponse)(nil),          // 5: eth.evm.v1.QueryBalanceResponse
 	(*QueryStorageRequest)(nil),           // 6: eth.evm.v1.QueryStorageRequest
 	(*QueryStorageResponse)(nil),          // 7: eth.evm.v1.QueryStorageResponse
-	(*QueryCodeRequest)(nil),              // 8: eth.evm.v1.QueryCodeRequest
-	(*QueryCodeResponse)(nil),             // 9: eth.evm.v1.QueryCodeResponse
-	(*QueryTxLogsRequest)(nil),            // 10: eth.evm.v1.QueryTxLogsRequest
-	(*QueryTxLogsResponse)(nil),           // 11: eth.evm.v1.QueryTxLogsResponse
-	(*QueryParamsRequest)(nil),            // 12: eth.evm.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),           // 13: eth.evm.v1.QueryParamsResponse
-	(*EthCallRequest)(nil),                // 14: eth.evm.v1.EthCallRequest
-	(*EstimateGasResponse)(nil),           // 15: eth.evm.v1.EstimateGasResponse
-	(*QueryTraceTxRequest)(nil),           // 16: eth.evm.v1.QueryTraceTxRequest
-	(*QueryTraceTxResponse)(nil),          // 17: eth.evm.v1.QueryTraceTxResponse
-	(*QueryTraceBlockRequest)(nil),        // 18: eth.evm.v1.QueryTraceBlockRequest
-	(*QueryTraceBlockResponse)(nil),       // 19: eth.evm.v1.QueryTraceBlockResponse
-	(*QueryBaseFeeRequest)(nil),           // 20: eth.evm.v1.QueryBaseFeeRequest
-	(*QueryBaseFeeResponse)(nil),          // 21: eth.evm.v1.QueryBaseFeeResponse
-	(*QueryFunTokenMappingRequest)(nil),   // 22: eth.evm.v1.QueryFunTokenMappingRequest
-	(*QueryFunTokenMappingResponse)(nil),  // 23: eth.evm.v1.QueryFunTokenMappingResponse
-	(*v1beta1.PageRequest)(nil),           // 24: cosmos.base.query.v1beta1.PageRequest
-	(*Log)(nil),                           // 25: eth.evm.v1.Log
-	(*v1beta1.PageResponse)(nil),          // 26: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),                        // 27: eth.evm.v1.Params
-	(*MsgEthereumTx)(nil),                 // 28: eth.evm.v1.MsgEthereumTx
-	(*TraceConfig)(nil),                   // 29: eth.evm.v1.TraceConfig
-	(*timestamppb.Timestamp)(nil),         // 30: google.protobuf.Timestamp
-	(*FunToken)(nil),                      // 31: eth.evm.v1.FunToken
-	(*MsgEthereumTxResponse)(nil),         // 32: eth.evm.v1.MsgEthereumTxResponse
+	(*QueryStorageProofRequest)(nil),      // 8: eth.evm.v1.QueryStorageProofRequest
+	(*QueryStorageProofResponse)(nil),     // 9: eth.evm.v1.QueryStorageProofResponse
+	(*StorageSlotProof)(nil),              // 10: eth.evm.v1.StorageSlotProof
+	(*QueryCodeRequest)(nil),              // 11: eth.evm.v1.QueryCodeRequest
+	(*QueryCodeResponse)(nil),             // 12: eth.evm.v1.QueryCodeResponse
+	(*QueryTxLogsRequest)(nil),            // 13: eth.evm.v1.QueryTxLogsRequest
+	(*QueryTxLogsResponse)(nil),           // 14: eth.evm.v1.QueryTxLogsResponse
+	(*QueryParamsRequest)(nil),            // 15: eth.evm.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),           // 16: eth.evm.v1.QueryParamsResponse
+	(*EthCallRequest)(nil),                // 17: eth.evm.v1.EthCallRequest
+	(*EstimateGasResponse)(nil),           // 18: eth.evm.v1.EstimateGasResponse
+	(*QueryTraceTxRequest)(nil),           // 19: eth.evm.v1.QueryTraceTxRequest
+	(*QueryTraceTxResponse)(nil),          // 20: eth.evm.v1.QueryTraceTxResponse
+	(*QueryTraceBlockRequest)(nil),        // 21: eth.evm.v1.QueryTraceBlockRequest
+	(*QueryTraceBlockResponse)(nil),       // 22: eth.evm.v1.QueryTraceBlockResponse
+	(*QueryBaseFeeRequest)(nil),           // 23: eth.evm.v1.QueryBaseFeeRequest
+	(*QueryBaseFeeResponse)(nil),          // 24: eth.evm.v1.QueryBaseFeeResponse
+	(*QueryFunTokenMappingRequest)(nil),   // 25: eth.evm.v1.QueryFunTokenMappingRequest
+	(*QueryFunTokenMappingResponse)(nil),  // 26: eth.evm.v1.QueryFunTokenMappingResponse
+	(*QueryFunTokenEscrowRequest)(nil),    // 27: eth.evm.v1.QueryFunTokenEscrowRequest
+	(*QueryFunTokenEscrowResponse)(nil),   // 28: eth.evm.v1.QueryFunTokenEscrowResponse
+	(*QueryFunNFTMappingRequest)(nil),     // 29: eth.evm.v1.QueryFunNFTMappingRequest
+	(*QueryFunNFTMappingResponse)(nil),    // 30: eth.evm.v1.QueryFunNFTMappingResponse
+	(*v1beta1.PageRequest)(nil),           // 31: cosmos.base.query.v1beta1.PageRequest
+	(*Log)(nil),                           // 32: eth.evm.v1.Log
+	(*v1beta1.PageResponse)(nil),          // 33: cosmos.base.query.v1beta1.PageResponse
+	(*Params)(nil),                        // 34: eth.evm.v1.Params
+	(*MsgEthereumTx)(nil),                 // 35: eth.evm.v1.MsgEthereumTx
+	(*TraceConfig)(nil),                   // 36: eth.evm.v1.TraceConfig
+	(*timestamppb.Timestamp)(nil),         // 37: google.protobuf.Timestamp
+	(*FunToken)(nil),                      // 38: eth.evm.v1.FunToken
+	(*FunTokenEscrow)(nil),                // 39: eth.evm.v1.FunTokenEscrow
+	(*FunNFT)(nil),                        // 40: eth.evm.v1.FunNFT
+	(*MsgEthereumTxResponse)(nil),         // 41: eth.evm.v1.MsgEthereumTxResponse
 }
 var file_eth_evm_v1_query_proto_depIdxs = []int32{
-	24, // 0: eth.evm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	25, // 1: eth.evm.v1.QueryTxLogsResponse.logs:type_name -> eth.evm.v1.Log
-	26, // 2: eth.evm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	27, // 3: eth.evm.v1.QueryParamsResponse.params:type_name -> eth.evm.v1.Params
-	28, // 4: eth.evm.v1.QueryTraceTxRequest.msg:type_name -> eth.evm.v1.MsgEthereumTx
-	29, // 5: eth.evm.v1.QueryTraceTxRequest.trace_config:type_name -> eth.evm.v1.TraceConfig
-	28, // 6: eth.evm.v1.QueryTraceTxRequest.predecessors:type_name -> eth.evm.v1.MsgEthereumTx
-	30, // 7: eth.evm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
-	28, // 8: eth.evm.v1.QueryTraceBlockRequest.txs:type_name -> eth.evm.v1.MsgEthereumTx
-	29, // 9: eth.evm.v1.QueryTraceBlockRequest.trace_config:type_name -> eth.evm.v1.TraceConfig
-	30, // 10: eth.evm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
-	31, // 11: eth.evm.v1.QueryFunTokenMappingResponse.fun_token:type_name -> eth.evm.v1.FunToken
-	0,  // 12: eth.evm.v1.Query.EthAccount:input_type -> eth.evm.v1.QueryEthAccountRequest
-	2,  // 13: eth.evm.v1.Query.ValidatorAccount:input_type -> eth.evm.v1.QueryValidatorAccountRequest
-	4,  // 14: eth.evm.v1.Query.Balance:input_type -> eth.evm.v1.QueryBalanceRequest
-	6,  // 15: eth.evm.v1.Query.Storage:input_type -> eth.evm.v1.QueryStorageRequest
-	8,  // 16: eth.evm.v1.Query.Code:input_type -> eth.evm.v1.QueryCodeRequest
-	12, // 17: eth.evm.v1.Query.Params:input_type -> eth.evm.v1.QueryParamsRequest
-	14, // 18: eth.evm.v1.Query.EthCall:input_type -> eth.evm.v1.EthCallRequest
-	14, // 19: eth.evm.v1.Query.EstimateGas:input_type -> eth.evm.v1.EthCallRequest
-	16, // 20: eth.evm.v1.Query.TraceTx:input_type -> eth.evm.v1.QueryTraceTxRequest
-	18, // 21: eth.evm.v1.Query.TraceBlock:input_type -> eth.evm.v1.QueryTraceBlockRequest
-	16, // 22: eth.evm.v1.Query.TraceCall:input_type -> eth.evm.v1.QueryTraceTxRequest
-	20, // 23: eth.evm.v1.Query.BaseFee:input_type -> eth.evm.v1.QueryBaseFeeRequest
-	22, // 24: eth.evm.v1.Query.FunTokenMapping:input_type -> eth.evm.v1.QueryFunTokenMappingRequest
-	1,  // 25: eth.evm.v1.Query.EthAccount:output_type -> eth.evm.v1.QueryEthAccountResponse
-	3,  // 26: eth.evm.v1.Query.ValidatorAccount:output_type -> eth.evm.v1.QueryValidatorAccountResponse
-	5,  // 27: eth.evm.v1.Query.Balance:output_type -> eth.evm.v1.QueryBalanceResponse
-	7,  // 28: eth.evm.v1.Query.Storage:output_type -> eth.evm.v1.QueryStorageResponse
-	9,  // 29: eth.evm.v1.Query.Code:output_type -> eth.evm.v1.QueryCodeResponse
-	13, // 30: eth.evm.v1.Query.Params:output_type -> eth.evm.v1.QueryParamsResponse
-	32, // 31: eth.evm.v1.Query.EthCall:output_type -> eth.evm.v1.MsgEthereumTxResponse
-	15, // 32: eth.evm.v1.Query.EstimateGas:output_type -> eth.evm.v1.EstimateGasResponse
-	17, // 33: eth.evm.v1.Query.TraceTx:output_type -> eth.evm.v1.QueryTraceTxResponse
-	19, // 34: eth.evm.v1.Query.TraceBlock:output_type -> eth.evm.v1.QueryTraceBlockResponse
-	17, // 35: eth.evm.v1.Query.TraceCall:output_type -> eth.evm.v1.QueryTraceTxResponse
-	21, // 36: eth.evm.v1.Query.BaseFee:output_type -> eth.evm.v1.QueryBaseFeeResponse
-	23, // 37: eth.evm.v1.Query.FunTokenMapping:output_type -> eth.evm.v1.QueryFunTokenMappingResponse
-	25, // [25:38] is the sub-list for method output_type
-	12, // [12:25] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	10, // 0: eth.evm.v1.QueryStorageProofResponse.proofs:type_name -> eth.evm.v1.StorageSlotProof
+	31, // 1: eth.evm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 2: eth.evm.v1.QueryTxLogsResponse.logs:type_name -> eth.evm.v1.Log
+	33, // 3: eth.evm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	34, // 4: eth.evm.v1.QueryParamsResponse.params:type_name -> eth.evm.v1.Params
+	35, // 5: eth.evm.v1.QueryTraceTxRequest.msg:type_name -> eth.evm.v1.MsgEthereumTx
+	36, // 6: eth.evm.v1.QueryTraceTxRequest.trace_config:type_name -> eth.evm.v1.TraceConfig
+	35, // 7: eth.evm.v1.QueryTraceTxRequest.predecessors:type_name -> eth.evm.v1.MsgEthereumTx
+	37, // 8: eth.evm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
+	35, // 9: eth.evm.v1.QueryTraceBlockRequest.txs:type_name -> eth.evm.v1.MsgEthereumTx
+	36, // 10: eth.evm.v1.QueryTraceBlockRequest.trace_config:type_name -> eth.evm.v1.TraceConfig
+	37, // 11: eth.evm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
+	38, // 12: eth.evm.v1.QueryFunTokenMappingResponse.fun_token:type_name -> eth.evm.v1.FunToken
+	38, // 13: eth.evm.v1.QueryFunTokenEscrowResponse.fun_token:type_name -> eth.evm.v1.FunToken
+	39, // 14: eth.evm.v1.QueryFunTokenEscrowResponse.escrow:type_name -> eth.evm.v1.FunTokenEscrow
+	40, // 15: eth.evm.v1.QueryFunNFTMappingResponse.fun_nft:type_name -> eth.evm.v1.FunNFT
+	0,  // 16: eth.evm.v1.Query.EthAccount:input_type -> eth.evm.v1.QueryEthAccountRequest
+	2,  // 17: eth.evm.v1.Query.ValidatorAccount:input_type -> eth.evm.v1.QueryValidatorAccountRequest
+	4,  // 18: eth.evm.v1.Query.Balance:input_type -> eth.evm.v1.QueryBalanceRequest
+	6,  // 19: eth.evm.v1.Query.Storage:input_type -> eth.evm.v1.QueryStorageRequest
+	8,  // 20: eth.evm.v1.Query.StorageProof:input_type -> eth.evm.v1.QueryStorageProofRequest
+	11, // 21: eth.evm.v1.Query.Code:input_type -> eth.evm.v1.QueryCodeRequest
+	15, // 22: eth.evm.v1.Query.Params:input_type -> eth.evm.v1.QueryParamsRequest
+	17, // 23: eth.evm.v1.Query.EthCall:input_type -> eth.evm.v1.EthCallRequest
+	17, // 24: eth.evm.v1.Query.EstimateGas:input_type -> eth.evm.v1.EthCallRequest
+	19, // 25: eth.evm.v1.Query.TraceTx:input_type -> eth.evm.v1.QueryTraceTxRequest
+	21, // 26: eth.evm.v1.Query.TraceBlock:input_type -> eth.evm.v1.QueryTraceBlockRequest
+	19, // 27: eth.evm.v1.Query.TraceCall:input_type -> eth.evm.v1.QueryTraceTxRequest
+	23, // 28: eth.evm.v1.Query.BaseFee:input_type -> eth.evm.v1.QueryBaseFeeRequest
+	25, // 29: eth.evm.v1.Query.FunTokenMapping:input_type -> eth.evm.v1.QueryFunTokenMappingRequest
+	27, // 30: eth.evm.v1.Query.FunTokenEscrow:input_type -> eth.evm.v1.QueryFunTokenEscrowRequest
+	29, // 31: eth.evm.v1.Query.FunNFTMapping:input_type -> eth.evm.v1.QueryFunNFTMappingRequest
+	1,  // 32: eth.evm.v1.Query.EthAccount:output_type -> eth.evm.v1.QueryEthAccountResponse
+	3,  // 33: eth.evm.v1.Query.ValidatorAccount:output_type -> eth.evm.v1.QueryValidatorAccountResponse
+	5,  // 34: eth.evm.v1.Query.Balance:output_type -> eth.evm.v1.QueryBalanceResponse
+	7,  // 35: eth.evm.v1.Query.Storage:output_type -> eth.evm.v1.QueryStorageResponse
+	9,  // 36: eth.evm.v1.Query.StorageProof:output_type -> eth.evm.v1.QueryStorageProofResponse
+	12, // 37: eth.evm.v1.Query.Code:output_type -> eth.evm.v1.QueryCodeResponse
+	16, // 38: eth.evm.v1.Query.Params:output_type -> eth.evm.v1.QueryParamsResponse
+	41, // 39: eth.evm.v1.Query.EthCall:output_type -> eth.evm.v1.MsgEthereumTxResponse
+	18, // 40: eth.evm.v1.Query.EstimateGas:output_type -> eth.evm.v1.EstimateGasResponse
+	20, // 41: eth.evm.v1.Query.TraceTx:output_type -> eth.evm.v1.QueryTraceTxResponse
+	22, // 42: eth.evm.v1.Query.TraceBlock:output_type -> eth.evm.v1.QueryTraceBlockResponse
+	20, // 43: eth.evm.v1.Query.TraceCall:output_type -> eth.evm.v1.QueryTraceTxResponse
+	24, // 44: eth.evm.v1.Query.BaseFee:output_type -> eth.evm.v1.QueryBaseFeeResponse
+	26, // 45: eth.evm.v1.Query.FunTokenMapping:output_type -> eth.evm.v1.QueryFunTokenMappingResponse
+	28, // 46: eth.evm.v1.Query.FunTokenEscrow:output_type -> eth.evm.v1.QueryFunTokenEscrowResponse
+	30, // 47: eth.evm.v1.Query.FunNFTMapping:output_type -> eth.evm.v1.QueryFunNFTMappingResponse
+	32, // [32:48] is the sub-list for method output_type
+	16, // [16:32] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_eth_evm_v1_query_proto_init() }
@@ -13728,7 +17623,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCodeRequest); i {
+			switch v := v.(*QueryStorageProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13740,7 +17635,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCodeResponse); i {
+			switch v := v.(*QueryStorageProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13752,7 +17647,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTxLogsRequest); i {
+			switch v := v.(*StorageSlotProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13764,7 +17659,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTxLogsResponse); i {
+			switch v := v.(*QueryCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13776,7 +17671,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13788,7 +17683,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsResponse); i {
+			switch v := v.(*QueryTxLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13800,7 +17695,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthCallRequest); i {
+			switch v := v.(*QueryTxLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13812,7 +17707,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateGasResponse); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13824,7 +17719,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxRequest); i {
+			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13836,7 +17731,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxResponse); i {
+			switch v := v.(*EthCallRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13848,7 +17743,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockRequest); i {
+			switch v := v.(*EstimateGasResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13860,7 +17755,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockResponse); i {
+			switch v := v.(*QueryTraceTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13872,7 +17767,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeRequest); i {
+			switch v := v.(*QueryTraceTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13884,7 +17779,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeResponse); i {
+			switch v := v.(*QueryTraceBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13896,7 +17791,7 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFunTokenMappingRequest); i {
+			switch v := v.(*QueryTraceBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13908,6 +17803,42 @@ func file_eth_evm_v1_query_proto_init() {
 			}
 		}
 		file_eth_evm_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBaseFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBaseFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFunTokenMappingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryFunTokenMappingResponse); i {
 			case 0:
 				return &v.state
@@ -13919,6 +17850,54 @@ func file_eth_evm_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_eth_evm_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFunTokenEscrowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFunTokenEscrowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFunNFTMappingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFunNFTMappingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -13926,7 +17905,7 @@ func file_eth_evm_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eth_evm_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,6 +33,12 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// StorageProof queries the root of the Ethereum storage trie of an account,
+	// built from its contract storage, and the Merkle proofs of storage slots
+	// against it. It implements the storage part of "eth_getProof". The root is
+	// not committed by consensus, so the proofs are not verifiable against a
+	// block header. Accounts with more than 100,000 storage slots are refused.
+	StorageProof(ctx context.Context, in *QueryStorageProofRequest, opts ...grpc.CallOption) (*QueryStorageProofResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
@@ -51,6 +57,12 @@ type QueryClient interface {
 	// Similar to feemarket module's method
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(ctx context.Context, in *QueryFunTokenMappingRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingResponse, error)
+	// FunTokenEscrow reports the ERC20 tokens escrowed by the EVM module for a
+	// "FunToken" created from an ERC20 against the supply of its Bank Coin.
+	FunTokenEscrow(ctx context.Context, in *QueryFunTokenEscrowRequest, opts ...grpc.CallOption) (*QueryFunTokenEscrowResponse, error)
+	// FunNFTMapping returns the "FunNFT" mapping of an ERC-721 collection or
+	// "x/nft" class.
+	FunNFTMapping(ctx context.Context, in *QueryFunNFTMappingRequest, opts ...grpc.CallOption) (*QueryFunNFTMappingResponse, error)
 }
 
 type queryClient struct {
@@ -97,6 +109,15 @@ func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) StorageProof(ctx context.Context, in *QueryStorageProofRequest, opts ...grpc.CallOption) (*QueryStorageProofResponse, error) {
+	out := new(QueryStorageProofResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/StorageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
 	out := new(QueryCodeResponse)
 	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/Code", in, out, opts...)
@@ -178,6 +199,24 @@ func (c *queryClient) FunTokenMapping(ctx context.Context, in *QueryFunTokenMapp
 	return out, nil
 }
 
+func (c *queryClient) FunTokenEscrow(ctx context.Context, in *QueryFunTokenEscrowRequest, opts ...grpc.CallOption) (*QueryFunTokenEscrowResponse, error) {
+	out := new(QueryFunTokenEscrowResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunNFTMapping(ctx context.Context, in *QueryFunNFTMappingRequest, opts ...grpc.CallOption) (*QueryFunNFTMappingResponse, error) {
+	out := new(QueryFunNFTMappingResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunNFTMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -193,6 +232,12 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// StorageProof queries the root of the Ethereum storage trie of an account,
+	// built from its contract storage, and the Merkle proofs of storage slots
+	// against it. It implements the storage part of "eth_getProof". The root is
+	// not committed by consensus, so the proofs are not verifiable against a
+	// block header. Accounts with more than 100,000 storage slots are refused.
+	StorageProof(context.Context, *QueryStorageProofRequest) (*QueryStorageProofResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
@@ -211,6 +256,12 @@ type QueryServer interface {
 	// Similar to feemarket module's method
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error)
+	// FunTokenEscrow reports the ERC20 tokens escrowed by the EVM module for a
+	// "FunToken" created from an ERC20 against the supply of its Bank Coin.
+	FunTokenEscrow(context.Context, *QueryFunTokenEscrowRequest) (*QueryFunTokenEscrowResponse, error)
+	// FunNFTMapping returns the "FunNFT" mapping of an ERC-721 collection or
+	// "x/nft" class.
+	FunNFTMapping(context.Context, *QueryFunNFTMappingRequest) (*QueryFunNFTMappingResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -230,6 +281,9 @@ func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (
 func (UnimplementedQueryServer) Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
+func (UnimplementedQueryServer) StorageProof(context.Context, *QueryStorageProofRequest) (*QueryStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProof not implemented")
+}
 func (UnimplementedQueryServer) Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
@@ -257,6 +311,12 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMapping not implemented")
 }
+func (UnimplementedQueryServer) FunTokenEscrow(context.Context, *QueryFunTokenEscrowRequest) (*QueryFunTokenEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenEscrow not implemented")
+}
+func (UnimplementedQueryServer) FunNFTMapping(context.Context, *QueryFunNFTMappingRequest) (*QueryFunNFTMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunNFTMapping not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -342,6 +402,24 @@ func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/StorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProof(ctx, req.(*QueryStorageProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +582,42 @@ func _Query_FunTokenMapping_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FunTokenEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenEscrow(ctx, req.(*QueryFunTokenEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunNFTMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunNFTMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunNFTMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunNFTMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunNFTMapping(ctx, req.(*QueryFunNFTMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -527,6 +641,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
 		},
+		{
+			MethodName: "StorageProof",
+			Handler:    _Query_StorageProof_Handler,
+		},
 		{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
@@ -563,6 +681,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FunTokenMapping",
 			Handler:    _Query_FunTokenMapping_Handler,
 		},
+		{
+			MethodName: "FunTokenEscrow",
+			Handler:    _Query_FunTokenEscrow_Handler,
+		},
+		{
+			MethodName: "FunNFTMapping",
+			Handler:    _Query_FunNFTMapping_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
@@ -5764,10 +5764,11 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_MsgCreateFunToken                 protoreflect.MessageDescriptor
-	fd_MsgCreateFunToken_from_erc20      protoreflect.FieldDescriptor
-	fd_MsgCreateFunToken_from_bank_denom protoreflect.FieldDescriptor
-	fd_MsgCreateFunToken_sender          protoreflect.FieldDescriptor
+	md_MsgCreateFunToken                  protoreflect.MessageDescriptor
+	fd_MsgCreateFunToken_from_erc20       protoreflect.FieldDescriptor
+	fd_MsgCreateFunToken_from_bank_denom  protoreflect.FieldDescriptor
+	fd_MsgCreateFunToken_sender           protoreflect.FieldDescriptor
+	fd_MsgCreateFunToken_scaling_exponent protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -5776,6 +5777,7 @@ func init() {
 	fd_MsgCreateFunToken_from_erc20 = md_MsgCreateFunToken.Fields().ByName("from_erc20")
 	fd_MsgCreateFunToken_from_bank_denom = md_MsgCreateFunToken.Fields().ByName("from_bank_denom")
 	fd_MsgCreateFunToken_sender = md_MsgCreateFunToken.Fields().ByName("sender")
+	fd_MsgCreateFunToken_scaling_exponent = md_MsgCreateFunToken.Fields().ByName("scaling_exponent")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateFunToken)(nil)
@@ -5861,6 +5863,12 @@ func (x *fastReflection_MsgCreateFunToken) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.ScalingExponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ScalingExponent)
+		if !f(fd_MsgCreateFunToken_scaling_exponent, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5882,6 +5890,8 @@ func (x *fastReflection_MsgCreateFunToken) Has(fd protoreflect.FieldDescriptor)
 		return x.FromBankDenom != ""
 	case "eth.evm.v1.MsgCreateFunToken.sender":
 		return x.Sender != ""
+	case "eth.evm.v1.MsgCreateFunToken.scaling_exponent":
+		return x.ScalingExponent != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunToken"))
@@ -5904,6 +5914,8 @@ func (x *fastReflection_MsgCreateFunToken) Clear(fd protoreflect.FieldDescriptor
 		x.FromBankDenom = ""
 	case "eth.evm.v1.MsgCreateFunToken.sender":
 		x.Sender = ""
+	case "eth.evm.v1.MsgCreateFunToken.scaling_exponent":
+		x.ScalingExponent = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunToken"))
@@ -5929,6 +5941,9 @@ func (x *fastReflection_MsgCreateFunToken) Get(descriptor protoreflect.FieldDesc
 	case "eth.evm.v1.MsgCreateFunToken.sender":
 		value := x.Sender
 		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgCreateFunToken.scaling_exponent":
+		value := x.ScalingExponent
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunToken"))
@@ -5955,6 +5970,8 @@ func (x *fastReflection_MsgCreateFunToken) Set(fd protoreflect.FieldDescriptor,
 		x.FromBankDenom = value.Interface().(string)
 	case "eth.evm.v1.MsgCreateFunToken.sender":
 		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgCreateFunToken.scaling_exponent":
+		x.ScalingExponent = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunToken"))
@@ -5981,6 +5998,8 @@ func (x *fastReflection_MsgCreateFunToken) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field from_bank_denom of message eth.evm.v1.MsgCreateFunToken is not mutable"))
 	case "eth.evm.v1.MsgCreateFunToken.sender":
 		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgCreateFunToken is not mutable"))
+	case "eth.evm.v1.MsgCreateFunToken.scaling_exponent":
+		panic(fmt.Errorf("field scaling_exponent of message eth.evm.v1.MsgCreateFunToken is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunToken"))
@@ -6000,6 +6019,8 @@ func (x *fastReflection_MsgCreateFunToken) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfString("")
 	case "eth.evm.v1.MsgCreateFunToken.sender":
 		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgCreateFunToken.scaling_exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunToken"))
@@ -6081,6 +6102,9 @@ func (x *fastReflection_MsgCreateFunToken) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ScalingExponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScalingExponent))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6110,6 +6134,11 @@ func (x *fastReflection_MsgCreateFunToken) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ScalingExponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScalingExponent))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.Sender) > 0 {
 			i -= len(x.Sender)
 			copy(dAtA[i:], x.Sender)
@@ -6276,6 +6305,25 @@ func (x *fastReflection_MsgCreateFunToken) ProtoMethods() *protoiface.Methods {
 				}
 				x.Sender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScalingExponent", wireType)
+				}
+				x.ScalingExponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScalingExponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7665,844 +7713,6429 @@ func (x *fastReflection_MsgConvertCoinToEvmResponse) ProtoMethods() *protoiface.
 	}
 }
 
-// Copyright (c) 2023-2024 Nibi, Inc.
+var (
+	md_MsgConvertEvmToCoin            protoreflect.MessageDescriptor
+	fd_MsgConvertEvmToCoin_sender     protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToCoin_erc20_addr protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToCoin_amount     protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToCoin_to_addr    protoreflect.FieldDescriptor
+)
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: eth/evm/v1/tx.proto
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgConvertEvmToCoin = File_eth_evm_v1_tx_proto.Messages().ByName("MsgConvertEvmToCoin")
+	fd_MsgConvertEvmToCoin_sender = md_MsgConvertEvmToCoin.Fields().ByName("sender")
+	fd_MsgConvertEvmToCoin_erc20_addr = md_MsgConvertEvmToCoin.Fields().ByName("erc20_addr")
+	fd_MsgConvertEvmToCoin_amount = md_MsgConvertEvmToCoin.Fields().ByName("amount")
+	fd_MsgConvertEvmToCoin_to_addr = md_MsgConvertEvmToCoin.Fields().ByName("to_addr")
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+var _ protoreflect.Message = (*fastReflection_MsgConvertEvmToCoin)(nil)
 
-// MsgEthereumTx encapsulates an Ethereum transaction as an SDK message.
-type MsgEthereumTx struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_MsgConvertEvmToCoin MsgConvertEvmToCoin
 
-	// data is inner transaction data of the Ethereum transaction
-	Data *anypb.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// size is the encoded storage size of the transaction (DEPRECATED)
-	Size float64 `protobuf:"fixed64,2,opt,name=size,proto3" json:"size,omitempty"`
-	// hash of the transaction in hex format
-	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
-	// from is the ethereum signer address in hex format. This address value is
-	// checked against the address derived from the signature (V, R, S) using the
-	// secp256k1 elliptic curve
-	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+func (x *MsgConvertEvmToCoin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToCoin)(x)
 }
 
-func (x *MsgEthereumTx) Reset() {
-	*x = MsgEthereumTx{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[0]
+func (x *MsgConvertEvmToCoin) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgEthereumTx) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgEthereumTx) ProtoMessage() {}
-
-// Deprecated: Use MsgEthereumTx.ProtoReflect.Descriptor instead.
-func (*MsgEthereumTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{0}
-}
+var _fastReflection_MsgConvertEvmToCoin_messageType fastReflection_MsgConvertEvmToCoin_messageType
+var _ protoreflect.MessageType = fastReflection_MsgConvertEvmToCoin_messageType{}
 
-func (x *MsgEthereumTx) GetData() *anypb.Any {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
+type fastReflection_MsgConvertEvmToCoin_messageType struct{}
 
-func (x *MsgEthereumTx) GetSize() float64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+func (x fastReflection_MsgConvertEvmToCoin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToCoin)(nil)
 }
-
-func (x *MsgEthereumTx) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
+func (x fastReflection_MsgConvertEvmToCoin_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToCoin)
 }
-
-func (x *MsgEthereumTx) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+func (x fastReflection_MsgConvertEvmToCoin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToCoin
 }
 
-// LegacyTx is the transaction data of regular Ethereum transactions.
-//
-// Note that setting "evm.Params.AllowUnprotectedTxs" to false will cause all
-// non-EIP155 signed transactions to fail, as they'll lack replay protection.
-//
-// LegacyTx is a custom implementation of "LegacyTx" from
-// "github.com/ethereum/go-ethereum/core/types".
-type LegacyTx struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// nonce corresponds to the account nonce (transaction sequence).
-	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// gas_price defines the value for each gas unit
-	GasPrice string `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	// gas defines the gas limit defined for the transaction.
-	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
-	// to is the hex formatted address of the recipient
-	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	// value defines the unsigned integer value of the transaction amount.
-	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
-	// data is the data payload bytes of the transaction.
-	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
-	// v defines the recovery id as the "v" signature value from the elliptic
-	// curve digital signatute algorithm (ECDSA). It indicates which of two
-	// possible solutions should be used to reconstruct the public key from the
-	// signature. In Ethereum, "v" takes the value 27 or 28 for transactions that
-	// are not relay-protected.
-	V []byte `protobuf:"bytes,7,opt,name=v,proto3" json:"v,omitempty"`
-	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
-	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
-	// uniqueness of the signature.
-	R []byte `protobuf:"bytes,8,opt,name=r,proto3" json:"r,omitempty"`
-	// s define the signature value derived from the private key, message hash,
-	// and the value of "r". It ensures that the signature is tied to both the
-	// message and the private key of the sender.
-	S []byte `protobuf:"bytes,9,opt,name=s,proto3" json:"s,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgConvertEvmToCoin) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToCoin
 }
 
-func (x *LegacyTx) Reset() {
-	*x = LegacyTx{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgConvertEvmToCoin) Type() protoreflect.MessageType {
+	return _fastReflection_MsgConvertEvmToCoin_messageType
 }
 
-func (x *LegacyTx) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgConvertEvmToCoin) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToCoin)
 }
 
-func (*LegacyTx) ProtoMessage() {}
-
-// Deprecated: Use LegacyTx.ProtoReflect.Descriptor instead.
-func (*LegacyTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgConvertEvmToCoin) Interface() protoreflect.ProtoMessage {
+	return (*MsgConvertEvmToCoin)(x)
 }
 
-func (x *LegacyTx) GetNonce() uint64 {
-	if x != nil {
-		return x.Nonce
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgConvertEvmToCoin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgConvertEvmToCoin_sender, value) {
+			return
+		}
 	}
-	return 0
-}
-
-func (x *LegacyTx) GetGasPrice() string {
-	if x != nil {
-		return x.GasPrice
+	if x.Erc20Addr != "" {
+		value := protoreflect.ValueOfString(x.Erc20Addr)
+		if !f(fd_MsgConvertEvmToCoin_erc20_addr, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *LegacyTx) GetGas() uint64 {
-	if x != nil {
-		return x.Gas
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgConvertEvmToCoin_amount, value) {
+			return
+		}
 	}
-	return 0
-}
-
-func (x *LegacyTx) GetTo() string {
-	if x != nil {
-		return x.To
+	if x.ToAddr != "" {
+		value := protoreflect.ValueOfString(x.ToAddr)
+		if !f(fd_MsgConvertEvmToCoin_to_addr, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *LegacyTx) GetValue() string {
-	if x != nil {
-		return x.Value
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgConvertEvmToCoin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		return x.Erc20Addr != ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		return x.Amount != ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		return x.ToAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *LegacyTx) GetData() []byte {
-	if x != nil {
-		return x.Data
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		x.Sender = ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		x.Erc20Addr = ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		x.Amount = ""
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		x.ToAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-func (x *LegacyTx) GetV() []byte {
-	if x != nil {
-		return x.V
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgConvertEvmToCoin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		value := x.Erc20Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		value := x.ToAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", descriptor.FullName()))
 	}
-	return nil
 }
 
-func (x *LegacyTx) GetR() []byte {
-	if x != nil {
-		return x.R
-	}
-	return nil
-}
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		x.Erc20Addr = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		x.Amount = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		x.ToAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		panic(fmt.Errorf("field erc20_addr of message eth.evm.v1.MsgConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		panic(fmt.Errorf("field amount of message eth.evm.v1.MsgConvertEvmToCoin is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		panic(fmt.Errorf("field to_addr of message eth.evm.v1.MsgConvertEvmToCoin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgConvertEvmToCoin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoin.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToCoin.erc20_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToCoin.amount":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToCoin.to_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoin"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgConvertEvmToCoin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgConvertEvmToCoin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgConvertEvmToCoin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgConvertEvmToCoin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgConvertEvmToCoin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgConvertEvmToCoin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToCoin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ToAddr) > 0 {
+			i -= len(x.ToAddr)
+			copy(dAtA[i:], x.ToAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddr)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc20Addr) > 0 {
+			i -= len(x.Erc20Addr)
+			copy(dAtA[i:], x.Erc20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Addr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToCoin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToCoin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgConvertEvmToCoinResponse           protoreflect.MessageDescriptor
+	fd_MsgConvertEvmToCoinResponse_bank_coin protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgConvertEvmToCoinResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgConvertEvmToCoinResponse")
+	fd_MsgConvertEvmToCoinResponse_bank_coin = md_MsgConvertEvmToCoinResponse.Fields().ByName("bank_coin")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgConvertEvmToCoinResponse)(nil)
+
+type fastReflection_MsgConvertEvmToCoinResponse MsgConvertEvmToCoinResponse
+
+func (x *MsgConvertEvmToCoinResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToCoinResponse)(x)
+}
+
+func (x *MsgConvertEvmToCoinResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgConvertEvmToCoinResponse_messageType fastReflection_MsgConvertEvmToCoinResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgConvertEvmToCoinResponse_messageType{}
+
+type fastReflection_MsgConvertEvmToCoinResponse_messageType struct{}
+
+func (x fastReflection_MsgConvertEvmToCoinResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToCoinResponse)(nil)
+}
+func (x fastReflection_MsgConvertEvmToCoinResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToCoinResponse)
+}
+func (x fastReflection_MsgConvertEvmToCoinResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToCoinResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToCoinResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgConvertEvmToCoinResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToCoinResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgConvertEvmToCoinResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankCoin != nil {
+		value := protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+		if !f(fd_MsgConvertEvmToCoinResponse_bank_coin, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		return x.BankCoin != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		x.BankCoin = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		value := x.BankCoin
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		x.BankCoin = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		if x.BankCoin == nil {
+			x.BankCoin = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.BankCoin.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToCoinResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToCoinResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgConvertEvmToCoinResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgConvertEvmToCoinResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgConvertEvmToCoinResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BankCoin != nil {
+			l = options.Size(x.BankCoin)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToCoinResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BankCoin != nil {
+			encoded, err := options.Marshal(x.BankCoin)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToCoinResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToCoinResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BankCoin == nil {
+					x.BankCoin = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BankCoin); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReconcileFunTokenEscrow             protoreflect.MessageDescriptor
+	fd_MsgReconcileFunTokenEscrow_sender      protoreflect.FieldDescriptor
+	fd_MsgReconcileFunTokenEscrow_erc20_addr  protoreflect.FieldDescriptor
+	fd_MsgReconcileFunTokenEscrow_is_rebasing protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgReconcileFunTokenEscrow = File_eth_evm_v1_tx_proto.Messages().ByName("MsgReconcileFunTokenEscrow")
+	fd_MsgReconcileFunTokenEscrow_sender = md_MsgReconcileFunTokenEscrow.Fields().ByName("sender")
+	fd_MsgReconcileFunTokenEscrow_erc20_addr = md_MsgReconcileFunTokenEscrow.Fields().ByName("erc20_addr")
+	fd_MsgReconcileFunTokenEscrow_is_rebasing = md_MsgReconcileFunTokenEscrow.Fields().ByName("is_rebasing")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReconcileFunTokenEscrow)(nil)
+
+type fastReflection_MsgReconcileFunTokenEscrow MsgReconcileFunTokenEscrow
+
+func (x *MsgReconcileFunTokenEscrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReconcileFunTokenEscrow)(x)
+}
+
+func (x *MsgReconcileFunTokenEscrow) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReconcileFunTokenEscrow_messageType fastReflection_MsgReconcileFunTokenEscrow_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReconcileFunTokenEscrow_messageType{}
+
+type fastReflection_MsgReconcileFunTokenEscrow_messageType struct{}
+
+func (x fastReflection_MsgReconcileFunTokenEscrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReconcileFunTokenEscrow)(nil)
+}
+func (x fastReflection_MsgReconcileFunTokenEscrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReconcileFunTokenEscrow)
+}
+func (x fastReflection_MsgReconcileFunTokenEscrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReconcileFunTokenEscrow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReconcileFunTokenEscrow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReconcileFunTokenEscrow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) New() protoreflect.Message {
+	return new(fastReflection_MsgReconcileFunTokenEscrow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Interface() protoreflect.ProtoMessage {
+	return (*MsgReconcileFunTokenEscrow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgReconcileFunTokenEscrow_sender, value) {
+			return
+		}
+	}
+	if x.Erc20Addr != "" {
+		value := protoreflect.ValueOfString(x.Erc20Addr)
+		if !f(fd_MsgReconcileFunTokenEscrow_erc20_addr, value) {
+			return
+		}
+	}
+	if x.IsRebasing != false {
+		value := protoreflect.ValueOfBool(x.IsRebasing)
+		if !f(fd_MsgReconcileFunTokenEscrow_is_rebasing, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.erc20_addr":
+		return x.Erc20Addr != ""
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.is_rebasing":
+		return x.IsRebasing != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.sender":
+		x.Sender = ""
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.erc20_addr":
+		x.Erc20Addr = ""
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.is_rebasing":
+		x.IsRebasing = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.erc20_addr":
+		value := x.Erc20Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.is_rebasing":
+		value := x.IsRebasing
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.erc20_addr":
+		x.Erc20Addr = value.Interface().(string)
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.is_rebasing":
+		x.IsRebasing = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgReconcileFunTokenEscrow is not mutable"))
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.erc20_addr":
+		panic(fmt.Errorf("field erc20_addr of message eth.evm.v1.MsgReconcileFunTokenEscrow is not mutable"))
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.is_rebasing":
+		panic(fmt.Errorf("field is_rebasing of message eth.evm.v1.MsgReconcileFunTokenEscrow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.erc20_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgReconcileFunTokenEscrow.is_rebasing":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrow"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgReconcileFunTokenEscrow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReconcileFunTokenEscrow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReconcileFunTokenEscrow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc20Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsRebasing {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReconcileFunTokenEscrow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IsRebasing {
+			i--
+			if x.IsRebasing {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Erc20Addr) > 0 {
+			i -= len(x.Erc20Addr)
+			copy(dAtA[i:], x.Erc20Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc20Addr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReconcileFunTokenEscrow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReconcileFunTokenEscrow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReconcileFunTokenEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc20Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsRebasing", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsRebasing = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReconcileFunTokenEscrowResponse           protoreflect.MessageDescriptor
+	fd_MsgReconcileFunTokenEscrowResponse_fun_token protoreflect.FieldDescriptor
+	fd_MsgReconcileFunTokenEscrowResponse_escrow    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgReconcileFunTokenEscrowResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgReconcileFunTokenEscrowResponse")
+	fd_MsgReconcileFunTokenEscrowResponse_fun_token = md_MsgReconcileFunTokenEscrowResponse.Fields().ByName("fun_token")
+	fd_MsgReconcileFunTokenEscrowResponse_escrow = md_MsgReconcileFunTokenEscrowResponse.Fields().ByName("escrow")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReconcileFunTokenEscrowResponse)(nil)
+
+type fastReflection_MsgReconcileFunTokenEscrowResponse MsgReconcileFunTokenEscrowResponse
+
+func (x *MsgReconcileFunTokenEscrowResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReconcileFunTokenEscrowResponse)(x)
+}
+
+func (x *MsgReconcileFunTokenEscrowResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReconcileFunTokenEscrowResponse_messageType fastReflection_MsgReconcileFunTokenEscrowResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReconcileFunTokenEscrowResponse_messageType{}
+
+type fastReflection_MsgReconcileFunTokenEscrowResponse_messageType struct{}
+
+func (x fastReflection_MsgReconcileFunTokenEscrowResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReconcileFunTokenEscrowResponse)(nil)
+}
+func (x fastReflection_MsgReconcileFunTokenEscrowResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReconcileFunTokenEscrowResponse)
+}
+func (x fastReflection_MsgReconcileFunTokenEscrowResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReconcileFunTokenEscrowResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReconcileFunTokenEscrowResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReconcileFunTokenEscrowResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgReconcileFunTokenEscrowResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgReconcileFunTokenEscrowResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FunToken != nil {
+		value := protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
+		if !f(fd_MsgReconcileFunTokenEscrowResponse_fun_token, value) {
+			return
+		}
+	}
+	if x.Escrow != nil {
+		value := protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+		if !f(fd_MsgReconcileFunTokenEscrowResponse_escrow, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.fun_token":
+		return x.FunToken != nil
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.escrow":
+		return x.Escrow != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrowResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrowResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.fun_token":
+		x.FunToken = nil
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.escrow":
+		x.Escrow = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrowResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrowResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.fun_token":
+		value := x.FunToken
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.escrow":
+		value := x.Escrow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrowResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrowResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.fun_token":
+		x.FunToken = value.Message().Interface().(*FunToken)
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.escrow":
+		x.Escrow = value.Message().Interface().(*FunTokenEscrow)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrowResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrowResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.fun_token":
+		if x.FunToken == nil {
+			x.FunToken = new(FunToken)
+		}
+		return protoreflect.ValueOfMessage(x.FunToken.ProtoReflect())
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.escrow":
+		if x.Escrow == nil {
+			x.Escrow = new(FunTokenEscrow)
+		}
+		return protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrowResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrowResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.fun_token":
+		m := new(FunToken)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "eth.evm.v1.MsgReconcileFunTokenEscrowResponse.escrow":
+		m := new(FunTokenEscrow)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgReconcileFunTokenEscrowResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgReconcileFunTokenEscrowResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgReconcileFunTokenEscrowResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReconcileFunTokenEscrowResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReconcileFunTokenEscrowResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FunToken != nil {
+			l = options.Size(x.FunToken)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Escrow != nil {
+			l = options.Size(x.Escrow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReconcileFunTokenEscrowResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Escrow != nil {
+			encoded, err := options.Marshal(x.Escrow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.FunToken != nil {
+			encoded, err := options.Marshal(x.FunToken)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReconcileFunTokenEscrowResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReconcileFunTokenEscrowResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReconcileFunTokenEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FunToken == nil {
+					x.FunToken = &FunToken{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FunToken); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Escrow == nil {
+					x.Escrow = &FunTokenEscrow{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateFunNFT             protoreflect.MessageDescriptor
+	fd_MsgCreateFunNFT_from_erc721 protoreflect.FieldDescriptor
+	fd_MsgCreateFunNFT_sender      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgCreateFunNFT = File_eth_evm_v1_tx_proto.Messages().ByName("MsgCreateFunNFT")
+	fd_MsgCreateFunNFT_from_erc721 = md_MsgCreateFunNFT.Fields().ByName("from_erc721")
+	fd_MsgCreateFunNFT_sender = md_MsgCreateFunNFT.Fields().ByName("sender")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateFunNFT)(nil)
+
+type fastReflection_MsgCreateFunNFT MsgCreateFunNFT
+
+func (x *MsgCreateFunNFT) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateFunNFT)(x)
+}
+
+func (x *MsgCreateFunNFT) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateFunNFT_messageType fastReflection_MsgCreateFunNFT_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateFunNFT_messageType{}
+
+type fastReflection_MsgCreateFunNFT_messageType struct{}
+
+func (x fastReflection_MsgCreateFunNFT_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateFunNFT)(nil)
+}
+func (x fastReflection_MsgCreateFunNFT_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateFunNFT)
+}
+func (x fastReflection_MsgCreateFunNFT_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateFunNFT
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateFunNFT) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateFunNFT
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateFunNFT) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateFunNFT_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateFunNFT) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateFunNFT)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateFunNFT) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateFunNFT)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateFunNFT) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromErc721 != "" {
+		value := protoreflect.ValueOfString(x.FromErc721)
+		if !f(fd_MsgCreateFunNFT_from_erc721, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgCreateFunNFT_sender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateFunNFT) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFT.from_erc721":
+		return x.FromErc721 != ""
+	case "eth.evm.v1.MsgCreateFunNFT.sender":
+		return x.Sender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateFunNFT) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFT.from_erc721":
+		x.FromErc721 = ""
+	case "eth.evm.v1.MsgCreateFunNFT.sender":
+		x.Sender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateFunNFT) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFT.from_erc721":
+		value := x.FromErc721
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgCreateFunNFT.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFT does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateFunNFT) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFT.from_erc721":
+		x.FromErc721 = value.Interface().(string)
+	case "eth.evm.v1.MsgCreateFunNFT.sender":
+		x.Sender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateFunNFT) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFT.from_erc721":
+		panic(fmt.Errorf("field from_erc721 of message eth.evm.v1.MsgCreateFunNFT is not mutable"))
+	case "eth.evm.v1.MsgCreateFunNFT.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgCreateFunNFT is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateFunNFT) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFT.from_erc721":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgCreateFunNFT.sender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateFunNFT) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgCreateFunNFT", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateFunNFT) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateFunNFT) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateFunNFT) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateFunNFT) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateFunNFT)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.FromErc721)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateFunNFT)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.FromErc721) > 0 {
+			i -= len(x.FromErc721)
+			copy(dAtA[i:], x.FromErc721)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromErc721)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateFunNFT)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateFunNFT: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateFunNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromErc721", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FromErc721 = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateFunNFTResponse                protoreflect.MessageDescriptor
+	fd_MsgCreateFunNFTResponse_funnft_mapping protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgCreateFunNFTResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgCreateFunNFTResponse")
+	fd_MsgCreateFunNFTResponse_funnft_mapping = md_MsgCreateFunNFTResponse.Fields().ByName("funnft_mapping")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateFunNFTResponse)(nil)
+
+type fastReflection_MsgCreateFunNFTResponse MsgCreateFunNFTResponse
+
+func (x *MsgCreateFunNFTResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateFunNFTResponse)(x)
+}
+
+func (x *MsgCreateFunNFTResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateFunNFTResponse_messageType fastReflection_MsgCreateFunNFTResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateFunNFTResponse_messageType{}
+
+type fastReflection_MsgCreateFunNFTResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateFunNFTResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateFunNFTResponse)(nil)
+}
+func (x fastReflection_MsgCreateFunNFTResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateFunNFTResponse)
+}
+func (x fastReflection_MsgCreateFunNFTResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateFunNFTResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateFunNFTResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateFunNFTResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateFunNFTResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateFunNFTResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateFunNFTResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateFunNFTResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateFunNFTResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateFunNFTResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateFunNFTResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FunnftMapping != nil {
+		value := protoreflect.ValueOfMessage(x.FunnftMapping.ProtoReflect())
+		if !f(fd_MsgCreateFunNFTResponse_funnft_mapping, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateFunNFTResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFTResponse.funnft_mapping":
+		return x.FunnftMapping != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateFunNFTResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFTResponse.funnft_mapping":
+		x.FunnftMapping = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateFunNFTResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFTResponse.funnft_mapping":
+		value := x.FunnftMapping
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFTResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateFunNFTResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFTResponse.funnft_mapping":
+		x.FunnftMapping = value.Message().Interface().(*FunNFT)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateFunNFTResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFTResponse.funnft_mapping":
+		if x.FunnftMapping == nil {
+			x.FunnftMapping = new(FunNFT)
+		}
+		return protoreflect.ValueOfMessage(x.FunnftMapping.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateFunNFTResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgCreateFunNFTResponse.funnft_mapping":
+		m := new(FunNFT)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgCreateFunNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgCreateFunNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateFunNFTResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgCreateFunNFTResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateFunNFTResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateFunNFTResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateFunNFTResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateFunNFTResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateFunNFTResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FunnftMapping != nil {
+			l = options.Size(x.FunnftMapping)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateFunNFTResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FunnftMapping != nil {
+			encoded, err := options.Marshal(x.FunnftMapping)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateFunNFTResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateFunNFTResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateFunNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunnftMapping", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FunnftMapping == nil {
+					x.FunnftMapping = &FunNFT{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FunnftMapping); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgConvertEvmToNFT             protoreflect.MessageDescriptor
+	fd_MsgConvertEvmToNFT_sender      protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToNFT_erc721_addr protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToNFT_token_id    protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToNFT_to_addr     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgConvertEvmToNFT = File_eth_evm_v1_tx_proto.Messages().ByName("MsgConvertEvmToNFT")
+	fd_MsgConvertEvmToNFT_sender = md_MsgConvertEvmToNFT.Fields().ByName("sender")
+	fd_MsgConvertEvmToNFT_erc721_addr = md_MsgConvertEvmToNFT.Fields().ByName("erc721_addr")
+	fd_MsgConvertEvmToNFT_token_id = md_MsgConvertEvmToNFT.Fields().ByName("token_id")
+	fd_MsgConvertEvmToNFT_to_addr = md_MsgConvertEvmToNFT.Fields().ByName("to_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgConvertEvmToNFT)(nil)
+
+type fastReflection_MsgConvertEvmToNFT MsgConvertEvmToNFT
+
+func (x *MsgConvertEvmToNFT) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToNFT)(x)
+}
+
+func (x *MsgConvertEvmToNFT) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgConvertEvmToNFT_messageType fastReflection_MsgConvertEvmToNFT_messageType
+var _ protoreflect.MessageType = fastReflection_MsgConvertEvmToNFT_messageType{}
+
+type fastReflection_MsgConvertEvmToNFT_messageType struct{}
+
+func (x fastReflection_MsgConvertEvmToNFT_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToNFT)(nil)
+}
+func (x fastReflection_MsgConvertEvmToNFT_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToNFT)
+}
+func (x fastReflection_MsgConvertEvmToNFT_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToNFT
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgConvertEvmToNFT) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToNFT
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgConvertEvmToNFT) Type() protoreflect.MessageType {
+	return _fastReflection_MsgConvertEvmToNFT_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgConvertEvmToNFT) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToNFT)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgConvertEvmToNFT) Interface() protoreflect.ProtoMessage {
+	return (*MsgConvertEvmToNFT)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgConvertEvmToNFT) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgConvertEvmToNFT_sender, value) {
+			return
+		}
+	}
+	if x.Erc721Addr != "" {
+		value := protoreflect.ValueOfString(x.Erc721Addr)
+		if !f(fd_MsgConvertEvmToNFT_erc721_addr, value) {
+			return
+		}
+	}
+	if x.TokenId != "" {
+		value := protoreflect.ValueOfString(x.TokenId)
+		if !f(fd_MsgConvertEvmToNFT_token_id, value) {
+			return
+		}
+	}
+	if x.ToAddr != "" {
+		value := protoreflect.ValueOfString(x.ToAddr)
+		if !f(fd_MsgConvertEvmToNFT_to_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgConvertEvmToNFT) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFT.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.MsgConvertEvmToNFT.erc721_addr":
+		return x.Erc721Addr != ""
+	case "eth.evm.v1.MsgConvertEvmToNFT.token_id":
+		return x.TokenId != ""
+	case "eth.evm.v1.MsgConvertEvmToNFT.to_addr":
+		return x.ToAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToNFT) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFT.sender":
+		x.Sender = ""
+	case "eth.evm.v1.MsgConvertEvmToNFT.erc721_addr":
+		x.Erc721Addr = ""
+	case "eth.evm.v1.MsgConvertEvmToNFT.token_id":
+		x.TokenId = ""
+	case "eth.evm.v1.MsgConvertEvmToNFT.to_addr":
+		x.ToAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgConvertEvmToNFT) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFT.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToNFT.erc721_addr":
+		value := x.Erc721Addr
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToNFT.token_id":
+		value := x.TokenId
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToNFT.to_addr":
+		value := x.ToAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFT does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToNFT) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFT.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToNFT.erc721_addr":
+		x.Erc721Addr = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToNFT.token_id":
+		x.TokenId = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToNFT.to_addr":
+		x.ToAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToNFT) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFT.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgConvertEvmToNFT is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToNFT.erc721_addr":
+		panic(fmt.Errorf("field erc721_addr of message eth.evm.v1.MsgConvertEvmToNFT is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToNFT.token_id":
+		panic(fmt.Errorf("field token_id of message eth.evm.v1.MsgConvertEvmToNFT is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToNFT.to_addr":
+		panic(fmt.Errorf("field to_addr of message eth.evm.v1.MsgConvertEvmToNFT is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgConvertEvmToNFT) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFT.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToNFT.erc721_addr":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToNFT.token_id":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToNFT.to_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFT"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFT does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgConvertEvmToNFT) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgConvertEvmToNFT", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgConvertEvmToNFT) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToNFT) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgConvertEvmToNFT) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgConvertEvmToNFT) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgConvertEvmToNFT)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Erc721Addr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokenId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToNFT)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ToAddr) > 0 {
+			i -= len(x.ToAddr)
+			copy(dAtA[i:], x.ToAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddr)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TokenId) > 0 {
+			i -= len(x.TokenId)
+			copy(dAtA[i:], x.TokenId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokenId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Erc721Addr) > 0 {
+			i -= len(x.Erc721Addr)
+			copy(dAtA[i:], x.Erc721Addr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Erc721Addr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToNFT)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToNFT: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Erc721Addr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Erc721Addr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgConvertEvmToNFTResponse          protoreflect.MessageDescriptor
+	fd_MsgConvertEvmToNFTResponse_class_id protoreflect.FieldDescriptor
+	fd_MsgConvertEvmToNFTResponse_nft_id   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgConvertEvmToNFTResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgConvertEvmToNFTResponse")
+	fd_MsgConvertEvmToNFTResponse_class_id = md_MsgConvertEvmToNFTResponse.Fields().ByName("class_id")
+	fd_MsgConvertEvmToNFTResponse_nft_id = md_MsgConvertEvmToNFTResponse.Fields().ByName("nft_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgConvertEvmToNFTResponse)(nil)
+
+type fastReflection_MsgConvertEvmToNFTResponse MsgConvertEvmToNFTResponse
+
+func (x *MsgConvertEvmToNFTResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToNFTResponse)(x)
+}
+
+func (x *MsgConvertEvmToNFTResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgConvertEvmToNFTResponse_messageType fastReflection_MsgConvertEvmToNFTResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgConvertEvmToNFTResponse_messageType{}
+
+type fastReflection_MsgConvertEvmToNFTResponse_messageType struct{}
+
+func (x fastReflection_MsgConvertEvmToNFTResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgConvertEvmToNFTResponse)(nil)
+}
+func (x fastReflection_MsgConvertEvmToNFTResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToNFTResponse)
+}
+func (x fastReflection_MsgConvertEvmToNFTResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToNFTResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertEvmToNFTResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgConvertEvmToNFTResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertEvmToNFTResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgConvertEvmToNFTResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_MsgConvertEvmToNFTResponse_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_MsgConvertEvmToNFTResponse_nft_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.class_id":
+		return x.ClassId != ""
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.nft_id":
+		return x.NftId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.class_id":
+		x.ClassId = ""
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.nft_id":
+		x.NftId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFTResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.class_id":
+		x.ClassId = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.nft_id":
+		x.NftId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.class_id":
+		panic(fmt.Errorf("field class_id of message eth.evm.v1.MsgConvertEvmToNFTResponse is not mutable"))
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.nft_id":
+		panic(fmt.Errorf("field nft_id of message eth.evm.v1.MsgConvertEvmToNFTResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.class_id":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertEvmToNFTResponse.nft_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertEvmToNFTResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertEvmToNFTResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgConvertEvmToNFTResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgConvertEvmToNFTResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgConvertEvmToNFTResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToNFTResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertEvmToNFTResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToNFTResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertEvmToNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgConvertNFTToEvm             protoreflect.MessageDescriptor
+	fd_MsgConvertNFTToEvm_sender      protoreflect.FieldDescriptor
+	fd_MsgConvertNFTToEvm_class_id    protoreflect.FieldDescriptor
+	fd_MsgConvertNFTToEvm_nft_id      protoreflect.FieldDescriptor
+	fd_MsgConvertNFTToEvm_to_eth_addr protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgConvertNFTToEvm = File_eth_evm_v1_tx_proto.Messages().ByName("MsgConvertNFTToEvm")
+	fd_MsgConvertNFTToEvm_sender = md_MsgConvertNFTToEvm.Fields().ByName("sender")
+	fd_MsgConvertNFTToEvm_class_id = md_MsgConvertNFTToEvm.Fields().ByName("class_id")
+	fd_MsgConvertNFTToEvm_nft_id = md_MsgConvertNFTToEvm.Fields().ByName("nft_id")
+	fd_MsgConvertNFTToEvm_to_eth_addr = md_MsgConvertNFTToEvm.Fields().ByName("to_eth_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgConvertNFTToEvm)(nil)
+
+type fastReflection_MsgConvertNFTToEvm MsgConvertNFTToEvm
+
+func (x *MsgConvertNFTToEvm) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgConvertNFTToEvm)(x)
+}
+
+func (x *MsgConvertNFTToEvm) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgConvertNFTToEvm_messageType fastReflection_MsgConvertNFTToEvm_messageType
+var _ protoreflect.MessageType = fastReflection_MsgConvertNFTToEvm_messageType{}
+
+type fastReflection_MsgConvertNFTToEvm_messageType struct{}
+
+func (x fastReflection_MsgConvertNFTToEvm_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgConvertNFTToEvm)(nil)
+}
+func (x fastReflection_MsgConvertNFTToEvm_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertNFTToEvm)
+}
+func (x fastReflection_MsgConvertNFTToEvm_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertNFTToEvm
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgConvertNFTToEvm) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertNFTToEvm
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgConvertNFTToEvm) Type() protoreflect.MessageType {
+	return _fastReflection_MsgConvertNFTToEvm_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgConvertNFTToEvm) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertNFTToEvm)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgConvertNFTToEvm) Interface() protoreflect.ProtoMessage {
+	return (*MsgConvertNFTToEvm)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgConvertNFTToEvm) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_MsgConvertNFTToEvm_sender, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_MsgConvertNFTToEvm_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_MsgConvertNFTToEvm_nft_id, value) {
+			return
+		}
+	}
+	if x.ToEthAddr != "" {
+		value := protoreflect.ValueOfString(x.ToEthAddr)
+		if !f(fd_MsgConvertNFTToEvm_to_eth_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgConvertNFTToEvm) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertNFTToEvm.sender":
+		return x.Sender != ""
+	case "eth.evm.v1.MsgConvertNFTToEvm.class_id":
+		return x.ClassId != ""
+	case "eth.evm.v1.MsgConvertNFTToEvm.nft_id":
+		return x.NftId != ""
+	case "eth.evm.v1.MsgConvertNFTToEvm.to_eth_addr":
+		return x.ToEthAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertNFTToEvm) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertNFTToEvm.sender":
+		x.Sender = ""
+	case "eth.evm.v1.MsgConvertNFTToEvm.class_id":
+		x.ClassId = ""
+	case "eth.evm.v1.MsgConvertNFTToEvm.nft_id":
+		x.NftId = ""
+	case "eth.evm.v1.MsgConvertNFTToEvm.to_eth_addr":
+		x.ToEthAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgConvertNFTToEvm) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "eth.evm.v1.MsgConvertNFTToEvm.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertNFTToEvm.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertNFTToEvm.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	case "eth.evm.v1.MsgConvertNFTToEvm.to_eth_addr":
+		value := x.ToEthAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvm does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertNFTToEvm) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertNFTToEvm.sender":
+		x.Sender = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertNFTToEvm.class_id":
+		x.ClassId = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertNFTToEvm.nft_id":
+		x.NftId = value.Interface().(string)
+	case "eth.evm.v1.MsgConvertNFTToEvm.to_eth_addr":
+		x.ToEthAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertNFTToEvm) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertNFTToEvm.sender":
+		panic(fmt.Errorf("field sender of message eth.evm.v1.MsgConvertNFTToEvm is not mutable"))
+	case "eth.evm.v1.MsgConvertNFTToEvm.class_id":
+		panic(fmt.Errorf("field class_id of message eth.evm.v1.MsgConvertNFTToEvm is not mutable"))
+	case "eth.evm.v1.MsgConvertNFTToEvm.nft_id":
+		panic(fmt.Errorf("field nft_id of message eth.evm.v1.MsgConvertNFTToEvm is not mutable"))
+	case "eth.evm.v1.MsgConvertNFTToEvm.to_eth_addr":
+		panic(fmt.Errorf("field to_eth_addr of message eth.evm.v1.MsgConvertNFTToEvm is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgConvertNFTToEvm) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "eth.evm.v1.MsgConvertNFTToEvm.sender":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertNFTToEvm.class_id":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertNFTToEvm.nft_id":
+		return protoreflect.ValueOfString("")
+	case "eth.evm.v1.MsgConvertNFTToEvm.to_eth_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvm"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvm does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgConvertNFTToEvm) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgConvertNFTToEvm", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgConvertNFTToEvm) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertNFTToEvm) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgConvertNFTToEvm) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgConvertNFTToEvm) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgConvertNFTToEvm)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToEthAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertNFTToEvm)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ToEthAddr) > 0 {
+			i -= len(x.ToEthAddr)
+			copy(dAtA[i:], x.ToEthAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToEthAddr)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertNFTToEvm)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertNFTToEvm: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertNFTToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToEthAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgConvertNFTToEvmResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_eth_evm_v1_tx_proto_init()
+	md_MsgConvertNFTToEvmResponse = File_eth_evm_v1_tx_proto.Messages().ByName("MsgConvertNFTToEvmResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgConvertNFTToEvmResponse)(nil)
+
+type fastReflection_MsgConvertNFTToEvmResponse MsgConvertNFTToEvmResponse
+
+func (x *MsgConvertNFTToEvmResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgConvertNFTToEvmResponse)(x)
+}
+
+func (x *MsgConvertNFTToEvmResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_eth_evm_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgConvertNFTToEvmResponse_messageType fastReflection_MsgConvertNFTToEvmResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgConvertNFTToEvmResponse_messageType{}
+
+type fastReflection_MsgConvertNFTToEvmResponse_messageType struct{}
+
+func (x fastReflection_MsgConvertNFTToEvmResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgConvertNFTToEvmResponse)(nil)
+}
+func (x fastReflection_MsgConvertNFTToEvmResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertNFTToEvmResponse)
+}
+func (x fastReflection_MsgConvertNFTToEvmResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertNFTToEvmResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgConvertNFTToEvmResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgConvertNFTToEvmResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgConvertNFTToEvmResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgConvertNFTToEvmResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvmResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvmResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvmResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvmResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvmResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvmResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvmResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvmResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvmResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvmResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: eth.evm.v1.MsgConvertNFTToEvmResponse"))
+		}
+		panic(fmt.Errorf("message eth.evm.v1.MsgConvertNFTToEvmResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in eth.evm.v1.MsgConvertNFTToEvmResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgConvertNFTToEvmResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgConvertNFTToEvmResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertNFTToEvmResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgConvertNFTToEvmResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertNFTToEvmResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgConvertNFTToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright (c) 2023-2024 Nibi, Inc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: eth/evm/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgEthereumTx encapsulates an Ethereum transaction as an SDK message.
+type MsgEthereumTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is inner transaction data of the Ethereum transaction
+	Data *anypb.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// size is the encoded storage size of the transaction (DEPRECATED)
+	Size float64 `protobuf:"fixed64,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hash of the transaction in hex format
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// from is the ethereum signer address in hex format. This address value is
+	// checked against the address derived from the signature (V, R, S) using the
+	// secp256k1 elliptic curve
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (x *MsgEthereumTx) Reset() {
+	*x = MsgEthereumTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgEthereumTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgEthereumTx) ProtoMessage() {}
+
+// Deprecated: Use MsgEthereumTx.ProtoReflect.Descriptor instead.
+func (*MsgEthereumTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgEthereumTx) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MsgEthereumTx) GetSize() float64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MsgEthereumTx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *MsgEthereumTx) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+// LegacyTx is the transaction data of regular Ethereum transactions.
+//
+// Note that setting "evm.Params.AllowUnprotectedTxs" to false will cause all
+// non-EIP155 signed transactions to fail, as they'll lack replay protection.
+//
+// LegacyTx is a custom implementation of "LegacyTx" from
+// "github.com/ethereum/go-ethereum/core/types".
+type LegacyTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// nonce corresponds to the account nonce (transaction sequence).
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_price defines the value for each gas unit
+	GasPrice string `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// gas defines the gas limit defined for the transaction.
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	// to is the hex formatted address of the recipient
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the unsigned integer value of the transaction amount.
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// data is the data payload bytes of the transaction.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// v defines the recovery id as the "v" signature value from the elliptic
+	// curve digital signatute algorithm (ECDSA). It indicates which of two
+	// possible solutions should be used to reconstruct the public key from the
+	// signature. In Ethereum, "v" takes the value 27 or 28 for transactions that
+	// are not relay-protected.
+	V []byte `protobuf:"bytes,7,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
+	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
+	// uniqueness of the signature.
+	R []byte `protobuf:"bytes,8,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value derived from the private key, message hash,
+	// and the value of "r". It ensures that the signature is tied to both the
+	// message and the private key of the sender.
+	S []byte `protobuf:"bytes,9,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *LegacyTx) Reset() {
+	*x = LegacyTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LegacyTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyTx) ProtoMessage() {}
+
+// Deprecated: Use LegacyTx.ProtoReflect.Descriptor instead.
+func (*LegacyTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LegacyTx) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *LegacyTx) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *LegacyTx) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *LegacyTx) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *LegacyTx) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *LegacyTx) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *LegacyTx) GetV() []byte {
+	if x != nil {
+		return x.V
+	}
+	return nil
+}
+
+func (x *LegacyTx) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *LegacyTx) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+// AccessListTx is the data of EIP-2930 access list transactions.
+// It is a custom implementation of "AccessListTx" from
+// "github.com/ethereum/go-ethereum/core/types".
+type AccessListTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chain_id of the destination EVM chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// nonce corresponds to the account nonce (transaction sequence).
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_price defines the value for each gas unit
+	GasPrice string `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// gas defines the gas limit defined for the transaction.
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	// to is the recipient address in hex format
+	To string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the unsigned integer value of the transaction amount.
+	Value string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	// data is the data payload bytes of the transaction.
+	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// accesses is an array of access tuples
+	Accesses []*AccessTuple `protobuf:"bytes,8,rep,name=accesses,proto3" json:"accesses,omitempty"`
+	// v defines the recovery id and "v" signature value from the elliptic curve
+	// digital signatute algorithm (ECDSA). It indicates which of two possible
+	// solutions should be used to reconstruct the public key from the signature.
+	// In Ethereum, "v" takes the value 27 or 28 for transactions that are not
+	// relay-protected.
+	V []byte `protobuf:"bytes,9,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
+	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
+	// uniqueness of the signature.
+	R []byte `protobuf:"bytes,10,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value derived from the private key, message hash,
+	// and the value of "r". It ensures that the signature is tied to both the
+	// message and the private key of the sender.
+	S []byte `protobuf:"bytes,11,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *AccessListTx) Reset() {
+	*x = AccessListTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessListTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessListTx) ProtoMessage() {}
+
+// Deprecated: Use AccessListTx.ProtoReflect.Descriptor instead.
+func (*AccessListTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccessListTx) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *AccessListTx) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *AccessListTx) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *AccessListTx) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *AccessListTx) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AccessListTx) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AccessListTx) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AccessListTx) GetAccesses() []*AccessTuple {
+	if x != nil {
+		return x.Accesses
+	}
+	return nil
+}
+
+func (x *AccessListTx) GetV() []byte {
+	if x != nil {
+		return x.V
+	}
+	return nil
+}
+
+func (x *AccessListTx) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *AccessListTx) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+// DynamicFeeTx is the data of EIP-1559 dynamic fee transactions. It is a custom
+// implementation of "DynamicFeeTx" from
+// "github.com/ethereum/go-ethereum/core/types".
+type DynamicFeeTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chain_id of the destination EVM chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// nonce corresponds to the account nonce (transaction sequence).
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gas_tip_cap defines the max value for the gas tip
+	GasTipCap string `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3" json:"gas_tip_cap,omitempty"`
+	// gas_fee_cap defines the max value for the gas fee
+	GasFeeCap string `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3" json:"gas_fee_cap,omitempty"`
+	// gas defines the gas limit defined for the transaction.
+	Gas uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	// to is the hex formatted address of the recipient
+	To string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	// value defines the the transaction amount.
+	Value string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	// data is the data payload bytes of the transaction.
+	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	// accesses is an array of access tuples
+	Accesses []*AccessTuple `protobuf:"bytes,9,rep,name=accesses,proto3" json:"accesses,omitempty"`
+	// v defines the recovery id and "v" signature value from the elliptic curve
+	// digital signatute algorithm (ECDSA). It indicates which of two possible
+	// solutions should be used to reconstruct the public key from the signature.
+	// In Ethereum, "v" takes the value 27 or 28 for transactions that are not
+	// relay-protected.
+	V []byte `protobuf:"bytes,10,opt,name=v,proto3" json:"v,omitempty"`
+	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
+	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
+	// uniqueness of the signature.
+	R []byte `protobuf:"bytes,11,opt,name=r,proto3" json:"r,omitempty"`
+	// s define the signature value derived from the private key, message hash,
+	// and the value of "r". It ensures that the signature is tied to both the
+	// message and the private key of the sender.
+	S []byte `protobuf:"bytes,12,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *DynamicFeeTx) Reset() {
+	*x = DynamicFeeTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicFeeTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicFeeTx) ProtoMessage() {}
+
+// Deprecated: Use DynamicFeeTx.ProtoReflect.Descriptor instead.
+func (*DynamicFeeTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DynamicFeeTx) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *DynamicFeeTx) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *DynamicFeeTx) GetGasTipCap() string {
+	if x != nil {
+		return x.GasTipCap
+	}
+	return ""
+}
+
+func (x *DynamicFeeTx) GetGasFeeCap() string {
+	if x != nil {
+		return x.GasFeeCap
+	}
+	return ""
+}
+
+func (x *DynamicFeeTx) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *DynamicFeeTx) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *DynamicFeeTx) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DynamicFeeTx) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DynamicFeeTx) GetAccesses() []*AccessTuple {
+	if x != nil {
+		return x.Accesses
+	}
+	return nil
+}
 
-func (x *LegacyTx) GetS() []byte {
+func (x *DynamicFeeTx) GetV() []byte {
+	if x != nil {
+		return x.V
+	}
+	return nil
+}
+
+func (x *DynamicFeeTx) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *DynamicFeeTx) GetS() []byte {
 	if x != nil {
 		return x.S
 	}
 	return nil
 }
 
-// AccessListTx is the data of EIP-2930 access list transactions.
-// It is a custom implementation of "AccessListTx" from
-// "github.com/ethereum/go-ethereum/core/types".
-type AccessListTx struct {
+// ExtensionOptionsEthereumTx is an extension option for ethereum transactions
+type ExtensionOptionsEthereumTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// chain_id of the destination EVM chain
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// nonce corresponds to the account nonce (transaction sequence).
-	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// gas_price defines the value for each gas unit
-	GasPrice string `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	// gas defines the gas limit defined for the transaction.
-	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
-	// to is the recipient address in hex format
-	To string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
-	// value defines the unsigned integer value of the transaction amount.
-	Value string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
-	// data is the data payload bytes of the transaction.
-	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
-	// accesses is an array of access tuples
-	Accesses []*AccessTuple `protobuf:"bytes,8,rep,name=accesses,proto3" json:"accesses,omitempty"`
-	// v defines the recovery id and "v" signature value from the elliptic curve
-	// digital signatute algorithm (ECDSA). It indicates which of two possible
-	// solutions should be used to reconstruct the public key from the signature.
-	// In Ethereum, "v" takes the value 27 or 28 for transactions that are not
-	// relay-protected.
-	V []byte `protobuf:"bytes,9,opt,name=v,proto3" json:"v,omitempty"`
-	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
-	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
-	// uniqueness of the signature.
-	R []byte `protobuf:"bytes,10,opt,name=r,proto3" json:"r,omitempty"`
-	// s define the signature value derived from the private key, message hash,
-	// and the value of "r". It ensures that the signature is tied to both the
-	// message and the private key of the sender.
-	S []byte `protobuf:"bytes,11,opt,name=s,proto3" json:"s,omitempty"`
+func (x *ExtensionOptionsEthereumTx) Reset() {
+	*x = ExtensionOptionsEthereumTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *AccessListTx) Reset() {
-	*x = AccessListTx{}
+func (x *ExtensionOptionsEthereumTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionOptionsEthereumTx) ProtoMessage() {}
+
+// Deprecated: Use ExtensionOptionsEthereumTx.ProtoReflect.Descriptor instead.
+func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+// MsgEthereumTxResponse defines the Msg/EthereumTx response type.
+type MsgEthereumTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hash of the ethereum transaction in hex format. This hash differs from the
+	// Tendermint sha256 hash of the transaction bytes. See
+	// https://github.com/tendermint/tendermint/issues/6539 for reference
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// logs contains the transaction hash and the proto-compatible ethereum
+	// logs.
+	Logs []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+	// ret is the returned data from evm function (result or data supplied with
+	// revert opcode)
+	Ret []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
+	// vm_error is the error returned by vm execution
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// gas_used specifies how much gas was consumed by the transaction
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (x *MsgEthereumTxResponse) Reset() {
+	*x = MsgEthereumTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[2]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccessListTx) String() string {
+func (x *MsgEthereumTxResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessListTx) ProtoMessage() {}
+func (*MsgEthereumTxResponse) ProtoMessage() {}
 
-// Deprecated: Use AccessListTx.ProtoReflect.Descriptor instead.
-func (*AccessListTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgEthereumTxResponse.ProtoReflect.Descriptor instead.
+func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AccessListTx) GetChainId() string {
+func (x *MsgEthereumTxResponse) GetHash() string {
 	if x != nil {
-		return x.ChainId
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *AccessListTx) GetNonce() uint64 {
+func (x *MsgEthereumTxResponse) GetLogs() []*Log {
 	if x != nil {
-		return x.Nonce
+		return x.Logs
 	}
-	return 0
+	return nil
 }
 
-func (x *AccessListTx) GetGasPrice() string {
+func (x *MsgEthereumTxResponse) GetRet() []byte {
 	if x != nil {
-		return x.GasPrice
+		return x.Ret
+	}
+	return nil
+}
+
+func (x *MsgEthereumTxResponse) GetVmError() string {
+	if x != nil {
+		return x.VmError
 	}
 	return ""
 }
 
-func (x *AccessListTx) GetGas() uint64 {
+func (x *MsgEthereumTxResponse) GetGasUsed() uint64 {
 	if x != nil {
-		return x.Gas
+		return x.GasUsed
 	}
 	return 0
 }
 
-func (x *AccessListTx) GetTo() string {
+// MsgUpdateParams defines a Msg for updating the x/evm module parameters.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/evm parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgCreateFunToken: Arguments to create a "FunToken" mapping. Either the ERC20
+// contract address can be given to create the mapping to a Bank Coin, or the
+// denomination for a Bank Coin can be given to create the mapping to an ERC20.
+type MsgCreateFunToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
+	FromErc20 string `protobuf:"bytes,1,opt,name=from_erc20,json=fromErc20,proto3" json:"from_erc20,omitempty"`
+	// Coin denomination in the Bank Module.
+	FromBankDenom string `protobuf:"bytes,2,opt,name=from_bank_denom,json=fromBankDenom,proto3" json:"from_bank_denom,omitempty"`
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Number of ERC-20 decimals to drop in the Bank Coin representation. See
+	// "FunToken.scaling_exponent". Only valid with "from_erc20". Defaults to
+	// zero, which keeps the decimals of the ERC-20.
+	ScalingExponent uint32 `protobuf:"varint,4,opt,name=scaling_exponent,json=scalingExponent,proto3" json:"scaling_exponent,omitempty"`
+}
+
+func (x *MsgCreateFunToken) Reset() {
+	*x = MsgCreateFunToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateFunToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateFunToken) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateFunToken.ProtoReflect.Descriptor instead.
+func (*MsgCreateFunToken) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgCreateFunToken) GetFromErc20() string {
+	if x != nil {
+		return x.FromErc20
+	}
+	return ""
+}
+
+func (x *MsgCreateFunToken) GetFromBankDenom() string {
 	if x != nil {
-		return x.To
+		return x.FromBankDenom
 	}
 	return ""
 }
 
-func (x *AccessListTx) GetValue() string {
+func (x *MsgCreateFunToken) GetSender() string {
 	if x != nil {
-		return x.Value
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *AccessListTx) GetData() []byte {
+func (x *MsgCreateFunToken) GetScalingExponent() uint32 {
 	if x != nil {
-		return x.Data
+		return x.ScalingExponent
 	}
-	return nil
+	return 0
 }
 
-func (x *AccessListTx) GetAccesses() []*AccessTuple {
-	if x != nil {
-		return x.Accesses
-	}
-	return nil
+type MsgCreateFunTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fungible token mapping corresponding to ERC20 tokens.
+	FuntokenMapping *FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping,omitempty"`
 }
 
-func (x *AccessListTx) GetV() []byte {
-	if x != nil {
-		return x.V
+func (x *MsgCreateFunTokenResponse) Reset() {
+	*x = MsgCreateFunTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AccessListTx) GetR() []byte {
-	if x != nil {
-		return x.R
-	}
-	return nil
+func (x *MsgCreateFunTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AccessListTx) GetS() []byte {
+func (*MsgCreateFunTokenResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateFunTokenResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateFunTokenResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgCreateFunTokenResponse) GetFuntokenMapping() *FunToken {
 	if x != nil {
-		return x.S
+		return x.FuntokenMapping
 	}
 	return nil
 }
 
-// DynamicFeeTx is the data of EIP-1559 dynamic fee transactions. It is a custom
-// implementation of "DynamicFeeTx" from
-// "github.com/ethereum/go-ethereum/core/types".
-type DynamicFeeTx struct {
+// MsgConvertCoinToEvm: Arguments to send a Bank Coin to ERC-20 representation
+type MsgConvertCoinToEvm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// chain_id of the destination EVM chain
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// nonce corresponds to the account nonce (transaction sequence).
-	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// gas_tip_cap defines the max value for the gas tip
-	GasTipCap string `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3" json:"gas_tip_cap,omitempty"`
-	// gas_fee_cap defines the max value for the gas fee
-	GasFeeCap string `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3" json:"gas_fee_cap,omitempty"`
-	// gas defines the gas limit defined for the transaction.
-	Gas uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
-	// to is the hex formatted address of the recipient
-	To string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
-	// value defines the the transaction amount.
-	Value string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
-	// data is the data payload bytes of the transaction.
-	Data []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
-	// accesses is an array of access tuples
-	Accesses []*AccessTuple `protobuf:"bytes,9,rep,name=accesses,proto3" json:"accesses,omitempty"`
-	// v defines the recovery id and "v" signature value from the elliptic curve
-	// digital signatute algorithm (ECDSA). It indicates which of two possible
-	// solutions should be used to reconstruct the public key from the signature.
-	// In Ethereum, "v" takes the value 27 or 28 for transactions that are not
-	// relay-protected.
-	V []byte `protobuf:"bytes,10,opt,name=v,proto3" json:"v,omitempty"`
-	// r defines the x-coordinate of a point on the elliptic curve in the elliptic
-	// curve digital signatute algorithm (ECDSA). It's crucial in ensuring
-	// uniqueness of the signature.
-	R []byte `protobuf:"bytes,11,opt,name=r,proto3" json:"r,omitempty"`
-	// s define the signature value derived from the private key, message hash,
-	// and the value of "r". It ensures that the signature is tied to both the
-	// message and the private key of the sender.
-	S []byte `protobuf:"bytes,12,opt,name=s,proto3" json:"s,omitempty"`
+	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
+	ToEthAddr string `protobuf:"bytes,1,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Bank Coin to get converted to ERC20
+	BankCoin *v1beta1.Coin `protobuf:"bytes,3,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
 }
 
-func (x *DynamicFeeTx) Reset() {
-	*x = DynamicFeeTx{}
+func (x *MsgConvertCoinToEvm) Reset() {
+	*x = MsgConvertCoinToEvm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[3]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DynamicFeeTx) String() string {
+func (x *MsgConvertCoinToEvm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DynamicFeeTx) ProtoMessage() {}
+func (*MsgConvertCoinToEvm) ProtoMessage() {}
 
-// Deprecated: Use DynamicFeeTx.ProtoReflect.Descriptor instead.
-func (*DynamicFeeTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgConvertCoinToEvm.ProtoReflect.Descriptor instead.
+func (*MsgConvertCoinToEvm) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DynamicFeeTx) GetChainId() string {
+func (x *MsgConvertCoinToEvm) GetToEthAddr() string {
 	if x != nil {
-		return x.ChainId
+		return x.ToEthAddr
 	}
 	return ""
 }
 
-func (x *DynamicFeeTx) GetNonce() uint64 {
+func (x *MsgConvertCoinToEvm) GetSender() string {
 	if x != nil {
-		return x.Nonce
+		return x.Sender
 	}
-	return 0
+	return ""
 }
 
-func (x *DynamicFeeTx) GetGasTipCap() string {
+func (x *MsgConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
 	if x != nil {
-		return x.GasTipCap
+		return x.BankCoin
 	}
-	return ""
+	return nil
 }
 
-func (x *DynamicFeeTx) GetGasFeeCap() string {
-	if x != nil {
-		return x.GasFeeCap
-	}
-	return ""
+type MsgConvertCoinToEvmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DynamicFeeTx) GetGas() uint64 {
-	if x != nil {
-		return x.Gas
+func (x *MsgConvertCoinToEvmResponse) Reset() {
+	*x = MsgConvertCoinToEvmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *DynamicFeeTx) GetTo() string {
-	if x != nil {
-		return x.To
-	}
-	return ""
+func (x *MsgConvertCoinToEvmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DynamicFeeTx) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
+func (*MsgConvertCoinToEvmResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgConvertCoinToEvmResponse.ProtoReflect.Descriptor instead.
+func (*MsgConvertCoinToEvmResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DynamicFeeTx) GetData() []byte {
-	if x != nil {
-		return x.Data
+// MsgConvertEvmToCoin: Arguments to send ERC20 tokens to their Bank Coin
+// representation
+type MsgConvertEvmToCoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sender: Address for the signer of the transaction. The ERC20 tokens are
+	// sent from the EVM address of the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token of the `FunToken` mapping
+	Erc20Addr string `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3" json:"erc20_addr,omitempty"`
+	// Amount of ERC20 tokens to convert
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Bech32 or hexadecimal address of the recipient of the Bank Coins
+	ToAddr string `protobuf:"bytes,4,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+}
+
+func (x *MsgConvertEvmToCoin) Reset() {
+	*x = MsgConvertEvmToCoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *DynamicFeeTx) GetAccesses() []*AccessTuple {
+func (x *MsgConvertEvmToCoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgConvertEvmToCoin) ProtoMessage() {}
+
+// Deprecated: Use MsgConvertEvmToCoin.ProtoReflect.Descriptor instead.
+func (*MsgConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgConvertEvmToCoin) GetSender() string {
 	if x != nil {
-		return x.Accesses
+		return x.Sender
 	}
-	return nil
+	return ""
 }
 
-func (x *DynamicFeeTx) GetV() []byte {
+func (x *MsgConvertEvmToCoin) GetErc20Addr() string {
 	if x != nil {
-		return x.V
+		return x.Erc20Addr
 	}
-	return nil
+	return ""
 }
 
-func (x *DynamicFeeTx) GetR() []byte {
+func (x *MsgConvertEvmToCoin) GetAmount() string {
 	if x != nil {
-		return x.R
+		return x.Amount
 	}
-	return nil
+	return ""
 }
 
-func (x *DynamicFeeTx) GetS() []byte {
+func (x *MsgConvertEvmToCoin) GetToAddr() string {
 	if x != nil {
-		return x.S
+		return x.ToAddr
 	}
-	return nil
+	return ""
 }
 
-// ExtensionOptionsEthereumTx is an extension option for ethereum transactions
-type ExtensionOptionsEthereumTx struct {
+type MsgConvertEvmToCoinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Bank Coin received by "to_addr". Its amount may be lower than the amount
+	// of the message for ERC20 tokens that take a fee on transfer.
+	BankCoin *v1beta1.Coin `protobuf:"bytes,1,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
 }
 
-func (x *ExtensionOptionsEthereumTx) Reset() {
-	*x = ExtensionOptionsEthereumTx{}
+func (x *MsgConvertEvmToCoinResponse) Reset() {
+	*x = MsgConvertEvmToCoinResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[4]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExtensionOptionsEthereumTx) String() string {
+func (x *MsgConvertEvmToCoinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtensionOptionsEthereumTx) ProtoMessage() {}
+func (*MsgConvertEvmToCoinResponse) ProtoMessage() {}
 
-// Deprecated: Use ExtensionOptionsEthereumTx.ProtoReflect.Descriptor instead.
-func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgConvertEvmToCoinResponse.ProtoReflect.Descriptor instead.
+func (*MsgConvertEvmToCoinResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
-// MsgEthereumTxResponse defines the Msg/EthereumTx response type.
-type MsgEthereumTxResponse struct {
+func (x *MsgConvertEvmToCoinResponse) GetBankCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.BankCoin
+	}
+	return nil
+}
+
+// MsgReconcileFunTokenEscrow: Arguments to reconcile the escrow of a
+// `FunToken` created from an ERC20 with the supply of its Bank Coin.
+type MsgReconcileFunTokenEscrow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// hash of the ethereum transaction in hex format. This hash differs from the
-	// Tendermint sha256 hash of the transaction bytes. See
-	// https://github.com/tendermint/tendermint/issues/6539 for reference
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// logs contains the transaction hash and the proto-compatible ethereum
-	// logs.
-	Logs []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
-	// ret is the returned data from evm function (result or data supplied with
-	// revert opcode)
-	Ret []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
-	// vm_error is the error returned by vm execution
-	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
-	// gas_used specifies how much gas was consumed by the transaction
-	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// Sender: Address of the x/sudo root or a sudo contract.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token of the `FunToken` mapping
+	Erc20Addr string `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3" json:"erc20_addr,omitempty"`
+	// is_rebasing: Flags the mapping as rebasing. A mapping whose escrow is
+	// worth less than the supply of its Bank Coin is flagged regardless, since
+	// only the token itself can shrink the escrow.
+	IsRebasing bool `protobuf:"varint,3,opt,name=is_rebasing,json=isRebasing,proto3" json:"is_rebasing,omitempty"`
 }
 
-func (x *MsgEthereumTxResponse) Reset() {
-	*x = MsgEthereumTxResponse{}
+func (x *MsgReconcileFunTokenEscrow) Reset() {
+	*x = MsgReconcileFunTokenEscrow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[5]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgEthereumTxResponse) String() string {
+func (x *MsgReconcileFunTokenEscrow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgEthereumTxResponse) ProtoMessage() {}
+func (*MsgReconcileFunTokenEscrow) ProtoMessage() {}
 
-// Deprecated: Use MsgEthereumTxResponse.ProtoReflect.Descriptor instead.
-func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgReconcileFunTokenEscrow.ProtoReflect.Descriptor instead.
+func (*MsgReconcileFunTokenEscrow) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgEthereumTxResponse) GetHash() string {
+func (x *MsgReconcileFunTokenEscrow) GetSender() string {
 	if x != nil {
-		return x.Hash
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *MsgReconcileFunTokenEscrow) GetErc20Addr() string {
+	if x != nil {
+		return x.Erc20Addr
 	}
 	return ""
 }
 
-func (x *MsgEthereumTxResponse) GetLogs() []*Log {
-	if x != nil {
-		return x.Logs
+func (x *MsgReconcileFunTokenEscrow) GetIsRebasing() bool {
+	if x != nil {
+		return x.IsRebasing
+	}
+	return false
+}
+
+type MsgReconcileFunTokenEscrowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The reconciled `FunToken` mapping
+	FunToken *FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token,omitempty"`
+	// Escrow of the mapping at the time of the reconciliation
+	Escrow *FunTokenEscrow `protobuf:"bytes,2,opt,name=escrow,proto3" json:"escrow,omitempty"`
+}
+
+func (x *MsgReconcileFunTokenEscrowResponse) Reset() {
+	*x = MsgReconcileFunTokenEscrowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *MsgEthereumTxResponse) GetRet() []byte {
-	if x != nil {
-		return x.Ret
-	}
-	return nil
+func (x *MsgReconcileFunTokenEscrowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgEthereumTxResponse) GetVmError() string {
+func (*MsgReconcileFunTokenEscrowResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgReconcileFunTokenEscrowResponse.ProtoReflect.Descriptor instead.
+func (*MsgReconcileFunTokenEscrowResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgReconcileFunTokenEscrowResponse) GetFunToken() *FunToken {
 	if x != nil {
-		return x.VmError
+		return x.FunToken
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgEthereumTxResponse) GetGasUsed() uint64 {
+func (x *MsgReconcileFunTokenEscrowResponse) GetEscrow() *FunTokenEscrow {
 	if x != nil {
-		return x.GasUsed
+		return x.Escrow
 	}
-	return 0
+	return nil
 }
 
-// MsgUpdateParams defines a Msg for updating the x/evm module parameters.
-type MsgUpdateParams struct {
+// MsgCreateFunNFT: Arguments to create a "FunNFT" mapping from an ERC-721
+// collection.
+type MsgCreateFunNFT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/evm parameters to update.
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// Hexadecimal address of the ERC-721 collection to which the `FunNFT` maps
+	FromErc721 string `protobuf:"bytes,1,opt,name=from_erc721,json=fromErc721,proto3" json:"from_erc721,omitempty"`
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
+func (x *MsgCreateFunNFT) Reset() {
+	*x = MsgCreateFunNFT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[6]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParams) String() string {
+func (x *MsgCreateFunNFT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgCreateFunNFT) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgCreateFunNFT.ProtoReflect.Descriptor instead.
+func (*MsgCreateFunNFT) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgCreateFunNFT) GetFromErc721() string {
 	if x != nil {
-		return x.Authority
+		return x.FromErc721
 	}
 	return ""
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgCreateFunNFT) GetSender() string {
 	if x != nil {
-		return x.Params
+		return x.Sender
 	}
-	return nil
+	return ""
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+type MsgCreateFunNFTResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Non-fungible token mapping corresponding to the ERC-721 collection.
+	FunnftMapping *FunNFT `protobuf:"bytes,1,opt,name=funnft_mapping,json=funnftMapping,proto3" json:"funnft_mapping,omitempty"`
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgCreateFunNFTResponse) Reset() {
+	*x = MsgCreateFunNFTResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[7]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgCreateFunNFTResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgCreateFunNFTResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgCreateFunNFTResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateFunNFTResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-// MsgCreateFunToken: Arguments to create a "FunToken" mapping. Either the ERC20
-// contract address can be given to create the mapping to a Bank Coin, or the
-// denomination for a Bank Coin can be given to create the mapping to an ERC20.
-type MsgCreateFunToken struct {
+func (x *MsgCreateFunNFTResponse) GetFunnftMapping() *FunNFT {
+	if x != nil {
+		return x.FunnftMapping
+	}
+	return nil
+}
+
+// MsgConvertEvmToNFT: Arguments to send an ERC-721 token to its "x/nft"
+// representation
+type MsgConvertEvmToNFT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
-	FromErc20 string `protobuf:"bytes,1,opt,name=from_erc20,json=fromErc20,proto3" json:"from_erc20,omitempty"`
-	// Coin denomination in the Bank Module.
-	FromBankDenom string `protobuf:"bytes,2,opt,name=from_bank_denom,json=fromBankDenom,proto3" json:"from_bank_denom,omitempty"`
-	// Sender: Address for the signer of the transaction.
-	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Sender: Address for the signer of the transaction. The ERC-721 token is
+	// sent from the EVM address of the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC-721 collection of the `FunNFT` mapping
+	Erc721Addr string `protobuf:"bytes,2,opt,name=erc721_addr,json=erc721Addr,proto3" json:"erc721_addr,omitempty"`
+	// ID of the ERC-721 token to convert
+	TokenId string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// Bech32 or hexadecimal address of the recipient of the "x/nft" token
+	ToAddr string `protobuf:"bytes,4,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
 }
 
-func (x *MsgCreateFunToken) Reset() {
-	*x = MsgCreateFunToken{}
+func (x *MsgConvertEvmToNFT) Reset() {
+	*x = MsgConvertEvmToNFT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[8]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateFunToken) String() string {
+func (x *MsgConvertEvmToNFT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateFunToken) ProtoMessage() {}
+func (*MsgConvertEvmToNFT) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateFunToken.ProtoReflect.Descriptor instead.
-func (*MsgCreateFunToken) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgConvertEvmToNFT.ProtoReflect.Descriptor instead.
+func (*MsgConvertEvmToNFT) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgCreateFunToken) GetFromErc20() string {
+func (x *MsgConvertEvmToNFT) GetSender() string {
 	if x != nil {
-		return x.FromErc20
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *MsgCreateFunToken) GetFromBankDenom() string {
+func (x *MsgConvertEvmToNFT) GetErc721Addr() string {
 	if x != nil {
-		return x.FromBankDenom
+		return x.Erc721Addr
 	}
 	return ""
 }
 
-func (x *MsgCreateFunToken) GetSender() string {
+func (x *MsgConvertEvmToNFT) GetTokenId() string {
 	if x != nil {
-		return x.Sender
+		return x.TokenId
 	}
 	return ""
 }
 
-type MsgCreateFunTokenResponse struct {
+func (x *MsgConvertEvmToNFT) GetToAddr() string {
+	if x != nil {
+		return x.ToAddr
+	}
+	return ""
+}
+
+type MsgConvertEvmToNFTResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Fungible token mapping corresponding to ERC20 tokens.
-	FuntokenMapping *FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping,omitempty"`
+	// ID of the "x/nft" class of the token
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// ID of the "x/nft" token received by "to_addr"
+	NftId string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func (x *MsgCreateFunTokenResponse) Reset() {
-	*x = MsgCreateFunTokenResponse{}
+func (x *MsgConvertEvmToNFTResponse) Reset() {
+	*x = MsgConvertEvmToNFTResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[9]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateFunTokenResponse) String() string {
+func (x *MsgConvertEvmToNFTResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateFunTokenResponse) ProtoMessage() {}
+func (*MsgConvertEvmToNFTResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateFunTokenResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateFunTokenResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgConvertEvmToNFTResponse.ProtoReflect.Descriptor instead.
+func (*MsgConvertEvmToNFTResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MsgCreateFunTokenResponse) GetFuntokenMapping() *FunToken {
+func (x *MsgConvertEvmToNFTResponse) GetClassId() string {
 	if x != nil {
-		return x.FuntokenMapping
+		return x.ClassId
 	}
-	return nil
+	return ""
 }
 
-// MsgConvertCoinToEvm: Arguments to send a Bank Coin to ERC-20 representation
-type MsgConvertCoinToEvm struct {
+func (x *MsgConvertEvmToNFTResponse) GetNftId() string {
+	if x != nil {
+		return x.NftId
+	}
+	return ""
+}
+
+// MsgConvertNFTToEvm: Arguments to send an "x/nft" token to its ERC-721
+// representation
+type MsgConvertNFTToEvm struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Hexadecimal address of the ERC20 token to which the `FunToken` maps
-	ToEthAddr string `protobuf:"bytes,1,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
-	// Sender: Address for the signer of the transaction.
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Bank Coin to get converted to ERC20
-	BankCoin *v1beta1.Coin `protobuf:"bytes,3,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin,omitempty"`
+	// Sender: Address for the signer of the transaction and owner of the
+	// "x/nft" token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ID of the "x/nft" class of the `FunNFT` mapping
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// ID of the "x/nft" token to convert
+	NftId string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// Hexadecimal address of the recipient of the ERC-721 token
+	ToEthAddr string `protobuf:"bytes,4,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
 }
 
-func (x *MsgConvertCoinToEvm) Reset() {
-	*x = MsgConvertCoinToEvm{}
+func (x *MsgConvertNFTToEvm) Reset() {
+	*x = MsgConvertNFTToEvm{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[10]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgConvertCoinToEvm) String() string {
+func (x *MsgConvertNFTToEvm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgConvertCoinToEvm) ProtoMessage() {}
+func (*MsgConvertNFTToEvm) ProtoMessage() {}
 
-// Deprecated: Use MsgConvertCoinToEvm.ProtoReflect.Descriptor instead.
-func (*MsgConvertCoinToEvm) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgConvertNFTToEvm.ProtoReflect.Descriptor instead.
+func (*MsgConvertNFTToEvm) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgConvertCoinToEvm) GetToEthAddr() string {
+func (x *MsgConvertNFTToEvm) GetSender() string {
 	if x != nil {
-		return x.ToEthAddr
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *MsgConvertCoinToEvm) GetSender() string {
+func (x *MsgConvertNFTToEvm) GetClassId() string {
 	if x != nil {
-		return x.Sender
+		return x.ClassId
 	}
 	return ""
 }
 
-func (x *MsgConvertCoinToEvm) GetBankCoin() *v1beta1.Coin {
+func (x *MsgConvertNFTToEvm) GetNftId() string {
 	if x != nil {
-		return x.BankCoin
+		return x.NftId
 	}
-	return nil
+	return ""
 }
 
-type MsgConvertCoinToEvmResponse struct {
+func (x *MsgConvertNFTToEvm) GetToEthAddr() string {
+	if x != nil {
+		return x.ToEthAddr
+	}
+	return ""
+}
+
+type MsgConvertNFTToEvmResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgConvertCoinToEvmResponse) Reset() {
-	*x = MsgConvertCoinToEvmResponse{}
+func (x *MsgConvertNFTToEvmResponse) Reset() {
+	*x = MsgConvertNFTToEvmResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eth_evm_v1_tx_proto_msgTypes[11]
+		mi := &file_eth_evm_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgConvertCoinToEvmResponse) String() string {
+func (x *MsgConvertNFTToEvmResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgConvertCoinToEvmResponse) ProtoMessage() {}
+func (*MsgConvertNFTToEvmResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgConvertCoinToEvmResponse.ProtoReflect.Descriptor instead.
-func (*MsgConvertCoinToEvmResponse) Descriptor() ([]byte, []int) {
-	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgConvertNFTToEvmResponse.ProtoReflect.Descriptor instead.
+func (*MsgConvertNFTToEvmResponse) Descriptor() ([]byte, []int) {
+	return file_eth_evm_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
 var File_eth_evm_v1_tx_proto protoreflect.FileDescriptor
@@ -8630,7 +14263,7 @@ var file_eth_evm_v1_tx_proto_rawDesc = []byte{
 	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x0e, 0x82,
 	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x19, 0x0a,
 	0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x55,
 	0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x72, 0x63, 0x32, 0x30, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68,
@@ -8641,62 +14274,181 @@ var file_eth_evm_v1_tx_proto_rawDesc = []byte{
 	0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
 	0x66, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a,
 	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x62, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65,
-	0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0xd7, 0x01, 0x0a, 0x13, 0x4d, 0x73,
-	0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76,
-	0x6d, 0x12, 0x56, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32,
-	0x2f, 0x65, 0x74, 0x68, 0x2e, 0x45, 0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09,
-	0x74, 0x6f, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x12, 0x50, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62,
-	0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43,
-	0x6f, 0x69, 0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x74, 0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xfd, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x6e, 0x0a, 0x0a, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x12, 0x19, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
-	0x6d, 0x54, 0x78, 0x1a, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a,
-	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65,
-	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5f, 0x74, 0x78, 0x12, 0x50, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x74, 0x68,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d,
-	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x25, 0x2e,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x22, 0x62, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x10, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0f, 0x66, 0x75, 0x6e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x22, 0xd7, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x56, 0x0a, 0x0b,
+	0x74, 0x6f, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e,
+	0x45, 0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x09,
+	0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00,
+	0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0x1d,
+	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd4, 0x01,
+	0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54,
+	0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x55, 0x0a,
+	0x0a, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e,
+	0x45, 0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x65, 0x72, 0x63, 0x32, 0x30,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f,
+	0x41, 0x64, 0x64, 0x72, 0x22, 0x6f, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x18, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x22, 0x52, 0x08, 0x62, 0x61, 0x6e,
+	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x0a,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e, 0x45,
+	0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x65, 0x72, 0x63, 0x32, 0x30, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x73, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x62, 0x61,
+	0x73, 0x69, 0x6e, 0x67, 0x22, 0x97, 0x01, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x66,
+	0x75, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x22, 0x82,
+	0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x4e,
+	0x46, 0x54, 0x12, 0x57, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x72, 0x63, 0x37, 0x32,
+	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76,
+	0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e, 0x45, 0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x72, 0x63, 0x37, 0x32, 0x31, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x22, 0x5a, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x75, 0x6e, 0x4e, 0x46, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x6e, 0x66, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e, 0x4e, 0x46, 0x54, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0d, 0x66, 0x75, 0x6e, 0x6e, 0x66, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22,
+	0xd8, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76,
+	0x6d, 0x54, 0x6f, 0x4e, 0x46, 0x54, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x57,
+	0x0a, 0x0b, 0x65, 0x72, 0x63, 0x37, 0x32, 0x31, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74,
+	0x68, 0x2e, 0x45, 0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x0a, 0x65, 0x72, 0x63,
+	0x37, 0x32, 0x31, 0x41, 0x64, 0x64, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x22, 0x4e, 0x0a, 0x1a, 0x4d, 0x73,
+	0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x6f, 0x4e, 0x46, 0x54,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x12, 0x4d,
+	0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x45, 0x76,
+	0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x0b, 0x74,
+	0x6f, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x74, 0x68, 0x2e, 0x45,
+	0x49, 0x50, 0x35, 0x35, 0x41, 0x64, 0x64, 0x72, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x74, 0x68, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xd6, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x6e, 0x0a, 0x0a, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x12, 0x19, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x54, 0x78, 0x1a, 0x21, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a, 0x2f,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5f, 0x74, 0x78, 0x12, 0x50, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x74, 0x68, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x2e,
 	0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43,
-	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x25, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f,
+	0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x43,
+	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x1a, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
 	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
-	0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x1a, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e,
-	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x74, 0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x86, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76,
-	0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e,
-	0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45,
-	0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x43, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x45, 0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d, 0x54,
+	0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x1f, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76, 0x6d,
+	0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x1a, 0x27, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x76,
+	0x6d, 0x54, 0x6f, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x71, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x46, 0x75, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x26, 0x2e, 0x65, 0x74, 0x68,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x46, 0x75, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x1a, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x46, 0x75, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x4e,
+	0x46, 0x54, 0x12, 0x1b, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x4e, 0x46, 0x54, 0x1a,
+	0x23, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x4e, 0x46, 0x54, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45,
+	0x76, 0x6d, 0x54, 0x6f, 0x4e, 0x46, 0x54, 0x12, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45,
+	0x76, 0x6d, 0x54, 0x6f, 0x4e, 0x46, 0x54, 0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45,
+	0x76, 0x6d, 0x54, 0x6f, 0x4e, 0x46, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x45,
+	0x76, 0x6d, 0x12, 0x1e, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x45,
+	0x76, 0x6d, 0x1a, 0x26, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4e, 0x46, 0x54, 0x54, 0x6f, 0x45,
+	0x76, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x86, 0x01, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54,
+	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x45,
+	0x58, 0xaa, 0x02, 0x0a, 0x45, 0x74, 0x68, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0a, 0x45, 0x74, 0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x74,
+	0x68, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x45, 0x74, 0x68, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8711,48 +14463,74 @@ func file_eth_evm_v1_tx_proto_rawDescGZIP() []byte {
 	return file_eth_evm_v1_tx_proto_rawDescData
 }
 
-var file_eth_evm_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_eth_evm_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_eth_evm_v1_tx_proto_goTypes = []interface{}{
-	(*MsgEthereumTx)(nil),               // 0: eth.evm.v1.MsgEthereumTx
-	(*LegacyTx)(nil),                    // 1: eth.evm.v1.LegacyTx
-	(*AccessListTx)(nil),                // 2: eth.evm.v1.AccessListTx
-	(*DynamicFeeTx)(nil),                // 3: eth.evm.v1.DynamicFeeTx
-	(*ExtensionOptionsEthereumTx)(nil),  // 4: eth.evm.v1.ExtensionOptionsEthereumTx
-	(*MsgEthereumTxResponse)(nil),       // 5: eth.evm.v1.MsgEthereumTxResponse
-	(*MsgUpdateParams)(nil),             // 6: eth.evm.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),     // 7: eth.evm.v1.MsgUpdateParamsResponse
-	(*MsgCreateFunToken)(nil),           // 8: eth.evm.v1.MsgCreateFunToken
-	(*MsgCreateFunTokenResponse)(nil),   // 9: eth.evm.v1.MsgCreateFunTokenResponse
-	(*MsgConvertCoinToEvm)(nil),         // 10: eth.evm.v1.MsgConvertCoinToEvm
-	(*MsgConvertCoinToEvmResponse)(nil), // 11: eth.evm.v1.MsgConvertCoinToEvmResponse
-	(*anypb.Any)(nil),                   // 12: google.protobuf.Any
-	(*AccessTuple)(nil),                 // 13: eth.evm.v1.AccessTuple
-	(*Log)(nil),                         // 14: eth.evm.v1.Log
-	(*Params)(nil),                      // 15: eth.evm.v1.Params
-	(*FunToken)(nil),                    // 16: eth.evm.v1.FunToken
-	(*v1beta1.Coin)(nil),                // 17: cosmos.base.v1beta1.Coin
+	(*MsgEthereumTx)(nil),                      // 0: eth.evm.v1.MsgEthereumTx
+	(*LegacyTx)(nil),                           // 1: eth.evm.v1.LegacyTx
+	(*AccessListTx)(nil),                       // 2: eth.evm.v1.AccessListTx
+	(*DynamicFeeTx)(nil),                       // 3: eth.evm.v1.DynamicFeeTx
+	(*ExtensionOptionsEthereumTx)(nil),         // 4: eth.evm.v1.ExtensionOptionsEthereumTx
+	(*MsgEthereumTxResponse)(nil),              // 5: eth.evm.v1.MsgEthereumTxResponse
+	(*MsgUpdateParams)(nil),                    // 6: eth.evm.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),            // 7: eth.evm.v1.MsgUpdateParamsResponse
+	(*MsgCreateFunToken)(nil),                  // 8: eth.evm.v1.MsgCreateFunToken
+	(*MsgCreateFunTokenResponse)(nil),          // 9: eth.evm.v1.MsgCreateFunTokenResponse
+	(*MsgConvertCoinToEvm)(nil),                // 10: eth.evm.v1.MsgConvertCoinToEvm
+	(*MsgConvertCoinToEvmResponse)(nil),        // 11: eth.evm.v1.MsgConvertCoinToEvmResponse
+	(*MsgConvertEvmToCoin)(nil),                // 12: eth.evm.v1.MsgConvertEvmToCoin
+	(*MsgConvertEvmToCoinResponse)(nil),        // 13: eth.evm.v1.MsgConvertEvmToCoinResponse
+	(*MsgReconcileFunTokenEscrow)(nil),         // 14: eth.evm.v1.MsgReconcileFunTokenEscrow
+	(*MsgReconcileFunTokenEscrowResponse)(nil), // 15: eth.evm.v1.MsgReconcileFunTokenEscrowResponse
+	(*MsgCreateFunNFT)(nil),                    // 16: eth.evm.v1.MsgCreateFunNFT
+	(*MsgCreateFunNFTResponse)(nil),            // 17: eth.evm.v1.MsgCreateFunNFTResponse
+	(*MsgConvertEvmToNFT)(nil),                 // 18: eth.evm.v1.MsgConvertEvmToNFT
+	(*MsgConvertEvmToNFTResponse)(nil),         // 19: eth.evm.v1.MsgConvertEvmToNFTResponse
+	(*MsgConvertNFTToEvm)(nil),                 // 20: eth.evm.v1.MsgConvertNFTToEvm
+	(*MsgConvertNFTToEvmResponse)(nil),         // 21: eth.evm.v1.MsgConvertNFTToEvmResponse
+	(*anypb.Any)(nil),                          // 22: google.protobuf.Any
+	(*AccessTuple)(nil),                        // 23: eth.evm.v1.AccessTuple
+	(*Log)(nil),                                // 24: eth.evm.v1.Log
+	(*Params)(nil),                             // 25: eth.evm.v1.Params
+	(*FunToken)(nil),                           // 26: eth.evm.v1.FunToken
+	(*v1beta1.Coin)(nil),                       // 27: cosmos.base.v1beta1.Coin
+	(*FunTokenEscrow)(nil),                     // 28: eth.evm.v1.FunTokenEscrow
+	(*FunNFT)(nil),                             // 29: eth.evm.v1.FunNFT
 }
 var file_eth_evm_v1_tx_proto_depIdxs = []int32{
-	12, // 0: eth.evm.v1.MsgEthereumTx.data:type_name -> google.protobuf.Any
-	13, // 1: eth.evm.v1.AccessListTx.accesses:type_name -> eth.evm.v1.AccessTuple
-	13, // 2: eth.evm.v1.DynamicFeeTx.accesses:type_name -> eth.evm.v1.AccessTuple
-	14, // 3: eth.evm.v1.MsgEthereumTxResponse.logs:type_name -> eth.evm.v1.Log
-	15, // 4: eth.evm.v1.MsgUpdateParams.params:type_name -> eth.evm.v1.Params
-	16, // 5: eth.evm.v1.MsgCreateFunTokenResponse.funtoken_mapping:type_name -> eth.evm.v1.FunToken
-	17, // 6: eth.evm.v1.MsgConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 7: eth.evm.v1.Msg.EthereumTx:input_type -> eth.evm.v1.MsgEthereumTx
-	6,  // 8: eth.evm.v1.Msg.UpdateParams:input_type -> eth.evm.v1.MsgUpdateParams
-	8,  // 9: eth.evm.v1.Msg.CreateFunToken:input_type -> eth.evm.v1.MsgCreateFunToken
-	10, // 10: eth.evm.v1.Msg.ConvertCoinToEvm:input_type -> eth.evm.v1.MsgConvertCoinToEvm
-	5,  // 11: eth.evm.v1.Msg.EthereumTx:output_type -> eth.evm.v1.MsgEthereumTxResponse
-	7,  // 12: eth.evm.v1.Msg.UpdateParams:output_type -> eth.evm.v1.MsgUpdateParamsResponse
-	9,  // 13: eth.evm.v1.Msg.CreateFunToken:output_type -> eth.evm.v1.MsgCreateFunTokenResponse
-	11, // 14: eth.evm.v1.Msg.ConvertCoinToEvm:output_type -> eth.evm.v1.MsgConvertCoinToEvmResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	22, // 0: eth.evm.v1.MsgEthereumTx.data:type_name -> google.protobuf.Any
+	23, // 1: eth.evm.v1.AccessListTx.accesses:type_name -> eth.evm.v1.AccessTuple
+	23, // 2: eth.evm.v1.DynamicFeeTx.accesses:type_name -> eth.evm.v1.AccessTuple
+	24, // 3: eth.evm.v1.MsgEthereumTxResponse.logs:type_name -> eth.evm.v1.Log
+	25, // 4: eth.evm.v1.MsgUpdateParams.params:type_name -> eth.evm.v1.Params
+	26, // 5: eth.evm.v1.MsgCreateFunTokenResponse.funtoken_mapping:type_name -> eth.evm.v1.FunToken
+	27, // 6: eth.evm.v1.MsgConvertCoinToEvm.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	27, // 7: eth.evm.v1.MsgConvertEvmToCoinResponse.bank_coin:type_name -> cosmos.base.v1beta1.Coin
+	26, // 8: eth.evm.v1.MsgReconcileFunTokenEscrowResponse.fun_token:type_name -> eth.evm.v1.FunToken
+	28, // 9: eth.evm.v1.MsgReconcileFunTokenEscrowResponse.escrow:type_name -> eth.evm.v1.FunTokenEscrow
+	29, // 10: eth.evm.v1.MsgCreateFunNFTResponse.funnft_mapping:type_name -> eth.evm.v1.FunNFT
+	0,  // 11: eth.evm.v1.Msg.EthereumTx:input_type -> eth.evm.v1.MsgEthereumTx
+	6,  // 12: eth.evm.v1.Msg.UpdateParams:input_type -> eth.evm.v1.MsgUpdateParams
+	8,  // 13: eth.evm.v1.Msg.CreateFunToken:input_type -> eth.evm.v1.MsgCreateFunToken
+	10, // 14: eth.evm.v1.Msg.ConvertCoinToEvm:input_type -> eth.evm.v1.MsgConvertCoinToEvm
+	12, // 15: eth.evm.v1.Msg.ConvertEvmToCoin:input_type -> eth.evm.v1.MsgConvertEvmToCoin
+	14, // 16: eth.evm.v1.Msg.ReconcileFunTokenEscrow:input_type -> eth.evm.v1.MsgReconcileFunTokenEscrow
+	16, // 17: eth.evm.v1.Msg.CreateFunNFT:input_type -> eth.evm.v1.MsgCreateFunNFT
+	18, // 18: eth.evm.v1.Msg.ConvertEvmToNFT:input_type -> eth.evm.v1.MsgConvertEvmToNFT
+	20, // 19: eth.evm.v1.Msg.ConvertNFTToEvm:input_type -> eth.evm.v1.MsgConvertNFTToEvm
+	5,  // 20: eth.evm.v1.Msg.EthereumTx:output_type -> eth.evm.v1.MsgEthereumTxResponse
+	7,  // 21: eth.evm.v1.Msg.UpdateParams:output_type -> eth.evm.v1.MsgUpdateParamsResponse
+	9,  // 22: eth.evm.v1.Msg.CreateFunToken:output_type -> eth.evm.v1.MsgCreateFunTokenResponse
+	11, // 23: eth.evm.v1.Msg.ConvertCoinToEvm:output_type -> eth.evm.v1.MsgConvertCoinToEvmResponse
+	13, // 24: eth.evm.v1.Msg.ConvertEvmToCoin:output_type -> eth.evm.v1.MsgConvertEvmToCoinResponse
+	15, // 25: eth.evm.v1.Msg.ReconcileFunTokenEscrow:output_type -> eth.evm.v1.MsgReconcileFunTokenEscrowResponse
+	17, // 26: eth.evm.v1.Msg.CreateFunNFT:output_type -> eth.evm.v1.MsgCreateFunNFTResponse
+	19, // 27: eth.evm.v1.Msg.ConvertEvmToNFT:output_type -> eth.evm.v1.MsgConvertEvmToNFTResponse
+	21, // 28: eth.evm.v1.Msg.ConvertNFTToEvm:output_type -> eth.evm.v1.MsgConvertNFTToEvmResponse
+	20, // [20:29] is the sub-list for method output_type
+	11, // [11:20] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_eth_evm_v1_tx_proto_init() }
@@ -8906,6 +14684,126 @@ func file_eth_evm_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_eth_evm_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConvertEvmToCoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConvertEvmToCoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReconcileFunTokenEscrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReconcileFunTokenEscrowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateFunNFT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateFunNFTResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConvertEvmToNFT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConvertEvmToNFTResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConvertNFTToEvm); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eth_evm_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConvertNFTToEvmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8913,7 +14811,7 @@ func file_eth_evm_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eth_evm_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,6 +36,29 @@ type MsgClient interface {
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
 	// representation.
 	ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+	// the EVM address of the sender to the given recipient ("to_addr") in the
+	// corresponding Bank Coin representation. It is the Cosmos equivalent of
+	// "sendToBank" in the FunToken precompile.
+	ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error)
+	// ReconcileFunTokenEscrow: Compares the ERC20 tokens escrowed by the EVM
+	// module for a "FunToken" created from an ERC20 with the supply of its Bank
+	// Coin and flags the mapping as rebasing if the escrow drifted. Only callable
+	// by the x/sudo root or sudo contracts.
+	ReconcileFunTokenEscrow(ctx context.Context, in *MsgReconcileFunTokenEscrow, opts ...grpc.CallOption) (*MsgReconcileFunTokenEscrowResponse, error)
+	// CreateFunNFT: Create a "FunNFT" mapping between an ERC-721 collection and
+	// a new class of the NFT Module ("x/nft"). Existing "x/nft" classes can't
+	// be mapped to an ERC-721 collection.
+	CreateFunNFT(ctx context.Context, in *MsgCreateFunNFT, opts ...grpc.CallOption) (*MsgCreateFunNFTResponse, error)
+	// ConvertEvmToNFT: Sends an ERC-721 token with a valid "FunNFT" mapping from
+	// the EVM address of the sender to the given recipient ("to_addr") as an
+	// "x/nft" token. It is the Cosmos equivalent of "sendNftToBank" in the
+	// FunToken precompile.
+	ConvertEvmToNFT(ctx context.Context, in *MsgConvertEvmToNFT, opts ...grpc.CallOption) (*MsgConvertEvmToNFTResponse, error)
+	// ConvertNFTToEvm: Sends an "x/nft" token with a valid "FunNFT" mapping to
+	// the given recipient address ("to_eth_addr") as the corresponding ERC-721
+	// token.
+	ConvertNFTToEvm(ctx context.Context, in *MsgConvertNFTToEvm, opts ...grpc.CallOption) (*MsgConvertNFTToEvmResponse, error)
 }
 
 type msgClient struct {
@@ -82,6 +105,51 @@ func (c *msgClient) ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEv
 	return out, nil
 }
 
+func (c *msgClient) ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error) {
+	out := new(MsgConvertEvmToCoinResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertEvmToCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReconcileFunTokenEscrow(ctx context.Context, in *MsgReconcileFunTokenEscrow, opts ...grpc.CallOption) (*MsgReconcileFunTokenEscrowResponse, error) {
+	out := new(MsgReconcileFunTokenEscrowResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ReconcileFunTokenEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateFunNFT(ctx context.Context, in *MsgCreateFunNFT, opts ...grpc.CallOption) (*MsgCreateFunNFTResponse, error) {
+	out := new(MsgCreateFunNFTResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/CreateFunNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertEvmToNFT(ctx context.Context, in *MsgConvertEvmToNFT, opts ...grpc.CallOption) (*MsgConvertEvmToNFTResponse, error) {
+	out := new(MsgConvertEvmToNFTResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertEvmToNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertNFTToEvm(ctx context.Context, in *MsgConvertNFTToEvm, opts ...grpc.CallOption) (*MsgConvertNFTToEvmResponse, error) {
+	out := new(MsgConvertNFTToEvmResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertNFTToEvm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -100,6 +168,29 @@ type MsgServer interface {
 	// given recipient address ("to_eth_addr") in the corresponding ERC20
 	// representation.
 	ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+	// the EVM address of the sender to the given recipient ("to_addr") in the
+	// corresponding Bank Coin representation. It is the Cosmos equivalent of
+	// "sendToBank" in the FunToken precompile.
+	ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error)
+	// ReconcileFunTokenEscrow: Compares the ERC20 tokens escrowed by the EVM
+	// module for a "FunToken" created from an ERC20 with the supply of its Bank
+	// Coin and flags the mapping as rebasing if the escrow drifted. Only callable
+	// by the x/sudo root or sudo contracts.
+	ReconcileFunTokenEscrow(context.Context, *MsgReconcileFunTokenEscrow) (*MsgReconcileFunTokenEscrowResponse, error)
+	// CreateFunNFT: Create a "FunNFT" mapping between an ERC-721 collection and
+	// a new class of the NFT Module ("x/nft"). Existing "x/nft" classes can't
+	// be mapped to an ERC-721 collection.
+	CreateFunNFT(context.Context, *MsgCreateFunNFT) (*MsgCreateFunNFTResponse, error)
+	// ConvertEvmToNFT: Sends an ERC-721 token with a valid "FunNFT" mapping from
+	// the EVM address of the sender to the given recipient ("to_addr") as an
+	// "x/nft" token. It is the Cosmos equivalent of "sendNftToBank" in the
+	// FunToken precompile.
+	ConvertEvmToNFT(context.Context, *MsgConvertEvmToNFT) (*MsgConvertEvmToNFTResponse, error)
+	// ConvertNFTToEvm: Sends an "x/nft" token with a valid "FunNFT" mapping to
+	// the given recipient address ("to_eth_addr") as the corresponding ERC-721
+	// token.
+	ConvertNFTToEvm(context.Context, *MsgConvertNFTToEvm) (*MsgConvertNFTToEvmResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -119,6 +210,21 @@ func (UnimplementedMsgServer) CreateFunToken(context.Context, *MsgCreateFunToken
 func (UnimplementedMsgServer) ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToEvm not implemented")
 }
+func (UnimplementedMsgServer) ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToCoin not implemented")
+}
+func (UnimplementedMsgServer) ReconcileFunTokenEscrow(context.Context, *MsgReconcileFunTokenEscrow) (*MsgReconcileFunTokenEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileFunTokenEscrow not implemented")
+}
+func (UnimplementedMsgServer) CreateFunNFT(context.Context, *MsgCreateFunNFT) (*MsgCreateFunNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunNFT not implemented")
+}
+func (UnimplementedMsgServer) ConvertEvmToNFT(context.Context, *MsgConvertEvmToNFT) (*MsgConvertEvmToNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToNFT not implemented")
+}
+func (UnimplementedMsgServer) ConvertNFTToEvm(context.Context, *MsgConvertNFTToEvm) (*MsgConvertNFTToEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertNFTToEvm not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -204,6 +310,96 @@ func _Msg_ConvertCoinToEvm_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConvertEvmToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertEvmToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertEvmToCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, req.(*MsgConvertEvmToCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReconcileFunTokenEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReconcileFunTokenEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReconcileFunTokenEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ReconcileFunTokenEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReconcileFunTokenEscrow(ctx, req.(*MsgReconcileFunTokenEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateFunNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFunNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFunNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/CreateFunNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFunNFT(ctx, req.(*MsgCreateFunNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertEvmToNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertEvmToNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertEvmToNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertEvmToNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertEvmToNFT(ctx, req.(*MsgConvertEvmToNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertNFTToEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertNFTToEvm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertNFTToEvm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertNFTToEvm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertNFTToEvm(ctx, req.(*MsgConvertNFTToEvm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +423,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertCoinToEvm",
 			Handler:    _Msg_ConvertCoinToEvm_Handler,
 		},
+		{
+			MethodName: "ConvertEvmToCoin",
+			Handler:    _Msg_ConvertEvmToCoin_Handler,
+		},
+		{
+			MethodName: "ReconcileFunTokenEscrow",
+			Handler:    _Msg_ReconcileFunTokenEscrow_Handler,
+		},
+		{
+			MethodName: "CreateFunNFT",
+			Handler:    _Msg_CreateFunNFT_Handler,
+		},
+		{
+			MethodName: "ConvertEvmToNFT",
+			Handler:    _Msg_ConvertEvmToNFT_Handler,
+		},
+		{
+			MethodName: "ConvertNFTToEvm",
+			Handler:    _Msg_ConvertNFTToEvm_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/tx.proto",
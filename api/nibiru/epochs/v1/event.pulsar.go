@@ -901,6 +901,666 @@ func (x *fastReflection_EventEpochEnd) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_EventEpochSubscriberError              protoreflect.MessageDescriptor
+	fd_EventEpochSubscriberError_contract     protoreflect.FieldDescriptor
+	fd_EventEpochSubscriberError_identifier   protoreflect.FieldDescriptor
+	fd_EventEpochSubscriberError_epoch_number protoreflect.FieldDescriptor
+	fd_EventEpochSubscriberError_hook         protoreflect.FieldDescriptor
+	fd_EventEpochSubscriberError_error        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_epochs_v1_event_proto_init()
+	md_EventEpochSubscriberError = File_nibiru_epochs_v1_event_proto.Messages().ByName("EventEpochSubscriberError")
+	fd_EventEpochSubscriberError_contract = md_EventEpochSubscriberError.Fields().ByName("contract")
+	fd_EventEpochSubscriberError_identifier = md_EventEpochSubscriberError.Fields().ByName("identifier")
+	fd_EventEpochSubscriberError_epoch_number = md_EventEpochSubscriberError.Fields().ByName("epoch_number")
+	fd_EventEpochSubscriberError_hook = md_EventEpochSubscriberError.Fields().ByName("hook")
+	fd_EventEpochSubscriberError_error = md_EventEpochSubscriberError.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventEpochSubscriberError)(nil)
+
+type fastReflection_EventEpochSubscriberError EventEpochSubscriberError
+
+func (x *EventEpochSubscriberError) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventEpochSubscriberError)(x)
+}
+
+func (x *EventEpochSubscriberError) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_epochs_v1_event_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventEpochSubscriberError_messageType fastReflection_EventEpochSubscriberError_messageType
+var _ protoreflect.MessageType = fastReflection_EventEpochSubscriberError_messageType{}
+
+type fastReflection_EventEpochSubscriberError_messageType struct{}
+
+func (x fastReflection_EventEpochSubscriberError_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventEpochSubscriberError)(nil)
+}
+func (x fastReflection_EventEpochSubscriberError_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventEpochSubscriberError)
+}
+func (x fastReflection_EventEpochSubscriberError_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventEpochSubscriberError
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventEpochSubscriberError) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventEpochSubscriberError
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventEpochSubscriberError) Type() protoreflect.MessageType {
+	return _fastReflection_EventEpochSubscriberError_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventEpochSubscriberError) New() protoreflect.Message {
+	return new(fastReflection_EventEpochSubscriberError)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventEpochSubscriberError) Interface() protoreflect.ProtoMessage {
+	return (*EventEpochSubscriberError)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventEpochSubscriberError) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_EventEpochSubscriberError_contract, value) {
+			return
+		}
+	}
+	if x.Identifier != "" {
+		value := protoreflect.ValueOfString(x.Identifier)
+		if !f(fd_EventEpochSubscriberError_identifier, value) {
+			return
+		}
+	}
+	if x.EpochNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochNumber)
+		if !f(fd_EventEpochSubscriberError_epoch_number, value) {
+			return
+		}
+	}
+	if x.Hook != "" {
+		value := protoreflect.ValueOfString(x.Hook)
+		if !f(fd_EventEpochSubscriberError_hook, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventEpochSubscriberError_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventEpochSubscriberError) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.epochs.v1.EventEpochSubscriberError.contract":
+		return x.Contract != ""
+	case "nibiru.epochs.v1.EventEpochSubscriberError.identifier":
+		return x.Identifier != ""
+	case "nibiru.epochs.v1.EventEpochSubscriberError.epoch_number":
+		return x.EpochNumber != uint64(0)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.hook":
+		return x.Hook != ""
+	case "nibiru.epochs.v1.EventEpochSubscriberError.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.epochs.v1.EventEpochSubscriberError"))
+		}
+		panic(fmt.Errorf("message nibiru.epochs.v1.EventEpochSubscriberError does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventEpochSubscriberError) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.epochs.v1.EventEpochSubscriberError.contract":
+		x.Contract = ""
+	case "nibiru.epochs.v1.EventEpochSubscriberError.identifier":
+		x.Identifier = ""
+	case "nibiru.epochs.v1.EventEpochSubscriberError.epoch_number":
+		x.EpochNumber = uint64(0)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.hook":
+		x.Hook = ""
+	case "nibiru.epochs.v1.EventEpochSubscriberError.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.epochs.v1.EventEpochSubscriberError"))
+		}
+		panic(fmt.Errorf("message nibiru.epochs.v1.EventEpochSubscriberError does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventEpochSubscriberError) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.epochs.v1.EventEpochSubscriberError.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfString(value)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.epoch_number":
+		value := x.EpochNumber
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.hook":
+		value := x.Hook
+		return protoreflect.ValueOfString(value)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.epochs.v1.EventEpochSubscriberError"))
+		}
+		panic(fmt.Errorf("message nibiru.epochs.v1.EventEpochSubscriberError does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventEpochSubscriberError) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.epochs.v1.EventEpochSubscriberError.contract":
+		x.Contract = value.Interface().(string)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.identifier":
+		x.Identifier = value.Interface().(string)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.epoch_number":
+		x.EpochNumber = value.Uint()
+	case "nibiru.epochs.v1.EventEpochSubscriberError.hook":
+		x.Hook = value.Interface().(string)
+	case "nibiru.epochs.v1.EventEpochSubscriberError.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.epochs.v1.EventEpochSubscriberError"))
+		}
+		panic(fmt.Errorf("message nibiru.epochs.v1.EventEpochSubscriberError does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventEpochSubscriberError) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.epochs.v1.EventEpochSubscriberError.contract":
+		panic(fmt.Errorf("field contract of message nibiru.epochs.v1.EventEpochSubscriberError is not mutable"))
+	case "nibiru.epochs.v1.EventEpochSubscriberError.identifier":
+		panic(fmt.Errorf("field identifier of message nibiru.epochs.v1.EventEpochSubscriberError is not mutable"))
+	case "nibiru.epochs.v1.EventEpochSubscriberError.epoch_number":
+		panic(fmt.Errorf("field epoch_number of message nibiru.epochs.v1.EventEpochSubscriberError is not mutable"))
+	case "nibiru.epochs.v1.EventEpochSubscriberError.hook":
+		panic(fmt.Errorf("field hook of message nibiru.epochs.v1.EventEpochSubscriberError is not mutable"))
+	case "nibiru.epochs.v1.EventEpochSubscriberError.error":
+		panic(fmt.Errorf("field error of message nibiru.epochs.v1.EventEpochSubscriberError is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.epochs.v1.EventEpochSubscriberError"))
+		}
+		panic(fmt.Errorf("message nibiru.epochs.v1.EventEpochSubscriberError does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventEpochSubscriberError) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.epochs.v1.EventEpochSubscriberError.contract":
+		return protoreflect.ValueOfString("")
+	case "nibiru.epochs.v1.EventEpochSubscriberError.identifier":
+		return protoreflect.ValueOfString("")
+	case "nibiru.epochs.v1.EventEpochSubscriberError.epoch_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.epochs.v1.EventEpochSubscriberError.hook":
+		return protoreflect.ValueOfString("")
+	case "nibiru.epochs.v1.EventEpochSubscriberError.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.epochs.v1.EventEpochSubscriberError"))
+		}
+		panic(fmt.Errorf("message nibiru.epochs.v1.EventEpochSubscriberError does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventEpochSubscriberError) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.epochs.v1.EventEpochSubscriberError", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventEpochSubscriberError) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventEpochSubscriberError) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventEpochSubscriberError) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventEpochSubscriberError) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventEpochSubscriberError)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Identifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochNumber))
+		}
+		l = len(x.Hook)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventEpochSubscriberError)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Hook) > 0 {
+			i -= len(x.Hook)
+			copy(dAtA[i:], x.Hook)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hook)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EpochNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Identifier) > 0 {
+			i -= len(x.Identifier)
+			copy(dAtA[i:], x.Identifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identifier)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventEpochSubscriberError)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventEpochSubscriberError: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventEpochSubscriberError: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Identifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				}
+				x.EpochNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hook = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -995,6 +1655,77 @@ func (x *EventEpochEnd) GetEpochNumber() uint64 {
 	return 0
 }
 
+// EventEpochSubscriberError: emitted when an epoch hook call to a subscribed
+// contract fails. The state changes of the failed call are reverted.
+type EventEpochSubscriberError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract    string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Identifier  string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	EpochNumber uint64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// Name of the hook, "after_epoch_end" or "before_epoch_start".
+	// EVM contracts only receive "after_epoch_end".
+	Hook  string `protobuf:"bytes,4,opt,name=hook,proto3" json:"hook,omitempty"`
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EventEpochSubscriberError) Reset() {
+	*x = EventEpochSubscriberError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_epochs_v1_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventEpochSubscriberError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEpochSubscriberError) ProtoMessage() {}
+
+// Deprecated: Use EventEpochSubscriberError.ProtoReflect.Descriptor instead.
+func (*EventEpochSubscriberError) Descriptor() ([]byte, []int) {
+	return file_nibiru_epochs_v1_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventEpochSubscriberError) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *EventEpochSubscriberError) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *EventEpochSubscriberError) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *EventEpochSubscriberError) GetHook() string {
+	if x != nil {
+		return x.Hook
+	}
+	return ""
+}
+
+func (x *EventEpochSubscriberError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_nibiru_epochs_v1_event_proto protoreflect.FileDescriptor
 
 var file_nibiru_epochs_v1_event_proto_rawDesc = []byte{
@@ -1016,18 +1747,29 @@ var file_nibiru_epochs_v1_event_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6e, 0x64, 0x12,
 	0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x42, 0xb0, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x45, 0x58, 0xaa, 0x02, 0x10, 0x4e, 0x69,
-	0x62, 0x69, 0x72, 0x75, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x1c, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x45, 0x70, 0x6f, 0x63, 0x68,
-	0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x12, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x45, 0x70, 0x6f, 0x63, 0x68,
-	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0xb0, 0x01, 0x0a, 0x14, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e,
+	0x45, 0x58, 0xaa, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x4e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x5c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x3a, 0x3a, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1042,14 +1784,15 @@ func file_nibiru_epochs_v1_event_proto_rawDescGZIP() []byte {
 	return file_nibiru_epochs_v1_event_proto_rawDescData
 }
 
-var file_nibiru_epochs_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_nibiru_epochs_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_nibiru_epochs_v1_event_proto_goTypes = []interface{}{
-	(*EventEpochStart)(nil),       // 0: nibiru.epochs.v1.EventEpochStart
-	(*EventEpochEnd)(nil),         // 1: nibiru.epochs.v1.EventEpochEnd
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*EventEpochStart)(nil),           // 0: nibiru.epochs.v1.EventEpochStart
+	(*EventEpochEnd)(nil),             // 1: nibiru.epochs.v1.EventEpochEnd
+	(*EventEpochSubscriberError)(nil), // 2: nibiru.epochs.v1.EventEpochSubscriberError
+	(*timestamppb.Timestamp)(nil),     // 3: google.protobuf.Timestamp
 }
 var file_nibiru_epochs_v1_event_proto_depIdxs = []int32{
-	2, // 0: nibiru.epochs.v1.EventEpochStart.epoch_start_time:type_name -> google.protobuf.Timestamp
+	3, // 0: nibiru.epochs.v1.EventEpochStart.epoch_start_time:type_name -> google.protobuf.Timestamp
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -1087,6 +1830,18 @@ func file_nibiru_epochs_v1_event_proto_init() {
 				return nil
 			}
 		}
+		file_nibiru_epochs_v1_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventEpochSubscriberError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1094,7 +1849,7 @@ func file_nibiru_epochs_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nibiru_epochs_v1_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,6 +26,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochInfosRequest, opts ...grpc.CallOption) (*QueryEpochInfosResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochSubscribers provide the contracts subscribed to the hooks of an
+	// epoch identifier
+	EpochSubscribers(ctx context.Context, in *QueryEpochSubscribersRequest, opts ...grpc.CallOption) (*QueryEpochSubscribersResponse, error)
 }
 
 type queryClient struct {
@@ -54,6 +57,15 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) EpochSubscribers(ctx context.Context, in *QueryEpochSubscribersRequest, opts ...grpc.CallOption) (*QueryEpochSubscribersResponse, error) {
+	out := new(QueryEpochSubscribersResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.epochs.v1.Query/EpochSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -62,6 +74,9 @@ type QueryServer interface {
 	EpochInfos(context.Context, *QueryEpochInfosRequest) (*QueryEpochInfosResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochSubscribers provide the contracts subscribed to the hooks of an
+	// epoch identifier
+	EpochSubscribers(context.Context, *QueryEpochSubscribersRequest) (*QueryEpochSubscribersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -75,6 +90,9 @@ func (UnimplementedQueryServer) EpochInfos(context.Context, *QueryEpochInfosRequ
 func (UnimplementedQueryServer) CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (UnimplementedQueryServer) EpochSubscribers(context.Context, *QueryEpochSubscribersRequest) (*QueryEpochSubscribersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSubscribers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -124,6 +142,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.epochs.v1.Query/EpochSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSubscribers(ctx, req.(*QueryEpochSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -139,6 +175,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "EpochSubscribers",
+			Handler:    _Query_EpochSubscribers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/epochs/v1/query.proto",
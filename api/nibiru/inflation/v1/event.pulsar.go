@@ -14,11 +14,63 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_EventInflationDistribution_4_list)(nil)
+
+type _EventInflationDistribution_4_list struct {
+	list *[]*InflationRecipientAllocation
+}
+
+func (x *_EventInflationDistribution_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventInflationDistribution_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventInflationDistribution_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipientAllocation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventInflationDistribution_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipientAllocation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventInflationDistribution_4_list) AppendMutable() protoreflect.Value {
+	v := new(InflationRecipientAllocation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventInflationDistribution_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventInflationDistribution_4_list) NewElement() protoreflect.Value {
+	v := new(InflationRecipientAllocation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventInflationDistribution_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_EventInflationDistribution                   protoreflect.MessageDescriptor
 	fd_EventInflationDistribution_staking_rewards   protoreflect.FieldDescriptor
 	fd_EventInflationDistribution_strategic_reserve protoreflect.FieldDescriptor
 	fd_EventInflationDistribution_community_pool    protoreflect.FieldDescriptor
+	fd_EventInflationDistribution_recipients        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +79,7 @@ func init() {
 	fd_EventInflationDistribution_staking_rewards = md_EventInflationDistribution.Fields().ByName("staking_rewards")
 	fd_EventInflationDistribution_strategic_reserve = md_EventInflationDistribution.Fields().ByName("strategic_reserve")
 	fd_EventInflationDistribution_community_pool = md_EventInflationDistribution.Fields().ByName("community_pool")
+	fd_EventInflationDistribution_recipients = md_EventInflationDistribution.Fields().ByName("recipients")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventInflationDistribution)(nil)
@@ -112,6 +165,12 @@ func (x *fastReflection_EventInflationDistribution) Range(f func(protoreflect.Fi
 			return
 		}
 	}
+	if len(x.Recipients) != 0 {
+		value := protoreflect.ValueOfList(&_EventInflationDistribution_4_list{list: &x.Recipients})
+		if !f(fd_EventInflationDistribution_recipients, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -133,6 +192,8 @@ func (x *fastReflection_EventInflationDistribution) Has(fd protoreflect.FieldDes
 		return x.StrategicReserve != nil
 	case "nibiru.inflation.v1.EventInflationDistribution.community_pool":
 		return x.CommunityPool != nil
+	case "nibiru.inflation.v1.EventInflationDistribution.recipients":
+		return len(x.Recipients) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationDistribution"))
@@ -155,6 +216,8 @@ func (x *fastReflection_EventInflationDistribution) Clear(fd protoreflect.FieldD
 		x.StrategicReserve = nil
 	case "nibiru.inflation.v1.EventInflationDistribution.community_pool":
 		x.CommunityPool = nil
+	case "nibiru.inflation.v1.EventInflationDistribution.recipients":
+		x.Recipients = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationDistribution"))
@@ -180,6 +243,12 @@ func (x *fastReflection_EventInflationDistribution) Get(descriptor protoreflect.
 	case "nibiru.inflation.v1.EventInflationDistribution.community_pool":
 		value := x.CommunityPool
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.EventInflationDistribution.recipients":
+		if len(x.Recipients) == 0 {
+			return protoreflect.ValueOfList(&_EventInflationDistribution_4_list{})
+		}
+		listValue := &_EventInflationDistribution_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationDistribution"))
@@ -206,6 +275,10 @@ func (x *fastReflection_EventInflationDistribution) Set(fd protoreflect.FieldDes
 		x.StrategicReserve = value.Message().Interface().(*v1beta1.Coin)
 	case "nibiru.inflation.v1.EventInflationDistribution.community_pool":
 		x.CommunityPool = value.Message().Interface().(*v1beta1.Coin)
+	case "nibiru.inflation.v1.EventInflationDistribution.recipients":
+		lv := value.List()
+		clv := lv.(*_EventInflationDistribution_4_list)
+		x.Recipients = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationDistribution"))
@@ -241,6 +314,12 @@ func (x *fastReflection_EventInflationDistribution) Mutable(fd protoreflect.Fiel
 			x.CommunityPool = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.CommunityPool.ProtoReflect())
+	case "nibiru.inflation.v1.EventInflationDistribution.recipients":
+		if x.Recipients == nil {
+			x.Recipients = []*InflationRecipientAllocation{}
+		}
+		value := &_EventInflationDistribution_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationDistribution"))
@@ -263,6 +342,9 @@ func (x *fastReflection_EventInflationDistribution) NewField(fd protoreflect.Fie
 	case "nibiru.inflation.v1.EventInflationDistribution.community_pool":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.EventInflationDistribution.recipients":
+		list := []*InflationRecipientAllocation{}
+		return protoreflect.ValueOfList(&_EventInflationDistribution_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationDistribution"))
@@ -344,6 +426,12 @@ func (x *fastReflection_EventInflationDistribution) ProtoMethods() *protoiface.M
 			l = options.Size(x.CommunityPool)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Recipients) > 0 {
+			for _, e := range x.Recipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -373,6 +461,22 @@ func (x *fastReflection_EventInflationDistribution) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Recipients) > 0 {
+			for iNdEx := len(x.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Recipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.CommunityPool != nil {
 			encoded, err := options.Marshal(x.CommunityPool)
 			if err != nil {
@@ -572,6 +676,40 @@ func (x *fastReflection_EventInflationDistribution) ProtoMethods() *protoiface.M
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipients = append(x.Recipients, &InflationRecipientAllocation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Recipients[len(x.Recipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -607,148 +745,1425 @@ func (x *fastReflection_EventInflationDistribution) ProtoMethods() *protoiface.M
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: nibiru/inflation/v1/event.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_InflationRecipientAllocation         protoreflect.MessageDescriptor
+	fd_InflationRecipientAllocation_address protoreflect.FieldDescriptor
+	fd_InflationRecipientAllocation_amount  protoreflect.FieldDescriptor
+	fd_InflationRecipientAllocation_error   protoreflect.FieldDescriptor
 )
 
-// EventInflationDistribution: Emitted when NIBI tokens are minted on the
-// network based on Nibiru's inflation schedule.
-type EventInflationDistribution struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_nibiru_inflation_v1_event_proto_init()
+	md_InflationRecipientAllocation = File_nibiru_inflation_v1_event_proto.Messages().ByName("InflationRecipientAllocation")
+	fd_InflationRecipientAllocation_address = md_InflationRecipientAllocation.Fields().ByName("address")
+	fd_InflationRecipientAllocation_amount = md_InflationRecipientAllocation.Fields().ByName("amount")
+	fd_InflationRecipientAllocation_error = md_InflationRecipientAllocation.Fields().ByName("error")
+}
 
-	StakingRewards   *v1beta1.Coin `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
-	StrategicReserve *v1beta1.Coin `protobuf:"bytes,2,opt,name=strategic_reserve,json=strategicReserve,proto3" json:"strategic_reserve,omitempty"`
-	CommunityPool    *v1beta1.Coin `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+var _ protoreflect.Message = (*fastReflection_InflationRecipientAllocation)(nil)
+
+type fastReflection_InflationRecipientAllocation InflationRecipientAllocation
+
+func (x *InflationRecipientAllocation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationRecipientAllocation)(x)
 }
 
-func (x *EventInflationDistribution) Reset() {
-	*x = EventInflationDistribution{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nibiru_inflation_v1_event_proto_msgTypes[0]
+func (x *InflationRecipientAllocation) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_event_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *EventInflationDistribution) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_InflationRecipientAllocation_messageType fastReflection_InflationRecipientAllocation_messageType
+var _ protoreflect.MessageType = fastReflection_InflationRecipientAllocation_messageType{}
 
-func (*EventInflationDistribution) ProtoMessage() {}
+type fastReflection_InflationRecipientAllocation_messageType struct{}
 
-// Deprecated: Use EventInflationDistribution.ProtoReflect.Descriptor instead.
-func (*EventInflationDistribution) Descriptor() ([]byte, []int) {
-	return file_nibiru_inflation_v1_event_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_InflationRecipientAllocation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationRecipientAllocation)(nil)
+}
+func (x fastReflection_InflationRecipientAllocation_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationRecipientAllocation)
+}
+func (x fastReflection_InflationRecipientAllocation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationRecipientAllocation
 }
 
-func (x *EventInflationDistribution) GetStakingRewards() *v1beta1.Coin {
-	if x != nil {
-		return x.StakingRewards
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InflationRecipientAllocation) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationRecipientAllocation
 }
 
-func (x *EventInflationDistribution) GetStrategicReserve() *v1beta1.Coin {
-	if x != nil {
-		return x.StrategicReserve
-	}
-	return nil
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InflationRecipientAllocation) Type() protoreflect.MessageType {
+	return _fastReflection_InflationRecipientAllocation_messageType
 }
 
-func (x *EventInflationDistribution) GetCommunityPool() *v1beta1.Coin {
-	if x != nil {
-		return x.CommunityPool
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InflationRecipientAllocation) New() protoreflect.Message {
+	return new(fastReflection_InflationRecipientAllocation)
 }
 
-var File_nibiru_inflation_v1_event_proto protoreflect.FileDescriptor
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InflationRecipientAllocation) Interface() protoreflect.ProtoMessage {
+	return (*InflationRecipientAllocation)(x)
+}
 
-var file_nibiru_inflation_v1_event_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x13, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x02, 0x0a,
-	0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x0f, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x52,
-	0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
-	0x68, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x20, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x18, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x63, 0x5f, 0x72,
-	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x22, 0x52, 0x10, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x69, 0x63, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1d, 0xc8, 0xde,
-	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d,
-	0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
-	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0xc5, 0x01, 0x0a, 0x17, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x4e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69, 0x62,
-	0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InflationRecipientAllocation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_InflationRecipientAllocation_address, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_InflationRecipientAllocation_amount, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_InflationRecipientAllocation_error, value) {
+			return
+		}
+	}
 }
 
-var (
-	file_nibiru_inflation_v1_event_proto_rawDescOnce sync.Once
-	file_nibiru_inflation_v1_event_proto_rawDescData = file_nibiru_inflation_v1_event_proto_rawDesc
-)
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InflationRecipientAllocation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipientAllocation.address":
+		return x.Address != ""
+	case "nibiru.inflation.v1.InflationRecipientAllocation.amount":
+		return x.Amount != nil
+	case "nibiru.inflation.v1.InflationRecipientAllocation.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipientAllocation"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipientAllocation does not contain field %s", fd.FullName()))
+	}
+}
 
-func file_nibiru_inflation_v1_event_proto_rawDescGZIP() []byte {
-	file_nibiru_inflation_v1_event_proto_rawDescOnce.Do(func() {
-		file_nibiru_inflation_v1_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_nibiru_inflation_v1_event_proto_rawDescData)
-	})
-	return file_nibiru_inflation_v1_event_proto_rawDescData
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationRecipientAllocation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipientAllocation.address":
+		x.Address = ""
+	case "nibiru.inflation.v1.InflationRecipientAllocation.amount":
+		x.Amount = nil
+	case "nibiru.inflation.v1.InflationRecipientAllocation.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipientAllocation"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipientAllocation does not contain field %s", fd.FullName()))
+	}
 }
 
-var file_nibiru_inflation_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_nibiru_inflation_v1_event_proto_goTypes = []interface{}{
-	(*EventInflationDistribution)(nil), // 0: nibiru.inflation.v1.EventInflationDistribution
-	(*v1beta1.Coin)(nil),               // 1: cosmos.base.v1beta1.Coin
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InflationRecipientAllocation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.InflationRecipientAllocation.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationRecipientAllocation.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.InflationRecipientAllocation.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipientAllocation"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipientAllocation does not contain field %s", descriptor.FullName()))
+	}
 }
-var file_nibiru_inflation_v1_event_proto_depIdxs = []int32{
-	1, // 0: nibiru.inflation.v1.EventInflationDistribution.staking_rewards:type_name -> cosmos.base.v1beta1.Coin
-	1, // 1: nibiru.inflation.v1.EventInflationDistribution.strategic_reserve:type_name -> cosmos.base.v1beta1.Coin
-	1, // 2: nibiru.inflation.v1.EventInflationDistribution.community_pool:type_name -> cosmos.base.v1beta1.Coin
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationRecipientAllocation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipientAllocation.address":
+		x.Address = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationRecipientAllocation.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "nibiru.inflation.v1.InflationRecipientAllocation.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipientAllocation"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipientAllocation does not contain field %s", fd.FullName()))
+	}
 }
 
-func init() { file_nibiru_inflation_v1_event_proto_init() }
-func file_nibiru_inflation_v1_event_proto_init() {
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationRecipientAllocation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipientAllocation.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "nibiru.inflation.v1.InflationRecipientAllocation.address":
+		panic(fmt.Errorf("field address of message nibiru.inflation.v1.InflationRecipientAllocation is not mutable"))
+	case "nibiru.inflation.v1.InflationRecipientAllocation.error":
+		panic(fmt.Errorf("field error of message nibiru.inflation.v1.InflationRecipientAllocation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipientAllocation"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipientAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InflationRecipientAllocation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipientAllocation.address":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationRecipientAllocation.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.InflationRecipientAllocation.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipientAllocation"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipientAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InflationRecipientAllocation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.InflationRecipientAllocation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InflationRecipientAllocation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationRecipientAllocation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InflationRecipientAllocation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InflationRecipientAllocation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InflationRecipientAllocation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InflationRecipientAllocation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InflationRecipientAllocation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationRecipientAllocation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationRecipientAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventInflationRecipientFallback         protoreflect.MessageDescriptor
+	fd_EventInflationRecipientFallback_address protoreflect.FieldDescriptor
+	fd_EventInflationRecipientFallback_amount  protoreflect.FieldDescriptor
+	fd_EventInflationRecipientFallback_error   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_event_proto_init()
+	md_EventInflationRecipientFallback = File_nibiru_inflation_v1_event_proto.Messages().ByName("EventInflationRecipientFallback")
+	fd_EventInflationRecipientFallback_address = md_EventInflationRecipientFallback.Fields().ByName("address")
+	fd_EventInflationRecipientFallback_amount = md_EventInflationRecipientFallback.Fields().ByName("amount")
+	fd_EventInflationRecipientFallback_error = md_EventInflationRecipientFallback.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventInflationRecipientFallback)(nil)
+
+type fastReflection_EventInflationRecipientFallback EventInflationRecipientFallback
+
+func (x *EventInflationRecipientFallback) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventInflationRecipientFallback)(x)
+}
+
+func (x *EventInflationRecipientFallback) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_event_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventInflationRecipientFallback_messageType fastReflection_EventInflationRecipientFallback_messageType
+var _ protoreflect.MessageType = fastReflection_EventInflationRecipientFallback_messageType{}
+
+type fastReflection_EventInflationRecipientFallback_messageType struct{}
+
+func (x fastReflection_EventInflationRecipientFallback_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventInflationRecipientFallback)(nil)
+}
+func (x fastReflection_EventInflationRecipientFallback_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventInflationRecipientFallback)
+}
+func (x fastReflection_EventInflationRecipientFallback_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventInflationRecipientFallback
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventInflationRecipientFallback) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventInflationRecipientFallback
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventInflationRecipientFallback) Type() protoreflect.MessageType {
+	return _fastReflection_EventInflationRecipientFallback_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventInflationRecipientFallback) New() protoreflect.Message {
+	return new(fastReflection_EventInflationRecipientFallback)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventInflationRecipientFallback) Interface() protoreflect.ProtoMessage {
+	return (*EventInflationRecipientFallback)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventInflationRecipientFallback) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_EventInflationRecipientFallback_address, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_EventInflationRecipientFallback_amount, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventInflationRecipientFallback_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventInflationRecipientFallback) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.address":
+		return x.Address != ""
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.amount":
+		return x.Amount != nil
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationRecipientFallback"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.EventInflationRecipientFallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventInflationRecipientFallback) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.address":
+		x.Address = ""
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.amount":
+		x.Amount = nil
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationRecipientFallback"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.EventInflationRecipientFallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventInflationRecipientFallback) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationRecipientFallback"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.EventInflationRecipientFallback does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventInflationRecipientFallback) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.address":
+		x.Address = value.Interface().(string)
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationRecipientFallback"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.EventInflationRecipientFallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventInflationRecipientFallback) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.address":
+		panic(fmt.Errorf("field address of message nibiru.inflation.v1.EventInflationRecipientFallback is not mutable"))
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.error":
+		panic(fmt.Errorf("field error of message nibiru.inflation.v1.EventInflationRecipientFallback is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationRecipientFallback"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.EventInflationRecipientFallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventInflationRecipientFallback) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.address":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.EventInflationRecipientFallback.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.EventInflationRecipientFallback"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.EventInflationRecipientFallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventInflationRecipientFallback) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.EventInflationRecipientFallback", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventInflationRecipientFallback) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventInflationRecipientFallback) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventInflationRecipientFallback) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventInflationRecipientFallback) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventInflationRecipientFallback)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventInflationRecipientFallback)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventInflationRecipientFallback)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventInflationRecipientFallback: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventInflationRecipientFallback: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: nibiru/inflation/v1/event.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventInflationDistribution: Emitted when NIBI tokens are minted on the
+// network based on Nibiru's inflation schedule.
+type EventInflationDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StakingRewards   *v1beta1.Coin `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
+	StrategicReserve *v1beta1.Coin `protobuf:"bytes,2,opt,name=strategic_reserve,json=strategicReserve,proto3" json:"strategic_reserve,omitempty"`
+	CommunityPool    *v1beta1.Coin `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// recipients: Amounts credited to the additional inflation recipients from
+	// "InflationDistribution.recipients".
+	Recipients []*InflationRecipientAllocation `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (x *EventInflationDistribution) Reset() {
+	*x = EventInflationDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_event_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventInflationDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInflationDistribution) ProtoMessage() {}
+
+// Deprecated: Use EventInflationDistribution.ProtoReflect.Descriptor instead.
+func (*EventInflationDistribution) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventInflationDistribution) GetStakingRewards() *v1beta1.Coin {
+	if x != nil {
+		return x.StakingRewards
+	}
+	return nil
+}
+
+func (x *EventInflationDistribution) GetStrategicReserve() *v1beta1.Coin {
+	if x != nil {
+		return x.StrategicReserve
+	}
+	return nil
+}
+
+func (x *EventInflationDistribution) GetCommunityPool() *v1beta1.Coin {
+	if x != nil {
+		return x.CommunityPool
+	}
+	return nil
+}
+
+func (x *EventInflationDistribution) GetRecipients() []*InflationRecipientAllocation {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// InflationRecipientAllocation: Amount of inflation allocated to one of the
+// additional inflation recipients.
+type InflationRecipientAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address of the recipient, as given in "InflationRecipient.address".
+	Address string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// error is non-empty if crediting the recipient failed. The amount then
+	// falls through to the strategic reserve.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *InflationRecipientAllocation) Reset() {
+	*x = InflationRecipientAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationRecipientAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationRecipientAllocation) ProtoMessage() {}
+
+// Deprecated: Use InflationRecipientAllocation.ProtoReflect.Descriptor instead.
+func (*InflationRecipientAllocation) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InflationRecipientAllocation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InflationRecipientAllocation) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *InflationRecipientAllocation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EventInflationRecipientFallback: Emitted when an additional inflation
+// recipient can't be credited, so its share goes to the strategic reserve
+// instead.
+type EventInflationRecipientFallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address of the recipient, as given in "InflationRecipient.address".
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount: The share of the recipient sent to the strategic reserve.
+	Amount *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// error: Why crediting the recipient failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EventInflationRecipientFallback) Reset() {
+	*x = EventInflationRecipientFallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventInflationRecipientFallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInflationRecipientFallback) ProtoMessage() {}
+
+// Deprecated: Use EventInflationRecipientFallback.ProtoReflect.Descriptor instead.
+func (*EventInflationRecipientFallback) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventInflationRecipientFallback) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EventInflationRecipientFallback) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *EventInflationRecipientFallback) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_nibiru_inflation_v1_event_proto protoreflect.FileDescriptor
+
+var file_nibiru_inflation_v1_event_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x03, 0x0a,
+	0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x0f, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x52,
+	0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x68, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x20, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x18, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x63, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x22, 0x52, 0x10, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x69, 0x63, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1d, 0xc8, 0xde,
+	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x52, 0x0d, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x57, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x1c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8a, 0x01,
+	0x0a, 0x1f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0xc5, 0x01, 0x0a, 0x17, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x4e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nibiru_inflation_v1_event_proto_rawDescOnce sync.Once
+	file_nibiru_inflation_v1_event_proto_rawDescData = file_nibiru_inflation_v1_event_proto_rawDesc
+)
+
+func file_nibiru_inflation_v1_event_proto_rawDescGZIP() []byte {
+	file_nibiru_inflation_v1_event_proto_rawDescOnce.Do(func() {
+		file_nibiru_inflation_v1_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_nibiru_inflation_v1_event_proto_rawDescData)
+	})
+	return file_nibiru_inflation_v1_event_proto_rawDescData
+}
+
+var file_nibiru_inflation_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_nibiru_inflation_v1_event_proto_goTypes = []interface{}{
+	(*EventInflationDistribution)(nil),      // 0: nibiru.inflation.v1.EventInflationDistribution
+	(*InflationRecipientAllocation)(nil),    // 1: nibiru.inflation.v1.InflationRecipientAllocation
+	(*EventInflationRecipientFallback)(nil), // 2: nibiru.inflation.v1.EventInflationRecipientFallback
+	(*v1beta1.Coin)(nil),                    // 3: cosmos.base.v1beta1.Coin
+}
+var file_nibiru_inflation_v1_event_proto_depIdxs = []int32{
+	3, // 0: nibiru.inflation.v1.EventInflationDistribution.staking_rewards:type_name -> cosmos.base.v1beta1.Coin
+	3, // 1: nibiru.inflation.v1.EventInflationDistribution.strategic_reserve:type_name -> cosmos.base.v1beta1.Coin
+	3, // 2: nibiru.inflation.v1.EventInflationDistribution.community_pool:type_name -> cosmos.base.v1beta1.Coin
+	1, // 3: nibiru.inflation.v1.EventInflationDistribution.recipients:type_name -> nibiru.inflation.v1.InflationRecipientAllocation
+	3, // 4: nibiru.inflation.v1.InflationRecipientAllocation.amount:type_name -> cosmos.base.v1beta1.Coin
+	3, // 5: nibiru.inflation.v1.EventInflationRecipientFallback.amount:type_name -> cosmos.base.v1beta1.Coin
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_nibiru_inflation_v1_event_proto_init() }
+func file_nibiru_inflation_v1_event_proto_init() {
 	if File_nibiru_inflation_v1_event_proto != nil {
 		return
 	}
@@ -765,6 +2180,30 @@ func file_nibiru_inflation_v1_event_proto_init() {
 				return nil
 			}
 		}
+		file_nibiru_inflation_v1_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationRecipientAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventInflationRecipientFallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -772,7 +2211,7 @@ func file_nibiru_inflation_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nibiru_inflation_v1_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -14,11 +14,63 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_InflationDistribution_4_list)(nil)
+
+type _InflationDistribution_4_list struct {
+	list *[]*InflationRecipient
+}
+
+func (x *_InflationDistribution_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InflationDistribution_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InflationDistribution_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationRecipient)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InflationDistribution_4_list) AppendMutable() protoreflect.Value {
+	v := new(InflationRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InflationDistribution_4_list) NewElement() protoreflect.Value {
+	v := new(InflationRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_InflationDistribution_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_InflationDistribution                    protoreflect.MessageDescriptor
 	fd_InflationDistribution_staking_rewards    protoreflect.FieldDescriptor
 	fd_InflationDistribution_community_pool     protoreflect.FieldDescriptor
 	fd_InflationDistribution_strategic_reserves protoreflect.FieldDescriptor
+	fd_InflationDistribution_recipients         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +79,7 @@ func init() {
 	fd_InflationDistribution_staking_rewards = md_InflationDistribution.Fields().ByName("staking_rewards")
 	fd_InflationDistribution_community_pool = md_InflationDistribution.Fields().ByName("community_pool")
 	fd_InflationDistribution_strategic_reserves = md_InflationDistribution.Fields().ByName("strategic_reserves")
+	fd_InflationDistribution_recipients = md_InflationDistribution.Fields().ByName("recipients")
 }
 
 var _ protoreflect.Message = (*fastReflection_InflationDistribution)(nil)
@@ -112,6 +165,12 @@ func (x *fastReflection_InflationDistribution) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if len(x.Recipients) != 0 {
+		value := protoreflect.ValueOfList(&_InflationDistribution_4_list{list: &x.Recipients})
+		if !f(fd_InflationDistribution_recipients, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -133,6 +192,8 @@ func (x *fastReflection_InflationDistribution) Has(fd protoreflect.FieldDescript
 		return x.CommunityPool != ""
 	case "nibiru.inflation.v1.InflationDistribution.strategic_reserves":
 		return x.StrategicReserves != ""
+	case "nibiru.inflation.v1.InflationDistribution.recipients":
+		return len(x.Recipients) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationDistribution"))
@@ -155,6 +216,8 @@ func (x *fastReflection_InflationDistribution) Clear(fd protoreflect.FieldDescri
 		x.CommunityPool = ""
 	case "nibiru.inflation.v1.InflationDistribution.strategic_reserves":
 		x.StrategicReserves = ""
+	case "nibiru.inflation.v1.InflationDistribution.recipients":
+		x.Recipients = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationDistribution"))
@@ -180,6 +243,12 @@ func (x *fastReflection_InflationDistribution) Get(descriptor protoreflect.Field
 	case "nibiru.inflation.v1.InflationDistribution.strategic_reserves":
 		value := x.StrategicReserves
 		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationDistribution.recipients":
+		if len(x.Recipients) == 0 {
+			return protoreflect.ValueOfList(&_InflationDistribution_4_list{})
+		}
+		listValue := &_InflationDistribution_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationDistribution"))
@@ -206,6 +275,10 @@ func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescript
 		x.CommunityPool = value.Interface().(string)
 	case "nibiru.inflation.v1.InflationDistribution.strategic_reserves":
 		x.StrategicReserves = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationDistribution.recipients":
+		lv := value.List()
+		clv := lv.(*_InflationDistribution_4_list)
+		x.Recipients = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationDistribution"))
@@ -226,6 +299,12 @@ func (x *fastReflection_InflationDistribution) Set(fd protoreflect.FieldDescript
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_InflationDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationDistribution.recipients":
+		if x.Recipients == nil {
+			x.Recipients = []*InflationRecipient{}
+		}
+		value := &_InflationDistribution_4_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(value)
 	case "nibiru.inflation.v1.InflationDistribution.staking_rewards":
 		panic(fmt.Errorf("field staking_rewards of message nibiru.inflation.v1.InflationDistribution is not mutable"))
 	case "nibiru.inflation.v1.InflationDistribution.community_pool":
@@ -251,6 +330,9 @@ func (x *fastReflection_InflationDistribution) NewField(fd protoreflect.FieldDes
 		return protoreflect.ValueOfString("")
 	case "nibiru.inflation.v1.InflationDistribution.strategic_reserves":
 		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationDistribution.recipients":
+		list := []*InflationRecipient{}
+		return protoreflect.ValueOfList(&_InflationDistribution_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationDistribution"))
@@ -332,6 +414,12 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Recipients) > 0 {
+			for _, e := range x.Recipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -361,6 +449,22 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Recipients) > 0 {
+			for iNdEx := len(x.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Recipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.StrategicReserves) > 0 {
 			i -= len(x.StrategicReserves)
 			copy(dAtA[i:], x.StrategicReserves)
@@ -527,6 +631,524 @@ func (x *fastReflection_InflationDistribution) ProtoMethods() *protoiface.Method
 				}
 				x.StrategicReserves = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipients = append(x.Recipients, &InflationRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Recipients[len(x.Recipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InflationRecipient         protoreflect.MessageDescriptor
+	fd_InflationRecipient_address protoreflect.FieldDescriptor
+	fd_InflationRecipient_weight  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_inflation_proto_init()
+	md_InflationRecipient = File_nibiru_inflation_v1_inflation_proto.Messages().ByName("InflationRecipient")
+	fd_InflationRecipient_address = md_InflationRecipient.Fields().ByName("address")
+	fd_InflationRecipient_weight = md_InflationRecipient.Fields().ByName("weight")
+}
+
+var _ protoreflect.Message = (*fastReflection_InflationRecipient)(nil)
+
+type fastReflection_InflationRecipient InflationRecipient
+
+func (x *InflationRecipient) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationRecipient)(x)
+}
+
+func (x *InflationRecipient) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_inflation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InflationRecipient_messageType fastReflection_InflationRecipient_messageType
+var _ protoreflect.MessageType = fastReflection_InflationRecipient_messageType{}
+
+type fastReflection_InflationRecipient_messageType struct{}
+
+func (x fastReflection_InflationRecipient_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationRecipient)(nil)
+}
+func (x fastReflection_InflationRecipient_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationRecipient)
+}
+func (x fastReflection_InflationRecipient_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationRecipient
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InflationRecipient) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationRecipient
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InflationRecipient) Type() protoreflect.MessageType {
+	return _fastReflection_InflationRecipient_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InflationRecipient) New() protoreflect.Message {
+	return new(fastReflection_InflationRecipient)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InflationRecipient) Interface() protoreflect.ProtoMessage {
+	return (*InflationRecipient)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InflationRecipient) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_InflationRecipient_address, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_InflationRecipient_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InflationRecipient) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipient.address":
+		return x.Address != ""
+	case "nibiru.inflation.v1.InflationRecipient.weight":
+		return x.Weight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipient"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationRecipient) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipient.address":
+		x.Address = ""
+	case "nibiru.inflation.v1.InflationRecipient.weight":
+		x.Weight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipient"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InflationRecipient) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.InflationRecipient.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationRecipient.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipient"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipient does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationRecipient) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipient.address":
+		x.Address = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationRecipient.weight":
+		x.Weight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipient"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationRecipient) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipient.address":
+		panic(fmt.Errorf("field address of message nibiru.inflation.v1.InflationRecipient is not mutable"))
+	case "nibiru.inflation.v1.InflationRecipient.weight":
+		panic(fmt.Errorf("field weight of message nibiru.inflation.v1.InflationRecipient is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipient"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InflationRecipient) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationRecipient.address":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationRecipient.weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationRecipient"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InflationRecipient) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.InflationRecipient", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InflationRecipient) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationRecipient) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InflationRecipient) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InflationRecipient) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InflationRecipient)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InflationRecipient)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InflationRecipient)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -592,6 +1214,10 @@ type InflationDistribution struct {
 	// strategic_reserves defines the proportion of the minted_denom that
 	// is to be allocated to the strategic reserves module address
 	StrategicReserves string `protobuf:"bytes,3,opt,name=strategic_reserves,json=strategicReserves,proto3" json:"strategic_reserves,omitempty"`
+	// recipients defines additional weighted recipients of the minted_denom.
+	// The weights of the recipients, together with the staking_rewards,
+	// community_pool, and strategic_reserves proportions, must sum to one.
+	Recipients []*InflationRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients,omitempty"`
 }
 
 func (x *InflationDistribution) Reset() {
@@ -635,6 +1261,66 @@ func (x *InflationDistribution) GetStrategicReserves() string {
 	return ""
 }
 
+func (x *InflationDistribution) GetRecipients() []*InflationRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+// InflationRecipient: A weighted recipient of inflation.
+type InflationRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address of the recipient. It is one of:
+	//   - a bech32 account address, credited with a bank send. Addresses
+	//     blocked from receiving funds, such as module accounts, are rejected.
+	//   - the name of a module account allowed to receive inflation, such as
+	//     "fee_collector", credited with a module-to-module send.
+	//   - a hex ("0x") EVM address, credited with the ERC20 representation of
+	//     the minted denom through its FunToken mapping.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the proportion of the minted_denom that is to be
+	// allocated to the recipient.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *InflationRecipient) Reset() {
+	*x = InflationRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_inflation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationRecipient) ProtoMessage() {}
+
+// Deprecated: Use InflationRecipient.ProtoReflect.Descriptor instead.
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_inflation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InflationRecipient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InflationRecipient) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
 var File_nibiru_inflation_v1_inflation_proto protoreflect.FileDescriptor
 
 var file_nibiru_inflation_v1_inflation_proto_rawDesc = []byte{
@@ -644,7 +1330,7 @@ var file_nibiru_inflation_v1_inflation_proto_rawDesc = []byte{
 	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x02, 0x0a, 0x15,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x02, 0x0a, 0x15,
 	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
 	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
 	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
@@ -663,21 +1349,33 @@ var file_nibiru_inflation_v1_inflation_proto_rawDesc = []byte{
 	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
 	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x11, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x69, 0x63, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x42, 0xc9, 0x01,
-	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69,
-	0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76,
-	0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x15, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x65, 0x67, 0x69, 0x63, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x12, 0x4d, 0x0a,
+	0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x79, 0x0a, 0x12,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0xc9, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13,
+	0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -692,16 +1390,18 @@ func file_nibiru_inflation_v1_inflation_proto_rawDescGZIP() []byte {
 	return file_nibiru_inflation_v1_inflation_proto_rawDescData
 }
 
-var file_nibiru_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_nibiru_inflation_v1_inflation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_nibiru_inflation_v1_inflation_proto_goTypes = []interface{}{
 	(*InflationDistribution)(nil), // 0: nibiru.inflation.v1.InflationDistribution
+	(*InflationRecipient)(nil),    // 1: nibiru.inflation.v1.InflationRecipient
 }
 var file_nibiru_inflation_v1_inflation_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: nibiru.inflation.v1.InflationDistribution.recipients:type_name -> nibiru.inflation.v1.InflationRecipient
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_inflation_v1_inflation_proto_init() }
@@ -722,6 +1422,18 @@ func file_nibiru_inflation_v1_inflation_proto_init() {
 				return nil
 			}
 		}
+		file_nibiru_inflation_v1_inflation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -729,7 +1441,7 @@ func file_nibiru_inflation_v1_inflation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nibiru_inflation_v1_inflation_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
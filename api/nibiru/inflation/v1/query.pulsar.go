@@ -4685,6 +4685,2214 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var (
+	md_QueryInflationScheduleRequest        protoreflect.MessageDescriptor
+	fd_QueryInflationScheduleRequest_params protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_QueryInflationScheduleRequest = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryInflationScheduleRequest")
+	fd_QueryInflationScheduleRequest_params = md_QueryInflationScheduleRequest.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInflationScheduleRequest)(nil)
+
+type fastReflection_QueryInflationScheduleRequest QueryInflationScheduleRequest
+
+func (x *QueryInflationScheduleRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInflationScheduleRequest)(x)
+}
+
+func (x *QueryInflationScheduleRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInflationScheduleRequest_messageType fastReflection_QueryInflationScheduleRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInflationScheduleRequest_messageType{}
+
+type fastReflection_QueryInflationScheduleRequest_messageType struct{}
+
+func (x fastReflection_QueryInflationScheduleRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInflationScheduleRequest)(nil)
+}
+func (x fastReflection_QueryInflationScheduleRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationScheduleRequest)
+}
+func (x fastReflection_QueryInflationScheduleRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationScheduleRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInflationScheduleRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationScheduleRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInflationScheduleRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInflationScheduleRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInflationScheduleRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationScheduleRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInflationScheduleRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryInflationScheduleRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInflationScheduleRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryInflationScheduleRequest_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInflationScheduleRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInflationScheduleRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInflationScheduleRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleRequest.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleRequest"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInflationScheduleRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryInflationScheduleRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInflationScheduleRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInflationScheduleRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInflationScheduleRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInflationScheduleRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationScheduleRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationScheduleRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationScheduleRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryInflationScheduleResponse_4_list)(nil)
+
+type _QueryInflationScheduleResponse_4_list struct {
+	list *[]*InflationPeriodProjection
+}
+
+func (x *_QueryInflationScheduleResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryInflationScheduleResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryInflationScheduleResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationPeriodProjection)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryInflationScheduleResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationPeriodProjection)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryInflationScheduleResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(InflationPeriodProjection)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryInflationScheduleResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryInflationScheduleResponse_4_list) NewElement() protoreflect.Value {
+	v := new(InflationPeriodProjection)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryInflationScheduleResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryInflationScheduleResponse                               protoreflect.MessageDescriptor
+	fd_QueryInflationScheduleResponse_params                        protoreflect.FieldDescriptor
+	fd_QueryInflationScheduleResponse_current_period                protoreflect.FieldDescriptor
+	fd_QueryInflationScheduleResponse_current_supply                protoreflect.FieldDescriptor
+	fd_QueryInflationScheduleResponse_periods                       protoreflect.FieldDescriptor
+	fd_QueryInflationScheduleResponse_total_minted                  protoreflect.FieldDescriptor
+	fd_QueryInflationScheduleResponse_current_period_epochs_elapsed protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_QueryInflationScheduleResponse = File_nibiru_inflation_v1_query_proto.Messages().ByName("QueryInflationScheduleResponse")
+	fd_QueryInflationScheduleResponse_params = md_QueryInflationScheduleResponse.Fields().ByName("params")
+	fd_QueryInflationScheduleResponse_current_period = md_QueryInflationScheduleResponse.Fields().ByName("current_period")
+	fd_QueryInflationScheduleResponse_current_supply = md_QueryInflationScheduleResponse.Fields().ByName("current_supply")
+	fd_QueryInflationScheduleResponse_periods = md_QueryInflationScheduleResponse.Fields().ByName("periods")
+	fd_QueryInflationScheduleResponse_total_minted = md_QueryInflationScheduleResponse.Fields().ByName("total_minted")
+	fd_QueryInflationScheduleResponse_current_period_epochs_elapsed = md_QueryInflationScheduleResponse.Fields().ByName("current_period_epochs_elapsed")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryInflationScheduleResponse)(nil)
+
+type fastReflection_QueryInflationScheduleResponse QueryInflationScheduleResponse
+
+func (x *QueryInflationScheduleResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryInflationScheduleResponse)(x)
+}
+
+func (x *QueryInflationScheduleResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryInflationScheduleResponse_messageType fastReflection_QueryInflationScheduleResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryInflationScheduleResponse_messageType{}
+
+type fastReflection_QueryInflationScheduleResponse_messageType struct{}
+
+func (x fastReflection_QueryInflationScheduleResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryInflationScheduleResponse)(nil)
+}
+func (x fastReflection_QueryInflationScheduleResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationScheduleResponse)
+}
+func (x fastReflection_QueryInflationScheduleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationScheduleResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryInflationScheduleResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryInflationScheduleResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryInflationScheduleResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryInflationScheduleResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryInflationScheduleResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryInflationScheduleResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryInflationScheduleResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryInflationScheduleResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryInflationScheduleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryInflationScheduleResponse_params, value) {
+			return
+		}
+	}
+	if x.CurrentPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CurrentPeriod)
+		if !f(fd_QueryInflationScheduleResponse_current_period, value) {
+			return
+		}
+	}
+	if x.CurrentSupply != "" {
+		value := protoreflect.ValueOfString(x.CurrentSupply)
+		if !f(fd_QueryInflationScheduleResponse_current_supply, value) {
+			return
+		}
+	}
+	if len(x.Periods) != 0 {
+		value := protoreflect.ValueOfList(&_QueryInflationScheduleResponse_4_list{list: &x.Periods})
+		if !f(fd_QueryInflationScheduleResponse_periods, value) {
+			return
+		}
+	}
+	if x.TotalMinted != "" {
+		value := protoreflect.ValueOfString(x.TotalMinted)
+		if !f(fd_QueryInflationScheduleResponse_total_minted, value) {
+			return
+		}
+	}
+	if x.CurrentPeriodEpochsElapsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CurrentPeriodEpochsElapsed)
+		if !f(fd_QueryInflationScheduleResponse_current_period_epochs_elapsed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryInflationScheduleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.params":
+		return x.Params != nil
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period":
+		return x.CurrentPeriod != uint64(0)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_supply":
+		return x.CurrentSupply != ""
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		return len(x.Periods) != 0
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_minted":
+		return x.TotalMinted != ""
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period_epochs_elapsed":
+		return x.CurrentPeriodEpochsElapsed != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.params":
+		x.Params = nil
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period":
+		x.CurrentPeriod = uint64(0)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_supply":
+		x.CurrentSupply = ""
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		x.Periods = nil
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_minted":
+		x.TotalMinted = ""
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period_epochs_elapsed":
+		x.CurrentPeriodEpochsElapsed = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryInflationScheduleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period":
+		value := x.CurrentPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_supply":
+		value := x.CurrentSupply
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		if len(x.Periods) == 0 {
+			return protoreflect.ValueOfList(&_QueryInflationScheduleResponse_4_list{})
+		}
+		listValue := &_QueryInflationScheduleResponse_4_list{list: &x.Periods}
+		return protoreflect.ValueOfList(listValue)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_minted":
+		value := x.TotalMinted
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period_epochs_elapsed":
+		value := x.CurrentPeriodEpochsElapsed
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period":
+		x.CurrentPeriod = value.Uint()
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_supply":
+		x.CurrentSupply = value.Interface().(string)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		lv := value.List()
+		clv := lv.(*_QueryInflationScheduleResponse_4_list)
+		x.Periods = *clv.list
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_minted":
+		x.TotalMinted = value.Interface().(string)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period_epochs_elapsed":
+		x.CurrentPeriodEpochsElapsed = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		if x.Periods == nil {
+			x.Periods = []*InflationPeriodProjection{}
+		}
+		value := &_QueryInflationScheduleResponse_4_list{list: &x.Periods}
+		return protoreflect.ValueOfList(value)
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period":
+		panic(fmt.Errorf("field current_period of message nibiru.inflation.v1.QueryInflationScheduleResponse is not mutable"))
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_supply":
+		panic(fmt.Errorf("field current_supply of message nibiru.inflation.v1.QueryInflationScheduleResponse is not mutable"))
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_minted":
+		panic(fmt.Errorf("field total_minted of message nibiru.inflation.v1.QueryInflationScheduleResponse is not mutable"))
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period_epochs_elapsed":
+		panic(fmt.Errorf("field current_period_epochs_elapsed of message nibiru.inflation.v1.QueryInflationScheduleResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryInflationScheduleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_supply":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.periods":
+		list := []*InflationPeriodProjection{}
+		return protoreflect.ValueOfList(&_QueryInflationScheduleResponse_4_list{list: &list})
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.total_minted":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.QueryInflationScheduleResponse.current_period_epochs_elapsed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.QueryInflationScheduleResponse"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.QueryInflationScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryInflationScheduleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.QueryInflationScheduleResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryInflationScheduleResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryInflationScheduleResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryInflationScheduleResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryInflationScheduleResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryInflationScheduleResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CurrentPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentPeriod))
+		}
+		l = len(x.CurrentSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Periods) > 0 {
+			for _, e := range x.Periods {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TotalMinted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CurrentPeriodEpochsElapsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentPeriodEpochsElapsed))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationScheduleResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CurrentPeriodEpochsElapsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentPeriodEpochsElapsed))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TotalMinted) > 0 {
+			i -= len(x.TotalMinted)
+			copy(dAtA[i:], x.TotalMinted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalMinted)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Periods) > 0 {
+			for iNdEx := len(x.Periods) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Periods[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.CurrentSupply) > 0 {
+			i -= len(x.CurrentSupply)
+			copy(dAtA[i:], x.CurrentSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrentSupply)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.CurrentPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentPeriod))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryInflationScheduleResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationScheduleResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryInflationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriod", wireType)
+				}
+				x.CurrentPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrentSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Periods = append(x.Periods, &InflationPeriodProjection{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Periods[len(x.Periods)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalMinted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriodEpochsElapsed", wireType)
+				}
+				x.CurrentPeriodEpochsElapsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentPeriodEpochsElapsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_InflationPeriodProjection_9_list)(nil)
+
+type _InflationPeriodProjection_9_list struct {
+	list *[]string
+}
+
+func (x *_InflationPeriodProjection_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_InflationPeriodProjection_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_InflationPeriodProjection_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_InflationPeriodProjection_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_InflationPeriodProjection_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message InflationPeriodProjection at list field Recipients as it is not of Message kind"))
+}
+
+func (x *_InflationPeriodProjection_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_InflationPeriodProjection_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_InflationPeriodProjection_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_InflationPeriodProjection                      protoreflect.MessageDescriptor
+	fd_InflationPeriodProjection_period               protoreflect.FieldDescriptor
+	fd_InflationPeriodProjection_epoch_mint_provision protoreflect.FieldDescriptor
+	fd_InflationPeriodProjection_minted               protoreflect.FieldDescriptor
+	fd_InflationPeriodProjection_staking_rewards      protoreflect.FieldDescriptor
+	fd_InflationPeriodProjection_community_pool       protoreflect.FieldDescriptor
+	fd_InflationPeriodProjection_strategic_reserves   protoreflect.FieldDescriptor
+	fd_InflationPeriodProjection_recipients           protoreflect.FieldDescriptor
+	fd_InflationPeriodProjection_cumulative_minted    protoreflect.FieldDescriptor
+	fd_InflationPeriodProjection_supply               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_nibiru_inflation_v1_query_proto_init()
+	md_InflationPeriodProjection = File_nibiru_inflation_v1_query_proto.Messages().ByName("InflationPeriodProjection")
+	fd_InflationPeriodProjection_period = md_InflationPeriodProjection.Fields().ByName("period")
+	fd_InflationPeriodProjection_epoch_mint_provision = md_InflationPeriodProjection.Fields().ByName("epoch_mint_provision")
+	fd_InflationPeriodProjection_minted = md_InflationPeriodProjection.Fields().ByName("minted")
+	fd_InflationPeriodProjection_staking_rewards = md_InflationPeriodProjection.Fields().ByName("staking_rewards")
+	fd_InflationPeriodProjection_community_pool = md_InflationPeriodProjection.Fields().ByName("community_pool")
+	fd_InflationPeriodProjection_strategic_reserves = md_InflationPeriodProjection.Fields().ByName("strategic_reserves")
+	fd_InflationPeriodProjection_recipients = md_InflationPeriodProjection.Fields().ByName("recipients")
+	fd_InflationPeriodProjection_cumulative_minted = md_InflationPeriodProjection.Fields().ByName("cumulative_minted")
+	fd_InflationPeriodProjection_supply = md_InflationPeriodProjection.Fields().ByName("supply")
+}
+
+var _ protoreflect.Message = (*fastReflection_InflationPeriodProjection)(nil)
+
+type fastReflection_InflationPeriodProjection InflationPeriodProjection
+
+func (x *InflationPeriodProjection) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationPeriodProjection)(x)
+}
+
+func (x *InflationPeriodProjection) slowProtoReflect() protoreflect.Message {
+	mi := &file_nibiru_inflation_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InflationPeriodProjection_messageType fastReflection_InflationPeriodProjection_messageType
+var _ protoreflect.MessageType = fastReflection_InflationPeriodProjection_messageType{}
+
+type fastReflection_InflationPeriodProjection_messageType struct{}
+
+func (x fastReflection_InflationPeriodProjection_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationPeriodProjection)(nil)
+}
+func (x fastReflection_InflationPeriodProjection_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationPeriodProjection)
+}
+func (x fastReflection_InflationPeriodProjection_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationPeriodProjection
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InflationPeriodProjection) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationPeriodProjection
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InflationPeriodProjection) Type() protoreflect.MessageType {
+	return _fastReflection_InflationPeriodProjection_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InflationPeriodProjection) New() protoreflect.Message {
+	return new(fastReflection_InflationPeriodProjection)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InflationPeriodProjection) Interface() protoreflect.ProtoMessage {
+	return (*InflationPeriodProjection)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InflationPeriodProjection) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Period != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Period)
+		if !f(fd_InflationPeriodProjection_period, value) {
+			return
+		}
+	}
+	if x.EpochMintProvision != "" {
+		value := protoreflect.ValueOfString(x.EpochMintProvision)
+		if !f(fd_InflationPeriodProjection_epoch_mint_provision, value) {
+			return
+		}
+	}
+	if x.Minted != "" {
+		value := protoreflect.ValueOfString(x.Minted)
+		if !f(fd_InflationPeriodProjection_minted, value) {
+			return
+		}
+	}
+	if x.StakingRewards != "" {
+		value := protoreflect.ValueOfString(x.StakingRewards)
+		if !f(fd_InflationPeriodProjection_staking_rewards, value) {
+			return
+		}
+	}
+	if x.CommunityPool != "" {
+		value := protoreflect.ValueOfString(x.CommunityPool)
+		if !f(fd_InflationPeriodProjection_community_pool, value) {
+			return
+		}
+	}
+	if x.StrategicReserves != "" {
+		value := protoreflect.ValueOfString(x.StrategicReserves)
+		if !f(fd_InflationPeriodProjection_strategic_reserves, value) {
+			return
+		}
+	}
+	if len(x.Recipients) != 0 {
+		value := protoreflect.ValueOfList(&_InflationPeriodProjection_9_list{list: &x.Recipients})
+		if !f(fd_InflationPeriodProjection_recipients, value) {
+			return
+		}
+	}
+	if x.CumulativeMinted != "" {
+		value := protoreflect.ValueOfString(x.CumulativeMinted)
+		if !f(fd_InflationPeriodProjection_cumulative_minted, value) {
+			return
+		}
+	}
+	if x.Supply != "" {
+		value := protoreflect.ValueOfString(x.Supply)
+		if !f(fd_InflationPeriodProjection_supply, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InflationPeriodProjection) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationPeriodProjection.period":
+		return x.Period != uint64(0)
+	case "nibiru.inflation.v1.InflationPeriodProjection.epoch_mint_provision":
+		return x.EpochMintProvision != ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.minted":
+		return x.Minted != ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.staking_rewards":
+		return x.StakingRewards != ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.community_pool":
+		return x.CommunityPool != ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.strategic_reserves":
+		return x.StrategicReserves != ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.recipients":
+		return len(x.Recipients) != 0
+	case "nibiru.inflation.v1.InflationPeriodProjection.cumulative_minted":
+		return x.CumulativeMinted != ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.supply":
+		return x.Supply != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationPeriodProjection"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationPeriodProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPeriodProjection) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationPeriodProjection.period":
+		x.Period = uint64(0)
+	case "nibiru.inflation.v1.InflationPeriodProjection.epoch_mint_provision":
+		x.EpochMintProvision = ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.minted":
+		x.Minted = ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.staking_rewards":
+		x.StakingRewards = ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.community_pool":
+		x.CommunityPool = ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.strategic_reserves":
+		x.StrategicReserves = ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.recipients":
+		x.Recipients = nil
+	case "nibiru.inflation.v1.InflationPeriodProjection.cumulative_minted":
+		x.CumulativeMinted = ""
+	case "nibiru.inflation.v1.InflationPeriodProjection.supply":
+		x.Supply = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationPeriodProjection"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationPeriodProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InflationPeriodProjection) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "nibiru.inflation.v1.InflationPeriodProjection.period":
+		value := x.Period
+		return protoreflect.ValueOfUint64(value)
+	case "nibiru.inflation.v1.InflationPeriodProjection.epoch_mint_provision":
+		value := x.EpochMintProvision
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationPeriodProjection.minted":
+		value := x.Minted
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationPeriodProjection.staking_rewards":
+		value := x.StakingRewards
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationPeriodProjection.community_pool":
+		value := x.CommunityPool
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationPeriodProjection.strategic_reserves":
+		value := x.StrategicReserves
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationPeriodProjection.recipients":
+		if len(x.Recipients) == 0 {
+			return protoreflect.ValueOfList(&_InflationPeriodProjection_9_list{})
+		}
+		listValue := &_InflationPeriodProjection_9_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(listValue)
+	case "nibiru.inflation.v1.InflationPeriodProjection.cumulative_minted":
+		value := x.CumulativeMinted
+		return protoreflect.ValueOfString(value)
+	case "nibiru.inflation.v1.InflationPeriodProjection.supply":
+		value := x.Supply
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationPeriodProjection"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationPeriodProjection does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPeriodProjection) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationPeriodProjection.period":
+		x.Period = value.Uint()
+	case "nibiru.inflation.v1.InflationPeriodProjection.epoch_mint_provision":
+		x.EpochMintProvision = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationPeriodProjection.minted":
+		x.Minted = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationPeriodProjection.staking_rewards":
+		x.StakingRewards = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationPeriodProjection.community_pool":
+		x.CommunityPool = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationPeriodProjection.strategic_reserves":
+		x.StrategicReserves = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationPeriodProjection.recipients":
+		lv := value.List()
+		clv := lv.(*_InflationPeriodProjection_9_list)
+		x.Recipients = *clv.list
+	case "nibiru.inflation.v1.InflationPeriodProjection.cumulative_minted":
+		x.CumulativeMinted = value.Interface().(string)
+	case "nibiru.inflation.v1.InflationPeriodProjection.supply":
+		x.Supply = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationPeriodProjection"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationPeriodProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPeriodProjection) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationPeriodProjection.recipients":
+		if x.Recipients == nil {
+			x.Recipients = []string{}
+		}
+		value := &_InflationPeriodProjection_9_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(value)
+	case "nibiru.inflation.v1.InflationPeriodProjection.period":
+		panic(fmt.Errorf("field period of message nibiru.inflation.v1.InflationPeriodProjection is not mutable"))
+	case "nibiru.inflation.v1.InflationPeriodProjection.epoch_mint_provision":
+		panic(fmt.Errorf("field epoch_mint_provision of message nibiru.inflation.v1.InflationPeriodProjection is not mutable"))
+	case "nibiru.inflation.v1.InflationPeriodProjection.minted":
+		panic(fmt.Errorf("field minted of message nibiru.inflation.v1.InflationPeriodProjection is not mutable"))
+	case "nibiru.inflation.v1.InflationPeriodProjection.staking_rewards":
+		panic(fmt.Errorf("field staking_rewards of message nibiru.inflation.v1.InflationPeriodProjection is not mutable"))
+	case "nibiru.inflation.v1.InflationPeriodProjection.community_pool":
+		panic(fmt.Errorf("field community_pool of message nibiru.inflation.v1.InflationPeriodProjection is not mutable"))
+	case "nibiru.inflation.v1.InflationPeriodProjection.strategic_reserves":
+		panic(fmt.Errorf("field strategic_reserves of message nibiru.inflation.v1.InflationPeriodProjection is not mutable"))
+	case "nibiru.inflation.v1.InflationPeriodProjection.cumulative_minted":
+		panic(fmt.Errorf("field cumulative_minted of message nibiru.inflation.v1.InflationPeriodProjection is not mutable"))
+	case "nibiru.inflation.v1.InflationPeriodProjection.supply":
+		panic(fmt.Errorf("field supply of message nibiru.inflation.v1.InflationPeriodProjection is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationPeriodProjection"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationPeriodProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InflationPeriodProjection) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "nibiru.inflation.v1.InflationPeriodProjection.period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "nibiru.inflation.v1.InflationPeriodProjection.epoch_mint_provision":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationPeriodProjection.minted":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationPeriodProjection.staking_rewards":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationPeriodProjection.community_pool":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationPeriodProjection.strategic_reserves":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationPeriodProjection.recipients":
+		list := []string{}
+		return protoreflect.ValueOfList(&_InflationPeriodProjection_9_list{list: &list})
+	case "nibiru.inflation.v1.InflationPeriodProjection.cumulative_minted":
+		return protoreflect.ValueOfString("")
+	case "nibiru.inflation.v1.InflationPeriodProjection.supply":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.inflation.v1.InflationPeriodProjection"))
+		}
+		panic(fmt.Errorf("message nibiru.inflation.v1.InflationPeriodProjection does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InflationPeriodProjection) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in nibiru.inflation.v1.InflationPeriodProjection", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InflationPeriodProjection) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPeriodProjection) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InflationPeriodProjection) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InflationPeriodProjection) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InflationPeriodProjection)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Period != 0 {
+			n += 1 + runtime.Sov(uint64(x.Period))
+		}
+		l = len(x.EpochMintProvision)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Minted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StakingRewards)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CommunityPool)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StrategicReserves)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Recipients) > 0 {
+			for _, s := range x.Recipients {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.CumulativeMinted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Supply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InflationPeriodProjection)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Recipients) > 0 {
+			for iNdEx := len(x.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Recipients[iNdEx])
+				copy(dAtA[i:], x.Recipients[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipients[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.Supply) > 0 {
+			i -= len(x.Supply)
+			copy(dAtA[i:], x.Supply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Supply)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.CumulativeMinted) > 0 {
+			i -= len(x.CumulativeMinted)
+			copy(dAtA[i:], x.CumulativeMinted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativeMinted)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.StrategicReserves) > 0 {
+			i -= len(x.StrategicReserves)
+			copy(dAtA[i:], x.StrategicReserves)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StrategicReserves)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.CommunityPool) > 0 {
+			i -= len(x.CommunityPool)
+			copy(dAtA[i:], x.CommunityPool)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityPool)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.StakingRewards) > 0 {
+			i -= len(x.StakingRewards)
+			copy(dAtA[i:], x.StakingRewards)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakingRewards)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Minted) > 0 {
+			i -= len(x.Minted)
+			copy(dAtA[i:], x.Minted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Minted)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.EpochMintProvision) > 0 {
+			i -= len(x.EpochMintProvision)
+			copy(dAtA[i:], x.EpochMintProvision)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EpochMintProvision)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Period != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Period))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InflationPeriodProjection)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationPeriodProjection: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationPeriodProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				}
+				x.Period = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Period |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochMintProvision = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Minted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakingRewards = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CommunityPool = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StrategicReserves", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StrategicReserves = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipients = append(x.Recipients, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeMinted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativeMinted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Supply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -5091,6 +7299,247 @@ func (x *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryInflationScheduleRequest is the request type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params: Candidate parameters to project the schedule with, such as those
+	// of a pending "MsgEditInflationParams". If unset, the current module
+	// parameters are used.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryInflationScheduleRequest) Reset() {
+	*x = QueryInflationScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryInflationScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInflationScheduleRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryInflationScheduleRequest.ProtoReflect.Descriptor instead.
+func (*QueryInflationScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryInflationScheduleRequest) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryInflationScheduleResponse is the response type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params: The parameters used for the projection.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// current_period: The inflation period the projection starts from.
+	CurrentPeriod uint64 `protobuf:"varint,2,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"`
+	// current_supply: Circulating supply of the mint denom when the query was
+	// made, the same amount as the "CirculatingSupply" query.
+	CurrentSupply string `protobuf:"bytes,3,opt,name=current_supply,json=currentSupply,proto3" json:"current_supply,omitempty"`
+	// periods: Projection for each period from "current_period" up to
+	// "max_period".
+	Periods []*InflationPeriodProjection `protobuf:"bytes,4,rep,name=periods,proto3" json:"periods,omitempty"`
+	// total_minted: Sum of the amounts minted across all projected periods.
+	TotalMinted string `protobuf:"bytes,5,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted,omitempty"`
+	// current_period_epochs_elapsed: Number of epochs of "current_period" that
+	// have already minted. Their provisions are part of "current_supply" and
+	// are not projected again.
+	CurrentPeriodEpochsElapsed uint64 `protobuf:"varint,6,opt,name=current_period_epochs_elapsed,json=currentPeriodEpochsElapsed,proto3" json:"current_period_epochs_elapsed,omitempty"`
+}
+
+func (x *QueryInflationScheduleResponse) Reset() {
+	*x = QueryInflationScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryInflationScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInflationScheduleResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryInflationScheduleResponse.ProtoReflect.Descriptor instead.
+func (*QueryInflationScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryInflationScheduleResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *QueryInflationScheduleResponse) GetCurrentPeriod() uint64 {
+	if x != nil {
+		return x.CurrentPeriod
+	}
+	return 0
+}
+
+func (x *QueryInflationScheduleResponse) GetCurrentSupply() string {
+	if x != nil {
+		return x.CurrentSupply
+	}
+	return ""
+}
+
+func (x *QueryInflationScheduleResponse) GetPeriods() []*InflationPeriodProjection {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *QueryInflationScheduleResponse) GetTotalMinted() string {
+	if x != nil {
+		return x.TotalMinted
+	}
+	return ""
+}
+
+func (x *QueryInflationScheduleResponse) GetCurrentPeriodEpochsElapsed() uint64 {
+	if x != nil {
+		return x.CurrentPeriodEpochsElapsed
+	}
+	return 0
+}
+
+// InflationPeriodProjection: Projected amounts minted and distributed during a
+// single inflation period. Amounts are in the base denomination (unibi).
+type InflationPeriodProjection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// period: The inflation period.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// epoch_mint_provision: Amount minted at the end of each epoch in the
+	// period.
+	EpochMintProvision string `protobuf:"bytes,2,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision,omitempty"`
+	// minted: Total amount minted during the period. For the current period,
+	// only the epochs that have not elapsed yet are counted.
+	Minted string `protobuf:"bytes,3,opt,name=minted,proto3" json:"minted,omitempty"`
+	// staking_rewards: Portion of "minted" sent to the fee collector.
+	StakingRewards string `protobuf:"bytes,4,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
+	// community_pool: Portion of "minted" sent to the community pool.
+	CommunityPool string `protobuf:"bytes,5,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	// strategic_reserves: Portion of "minted" sent to the strategic reserve.
+	StrategicReserves string `protobuf:"bytes,6,opt,name=strategic_reserves,json=strategicReserves,proto3" json:"strategic_reserves,omitempty"`
+	// recipients: Portion of "minted" sent to the additional inflation
+	// recipients, in the order of "InflationDistribution.recipients".
+	Recipients []string `protobuf:"bytes,9,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// cumulative_minted: Sum of "minted" over this and all earlier projected
+	// periods.
+	CumulativeMinted string `protobuf:"bytes,7,opt,name=cumulative_minted,json=cumulativeMinted,proto3" json:"cumulative_minted,omitempty"`
+	// supply: Projected supply of the mint denom at the end of the period.
+	Supply string `protobuf:"bytes,8,opt,name=supply,proto3" json:"supply,omitempty"`
+}
+
+func (x *InflationPeriodProjection) Reset() {
+	*x = InflationPeriodProjection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nibiru_inflation_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationPeriodProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationPeriodProjection) ProtoMessage() {}
+
+// Deprecated: Use InflationPeriodProjection.ProtoReflect.Descriptor instead.
+func (*InflationPeriodProjection) Descriptor() ([]byte, []int) {
+	return file_nibiru_inflation_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *InflationPeriodProjection) GetPeriod() uint64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *InflationPeriodProjection) GetEpochMintProvision() string {
+	if x != nil {
+		return x.EpochMintProvision
+	}
+	return ""
+}
+
+func (x *InflationPeriodProjection) GetMinted() string {
+	if x != nil {
+		return x.Minted
+	}
+	return ""
+}
+
+func (x *InflationPeriodProjection) GetStakingRewards() string {
+	if x != nil {
+		return x.StakingRewards
+	}
+	return ""
+}
+
+func (x *InflationPeriodProjection) GetCommunityPool() string {
+	if x != nil {
+		return x.CommunityPool
+	}
+	return ""
+}
+
+func (x *InflationPeriodProjection) GetStrategicReserves() string {
+	if x != nil {
+		return x.StrategicReserves
+	}
+	return ""
+}
+
+func (x *InflationPeriodProjection) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *InflationPeriodProjection) GetCumulativeMinted() string {
+	if x != nil {
+		return x.CumulativeMinted
+	}
+	return ""
+}
+
+func (x *InflationPeriodProjection) GetSupply() string {
+	if x != nil {
+		return x.Supply
+	}
+	return ""
+}
+
 var File_nibiru_inflation_v1_query_proto protoreflect.FileDescriptor
 
 var file_nibiru_inflation_v1_query_proto_rawDesc = []byte{
@@ -5157,80 +7606,168 @@ var file_nibiru_inflation_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
 	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32,
-	0xb2, 0x07, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x27, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
-	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
-	0x1b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0xb2, 0x01, 0x0a,
-	0x12, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+	0x54, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb9, 0x03, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x52, 0x0a, 0x0e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x4e,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x4e,
+	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x41,
+	0x0a, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x64, 0x22, 0xcf, 0x05, 0x0a, 0x19, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x63, 0x0a, 0x14, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4d,
+	0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x06,
+	0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65,
+	0x64, 0x12, 0x54, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x52, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x5a, 0x0a, 0x12, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x69, 0x63, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x11, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x10, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x43,
+	0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x32, 0xd8, 0x08, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01,
+	0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x27, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72,
 	0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f,
-	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x73, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x73, 0x12, 0xad, 0x01, 0x0a, 0x11, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0xb2, 0x01, 0x0a, 0x12, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
 	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75,
-	0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6e, 0x69,
+	0x65, 0x72, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6e,
+	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x69, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x12, 0x23, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x11, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x2e, 0x6e, 0x69,
 	0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
 	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
-	0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x6e,
+	0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6e,
 	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x2e, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
-	0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0xc5, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62,
-	0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e,
-	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e,
+	0x76, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x12, 0x23, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x27, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x69, 0x62, 0x69,
+	0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6e, 0x69,
+	0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa3, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x32,
+	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0xc5,
+	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x49, 0x58,
+	0xaa, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e,
 	0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x49, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x15, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5245,7 +7782,7 @@ func file_nibiru_inflation_v1_query_proto_rawDescGZIP() []byte {
 	return file_nibiru_inflation_v1_query_proto_rawDescData
 }
 
-var file_nibiru_inflation_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_nibiru_inflation_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_nibiru_inflation_v1_query_proto_goTypes = []interface{}{
 	(*QueryPeriodRequest)(nil),              // 0: nibiru.inflation.v1.QueryPeriodRequest
 	(*QueryPeriodResponse)(nil),             // 1: nibiru.inflation.v1.QueryPeriodResponse
@@ -5259,30 +7796,38 @@ var file_nibiru_inflation_v1_query_proto_goTypes = []interface{}{
 	(*QueryInflationRateResponse)(nil),      // 9: nibiru.inflation.v1.QueryInflationRateResponse
 	(*QueryParamsRequest)(nil),              // 10: nibiru.inflation.v1.QueryParamsRequest
 	(*QueryParamsResponse)(nil),             // 11: nibiru.inflation.v1.QueryParamsResponse
-	(*v1beta1.DecCoin)(nil),                 // 12: cosmos.base.v1beta1.DecCoin
-	(*Params)(nil),                          // 13: nibiru.inflation.v1.Params
+	(*QueryInflationScheduleRequest)(nil),   // 12: nibiru.inflation.v1.QueryInflationScheduleRequest
+	(*QueryInflationScheduleResponse)(nil),  // 13: nibiru.inflation.v1.QueryInflationScheduleResponse
+	(*InflationPeriodProjection)(nil),       // 14: nibiru.inflation.v1.InflationPeriodProjection
+	(*v1beta1.DecCoin)(nil),                 // 15: cosmos.base.v1beta1.DecCoin
+	(*Params)(nil),                          // 16: nibiru.inflation.v1.Params
 }
 var file_nibiru_inflation_v1_query_proto_depIdxs = []int32{
-	12, // 0: nibiru.inflation.v1.QueryEpochMintProvisionResponse.epoch_mint_provision:type_name -> cosmos.base.v1beta1.DecCoin
-	12, // 1: nibiru.inflation.v1.QueryCirculatingSupplyResponse.circulating_supply:type_name -> cosmos.base.v1beta1.DecCoin
-	13, // 2: nibiru.inflation.v1.QueryParamsResponse.params:type_name -> nibiru.inflation.v1.Params
-	0,  // 3: nibiru.inflation.v1.Query.Period:input_type -> nibiru.inflation.v1.QueryPeriodRequest
-	2,  // 4: nibiru.inflation.v1.Query.EpochMintProvision:input_type -> nibiru.inflation.v1.QueryEpochMintProvisionRequest
-	4,  // 5: nibiru.inflation.v1.Query.SkippedEpochs:input_type -> nibiru.inflation.v1.QuerySkippedEpochsRequest
-	6,  // 6: nibiru.inflation.v1.Query.CirculatingSupply:input_type -> nibiru.inflation.v1.QueryCirculatingSupplyRequest
-	8,  // 7: nibiru.inflation.v1.Query.InflationRate:input_type -> nibiru.inflation.v1.QueryInflationRateRequest
-	10, // 8: nibiru.inflation.v1.Query.Params:input_type -> nibiru.inflation.v1.QueryParamsRequest
-	1,  // 9: nibiru.inflation.v1.Query.Period:output_type -> nibiru.inflation.v1.QueryPeriodResponse
-	3,  // 10: nibiru.inflation.v1.Query.EpochMintProvision:output_type -> nibiru.inflation.v1.QueryEpochMintProvisionResponse
-	5,  // 11: nibiru.inflation.v1.Query.SkippedEpochs:output_type -> nibiru.inflation.v1.QuerySkippedEpochsResponse
-	7,  // 12: nibiru.inflation.v1.Query.CirculatingSupply:output_type -> nibiru.inflation.v1.QueryCirculatingSupplyResponse
-	9,  // 13: nibiru.inflation.v1.Query.InflationRate:output_type -> nibiru.inflation.v1.QueryInflationRateResponse
-	11, // 14: nibiru.inflation.v1.Query.Params:output_type -> nibiru.inflation.v1.QueryParamsResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	15, // 0: nibiru.inflation.v1.QueryEpochMintProvisionResponse.epoch_mint_provision:type_name -> cosmos.base.v1beta1.DecCoin
+	15, // 1: nibiru.inflation.v1.QueryCirculatingSupplyResponse.circulating_supply:type_name -> cosmos.base.v1beta1.DecCoin
+	16, // 2: nibiru.inflation.v1.QueryParamsResponse.params:type_name -> nibiru.inflation.v1.Params
+	16, // 3: nibiru.inflation.v1.QueryInflationScheduleRequest.params:type_name -> nibiru.inflation.v1.Params
+	16, // 4: nibiru.inflation.v1.QueryInflationScheduleResponse.params:type_name -> nibiru.inflation.v1.Params
+	14, // 5: nibiru.inflation.v1.QueryInflationScheduleResponse.periods:type_name -> nibiru.inflation.v1.InflationPeriodProjection
+	0,  // 6: nibiru.inflation.v1.Query.Period:input_type -> nibiru.inflation.v1.QueryPeriodRequest
+	2,  // 7: nibiru.inflation.v1.Query.EpochMintProvision:input_type -> nibiru.inflation.v1.QueryEpochMintProvisionRequest
+	4,  // 8: nibiru.inflation.v1.Query.SkippedEpochs:input_type -> nibiru.inflation.v1.QuerySkippedEpochsRequest
+	6,  // 9: nibiru.inflation.v1.Query.CirculatingSupply:input_type -> nibiru.inflation.v1.QueryCirculatingSupplyRequest
+	8,  // 10: nibiru.inflation.v1.Query.InflationRate:input_type -> nibiru.inflation.v1.QueryInflationRateRequest
+	10, // 11: nibiru.inflation.v1.Query.Params:input_type -> nibiru.inflation.v1.QueryParamsRequest
+	12, // 12: nibiru.inflation.v1.Query.InflationSchedule:input_type -> nibiru.inflation.v1.QueryInflationScheduleRequest
+	1,  // 13: nibiru.inflation.v1.Query.Period:output_type -> nibiru.inflation.v1.QueryPeriodResponse
+	3,  // 14: nibiru.inflation.v1.Query.EpochMintProvision:output_type -> nibiru.inflation.v1.QueryEpochMintProvisionResponse
+	5,  // 15: nibiru.inflation.v1.Query.SkippedEpochs:output_type -> nibiru.inflation.v1.QuerySkippedEpochsResponse
+	7,  // 16: nibiru.inflation.v1.Query.CirculatingSupply:output_type -> nibiru.inflation.v1.QueryCirculatingSupplyResponse
+	9,  // 17: nibiru.inflation.v1.Query.InflationRate:output_type -> nibiru.inflation.v1.QueryInflationRateResponse
+	11, // 18: nibiru.inflation.v1.Query.Params:output_type -> nibiru.inflation.v1.QueryParamsResponse
+	13, // 19: nibiru.inflation.v1.Query.InflationSchedule:output_type -> nibiru.inflation.v1.QueryInflationScheduleResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_inflation_v1_query_proto_init() }
@@ -5436,6 +7981,42 @@ func file_nibiru_inflation_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInflationScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInflationScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nibiru_inflation_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationPeriodProjection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5443,7 +8024,7 @@ func file_nibiru_inflation_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nibiru_inflation_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,10 @@ type QueryClient interface {
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InflationSchedule projects the amounts minted and distributed in each
+	// remaining inflation period, using either the current parameters or
+	// candidate parameters supplied in the request.
+	InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -99,6 +103,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error) {
+	out := new(QueryInflationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/InflationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -116,6 +129,10 @@ type QueryServer interface {
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InflationSchedule projects the amounts minted and distributed in each
+	// remaining inflation period, using either the current parameters or
+	// candidate parameters supplied in the request.
+	InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -141,6 +158,9 @@ func (UnimplementedQueryServer) InflationRate(context.Context, *QueryInflationRa
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationSchedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -262,6 +282,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.inflation.v1.Query/InflationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationSchedule(ctx, req.(*QueryInflationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -293,6 +331,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "InflationSchedule",
+			Handler:    _Query_InflationSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/inflation/v1/query.proto",
@@ -373,12 +373,14 @@ func (x *fastReflection_QuerySudoersRequest) ProtoMethods() *protoiface.Methods
 var (
 	md_QuerySudoersResponse         protoreflect.MessageDescriptor
 	fd_QuerySudoersResponse_sudoers protoreflect.FieldDescriptor
+	fd_QuerySudoersResponse_params  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_nibiru_sudo_v1_query_proto_init()
 	md_QuerySudoersResponse = File_nibiru_sudo_v1_query_proto.Messages().ByName("QuerySudoersResponse")
 	fd_QuerySudoersResponse_sudoers = md_QuerySudoersResponse.Fields().ByName("sudoers")
+	fd_QuerySudoersResponse_params = md_QuerySudoersResponse.Fields().ByName("params")
 }
 
 var _ protoreflect.Message = (*fastReflection_QuerySudoersResponse)(nil)
@@ -452,6 +454,12 @@ func (x *fastReflection_QuerySudoersResponse) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QuerySudoersResponse_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -469,6 +477,8 @@ func (x *fastReflection_QuerySudoersResponse) Has(fd protoreflect.FieldDescripto
 	switch fd.FullName() {
 	case "nibiru.sudo.v1.QuerySudoersResponse.sudoers":
 		return x.Sudoers != nil
+	case "nibiru.sudo.v1.QuerySudoersResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.sudo.v1.QuerySudoersResponse"))
@@ -487,6 +497,8 @@ func (x *fastReflection_QuerySudoersResponse) Clear(fd protoreflect.FieldDescrip
 	switch fd.FullName() {
 	case "nibiru.sudo.v1.QuerySudoersResponse.sudoers":
 		x.Sudoers = nil
+	case "nibiru.sudo.v1.QuerySudoersResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.sudo.v1.QuerySudoersResponse"))
@@ -506,6 +518,9 @@ func (x *fastReflection_QuerySudoersResponse) Get(descriptor protoreflect.FieldD
 	case "nibiru.sudo.v1.QuerySudoersResponse.sudoers":
 		value := x.Sudoers
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "nibiru.sudo.v1.QuerySudoersResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.sudo.v1.QuerySudoersResponse"))
@@ -528,6 +543,8 @@ func (x *fastReflection_QuerySudoersResponse) Set(fd protoreflect.FieldDescripto
 	switch fd.FullName() {
 	case "nibiru.sudo.v1.QuerySudoersResponse.sudoers":
 		x.Sudoers = value.Message().Interface().(*Sudoers)
+	case "nibiru.sudo.v1.QuerySudoersResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.sudo.v1.QuerySudoersResponse"))
@@ -553,6 +570,11 @@ func (x *fastReflection_QuerySudoersResponse) Mutable(fd protoreflect.FieldDescr
 			x.Sudoers = new(Sudoers)
 		}
 		return protoreflect.ValueOfMessage(x.Sudoers.ProtoReflect())
+	case "nibiru.sudo.v1.QuerySudoersResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.sudo.v1.QuerySudoersResponse"))
@@ -569,6 +591,9 @@ func (x *fastReflection_QuerySudoersResponse) NewField(fd protoreflect.FieldDesc
 	case "nibiru.sudo.v1.QuerySudoersResponse.sudoers":
 		m := new(Sudoers)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "nibiru.sudo.v1.QuerySudoersResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: nibiru.sudo.v1.QuerySudoersResponse"))
@@ -642,6 +667,10 @@ func (x *fastReflection_QuerySudoersResponse) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Sudoers)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -671,6 +700,20 @@ func (x *fastReflection_QuerySudoersResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.Sudoers != nil {
 			encoded, err := options.Marshal(x.Sudoers)
 			if err != nil {
@@ -770,6 +813,42 @@ func (x *fastReflection_QuerySudoersResponse) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -851,6 +930,9 @@ type QuerySudoersResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Sudoers *Sudoers `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers,omitempty"`
+	// Params: The module parameters, including the delay applied to root
+	// changes.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (x *QuerySudoersResponse) Reset() {
@@ -880,6 +962,13 @@ func (x *QuerySudoersResponse) GetSudoers() *Sudoers {
 	return nil
 }
 
+func (x *QuerySudoersResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 var File_nibiru_sudo_v1_query_proto protoreflect.FileDescriptor
 
 var file_nibiru_sudo_v1_query_proto_rawDesc = []byte{
@@ -892,31 +981,34 @@ var file_nibiru_sudo_v1_query_proto_rawDesc = []byte{
 	0x1a, 0x1a, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x73, 0x75, 0x64, 0x6f, 0x2f, 0x76, 0x31,
 	0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x15, 0x0a, 0x13,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x64, 0x6f,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x73,
-	0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x73, 0x75, 0x64, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x64, 0x6f, 0x65, 0x72, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x75, 0x64,
-	0x6f, 0x65, 0x72, 0x73, 0x32, 0x80, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x77,
-	0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x12, 0x23,
-	0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x73, 0x75, 0x64, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x73, 0x75, 0x64,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x64, 0x6f, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x73, 0x75, 0x64, 0x6f, 0x2f,
-	0x73, 0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x42, 0xa2, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
-	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x73, 0x75, 0x64, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x73, 0x75, 0x64, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x75,
-	0x64, 0x6f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x58, 0xaa, 0x02, 0x0e, 0x4e, 0x69, 0x62,
-	0x69, 0x72, 0x75, 0x2e, 0x53, 0x75, 0x64, 0x6f, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x4e, 0x69,
-	0x62, 0x69, 0x72, 0x75, 0x5c, 0x53, 0x75, 0x64, 0x6f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x4e,
-	0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x53, 0x75, 0x64, 0x6f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69,
-	0x72, 0x75, 0x3a, 0x3a, 0x53, 0x75, 0x64, 0x6f, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x64,
+	0x6f, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07,
+	0x73, 0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x73, 0x75, 0x64, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x75,
+	0x64, 0x6f, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x73,
+	0x75, 0x64, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0x80, 0x01, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x77, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75,
+	0x64, 0x6f, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x73,
+	0x75, 0x64, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x64, 0x6f,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x69, 0x62,
+	0x69, 0x72, 0x75, 0x2e, 0x73, 0x75, 0x64, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72,
+	0x75, 0x2f, 0x73, 0x75, 0x64, 0x6f, 0x2f, 0x73, 0x75, 0x64, 0x6f, 0x65, 0x72, 0x73, 0x42, 0xa2,
+	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x73, 0x75,
+	0x64, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2f, 0x73, 0x75, 0x64,
+	0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x75, 0x64, 0x6f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53,
+	0x58, 0xaa, 0x02, 0x0e, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x2e, 0x53, 0x75, 0x64, 0x6f, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x0e, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x53, 0x75, 0x64, 0x6f,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x5c, 0x53, 0x75, 0x64,
+	0x6f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x10, 0x4e, 0x69, 0x62, 0x69, 0x72, 0x75, 0x3a, 0x3a, 0x53, 0x75, 0x64, 0x6f, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -936,16 +1028,18 @@ var file_nibiru_sudo_v1_query_proto_goTypes = []interface{}{
 	(*QuerySudoersRequest)(nil),  // 0: nibiru.sudo.v1.QuerySudoersRequest
 	(*QuerySudoersResponse)(nil), // 1: nibiru.sudo.v1.QuerySudoersResponse
 	(*Sudoers)(nil),              // 2: nibiru.sudo.v1.Sudoers
+	(*Params)(nil),               // 3: nibiru.sudo.v1.Params
 }
 var file_nibiru_sudo_v1_query_proto_depIdxs = []int32{
 	2, // 0: nibiru.sudo.v1.QuerySudoersResponse.sudoers:type_name -> nibiru.sudo.v1.Sudoers
-	0, // 1: nibiru.sudo.v1.Query.QuerySudoers:input_type -> nibiru.sudo.v1.QuerySudoersRequest
-	1, // 2: nibiru.sudo.v1.Query.QuerySudoers:output_type -> nibiru.sudo.v1.QuerySudoersResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: nibiru.sudo.v1.QuerySudoersResponse.params:type_name -> nibiru.sudo.v1.Params
+	0, // 2: nibiru.sudo.v1.Query.QuerySudoers:input_type -> nibiru.sudo.v1.QuerySudoersRequest
+	1, // 3: nibiru.sudo.v1.Query.QuerySudoers:output_type -> nibiru.sudo.v1.QuerySudoersResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_nibiru_sudo_v1_query_proto_init() }
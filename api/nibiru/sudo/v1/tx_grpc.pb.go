@@ -24,7 +24,18 @@ const _ = grpc.SupportPackageIsVersion7
 type MsgClient interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error)
+	// ChangeRoot proposes a new root. The change takes effect only after the
+	// new root accepts it with "AcceptRoot".
 	ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error)
+	// AcceptRoot completes a pending root change. It must be signed by the
+	// proposed new root after the root change delay has elapsed.
+	AcceptRoot(ctx context.Context, in *MsgAcceptRoot, opts ...grpc.CallOption) (*MsgAcceptRootResponse, error)
+	// CancelRootChange discards a pending root change. It must be signed by the
+	// current root.
+	CancelRootChange(ctx context.Context, in *MsgCancelRootChange, opts ...grpc.CallOption) (*MsgCancelRootChangeResponse, error)
+	// UpdateParams updates the module parameters. The authority is the x/gov
+	// module account unless the module config sets another one.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -53,13 +64,51 @@ func (c *msgClient) ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) AcceptRoot(ctx context.Context, in *MsgAcceptRoot, opts ...grpc.CallOption) (*MsgAcceptRootResponse, error) {
+	out := new(MsgAcceptRootResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/AcceptRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRootChange(ctx context.Context, in *MsgCancelRootChange, opts ...grpc.CallOption) (*MsgCancelRootChangeResponse, error) {
+	out := new(MsgCancelRootChangeResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/CancelRootChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(context.Context, *MsgEditSudoers) (*MsgEditSudoersResponse, error)
+	// ChangeRoot proposes a new root. The change takes effect only after the
+	// new root accepts it with "AcceptRoot".
 	ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error)
+	// AcceptRoot completes a pending root change. It must be signed by the
+	// proposed new root after the root change delay has elapsed.
+	AcceptRoot(context.Context, *MsgAcceptRoot) (*MsgAcceptRootResponse, error)
+	// CancelRootChange discards a pending root change. It must be signed by the
+	// current root.
+	CancelRootChange(context.Context, *MsgCancelRootChange) (*MsgCancelRootChangeResponse, error)
+	// UpdateParams updates the module parameters. The authority is the x/gov
+	// module account unless the module config sets another one.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -73,6 +122,15 @@ func (UnimplementedMsgServer) EditSudoers(context.Context, *MsgEditSudoers) (*Ms
 func (UnimplementedMsgServer) ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoot not implemented")
 }
+func (UnimplementedMsgServer) AcceptRoot(context.Context, *MsgAcceptRoot) (*MsgAcceptRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRoot not implemented")
+}
+func (UnimplementedMsgServer) CancelRootChange(context.Context, *MsgCancelRootChange) (*MsgCancelRootChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRootChange not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -122,6 +180,60 @@ func _Msg_ChangeRoot_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcceptRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/AcceptRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptRoot(ctx, req.(*MsgAcceptRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRootChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRootChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRootChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/CancelRootChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRootChange(ctx, req.(*MsgCancelRootChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -137,6 +249,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeRoot",
 			Handler:    _Msg_ChangeRoot_Handler,
 		},
+		{
+			MethodName: "AcceptRoot",
+			Handler:    _Msg_AcceptRoot_Handler,
+		},
+		{
+			MethodName: "CancelRootChange",
+			Handler:    _Msg_CancelRootChange_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/tx.proto",
@@ -0,0 +1,47 @@
+package ante
+
+import (
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+// NewTxFeeChecker returns the [sdkante.TxFeeChecker] of the Cosmos txs. Like
+// the default of the SDK, it checks the fee against the minimum gas prices of
+// the validator in CheckTx. The priority of the tx is in the same unit as the
+// one of Ethereum txs (see [evm.GetCosmosTxPriority]), so that both kinds of
+// txs are ordered by the tip they pay in the mempool.
+func NewTxFeeChecker(evmKeeper *evmkeeper.Keeper) sdkante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, sdkioerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		// The minimum gas prices are only checked for the local mempool.
+		if minGasPrices := ctx.MinGasPrices(); ctx.IsCheckTx() && !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+			// fee = ceil(minGasPrice * gasLimit)
+			gasLimit := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(gasLimit)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, sdkioerrors.Wrapf(
+					sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees,
+				)
+			}
+		}
+
+		priority := evm.GetCosmosTxPriority(feeCoins, gas, evmKeeper.BaseFeeWeiPerGas(ctx))
+		return feeCoins, priority, nil
+	}
+}
@@ -0,0 +1,78 @@
+package ante_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	nibiruante "github.com/NibiruChain/nibiru/v2/app/ante"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// TestTxFeeChecker_Priority checks that Cosmos and Ethereum txs get their
+// priority from the tip they pay on top of the base fee, whatever their kind.
+func TestTxFeeChecker_Priority(t *testing.T) {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+	txConfig := nibiru.GetTxConfig()
+	baseFeeWei := nibiru.EvmKeeper.BaseFeeWeiPerGas(ctx)
+	to := gethcommon.BigToAddress(big.NewInt(1))
+	const gasLimit = 100_000
+
+	// ethTxPriority returns the priority that the EVM ante handler gives to an
+	// Ethereum tx that pays the tip in wei per gas.
+	ethTxPriority := func(tipWei *big.Int) int64 {
+		msg := evm.NewTx(&evm.EvmTxArgs{
+			To:       &to,
+			Amount:   big.NewInt(1),
+			GasLimit: gasLimit,
+			GasPrice: new(big.Int).Add(baseFeeWei, tipWei),
+		})
+		txData, err := evm.UnpackTxData(msg.Data)
+		require.NoError(t, err)
+		return evm.GetTxPriority(txData, baseFeeWei)
+	}
+	// cosmosTxPriority returns the priority that the fee checker of the ante handler
+	// gives to a Cosmos tx whose gas price pays the tip in wei per gas.
+	cosmosTxPriority := func(tipWei *big.Int) int64 {
+		priv := secp256k1.GenPrivKey()
+		sender := sdk.AccAddress(priv.PubKey().Address())
+		gasPriceWei := new(big.Int).Add(baseFeeWei, tipWei)
+		fee := evm.WeiToNative(new(big.Int).Mul(gasPriceWei, big.NewInt(gasLimit)))
+
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(
+			sender, sender, sdk.NewCoins(sdk.NewInt64Coin(eth.EthBaseDenom, 1)),
+		)))
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(eth.EthBaseDenom, sdkmath.NewIntFromBigInt(fee))))
+		require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey: priv.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		}))
+		tx := txBuilder.GetTx()
+		_, priority, err := nibiruante.NewTxFeeChecker(nibiru.EvmKeeper)(ctx, tx)
+		require.NoError(t, err)
+		return priority
+	}
+
+	tipUnit := evm.NativeToWei(big.NewInt(1))
+	cosmosLowPriority := cosmosTxPriority(tipUnit)
+	ethMidPriority := ethTxPriority(new(big.Int).Mul(big.NewInt(2), tipUnit))
+	cosmosHighPriority := cosmosTxPriority(new(big.Int).Mul(big.NewInt(3), tipUnit))
+	ethLowPriority := ethTxPriority(tipUnit)
+
+	// The same tip gives the same priority to both kinds of txs.
+	require.Equal(t, cosmosLowPriority, ethLowPriority)
+	require.Positive(t, cosmosLowPriority)
+	require.Less(t, cosmosLowPriority, ethMidPriority)
+	require.Less(t, ethMidPriority, cosmosHighPriority)
+}
@@ -51,6 +51,7 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -141,6 +142,7 @@ var (
 		evidence.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		groupmodule.AppModuleBasic{},
+		nftmodule.AppModuleBasic{},
 		// ibc 'AppModuleBasic's
 		ibc.AppModuleBasic{},
 		ibctransfer.AppModuleBasic{},
@@ -256,6 +258,8 @@ func NewNibiruApp(
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *NibiruApp {
 	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
+		// Txs are ordered by the CometBFT mempool, using the priority that the
+		// ante handler returns in CheckTx.
 		mp := mempool.NoOpMempool{}
 		app.SetMempool(mp)
 		handler := baseapp.NewDefaultProposalHandler(mp, app)
@@ -317,6 +321,7 @@ func NewNibiruApp(
 		&app.authzKeeper,
 		&app.evidenceKeeper,
 		&app.FeeGrantKeeper,
+		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
 		&app.SudoKeeper,
 		&app.OracleKeeper,
@@ -431,6 +436,7 @@ func NewNibiruApp(
 			SignModeHandler:        app.txConfig.SignModeHandler(),
 			SigGasConsumer:         authante.DefaultSigVerificationGasConsumer,
 			ExtensionOptionChecker: func(*codectypes.Any) bool { return true },
+			TxFeeChecker:           ante.NewTxFeeChecker(app.EvmKeeper),
 		},
 		IBCKeeper:         app.ibcKeeper,
 		TxCounterStoreKey: app.keys[wasmtypes.StoreKey],
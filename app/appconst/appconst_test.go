@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
@@ -48,3 +49,10 @@ func (s *TestSuite) TestGetEthChainID() {
 		}
 	})
 }
+
+func (s *TestSuite) TestNewDefaultTendermintConfig() {
+	cfg := appconst.NewDefaultTendermintConfig()
+	s.Require().NoError(cfg.ValidateBasic())
+	// CometBFT orders the txs of the mempool by their CheckTx priority.
+	s.Equal(cmtcfg.MempoolV1, cfg.Mempool.Version)
+}
@@ -7,8 +7,8 @@ import (
 )
 
 // NewDefaultTendermintConfig returns a consensus "Config" (CometBFT) with new
-// default values for the "consensus" and "db_backend" fields to be enforced upon
-// node initialization. See the "nibiru/cmd/nibid/cmd/InitCmd" function for more
+// default values for the "consensus", "db_backend" and "mempool.version" fields
+// to be enforced upon node initialization. See the "nibiru/cmd/nibid/cmd/InitCmd" function for more
 // information.
 func NewDefaultTendermintConfig() *cmtcfg.Config {
 	cfg := cmtcfg.DefaultConfig()
@@ -26,5 +26,9 @@ func NewDefaultTendermintConfig() *cmtcfg.Config {
 	cfg.Consensus.TimeoutCommit = ms(1_000)
 
 	cfg.DBBackend = string(DefaultDBBackend)
+
+	// The priority mempool orders txs by the priority that the ante handler
+	// returns in CheckTx, which grows with the tip paid on top of the base fee.
+	cfg.Mempool.Version = cmtcfg.MempoolV1
 	return cfg
 }
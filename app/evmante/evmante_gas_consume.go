@@ -69,6 +69,7 @@ func (anteDec AnteDecEthGasConsume) AnteHandle(
 	// Use the lowest priority of all the messages as the final one.
 	minPriority := int64(math.MaxInt64)
 	baseFeeMicronibiPerGas := anteDec.evmKeeper.BaseFeeMicronibiPerGas(ctx)
+	baseFeeWeiPerGas := anteDec.evmKeeper.BaseFeeWeiPerGas(ctx)
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evm.MsgEthereumTx)
@@ -117,7 +118,9 @@ func (anteDec AnteDecEthGasConsume) AnteHandle(
 			),
 		)
 
-		priority := evm.GetTxPriority(txData, baseFeeMicronibiPerGas)
+		// The priority of the tx grows with the tip paid on top of the base
+		// fee, so that the mempool includes the txs with the highest tips first.
+		priority := evm.GetTxPriority(txData, baseFeeWeiPerGas)
 
 		if priority < minPriority {
 			minPriority = priority
@@ -247,6 +247,10 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		append(GetWasmOpts(*app, appOpts, wmha), wasmkeeper.WithWasmEngine(wasmVM))...,
 	)
 
+	// Epoch subscribers are sudo contracts called through the Wasm "sudo"
+	// entry point.
+	app.EpochsKeeper.SetWasmKeeper(wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper))
+
 	app.WasmClientKeeper = ibcwasmkeeper.NewKeeperWithVM(
 		app.appCodec,
 		app.keys[ibcwasmtypes.StoreKey],
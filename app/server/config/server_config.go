@@ -4,7 +4,10 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"path"
+	"strconv"
+	stdstrings "strings"
 	"time"
 
 	tracerslogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -91,6 +94,26 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultRateLimitRequestsPerSecond is the default rate at which each
+	// client of the JSON-RPC server regains request credits
+	DefaultRateLimitRequestsPerSecond float64 = 50
+
+	// DefaultRateLimitBurst is the default max number of request credits that
+	// a client of the JSON-RPC server can spend at once
+	DefaultRateLimitBurst = 100
+
+	// DefaultRateLimitWsRequestsPerSecond is the default rate at which each
+	// client of the JSON-RPC WebSocket server regains request credits
+	DefaultRateLimitWsRequestsPerSecond float64 = 20
+
+	// DefaultRateLimitWsBurst is the default max number of request credits
+	// that a client of the JSON-RPC WebSocket server can spend at once
+	DefaultRateLimitWsBurst = 40
+
+	// DefaultRateLimitMaxBatchSize is the default max number of calls in a
+	// JSON-RPC batch request
+	DefaultRateLimitMaxBatchSize = 100
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
@@ -162,6 +185,56 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// EnableTraceToFile defines if the "debug_standardTraceBlockToFile" method
+	// can write trace files to the TraceFileDir.
+	EnableTraceToFile bool `mapstructure:"enable-trace-to-file"`
+	// TraceFileDir defines the directory of the trace files. The temporary
+	// directory of the OS is used if it's empty.
+	TraceFileDir string `mapstructure:"trace-file-dir"`
+	// RateLimit defines the per-client request limits of the JSON-RPC servers.
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+}
+
+// RateLimitConfig defines the per-client request limits of the JSON-RPC HTTP
+// and WebSocket servers. Each client has a token bucket of request credits,
+// and each call costs the weight of its method.
+type RateLimitConfig struct {
+	// Enable defines if the requests of each client are limited.
+	Enable bool `mapstructure:"enable"`
+	// RequestsPerSecond is the rate at which a client of the HTTP server
+	// regains request credits.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+	// Burst is the max number of request credits that a client of the HTTP
+	// server can spend at once.
+	Burst int `mapstructure:"burst"`
+	// WsRequestsPerSecond is the rate at which a client of the WebSocket
+	// server regains request credits.
+	WsRequestsPerSecond float64 `mapstructure:"ws-requests-per-second"`
+	// WsBurst is the max number of request credits that a client of the
+	// WebSocket server can spend at once.
+	WsBurst int `mapstructure:"ws-burst"`
+	// MaxBatchSize is the max number of calls in a batch request.
+	MaxBatchSize int `mapstructure:"max-batch-size"`
+	// APIKeyHeader is the HTTP header that holds the API key of a client.
+	// Clients are keyed by API key when the header is set in the request, and
+	// by IP address otherwise.
+	APIKeyHeader string `mapstructure:"api-key-header"`
+	// APIKeys defines the quotas of API keys in the form
+	// "<api-key>:<requests-per-second>". The burst of an API key is scaled by
+	// the same factor as its rate. Unknown API keys get the default limits.
+	APIKeys []string `mapstructure:"api-keys"`
+	// TrustProxyHeaders defines if the IP address of a client is read from
+	// the "X-Forwarded-For" or "X-Real-IP" headers set by a reverse proxy.
+	// The client is the rightmost "X-Forwarded-For" entry that isn't one of
+	// the TrustedProxies, since the entries on its left are set by the client.
+	TrustProxyHeaders bool `mapstructure:"trust-proxy-headers"`
+	// TrustedProxies defines the IP addresses or CIDR ranges of the reverse
+	// proxies in front of the server, which are skipped in "X-Forwarded-For".
+	TrustedProxies []string `mapstructure:"trusted-proxies"`
+	// MethodWeights defines the cost of calls to expensive methods. Method
+	// names are case-insensitive, and a name ending in "*" matches all of the
+	// methods with that prefix. The other methods cost 1.
+	MethodWeights map[string]int `mapstructure:"method-weights"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -248,7 +321,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "net", "txpool", "debug"}
+	return []string{"web3", "eth", "net", "txpool", "debug", "trace", "ots"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -272,7 +345,110 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		EnableTraceToFile:        false,
+		TraceFileDir:             "",
+		RateLimit:                *DefaultRateLimitConfig(),
+	}
+}
+
+// DefaultRateLimitConfig returns the default, disabled, rate limits of the
+// JSON-RPC servers.
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enable:              false,
+		RequestsPerSecond:   DefaultRateLimitRequestsPerSecond,
+		Burst:               DefaultRateLimitBurst,
+		WsRequestsPerSecond: DefaultRateLimitWsRequestsPerSecond,
+		WsBurst:             DefaultRateLimitWsBurst,
+		MaxBatchSize:        DefaultRateLimitMaxBatchSize,
+		APIKeyHeader:        "",
+		APIKeys:             []string{},
+		TrustProxyHeaders:   false,
+		TrustedProxies:      []string{},
+		// Method names are lowercase because viper lowercases the keys of
+		// the method weights read from app.toml.
+		MethodWeights: map[string]int{
+			"eth_getlogs":     10,
+			"eth_call":        2,
+			"eth_estimategas": 2,
+			"debug_trace*":    20,
+		},
+	}
+}
+
+// ParseAPIKeys returns the requests per second of each API key in APIKeys.
+func (c RateLimitConfig) ParseAPIKeys() (map[string]float64, error) {
+	apiKeys := make(map[string]float64, len(c.APIKeys))
+	for _, apiKeyQuota := range c.APIKeys {
+		sep := stdstrings.LastIndex(apiKeyQuota, ":")
+		if sep <= 0 {
+			return nil, fmt.Errorf(
+				"API key quota %q is not in the form <api-key>:<requests-per-second>", apiKeyQuota,
+			)
+		}
+		requestsPerSecond, err := strconv.ParseFloat(apiKeyQuota[sep+1:], 64)
+		if err != nil || requestsPerSecond <= 0 {
+			return nil, fmt.Errorf(
+				"API key quota %q must have positive requests per second", apiKeyQuota,
+			)
+		}
+		apiKeys[apiKeyQuota[:sep]] = requestsPerSecond
+	}
+	return apiKeys, nil
+}
+
+// ParseTrustedProxies returns the IP ranges of the TrustedProxies. A single
+// IP address is a range of one address.
+func (c RateLimitConfig) ParseTrustedProxies() ([]netip.Prefix, error) {
+	proxies := make([]netip.Prefix, len(c.TrustedProxies))
+	for i, proxy := range c.TrustedProxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			proxies[i] = prefix.Masked()
+			continue
+		}
+		addr, err := netip.ParseAddr(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is neither an IP address nor a CIDR range", proxy)
+		}
+		proxies[i] = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+	}
+	return proxies, nil
+}
+
+// Validate returns an error if the rate limits are invalid.
+func (c RateLimitConfig) Validate() error {
+	if c.MaxBatchSize < 0 {
+		return errors.New("max batch size cannot be negative")
+	}
+
+	if !c.Enable {
+		return nil
 	}
+
+	if c.MaxBatchSize < 1 {
+		return errors.New("max batch size must be at least 1")
+	}
+
+	if c.RequestsPerSecond <= 0 || c.WsRequestsPerSecond <= 0 {
+		return errors.New("requests per second must be positive")
+	}
+
+	if c.Burst < 1 || c.WsBurst < 1 {
+		return errors.New("burst must be at least 1")
+	}
+
+	for method, weight := range c.MethodWeights {
+		if weight < 1 {
+			return fmt.Errorf("weight of method %s must be at least 1", method)
+		}
+	}
+
+	if _, err := c.ParseTrustedProxies(); err != nil {
+		return err
+	}
+
+	_, err := c.ParseAPIKeys()
+	return err
 }
 
 // Validate returns an error if the JSON-RPC configuration fields are invalid.
@@ -313,6 +489,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if err := c.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("JSON-RPC rate limit: %w", err)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -433,7 +613,7 @@ address = "{{ .JSONRPC.Address }}"
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
 # API defines a list of JSON-RPC namespaces that should be enabled
-# Example: "eth,txpool,net,debug,web3"
+# Example: "eth,txpool,net,debug,web3,trace,ots"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
@@ -481,6 +661,62 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# EnableTraceToFile allows the 'debug_standardTraceBlockToFile' method to write
+# trace files to the trace-file-dir. Keep it disabled on public nodes, since the
+# trace files can fill the disk.
+enable-trace-to-file = {{ .JSONRPC.EnableTraceToFile }}
+
+# TraceFileDir defines the directory of the trace files. The temporary directory
+# of the OS is used if it's empty.
+trace-file-dir = "{{ .JSONRPC.TraceFileDir }}"
+
+[json-rpc.rate-limit]
+
+# Enable defines if the requests of each client to the JSON-RPC servers are limited.
+# Each client has a bucket of request credits, and each call costs the weight of its
+# method. Throttled calls get the JSON-RPC error code -32005 (limit exceeded).
+enable = {{ .JSONRPC.RateLimit.Enable }}
+
+# RequestsPerSecond is the rate at which a client of the HTTP server regains request credits.
+requests-per-second = {{ .JSONRPC.RateLimit.RequestsPerSecond }}
+
+# Burst is the max number of request credits that a client of the HTTP server can spend at once.
+burst = {{ .JSONRPC.RateLimit.Burst }}
+
+# WsRequestsPerSecond is the rate at which a client of the WebSocket server regains request credits.
+ws-requests-per-second = {{ .JSONRPC.RateLimit.WsRequestsPerSecond }}
+
+# WsBurst is the max number of request credits that a client of the WebSocket server can spend at once.
+ws-burst = {{ .JSONRPC.RateLimit.WsBurst }}
+
+# MaxBatchSize is the max number of calls in a batch request.
+max-batch-size = {{ .JSONRPC.RateLimit.MaxBatchSize }}
+
+# APIKeyHeader is the HTTP header that holds the API key of a client, e.g. "X-API-Key".
+# Clients are keyed by API key when the header is set, and by IP address otherwise.
+api-key-header = "{{ .JSONRPC.RateLimit.APIKeyHeader }}"
+
+# APIKeys defines the quotas of API keys in the form "<api-key>:<requests-per-second>".
+# The burst of an API key is scaled by the same factor as its rate.
+# Example: ["my-indexer-key:500"]
+api-keys = [{{ range $index, $elmt := .JSONRPC.RateLimit.APIKeys }}{{ if $index }}, {{ end }}"{{ $elmt }}"{{ end }}]
+
+# TrustProxyHeaders defines if the IP address of a client is read from the
+# X-Forwarded-For or X-Real-IP headers. Only enable it behind a reverse proxy.
+# The client is the rightmost X-Forwarded-For entry that isn't a trusted proxy.
+trust-proxy-headers = {{ .JSONRPC.RateLimit.TrustProxyHeaders }}
+
+# TrustedProxies defines the IP addresses or CIDR ranges of the reverse proxies in
+# front of the server, which are skipped in X-Forwarded-For.
+# Example: ["10.0.0.0/8"]
+trusted-proxies = [{{ range $index, $elmt := .JSONRPC.RateLimit.TrustedProxies }}{{ if $index }}, {{ end }}"{{ $elmt }}"{{ end }}]
+
+# MethodWeights defines the cost of calls to expensive methods. Method names are
+# case-insensitive, and a name ending in "*" matches all of the methods with that
+# prefix. The other methods cost 1.
+[json-rpc.rate-limit.method-weights]
+{{ range $method, $weight := .JSONRPC.RateLimit.MethodWeights }}"{{ $method }}" = {{ $weight }}
+{{ end }}
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -3,6 +3,7 @@ package server
 import (
 	"errors"
 	"html/template"
+	"net"
 	"net/http"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
 
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -29,8 +31,13 @@ import (
 //go:embed evm_json_rpc_get.html
 var htmlTemplateEvmJsonRpc []byte
 
+// JSONRPCMetricsPath is the path of the Prometheus metrics of the JSON-RPC
+// server on the metrics address.
+const JSONRPCMetricsPath = "/debug/metrics/prometheus"
+
 // StartEthereumJSONRPC starts the Ethereum JSON-RPC server and websocket server
-// for Nibiru.
+// for Nibiru. When the "--metrics" flag is passed, it also starts a server
+// for the Prometheus metrics of the JSON-RPC API on the metrics address.
 func StartEthereumJSONRPC(
 	ctx *server.Context,
 	clientCtx client.Context,
@@ -48,6 +55,7 @@ func StartEthereumJSONRPC(
 	gethlog.SetDefault(gethLogger)
 
 	rpcServer := gethrpc.NewServer()
+	rpcServer.SetHTTPBodyLimit(rpcapi.HTTPBodyLimit)
 
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
@@ -65,12 +73,34 @@ func StartEthereumJSONRPC(
 		}
 	}
 
+	// Metrics are enabled when --metrics is passed. The flag is not added in
+	// the config to avoid users enabling it in the config without the CLI.
+	var metrics *rpcapi.Metrics
+	var rpcHandler http.Handler = rpcServer
+	if ctx.Viper.GetBool(JSONRPCEnableMetrics) {
+		metrics = rpcapi.NewMetrics(clientCtx, apis, indexer)
+	}
+
+	var rateLimiter *rpcapi.RateLimiter
+	if config.JSONRPC.RateLimit.Enable {
+		var err error
+		rateLimiter, err = rpcapi.NewRateLimiter(config.JSONRPC.RateLimit)
+		if err != nil {
+			return nil, nil, err
+		}
+		rpcHandler = rateLimiter.Middleware(rpcHandler)
+	}
+	if metrics != nil {
+		// Metrics wrap the rate limiter to count throttled calls as errors.
+		rpcHandler = metrics.Middleware(rpcHandler)
+	}
+
 	// This router for the Ethereum JSON-RPC matches on both the path ("/")
 	// and method ("POST", "GET", "PUT") to choose a handler. This allows us
 	// to add different behavior based on the type of request to display a
 	// webpage if someone visits the RPC URL.
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
 	r.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		startTime := time.Now()
 		w.Header().Set("Content-Type", "text/html")
@@ -112,6 +142,16 @@ func StartEthereumJSONRPC(
 	}
 	httpSrvDone := make(chan struct{}, 1)
 
+	if metrics != nil {
+		metricsSrv, err := startJSONRPCMetricsServer(ctx, config, metrics)
+		if err != nil {
+			return nil, nil, err
+		}
+		httpSrv.RegisterOnShutdown(func() {
+			_ = metricsSrv.Close()
+		})
+	}
+
 	ln, err := Listen(httpSrv.Addr, config)
 	if err != nil {
 		return nil, nil, err
@@ -142,7 +182,37 @@ func StartEthereumJSONRPC(
 
 	// allocate separate WS connection to Tendermint
 	tmWsClientForRPCWs := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpcapi.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClientForRPCWs, config)
+	wsSrv := rpcapi.NewWebsocketsServer(
+		clientCtx, ctx.Logger, tmWsClientForRPCWs, config, metrics, rateLimiter,
+	)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// startJSONRPCMetricsServer serves the metrics of the JSON-RPC server at
+// [JSONRPCMetricsPath] on the metrics address of the config.
+func startJSONRPCMetricsServer(
+	ctx *server.Context,
+	config *srvconfig.Config,
+	metrics *rpcapi.Metrics,
+) (*http.Server, error) {
+	r := mux.NewRouter()
+	r.Handle(JSONRPCMetricsPath, promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}))
+	metricsSrv := &http.Server{
+		Addr:              config.JSONRPC.MetricsAddress,
+		Handler:           r,
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+	}
+
+	ln, err := net.Listen("tcp", metricsSrv.Addr)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		ctx.Logger.Info("Starting JSON-RPC metrics server", "address", metricsSrv.Addr)
+		if err := metricsSrv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			ctx.Logger.Error("JSON-RPC metrics server stopped", "error", err.Error())
+		}
+	}()
+	return metricsSrv, nil
+}
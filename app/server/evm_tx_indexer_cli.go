@@ -24,6 +24,11 @@ func NewEVMTxIndexCmd() *cobra.Command {
 with EVMTxIndexer turned off or was stopped without proper closing/flushing EVMIndexerDB.
 Processes blocks from minBlockNumber to maxBlockNumber, indexes evm txs.
 
+Blocks indexed by versions of the node without the address indexes of the
+"ots" namespace are missing from its lookups by address until they are indexed
+again. Indexing them again up to the blocks indexed with the address indexes,
+for example from the first available block to "latest", fills the gap.
+
 - minBlockNumber: min block to start indexing. Supply "last-indexed" to start with the latest block available in EVMIndexerDB.
 - maxBlockNumber: max block, could be a number or "latest".
 
@@ -69,9 +74,9 @@ nibid evm-tx-index last-indexed latest
 					fromBlock = 0
 				}
 			} else {
-				fromBlock, err = strconv.ParseInt(args[1], 10, 64)
+				fromBlock, err = strconv.ParseInt(args[0], 10, 64)
 				if err != nil {
-					return fmt.Errorf("cannot parse min block number: %s", args[1])
+					return fmt.Errorf("cannot parse min block number: %s", args[0])
 				}
 				if fromBlock > maxAvailableHeight {
 					return fmt.Errorf("maximum available block is: %d", maxAvailableHeight)
@@ -121,6 +126,19 @@ nibid evm-tx-index last-indexed latest
 				}
 				fmt.Println(height)
 			}
+
+			// The address indexes are complete from fromBlock if the blocks
+			// indexed up to toBlock reach the ones indexed with them.
+			addressIndexedFrom, err := evmTxIndexer.AddressIndexedFrom()
+			if err != nil {
+				return err
+			}
+			if fromBlock < addressIndexedFrom && addressIndexedFrom <= toBlock+1 {
+				if err := evmTxIndexer.SetAddressIndexedFrom(fromBlock); err != nil {
+					return err
+				}
+				fmt.Printf("Address indexes are complete from block %d\n", fromBlock)
+			}
 			err = evmTxIndexer.CloseDBAndExit()
 			if err != nil {
 				return err
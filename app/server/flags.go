@@ -50,6 +50,8 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCEnableTraceToFile   = "json-rpc.enable-trace-to-file"
+	JSONRPCTraceFileDir        = "json-rpc.trace-file-dir"
 	JSONRPCEnableMetrics       = "metrics"
 )
 
@@ -40,8 +40,6 @@ import (
 	"cosmossdk.io/tools/rosetta"
 	crgserver "cosmossdk.io/tools/rosetta/lib/server"
 
-	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
-
 	sdkioerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -197,6 +195,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(JSONRPCEnableTraceToFile, false, "Allow debug_standardTraceBlockToFile to write trace files")
+	cmd.Flags().String(JSONRPCTraceFileDir, "", "Sets the directory of the trace files of debug_standardTraceBlockToFile (default: OS temp dir)") //nolint:lll
 	cmd.Flags().Bool(JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -376,12 +376,6 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, opts Start
 		return err
 	}
 
-	// Enable metrics if JSONRPC is enabled and --metrics is passed
-	// Flag not added in config to avoid user enabling in config without passing in CLI
-	if conf.JSONRPC.Enable && ctx.Viper.GetBool(JSONRPCEnableMetrics) {
-		ethmetricsexp.Setup(conf.JSONRPC.MetricsAddress)
-	}
-
 	var evmIdxer eth.EVMTxIndexer
 	if conf.JSONRPC.EnableIndexer {
 		idxDB, err := OpenIndexerDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
@@ -0,0 +1,300 @@
+package v2_6_0
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/NibiruChain/collections"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clientkeeper "github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/app/upgrades"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+)
+
+const UpgradeName = "v2.6.0"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName: UpgradeName,
+	CreateUpgradeHandler: func(
+		mm *module.Manager,
+		cfg module.Configurator,
+		nibiru *keepers.PublicKeepers,
+		clientKeeper clientkeeper.Keeper,
+	) upgradetypes.UpgradeHandler {
+		return func(
+			ctx sdk.Context,
+			plan upgradetypes.Plan,
+			fromVM module.VersionMap,
+		) (module.VersionMap, error) {
+			err := UpgradeFunTokenContracts(nibiru, ctx)
+			if err != nil {
+				panic(fmt.Errorf("v2.6.0 upgrade failure: %w", err))
+			}
+			ReconcileFunTokenEscrows(nibiru, ctx)
+
+			return mm.RunMigrations(ctx, cfg, fromVM)
+		}
+	},
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{nft.StoreKey},
+	},
+}
+
+// UpgradeFunTokenContracts replaces the bytecode of every ERC20 deployed by
+// the EVM module for a FunToken mapping created from a Bank Coin with
+// "ERC20MinterWithMetadataUpdates", so that changes to the bank metadata of
+// the coin can propagate to the name and symbol of the ERC20. Contracts that
+// already run that bytecode are skipped.
+//
+// As in the v2.5.0 upgrade of stNIBI, each contract is upgraded by deploying
+// the new bytecode at a new address and copying its code hash and state over
+// to the original address. The balances, allowances, and total supply of the
+// original contract are kept because they use the same storage slots.
+func UpgradeFunTokenContracts(
+	keepers *keepers.PublicKeepers,
+	ctx sdk.Context,
+) error {
+	newCompiledContract := embeds.SmartContract_ERC20MinterWithMetadataUpdates
+	newCodeHash := crypto.Keccak256Hash(newCompiledContract.DeployedBytecode)
+
+	var funtokens []evm.FunToken
+	for _, funtoken := range keepers.EvmKeeper.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values() {
+		if !funtoken.IsMadeFromCoin {
+			continue
+		}
+		acc := keepers.EvmKeeper.GetAccount(ctx, funtoken.Erc20Addr.Address)
+		if acc == nil || !acc.IsContract() || gethcommon.BytesToHash(acc.CodeHash) == newCodeHash {
+			continue
+		}
+		funtokens = append(funtokens, funtoken)
+	}
+	if len(funtokens) == 0 {
+		return nil
+	}
+
+	var evmLogs []evm.Log
+	for _, funtoken := range funtokens {
+		logs, err := upgradeFunTokenContract(keepers, ctx, funtoken)
+		if err != nil {
+			return fmt.Errorf("failed to upgrade ERC20 %s of FunToken \"%s\": %w",
+				funtoken.Erc20Addr.Hex(), funtoken.BankDenom, err,
+			)
+		}
+		evmLogs = append(evmLogs, logs...)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmLogs})
+	return nil
+}
+
+// ReconcileFunTokenEscrows reconciles every FunToken mapping created from an
+// ERC20 that isn't flagged as rebasing yet, since conversions now check the
+// escrow invariant. Mappings whose escrow already drifted below the supply of
+// their Bank Coin, such as fee-on-transfer or rebasing tokens, are flagged as
+// rebasing so that their conversions keep working pro rata. Each flagged
+// mapping emits an "EventFunTokenEscrowReconciled" sent by the x/upgrade
+// module. A mapping whose escrow can't be read is logged and left as is.
+func ReconcileFunTokenEscrows(
+	keepers *keepers.PublicKeepers,
+	ctx sdk.Context,
+) {
+	sender := authtypes.NewModuleAddress(upgradetypes.ModuleName).String()
+	for _, funtoken := range keepers.EvmKeeper.FunTokens.Iterate(ctx, collections.Range[[]byte]{}).Values() {
+		if funtoken.IsMadeFromCoin || funtoken.IsRebasing {
+			continue
+		}
+		cacheCtx, commit := ctx.CacheContext()
+		reconciled, escrow, err := keepers.EvmKeeper.ReconcileFunTokenEscrowDrift(
+			cacheCtx, funtoken, false, /*isRebasing*/
+		)
+		if err != nil {
+			ctx.Logger().Error(
+				"v2.6.0 upgrade: failed to reconcile FunToken escrow",
+				"erc20", funtoken.Erc20Addr.Hex(),
+				"bank_denom", funtoken.BankDenom,
+				"error", err,
+			)
+			continue
+		}
+		if !reconciled.IsRebasing {
+			continue
+		}
+		commit()
+		ctx.Logger().Info(
+			"v2.6.0 upgrade: FunToken escrow drifted, flagged the mapping as rebasing",
+			"erc20", funtoken.Erc20Addr.Hex(),
+			"bank_denom", funtoken.BankDenom,
+			"escrow", escrow.EscrowBalance.String(),
+			"bank_supply", escrow.BankSupply.String(),
+		)
+		_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenEscrowReconciled{
+			Sender:   sender,
+			FunToken: reconciled,
+			Escrow:   escrow,
+		})
+	}
+}
+
+func upgradeFunTokenContract(
+	keepers *keepers.PublicKeepers,
+	ctx sdk.Context,
+	funtoken evm.FunToken,
+) (evmLogs []evm.Log, err error) {
+	var (
+		originalErc20Addr    = funtoken.Erc20Addr.Address
+		originalErc20Account = keepers.EvmKeeper.GetAccount(ctx, originalErc20Addr)
+		newCompiledContract  = embeds.SmartContract_ERC20MinterWithMetadataUpdates
+		accState             = keepers.EvmKeeper.EvmState.AccState
+	)
+
+	evmModuleNonce := keepers.EvmKeeper.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS)
+	newErc20Addr := crypto.CreateAddress(evm.EVM_MODULE_ADDRESS, evmModuleNonce)
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               nil,                    // To is blank -> deploy contract
+		From:             evm.EVM_MODULE_ADDRESS, // From is the deployer
+		Nonce:            evmModuleNonce,
+		Value:            unusedBigInt, // amount
+		GasLimit:         evmkeeper.Erc20GasLimitDeploy,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		AccessList:       gethcore.AccessList{},
+		SkipNonceChecks:  false,
+		SkipFromEOACheck: false,
+	}
+	stateDB := keepers.EvmKeeper.Bank.StateDB
+	if stateDB == nil {
+		stateDB = keepers.EvmKeeper.NewStateDB(ctx, keepers.EvmKeeper.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		keepers.EvmKeeper.Bank.StateDB = nil
+	}()
+	evmObj := keepers.EvmKeeper.NewEVM(ctx, evmMsg, keepers.EvmKeeper.GetEVMConfig(ctx), nil, stateDB)
+
+	// -------------------------------------------------------------------------
+	// STEP 1: Read the metadata of the original contract. The name and symbol
+	// follow the bank metadata of the coin when it exists, and the decimals
+	// never change.
+	// -------------------------------------------------------------------------
+	desiredName, err := keepers.EvmKeeper.ERC20().LoadERC20Name(
+		ctx, evmObj, newCompiledContract.ABI, originalErc20Addr,
+	)
+	if err != nil {
+		return nil, err
+	}
+	desiredSymbol, err := keepers.EvmKeeper.ERC20().LoadERC20Symbol(
+		ctx, evmObj, newCompiledContract.ABI, originalErc20Addr,
+	)
+	if err != nil {
+		return nil, err
+	}
+	desiredDecimals, err := keepers.EvmKeeper.ERC20().LoadERC20Decimals(
+		ctx, evmObj, newCompiledContract.ABI, originalErc20Addr,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if bankMetadata, found := keepers.BankKeeper.GetDenomMetaData(ctx, funtoken.BankDenom); found {
+		desiredName, desiredSymbol = bankMetadata.Name, bankMetadata.Symbol
+	}
+
+	// -------------------------------------------------------------------------
+	// STEP 2: Deploy the new bytecode at a new address. That produces a valid
+	// state we can copy over to the original address.
+	// -------------------------------------------------------------------------
+	// empty method name means deploy with the constructor
+	packedArgs, err := newCompiledContract.ABI.Pack("", desiredName, desiredSymbol, desiredDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack ABI args: %w", err)
+	}
+	contractInput := append(newCompiledContract.Bytecode, packedArgs...)
+	evmResp, err := keepers.EvmKeeper.CallContractWithInput(
+		ctx, evmObj, evmMsg.From, nil, true /*commit*/, contractInput,
+		evmkeeper.Erc20GasLimitDeploy,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy ERC20 contract: %w", err)
+	} else if len(evmResp.VmError) > 0 {
+		return nil, fmt.Errorf("VM Error in deploy ERC20: %s", evmResp.VmError)
+	}
+	evmLogs = append(evmLogs, evmResp.Logs...)
+	_ = ctx.EventManager().EmitTypedEvents(
+		&evm.EventContractDeployed{
+			Sender:       evmMsg.From.Hex(),
+			ContractAddr: newErc20Addr.Hex(),
+		},
+	)
+
+	// -------------------------------------------------------------------------
+	// STEP 3: Copy over the new bytecode by overwriting the code hash of the
+	// original ERC20 account
+	// -------------------------------------------------------------------------
+	newErc20Acc := keepers.EvmKeeper.GetAccount(ctx, newErc20Addr)
+	originalErc20Account.CodeHash = newErc20Acc.CodeHash
+	err = keepers.EvmKeeper.SetAccount(ctx, originalErc20Addr, *originalErc20Account)
+	if err != nil {
+		return nil, fmt.Errorf("overwrite of contract bytecode failed: %w", err)
+	}
+
+	// -------------------------------------------------------------------------
+	// STEP 4: Copy over the state of the new contract. This sets the owner,
+	// decimals, name, and symbol, and leaves the balances untouched.
+	// -------------------------------------------------------------------------
+	{
+		iter := accState.Iterate(ctx, collections.PairRange[gethcommon.Address, gethcommon.Hash]{}.Prefix(newErc20Addr))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			accState.Insert(
+				ctx,
+				collections.Join(originalErc20Addr, iter.Key().K2()),
+				iter.Value(),
+			)
+		}
+	}
+	_ = ctx.EventManager().EmitTypedEvents(
+		// This event is to show we've overwritten the bytecode. Think of this
+		// like a redeployment.
+		&evm.EventContractDeployed{
+			Sender:       evmMsg.From.Hex(),
+			ContractAddr: originalErc20Addr.Hex(),
+		},
+	)
+
+	// -------------------------------------------------------------------------
+	// STEP 5: Sanity check the upgraded contract at the original address. A
+	// new StateDB is needed since the previous one cached the old account.
+	// -------------------------------------------------------------------------
+	stateDB = keepers.EvmKeeper.NewStateDB(ctx, keepers.EvmKeeper.TxConfig(ctx, gethcommon.Hash{}))
+	evmObj = keepers.EvmKeeper.NewEVM(ctx, evmMsg, keepers.EvmKeeper.GetEVMConfig(ctx), nil, stateDB)
+	gotName, _ := keepers.EvmKeeper.ERC20().LoadERC20Name(
+		ctx, evmObj, newCompiledContract.ABI, originalErc20Addr,
+	)
+	gotSymbol, _ := keepers.EvmKeeper.ERC20().LoadERC20Symbol(
+		ctx, evmObj, newCompiledContract.ABI, originalErc20Addr,
+	)
+	gotDecimals, _ := keepers.EvmKeeper.ERC20().LoadERC20Decimals(
+		ctx, evmObj, newCompiledContract.ABI, originalErc20Addr,
+	)
+	if desiredName != gotName || desiredSymbol != gotSymbol || desiredDecimals != gotDecimals {
+		return nil, fmt.Errorf(
+			"mismatch in upgraded contract: wanted (%s, %s, %d), got (%s, %s, %d)",
+			desiredName, desiredSymbol, desiredDecimals, gotName, gotSymbol, gotDecimals,
+		)
+	}
+
+	return evmLogs, nil
+}
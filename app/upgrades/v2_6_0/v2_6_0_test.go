@@ -0,0 +1,236 @@
+package v2_6_0_test
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/NibiruChain/nibiru/v2/app/upgrades/v2_6_0"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	evmkeeper "github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	tf "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
+)
+
+func (s *Suite) TestUpgrade() {
+	s.T().Log("Set up a FunToken whose ERC20 runs the older ERC20Minter bytecode")
+	var (
+		deps    = evmtest.NewTestDeps()
+		creator = testutil.AccAddress()
+		tfDenom = tf.TFDenom{
+			Creator:  creator.String(),
+			Subdenom: "fun",
+		}
+		funtoken  = evmtest.CreateFunTokenForBankCoin(deps, tfDenom.Denom().String(), &s.Suite)
+		erc20Addr = funtoken.Erc20Addr.Address
+		holders   = []gethcommon.Address{
+			gethcommon.BytesToAddress(testutil.AccAddress().Bytes()),
+			gethcommon.BytesToAddress(testutil.AccAddress().Bytes()),
+			gethcommon.BytesToAddress(testutil.AccAddress().Bytes()),
+		}
+		newCompiledContract = embeds.SmartContract_ERC20MinterWithMetadataUpdates
+		newCodeHash         = crypto.Keccak256Hash(newCompiledContract.DeployedBytecode)
+	)
+	s.Require().Equal(
+		newCodeHash, gethcommon.BytesToHash(deps.EvmKeeper.GetAccount(deps.Ctx, erc20Addr).CodeHash),
+		"new FunTokens should already use ERC20MinterWithMetadataUpdates",
+	)
+
+	s.NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		creator,
+		sdk.NewCoins(sdk.NewInt64Coin(funtoken.BankDenom, 420)),
+	))
+	for idx, holderAddr := range holders {
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(deps.GoCtx(),
+			&evm.MsgConvertCoinToEvm{
+				ToEthAddr: eth.EIP55Addr{Address: holderAddr},
+				Sender:    creator.String(),
+				BankCoin:  sdk.NewCoin(funtoken.BankDenom, sdkmath.NewInt(20*int64(idx+1))),
+			},
+		)
+		s.Require().NoError(err)
+	}
+
+	stateDB := deps.NewStateDB()
+	stateDB.SetCode(erc20Addr, embeds.SmartContract_ERC20Minter.DeployedBytecode)
+	s.Require().NoError(stateDB.Commit())
+	s.Require().NotEqual(
+		newCodeHash, gethcommon.BytesToHash(deps.EvmKeeper.GetAccount(deps.Ctx, erc20Addr).CodeHash),
+	)
+
+	s.Run("Perform upgrade", func() {
+		deps.EvmKeeper.Bank.StateDB = nil // IMPORTANT: make sure to clear the StateDB before running the upgrade
+		s.Require().True(deps.App.UpgradeKeeper.HasHandler(v2_6_0.Upgrade.UpgradeName))
+		s.Require().NoError(v2_6_0.UpgradeFunTokenContracts(&deps.App.PublicKeepers, deps.Ctx))
+		s.Require().Equal(
+			newCodeHash, gethcommon.BytesToHash(deps.EvmKeeper.GetAccount(deps.Ctx, erc20Addr).CodeHash),
+		)
+	})
+
+	s.Run("Holder balances and total supply are unharmed", func() {
+		evmObj, _ := deps.NewEVMLessVerboseLogger()
+		for idx, holderAddr := range holders {
+			balErc20, err := deps.EvmKeeper.ERC20().BalanceOf(erc20Addr, holderAddr, deps.Ctx, evmObj)
+			s.Require().NoError(err)
+			s.Require().Equal(strconv.Itoa(20*(idx+1)), balErc20.String())
+		}
+		totalSupply, err := deps.EvmKeeper.ERC20().TotalSupply(erc20Addr, deps.Ctx, evmObj)
+		s.Require().NoError(err)
+		s.Require().Equal(big.NewInt(120), totalSupply)
+	})
+
+	s.Run("Owner should be the EVM module", func() {
+		input, err := newCompiledContract.ABI.Pack("owner")
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVMLessVerboseLogger()
+		evmResp, err := deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &erc20Addr, false /*commit*/, input, evmkeeper.Erc20GasLimitQuery,
+		)
+		s.Require().NoError(err)
+		ownerVal := new(struct{ Value gethcommon.Address })
+		s.Require().NoError(newCompiledContract.ABI.UnpackIntoInterface(ownerVal, "owner", evmResp.Ret))
+		s.Require().Equal(evm.EVM_MODULE_ADDRESS.Hex(), ownerVal.Value.Hex())
+	})
+
+	s.Run("Bank metadata updates propagate to the ERC20", func() {
+		bankMetadata := tfDenom.DefaultBankMetadata()
+		bankMetadata.Name = "Fun Token"
+		bankMetadata.Symbol = "FUN"
+		s.Require().NoError(deps.EvmKeeper.UpdateFunTokenMetadata(deps.Ctx, bankMetadata))
+
+		evmObj, _ := deps.NewEVMLessVerboseLogger()
+		gotName, err := deps.EvmKeeper.ERC20().LoadERC20Name(deps.Ctx, evmObj, newCompiledContract.ABI, erc20Addr)
+		s.Require().NoError(err)
+		gotSymbol, err := deps.EvmKeeper.ERC20().LoadERC20Symbol(deps.Ctx, evmObj, newCompiledContract.ABI, erc20Addr)
+		s.Require().NoError(err)
+		s.Equal("Fun Token", gotName)
+		s.Equal("FUN", gotSymbol)
+	})
+
+	s.Run("Running the upgrade again is a no-op", func() {
+		nonce := deps.EvmKeeper.GetAccNonce(deps.Ctx, evm.EVM_MODULE_ADDRESS)
+		s.Require().NoError(v2_6_0.UpgradeFunTokenContracts(&deps.App.PublicKeepers, deps.Ctx))
+		s.Require().Equal(nonce, deps.EvmKeeper.GetAccNonce(deps.Ctx, evm.EVM_MODULE_ADDRESS))
+	})
+}
+
+func (s *Suite) TestReconcileFunTokenEscrows() {
+	deps := evmtest.NewTestDeps()
+
+	s.T().Log("Create two FunTokens from ERC20s with 100 tokens in escrow each")
+	createFunToken := func() (erc20Addr gethcommon.Address) {
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+			deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+		))
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20MinterWithMetadataUpdates,
+			"erc20name", "TOKEN", uint8(18),
+		)
+		s.Require().NoError(err)
+		erc20Addr = deployResp.ContractAddr
+		_, err = deps.EvmKeeper.CreateFunToken(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgCreateFunToken{
+				FromErc20: &eth.EIP55Addr{Address: erc20Addr},
+				Sender:    deps.Sender.NibiruAddr.String(),
+			},
+		)
+		s.Require().NoError(err)
+
+		callERC20(s, deps, erc20Addr, "mint", deps.Sender.EthAddr, big.NewInt(100))
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err = deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: eth.EIP55Addr{Address: erc20Addr},
+				Amount:    sdkmath.NewInt(100),
+				ToAddr:    deps.Sender.NibiruAddr.String(),
+			},
+		)
+		s.Require().NoError(err)
+		return erc20Addr
+	}
+	healthyErc20 := createFunToken()
+	driftedErc20 := createFunToken()
+
+	s.T().Log("The escrow of one of them shrinks on its own")
+	callERC20(s, deps, driftedErc20, "burnFromAuthority", evm.EVM_MODULE_ADDRESS, big.NewInt(10))
+
+	getFunToken := func(erc20Addr gethcommon.Address) evm.FunToken {
+		funtokens := deps.EvmKeeper.FunTokens.Collect(
+			deps.Ctx, deps.EvmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(deps.Ctx, erc20Addr),
+		)
+		s.Require().Len(funtokens, 1)
+		return funtokens[0]
+	}
+	s.Require().False(getFunToken(driftedErc20).IsRebasing)
+
+	v2_6_0.ReconcileFunTokenEscrows(&deps.App.PublicKeepers, deps.Ctx)
+	s.False(getFunToken(healthyErc20).IsRebasing, "escrow without drift is unchanged")
+	s.True(getFunToken(driftedErc20).IsRebasing, "drifted escrow is flagged as rebasing")
+
+	var events []*evm.EventFunTokenEscrowReconciled
+	for _, abciEvent := range deps.Ctx.EventManager().Events() {
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(abciEvent))
+		if err != nil {
+			continue
+		}
+		if e, ok := typedEvent.(*evm.EventFunTokenEscrowReconciled); ok {
+			events = append(events, e)
+		}
+	}
+	s.Require().Len(events, 1)
+	s.Equal(driftedErc20, events[0].FunToken.Erc20Addr.Address)
+	s.Equal("90", events[0].Escrow.EscrowBalance.String())
+	s.Equal("100", events[0].Escrow.BankSupply.String())
+	s.True(events[0].Escrow.InvariantHolds)
+
+	s.Run("Drifted mappings convert again", func() {
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertCoinToEvm{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				BankCoin:  sdk.NewInt64Coin(getFunToken(driftedErc20).BankDenom, 50),
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			},
+		)
+		s.Require().NoError(err)
+	})
+}
+
+func callERC20(
+	s *Suite, deps evmtest.TestDeps, erc20Addr gethcommon.Address, method string, args ...any,
+) {
+	input, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack(method, args...)
+	s.Require().NoError(err)
+	deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	evmObj, _ := deps.NewEVM()
+	_, err = deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &erc20Addr, true /*commit*/, input, evmkeeper.Erc20GasLimitExecute,
+	)
+	s.Require().NoError(err)
+}
+
+type Suite struct {
+	suite.Suite
+}
+
+func TestV2_6_0(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
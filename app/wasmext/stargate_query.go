@@ -107,8 +107,9 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		"/nibiru.tokenfactory.v1.Query/DenomInfo": new(tokenfactory.QueryDenomInfoResponse),
 
 		// nibiru epochs
-		"/nibiru.epochs.v1.Query/EpochInfos":   new(epochs.QueryEpochInfosResponse),
-		"/nibiru.epochs.v1.Query/CurrentEpoch": new(epochs.QueryCurrentEpochResponse),
+		"/nibiru.epochs.v1.Query/EpochInfos":       new(epochs.QueryEpochInfosResponse),
+		"/nibiru.epochs.v1.Query/CurrentEpoch":     new(epochs.QueryCurrentEpochResponse),
+		"/nibiru.epochs.v1.Query/EpochSubscribers": new(epochs.QueryEpochSubscribersResponse),
 
 		// nibiru inflation
 		"/nibiru.inflation.v1.Query/Period":             new(inflation.QueryPeriodResponse),
@@ -117,6 +118,7 @@ func WasmAcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
 		"/nibiru.inflation.v1.Query/CirculatingSupply":  new(inflation.QueryCirculatingSupplyResponse),
 		"/nibiru.inflation.v1.Query/InflationRate":      new(inflation.QueryInflationRateResponse),
 		"/nibiru.inflation.v1.Query/Params":             new(inflation.QueryParamsResponse),
+		"/nibiru.inflation.v1.Query/InflationSchedule":  new(inflation.QueryInflationScheduleResponse),
 
 		// nibiru oracle
 		"/nibiru.oracle.v1.Query/ExchangeRate":      new(oracle.QueryExchangeRateResponse),
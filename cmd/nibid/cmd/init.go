@@ -166,6 +166,7 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 			customCfg := appconst.NewDefaultTendermintConfig()
 			config.Consensus = customCfg.Consensus
 			config.DBBackend = customCfg.DBBackend
+			config.Mempool.Version = customCfg.Mempool.Version
 			cmtcfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
 			return displayInfo(toPrint)
 		},
@@ -3,6 +3,7 @@ package eth
 
 import (
 	"bytes"
+	"fmt"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +21,22 @@ func NibiruAddrToEthAddr(nibiruAddr sdk.AccAddress) gethcommon.Address {
 	return gethcommon.BytesToAddress(nibiruAddr.Bytes())
 }
 
+// ParseBech32OrHexAddr parses a user-specified address "addr" that might be
+// bech32 or hex.
+func ParseBech32OrHexAddr(addr string) (gethcommon.Address, error) {
+	// check if bech32 or hex
+	if err := ValidateAddress(addr); err == nil {
+		// hex address
+		return gethcommon.HexToAddress(addr), nil
+	}
+	// else try bech32
+	nibiAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("invalid bech32 or hex address: %w", err)
+	}
+	return NibiruAddrToEthAddr(nibiAddr), nil
+}
+
 var (
 	_ authtypes.AccountI                 = (*EthAccount)(nil)
 	_ EthAccountI                        = (*EthAccount)(nil)
@@ -11,10 +11,24 @@ import (
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	IndexBlock(*cmttypes.Block, []*abci.ResponseDeliverTx) error
 
 	// GetByTxHash returns nil if tx not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetByAddress returns the hashes of the txs sent from or to the address
+	// in the blocks before (or after) the block number, nearest block first,
+	// and whether more txs remain.
+	GetByAddress(addr common.Address, blockNumber int64, before bool, limit int) ([]common.Hash, bool, error)
+	// GetBySenderAndNonce returns nil if tx not found.
+	GetBySenderAndNonce(common.Address, uint64) (*common.Hash, error)
+	// GetContractCreationTx returns nil if tx not found.
+	GetContractCreationTx(common.Address) (*common.Hash, error)
+	// AddressIndexedFrom returns the block from which the lookups by address
+	// are complete. Blocks indexed before it don't have address indexes.
+	AddressIndexedFrom() (int64, error)
 }
@@ -14,6 +14,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
@@ -21,8 +23,12 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash          = 1
+	KeyPrefixTxIndex         = 2
+	KeyPrefixAddressTx       = 3
+	KeyPrefixSenderNonce     = 4
+	KeyPrefixContractCreator = 5
+	KeyPrefixAddressIndexed  = 6
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
@@ -53,6 +59,24 @@ func (indexer *EVMTxIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci
 	batch := indexer.db.NewBatch()
 	defer batch.Close()
 
+	// The address indexes are complete from the first new block indexed with
+	// them. Blocks indexed before by older versions don't have them.
+	addressIndexedFrom, err := indexer.db.Get(AddressIndexedFromKey())
+	if err != nil {
+		return sdkioerrors.Wrapf(err, "IndexBlock %d", height)
+	}
+	if len(addressIndexedFrom) == 0 {
+		lastBlock, err := indexer.LastIndexedBlock()
+		if err != nil {
+			return sdkioerrors.Wrapf(err, "IndexBlock %d", height)
+		}
+		if height > lastBlock {
+			if err := batch.Set(AddressIndexedFromKey(), sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+				return sdkioerrors.Wrapf(err, "IndexBlock %d, set address-indexed-from key", height)
+			}
+		}
+	}
+
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
 	for txIndex, tx := range block.Txs {
@@ -124,6 +148,9 @@ func (indexer *EVMTxIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci
 			if err := saveTxResult(indexer.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return sdkioerrors.Wrapf(err, "IndexBlock %d", height)
 			}
+			if err := indexer.saveAddressIndexes(batch, ethMsg, txHash, &txResult); err != nil {
+				return sdkioerrors.Wrapf(err, "IndexBlock %d", height)
+			}
 		}
 	}
 	if err := batch.Write(); err != nil {
@@ -142,6 +169,32 @@ func (indexer *EVMTxIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(indexer.db)
 }
 
+// AddressIndexedFrom returns the block from which the address indexes are
+// complete. Blocks indexed before it by older versions of the indexer are
+// missing from [EVMTxIndexer.GetByAddress], [EVMTxIndexer.GetBySenderAndNonce]
+// and [EVMTxIndexer.GetContractCreationTx] until they are indexed again.
+// Before any block is indexed with the address indexes, it is the block after
+// the last indexed one.
+func (indexer *EVMTxIndexer) AddressIndexedFrom() (int64, error) {
+	bz, err := indexer.db.Get(AddressIndexedFromKey())
+	if err != nil {
+		return 0, sdkioerrors.Wrap(err, "AddressIndexedFrom")
+	}
+	if len(bz) == 0 {
+		lastBlock, err := indexer.LastIndexedBlock()
+		return lastBlock + 1, err
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// SetAddressIndexedFrom records that the address indexes are complete from the
+// block. It is called after the blocks before [EVMTxIndexer.AddressIndexedFrom]
+// are indexed again.
+func (indexer *EVMTxIndexer) SetAddressIndexedFrom(blockNumber int64) error {
+	err := indexer.db.SetSync(AddressIndexedFromKey(), sdk.Uint64ToBigEndian(uint64(blockNumber)))
+	return sdkioerrors.Wrap(err, "SetAddressIndexedFrom")
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (indexer *EVMTxIndexer) GetByTxHash(hash common.Hash) (*eth.TxResult, error) {
 	bz, err := indexer.db.Get(TxHashKey(hash))
@@ -170,6 +223,68 @@ func (indexer *EVMTxIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32
 	return indexer.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetByAddress returns the hashes of the txs sent from or to the address,
+// including the tx that created it if it is a contract, in the blocks before
+// (if "before") or after the block number, which is excluded. The txs are
+// ordered from the nearest to the farthest block. At least "limit" txs are
+// returned if there are enough of them, completing the txs of the last block,
+// along with whether more txs remain.
+func (indexer *EVMTxIndexer) GetByAddress(
+	addr common.Address, blockNumber int64, before bool, limit int,
+) (hashes []common.Hash, hasMore bool, err error) {
+	prefix := append([]byte{KeyPrefixAddressTx}, addr.Bytes()...)
+	var it dbm.Iterator
+	if before {
+		it, err = indexer.db.ReverseIterator(prefix, AddressTxKey(addr, blockNumber, 0))
+	} else {
+		it, err = indexer.db.Iterator(AddressTxKey(addr, blockNumber+1, 0), sdk.PrefixEndBytes(prefix))
+	}
+	if err != nil {
+		return nil, false, sdkioerrors.Wrapf(err, "GetByAddress %s", addr.Hex())
+	}
+	defer it.Close()
+
+	var lastHeight int64
+	for ; it.Valid(); it.Next() {
+		height := int64(sdk.BigEndianToUint64(it.Key()[len(prefix) : len(prefix)+8]))
+		if len(hashes) >= limit && height != lastHeight {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastHeight = height
+	}
+	return hashes, false, it.Error()
+}
+
+// GetBySenderAndNonce returns the hash of the tx sent with the nonce by the
+// sender, or nil if tx not found.
+func (indexer *EVMTxIndexer) GetBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	bz, err := indexer.db.Get(SenderNonceKey(sender, nonce))
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(err, "GetBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
+// GetContractCreationTx returns the hash of the tx that deployed the contract,
+// or nil if tx not found. Only the contracts deployed by a contract creation
+// tx are indexed, not the ones created by other contracts.
+func (indexer *EVMTxIndexer) GetContractCreationTx(contract common.Address) (*common.Hash, error) {
+	bz, err := indexer.db.Get(ContractCreatorKey(contract))
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(err, "GetContractCreationTx %s", contract.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -182,6 +297,29 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func AddressTxKey(addr common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	key := append([]byte{KeyPrefixAddressTx}, addr.Bytes()...)
+	return append(append(key, bz1...), bz2...)
+}
+
+// SenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func SenderNonceKey(sender common.Address, nonce uint64) []byte {
+	return append(append([]byte{KeyPrefixSenderNonce}, sender.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// ContractCreatorKey returns the key for db entry: `contract address -> tx hash`
+func ContractCreatorKey(contract common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreator}, contract.Bytes()...)
+}
+
+// AddressIndexedFromKey returns the key for db entry: `-> first block with complete address indexes`
+func AddressIndexedFromKey() []byte {
+	return []byte{KeyPrefixAddressIndexed}
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -243,6 +381,43 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveAddressIndexes indexes the tx by its sender and recipient, by its sender
+// and nonce, and by the contract it deployed, if any, into the kv db batch.
+func (indexer *EVMTxIndexer) saveAddressIndexes(
+	batch dbm.Batch, ethMsg *evm.MsgEthereumTx, txHash common.Hash, txResult *eth.TxResult,
+) error {
+	ethTx := ethMsg.AsTransaction()
+	if ethTx == nil {
+		indexer.logger.Error("Fail to unpack tx data", "hash", txHash.Hex())
+		return nil
+	}
+	sender, err := gethcore.LatestSignerForChainID(ethTx.ChainId()).Sender(ethTx)
+	if err != nil {
+		indexer.logger.Error("Fail to recover tx sender", "err", err, "hash", txHash.Hex())
+		return nil
+	}
+
+	recipient := ethTx.To()
+	if recipient == nil {
+		contract := crypto.CreateAddress(sender, ethTx.Nonce())
+		recipient = &contract
+		if !txResult.Failed {
+			if err := batch.Set(ContractCreatorKey(contract), txHash.Bytes()); err != nil {
+				return sdkioerrors.Wrap(err, "set contract-creator key")
+			}
+		}
+	}
+	for _, addr := range []common.Address{sender, *recipient} {
+		if err := batch.Set(AddressTxKey(addr, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return sdkioerrors.Wrap(err, "set address-tx key")
+		}
+	}
+	if err := batch.Set(SenderNonceKey(sender, ethTx.Nonce()), txHash.Bytes()); err != nil {
+		return sdkioerrors.Wrap(err, "set sender-nonce key")
+	}
+	return nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/v2/app"
@@ -200,3 +201,161 @@ func TestEVMTxIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestEVMTxIndexer_AddressIndexes(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := evmtest.NewSigner(priv)
+	ethSigner := gethcore.LatestSignerForChainID(nil)
+
+	encCfg := app.MakeEncodingConfig()
+	eth.RegisterInterfaces(encCfg.InterfaceRegistry)
+	evm.RegisterInterfaces(encCfg.InterfaceRegistry)
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec)
+
+	// buildTx returns a signed eth tx sent by "from", along with its hash
+	buildTx := func(nonce uint64, to *common.Address) (cmttypes.Tx, common.Hash) {
+		tx := evm.NewTx(&evm.EvmTxArgs{
+			Nonce:    nonce,
+			To:       to,
+			Amount:   big.NewInt(1000),
+			GasLimit: 100_000,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+		sdkTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), eth.EthBaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(sdkTx)
+		require.NoError(t, err)
+		return txBz, txHash
+	}
+	deliverTx := func(txHash common.Hash) *abci.ResponseDeliverTx {
+		return &abci.ResponseDeliverTx{
+			Events: []abci.Event{{
+				Type: evm.PendingEthereumTxEvent,
+				Attributes: []abci.EventAttribute{
+					{Key: evm.PendingEthereumTxEventAttrEthHash, Value: txHash.Hex()},
+					{Key: evm.PendingEthereumTxEventAttrIndex, Value: "0"},
+				},
+			}},
+		}
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewEVMTxIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	// Block 1: "from" deploys a contract. Block 2: "from" sends to "alice".
+	// Block 3: "from" sends to "bob".
+	alice := common.BigToAddress(big.NewInt(1))
+	bob := common.BigToAddress(big.NewInt(2))
+	contract := crypto.CreateAddress(from, 0)
+	var txHashes []common.Hash
+	for i, to := range []*common.Address{nil, &alice, &bob} {
+		txBz, txHash := buildTx(uint64(i), to)
+		txHashes = append(txHashes, txHash)
+		block := &cmttypes.Block{
+			Header: cmttypes.Header{Height: int64(i + 1)},
+			Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+		}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{deliverTx(txHash)}))
+	}
+
+	for _, tc := range []struct {
+		name        string
+		addr        common.Address
+		blockNumber int64
+		before      bool
+		limit       int
+		wantHashes  []common.Hash
+		wantHasMore bool
+	}{
+		{
+			name: "sender, before latest", addr: from, blockNumber: 4, before: true, limit: 10,
+			wantHashes: []common.Hash{txHashes[2], txHashes[1], txHashes[0]},
+		},
+		{
+			name: "sender, paginated", addr: from, blockNumber: 3, before: true, limit: 1,
+			wantHashes: []common.Hash{txHashes[1]}, wantHasMore: true,
+		},
+		{
+			name: "sender, after block", addr: from, blockNumber: 1, before: false, limit: 10,
+			wantHashes: []common.Hash{txHashes[1], txHashes[2]},
+		},
+		{
+			name: "recipient", addr: alice, blockNumber: 0, before: false, limit: 10,
+			wantHashes: []common.Hash{txHashes[1]},
+		},
+		{
+			name: "created contract", addr: contract, blockNumber: 4, before: true, limit: 10,
+			wantHashes: []common.Hash{txHashes[0]},
+		},
+		{
+			name: "no txs", addr: bob, blockNumber: 3, before: true, limit: 10,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, hasMore, err := idxer.GetByAddress(tc.addr, tc.blockNumber, tc.before, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantHashes, hashes)
+			require.Equal(t, tc.wantHasMore, hasMore)
+		})
+	}
+
+	hash, err := idxer.GetBySenderAndNonce(from, 1)
+	require.NoError(t, err)
+	require.Equal(t, txHashes[1], *hash)
+	hash, err = idxer.GetBySenderAndNonce(from, 3)
+	require.NoError(t, err)
+	require.Nil(t, hash)
+
+	hash, err = idxer.GetContractCreationTx(contract)
+	require.NoError(t, err)
+	require.Equal(t, txHashes[0], *hash)
+	hash, err = idxer.GetContractCreationTx(alice)
+	require.NoError(t, err)
+	require.Nil(t, hash)
+
+	addressIndexedFrom, err := idxer.AddressIndexedFrom()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), addressIndexedFrom)
+
+	t.Log("Blocks indexed by older versions have no address indexes")
+	db = dbm.NewMemDB()
+	idxer = indexer.NewEVMTxIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	txBz, txHash := buildTx(0, &alice)
+	block := &cmttypes.Block{
+		Header: cmttypes.Header{Height: 1},
+		Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+	}
+	require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{deliverTx(txHash)}))
+	require.NoError(t, db.Delete(indexer.AddressIndexedFromKey()))
+	addressIndexedFrom, err = idxer.AddressIndexedFrom()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), addressIndexedFrom)
+
+	t.Log("Indexing the older blocks again doesn't move the start of the address indexes")
+	require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{deliverTx(txHash)}))
+	addressIndexedFrom, err = idxer.AddressIndexedFrom()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), addressIndexedFrom)
+
+	t.Log("New blocks are indexed with the address indexes")
+	txBz, txHash = buildTx(1, &bob)
+	block = &cmttypes.Block{
+		Header: cmttypes.Header{Height: 2},
+		Data:   cmttypes.Data{Txs: []cmttypes.Tx{txBz}},
+	}
+	require.NoError(t, idxer.IndexBlock(block, []*abci.ResponseDeliverTx{deliverTx(txHash)}))
+	addressIndexedFrom, err = idxer.AddressIndexedFrom()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), addressIndexedFrom)
+
+	require.NoError(t, idxer.SetAddressIndexedFrom(1))
+	addressIndexedFrom, err = idxer.AddressIndexedFrom()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), addressIndexedFrom)
+}
@@ -2,9 +2,9 @@
 package rpcapi
 
 import (
+	"context"
 	"fmt"
 	"math"
-	"math/big"
 
 	sdkioerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -40,12 +40,23 @@ func (b *Backend) GetCode(
 	return res.Code, nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof.
+//
+// The storage hash is the root of the Ethereum storage trie of the account,
+// built by the node from its contract storage. See [evm.StorageTrie]. It is
+// not committed by consensus, so Merkle proofs of storage slots against it
+// can't be verified against a block header, and storage proofs are not
+// supported. The account proof is the IAVL proof of the account against the
+// app hash, which is the state root of the block.
 func (b *Backend) GetProof(
 	address gethcommon.Address,
 	storageKeys []string,
 	blockNrOrHash rpc.BlockNumberOrHash,
 ) (*rpc.AccountResult, error) {
+	if len(storageKeys) > 0 {
+		return nil, ErrStorageProofsNotSupported
+	}
+
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
 		return nil, err
@@ -58,7 +69,6 @@ func (b *Backend) GetProof(
 		// the error message imitates geth behavior
 		return nil, pkgerrors.New("header not found")
 	}
-	ctx := rpc.NewContextWithHeight(height)
 
 	// if the height is equal to zero, meaning the query condition of the block
 	// is either "pending" or "latest"
@@ -75,27 +85,12 @@ func (b *Backend) GetProof(
 		height = int64(bn) //#nosec G701 -- checked for int overflow already
 	}
 
+	ctx := rpc.NewContextWithHeight(height)
 	clientCtx := b.clientCtx.WithHeight(height)
 
-	// query storage proofs
-	storageProofs := make([]rpc.StorageResult, len(storageKeys))
-
-	for i, key := range storageKeys {
-		hexKey := gethcommon.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(
-			clientCtx,
-			evm.StoreKey,
-			evm.StateKey(address, hexKey.Bytes()),
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		storageProofs[i] = rpc.StorageResult{
-			Key:   key,
-			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
-		}
+	storageHash, err := b.storageRoot(ctx, address, height)
+	if err != nil {
+		return nil, err
 	}
 
 	// query EVM account
@@ -126,14 +121,45 @@ func (b *Backend) GetProof(
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     gethcommon.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
-		// NOTE: The StorageHash is blank. Consider whether this is useful in the
-		// future. Currently, all storage is handled by persistent and transient
-		// `sdk.KVStore` objects.
-		StorageHash:  gethcommon.Hash{},
-		StorageProof: storageProofs,
+		StorageHash:  storageHash,
+		StorageProof: []rpc.StorageResult{},
 	}, nil
 }
 
+// ErrStorageProofsNotSupported is returned by "eth_getProof" when storage keys
+// are given. See [Backend.GetProof].
+var ErrStorageProofsNotSupported = pkgerrors.New(
+	"storage proofs are not supported: the storage hash of an account is not committed by consensus",
+)
+
+// storageRoot returns the root of the Ethereum storage trie of the account at
+// the block height. The trie is built from all of the storage slots of the
+// account, so the roots are cached by address and height, whose state doesn't
+// change.
+func (b *Backend) storageRoot(
+	ctx context.Context, address gethcommon.Address, height int64,
+) (gethcommon.Hash, error) {
+	key := storageRootKey{address: address, height: height}
+	if root, ok := b.storageRootCache.Get(key); ok {
+		return root, nil
+	}
+	res, err := b.queryClient.StorageProof(ctx, &evm.QueryStorageProofRequest{
+		Address: address.String(),
+	})
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	root := gethcommon.HexToHash(res.StorageRoot)
+	b.storageRootCache.Add(key, root)
+	return root, nil
+}
+
+// storageRootKey identifies the storage root of an account at a block height.
+type storageRootKey struct {
+	address gethcommon.Address
+	height  int64
+}
+
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *Backend) GetStorageAt(address gethcommon.Address, key string, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
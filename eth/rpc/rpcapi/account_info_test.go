@@ -4,11 +4,13 @@ import (
 	"math/big"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"golang.org/x/crypto/sha3"
 
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 
 	rpc "github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
 )
 
 func (s *BackendSuite) TestGetCode() {
@@ -50,45 +52,55 @@ func (s *BackendSuite) TestGetCode() {
 }
 
 func (s *BackendSuite) TestGetProof() {
+	blockNumber := *s.SuccessfulTxDeployContract().BlockNumberRpc
 	testCases := []struct {
-		name         string
-		contractAddr gethcommon.Address
-		blockNumber  rpc.BlockNumber
-		address      gethcommon.Address
-		slot         uint64
-		wantValue    string
+		name             string
+		address          gethcommon.Address
+		wantEmptyStorage bool
 	}{
 		{
-			name:         "happy: balance of the contract deployer",
-			contractAddr: testContractAddress,
-			address:      s.fundedAccEthAddr,
-			blockNumber:  *s.SuccessfulTxDeployContract().BlockNumberRpc,
-			slot:         0,                        // _balances is the first slot in ERC20
-			wantValue:    "0xd3c21bcecceda1000000", // = 1000000 * (10**18), initial supply
+			name:             "happy: contract with storage",
+			address:          testContractAddress,
+			wantEmptyStorage: false,
 		},
 		{
-			name:         "sad: address which is not in contract storage",
-			contractAddr: s.fundedAccEthAddr,
-			address:      recipient,
-			blockNumber:  *s.SuccessfulTxDeployContract().BlockNumberRpc,
-			slot:         0,
-			wantValue:    "0x0",
+			name:             "happy: account without storage",
+			address:          s.fundedAccEthAddr,
+			wantEmptyStorage: true,
 		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			proof, err := s.backend.GetProof(
-				tc.contractAddr,
-				[]string{generateStorageKey(tc.address, tc.slot)},
-				rpc.BlockNumberOrHash{
-					BlockNumber: &tc.blockNumber,
-				},
+				tc.address,
+				nil,
+				rpc.BlockNumberOrHash{BlockNumber: &blockNumber},
 			)
 			s.Require().NoError(err)
 			s.Require().NotNil(proof)
-			s.Require().Equal(tc.wantValue, proof.StorageProof[0].Value.String())
+			s.Require().NotEmpty(proof.AccountProof)
+			s.Require().Empty(proof.StorageProof)
+			s.Require().Equal(tc.wantEmptyStorage, proof.StorageHash == gethcore.EmptyRootHash)
+
+			// The storage hash is served from the cache the second time.
+			cachedProof, err := s.backend.GetProof(
+				tc.address,
+				nil,
+				rpc.BlockNumberOrHash{BlockNumber: &blockNumber},
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(proof.StorageHash, cachedProof.StorageHash)
 		})
 	}
+
+	s.Run("sad: storage proofs are not supported", func() {
+		_, err := s.backend.GetProof(
+			testContractAddress,
+			[]string{generateStorageKey(s.fundedAccEthAddr, 0)},
+			rpc.BlockNumberOrHash{BlockNumber: &blockNumber},
+		)
+		s.Require().ErrorIs(err, rpcapi.ErrStorageProofsNotSupported)
+	})
 }
 
 func (s *BackendSuite) TestGetStorageAt() {
@@ -401,30 +401,40 @@ func (a *DebugAPI) GetRawTransaction(
 	return nil, ErrNotImplemented(fnName)
 }
 
-// StandardTraceBadBlockToFile dumps the structured logs created during the
-// execution of EVM against a block pulled from the pool of bad ones to the
-// local file system and returns a list of files to the caller.
+// StandardTraceBadBlockToFile is not supported. In geth, it dumps the
+// structured logs of a block pulled from the pool of bad blocks to files.
+// Blocks are final on Nibiru once committed, so there is no pool of bad
+// blocks (see "debug_getBadBlocks") to trace.
 func (a *DebugAPI) StandardTraceBadBlockToFile(
 	ctx context.Context,
 	hash common.Hash,
 	config *tracers.StdTraceConfig,
 ) ([]string, error) {
 	fnName := "debug_standardTraceBadBlockToFile"
-	a.logger.Debug(fnName)
+	a.logger.Debug(fnName, "hash", hash)
 	return nil, ErrNotImplemented(fnName)
 }
 
 // StandardTraceBlockToFile dumps the structured logs created during the
 // execution of EVM to the local file system and returns a list of files
-// to the caller.
+// to the caller. It requires the "json-rpc.enable-trace-to-file" config
+// option of the node.
 func (a *DebugAPI) StandardTraceBlockToFile(
 	ctx context.Context,
 	hash common.Hash,
 	config *tracers.StdTraceConfig,
 ) ([]string, error) {
-	fnName := "debug_standardTraceBlockToFile"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	a.logger.Debug("debug_standardTraceBlockToFile", "hash", hash)
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+	return a.backend.StandardTraceBlockToFile(resBlock, config)
 }
 
 // TraceBadBlock returns the structured logs created during the execution of
@@ -441,27 +451,29 @@ func (a *DebugAPI) TraceBadBlock(
 }
 
 // TraceBlock returns the structured logs created during the execution of EVM
-// and returns them as a JSON object.
+// and returns them as a JSON object. The block is given as RLP, like the
+// output of "debug_getBlockRlp", and it's executed on top of the state of its
+// parent block, so it doesn't need to be part of the chain.
 func (a *DebugAPI) TraceBlock(
 	ctx context.Context,
 	blob hexutil.Bytes,
-	config *tracers.TraceConfig,
-) ([]json.RawMessage, error) {
-	fnName := "debug_traceBlock"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBlock", "size", len(blob))
+	return a.backend.TraceRawBlock(blob, config)
 }
 
 // TraceBlockFromFile returns the structured logs created during the execution of
-// EVM and returns them as a JSON object.
+// EVM and returns them as a JSON object. The file holds the RLP of the block,
+// like for "debug_traceBlock", and it must be in the "json-rpc.trace-file-dir"
+// directory of the node. Disabled unless "json-rpc.enable-trace-to-file" is set.
 func (a *DebugAPI) TraceBlockFromFile(
 	ctx context.Context,
 	file string,
-	config *tracers.TraceConfig,
-) ([]json.RawMessage, error) {
-	fnName := "debug_traceBlockFromFile"
-	a.logger.Debug(fnName)
-	return nil, ErrNotImplemented(fnName)
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBlockFromFile", "file", file)
+	return a.backend.TraceBlockFromFile(file, config)
 }
 
 // TraceChain returns the structured logs created during the execution of EVM
@@ -198,6 +198,18 @@ func (e *EthAPI) GetTransactionReceipt(
 	return out, err
 }
 
+// GetBlockReceipts returns the receipts of all of the transactions in the block
+// identified by number or hash, in the order of the transactions in the block.
+func (e *EthAPI) GetBlockReceipts(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]*TransactionReceipt, error) {
+	methodName := "eth_getBlockReceipts"
+	e.logger.Debug(methodName, "blockNrOrHash", blockNrOrHash)
+	out, err := e.backend.GetBlockReceipts(blockNrOrHash)
+	logError(e.logger, err, methodName)
+	return out, err
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *EthAPI) GetBlockTransactionCountByHash(
 	blockHash common.Hash,
@@ -297,7 +309,8 @@ func (e *EthAPI) GetCode(
 	return e.backend.GetCode(address, blockNrOrHash)
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof. Storage proofs are not
+// supported, see [Backend.GetProof].
 func (e *EthAPI) GetProof(address common.Address,
 	storageKeys []string,
 	blockNrOrHash rpc.BlockNumberOrHash,
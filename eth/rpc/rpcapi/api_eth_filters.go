@@ -94,6 +94,17 @@ func (api *FiltersAPI) timeoutLoop() {
 	}
 }
 
+// numFilters returns the number of installed filters of each type.
+func (api *FiltersAPI) numFilters() map[filters.Type]int {
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+	numFilters := make(map[filters.Type]int)
+	for _, f := range api.filters {
+		numFilters[f.typ]++
+	}
+	return numFilters
+}
+
 // NewPendingTransactionFilter creates a filter that fetches pending transaction
 // hashes as transactions enter the pending state.
 //
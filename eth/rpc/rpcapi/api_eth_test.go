@@ -84,9 +84,11 @@ func (s *Suite) TestExpectedMethods() {
 		[]string{
 			rpcapi.NamespaceEth, // eth and filters services
 			rpcapi.NamespaceDebug,
+			rpcapi.NamespaceTrace,
+			rpcapi.NamespaceOts,
 		},
 	)
-	s.Require().Len(apis, 3)
+	s.Require().Len(apis, 5)
 	type TestCase struct {
 		ServiceName string
 		Methods     []string
@@ -106,6 +108,7 @@ func (s *Suite) TestExpectedMethods() {
 				"eth_getBalance",
 				"eth_getBlockByHash",
 				"eth_getBlockByNumber",
+				"eth_getBlockReceipts",
 				"eth_getCode",
 				"eth_getPendingTransactions",
 				"eth_getProof",
@@ -151,6 +154,33 @@ func (s *Suite) TestExpectedMethods() {
 				"debug_traceTransaction",
 			},
 		},
+		{
+			ServiceName: "rpcapi.TraceAPI",
+			// See https://openethereum.github.io/JSONRPC-trace-module
+			Methods: []string{
+				"trace_block",
+				"trace_filter",
+				"trace_replayBlockTransactions",
+				"trace_transaction",
+			},
+		},
+		{
+			ServiceName: "rpcapi.OtterscanAPI",
+			// See https://docs.otterscan.io/api-docs/ots-api
+			Methods: []string{
+				"ots_getBlockDetails",
+				"ots_getBlockDetailsByHash",
+				"ots_getBlockTransactions",
+				"ots_getContractCreator",
+				"ots_getInternalOperations",
+				"ots_getTransactionBySenderAndNonce",
+				"ots_getTransactionError",
+				"ots_hasCode",
+				"ots_searchTransactionsAfter",
+				"ots_searchTransactionsBefore",
+				"ots_traceTransaction",
+			},
+		},
 	}
 
 	for idx, api := range apis {
@@ -0,0 +1,117 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+)
+
+// OtterscanAPI is the "ots_" prefixed set of APIs used by the Otterscan block
+// explorer. The address-indexed lookups require the EVM tx indexer, and the
+// internal operations and traces are produced by the geth "callTracer".
+// Blocks indexed by versions of the node without the address indexes make
+// these lookups fail until they are indexed again with "evm-tx-index".
+type OtterscanAPI struct {
+	logger  log.Logger
+	backend *Backend
+}
+
+// NewImplOtterscanAPI creates an instance of the Otterscan API.
+func NewImplOtterscanAPI(logger log.Logger, backend *Backend) *OtterscanAPI {
+	return &OtterscanAPI{
+		logger:  logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the version of the Otterscan API implemented by the
+// node.
+func (api *OtterscanAPI) GetApiLevel() uint64 {
+	api.logger.Debug("ots_getApiLevel")
+	return OtsAPILevel
+}
+
+// HasCode returns whether the address has code at the block.
+func (api *OtterscanAPI) HasCode(addr common.Address, blockNrOrHash rpc.BlockNumberOrHash) (bool, error) {
+	api.logger.Debug("ots_hasCode", "address", addr, "block number or hash", blockNrOrHash)
+	return api.backend.OtsHasCode(addr, blockNrOrHash)
+}
+
+// GetBlockDetails returns the block without its txs, along with the number of
+// its txs and the fees paid by them.
+func (api *OtterscanAPI) GetBlockDetails(blockNum rpc.BlockNumber) (*OtsBlockDetails, error) {
+	api.logger.Debug("ots_getBlockDetails", "number", blockNum)
+	return api.backend.OtsBlockDetails(rpc.BlockNumberOrHash{BlockNumber: &blockNum})
+}
+
+// GetBlockDetailsByHash is [OtterscanAPI.GetBlockDetails] for the block with
+// the hash.
+func (api *OtterscanAPI) GetBlockDetailsByHash(hash common.Hash) (*OtsBlockDetails, error) {
+	api.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	return api.backend.OtsBlockDetails(rpc.BlockNumberOrHash{BlockHash: &hash})
+}
+
+// GetBlockTransactions returns a page of the txs of the block and their
+// receipts, counting pages from the last txs of the block.
+func (api *OtterscanAPI) GetBlockTransactions(
+	blockNum rpc.BlockNumber, pageNumber, pageSize uint8,
+) (*OtsBlockTransactions, error) {
+	api.logger.Debug("ots_getBlockTransactions", "number", blockNum, "pageNumber", pageNumber, "pageSize", pageSize)
+	return api.backend.OtsBlockTransactions(blockNum, pageNumber, pageSize)
+}
+
+// GetTransactionError returns the revert data of the tx.
+func (api *OtterscanAPI) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	api.logger.Debug("ots_getTransactionError", "hash", hash)
+	return api.backend.OtsTransactionError(hash)
+}
+
+// GetInternalOperations returns the value transfers, contract creations, and
+// self-destructs performed by the contracts called by the tx.
+func (api *OtterscanAPI) GetInternalOperations(hash common.Hash) ([]*OtsInternalOperation, error) {
+	api.logger.Debug("ots_getInternalOperations", "hash", hash)
+	return api.backend.OtsInternalOperations(hash)
+}
+
+// TraceTransaction returns the call frames of the tx, ordered depth-first.
+func (api *OtterscanAPI) TraceTransaction(hash common.Hash) ([]*OtsTraceEntry, error) {
+	api.logger.Debug("ots_traceTransaction", "hash", hash)
+	return api.backend.OtsTraceTransaction(hash)
+}
+
+// SearchTransactionsBefore returns a page of the txs of the address in the
+// blocks before the block number, or before the latest block if zero.
+func (api *OtterscanAPI) SearchTransactionsBefore(
+	addr common.Address, blockNum uint64, pageSize uint16,
+) (*OtsSearchResult, error) {
+	api.logger.Debug("ots_searchTransactionsBefore", "address", addr, "blockNum", blockNum, "pageSize", pageSize)
+	return api.backend.OtsSearchTransactions(addr, blockNum, pageSize, true)
+}
+
+// SearchTransactionsAfter returns a page of the txs of the address in the
+// blocks after the block number.
+func (api *OtterscanAPI) SearchTransactionsAfter(
+	addr common.Address, blockNum uint64, pageSize uint16,
+) (*OtsSearchResult, error) {
+	api.logger.Debug("ots_searchTransactionsAfter", "address", addr, "blockNum", blockNum, "pageSize", pageSize)
+	return api.backend.OtsSearchTransactions(addr, blockNum, pageSize, false)
+}
+
+// GetContractCreator returns the tx that deployed the contract and its
+// sender, or nil if the address is not a contract.
+func (api *OtterscanAPI) GetContractCreator(addr common.Address) (*OtsContractCreator, error) {
+	api.logger.Debug("ots_getContractCreator", "address", addr)
+	return api.backend.OtsContractCreator(addr)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the tx sent with the
+// nonce by the sender, or nil if it is not found.
+func (api *OtterscanAPI) GetTransactionBySenderAndNonce(
+	sender common.Address, nonce uint64,
+) (*common.Hash, error) {
+	api.logger.Debug("ots_getTransactionBySenderAndNonce", "sender", sender, "nonce", nonce)
+	return api.backend.OtsTransactionBySenderAndNonce(sender, nonce)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+)
+
+// TraceAPI is the "trace_" prefixed set of APIs of Parity (OpenEthereum),
+// which return flat call traces of the Ethereum txs, as used by block
+// explorers to index internal transactions. The traces are produced by the
+// geth "callTracer" of the "TraceBlock" and "TraceTx" queries.
+type TraceAPI struct {
+	logger  log.Logger
+	backend *Backend
+}
+
+// NewImplTraceAPI creates an instance of the Parity trace API.
+func NewImplTraceAPI(logger log.Logger, backend *Backend) *TraceAPI {
+	return &TraceAPI{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the traces of all of the txs in the block.
+func (api *TraceAPI) Block(blockNum rpc.BlockNumber) ([]*ParityTrace, error) {
+	api.logger.Debug("trace_block", "blockNumber", blockNum)
+	if blockNum == rpc.EthEarliestBlockNumber {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := api.backend.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		api.logger.Debug("get block failed", "height", blockNum, "error", err.Error())
+		return nil, err
+	}
+	return api.backend.ParityTraceBlock(resBlock)
+}
+
+// Transaction returns the traces of the tx with the given hash.
+func (api *TraceAPI) Transaction(hash common.Hash) ([]*ParityTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	return api.backend.ParityTraceTransaction(hash)
+}
+
+// Filter returns the traces of the txs in a range of blocks that match the
+// given addresses. The range can span at most "block-range-cap" blocks.
+func (api *TraceAPI) Filter(args TraceFilterArgs) ([]*ParityTrace, error) {
+	api.logger.Debug("trace_filter", "fromBlock", args.FromBlock, "toBlock", args.ToBlock)
+	return api.backend.ParityTraceFilter(args)
+}
+
+// ReplayBlockTransactions replays all of the txs in the block and returns,
+// for each tx, the results of the requested trace types: "trace" for the
+// traces, and "stateDiff" for the changes of the accounts modified by the tx.
+// The "vmTrace" type is not supported.
+func (api *TraceAPI) ReplayBlockTransactions(
+	blockNrOrHash rpc.BlockNumberOrHash, traceTypes []string,
+) ([]*ParityTraceResults, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "blockNrOrHash", blockNrOrHash, "traceTypes", traceTypes)
+	blockNum, err := api.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if blockNum == rpc.EthEarliestBlockNumber {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := api.backend.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		api.logger.Debug("get block failed", "height", blockNum, "error", err.Error())
+		return nil, err
+	}
+	return api.backend.ParityReplayBlock(resBlock, traceTypes)
+}
@@ -28,6 +28,8 @@ const (
 	NamespaceNet    = "net"
 	NamespaceTxPool = "txpool"
 	NamespaceDebug  = "debug"
+	NamespaceTrace  = "trace"
+	NamespaceOts    = "ots"
 
 	apiVersion = "1.0"
 )
@@ -114,6 +116,38 @@ func init() {
 				},
 			}
 		},
+		NamespaceTrace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: NamespaceTrace,
+					Version:   apiVersion,
+					Service:   NewImplTraceAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		NamespaceOts: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: NamespaceOts,
+					Version:   apiVersion,
+					Service:   NewImplOtterscanAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -8,6 +8,8 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
 
 	"github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -27,8 +29,19 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	evmTxIndexer        eth.EVMTxIndexer
+
+	// gasTipCache holds the result of [Backend.SuggestGasTipCap] for the
+	// latest block.
+	gasTipCache *gasTipCache
+	// storageRootCache holds the storage roots of accounts returned by
+	// [Backend.GetProof], by address and block height.
+	storageRootCache *lru.Cache[storageRootKey, gethcommon.Hash]
 }
 
+// storageRootCacheSize is the number of storage roots held by
+// [Backend.storageRootCache].
+const storageRootCacheSize = 1024
+
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
 func NewBackend(
 	ctx *server.Context,
@@ -52,6 +65,8 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		evmTxIndexer:        evmTxIndexer,
+		gasTipCache:         &gasTipCache{},
+		storageRootCache:    lru.NewCache[storageRootKey, gethcommon.Hash](storageRootCacheSize),
 	}
 }
 
@@ -4,6 +4,8 @@ package rpcapi
 import (
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -175,11 +177,73 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap Not yet supported. Returns 0 as the suggested tip cap.
-// After implementing tx prioritization, this function can come to life.
+// Parameters of the gas tip oracle of [Backend.SuggestGasTipCap], which has
+// the same defaults as the geth gas price oracle.
+const (
+	// gasTipOracleBlocks is the number of recent blocks sampled.
+	gasTipOracleBlocks = 20
+	// gasTipOracleSamples is the number of lowest tips sampled per block.
+	gasTipOracleSamples = 3
+	// gasTipOraclePercentile is the percentile of the sampled tips suggested.
+	gasTipOraclePercentile = 60
+)
+
+// gasTipCache is the suggested gas tip of the block at "height".
+type gasTipCache struct {
+	sync.Mutex
+	height int64
+	tip    *big.Int
+}
+
+// SuggestGasTipCap returns a suggestion for the tip of a tx in units of wei per
+// gas, based on the tips paid by the Ethereum txs of the recent blocks. Since
+// the mempool orders txs by tip, the txs that pay the suggested tip are
+// included ahead of most of the txs competing for the same blocks.
+//
+// Like the geth gas price oracle, it samples the lowest tips paid in each of
+// the last [gasTipOracleBlocks] blocks, and returns the
+// [gasTipOraclePercentile]-th percentile of the samples. Txs without a tip are
+// ignored. It returns 0 if no recent tx paid a tip. The tips are relative to
+// the base fee of their block, so "baseFee" is unused. The suggestion is
+// computed once per block.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
-	maxDelta := big.NewInt(0)
-	return maxDelta, nil
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	lastBlock := int64(latest) // #nosec G701 -- checked for int overflow already
+
+	b.gasTipCache.Lock()
+	defer b.gasTipCache.Unlock()
+	if b.gasTipCache.tip != nil && b.gasTipCache.height == lastBlock {
+		return new(big.Int).Set(b.gasTipCache.tip), nil
+	}
+
+	var samples []*big.Int
+	for height := lastBlock; height > 0 && height > lastBlock-gasTipOracleBlocks; height-- {
+		resBlock, err := b.TendermintBlockByNumber(rpc.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		var blockSamples int
+		for _, tip := range b.ethTxTipsFromBlock(resBlock) {
+			if tip.Sign() == 0 {
+				continue
+			}
+			samples = append(samples, tip)
+			if blockSamples++; blockSamples == gasTipOracleSamples {
+				break
+			}
+		}
+	}
+	tip := big.NewInt(0)
+	if len(samples) > 0 {
+		sort.Slice(samples, func(i, j int) bool { return samples[i].Cmp(samples[j]) < 0 })
+		tip.Set(samples[(len(samples)-1)*gasTipOraclePercentile/100])
+	}
+
+	b.gasTipCache.height, b.gasTipCache.tip = lastBlock, tip
+	return new(big.Int).Set(tip), nil
 }
 
 // GlobalMinGasPrice returns the minimum gas price for all nodes.
@@ -4,6 +4,8 @@ import (
 	"math/big"
 
 	gethmath "github.com/ethereum/go-ethereum/common/math"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
@@ -76,9 +78,38 @@ func (s *BackendSuite) TestFeeHistory() {
 }
 
 func (s *BackendSuite) TestSuggestGasTipCap() {
-	tipCap, err := s.backend.SuggestGasTipCap(big.NewInt(1))
+	// The txs of the suite pay no tip, since their gas price is below the base
+	// fee, so the suggestion comes from the tx that pays one.
+	tip := evm.NativeToWei(big.NewInt(2))
+	nonce := s.getCurrentNonce(s.fundedAccEthAddr)
+	txHash := SendTransaction(
+		s,
+		&gethcore.LegacyTx{
+			To:       &recipient,
+			Nonce:    uint64(nonce),
+			Value:    amountToSend,
+			Gas:      params.TxGas,
+			GasPrice: new(big.Int).Add(evm.BASE_FEE_WEI, tip),
+		},
+		true,
+	)
+	blockNumber, _, _, err := WaitForReceipt(s, txHash)
+	s.Require().NoError(err)
+
+	tipCap, err := s.backend.SuggestGasTipCap(evm.BASE_FEE_WEI)
+	s.Require().NoError(err)
+	s.Require().Equal(tip, tipCap)
+
+	// The suggestion is cached for the block, and callers can't modify it.
+	tipCap.SetInt64(0)
+	tipCap, err = s.backend.SuggestGasTipCap(evm.BASE_FEE_WEI)
+	s.Require().NoError(err)
+	s.Require().Equal(tip, tipCap)
+
+	// The fee history rewards are the tips paid in the block.
+	res, err := s.backend.FeeHistory(1, gethrpc.BlockNumber(blockNumber.Int64()), []float64{100})
 	s.Require().NoError(err)
-	s.Require().Equal(big.NewInt(0), tipCap)
+	s.Require().Equal(tip, res.Reward[0][0].ToInt())
 }
 
 func (s *BackendSuite) TestGlobalMinGasPrice() {
@@ -2,6 +2,7 @@
 package rpcapi
 
 import (
+	"fmt"
 	"math/big"
 
 	sdkioerrors "cosmossdk.io/errors"
@@ -12,6 +13,7 @@ import (
 	gogoproto "github.com/cosmos/gogoproto/proto"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
@@ -66,6 +68,89 @@ func includes(addresses []common.Address, a common.Address) bool {
 }
 
 // https://github.com/ethereum/go-ethereum/blob/v1.10.14/eth/filters/filter.go#L321
+// PendingTxsFilter is the optional second parameter of the
+// "newPendingTransactions" subscription. It is either a boolean, as in
+// go-ethereum, which is true to receive full transaction objects instead of
+// hashes, or an object with the fields:
+//   - fullTx: true to receive full transaction objects.
+//   - fromAddress: sender address, or array of addresses, to match.
+//   - toAddress: recipient address, or array of addresses, to match.
+//
+// A transaction matches if it matches each of the given address lists.
+type PendingTxsFilter struct {
+	FullTx        bool
+	FromAddresses []common.Address
+	ToAddresses   []common.Address
+}
+
+// ParsePendingTxsFilter parses the second parameter of the
+// "newPendingTransactions" subscription, decoded from JSON. A nil parameter
+// returns the filter that matches every tx and streams hashes.
+func ParsePendingTxsFilter(extra any) (filter PendingTxsFilter, err error) {
+	switch params := extra.(type) {
+	case nil:
+		return filter, nil
+	case bool:
+		filter.FullTx = params
+		return filter, nil
+	case map[string]any:
+		if fullTx, ok := params["fullTx"]; ok && fullTx != nil {
+			filter.FullTx, ok = fullTx.(bool)
+			if !ok {
+				return filter, fmt.Errorf("invalid fullTx; must be a boolean")
+			}
+		}
+		if filter.FromAddresses, err = parseAddresses(params["fromAddress"]); err != nil {
+			return filter, fmt.Errorf("invalid fromAddress: %w", err)
+		}
+		if filter.ToAddresses, err = parseAddresses(params["toAddress"]); err != nil {
+			return filter, fmt.Errorf("invalid toAddress: %w", err)
+		}
+		return filter, nil
+	default:
+		return filter, fmt.Errorf("invalid pending transactions filter of type %T; must be a boolean or an object", extra)
+	}
+}
+
+// parseAddresses parses an address or an array of addresses decoded from
+// JSON. A nil value returns no addresses.
+func parseAddresses(value any) ([]common.Address, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("invalid address %q", value)
+		}
+		return []common.Address{common.HexToAddress(value)}, nil
+	case []any:
+		addresses := make([]common.Address, 0, len(value))
+		for _, item := range value {
+			address, ok := item.(string)
+			if !ok || !common.IsHexAddress(address) {
+				return nil, fmt.Errorf("invalid address %v", item)
+			}
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+		return addresses, nil
+	default:
+		return nil, fmt.Errorf("must be address or array of addresses")
+	}
+}
+
+// Matches returns true if the tx is sent from one of the FromAddresses and
+// to one of the ToAddresses. Empty address lists match any tx, and contract
+// creations only match an empty ToAddresses.
+func (f PendingTxsFilter) Matches(tx *rpc.EthTxJsonRPC) bool {
+	if len(f.FromAddresses) > 0 && !includes(f.FromAddresses, tx.From) {
+		return false
+	}
+	if len(f.ToAddresses) > 0 && (tx.To == nil || !includes(f.ToAddresses, *tx.To)) {
+		return false
+	}
+	return true
+}
+
 func bloomFilter(bloom gethcore.Bloom, addresses []common.Address, topics [][]common.Hash) bool {
 	if len(addresses) > 0 {
 		var included bool
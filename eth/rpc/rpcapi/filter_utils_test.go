@@ -0,0 +1,86 @@
+package rpcapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
+)
+
+func TestPendingTxsFilter(t *testing.T) {
+	alice := gethcommon.HexToAddress("0x000000000000000000000000000000000000A11C")
+	bob := gethcommon.HexToAddress("0x0000000000000000000000000000000000000B0B")
+	carol := gethcommon.HexToAddress("0x00000000000000000000000000000000000CA201")
+
+	// parse decodes the JSON parameter like the websocket server does.
+	parse := func(param string) (rpcapi.PendingTxsFilter, error) {
+		var extra any
+		require.NoError(t, json.Unmarshal([]byte(param), &extra))
+		return rpcapi.ParsePendingTxsFilter(extra)
+	}
+
+	for _, tc := range []struct {
+		param   string
+		want    rpcapi.PendingTxsFilter
+		wantErr string
+	}{
+		{param: `null`, want: rpcapi.PendingTxsFilter{}},
+		{param: `true`, want: rpcapi.PendingTxsFilter{FullTx: true}},
+		{param: `false`, want: rpcapi.PendingTxsFilter{}},
+		{
+			param: `{"fullTx": true, "fromAddress": "` + alice.Hex() + `"}`,
+			want: rpcapi.PendingTxsFilter{
+				FullTx:        true,
+				FromAddresses: []gethcommon.Address{alice},
+			},
+		},
+		{
+			param: `{"toAddress": ["` + bob.Hex() + `", "` + carol.Hex() + `"]}`,
+			want: rpcapi.PendingTxsFilter{
+				ToAddresses: []gethcommon.Address{bob, carol},
+			},
+		},
+		{param: `"full"`, wantErr: "must be a boolean or an object"},
+		{param: `{"fullTx": "yes"}`, wantErr: "invalid fullTx"},
+		{param: `{"fromAddress": "0x1234"}`, wantErr: "invalid fromAddress"},
+		{param: `{"toAddress": [1]}`, wantErr: "invalid toAddress"},
+	} {
+		t.Run(tc.param, func(t *testing.T) {
+			got, err := parse(tc.param)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	txToBob := &rpc.EthTxJsonRPC{From: alice, To: &bob}
+	contractCreation := &rpc.EthTxJsonRPC{From: alice}
+
+	filter := rpcapi.PendingTxsFilter{}
+	require.True(t, filter.Matches(txToBob))
+	require.True(t, filter.Matches(contractCreation))
+
+	filter = rpcapi.PendingTxsFilter{FromAddresses: []gethcommon.Address{alice}}
+	require.True(t, filter.Matches(txToBob))
+	require.True(t, filter.Matches(contractCreation))
+
+	filter = rpcapi.PendingTxsFilter{FromAddresses: []gethcommon.Address{bob}}
+	require.False(t, filter.Matches(txToBob))
+
+	filter = rpcapi.PendingTxsFilter{
+		FromAddresses: []gethcommon.Address{alice},
+		ToAddresses:   []gethcommon.Address{carol, bob},
+	}
+	require.True(t, filter.Matches(txToBob))
+	require.False(t, filter.Matches(contractCreation))
+
+	filter = rpcapi.PendingTxsFilter{ToAddresses: []gethcommon.Address{carol}}
+	require.False(t, filter.Matches(txToBob))
+}
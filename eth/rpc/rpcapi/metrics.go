@@ -0,0 +1,335 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/pubsub"
+)
+
+const (
+	metricsNamespace = "nibiru"
+	metricsSubsystem = "evm_rpc"
+
+	// methodUnknown is the method label of calls to methods that are not
+	// registered, which keeps the cardinality of the labels bounded.
+	methodUnknown = "unknown"
+
+	statusSuccess = "success"
+	statusError   = "error"
+
+	// HTTPBodyLimit is the size limit of the HTTP requests to the JSON-RPC
+	// server, which is the default of go-ethereum. Middlewares that read the
+	// request body don't read past it.
+	HTTPBodyLimit = 5 * 1024 * 1024
+)
+
+// filterTypeLabels are the "type" labels of the filter gauge.
+var filterTypeLabels = map[filters.Type]string{
+	filters.LogsSubscription:                "logs",
+	filters.PendingTransactionsSubscription: "pending_transactions",
+	filters.BlocksSubscription:              "blocks",
+}
+
+// Metrics holds the Prometheus metrics of the Ethereum JSON-RPC server:
+//   - Request counts, latencies, and in-flight requests for each namespace
+//     and method, recorded by [Metrics.Middleware].
+//   - Websocket connections and subscriptions by subscription type.
+//   - The number of installed filters of each [FiltersAPI].
+//   - The lag of the EVMTxIndexer behind the latest committed block.
+type Metrics struct {
+	Registry *prometheus.Registry
+
+	// methodNamespaces maps the registered methods to their namespace.
+	methodNamespaces map[string]string
+
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	inFlight        *prometheus.GaugeVec
+	wsConnections   prometheus.Gauge
+	wsSubscriptions *prometheus.GaugeVec
+}
+
+// NewMetrics returns the metrics of a JSON-RPC server serving the given APIs.
+// The filters of any [FiltersAPI] among them are tracked. The indexer lag is
+// tracked when indexer is not nil.
+func NewMetrics(
+	clientCtx client.Context, apis []gethrpc.API, indexer eth.EVMTxIndexer,
+) *Metrics {
+	m := &Metrics{
+		Registry:         prometheus.NewRegistry(),
+		methodNamespaces: make(map[string]string),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Number of JSON-RPC calls by namespace, method, and status (success or error).",
+		}, []string{"namespace", "method", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of JSON-RPC calls by namespace and method. Calls in a batch are observed with the duration of the batch.",
+			Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}, []string{"namespace", "method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_in_flight",
+			Help:      "Number of JSON-RPC calls being served by namespace and method.",
+		}, []string{"namespace", "method"}),
+		wsConnections: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "ws_connections",
+			Help:      "Number of open websocket connections.",
+		}),
+		wsSubscriptions: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "ws_subscriptions",
+			Help:      "Number of active websocket subscriptions by subscription type.",
+		}, []string{"subscription"}),
+	}
+	m.Registry.MustRegister(
+		m.requests, m.requestDuration, m.inFlight, m.wsConnections, m.wsSubscriptions,
+	)
+
+	for _, api := range apis {
+		// Any exported method of a service can be called, including those
+		// that return no error, which ParseAPIMethods skips.
+		svcType := reflect.TypeOf(api.Service)
+		for i := range svcType.NumMethod() {
+			method := rpcMethodName(api.Namespace, svcType.Method(i).Name)
+			m.methodNamespaces[method] = api.Namespace
+		}
+		if filtersAPI, ok := api.Service.(*FiltersAPI); ok {
+			m.Registry.MustRegister(&filtersCollector{api: filtersAPI})
+		}
+	}
+	if indexer != nil {
+		m.Registry.MustRegister(&indexerLagCollector{
+			clientCtx: clientCtx,
+			indexer:   indexer,
+		})
+	}
+	return m
+}
+
+// rpcCall is the part of a JSON-RPC request or response used by the metrics.
+type rpcCall struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// parseRPCCalls decodes a single or batch JSON-RPC message. It returns nil
+// when the message is not valid JSON-RPC.
+func parseRPCCalls(raw []byte) []rpcCall {
+	if isBatch(raw) {
+		var calls []rpcCall
+		if err := json.Unmarshal(raw, &calls); err != nil {
+			return nil
+		}
+		return calls
+	}
+	var call rpcCall
+	if err := json.Unmarshal(raw, &call); err != nil {
+		return nil
+	}
+	return []rpcCall{call}
+}
+
+// labels returns the namespace and method labels of a call to the method.
+func (m *Metrics) labels(method string) (namespace, methodLabel string) {
+	namespace, ok := m.methodNamespaces[method]
+	if !ok {
+		return methodUnknown, methodUnknown
+	}
+	return namespace, method
+}
+
+// readRequestBody reads the body of a JSON-RPC request, up to HTTPBodyLimit,
+// and replaces it so that the next handler can read it again. If the body
+// can't be read, it writes an error response and returns false.
+func readRequestBody(w http.ResponseWriter, r *http.Request) (body []byte, ok bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, HTTPBodyLimit))
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, err.Error(), status)
+		return nil, false
+	}
+	return body, true
+}
+
+// Middleware records the request metrics of the JSON-RPC calls served by
+// next. The calls are read from the request body, and each call counts as an
+// error if its response carries an error or the HTTP status is not OK.
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := readRequestBody(w, r)
+		if !ok {
+			m.requests.WithLabelValues(methodUnknown, methodUnknown, statusError).Inc()
+			return
+		}
+
+		calls := parseRPCCalls(body)
+		if len(calls) == 0 {
+			calls = []rpcCall{{Method: methodUnknown}}
+		}
+		for _, call := range calls {
+			m.inFlight.WithLabelValues(m.labels(call.Method)).Inc()
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(recorder, r)
+		elapsed := time.Since(start).Seconds()
+
+		// IDs of the calls that failed
+		failed := make(map[string]bool)
+		for _, resp := range parseRPCCalls(recorder.body.Bytes()) {
+			if len(resp.Error) > 0 && string(resp.Error) != "null" {
+				failed[string(resp.ID)] = true
+			}
+		}
+		for _, call := range calls {
+			namespace, method := m.labels(call.Method)
+			status := statusSuccess
+			if recorder.status != http.StatusOK || failed[string(call.ID)] {
+				status = statusError
+			}
+			m.inFlight.WithLabelValues(namespace, method).Dec()
+			m.requestDuration.WithLabelValues(namespace, method).Observe(elapsed)
+			m.requests.WithLabelValues(namespace, method, status).Inc()
+		}
+	})
+}
+
+// responseRecorder is an http.ResponseWriter that keeps a copy of the status
+// and body of the response.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(bz []byte) (int, error) {
+	r.body.Write(bz)
+	return r.ResponseWriter.Write(bz)
+}
+
+// TrackWsConnection counts an open websocket connection. The returned func
+// must be called when the connection closes. It is safe to call on nil
+// Metrics, which is the case when metrics are disabled.
+func (m *Metrics) TrackWsConnection() (closed func()) {
+	if m == nil {
+		return func() {}
+	}
+	m.wsConnections.Inc()
+	return m.wsConnections.Dec
+}
+
+// TrackWsSubscription counts an active websocket subscription of the given
+// type, such as "newHeads" or "logs", until it is unsubscribed. It is safe to
+// call on nil Metrics.
+func (m *Metrics) TrackWsSubscription(
+	subscription string, unsubFn pubsub.UnsubscribeFunc,
+) pubsub.UnsubscribeFunc {
+	if m == nil {
+		return unsubFn
+	}
+	gauge := m.wsSubscriptions.WithLabelValues(subscription)
+	gauge.Inc()
+	var once sync.Once
+	return func() {
+		once.Do(gauge.Dec)
+		unsubFn()
+	}
+}
+
+// filtersCollector reports the number of installed filters of a FiltersAPI
+// when the metrics are scraped.
+type filtersCollector struct {
+	api *FiltersAPI
+}
+
+var filtersDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "filters"),
+	"Number of installed filters by type.",
+	[]string{"type"}, nil,
+)
+
+func (c *filtersCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- filtersDesc
+}
+
+func (c *filtersCollector) Collect(ch chan<- prometheus.Metric) {
+	numFilters := c.api.numFilters()
+	for typ, label := range filterTypeLabels {
+		ch <- prometheus.MustNewConstMetric(
+			filtersDesc, prometheus.GaugeValue, float64(numFilters[typ]), label,
+		)
+	}
+}
+
+// indexerLagCollector reports how many blocks the EVMTxIndexer is behind
+// the latest committed block when the metrics are scraped.
+type indexerLagCollector struct {
+	clientCtx client.Context
+	indexer   eth.EVMTxIndexer
+}
+
+var indexerLagDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "indexer_lag_blocks"),
+	"Number of committed blocks that the EVM tx indexer has not indexed yet.",
+	nil, nil,
+)
+
+func (c *indexerLagCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- indexerLagDesc
+}
+
+func (c *indexerLagCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	status, err := c.clientCtx.Client.Status(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(indexerLagDesc, err)
+		return
+	}
+	lastIndexed, err := c.indexer.LastIndexedBlock()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(indexerLagDesc, err)
+		return
+	}
+	// LastIndexedBlock is -1 when nothing is indexed yet
+	lag := status.SyncInfo.LatestBlockHeight - max(lastIndexed, 0)
+	ch <- prometheus.MustNewConstMetric(
+		indexerLagDesc, prometheus.GaugeValue, float64(max(lag, 0)),
+	)
+}
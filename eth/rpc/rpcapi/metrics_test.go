@@ -0,0 +1,92 @@
+package rpcapi_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
+)
+
+// scrapeMetrics returns the metrics in the Prometheus text format.
+func scrapeMetrics(t *testing.T, metrics *rpcapi.Metrics) string {
+	rec := httptest.NewRecorder()
+	promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}).ServeHTTP(
+		rec, httptest.NewRequest(http.MethodGet, "/", nil),
+	)
+	return rec.Body.String()
+}
+
+func TestMetrics(t *testing.T) {
+	apis := []gethrpc.API{{
+		Namespace: rpcapi.NamespaceWeb3,
+		Service:   rpcapi.NewImplWeb3API(),
+	}}
+	rpcServer := gethrpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName(apis[0].Namespace, apis[0].Service))
+	metrics := rpcapi.NewMetrics(client.Context{}, apis, nil)
+	srv := httptest.NewServer(metrics.Middleware(rpcServer))
+	defer srv.Close()
+
+	post := func(body string) {
+		resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		_, err = io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+	post(`{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion","params":[]}`)
+	post(`[
+		{"jsonrpc":"2.0","id":2,"method":"web3_clientVersion","params":[]},
+		{"jsonrpc":"2.0","id":3,"method":"web3_sha3","params":[1]},
+		{"jsonrpc":"2.0","id":4,"method":"eth_nonexistentMethod","params":[]}
+	]`)
+
+	// Bodies above the limit are rejected without being read in full.
+	resp, err := http.Post(srv.URL, "application/json", strings.NewReader(
+		`{"jsonrpc":"2.0","id":5,"method":"web3_sha3","params":["`+
+			strings.Repeat("a", rpcapi.HTTPBodyLimit)+`"]}`,
+	))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	unsubscribed := 0
+	unsubFn := metrics.TrackWsSubscription("newHeads", func() { unsubscribed++ })
+	closeConn := metrics.TrackWsConnection()
+
+	scraped := scrapeMetrics(t, metrics)
+	for _, line := range []string{
+		`nibiru_evm_rpc_requests_total{method="web3_clientVersion",namespace="web3",status="success"} 2`,
+		`nibiru_evm_rpc_requests_total{method="web3_sha3",namespace="web3",status="error"} 1`,
+		`nibiru_evm_rpc_requests_total{method="unknown",namespace="unknown",status="error"} 2`,
+		`nibiru_evm_rpc_request_duration_seconds_count{method="web3_clientVersion",namespace="web3"} 2`,
+		`nibiru_evm_rpc_requests_in_flight{method="web3_clientVersion",namespace="web3"} 0`,
+		`nibiru_evm_rpc_ws_subscriptions{subscription="newHeads"} 1`,
+		`nibiru_evm_rpc_ws_connections 1`,
+	} {
+		require.Contains(t, scraped, line)
+	}
+
+	// Unsubscribing more than once only decrements the gauge once.
+	unsubFn()
+	unsubFn()
+	closeConn()
+	require.Equal(t, 2, unsubscribed)
+	scraped = scrapeMetrics(t, metrics)
+	require.Contains(t, scraped, `nibiru_evm_rpc_ws_subscriptions{subscription="newHeads"} 0`)
+	require.Contains(t, scraped, `nibiru_evm_rpc_ws_connections 0`)
+
+	// Tracking on nil metrics, when metrics are disabled, is a no-op.
+	var disabled *rpcapi.Metrics
+	disabled.TrackWsSubscription("logs", func() {})()
+	disabled.TrackWsConnection()()
+}
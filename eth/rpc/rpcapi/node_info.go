@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
@@ -38,8 +39,6 @@ func (b *Backend) Accounts() ([]gethcommon.Address, error) {
 // - startingBlock: block number this node started to synchronize from
 // - currentBlock:  block number this node is currently importing
 // - highestBlock:  block number of the highest block header this node has received from peers
-// - pulledStates:  number of state entries processed until now
-// - knownStates:   number of known state entries that still need to be pulled
 func (b *Backend) Syncing() (any, error) {
 	status, err := b.clientCtx.Client.Status(b.ctx)
 	if err != nil {
@@ -50,13 +49,42 @@ func (b *Backend) Syncing() (any, error) {
 		return false, nil
 	}
 
-	return map[string]any{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
-		// "highestBlock":  nil, // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
-	}, nil
+	return NewSyncStatus(status.SyncInfo), nil
+}
+
+// SyncStatus is the sync progress of the node, as returned by "eth_syncing"
+// while the node catches up with the network.
+type SyncStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// NewSyncStatus returns the sync progress from the CometBFT sync info.
+// CometBFT doesn't report the height of the peers, so the highest block is
+// the latest block of the node.
+func NewSyncStatus(syncInfo tmrpctypes.SyncInfo) SyncStatus {
+	return SyncStatus{
+		StartingBlock: hexutil.Uint64(syncInfo.EarliestBlockHeight),
+		CurrentBlock:  hexutil.Uint64(syncInfo.LatestBlockHeight),
+		HighestBlock:  hexutil.Uint64(syncInfo.LatestBlockHeight),
+	}
+}
+
+// SyncingResult is the notification of the "syncing" websocket
+// subscription, in the same format as go-ethereum.
+type SyncingResult struct {
+	Syncing bool       `json:"syncing"`
+	Status  SyncStatus `json:"status"`
+}
+
+// NewSyncingResult returns the "syncing" notification from the CometBFT sync
+// info.
+func NewSyncingResult(syncInfo tmrpctypes.SyncInfo) SyncingResult {
+	return SyncingResult{
+		Syncing: syncInfo.CatchingUp,
+		Status:  NewSyncStatus(syncInfo),
+	}
 }
 
 // RPCGasCap is the global gas cap for eth-call variants.
@@ -1,10 +1,16 @@
 package rpcapi_test
 
 import (
+	"encoding/json"
+	"testing"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
 )
 
 func (s *BackendSuite) TestAccounts() {
@@ -20,6 +26,23 @@ func (s *BackendSuite) TestSyncing() {
 	s.Require().False(syncing.(bool))
 }
 
+func TestNewSyncingResult(t *testing.T) {
+	syncInfo := tmrpctypes.SyncInfo{
+		EarliestBlockHeight: 1,
+		LatestBlockHeight:   255,
+		CatchingUp:          true,
+	}
+	bz, err := json.Marshal(rpcapi.NewSyncingResult(syncInfo))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"syncing": true,
+		"status": {"startingBlock": "0x1", "currentBlock": "0xff", "highestBlock": "0xff"}
+	}`, string(bz))
+
+	syncInfo.CatchingUp = false
+	require.False(t, rpcapi.NewSyncingResult(syncInfo).Syncing)
+}
+
 func (s *BackendSuite) TestRPCGasCap() {
 	s.Require().Equal(config.DefaultConfig().JSONRPC.GasCap, s.backend.RPCGasCap())
 }
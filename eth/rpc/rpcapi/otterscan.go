@@ -0,0 +1,467 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// OtsAPILevel is the version of the Otterscan "ots_*" API implemented by the
+// node, as returned by "ots_getApiLevel". Otterscan refuses to run against
+// nodes with a lower API level than the one it requires.
+const OtsAPILevel = 8
+
+// Types of the [OtsInternalOperation]s.
+const (
+	OtsOpTransfer     = 0
+	OtsOpSelfDestruct = 1
+	OtsOpCreate       = 2
+	OtsOpCreate2      = 3
+)
+
+// errOtsIndexerDisabled is returned by the address-indexed lookups of the
+// "ots_*" API when the node runs without the EVM tx indexer.
+var errOtsIndexerDisabled = errors.New(
+	"the ots namespace requires the EVM tx indexer, enable it with --json-rpc.enable-indexer",
+)
+
+// OtsInternalOperation is an internal operation of a tx: a value transfer,
+// contract creation, or self-destruct performed by a contract call.
+type OtsInternalOperation struct {
+	Type  int                `json:"type"`
+	From  gethcommon.Address `json:"from"`
+	To    gethcommon.Address `json:"to"`
+	Value *hexutil.Big       `json:"value"`
+}
+
+// OtsTraceEntry is a call frame of a tx, as returned by "ots_traceTransaction",
+// ordered depth-first.
+type OtsTraceEntry struct {
+	Type   string             `json:"type"`
+	Depth  int                `json:"depth"`
+	From   gethcommon.Address `json:"from"`
+	To     gethcommon.Address `json:"to"`
+	Value  *hexutil.Big       `json:"value"`
+	Input  hexutil.Bytes      `json:"input"`
+	Output hexutil.Bytes      `json:"output"`
+}
+
+// OtsSearchResult is a page of the txs of an address, ordered from the most
+// recent to the oldest. FirstPage is true for the page of the most recent txs
+// and LastPage is true for the page of the oldest ones. The receipts include
+// the "timestamp" of their block.
+type OtsSearchResult struct {
+	Txs       []*rpc.EthTxJsonRPC `json:"txs"`
+	Receipts  []map[string]any    `json:"receipts"`
+	FirstPage bool                `json:"firstPage"`
+	LastPage  bool                `json:"lastPage"`
+}
+
+// OtsContractCreator is the tx that deployed a contract and its sender.
+type OtsContractCreator struct {
+	Hash    gethcommon.Hash    `json:"hash"`
+	Creator gethcommon.Address `json:"creator"`
+}
+
+// OtsBlockDetails is a block without its txs and logs bloom, as returned by
+// "ots_getBlockDetails". The block has a "transactionCount" field instead.
+type OtsBlockDetails struct {
+	Block     map[string]any `json:"block"`
+	Issuance  OtsIssuance    `json:"issuance"`
+	TotalFees *hexutil.Big   `json:"totalFees"`
+}
+
+// OtsIssuance is the issuance of a block in Ethereum proof-of-work chains. It
+// is always zero, as Nibiru blocks have no block or uncle rewards.
+type OtsIssuance struct {
+	BlockReward *hexutil.Big `json:"blockReward"`
+	UncleReward *hexutil.Big `json:"uncleReward"`
+	Issuance    *hexutil.Big `json:"issuance"`
+}
+
+// OtsBlockTransactions is a page of the txs of a block and their receipts, as
+// returned by "ots_getBlockTransactions". The inputs of the txs are cropped to
+// their 4-byte selectors, and the receipts have no logs.
+type OtsBlockTransactions struct {
+	FullBlock map[string]any   `json:"fullblock"`
+	Receipts  []map[string]any `json:"receipts"`
+}
+
+// otsCallTrace returns the call tree of the tx from the geth "callTracer".
+func (b *Backend) otsCallTrace(hash gethcommon.Hash) (*callFrame, error) {
+	callTrace, err := b.TraceTransaction(hash, &evm.TraceConfig{Tracer: "callTracer"})
+	if err != nil {
+		return nil, err
+	}
+	var root callFrame
+	if err := json.Unmarshal(callTrace, &root); err != nil {
+		return nil, fmt.Errorf("failed to decode call trace: %w", err)
+	}
+	return &root, nil
+}
+
+// otsCheckAddressIndexes returns an error if the lookups by address of the
+// EVM tx indexer can miss txs in the blocks from "fromBlock". That is the case
+// for blocks indexed by versions of the node without the address indexes,
+// until they are indexed again with the "evm-tx-index" command.
+func (b *Backend) otsCheckAddressIndexes(fromBlock int64) error {
+	firstBlock, err := b.evmTxIndexer.FirstIndexedBlock()
+	if err != nil || firstBlock < 0 {
+		return err
+	}
+	addressIndexedFrom, err := b.evmTxIndexer.AddressIndexedFrom()
+	if err != nil {
+		return err
+	}
+	if fromBlock < addressIndexedFrom && firstBlock < addressIndexedFrom {
+		return fmt.Errorf(
+			"the EVM tx indexer has no address indexes for the blocks %d to %d, "+
+				"stop the node and run \"evm-tx-index %d latest\" to index them",
+			firstBlock, addressIndexedFrom-1, firstBlock,
+		)
+	}
+	return nil
+}
+
+// otsReceipt returns the receipt as a JSON object, so that fields can be added
+// to it or removed from it.
+func otsReceipt(receipt *TransactionReceipt) (map[string]any, error) {
+	receiptJson, err := json.Marshal(receipt)
+	if err != nil {
+		return nil, err
+	}
+	var receiptMap map[string]any
+	if err := json.Unmarshal(receiptJson, &receiptMap); err != nil {
+		return nil, err
+	}
+	return receiptMap, nil
+}
+
+// OtsHasCode returns whether the address has code at the block.
+func (b *Backend) OtsHasCode(addr gethcommon.Address, blockNrOrHash rpc.BlockNumberOrHash) (bool, error) {
+	code, err := b.GetCode(addr, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// OtsBlockDetails returns the block without its txs and logs bloom, along with
+// the number of its txs and the fees paid by them.
+func (b *Backend) OtsBlockDetails(blockNrOrHash rpc.BlockNumberOrHash) (*OtsBlockDetails, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := b.GetBlockByNumber(blockNum, false)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := b.GetBlockReceipts(rpc.BlockNumberOrHash{BlockNumber: &blockNum})
+	if err != nil {
+		return nil, err
+	}
+
+	totalFees := new(big.Int)
+	for _, receipt := range receipts {
+		fee := new(big.Int).SetUint64(receipt.GasUsed)
+		if receipt.EffectiveGasPrice != nil {
+			fee.Mul(fee, receipt.EffectiveGasPrice.ToInt())
+		}
+		totalFees.Add(totalFees, fee)
+	}
+
+	if txs, ok := block["transactions"].([]any); ok {
+		block["transactionCount"] = len(txs)
+	}
+	delete(block, "transactions")
+	block["logsBloom"] = nil
+	return &OtsBlockDetails{
+		Block: block,
+		Issuance: OtsIssuance{
+			BlockReward: new(hexutil.Big),
+			UncleReward: new(hexutil.Big),
+			Issuance:    new(hexutil.Big),
+		},
+		TotalFees: (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// OtsBlockTransactions returns a page of the txs of the block and their
+// receipts. Pages are counted from the last txs of the block, so page zero
+// has the last "pageSize" txs.
+func (b *Backend) OtsBlockTransactions(
+	blockNum rpc.BlockNumber, pageNumber, pageSize uint8,
+) (*OtsBlockTransactions, error) {
+	block, err := b.GetBlockByNumber(blockNum, true)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := b.GetBlockReceipts(rpc.BlockNumberOrHash{BlockNumber: &blockNum})
+	if err != nil {
+		return nil, err
+	}
+	txs, _ := block["transactions"].([]any)
+	if len(txs) != len(receipts) {
+		return nil, fmt.Errorf(
+			"block %d has %d txs but %d receipts", blockNum, len(txs), len(receipts),
+		)
+	}
+
+	pageEnd := max(len(txs)-int(pageNumber)*int(pageSize), 0)
+	pageStart := max(pageEnd-int(pageSize), 0)
+
+	result := &OtsBlockTransactions{
+		FullBlock: block,
+		Receipts:  make([]map[string]any, 0, pageEnd-pageStart),
+	}
+	for _, tx := range txs[pageStart:pageEnd] {
+		if rpcTx, ok := tx.(*rpc.EthTxJsonRPC); ok && len(rpcTx.Input) > 4 {
+			rpcTx.Input = rpcTx.Input[:4]
+		}
+	}
+	for _, receipt := range receipts[pageStart:pageEnd] {
+		receiptMap, err := otsReceipt(receipt)
+		if err != nil {
+			return nil, err
+		}
+		receiptMap["logs"] = nil
+		receiptMap["logsBloom"] = nil
+		result.Receipts = append(result.Receipts, receiptMap)
+	}
+	block["transactions"] = txs[pageStart:pageEnd]
+	return result, nil
+}
+
+// OtsTransactionError returns the revert data of the tx, which is empty if the
+// tx succeeded or failed without revert data.
+func (b *Backend) OtsTransactionError(hash gethcommon.Hash) (hexutil.Bytes, error) {
+	root, err := b.otsCallTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+	if root.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return root.Output, nil
+}
+
+// OtsInternalOperations returns the value transfers, contract creations, and
+// self-destructs performed by the contracts called by the tx. The top-level
+// call of the tx is not an internal operation.
+func (b *Backend) OtsInternalOperations(hash gethcommon.Hash) ([]*OtsInternalOperation, error) {
+	root, err := b.otsCallTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []*OtsInternalOperation{}
+	var walk func(frame *callFrame, depth int)
+	walk = func(frame *callFrame, depth int) {
+		if depth > 0 && frame.Error == "" {
+			op := &OtsInternalOperation{From: frame.From, Value: frame.Value}
+			if frame.To != nil {
+				op.To = *frame.To
+			}
+			if op.Value == nil {
+				op.Value = new(hexutil.Big)
+			}
+
+			switch strings.ToUpper(frame.Type) {
+			case vm.CALL.String():
+				if op.Value.ToInt().Sign() > 0 {
+					op.Type = OtsOpTransfer
+					ops = append(ops, op)
+				}
+			case vm.SELFDESTRUCT.String():
+				op.Type = OtsOpSelfDestruct
+				ops = append(ops, op)
+			case vm.CREATE.String():
+				op.Type = OtsOpCreate
+				ops = append(ops, op)
+			case vm.CREATE2.String():
+				op.Type = OtsOpCreate2
+				ops = append(ops, op)
+			}
+		}
+		for i := range frame.Calls {
+			walk(&frame.Calls[i], depth+1)
+		}
+	}
+	walk(root, 0)
+	return ops, nil
+}
+
+// OtsTraceTransaction returns the call frames of the tx, ordered depth-first.
+// The value of delegate and static calls is nil.
+func (b *Backend) OtsTraceTransaction(hash gethcommon.Hash) ([]*OtsTraceEntry, error) {
+	root, err := b.otsCallTrace(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*OtsTraceEntry{}
+	var walk func(frame *callFrame, depth int)
+	walk = func(frame *callFrame, depth int) {
+		entry := &OtsTraceEntry{
+			Type:   strings.ToUpper(frame.Type),
+			Depth:  depth,
+			From:   frame.From,
+			Value:  frame.Value,
+			Input:  frame.Input,
+			Output: frame.Output,
+		}
+		if frame.To != nil {
+			entry.To = *frame.To
+		}
+		if entry.Type == vm.DELEGATECALL.String() || entry.Type == vm.STATICCALL.String() {
+			entry.Value = nil
+		} else if entry.Value == nil {
+			entry.Value = new(hexutil.Big)
+		}
+		entries = append(entries, entry)
+		for i := range frame.Calls {
+			walk(&frame.Calls[i], depth+1)
+		}
+	}
+	walk(root, 0)
+	return entries, nil
+}
+
+// OtsSearchTransactions returns a page of at least "pageSize" txs sent from
+// or to the address in the blocks before (if "before") or after the block
+// number, which is excluded. The txs of the last block of the page are all
+// included, so pages can be larger than "pageSize". A zero block number
+// searches from the latest block for "before" and from genesis otherwise.
+func (b *Backend) OtsSearchTransactions(
+	addr gethcommon.Address, blockNum uint64, pageSize uint16, before bool,
+) (*OtsSearchResult, error) {
+	if b.evmTxIndexer == nil {
+		return nil, errOtsIndexerDisabled
+	}
+	height := int64(blockNum) // #nosec G701 -- block numbers fit in int64
+	if before && blockNum == 0 {
+		latest, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = int64(latest) + 1 // #nosec G701 -- checked for int overflow already
+	}
+
+	hashes, hasMore, err := b.evmTxIndexer.GetByAddress(addr, height, before, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	if !before {
+		// Pages are ordered from the most recent tx to the oldest one.
+		slices.Reverse(hashes)
+	}
+
+	result := &OtsSearchResult{
+		Txs:      make([]*rpc.EthTxJsonRPC, 0, len(hashes)),
+		Receipts: make([]map[string]any, 0, len(hashes)),
+	}
+	if before {
+		result.FirstPage, result.LastPage = blockNum == 0, !hasMore
+	} else {
+		result.FirstPage, result.LastPage = !hasMore, blockNum == 0
+	}
+
+	// The lookup reaches the oldest indexed block unless more txs remain.
+	var fromBlock int64
+	if !before {
+		fromBlock = height + 1
+	}
+
+	blockTimes := make(map[int64]uint64)
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := b.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || tx.BlockNumber == nil || receipt == nil {
+			return nil, fmt.Errorf("indexed tx %s not found", hash.Hex())
+		}
+
+		txHeight := tx.BlockNumber.ToInt().Int64()
+		if before && hasMore {
+			fromBlock = txHeight
+		}
+		blockTime, ok := blockTimes[txHeight]
+		if !ok {
+			resBlock, err := b.TendermintBlockByNumber(rpc.BlockNumber(txHeight))
+			if err != nil {
+				return nil, err
+			}
+			blockTime = uint64(resBlock.Block.Time.Unix()) // #nosec G701 -- block times are positive
+			blockTimes[txHeight] = blockTime
+		}
+
+		receiptMap, err := otsReceipt(receipt)
+		if err != nil {
+			return nil, err
+		}
+		receiptMap["timestamp"] = blockTime
+
+		result.Txs = append(result.Txs, tx)
+		result.Receipts = append(result.Receipts, receiptMap)
+	}
+	if err := b.otsCheckAddressIndexes(fromBlock); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// OtsContractCreator returns the tx that deployed the contract and its
+// sender, or nil if the address is not a contract deployed by a contract
+// creation tx.
+func (b *Backend) OtsContractCreator(addr gethcommon.Address) (*OtsContractCreator, error) {
+	if b.evmTxIndexer == nil {
+		return nil, errOtsIndexerDisabled
+	}
+	hash, err := b.evmTxIndexer.GetContractCreationTx(addr)
+	if err != nil {
+		return nil, err
+	}
+	if hash == nil {
+		return nil, b.otsCheckAddressIndexes(0)
+	}
+	tx, err := b.GetTransactionByHash(*hash)
+	if err != nil {
+		return nil, err
+	}
+	return &OtsContractCreator{Hash: *hash, Creator: tx.From}, nil
+}
+
+// OtsTransactionBySenderAndNonce returns the hash of the tx sent with the
+// nonce by the sender, or nil if it is not found.
+func (b *Backend) OtsTransactionBySenderAndNonce(
+	sender gethcommon.Address, nonce uint64,
+) (*gethcommon.Hash, error) {
+	if b.evmTxIndexer == nil {
+		return nil, errOtsIndexerDisabled
+	}
+	hash, err := b.evmTxIndexer.GetBySenderAndNonce(sender, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if hash == nil {
+		return nil, b.otsCheckAddressIndexes(0)
+	}
+	return hash, nil
+}
@@ -0,0 +1,199 @@
+package rpcapi_test
+
+import (
+	"slices"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
+)
+
+func (s *BackendSuite) TestOtterscanNamespace() {
+	transferTx := s.SuccessfulTxTransfer()
+	deployTx := s.SuccessfulTxDeployContract()
+
+	s.Run("ots_getApiLevel", func() {
+		var apiLevel uint64
+		err := s.node.EvmRpcClient.Client().Call(&apiLevel, "ots_getApiLevel")
+		s.Require().NoError(err)
+		s.Equal(uint64(rpcapi.OtsAPILevel), apiLevel)
+	})
+
+	s.Run("ots_traceTransaction", func() {
+		var entries []*rpcapi.OtsTraceEntry
+		err := s.node.EvmRpcClient.Client().Call(
+			&entries, "ots_traceTransaction", transferTx.Receipt.TxHash,
+		)
+		s.Require().NoError(err)
+		s.Require().Len(entries, 1)
+		s.Equal("CALL", entries[0].Type)
+		s.Equal(0, entries[0].Depth)
+		s.Equal(s.fundedAccEthAddr, entries[0].From)
+		s.Equal(recipient, entries[0].To)
+		s.Equal(amountToSend, entries[0].Value.ToInt())
+	})
+
+	s.Run("ots_getInternalOperations", func() {
+		// The top-level call of a tx is not an internal operation.
+		ops, err := s.backend.OtsInternalOperations(transferTx.Receipt.TxHash)
+		s.Require().NoError(err)
+		s.Empty(ops)
+	})
+
+	s.Run("ots_searchTransactionsBefore", func() {
+		result, err := s.backend.OtsSearchTransactions(s.fundedAccEthAddr, 0, 100, true)
+		s.Require().NoError(err)
+		s.True(result.FirstPage)
+		s.True(result.LastPage)
+		s.Require().Equal(len(result.Txs), len(result.Receipts))
+
+		// The deployment is more recent than the transfer.
+		txHashes := make([]gethcommon.Hash, len(result.Txs))
+		for i, tx := range result.Txs {
+			txHashes[i] = tx.Hash
+			s.Equal(tx.Hash.Hex(), result.Receipts[i]["transactionHash"])
+			s.Contains(result.Receipts[i], "timestamp")
+		}
+		s.Require().Contains(txHashes, transferTx.Receipt.TxHash)
+		s.Require().Contains(txHashes, deployTx.Receipt.TxHash)
+		s.Less(
+			slices.Index(txHashes, deployTx.Receipt.TxHash),
+			slices.Index(txHashes, transferTx.Receipt.TxHash),
+		)
+
+		// The recipient only has the transfer.
+		result, err = s.backend.OtsSearchTransactions(recipient, transferTx.BlockNumber.Uint64()+1, 1, true)
+		s.Require().NoError(err)
+		s.False(result.FirstPage)
+		s.True(result.LastPage)
+		s.Require().Len(result.Txs, 1)
+		s.Equal(transferTx.Receipt.TxHash, result.Txs[0].Hash)
+
+		result, err = s.backend.OtsSearchTransactions(recipient, transferTx.BlockNumber.Uint64(), 1, true)
+		s.Require().NoError(err)
+		s.Empty(result.Txs)
+	})
+
+	s.Run("ots_searchTransactionsAfter", func() {
+		result, err := s.backend.OtsSearchTransactions(
+			s.fundedAccEthAddr, transferTx.BlockNumber.Uint64()-1, 1, false,
+		)
+		s.Require().NoError(err)
+		s.False(result.LastPage)
+		s.Require().NotEmpty(result.Txs)
+		s.Equal(transferTx.Receipt.TxHash, result.Txs[len(result.Txs)-1].Hash)
+
+		result, err = s.backend.OtsSearchTransactions(recipient, 0, 10, false)
+		s.Require().NoError(err)
+		s.True(result.FirstPage)
+		s.True(result.LastPage)
+		s.Require().Len(result.Txs, 1)
+		s.Equal(transferTx.Receipt.TxHash, result.Txs[0].Hash)
+	})
+
+	s.Run("ots_hasCode", func() {
+		deployBlock := rpc.BlockNumberOrHash{BlockNumber: deployTx.BlockNumberRpc}
+		var hasCode bool
+		err := s.node.EvmRpcClient.Client().Call(
+			&hasCode, "ots_hasCode", testContractAddress, deployBlock,
+		)
+		s.Require().NoError(err)
+		s.True(hasCode)
+
+		hasCode, err = s.backend.OtsHasCode(recipient, deployBlock)
+		s.Require().NoError(err)
+		s.False(hasCode)
+	})
+
+	s.Run("ots_getBlockDetails", func() {
+		var details *rpcapi.OtsBlockDetails
+		err := s.node.EvmRpcClient.Client().Call(
+			&details, "ots_getBlockDetails", transferTx.BlockNumberRpc,
+		)
+		s.Require().NoError(err)
+		s.Require().NotNil(details)
+		s.Equal(transferTx.BlockHash.Hex(), details.Block["hash"])
+		s.NotContains(details.Block, "transactions")
+		s.Nil(details.Block["logsBloom"])
+		s.NotZero(details.Block["transactionCount"])
+		s.Positive(details.TotalFees.ToInt().Sign())
+		s.Zero(details.Issuance.BlockReward.ToInt().Sign())
+
+		var detailsByHash *rpcapi.OtsBlockDetails
+		err = s.node.EvmRpcClient.Client().Call(
+			&detailsByHash, "ots_getBlockDetailsByHash", transferTx.BlockHash,
+		)
+		s.Require().NoError(err)
+		s.Equal(details, detailsByHash)
+	})
+
+	s.Run("ots_getBlockTransactions", func() {
+		var page *rpcapi.OtsBlockTransactions
+		err := s.node.EvmRpcClient.Client().Call(
+			&page, "ots_getBlockTransactions", deployTx.BlockNumberRpc, 0, 25,
+		)
+		s.Require().NoError(err)
+		s.Require().NotNil(page)
+		txs, ok := page.FullBlock["transactions"].([]any)
+		s.Require().True(ok)
+		s.Require().Len(page.Receipts, len(txs))
+
+		var found bool
+		for i, tx := range txs {
+			txMap := tx.(map[string]any)
+			s.LessOrEqual(len(txMap["input"].(string)), len("0x12345678"))
+			s.Equal(txMap["hash"], page.Receipts[i]["transactionHash"])
+			s.Nil(page.Receipts[i]["logs"])
+			found = found || txMap["hash"] == deployTx.Receipt.TxHash.Hex()
+		}
+		s.True(found)
+
+		// Pages past the txs of the block are empty.
+		page, err = s.backend.OtsBlockTransactions(*deployTx.BlockNumberRpc, 1, 25)
+		s.Require().NoError(err)
+		s.Empty(page.Receipts)
+	})
+
+	s.Run("ots_getTransactionError", func() {
+		var revertData hexutil.Bytes
+		err := s.node.EvmRpcClient.Client().Call(
+			&revertData, "ots_getTransactionError", transferTx.Receipt.TxHash,
+		)
+		s.Require().NoError(err)
+		s.Empty(revertData)
+	})
+
+	s.Run("ots_getContractCreator", func() {
+		var creator *rpcapi.OtsContractCreator
+		err := s.node.EvmRpcClient.Client().Call(
+			&creator, "ots_getContractCreator", deployTx.Receipt.ContractAddress,
+		)
+		s.Require().NoError(err)
+		s.Require().NotNil(creator)
+		s.Equal(deployTx.Receipt.TxHash, creator.Hash)
+		s.Equal(s.fundedAccEthAddr, creator.Creator)
+
+		creator, err = s.backend.OtsContractCreator(recipient)
+		s.Require().NoError(err)
+		s.Nil(creator)
+	})
+
+	s.Run("ots_getTransactionBySenderAndNonce", func() {
+		tx, err := s.backend.GetTransactionByHash(deployTx.Receipt.TxHash)
+		s.Require().NoError(err)
+
+		var hash *gethcommon.Hash
+		err = s.node.EvmRpcClient.Client().Call(
+			&hash, "ots_getTransactionBySenderAndNonce", s.fundedAccEthAddr, uint64(tx.Nonce),
+		)
+		s.Require().NoError(err)
+		s.Require().NotNil(hash)
+		s.Equal(deployTx.Receipt.TxHash, *hash)
+
+		hash, err = s.backend.OtsTransactionBySenderAndNonce(recipient, 0)
+		s.Require().NoError(err)
+		s.Nil(hash)
+	})
+}
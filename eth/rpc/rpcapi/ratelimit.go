@@ -0,0 +1,382 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/NibiruChain/nibiru/v2/app/server/config"
+)
+
+const (
+	// errCodeInvalidRequest is the JSON-RPC error code of invalid requests,
+	// such as batches with too many calls.
+	errCodeInvalidRequest = -32600
+	// errCodeLimitExceeded is the JSON-RPC error code of throttled calls,
+	// from EIP-1474.
+	errCodeLimitExceeded = -32005
+
+	// rateLimitIdleTimeout is the time after which the limiter of a client
+	// that made no request is dropped.
+	rateLimitIdleTimeout = 10 * time.Minute
+
+	// wsForwardHeader marks the calls that the WebSocket server forwards to
+	// the HTTP server. These calls are already limited by the WebSocket limits.
+	wsForwardHeader = "X-Nibiru-Ws-Forward"
+)
+
+// RateLimiter limits the JSON-RPC calls of each client of the HTTP and
+// WebSocket servers with token buckets of request credits, as configured by
+// [config.RateLimitConfig]. Clients with a known API key are keyed by it,
+// and the other clients by IP address.
+type RateLimiter struct {
+	cfg config.RateLimitConfig
+	// apiKeys maps the known API keys to their requests per second.
+	apiKeys map[string]float64
+	// weights maps lowercase method names to their cost.
+	weights map[string]int
+	// prefixWeights maps lowercase method name prefixes to their cost.
+	prefixWeights map[string]int
+	// trustedProxies are the IP ranges skipped in "X-Forwarded-For".
+	trustedProxies []netip.Prefix
+	// forwardToken is the value of the [wsForwardHeader] set by the
+	// WebSocket server. It is random so that clients can't forge it.
+	forwardToken string
+
+	// mu protects access to the clients and the time of the last sweep
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimitClient identifies a client and its limits.
+type rateLimitClient struct {
+	key   string
+	limit rate.Limit
+	burst int
+}
+
+// NewRateLimiter returns a RateLimiter with the given limits.
+func NewRateLimiter(cfg config.RateLimitConfig) (*RateLimiter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	apiKeys, err := cfg.ParseAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+	trustedProxies, err := cfg.ParseTrustedProxies()
+	if err != nil {
+		return nil, err
+	}
+	tokenBz := make([]byte, 32)
+	if _, err := rand.Read(tokenBz); err != nil {
+		return nil, err
+	}
+
+	l := &RateLimiter{
+		cfg:            cfg,
+		apiKeys:        apiKeys,
+		weights:        make(map[string]int),
+		prefixWeights:  make(map[string]int),
+		trustedProxies: trustedProxies,
+		forwardToken:   hex.EncodeToString(tokenBz),
+		clients:        make(map[string]*clientLimiter),
+		lastSweep:      time.Now(),
+	}
+	for method, weight := range cfg.MethodWeights {
+		method = strings.ToLower(method)
+		if prefix, ok := strings.CutSuffix(method, "*"); ok {
+			l.prefixWeights[prefix] = weight
+		} else {
+			l.weights[method] = weight
+		}
+	}
+	return l, nil
+}
+
+// weight returns the cost of a call to the method. An exact match takes
+// precedence over the longest matching prefix.
+func (l *RateLimiter) weight(method string) int {
+	method = strings.ToLower(method)
+	if weight, ok := l.weights[method]; ok {
+		return weight
+	}
+	weight, longestPrefix := 1, -1
+	for prefix, prefixWeight := range l.prefixWeights {
+		if strings.HasPrefix(method, prefix) && len(prefix) > longestPrefix {
+			weight, longestPrefix = prefixWeight, len(prefix)
+		}
+	}
+	return weight
+}
+
+// clientIP returns the IP address of the client of the request. Behind
+// trusted reverse proxies, it is the rightmost "X-Forwarded-For" entry that
+// isn't a trusted proxy: each proxy appends the address it received the
+// request from, while the entries on the left are set by the client.
+func (l *RateLimiter) clientIP(r *http.Request) string {
+	if l.cfg.TrustProxyHeaders {
+		forwardedFor := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+		for i := len(forwardedFor) - 1; i >= 0; i-- {
+			ip := strings.TrimSpace(forwardedFor[i])
+			if ip == "" {
+				continue
+			}
+			if i > 0 && l.isTrustedProxy(ip) {
+				continue
+			}
+			return ip
+		}
+		if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); realIP != "" {
+			return realIP
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// isTrustedProxy returns true if ip is in the trusted proxy ranges.
+func (l *RateLimiter) isTrustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, proxy := range l.trustedProxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// client returns the client of the request with its HTTP or WebSocket
+// limits. The limits of a known API key are the default ones scaled by its
+// quota.
+func (l *RateLimiter) client(r *http.Request, ws bool) rateLimitClient {
+	requestsPerSecond, burst, server := l.cfg.RequestsPerSecond, l.cfg.Burst, "http"
+	if ws {
+		requestsPerSecond, burst, server = l.cfg.WsRequestsPerSecond, l.cfg.WsBurst, "ws"
+	}
+
+	if l.cfg.APIKeyHeader != "" {
+		apiKey := r.Header.Get(l.cfg.APIKeyHeader)
+		if quota, ok := l.apiKeys[apiKey]; ok {
+			scale := quota / l.cfg.RequestsPerSecond
+			return rateLimitClient{
+				key:   server + "/key/" + apiKey,
+				limit: rate.Limit(requestsPerSecond * scale),
+				burst: max(1, int(math.Ceil(float64(burst)*scale))),
+			}
+		}
+	}
+	return rateLimitClient{
+		key:   server + "/ip/" + l.clientIP(r),
+		limit: rate.Limit(requestsPerSecond),
+		burst: burst,
+	}
+}
+
+// allow spends cost request credits of the client. If the client doesn't
+// have enough credits, nothing is spent and it returns how long until it
+// does. The cost must not exceed the burst of the client.
+func (l *RateLimiter) allow(client rateLimitClient, cost int) (retryAfter time.Duration, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) > rateLimitIdleTimeout {
+		for key, c := range l.clients {
+			if now.Sub(c.lastSeen) > rateLimitIdleTimeout {
+				delete(l.clients, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	c, found := l.clients[client.key]
+	if !found {
+		c = &clientLimiter{limiter: rate.NewLimiter(client.limit, client.burst)}
+		l.clients[client.key] = c
+	}
+	c.lastSeen = now
+
+	reservation := c.limiter.ReserveN(now, cost)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// limit charges the calls of a JSON-RPC message to the client. It returns
+// the error to reply with, instead of serving the message, when the message
+// exceeds the limits.
+func (l *RateLimiter) limit(client rateLimitClient, msg []byte) *rateLimitError {
+	batch := isBatch(msg)
+	calls := parseRPCCalls(msg)
+	if batch && l.cfg.MaxBatchSize > 0 && len(calls) > l.cfg.MaxBatchSize {
+		// Like go-ethereum, reply to a batch that is too large with a single
+		// error.
+		return &rateLimitError{
+			code:    errCodeInvalidRequest,
+			message: "batch too large",
+		}
+	}
+
+	cost := 0
+	for _, call := range calls {
+		cost += l.weight(call.Method)
+	}
+	cost = max(cost, 1)
+	if cost > client.burst {
+		// The client can never have enough credits, so retrying won't help.
+		return &rateLimitError{
+			code: errCodeInvalidRequest,
+			message: fmt.Sprintf(
+				"request costs %d credits, more than the burst of %d", cost, client.burst,
+			),
+			calls: calls,
+			batch: batch,
+		}
+	}
+	retryAfter, ok := l.allow(client, cost)
+	if ok {
+		return nil
+	}
+	return &rateLimitError{
+		code:       errCodeLimitExceeded,
+		message:    "request rate limit exceeded",
+		retryAfter: retryAfter,
+		calls:      calls,
+		batch:      batch,
+	}
+}
+
+// Middleware limits the JSON-RPC calls served by next. Throttled requests
+// get an HTTP 429 response with a "Retry-After" header and JSON-RPC errors.
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(wsForwardHeader) == l.forwardToken {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, ok := readRequestBody(w, r)
+		if !ok {
+			return
+		}
+
+		if limitErr := l.limit(l.client(r, false), body); limitErr != nil {
+			w.Header().Set("Content-Type", "application/json")
+			status := http.StatusOK
+			if limitErr.retryAfter > 0 {
+				retryAfterSecs := int(math.Ceil(limitErr.retryAfter.Seconds()))
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfterSecs))
+				status = http.StatusTooManyRequests
+			}
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(limitErr.response())
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// wsClient returns the client of a WebSocket connection from its upgrade
+// request. It is safe to call on a nil RateLimiter, which is the case when
+// rate limiting is disabled.
+func (l *RateLimiter) wsClient(r *http.Request) rateLimitClient {
+	if l == nil {
+		return rateLimitClient{}
+	}
+	return l.client(r, true)
+}
+
+// limitWs is [RateLimiter.limit] for the messages of a WebSocket connection.
+// It is safe to call on a nil RateLimiter.
+func (l *RateLimiter) limitWs(client rateLimitClient, msg []byte) *rateLimitError {
+	if l == nil {
+		return nil
+	}
+	return l.limit(client, msg)
+}
+
+// setWsForwardHeader marks a call forwarded by the WebSocket server to the
+// HTTP server, so that it isn't limited twice. It is safe to call on a nil
+// RateLimiter.
+func (l *RateLimiter) setWsForwardHeader(req *http.Request) {
+	if l == nil {
+		return
+	}
+	req.Header.Set(wsForwardHeader, l.forwardToken)
+}
+
+// rateLimitError is the JSON-RPC error of a message that exceeds the limits.
+type rateLimitError struct {
+	code       int
+	message    string
+	retryAfter time.Duration
+	// calls of the message, answered with one error each
+	calls []rpcCall
+	batch bool
+}
+
+type jsonrpcErrorResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonrpcError    `json:"error"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// response returns the JSON-RPC response of the error: an array of errors
+// for a batch, and a single error otherwise.
+func (e *rateLimitError) response() any {
+	newResponse := func(id json.RawMessage) jsonrpcErrorResponse {
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		return jsonrpcErrorResponse{
+			Jsonrpc: "2.0",
+			ID:      id,
+			Error:   jsonrpcError{Code: e.code, Message: e.message},
+		}
+	}
+	if !e.batch {
+		var id json.RawMessage
+		if len(e.calls) == 1 {
+			id = e.calls[0].ID
+		}
+		return newResponse(id)
+	}
+	responses := make([]jsonrpcErrorResponse, len(e.calls))
+	for i, call := range e.calls {
+		responses[i] = newResponse(call.ID)
+	}
+	return responses
+}
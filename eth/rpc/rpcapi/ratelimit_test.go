@@ -0,0 +1,145 @@
+package rpcapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
+)
+
+func TestRateLimiter(t *testing.T) {
+	cfg := *config.DefaultRateLimitConfig()
+	cfg.Enable = true
+	cfg.RequestsPerSecond = 0.001 // credits are not regained during the test
+	cfg.Burst = 3
+	cfg.MaxBatchSize = 2
+	cfg.APIKeyHeader = "X-API-Key"
+	cfg.APIKeys = []string{"vip:0.01"}
+	cfg.MethodWeights = map[string]int{"eth_getLogs": 2, "debug_trace*": 3}
+	limiter, err := rpcapi.NewRateLimiter(cfg)
+	require.NoError(t, err)
+
+	served := 0
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}))
+	call := func(method string) string {
+		return `{"jsonrpc":"2.0","id":7,"method":"` + method + `","params":[]}`
+	}
+	post := func(ip, body string, headers ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = ip + ":1234"
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	type errorResponse struct {
+		ID    json.RawMessage `json:"id"`
+		Error struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+
+	t.Log("Each client has its own burst of credits")
+	for range 3 {
+		require.Equal(t, http.StatusOK, post("10.0.0.1", call("web3_clientVersion")).Code)
+	}
+	rec := post("10.0.0.1", call("web3_clientVersion"))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("Retry-After"))
+	var resp errorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, -32005, resp.Error.Code)
+	require.Equal(t, "7", string(resp.ID))
+	require.Equal(t, 3, served)
+
+	t.Log("Proxy headers are ignored unless trusted")
+	rec = post("10.0.0.1", call("web3_clientVersion"), "X-Forwarded-For", "10.0.0.9")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	t.Log("Methods cost their weights, matched case-insensitively or by prefix")
+	require.Equal(t, http.StatusOK, post("10.0.0.2", call("eth_getLogs")).Code)
+	require.Equal(t, http.StatusTooManyRequests, post("10.0.0.2", call("debug_traceTransaction")).Code)
+	require.Equal(t, http.StatusOK, post("10.0.0.3", call("debug_traceTransaction")).Code)
+
+	t.Log("Batches cost the sum of their calls and are capped in size")
+	rec = post("10.0.0.2", `[`+call("eth_getLogs")+`,`+call("net_version")+`]`)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	var batchResp []errorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &batchResp))
+	require.Len(t, batchResp, 2)
+	require.Equal(t, -32005, batchResp[1].Error.Code)
+
+	t.Log("Batches that cost more than the burst are rejected, not capped")
+	servedBefore := served
+	rec = post("10.0.0.4", `[`+call("eth_getLogs")+`,`+call("eth_getLogs")+`]`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Retry-After"))
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &batchResp))
+	require.Len(t, batchResp, 2)
+	require.Equal(t, -32600, batchResp[0].Error.Code)
+	require.Equal(t, servedBefore, served)
+	require.Equal(t, http.StatusOK, post("10.0.0.4", call("debug_traceTransaction")).Code)
+
+	rec = post("10.0.0.5", `[`+strings.Repeat(call("net_version")+`,`, 2)+call("net_version")+`]`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, -32600, resp.Error.Code)
+	require.Equal(t, "null", string(resp.ID))
+
+	t.Log("Bodies above the size limit are rejected before they are limited")
+	rec = post("10.0.0.6", call(strings.Repeat("a", rpcapi.HTTPBodyLimit)))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	t.Log("Known API keys have their own quota, unknown ones are keyed by IP")
+	for range 30 {
+		require.Equal(t, http.StatusOK, post("10.0.0.1", call("net_version"), "X-API-Key", "vip").Code)
+	}
+	require.Equal(t, http.StatusTooManyRequests, post("10.0.0.1", call("net_version"), "X-API-Key", "vip").Code)
+	require.Equal(t, http.StatusTooManyRequests, post("10.0.0.1", call("net_version"), "X-API-Key", "other").Code)
+
+	t.Log("Behind trusted proxies, clients are keyed by the rightmost untrusted X-Forwarded-For entry")
+	proxyCfg := cfg
+	proxyCfg.TrustProxyHeaders = true
+	proxyCfg.TrustedProxies = []string{"10.1.0.0/16", "192.168.0.1"}
+	proxyLimiter, err := rpcapi.NewRateLimiter(proxyCfg)
+	require.NoError(t, err)
+	handler = proxyLimiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	for i := range 3 {
+		// Spoofed entries on the left don't give the client a new bucket
+		spoofed := fmt.Sprintf("1.1.1.%d, 203.0.113.7, 10.1.2.3", i)
+		require.Equal(t, http.StatusOK, post("192.168.0.1", call("net_version"), "X-Forwarded-For", spoofed).Code)
+	}
+	require.Equal(t, http.StatusTooManyRequests,
+		post("192.168.0.1", call("net_version"), "X-Forwarded-For", "203.0.113.7").Code)
+	require.Equal(t, http.StatusOK,
+		post("192.168.0.1", call("net_version"), "X-Forwarded-For", "203.0.113.8").Code)
+
+	proxyCfg.TrustedProxies = []string{"not-an-ip"}
+	_, err = rpcapi.NewRateLimiter(proxyCfg)
+	require.ErrorContains(t, err, "trusted proxy")
+
+	t.Log("Batch sizes must be capped")
+	proxyCfg.TrustedProxies = nil
+	proxyCfg.MaxBatchSize = 0
+	_, err = rpcapi.NewRateLimiter(proxyCfg)
+	require.ErrorContains(t, err, "max batch size must be at least 1")
+
+	t.Log("Invalid API key quotas are rejected")
+	cfg.APIKeys = []string{"no-quota"}
+	_, err = rpcapi.NewRateLimiter(cfg)
+	require.ErrorContains(t, err, "not in the form")
+}
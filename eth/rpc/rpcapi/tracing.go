@@ -2,14 +2,24 @@
 package rpcapi
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/rlp"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
@@ -150,6 +160,14 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 		return []*evm.TxTraceResult{}, nil
 	}
 
+	txsMessages := b.ethMsgsInBlock(block)
+	return b.traceEthMsgsInBlock(height, config, block, txsMessages)
+}
+
+// ethMsgsInBlock returns the Ethereum tx messages of the block in the order
+// in which they were executed.
+func (b *Backend) ethMsgsInBlock(block *tmrpctypes.ResultBlock) []*evm.MsgEthereumTx {
+	txs := block.Block.Txs
 	txDecoder := b.clientCtx.TxConfig.TxDecoder()
 
 	var txsMessages []*evm.MsgEthereumTx
@@ -169,10 +187,148 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 			txsMessages = append(txsMessages, ethMessage)
 		}
 	}
+	return txsMessages
+}
 
-	// minus one to get the context at the beginning of the block
-	contextHeight := max(height-1, 1) // 0 is a special value for `ContextWithHeight`.
-	ctxWithHeight := rpc.NewContextWithHeight(int64(contextHeight))
+// StandardTraceBlockToFile traces the Ethereum txs of the block with the
+// "struct" tracer and dumps the trace of each tx to a new file, with one JSON
+// object per opcode and a last line with the result of the tx, like the
+// standard JSON tracer of geth. If "config.TxHash" is set, only the trace of
+// that tx is dumped. Returns the paths of the files.
+//
+// The files are written to the "json-rpc.trace-file-dir" directory of the
+// node, and the method is disabled unless "json-rpc.enable-trace-to-file" is
+// set, since it writes to the local file system.
+func (b *Backend) StandardTraceBlockToFile(
+	block *tmrpctypes.ResultBlock,
+	config *tracers.StdTraceConfig,
+) ([]string, error) {
+	if !b.cfg.JSONRPC.EnableTraceToFile {
+		return nil, pkgerrors.New(
+			"tracing to files is disabled, see the \"json-rpc.enable-trace-to-file\" config option",
+		)
+	}
+	if block.Block.Height == 0 {
+		return nil, pkgerrors.New("genesis is not traceable")
+	}
+
+	traceConfig := &evm.TraceConfig{Tracer: evm.TracerStruct}
+	var txHash gethcommon.Hash
+	if config != nil {
+		traceConfig.DisableStack = config.DisableStack
+		traceConfig.DisableStorage = config.DisableStorage
+		traceConfig.EnableMemory = config.EnableMemory
+		traceConfig.EnableReturnData = config.EnableReturnData
+		if config.Limit < 0 || config.Limit > math.MaxInt32 {
+			return nil, fmt.Errorf("limit %d must be between 0 and %d", config.Limit, math.MaxInt32)
+		}
+		traceConfig.Limit = int32(config.Limit) // #nosec G701 -- checked for int overflow already
+		txHash = config.TxHash
+	}
+
+	txsMessages := b.ethMsgsInBlock(block)
+	if txHash != (gethcommon.Hash{}) && !slices.ContainsFunc(
+		txsMessages, func(msg *evm.MsgEthereumTx) bool {
+			return msg.AsTransaction().Hash() == txHash
+		},
+	) {
+		return nil, fmt.Errorf("transaction %#x not found in block", txHash)
+	}
+
+	results, err := b.traceEthMsgsInBlock(
+		rpc.BlockNumber(block.Block.Height), traceConfig, block, txsMessages,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := b.traceFileDir()
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	var dumps []string
+	for i, msg := range txsMessages {
+		ethTxHash := msg.AsTransaction().Hash()
+		if txHash != (gethcommon.Hash{}) && ethTxHash != txHash {
+			continue
+		}
+		pattern := fmt.Sprintf(
+			"block_%#x-%d-%#x-*.jsonl", block.BlockID.Hash[:4], i, ethTxHash.Bytes()[:4],
+		)
+		dump, err := writeStructTraceToFile(dir, pattern, results[i])
+		if err != nil {
+			return dumps, err
+		}
+		dumps = append(dumps, dump)
+	}
+	return dumps, nil
+}
+
+// writeStructTraceToFile writes the result of the "struct" tracer to a new
+// file in the directory, with one line per struct log and a last line with
+// the other fields of the result. Returns the path of the file.
+func writeStructTraceToFile(
+	dir, pattern string, traceResult *evm.TxTraceResult,
+) (string, error) {
+	result := make(map[string]json.RawMessage)
+	if traceResult.Result != nil {
+		resultJSON, err := json.Marshal(traceResult.Result)
+		if err != nil {
+			return "", err
+		}
+		if err := json.Unmarshal(resultJSON, &result); err != nil {
+			return "", err
+		}
+	}
+	var structLogs []json.RawMessage
+	if structLogsJSON, ok := result["structLogs"]; ok {
+		if err := json.Unmarshal(structLogsJSON, &structLogs); err != nil {
+			return "", err
+		}
+		delete(result, "structLogs")
+	}
+	if traceResult.Error != "" {
+		errJSON, err := json.Marshal(traceResult.Error)
+		if err != nil {
+			return "", err
+		}
+		result["error"] = errJSON
+	}
+
+	dump, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return "", err
+	}
+	defer dump.Close()
+
+	writer := bufio.NewWriter(dump)
+	for _, structLog := range structLogs {
+		if _, err := fmt.Fprintf(writer, "%s\n", structLog); err != nil {
+			return dump.Name(), err
+		}
+	}
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return dump.Name(), err
+	}
+	if _, err := fmt.Fprintf(writer, "%s\n", resultJSON); err != nil {
+		return dump.Name(), err
+	}
+	return dump.Name(), writer.Flush()
+}
+
+// traceEthMsgsInBlock runs the "TraceBlock" query on the given Ethereum tx
+// messages of the block. The return value has one item per message.
+func (b *Backend) traceEthMsgsInBlock(
+	height rpc.BlockNumber,
+	config *evm.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+	txsMessages []*evm.MsgEthereumTx,
+) ([]*evm.TxTraceResult, error) {
+	if len(txsMessages) == 0 {
+		return []*evm.TxTraceResult{}, nil
+	}
 
 	nc, ok := b.clientCtx.Client.(cmtrpcclient.NetworkClient)
 	if !ok {
@@ -195,12 +351,25 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
 	}
 
+	return b.traceBlockRequest(height, traceBlockRequest)
+}
+
+// traceBlockRequest runs the "TraceBlock" query on top of the state at the
+// beginning of the block at the given height.
+func (b *Backend) traceBlockRequest(
+	height rpc.BlockNumber,
+	traceBlockRequest *evm.QueryTraceBlockRequest,
+) ([]*evm.TxTraceResult, error) {
+	// minus one to get the context at the beginning of the block
+	contextHeight := max(height-1, 1) // 0 is a special value for `ContextWithHeight`.
+	ctxWithHeight := rpc.NewContextWithHeight(int64(contextHeight))
+
 	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
 	if err != nil {
 		return nil, err
 	}
 
-	decodedResults := make([]*evm.TxTraceResult, txsLength)
+	decodedResults := make([]*evm.TxTraceResult, len(traceBlockRequest.Txs))
 	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
 		return nil, err
 	}
@@ -208,6 +377,125 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 	return decodedResults, nil
 }
 
+// traceFileDir returns the "json-rpc.trace-file-dir" directory of the node,
+// or the temp directory of the OS if it's not set.
+func (b *Backend) traceFileDir() string {
+	if b.cfg.JSONRPC.TraceFileDir == "" {
+		return os.TempDir()
+	}
+	return b.cfg.JSONRPC.TraceFileDir
+}
+
+// TraceBlockFromFile traces the Ethereum txs of the RLP-encoded block in the
+// file, like "TraceRawBlock". Relative paths are resolved against the
+// "json-rpc.trace-file-dir" directory of the node, and files outside of it
+// can't be read, including through symlinks. The method is disabled unless
+// "json-rpc.enable-trace-to-file" is set, since it reads from the local file
+// system.
+func (b *Backend) TraceBlockFromFile(
+	file string, config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	if !b.cfg.JSONRPC.EnableTraceToFile {
+		return nil, pkgerrors.New(
+			"tracing from files is disabled, see the \"json-rpc.enable-trace-to-file\" config option",
+		)
+	}
+
+	dir, err := filepath.Abs(b.traceFileDir())
+	if err != nil {
+		return nil, err
+	}
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(dir, file)
+	}
+	file = filepath.Clean(file)
+	if !strings.HasPrefix(file, dir+string(filepath.Separator)) {
+		return nil, fmt.Errorf(
+			"file %q is outside of the \"json-rpc.trace-file-dir\" directory", file,
+		)
+	}
+
+	relFile, err := filepath.Rel(dir, file)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reading through an [os.Root] keeps symlinks from escaping the directory
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the trace file dir: %w", err)
+	}
+	defer root.Close()
+	f, err := root.Open(relFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file: %w", err)
+	}
+	defer f.Close()
+	blob, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file: %w", err)
+	}
+	return b.TraceRawBlock(blob, config)
+}
+
+// TraceRawBlock traces the Ethereum txs of the RLP-encoded block on top of
+// the state of its parent block, like "TraceBlock". The block doesn't need to
+// be part of the chain, so candidate blocks can be replayed to debug
+// consensus divergences and gas usage.
+func (b *Backend) TraceRawBlock(
+	blockRlp []byte, config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	block := new(gethcore.Block)
+	if err := rlp.DecodeBytes(blockRlp, block); err != nil {
+		return nil, fmt.Errorf("could not decode block: %w", err)
+	}
+	if block.NumberU64() == 0 {
+		return nil, pkgerrors.New("genesis is not traceable")
+	}
+	if block.NumberU64() > math.MaxInt64 {
+		return nil, fmt.Errorf("block number %d is overflowing", block.NumberU64())
+	}
+	height := int64(block.NumberU64()) // #nosec G701 -- checked for int overflow already
+
+	txsMessages := make([]*evm.MsgEthereumTx, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		txsMessages[i] = new(evm.MsgEthereumTx)
+		if err := txsMessages[i].FromEthereumTx(tx); err != nil {
+			return nil, fmt.Errorf("invalid tx %s: %w", tx.Hash().Hex(), err)
+		}
+	}
+	if len(txsMessages) == 0 {
+		return []*evm.TxTraceResult{}, nil
+	}
+
+	// Blocks of the chain have no gas limit in their header, so they take the
+	// one of the consensus params at the parent block.
+	blockMaxGas := block.GasLimit()
+	if blockMaxGas == 0 {
+		parentHeight := max(height-1, 1)
+		maxGas, err := rpc.BlockMaxGasFromConsensusParams(b.ctx, b.clientCtx, parentHeight)
+		if err != nil {
+			return nil, err
+		}
+		blockMaxGas = uint64(maxGas) // #nosec G701 -- the max gas is positive
+	}
+	if blockMaxGas > math.MaxInt64 {
+		return nil, fmt.Errorf("block gas limit %d is overflowing", blockMaxGas)
+	}
+
+	traceBlockRequest := &evm.QueryTraceBlockRequest{
+		Txs:             txsMessages,
+		TraceConfig:     config,
+		BlockNumber:     height,
+		BlockTime:       time.Unix(int64(block.Time()), 0).UTC(), // #nosec G701 -- block times fit in int64
+		BlockHash:       gethcommon.Bytes2Hex(block.Hash().Bytes()),
+		ProposerAddress: sdk.ConsAddress(block.Coinbase().Bytes()),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     int64(blockMaxGas), // #nosec G701 -- checked for int overflow already
+	}
+	return b.traceBlockRequest(rpc.BlockNumber(height), traceBlockRequest)
+}
+
 // TraceCall implements eth debug_traceCall method which lets you run an eth_call
 // within the context of the given block execution using the final state of parent block as the base.
 // Method returns the structured logs created during the execution of EVM.
@@ -0,0 +1,581 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// Trace types of "trace_replayBlockTransactions".
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVmTrace   = "vmTrace"
+)
+
+// ParityTrace is a call trace in the flat format of the Parity (OpenEthereum)
+// "trace_*" methods: one item per call frame, ordered depth-first, where
+// "traceAddress" is the path of the frame in the call tree.
+type ParityTrace struct {
+	Action ParityTraceAction `json:"action"`
+	// BlockHash, BlockNumber, TransactionHash and TransactionPosition are
+	// omitted by "trace_replayBlockTransactions", which reports them per tx.
+	BlockHash           *gethcommon.Hash   `json:"blockHash,omitempty"`
+	BlockNumber         *uint64            `json:"blockNumber,omitempty"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     *gethcommon.Hash   `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64            `json:"transactionPosition,omitempty"`
+	// Type: "call", "create", or "suicide".
+	Type string `json:"type"`
+}
+
+// ParityTraceAction is the action of a [ParityTrace]. Calls set CallType,
+// From, To, Gas, Input, and Value. Contract creations set CreationMethod,
+// From, Gas, Init, and Value. Self-destructs set Address, RefundAddress, and
+// Balance.
+type ParityTraceAction struct {
+	CallType       string              `json:"callType,omitempty"`
+	CreationMethod string              `json:"creationMethod,omitempty"`
+	From           *gethcommon.Address `json:"from,omitempty"`
+	To             *gethcommon.Address `json:"to,omitempty"`
+	Gas            *hexutil.Uint64     `json:"gas,omitempty"`
+	Input          *hexutil.Bytes      `json:"input,omitempty"`
+	Init           *hexutil.Bytes      `json:"init,omitempty"`
+	Value          *hexutil.Big        `json:"value,omitempty"`
+	Address        *gethcommon.Address `json:"address,omitempty"`
+	RefundAddress  *gethcommon.Address `json:"refundAddress,omitempty"`
+	Balance        *hexutil.Big        `json:"balance,omitempty"`
+}
+
+// ParityTraceResult is the result of a [ParityTrace]: the output of a call,
+// or the address and code of a created contract.
+type ParityTraceResult struct {
+	GasUsed hexutil.Uint64      `json:"gasUsed"`
+	Output  *hexutil.Bytes      `json:"output,omitempty"`
+	Address *gethcommon.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes      `json:"code,omitempty"`
+}
+
+// ParityTraceResults is the replay of a tx by "trace_replayBlockTransactions".
+// Trace and StateDiff are only set when requested by the trace types.
+type ParityTraceResults struct {
+	Output          hexutil.Bytes   `json:"output"`
+	StateDiff       ParityStateDiff `json:"stateDiff"`
+	Trace           []*ParityTrace  `json:"trace"`
+	TransactionHash gethcommon.Hash `json:"transactionHash"`
+	VmTrace         *struct{}       `json:"vmTrace"`
+}
+
+// ParityStateDiff maps the accounts modified by a tx to their changes.
+type ParityStateDiff map[gethcommon.Address]*ParityAccountDiff
+
+// ParityAccountDiff holds the changes of the fields of an account. Each
+// field is "=" if unchanged, {"+": value} if the account is created,
+// {"-": value} if it is destroyed, and {"*": {"from": old, "to": new}} if
+// the value is modified.
+type ParityAccountDiff struct {
+	Balance any                     `json:"balance"`
+	Nonce   any                     `json:"nonce"`
+	Code    any                     `json:"code"`
+	Storage map[gethcommon.Hash]any `json:"storage"`
+}
+
+// TraceFilterArgs are the arguments of "trace_filter". Traces match if they
+// are sent from one of FromAddress and to one of ToAddress, where empty lists
+// match any address. The first After matching traces are skipped, and at
+// most Count traces are returned.
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber     `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber     `json:"toBlock"`
+	FromAddress []gethcommon.Address `json:"fromAddress"`
+	ToAddress   []gethcommon.Address `json:"toAddress"`
+	After       *uint64              `json:"after"`
+	Count       *uint64              `json:"count"`
+}
+
+// callFrame is a call frame of the output of the geth "callTracer".
+type callFrame struct {
+	Type    string              `json:"type"`
+	From    gethcommon.Address  `json:"from"`
+	To      *gethcommon.Address `json:"to"`
+	Value   *hexutil.Big        `json:"value"`
+	Gas     hexutil.Uint64      `json:"gas"`
+	GasUsed hexutil.Uint64      `json:"gasUsed"`
+	Input   hexutil.Bytes       `json:"input"`
+	Output  hexutil.Bytes       `json:"output"`
+	Error   string              `json:"error"`
+	Calls   []callFrame         `json:"calls"`
+}
+
+// prestateAccount is an account of the output of the geth "prestateTracer".
+type prestateAccount struct {
+	Balance *hexutil.Big                        `json:"balance"`
+	Nonce   *uint64                             `json:"nonce"`
+	Code    *hexutil.Bytes                      `json:"code"`
+	Storage map[gethcommon.Hash]gethcommon.Hash `json:"storage"`
+}
+
+// prestateDiff is the output of the geth "prestateTracer" in diff mode.
+type prestateDiff struct {
+	Pre  map[gethcommon.Address]*prestateAccount `json:"pre"`
+	Post map[gethcommon.Address]*prestateAccount `json:"post"`
+}
+
+// parityErrors maps the EVM errors of the "callTracer" to the errors reported
+// by Parity.
+var parityErrors = map[string]string{
+	vm.ErrExecutionReverted.Error():        "Reverted",
+	vm.ErrOutOfGas.Error():                 "Out of gas",
+	vm.ErrInvalidJump.Error():              "Bad jump destination",
+	vm.ErrWriteProtection.Error():          "Mutable Call In Static Context",
+	vm.ErrDepth.Error():                    "Out of stack",
+	vm.ErrInsufficientBalance.Error():      "Insufficient balance for transfer",
+	vm.ErrContractAddressCollision.Error(): "Contract address collision",
+	vm.ErrCodeStoreOutOfGas.Error():        "Out of gas",
+}
+
+// ParityTracesFromCallTrace flattens the output of the geth "callTracer" for
+// a tx into Parity traces, ordered depth-first.
+func ParityTracesFromCallTrace(callTrace json.RawMessage) ([]*ParityTrace, error) {
+	var root callFrame
+	if err := json.Unmarshal(callTrace, &root); err != nil {
+		return nil, fmt.Errorf("failed to decode call trace: %w", err)
+	}
+	var traces []*ParityTrace
+	var flatten func(frame *callFrame, traceAddress []int)
+	flatten = func(frame *callFrame, traceAddress []int) {
+		traces = append(traces, parityTraceFromCallFrame(frame, traceAddress))
+		for i := range frame.Calls {
+			childAddress := append(append([]int{}, traceAddress...), i)
+			flatten(&frame.Calls[i], childAddress)
+		}
+	}
+	flatten(&root, []int{})
+	return traces, nil
+}
+
+func parityTraceFromCallFrame(frame *callFrame, traceAddress []int) *ParityTrace {
+	value := frame.Value
+	if value == nil {
+		value = (*hexutil.Big)(new(big.Int))
+	}
+	trace := &ParityTrace{
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+	from, gas := frame.From, frame.Gas
+	result := &ParityTraceResult{GasUsed: frame.GasUsed}
+
+	switch callType := strings.ToUpper(frame.Type); callType {
+	case vm.CREATE.String(), vm.CREATE2.String():
+		init, code := frame.Input, frame.Output
+		trace.Type = "create"
+		trace.Action = ParityTraceAction{
+			CreationMethod: strings.ToLower(callType),
+			From:           &from,
+			Gas:            &gas,
+			Init:           &init,
+			Value:          value,
+		}
+		result.Address = frame.To
+		result.Code = &code
+	case vm.SELFDESTRUCT.String():
+		trace.Type = "suicide"
+		trace.Action = ParityTraceAction{
+			Address:       &from,
+			RefundAddress: frame.To,
+			Balance:       value,
+		}
+		result = nil
+	default:
+		input, output := frame.Input, frame.Output
+		trace.Type = "call"
+		trace.Action = ParityTraceAction{
+			CallType: strings.ToLower(callType),
+			From:     &from,
+			To:       frame.To,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    value,
+		}
+		result.Output = &output
+	}
+
+	if frame.Error != "" {
+		trace.Error = frame.Error
+		if parityError, ok := parityErrors[frame.Error]; ok {
+			trace.Error = parityError
+		}
+		result = nil
+	}
+	trace.Result = result
+	return trace
+}
+
+// ParityStateDiffFromPrestate converts the output of the geth
+// "prestateTracer" in diff mode for a tx into a Parity state diff.
+func ParityStateDiffFromPrestate(prestate json.RawMessage) (ParityStateDiff, error) {
+	var diff prestateDiff
+	if err := json.Unmarshal(prestate, &diff); err != nil {
+		return nil, fmt.Errorf("failed to decode prestate diff: %w", err)
+	}
+
+	stateDiff := make(ParityStateDiff)
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			// Accounts only in the pre state were destroyed.
+			stateDiff[addr] = accountDiff(pre, nil)
+			continue
+		}
+		stateDiff[addr] = accountDiff(pre, post)
+	}
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; !ok {
+			// Accounts only in the post state were created.
+			stateDiff[addr] = accountDiff(nil, post)
+		}
+	}
+	return stateDiff, nil
+}
+
+// accountDiff returns the diff of an account from its pre and post states,
+// where a nil pre state means the account is created, and a nil post state
+// means it is destroyed. As in diff mode, the fields of the post state are
+// only set if they changed.
+func accountDiff(pre, post *prestateAccount) *ParityAccountDiff {
+	switch {
+	case pre == nil:
+		diff := &ParityAccountDiff{
+			Balance: bornDiff(post.Balance, new(hexutil.Big)),
+			Nonce:   bornDiff(hexUint64Ptr(post.Nonce), new(hexutil.Uint64)),
+			Code:    bornDiff(post.Code, new(hexutil.Bytes)),
+			Storage: make(map[gethcommon.Hash]any),
+		}
+		for key, value := range post.Storage {
+			diff.Storage[key] = map[string]any{"+": value}
+		}
+		return diff
+	case post == nil:
+		diff := &ParityAccountDiff{
+			Balance: diedDiff(pre.Balance, new(hexutil.Big)),
+			Nonce:   diedDiff(hexUint64Ptr(pre.Nonce), new(hexutil.Uint64)),
+			Code:    diedDiff(pre.Code, new(hexutil.Bytes)),
+			Storage: make(map[gethcommon.Hash]any),
+		}
+		for key, value := range pre.Storage {
+			diff.Storage[key] = map[string]any{"-": value}
+		}
+		return diff
+	}
+
+	diff := &ParityAccountDiff{
+		Balance: "=",
+		Nonce:   "=",
+		Code:    "=",
+		Storage: make(map[gethcommon.Hash]any),
+	}
+	if post.Balance != nil {
+		diff.Balance = changedDiff(valueOr(pre.Balance, new(hexutil.Big)), post.Balance)
+	}
+	if post.Nonce != nil {
+		diff.Nonce = changedDiff(valueOr(hexUint64Ptr(pre.Nonce), new(hexutil.Uint64)), hexUint64Ptr(post.Nonce))
+	}
+	if post.Code != nil {
+		diff.Code = changedDiff(valueOr(pre.Code, new(hexutil.Bytes)), post.Code)
+	}
+	// The pre state only keeps the modified slots. Slots cleared by the tx
+	// are missing from the post state.
+	for key, preValue := range pre.Storage {
+		diff.Storage[key] = changedDiff(preValue, post.Storage[key])
+	}
+	for key, postValue := range post.Storage {
+		if _, ok := pre.Storage[key]; !ok {
+			diff.Storage[key] = changedDiff(gethcommon.Hash{}, postValue)
+		}
+	}
+	return diff
+}
+
+func bornDiff[T any](value *T, zero *T) map[string]any {
+	return map[string]any{"+": valueOr(value, zero)}
+}
+
+func diedDiff[T any](value *T, zero *T) map[string]any {
+	return map[string]any{"-": valueOr(value, zero)}
+}
+
+func changedDiff(from, to any) map[string]any {
+	return map[string]any{"*": map[string]any{"from": from, "to": to}}
+}
+
+func valueOr[T any](value *T, fallback *T) *T {
+	if value == nil {
+		return fallback
+	}
+	return value
+}
+
+func hexUint64Ptr(value *uint64) *hexutil.Uint64 {
+	if value == nil {
+		return nil
+	}
+	return (*hexutil.Uint64)(value)
+}
+
+// parityTraceEthMsgs traces the Ethereum txs of the block that are included
+// in the EVM, in the same order as "eth_getBlockByNumber", with the given
+// geth tracer.
+func (b *Backend) parityTraceEthMsgs(
+	resBlock *tmrpctypes.ResultBlock, tracer string, tracerConfig *evm.TracerConfig,
+) ([]*evm.MsgEthereumTx, []json.RawMessage, error) {
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, nil, err
+	}
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	results, err := b.traceEthMsgsInBlock(
+		rpc.BlockNumber(height),
+		&evm.TraceConfig{Tracer: tracer, TracerConfig: tracerConfig},
+		resBlock,
+		msgs,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, nil, fmt.Errorf(
+			"traced %d of the %d txs of block %d", len(results), len(msgs), height,
+		)
+	}
+
+	traces := make([]json.RawMessage, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, nil, fmt.Errorf("failed to trace tx %s: %s", msgs[i].Hash, result.Error)
+		}
+		if traces[i], err = json.Marshal(result.Result); err != nil {
+			return nil, nil, err
+		}
+	}
+	return msgs, traces, nil
+}
+
+// ParityTraceBlock returns the Parity traces of all of the Ethereum txs of
+// the block.
+func (b *Backend) ParityTraceBlock(resBlock *tmrpctypes.ResultBlock) ([]*ParityTrace, error) {
+	msgs, callTraces, err := b.parityTraceEthMsgs(resBlock, "callTracer", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := gethcommon.BytesToHash(resBlock.Block.Hash())
+	blockNumber := uint64(resBlock.Block.Height) // #nosec G701 -- checked for int overflow already
+	traces := []*ParityTrace{}
+	for i, callTrace := range callTraces {
+		txTraces, err := ParityTracesFromCallTrace(callTrace)
+		if err != nil {
+			return nil, err
+		}
+		txHash := gethcommon.HexToHash(msgs[i].Hash)
+		txPosition := uint64(i)
+		for _, trace := range txTraces {
+			trace.BlockHash = &blockHash
+			trace.BlockNumber = &blockNumber
+			trace.TransactionHash = &txHash
+			trace.TransactionPosition = &txPosition
+		}
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// ParityTraceTransaction returns the Parity traces of the Ethereum tx.
+func (b *Backend) ParityTraceTransaction(hash gethcommon.Hash) ([]*ParityTrace, error) {
+	tx, err := b.GetTransactionByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil || tx.BlockHash == nil || tx.TransactionIndex == nil {
+		// Like Parity, resolve to nil for pending txs.
+		return nil, nil
+	}
+
+	callTrace, err := b.TraceTransaction(hash, &evm.TraceConfig{Tracer: "callTracer"})
+	if err != nil {
+		return nil, err
+	}
+	traces, err := ParityTracesFromCallTrace(callTrace)
+	if err != nil {
+		return nil, err
+	}
+	blockNumber := tx.BlockNumber.ToInt().Uint64()
+	txPosition := uint64(*tx.TransactionIndex)
+	for _, trace := range traces {
+		trace.BlockHash = tx.BlockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &hash
+		trace.TransactionPosition = &txPosition
+	}
+	return traces, nil
+}
+
+// ParityReplayBlock replays the Ethereum txs of the block and returns, for
+// each tx, its output and the results of the given trace types:
+// [TraceTypeTrace] and [TraceTypeStateDiff]. [TraceTypeVmTrace] is not
+// supported.
+func (b *Backend) ParityReplayBlock(
+	resBlock *tmrpctypes.ResultBlock, traceTypes []string,
+) ([]*ParityTraceResults, error) {
+	var withTrace, withStateDiff bool
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace:
+			withTrace = true
+		case TraceTypeStateDiff:
+			withStateDiff = true
+		case TraceTypeVmTrace:
+			return nil, fmt.Errorf("trace type %q is not supported", traceType)
+		default:
+			return nil, fmt.Errorf("invalid trace type %q", traceType)
+		}
+	}
+
+	msgs, callTraces, err := b.parityTraceEthMsgs(resBlock, "callTracer", nil)
+	if err != nil {
+		return nil, err
+	}
+	var prestates []json.RawMessage
+	if withStateDiff {
+		_, prestates, err = b.parityTraceEthMsgs(
+			resBlock, "prestateTracer", &evm.TracerConfig{DiffMode: true},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*ParityTraceResults, len(msgs))
+	for i, callTrace := range callTraces {
+		traces, err := ParityTracesFromCallTrace(callTrace)
+		if err != nil {
+			return nil, err
+		}
+		result := &ParityTraceResults{
+			Output:          hexutil.Bytes{},
+			TransactionHash: gethcommon.HexToHash(msgs[i].Hash),
+		}
+		if output := traces[0].Result; output != nil && output.Output != nil {
+			result.Output = *output.Output
+		}
+		if withTrace {
+			result.Trace = traces
+		}
+		if withStateDiff {
+			if result.StateDiff, err = ParityStateDiffFromPrestate(prestates[i]); err != nil {
+				return nil, err
+			}
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// ParityTraceFilter returns the Parity traces of the blocks in the range of
+// the filter that match its addresses. The range can't exceed the
+// "block-range-cap" of the JSON-RPC config.
+func (b *Backend) ParityTraceFilter(args TraceFilterArgs) ([]*ParityTrace, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	resolve := func(blockNum *rpc.BlockNumber) int64 {
+		if blockNum == nil || blockNum.Int64() < 0 {
+			// latest and pending
+			return int64(latest) // #nosec G701 -- checked for int overflow already
+		}
+		return blockNum.Int64()
+	}
+	fromBlock, toBlock := max(resolve(args.FromBlock), 1), resolve(args.ToBlock)
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("invalid block range: fromBlock %d is after toBlock %d", fromBlock, toBlock)
+	}
+	blockLimit := int64(b.RPCBlockRangeCap())
+	if toBlock-fromBlock > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var after, count uint64 = 0, 0
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	traces := []*ParityTrace{}
+	for height := fromBlock; height <= toBlock; height++ {
+		resBlock, err := b.TendermintBlockByNumber(rpc.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if len(resBlock.Block.Txs) == 0 {
+			continue
+		}
+		blockTraces, err := b.ParityTraceBlock(resBlock)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range blockTraces {
+			if !args.matches(trace) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// matches returns true if the trace matches the addresses of the filter. The
+// sender of a self-destruct is the destroyed contract, and the recipient of
+// a contract creation is the created contract.
+func (args TraceFilterArgs) matches(trace *ParityTrace) bool {
+	from, to := trace.Action.From, trace.Action.To
+	switch trace.Type {
+	case "suicide":
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	case "create":
+		to = nil
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	}
+	if len(args.FromAddress) > 0 && (from == nil || !includes(args.FromAddress, *from)) {
+		return false
+	}
+	if len(args.ToAddress) > 0 && (to == nil || !includes(args.ToAddress, *to)) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,262 @@
+package rpcapi_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
+)
+
+func TestParityTracesFromCallTrace(t *testing.T) {
+	callTrace := `{
+		"type": "CALL", "from": "0x00000000000000000000000000000000000000a1",
+		"to": "0x00000000000000000000000000000000000000b2", "value": "0x5",
+		"gas": "0x7530", "gasUsed": "0x5208", "input": "0x1234", "output": "0xff",
+		"calls": [
+			{
+				"type": "STATICCALL", "from": "0x00000000000000000000000000000000000000b2",
+				"to": "0x00000000000000000000000000000000000000c3",
+				"gas": "0x100", "gasUsed": "0x10", "input": "0x", "output": "0x",
+				"error": "execution reverted"
+			},
+			{
+				"type": "CREATE2", "from": "0x00000000000000000000000000000000000000b2",
+				"to": "0x00000000000000000000000000000000000000d4", "value": "0x0",
+				"gas": "0x200", "gasUsed": "0x20", "input": "0x6080", "output": "0x60",
+				"calls": [{
+					"type": "SELFDESTRUCT", "from": "0x00000000000000000000000000000000000000d4",
+					"to": "0x00000000000000000000000000000000000000a1", "value": "0x1",
+					"gas": "0x0", "gasUsed": "0x0", "input": "0x"
+				}]
+			}
+		]
+	}`
+	traces, err := rpcapi.ParityTracesFromCallTrace(json.RawMessage(callTrace))
+	require.NoError(t, err)
+	bz, err := json.Marshal(traces)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{
+			"action": {
+				"callType": "call", "from": "0x00000000000000000000000000000000000000a1",
+				"to": "0x00000000000000000000000000000000000000b2", "gas": "0x7530",
+				"input": "0x1234", "value": "0x5"
+			},
+			"result": {"gasUsed": "0x5208", "output": "0xff"},
+			"subtraces": 2, "traceAddress": [], "type": "call"
+		},
+		{
+			"action": {
+				"callType": "staticcall", "from": "0x00000000000000000000000000000000000000b2",
+				"to": "0x00000000000000000000000000000000000000c3", "gas": "0x100",
+				"input": "0x", "value": "0x0"
+			},
+			"error": "Reverted", "result": null,
+			"subtraces": 0, "traceAddress": [0], "type": "call"
+		},
+		{
+			"action": {
+				"creationMethod": "create2", "from": "0x00000000000000000000000000000000000000b2",
+				"gas": "0x200", "init": "0x6080", "value": "0x0"
+			},
+			"result": {
+				"gasUsed": "0x20", "address": "0x00000000000000000000000000000000000000d4",
+				"code": "0x60"
+			},
+			"subtraces": 1, "traceAddress": [1], "type": "create"
+		},
+		{
+			"action": {
+				"address": "0x00000000000000000000000000000000000000d4",
+				"refundAddress": "0x00000000000000000000000000000000000000a1", "balance": "0x1"
+			},
+			"result": null, "subtraces": 0, "traceAddress": [1, 0], "type": "suicide"
+		}
+	]`, string(bz))
+
+	_, err = rpcapi.ParityTracesFromCallTrace(json.RawMessage(`[]`))
+	require.ErrorContains(t, err, "failed to decode call trace")
+}
+
+func TestParityStateDiffFromPrestate(t *testing.T) {
+	prestate := `{
+		"pre": {
+			"0x00000000000000000000000000000000000000a1": {"balance": "0x10", "nonce": 1},
+			"0x00000000000000000000000000000000000000b2": {
+				"balance": "0x0", "code": "0x6080",
+				"storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000007"}
+			},
+			"0x00000000000000000000000000000000000000d4": {"balance": "0x3", "nonce": 1, "code": "0x60"}
+		},
+		"post": {
+			"0x00000000000000000000000000000000000000a1": {"balance": "0x8", "nonce": 2},
+			"0x00000000000000000000000000000000000000b2": {
+				"storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000009"}
+			},
+			"0x00000000000000000000000000000000000000c3": {"balance": "0x5", "nonce": 1}
+		}
+	}`
+	stateDiff, err := rpcapi.ParityStateDiffFromPrestate(json.RawMessage(prestate))
+	require.NoError(t, err)
+	bz, err := json.Marshal(stateDiff)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"0x00000000000000000000000000000000000000a1": {
+			"balance": {"*": {"from": "0x10", "to": "0x8"}},
+			"nonce": {"*": {"from": "0x1", "to": "0x2"}},
+			"code": "=", "storage": {}
+		},
+		"0x00000000000000000000000000000000000000b2": {
+			"balance": "=", "nonce": "=", "code": "=",
+			"storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": {"*": {
+					"from": "0x0000000000000000000000000000000000000000000000000000000000000007",
+					"to": "0x0000000000000000000000000000000000000000000000000000000000000009"
+				}}
+			}
+		},
+		"0x00000000000000000000000000000000000000c3": {
+			"balance": {"+": "0x5"}, "nonce": {"+": "0x1"}, "code": {"+": "0x"}, "storage": {}
+		},
+		"0x00000000000000000000000000000000000000d4": {
+			"balance": {"-": "0x3"}, "nonce": {"-": "0x1"}, "code": {"-": "0x60"}, "storage": {}
+		}
+	}`, string(bz))
+}
+
+func (s *BackendSuite) TestTraceNamespace() {
+	transferTx := s.SuccessfulTxTransfer()
+	deployTx := s.SuccessfulTxDeployContract()
+
+	s.Run("trace_block", func() {
+		var traces []*rpcapi.ParityTrace
+		err := s.node.EvmRpcClient.Client().Call(
+			&traces, "trace_block", hexutil.EncodeBig(transferTx.BlockNumber),
+		)
+		s.Require().NoError(err)
+		var found bool
+		for _, trace := range traces {
+			s.Require().Equal(*transferTx.BlockHash, *trace.BlockHash)
+			s.Require().Equal(transferTx.BlockNumber.Uint64(), *trace.BlockNumber)
+			if *trace.TransactionHash != transferTx.Receipt.TxHash {
+				continue
+			}
+			found = true
+			s.Equal("call", trace.Type)
+			s.Equal("call", trace.Action.CallType)
+			s.Equal(s.fundedAccEthAddr, *trace.Action.From)
+			s.Equal(recipient, *trace.Action.To)
+			s.Equal(amountToSend, trace.Action.Value.ToInt())
+			s.Equal(uint64(transferTx.Receipt.TransactionIndex), *trace.TransactionPosition)
+			s.Empty(trace.TraceAddress)
+		}
+		s.Require().True(found)
+	})
+
+	s.Run("trace_transaction: contract creation", func() {
+		traces, err := s.backend.ParityTraceTransaction(deployTx.Receipt.TxHash)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(traces)
+		s.Equal("create", traces[0].Type)
+		s.Equal("create", traces[0].Action.CreationMethod)
+		s.Equal(deployTx.Receipt.ContractAddress, traces[0].Result.Address)
+		s.NotEmpty(*traces[0].Result.Code)
+		s.Equal(deployTx.Receipt.TxHash, *traces[0].TransactionHash)
+
+		_, err = s.backend.ParityTraceTransaction(gethcommon.BytesToHash([]byte("0x0")))
+		s.ErrorContains(err, "tx not found")
+	})
+
+	s.Run("trace_filter", func() {
+		fromBlock := rpc.NewBlockNumber(transferTx.BlockNumber)
+		toBlock := rpc.NewBlockNumber(deployTx.BlockNumber)
+		traces, err := s.backend.ParityTraceFilter(rpcapi.TraceFilterArgs{
+			FromBlock: &fromBlock,
+			ToBlock:   &toBlock,
+			ToAddress: []gethcommon.Address{recipient},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(traces, 1)
+		s.Equal(transferTx.Receipt.TxHash, *traces[0].TransactionHash)
+
+		traces, err = s.backend.ParityTraceFilter(rpcapi.TraceFilterArgs{
+			FromBlock:   &fromBlock,
+			ToBlock:     &toBlock,
+			FromAddress: []gethcommon.Address{s.fundedAccEthAddr},
+		})
+		s.Require().NoError(err)
+		s.Require().GreaterOrEqual(len(traces), 2)
+
+		count, after := uint64(1), uint64(1)
+		paged, err := s.backend.ParityTraceFilter(rpcapi.TraceFilterArgs{
+			FromBlock:   &fromBlock,
+			ToBlock:     &toBlock,
+			FromAddress: []gethcommon.Address{s.fundedAccEthAddr},
+			After:       &after,
+			Count:       &count,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(paged, 1)
+		s.Equal(traces[1], paged[0])
+
+		farBlock := rpc.NewBlockNumber(new(big.Int).Add(
+			transferTx.BlockNumber, big.NewInt(int64(s.backend.RPCBlockRangeCap())+1),
+		))
+		_, err = s.backend.ParityTraceFilter(rpcapi.TraceFilterArgs{
+			FromBlock: &fromBlock,
+			ToBlock:   &farBlock,
+		})
+		s.ErrorContains(err, "maximum [from, to] blocks distance")
+
+		_, err = s.backend.ParityTraceFilter(rpcapi.TraceFilterArgs{
+			FromBlock: &toBlock,
+			ToBlock:   &fromBlock,
+		})
+		if toBlock.Int64() > fromBlock.Int64() {
+			s.ErrorContains(err, "invalid block range")
+		}
+	})
+
+	s.Run("trace_replayBlockTransactions", func() {
+		resBlock, err := s.backend.TendermintBlockByNumber(*transferTx.BlockNumberRpc)
+		s.Require().NoError(err)
+		results, err := s.backend.ParityReplayBlock(
+			resBlock, []string{rpcapi.TraceTypeTrace, rpcapi.TraceTypeStateDiff},
+		)
+		s.Require().NoError(err)
+		var result *rpcapi.ParityTraceResults
+		for _, r := range results {
+			if r.TransactionHash == transferTx.Receipt.TxHash {
+				result = r
+			}
+		}
+		s.Require().NotNil(result)
+		s.Require().NotEmpty(result.Trace)
+		s.Nil(result.Trace[0].TransactionHash)
+
+		// The balances of the sender and recipient changed.
+		s.Require().Contains(result.StateDiff, s.fundedAccEthAddr)
+		s.Require().Contains(result.StateDiff, recipient)
+		bz, err := json.Marshal(result.StateDiff[recipient])
+		s.Require().NoError(err)
+		s.Contains(string(bz), hexutil.EncodeBig(amountToSend))
+
+		results, err = s.backend.ParityReplayBlock(
+			resBlock, []string{rpcapi.TraceTypeTrace},
+		)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(results)
+		s.Nil(results[0].StateDiff)
+
+		_, err = s.backend.ParityReplayBlock(
+			resBlock, []string{rpcapi.TraceTypeVmTrace},
+		)
+		s.ErrorContains(err, "not supported")
+	})
+}
@@ -2,15 +2,21 @@ package rpcapi_test
 
 import (
 	"encoding/json"
+	"math"
 	"math/big"
+	"os"
+	"path/filepath"
 	"strings"
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/params"
 
+	appserver "github.com/NibiruChain/nibiru/v2/app/server"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
@@ -238,3 +244,174 @@ func AssertTraceCall(
 	s.Require().Equal(strings.ToLower(recipient.Hex()), trace["to"])
 	s.Require().Equal("0x"+gethcommon.Bytes2Hex(amountToSend.Bytes()), trace["value"])
 }
+
+func (s *BackendSuite) TestTraceRawBlock() {
+	blockNumber := *s.SuccessfulTxTransfer().BlockNumberRpc
+	tmBlock, err := s.backend.TendermintBlockByNumber(blockNumber)
+	s.Require().NoError(err)
+	wantResults, err := s.backend.TraceBlock(blockNumber, traceConfigCallTracer(), tmBlock)
+	s.Require().NoError(err)
+	s.Require().Len(wantResults, 1)
+
+	var blockRlp hexutil.Bytes
+	err = s.node.EvmRpcClient.Client().Call(
+		&blockRlp, "debug_getBlockRlp", blockNumber.Int64(),
+	)
+	s.Require().NoError(err)
+
+	s.Run("happy: same traces as the block of the chain", func() {
+		gotResults, err := s.backend.TraceRawBlock(blockRlp, traceConfigCallTracer())
+		s.Require().NoError(err)
+		s.Require().Equal(wantResults, gotResults)
+
+		var resJson json.RawMessage
+		err = s.node.EvmRpcClient.Client().Call(
+			&resJson, "debug_traceBlock", blockRlp, traceConfigCallTracer(),
+		)
+		s.Require().NoError(err)
+		var txTraceResults []*evm.TxTraceResult
+		s.Require().NoError(json.Unmarshal(resJson, &txTraceResults))
+		s.Require().Equal(wantResults, txTraceResults)
+	})
+
+	s.Run("sad: invalid rlp", func() {
+		_, err := s.backend.TraceRawBlock([]byte("not rlp"), traceConfigCallTracer())
+		s.Require().ErrorContains(err, "could not decode block")
+	})
+}
+
+func (s *BackendSuite) TestTraceBlockFromFile() {
+	blockNumber := *s.SuccessfulTxTransfer().BlockNumberRpc
+	tmBlock, err := s.backend.TendermintBlockByNumber(blockNumber)
+	s.Require().NoError(err)
+	wantResults, err := s.backend.TraceBlock(blockNumber, traceConfigCallTracer(), tmBlock)
+	s.Require().NoError(err)
+
+	var blockRlp hexutil.Bytes
+	err = s.node.EvmRpcClient.Client().Call(
+		&blockRlp, "debug_getBlockRlp", blockNumber.Int64(),
+	)
+	s.Require().NoError(err)
+
+	traceDir := s.T().TempDir()
+	file := filepath.Join(traceDir, "block.rlp")
+	s.Require().NoError(os.WriteFile(file, blockRlp, 0o600))
+	outsideFile := filepath.Join(s.T().TempDir(), "block.rlp")
+	s.Require().NoError(os.WriteFile(outsideFile, blockRlp, 0o600))
+
+	s.Run("sad: disabled by default", func() {
+		var resJson json.RawMessage
+		err := s.node.EvmRpcClient.Client().Call(
+			&resJson, "debug_traceBlockFromFile", file, traceConfigCallTracer(),
+		)
+		s.Require().ErrorContains(err, "tracing from files is disabled")
+	})
+
+	viper := s.node.Ctx.Viper
+	viper.Set(appserver.JSONRPCEnableTraceToFile, true)
+	viper.Set(appserver.JSONRPCTraceFileDir, traceDir)
+	backend := rpcapi.NewBackend(
+		s.node.Ctx, s.node.Ctx.Logger, s.node.ClientCtx, false, s.node.EthTxIndexer,
+	)
+	viper.Set(appserver.JSONRPCEnableTraceToFile, false)
+	viper.Set(appserver.JSONRPCTraceFileDir, "")
+
+	for _, path := range []string{file, "block.rlp", "./sub/../block.rlp"} {
+		s.Run("happy: file in the trace dir: "+path, func() {
+			gotResults, err := backend.TraceBlockFromFile(path, traceConfigCallTracer())
+			s.Require().NoError(err)
+			s.Require().Equal(wantResults, gotResults)
+		})
+	}
+
+	outsidePaths := []string{
+		outsideFile,
+		filepath.Join("..", filepath.Base(filepath.Dir(outsideFile)), "block.rlp"),
+		filepath.Join(traceDir, "..", filepath.Base(filepath.Dir(outsideFile)), "block.rlp"),
+		traceDir,
+	}
+	for _, path := range outsidePaths {
+		s.Run("sad: file outside of the trace dir: "+path, func() {
+			_, err := backend.TraceBlockFromFile(path, traceConfigCallTracer())
+			s.Require().ErrorContains(err, "is outside of the")
+		})
+	}
+
+	s.Run("sad: symlink in the trace dir to a file outside of it", func() {
+		link := filepath.Join(traceDir, "link.rlp")
+		s.Require().NoError(os.Symlink(outsideFile, link))
+		for _, path := range []string{link, "link.rlp"} {
+			_, err := backend.TraceBlockFromFile(path, traceConfigCallTracer())
+			s.Require().ErrorContains(err, "escapes", path)
+		}
+	})
+
+	s.Run("sad: missing file", func() {
+		_, err := backend.TraceBlockFromFile("missing.rlp", traceConfigCallTracer())
+		s.Require().ErrorContains(err, "could not read file")
+	})
+}
+
+func (s *BackendSuite) TestStandardTraceBlockToFile() {
+	blockNumber := *s.SuccessfulTxTransfer().BlockNumberRpc
+	tmBlock, err := s.backend.TendermintBlockByNumber(blockNumber)
+	s.Require().NoError(err)
+	txHash := s.SuccessfulTxTransfer().Receipt.TxHash
+
+	s.Run("sad: disabled by default", func() {
+		_, err := s.backend.StandardTraceBlockToFile(tmBlock, nil)
+		s.Require().ErrorContains(err, "tracing to files is disabled")
+	})
+
+	traceDir := s.T().TempDir()
+	viper := s.node.Ctx.Viper
+	viper.Set(appserver.JSONRPCEnableTraceToFile, true)
+	viper.Set(appserver.JSONRPCTraceFileDir, traceDir)
+	backend := rpcapi.NewBackend(
+		s.node.Ctx, s.node.Ctx.Logger, s.node.ClientCtx, false, s.node.EthTxIndexer,
+	)
+	viper.Set(appserver.JSONRPCEnableTraceToFile, false)
+	viper.Set(appserver.JSONRPCTraceFileDir, "")
+
+	s.Run("happy: one file per tx", func() {
+		dumps, err := backend.StandardTraceBlockToFile(
+			tmBlock, &tracers.StdTraceConfig{TxHash: txHash},
+		)
+		s.Require().NoError(err)
+		s.Require().Len(dumps, 1)
+		s.Require().Equal(traceDir, filepath.Dir(dumps[0]))
+
+		dump, err := os.ReadFile(dumps[0])
+		s.Require().NoError(err)
+		lines := strings.Split(strings.TrimSpace(string(dump)), "\n")
+		s.Require().NotEmpty(lines)
+
+		// A transfer tx runs no opcodes, so the trace only has the result.
+		var result map[string]any
+		s.Require().NoError(json.Unmarshal([]byte(lines[len(lines)-1]), &result))
+		s.Require().Equal(float64(params.TxGas), result["gas"])
+		s.Require().Equal(false, result["failed"])
+	})
+
+	s.Run("sad: tx not in the block", func() {
+		_, err := backend.StandardTraceBlockToFile(
+			tmBlock, &tracers.StdTraceConfig{TxHash: gethcommon.HexToHash("0x1234")},
+		)
+		s.Require().ErrorContains(err, "not found in block")
+	})
+
+	s.Run("sad: limit overflowing int32", func() {
+		config := &tracers.StdTraceConfig{TxHash: txHash}
+		config.Limit = math.MaxInt32 + 1
+		_, err := backend.StandardTraceBlockToFile(tmBlock, config)
+		s.Require().ErrorContains(err, "limit")
+	})
+
+	s.Run("sad: bad blocks are not supported", func() {
+		var dumps []string
+		err := s.node.EvmRpcClient.Client().Call(
+			&dumps, "debug_standardTraceBadBlockToFile", txHash, nil,
+		)
+		s.Require().ErrorContains(err, "not implemented")
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"math/big"
 
 	sdkioerrors "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -249,12 +250,6 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evm.MsgEthereumTx)
 
-	txData, err := evm.UnpackTxData(ethMsg.Data)
-	if err != nil {
-		b.logger.Error("failed to unpack tx data", "error", err.Error())
-		return nil, err
-	}
-
 	cumulativeGasUsed := uint64(0)
 	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
 	if err != nil {
@@ -266,18 +261,6 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 	}
 	cumulativeGasUsed += res.CumulativeGasUsed
 
-	var status uint64 = gethcore.ReceiptStatusSuccessful
-	if res.Failed {
-		status = gethcore.ReceiptStatusFailed
-	}
-
-	chainID := b.ChainID()
-
-	from, err := ethMsg.GetSender(chainID.ToInt())
-	if err != nil {
-		return nil, err
-	}
-
 	// parse tx logs from events
 	msgIndex := int(res.MsgIndex) // #nosec G701 -- checked for int overflow already
 	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
@@ -300,6 +283,142 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 		return nil, pkgerrors.New("can't find index of ethereum tx")
 	}
 
+	return b.newTransactionReceipt(
+		ethMsg, res, gethcommon.BytesToHash(resBlock.Block.Header.Hash()),
+		cumulativeGasUsed, logs,
+	)
+}
+
+// GetBlockReceipts returns the receipts of all of the Ethereum txs in the
+// block with the given hash or number, in the same format as
+// [Backend.GetTransactionReceipt]. The receipts are built from the block
+// results in one pass instead of looking up each tx in the tx indexer. If the
+// block is not found, this resolves to nil.
+func (b *Backend) GetBlockReceipts(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]*TransactionReceipt, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		b.logger.Debug("block not found", "error", err.Error())
+		return nil, nil
+	}
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNum, "error", err.Error())
+		return nil, nil
+	}
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
+		return nil, nil
+	}
+	blockHash := gethcommon.BytesToHash(resBlock.Block.Header.Hash())
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	msgsByHash := make(map[gethcommon.Hash]*evm.MsgEthereumTx, len(msgs))
+	for _, ethMsg := range msgs {
+		msgsByHash[gethcommon.HexToHash(ethMsg.Hash)] = ethMsg
+	}
+
+	// Index the Ethereum txs parsed from the events of the block by hash, with
+	// the position of their Cosmos tx, like the EVM tx indexer does.
+	type parsedEthTx struct {
+		txIndex   uint32
+		parsedTxs *rpc.ParsedTxs
+		msgIndex  int
+		// gas used in the block by the preceding Cosmos txs
+		blockGasUsed uint64
+	}
+	parsed := make(map[gethcommon.Hash]parsedEthTx, len(msgs))
+	var blockGasUsed uint64
+	for txIndex, txResult := range blockRes.TxsResults {
+		precedingGasUsed := blockGasUsed
+		blockGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
+		if isValidEnough, _ := rpc.TxIsValidEnough(txResult); !isValidEnough {
+			continue
+		}
+		parsedTxs, err := rpc.ParseTxResult(txResult, nil)
+		if err != nil {
+			b.logger.Debug("failed to parse tx events", "height", height, "txIndex", txIndex, "error", err.Error())
+			continue
+		}
+		for msgIndex, parsedTx := range parsedTxs.Txs {
+			if ethMsg, ok := msgsByHash[parsedTx.EthHash]; ok && txResult.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit
+				// because that's what's charged by ante handler.
+				parsedTxs.Txs[msgIndex].GasUsed = ethMsg.GetGas()
+				parsedTxs.Txs[msgIndex].Failed = true
+			}
+			parsed[parsedTx.EthHash] = parsedEthTx{
+				txIndex:      uint32(txIndex), // #nosec G701
+				parsedTxs:    parsedTxs,
+				msgIndex:     msgIndex,
+				blockGasUsed: precedingGasUsed,
+			}
+		}
+	}
+
+	receipts := make([]*TransactionReceipt, 0, len(msgs))
+	for ethTxIndex, ethMsg := range msgs {
+		p, found := parsed[gethcommon.HexToHash(ethMsg.Hash)]
+		if !found {
+			return nil, fmt.Errorf("ethereum tx %s not found in the events of block %d", ethMsg.Hash, height)
+		}
+		parsedTx := p.parsedTxs.Txs[p.msgIndex]
+		res := &eth.TxResult{
+			Height:            height,
+			TxIndex:           p.txIndex,
+			MsgIndex:          uint32(p.msgIndex), // #nosec G701
+			EthTxIndex:        int32(ethTxIndex),  // #nosec G701
+			GasUsed:           parsedTx.GasUsed,
+			Failed:            parsedTx.Failed,
+			CumulativeGasUsed: p.parsedTxs.AccumulativeGasUsed(p.msgIndex),
+		}
+
+		logs, err := TxLogsFromEvents(blockRes.TxsResults[p.txIndex].Events, p.msgIndex)
+		if err != nil {
+			b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
+		}
+
+		receipt, err := b.newTransactionReceipt(
+			ethMsg, res, blockHash, p.blockGasUsed+res.CumulativeGasUsed, logs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// newTransactionReceipt returns the receipt of an Ethereum tx from its result
+// and logs.
+func (b *Backend) newTransactionReceipt(
+	ethMsg *evm.MsgEthereumTx,
+	res *eth.TxResult,
+	blockHash gethcommon.Hash,
+	cumulativeGasUsed uint64,
+	logs []*gethcore.Log,
+) (*TransactionReceipt, error) {
+	txData, err := evm.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		b.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, err
+	}
+
+	var status uint64 = gethcore.ReceiptStatusSuccessful
+	if res.Failed {
+		status = gethcore.ReceiptStatusFailed
+	}
+
+	chainID := b.ChainID()
+
+	from, err := ethMsg.GetSender(chainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+
 	receipt := TransactionReceipt{
 		Receipt: gethcore.Receipt{
 			Type: ethMsg.AsTransaction().Type(),
@@ -312,10 +431,10 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 
 			// Implementation fields: These fields are added by geth when processing a transaction.
 			// They are stored in the chain database.
-			TxHash:  hash,
+			TxHash:  ethMsg.AsTransaction().Hash(),
 			GasUsed: res.GasUsed,
 
-			BlockHash:        gethcommon.BytesToHash(resBlock.Block.Header.Hash()),
+			BlockHash:        blockHash,
 			BlockNumber:      big.NewInt(res.Height),
 			TransactionIndex: uint(res.EthTxIndex),
 		},
@@ -106,6 +106,62 @@ func (s *BackendSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (s *BackendSuite) TestGetBlockReceipts() {
+	for _, successfulTx := range []SuccessfulTx{
+		s.SuccessfulTxTransfer(),
+		s.SuccessfulTxDeployContract(),
+	} {
+		wantReceipt, err := s.backend.GetTransactionReceipt(successfulTx.Receipt.TxHash)
+		s.Require().NoError(err)
+		wantJson, err := json.Marshal(wantReceipt)
+		s.Require().NoError(err)
+
+		for _, blockNrOrHash := range []rpc.BlockNumberOrHash{
+			{BlockNumber: successfulTx.BlockNumberRpc},
+			{BlockHash: successfulTx.BlockHash},
+		} {
+			receipts, err := s.backend.GetBlockReceipts(blockNrOrHash)
+			s.Require().NoError(err)
+			s.Require().NotEmpty(receipts)
+
+			// The receipts are in block order and match the receipts of
+			// eth_getTransactionReceipt.
+			found := false
+			for i, receipt := range receipts {
+				s.Require().Equal(uint(i), receipt.TransactionIndex)
+				s.Require().Equal(*successfulTx.BlockHash, receipt.BlockHash)
+				if i > 0 {
+					s.Require().Greater(receipt.CumulativeGasUsed, receipts[i-1].CumulativeGasUsed)
+				}
+				if receipt.TxHash == successfulTx.Receipt.TxHash {
+					found = true
+					gotJson, err := json.Marshal(receipt)
+					s.Require().NoError(err)
+					s.Require().JSONEq(string(wantJson), string(gotJson))
+				}
+			}
+			s.Require().True(found)
+		}
+	}
+
+	s.Run("via JSON-RPC", func() {
+		var receipts []*rpcapi.TransactionReceipt
+		err := s.node.EvmRpcClient.Client().Call(
+			&receipts, "eth_getBlockReceipts",
+			hexutil.EncodeBig(s.SuccessfulTxTransfer().BlockNumber),
+		)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(receipts)
+	})
+
+	s.Run("sad: block not found", func() {
+		blockNumber := rpc.NewBlockNumber(big.NewInt(1_000_000))
+		receipts, err := s.backend.GetBlockReceipts(rpc.BlockNumberOrHash{BlockNumber: &blockNumber})
+		s.Require().NoError(err)
+		s.Require().Nil(receipts)
+	})
+}
+
 func (s *BackendSuite) TestGetTransactionByBlockHashAndIndex() {
 	blockWithTx, err := s.backend.GetBlockByNumber(
 		*s.SuccessfulTxTransfer().BlockNumberRpc, false)
@@ -173,11 +173,14 @@ func (b *Backend) retrieveEVMTxFeesFromBlock(
 			if !ok {
 				continue
 			}
-			tx := ethMsg.AsTransaction()
-			reward := tx.EffectiveGasTipValue(blockBaseFee)
-			if reward == nil {
-				reward = big.NewInt(0)
+			txData, err := evm.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				b.logger.Debug("failed to unpack tx data", "hash", ethMsg.Hash, "error", err.Error())
+				continue
 			}
+			// The reward is the tip paid on top of the base fee, which sets the
+			// priority of the tx in the mempool.
+			reward := evm.EffectiveGasTipWei(txData, blockBaseFee)
 			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
 		}
 	}
@@ -205,6 +208,32 @@ func (b *Backend) retrieveEVMTxFeesFromBlock(
 	return nil
 }
 
+// ethTxTipsFromBlock returns the tips paid by the Ethereum txs of the block in
+// units of wei per gas, sorted in ascending order.
+func (b *Backend) ethTxTipsFromBlock(resBlock *tmrpctypes.ResultBlock) []*big.Int {
+	var tips []*big.Int
+	for _, txBz := range resBlock.Block.Txs {
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", resBlock.Block.Height, "error", err.Error())
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			txData, err := evm.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				continue
+			}
+			tips = append(tips, evm.EffectiveGasTipWei(txData, evm.BASE_FEE_WEI))
+		}
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	return tips
+}
+
 // TxLogsFromEvents parses ethereum logs from cosmos events for specific msg index
 func TxLogsFromEvents(events []abci.Event, msgIndex int) ([]*gethcore.Log, error) {
 	for _, event := range events {
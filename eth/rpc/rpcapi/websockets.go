@@ -28,9 +28,8 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
-	"github.com/NibiruChain/collections"
-
 	"github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc/pubsub"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
@@ -75,13 +74,21 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	metrics  *Metrics // nil when metrics are disabled
+
+	rateLimiter *RateLimiter // nil when rate limiting is disabled
 }
 
+// NewWebsocketsServer returns the websocket server of the JSON-RPC API. Its
+// connections and subscriptions are tracked by metrics unless it is nil, and
+// the calls of its clients are limited by rateLimiter unless it is nil.
 func NewWebsocketsServer(
 	clientCtx client.Context,
 	logger log.Logger,
 	tmWSClient *rpcclient.WSClient,
 	cfg *config.Config,
+	metrics *Metrics,
+	rateLimiter *RateLimiter,
 ) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
@@ -93,6 +100,9 @@ func NewWebsocketsServer(
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
 		logger:   logger,
+		metrics:  metrics,
+
+		rateLimiter: rateLimiter,
 	}
 }
 
@@ -133,9 +143,11 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	defer s.metrics.TrackWsConnection()()
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:       new(sync.Mutex),
+		conn:      conn,
+		rateLimit: s.rateLimiter.wsClient(r),
 	})
 }
 
@@ -155,6 +167,9 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+
+	// rateLimit is the client of the connection for the rate limiter
+	rateLimit rateLimitClient
 }
 
 func (w *wsConn) WriteJSON(v any) error {
@@ -196,6 +211,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		if limitErr := s.rateLimiter.limitWs(wsConn.rateLimit, mb); limitErr != nil {
+			_ = wsConn.WriteJSON(limitErr.response()) // #nosec G703
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -250,7 +270,8 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
-			subscriptions[subID] = unsubFn
+			subscription, _ := params[0].(string)
+			subscriptions[subID] = s.metrics.TrackWsSubscription(subscription, unsubFn)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -323,6 +344,7 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	s.rateLimiter.setWsForwardHeader(req)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -350,6 +372,7 @@ type pubSubAPI struct {
 	events    *EventSubscriber
 	logger    log.Logger
 	clientCtx client.Context
+	chainID   *big.Int
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
@@ -359,6 +382,7 @@ func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpccl
 		events:    NewEventSubscriber(logger, tmWSClient),
 		logger:    logger,
 		clientCtx: clientCtx,
+		chainID:   eth.ParseEthChainID(clientCtx.ChainID),
 	}
 }
 
@@ -378,7 +402,10 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID gethrpc.ID, params []any)
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(wsConn, subID, params[1])
+		}
+		return api.subscribePendingTransactions(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -626,10 +653,19 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID gethrpc.ID, extra any)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID gethrpc.ID) (pubsub.UnsubscribeFunc, error) {
+// subscribePendingTransactions notifies the subscriber of the Ethereum txs
+// that match the [PendingTxsFilter] given as extra, with their hashes or, in
+// full-tx mode, with the transaction objects.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID gethrpc.ID, extra any) (pubsub.UnsubscribeFunc, error) {
+	filter, err := ParsePendingTxsFilter(extra)
+	if err != nil {
+		api.logger.Debug("invalid pending transactions filter", "error", err.Error())
+		return nil, err
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
-		return nil, pkgerrors.Wrap(err, "error creating block filter: %s")
+		return nil, pkgerrors.Wrap(err, "error creating block filter")
 	}
 
 	go func() {
@@ -637,14 +673,17 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID gethrpc
 		errCh := sub.Error()
 		for {
 			select {
-			case ev := <-txsCh:
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
 				data, ok := ev.Data.(cmttypes.EventDataTx)
 				if !ok {
 					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
 					continue
 				}
 
-				fmt.Printf("data.Tx: %s\n", collections.HumanizeBytes(data.Tx))
 				ethTxs, err := rpc.RawTxToEthTx(api.clientCtx, data.Tx)
 				if err != nil {
 					// not ethereum tx
@@ -652,13 +691,30 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID gethrpc
 				}
 
 				for _, ethTx := range ethTxs {
+					rpcTx := rpc.NewRPCTxFromMsgEthTx(
+						ethTx,
+						common.Hash{},
+						uint64(0),
+						uint64(0),
+						nil,
+						api.chainID,
+					)
+					if !filter.Matches(rpcTx) {
+						continue
+					}
+
+					var result any = rpcTx.Hash
+					if filter.FullTx {
+						result = rpcTx
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -685,8 +741,78 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID gethrpc
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ gethrpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, pkgerrors.New("syncing subscription is not implemented")
+// subscribeSyncing notifies the subscriber with a [SyncingResult] whenever
+// the node starts or stops catching up with the network, and right away if
+// it is catching up. The CometBFT status is checked on each new block header.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID gethrpc.ID) (pubsub.UnsubscribeFunc, error) {
+	status, err := api.clientCtx.Client.Status(context.Background())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error querying the node status")
+	}
+
+	sub, unsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error creating block filter")
+	}
+
+	notify := func(result SyncingResult) {
+		// write to ws conn
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+
+		err := wsConn.WriteJSON(res)
+		if err != nil {
+			api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close() // #nosec G703
+				}
+			}, api.logger, "closing websocket peer sub")
+		}
+	}
+
+	go func() {
+		catchingUp := status.SyncInfo.CatchingUp
+		if catchingUp {
+			notify(NewSyncingResult(status.SyncInfo))
+		}
+
+		headersCh := sub.EventCh
+		errCh := sub.Error()
+		for {
+			select {
+			case _, ok := <-headersCh:
+				if !ok {
+					return
+				}
+
+				status, err := api.clientCtx.Client.Status(context.Background())
+				if err != nil {
+					api.logger.Debug("error querying the node status", "error", err.Error())
+					continue
+				}
+				if status.SyncInfo.CatchingUp == catchingUp {
+					continue
+				}
+				catchingUp = status.SyncInfo.CatchingUp
+				notify(NewSyncingResult(status.SyncInfo))
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Syncing WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return unsubFn, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -2,6 +2,7 @@ package gosdk
 
 import (
 	"context"
+	"fmt"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
@@ -16,6 +17,21 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 )
 
+const (
+	// DefaultGasLimit is the gas limit of a tx when neither
+	// [BroadcastArgs.GasLimit] nor [BroadcastArgs.SimulateGas] is set.
+	DefaultGasLimit uint64 = uint64(2 * common.TO_MICRO)
+	// DefaultGasAdjustment is the factor applied to the gas used in a
+	// simulation when [BroadcastArgs.GasAdjustment] is not set.
+	DefaultGasAdjustment float64 = 1.5
+)
+
+// DefaultFees is the fee of a tx when no fee or gas price option of
+// [BroadcastArgs] is set.
+func DefaultFees() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(denoms.NIBI, sdk.NewInt(1000)))
+}
+
 func BroadcastMsgsWithSeq(
 	args BroadcastArgs,
 	from sdk.AccAddress,
@@ -29,19 +45,14 @@ func BroadcastMsgsWithSeq(
 		return nil, err
 	}
 
-	txBuilder := args.txCfg.NewTxBuilder()
-	err = txBuilder.SetMsgs(msgs...)
+	nums, err := args.gosdk.GetAccountNumbers(from.String())
 	if err != nil {
 		return nil, err
 	}
 
-	bondDenom := denoms.NIBI
-	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1000))))
-	txBuilder.SetGasLimit(uint64(2 * common.TO_MICRO))
-
-	nums, err := args.gosdk.GetAccountNumbers(from.String())
-	if err != nil {
-		return nil, err
+	gasAdjustment := args.GasAdjustment
+	if gasAdjustment == 0 {
+		gasAdjustment = DefaultGasAdjustment
 	}
 
 	var accRetriever sdkclient.AccountRetriever = authtypes.AccountRetriever{}
@@ -51,7 +62,49 @@ func BroadcastMsgsWithSeq(
 		WithTxConfig(args.txCfg).
 		WithAccountRetriever(accRetriever).
 		WithAccountNumber(nums.Number).
-		WithSequence(seq)
+		WithSequence(seq).
+		WithFromName(info.Name).
+		WithGasAdjustment(gasAdjustment).
+		WithMemo(args.Memo).
+		WithTimeoutHeight(args.TimeoutHeight).
+		WithFeeGranter(args.FeeGranter).
+		WithFeePayer(args.FeePayer)
+
+	gasPrices := args.GasPrices
+	if args.Fees.Empty() && gasPrices.Empty() && args.UseNodeMinGasPrices {
+		gasPrices, err = args.gosdk.NodeMinGasPrices()
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case !args.Fees.Empty():
+		txFactory = txFactory.WithFees(args.Fees.String())
+	case !gasPrices.Empty():
+		txFactory = txFactory.WithGasPrices(gasPrices.String())
+	case !args.UseNodeMinGasPrices:
+		txFactory = txFactory.WithFees(DefaultFees().String())
+	}
+
+	switch {
+	case args.GasLimit > 0:
+		txFactory = txFactory.WithGas(args.GasLimit)
+	case args.SimulateGas:
+		_, gasLimit, err := sdkclienttx.CalculateGas(
+			args.gosdk.Querier.ClientConn, txFactory.WithSimulateAndExecute(true), msgs...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to simulate tx: %w", err)
+		}
+		txFactory = txFactory.WithGas(gasLimit)
+	default:
+		txFactory = txFactory.WithGas(DefaultGasLimit)
+	}
+
+	txBuilder, err := txFactory.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
 
 	overwriteSig := true
 	err = sdkclienttx.Sign(txFactory, info.Name, txBuilder, overwriteSig)
@@ -143,6 +196,36 @@ type BroadcastArgs struct {
 	Broadcaster Broadcaster
 	rpc         cmtrpcclient.Client
 	chainID     string
+
+	// GasLimit is the gas limit of the tx. When zero, the gas limit is
+	// estimated with a simulation if SimulateGas is set, and is
+	// DefaultGasLimit otherwise.
+	GasLimit uint64
+	// SimulateGas estimates the gas limit by simulating the tx on the node
+	// and multiplying the gas used by GasAdjustment.
+	SimulateGas bool
+	// GasAdjustment is the factor applied to the simulated gas used.
+	// Defaults to DefaultGasAdjustment.
+	GasAdjustment float64
+
+	// Fees is the fee paid for the tx. It cannot be combined with GasPrices.
+	Fees sdk.Coins
+	// GasPrices sets the fee of the tx to ceil(gasPrice * gasLimit).
+	GasPrices sdk.DecCoins
+	// UseNodeMinGasPrices computes the fee from the minimum gas prices of the
+	// node when neither Fees nor GasPrices are set. The fee is DefaultFees
+	// when none of the three are set.
+	UseNodeMinGasPrices bool
+	// FeeGranter is an account with a fee allowance that pays the fee.
+	FeeGranter sdk.AccAddress
+	// FeePayer is an account other than the first signer that pays the fee.
+	// It must also sign the tx.
+	FeePayer sdk.AccAddress
+
+	Memo string
+	// TimeoutHeight is the block height after which the tx is no longer
+	// valid. Zero means no timeout.
+	TimeoutHeight uint64
 }
 
 func initBroadcastArgs(
@@ -159,6 +242,14 @@ func initBroadcastArgs(
 	}
 }
 
+// NewBroadcastArgs returns the args used to broadcast txs from the keyring of
+// the SDK with the given broadcaster. Set the exported fields to configure the
+// gas and fee of a tx, then pass the args to [BroadcastMsgs] or
+// [BroadcastMsgsWithSeq].
+func (nc *NibiruSDK) NewBroadcastArgs(broadcaster Broadcaster) BroadcastArgs {
+	return initBroadcastArgs(nc, broadcaster)
+}
+
 func (nc *NibiruSDK) BroadcastMsgs(
 	from sdk.AccAddress,
 	msgs ...sdk.Msg,
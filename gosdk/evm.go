@@ -0,0 +1,439 @@
+package gosdk
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	srvconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+// EthTxArgs are the args of an Ethereum tx signed with a key from the keyring
+// of the SDK. The type of the tx follows from the fee fields: GasFeeCap makes
+// a dynamic fee tx, AccessList without GasFeeCap makes an access list tx, and
+// a legacy tx is built otherwise.
+type EthTxArgs struct {
+	// From is the address of an "eth_secp256k1" key in the keyring.
+	From gethcommon.Address
+	// To is the recipient of the tx. A nil To deploys a contract with Input
+	// as the init code.
+	To *gethcommon.Address
+	// Value is the amount of wei sent with the tx.
+	Value *big.Int
+	Input []byte
+
+	// Nonce overrides the nonce of the sender queried from the chain.
+	Nonce *uint64
+	// GasLimit is the gas limit of the tx. When zero, it's estimated with the
+	// EstimateGas query of the EVM module.
+	GasLimit uint64
+	// GasPrice is the gas price in wei of a legacy or access list tx.
+	// Defaults to the base fee.
+	GasPrice *big.Int
+	// GasFeeCap is the max fee per gas in wei of a dynamic fee tx.
+	GasFeeCap *big.Int
+	// GasTipCap is the max priority fee per gas in wei of a dynamic fee tx.
+	// Defaults to zero.
+	GasTipCap  *big.Int
+	AccessList *gethcore.AccessList
+}
+
+// JsonTxArgs returns the args in the JSON-RPC format used by the EthCall and
+// EstimateGas queries.
+func (txArgs EthTxArgs) JsonTxArgs() evm.JsonTxArgs {
+	input := hexutil.Bytes(txArgs.Input)
+	jsonTxArgs := evm.JsonTxArgs{
+		From:                 &txArgs.From,
+		To:                   txArgs.To,
+		Input:                &input,
+		Value:                (*hexutil.Big)(txArgs.Value),
+		GasPrice:             (*hexutil.Big)(txArgs.GasPrice),
+		MaxFeePerGas:         (*hexutil.Big)(txArgs.GasFeeCap),
+		MaxPriorityFeePerGas: (*hexutil.Big)(txArgs.GasTipCap),
+		AccessList:           txArgs.AccessList,
+	}
+	if txArgs.Nonce != nil {
+		jsonTxArgs.Nonce = (*hexutil.Uint64)(txArgs.Nonce)
+	}
+	if txArgs.GasLimit > 0 {
+		jsonTxArgs.Gas = (*hexutil.Uint64)(&txArgs.GasLimit)
+	}
+	return jsonTxArgs
+}
+
+// EthChainID returns the EIP-155 chain ID of the chain.
+func (nc *NibiruSDK) EthChainID() *big.Int {
+	return appconst.GetEthChainID(nc.ChainId)
+}
+
+// EthNonce returns the nonce of an Ethereum account.
+func (nc *NibiruSDK) EthNonce(addr gethcommon.Address) (uint64, error) {
+	resp, err := nc.Querier.EVM.EthAccount(
+		context.Background(), &evm.QueryEthAccountRequest{Address: addr.Hex()},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Nonce, nil
+}
+
+// EthBaseFee returns the EIP-1559 base fee in wei.
+func (nc *NibiruSDK) EthBaseFee() (*big.Int, error) {
+	resp, err := nc.Querier.EVM.BaseFee(context.Background(), &evm.QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.BaseFee.BigInt(), nil
+}
+
+func (nc *NibiruSDK) ethCallRequest(txArgs EthTxArgs) (*evm.EthCallRequest, error) {
+	jsonTxArgs := txArgs.JsonTxArgs()
+	argsBz, err := json.Marshal(&jsonTxArgs)
+	if err != nil {
+		return nil, err
+	}
+	return &evm.EthCallRequest{
+		Args:    argsBz,
+		GasCap:  srvconfig.DefaultEthCallGasLimit,
+		ChainId: nc.EthChainID().Int64(),
+	}, nil
+}
+
+// EthCall executes the tx without committing it to the chain state, like
+// "eth_call" does. An error is returned if the EVM execution fails.
+func (nc *NibiruSDK) EthCall(txArgs EthTxArgs) (*evm.MsgEthereumTxResponse, error) {
+	req, err := nc.ethCallRequest(txArgs)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := nc.Querier.EVM.EthCall(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Failed() {
+		return resp, fmt.Errorf("eth call failed: %s", resp.VmError)
+	}
+	return resp, nil
+}
+
+// EthEstimateGas returns the gas limit needed to execute the tx, like
+// "eth_estimateGas" does.
+func (nc *NibiruSDK) EthEstimateGas(txArgs EthTxArgs) (uint64, error) {
+	req, err := nc.ethCallRequest(txArgs)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := nc.Querier.EVM.EstimateGas(context.Background(), req)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Gas, nil
+}
+
+// BuildEthTx fills in the nonce, gas limit, and gas price of the tx when they
+// aren't set, then signs the tx with the key of the sender in the keyring.
+func BuildEthTx(args BroadcastArgs, txArgs EthTxArgs) (*evm.MsgEthereumTx, error) {
+	nc := &args.gosdk
+	if txArgs.Nonce == nil {
+		nonce, err := nc.EthNonce(txArgs.From)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query nonce: %w", err)
+		}
+		txArgs.Nonce = &nonce
+	}
+	if txArgs.GasFeeCap == nil && txArgs.GasPrice == nil {
+		baseFee, err := nc.EthBaseFee()
+		if err != nil {
+			return nil, fmt.Errorf("failed to query base fee: %w", err)
+		}
+		txArgs.GasPrice = baseFee
+	}
+	if txArgs.GasFeeCap != nil && txArgs.GasTipCap == nil {
+		txArgs.GasTipCap = big.NewInt(0)
+	}
+	if txArgs.GasLimit == 0 {
+		gasLimit, err := nc.EthEstimateGas(txArgs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+		txArgs.GasLimit = gasLimit
+	}
+
+	chainID := nc.EthChainID()
+	msg := evm.NewTx(&evm.EvmTxArgs{
+		ChainID:   chainID,
+		Nonce:     *txArgs.Nonce,
+		To:        txArgs.To,
+		Amount:    txArgs.Value,
+		GasLimit:  txArgs.GasLimit,
+		GasPrice:  txArgs.GasPrice,
+		GasFeeCap: txArgs.GasFeeCap,
+		GasTipCap: txArgs.GasTipCap,
+		Input:     txArgs.Input,
+		Accesses:  txArgs.AccessList,
+	})
+	msg.From = txArgs.From.Hex()
+	if err := msg.Sign(gethcore.LatestSignerForChainID(chainID), args.kring); err != nil {
+		return nil, fmt.Errorf("failed to sign eth tx: %w", err)
+	}
+	return msg, nil
+}
+
+// BroadcastEthTx builds and signs an Ethereum tx with [BuildEthTx], then
+// broadcasts it wrapped in a Cosmos tx with the broadcaster of the args. Use
+// [NibiruSDK.WaitForEthReceipt] with the hash of the returned msg to wait for
+// the execution of the tx.
+func BroadcastEthTx(
+	args BroadcastArgs, txArgs EthTxArgs,
+) (*evm.MsgEthereumTx, *sdk.TxResponse, error) {
+	msg, err := BuildEthTx(args, txArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+	cosmosTx, err := msg.BuildTx(args.txCfg.NewTxBuilder(), evm.EVMBankDenom)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build Cosmos tx from eth tx: %w", err)
+	}
+	txBytes, err := args.txCfg.TxEncoder()(cosmosTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	txResp, err := args.Broadcaster.BroadcastTxSync(txBytes)
+	return msg, txResp, err
+}
+
+// SendEthTx broadcasts an Ethereum tx with the CometBFT RPC client of the SDK.
+// See [BroadcastEthTx].
+func (nc *NibiruSDK) SendEthTx(
+	txArgs EthTxArgs,
+) (*evm.MsgEthereumTx, *sdk.TxResponse, error) {
+	broadcaster := BroadcasterTmRpc{RPC: nc.CometRPC}
+	args := initBroadcastArgs(nc, broadcaster)
+	return BroadcastEthTx(args, txArgs)
+}
+
+// DeployContract sends an Ethereum tx that deploys the compiled contract with
+// the given constructor args. It returns the address of the contract, which
+// holds code once the tx executes successfully.
+func (nc *NibiruSDK) DeployContract(
+	from gethcommon.Address,
+	contract embeds.CompiledEvmContract,
+	constructorArgs ...any,
+) (contractAddr gethcommon.Address, msg *evm.MsgEthereumTx, txResp *sdk.TxResponse, err error) {
+	packedArgs, err := contract.ABI.Pack("", constructorArgs...)
+	if err != nil {
+		return contractAddr, nil, nil, fmt.Errorf("failed to pack constructor args: %w", err)
+	}
+	input := append(append([]byte{}, contract.Bytecode...), packedArgs...)
+	msg, txResp, err = nc.SendEthTx(EthTxArgs{From: from, Input: input})
+	if err != nil {
+		return contractAddr, msg, txResp, err
+	}
+	contractAddr = crypto.CreateAddress(from, msg.AsTransaction().Nonce())
+	return contractAddr, msg, txResp, nil
+}
+
+// WaitForEthReceipt queries the node for the Ethereum tx with the given hash
+// until it is included in a block or the context is done. The receipt is
+// built from the response of the tx and its block, like the receipt of
+// "eth_getTransactionReceipt". A tx with a failed EVM execution returns a
+// receipt with a failed status and no error.
+func (nc *NibiruSDK) WaitForEthReceipt(
+	ctx context.Context, ethTxHash gethcommon.Hash,
+) (*gethcore.Receipt, error) {
+	query := fmt.Sprintf("%s.%s='%s'",
+		evm.PendingEthereumTxEvent, evm.PendingEthereumTxEventAttrEthHash, ethTxHash.Hex(),
+	)
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+	for {
+		page, perPage := 1, 1
+		res, queryErr := nc.CometRPC.TxSearch(ctx, query, false, &page, &perPage, "")
+		if queryErr == nil && len(res.Txs) > 0 {
+			txResp, _, err := nc.WaitForTx(ctx, TxHashBytesToHex(res.Txs[0].Hash))
+			if err != nil {
+				return nil, err
+			}
+			return nc.ethReceiptFromTxResponse(ctx, txResp, res.Txs[0].Index, ethTxHash)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf(
+				"eth tx %s not found before %w", ethTxHash.Hex(), ctx.Err(),
+			)
+		case <-ticker.C:
+		}
+	}
+}
+
+// ethReceiptFromTxResponse builds the receipt of the Ethereum tx with the
+// given hash from the response of the Cosmos tx at index "txIndex" of its
+// block. As in "eth_getTransactionReceipt", the cumulative gas used includes
+// the gas of every Cosmos tx before it in the block.
+func (nc *NibiruSDK) ethReceiptFromTxResponse(
+	ctx context.Context, txResp *sdk.TxResponse, txIndex uint32, ethTxHash gethcommon.Hash,
+) (*gethcore.Receipt, error) {
+	if txResp.Code != 0 {
+		return nil, fmt.Errorf(
+			"tx %s of eth tx %s failed with code %d: %s",
+			txResp.TxHash, ethTxHash.Hex(), txResp.Code, txResp.RawLog,
+		)
+	}
+
+	var ethTx *gethcore.Transaction
+	cosmosTx, err := nc.EncCfg.TxConfig.TxDecoder()(txResp.Tx.Value)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range cosmosTx.GetMsgs() {
+		if ethMsg, ok := msg.(*evm.MsgEthereumTx); ok && ethMsg.Hash == ethTxHash.Hex() {
+			ethTx = ethMsg.AsTransaction()
+		}
+	}
+	if ethTx == nil {
+		return nil, fmt.Errorf("eth tx %s not found in tx %s", ethTxHash.Hex(), txResp.TxHash)
+	}
+
+	txMsgData := new(sdk.TxMsgData)
+	dataBz, err := hex.DecodeString(txResp.Data)
+	if err == nil {
+		err = nc.EncCfg.Codec.Unmarshal(dataBz, txMsgData)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx data: %w", err)
+	}
+	var evmResp *evm.MsgEthereumTxResponse
+	for _, msgResp := range txMsgData.MsgResponses {
+		resp := new(evm.MsgEthereumTxResponse)
+		if err := nc.EncCfg.Codec.Unmarshal(msgResp.Value, resp); err == nil && resp.Hash == ethTxHash.Hex() {
+			evmResp = resp
+		}
+	}
+	if evmResp == nil {
+		return nil, fmt.Errorf("response of eth tx %s not found in tx %s", ethTxHash.Hex(), txResp.TxHash)
+	}
+
+	parsedTxs, err := rpc.ParseTxResult(
+		&abci.ResponseDeliverTx{Events: txResp.Events, GasUsed: txResp.GasUsed}, cosmosTx,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse events of tx %s: %w", txResp.TxHash, err)
+	}
+	parsedTx := parsedTxs.GetTxByHash(ethTxHash)
+	if parsedTx == nil || parsedTx.EthTxIndex < 0 {
+		return nil, fmt.Errorf("index of eth tx %s not found in tx %s", ethTxHash.Hex(), txResp.TxHash)
+	}
+
+	height := txResp.Height
+	block, err := nc.CometRPC.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query block %d: %w", height, err)
+	}
+	blockResults, err := nc.CometRPC.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query results of block %d: %w", height, err)
+	}
+	if int(txIndex) >= len(blockResults.TxsResults) {
+		return nil, fmt.Errorf("tx %s not found in the results of block %d", txResp.TxHash, height)
+	}
+	cumulativeGasUsed := parsedTxs.AccumulativeGasUsed(parsedTx.MsgIndex)
+	for _, txResult := range blockResults.TxsResults[:txIndex] {
+		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- gas used is never negative
+	}
+
+	logs := evm.LogsToEthereum(evmResp.Logs)
+	blockHash := gethcommon.BytesToHash(block.Block.Header.Hash())
+	for _, log := range logs {
+		log.BlockHash = blockHash
+		log.TxIndex = uint(parsedTx.EthTxIndex)
+	}
+	receipt := &gethcore.Receipt{
+		Type:              ethTx.Type(),
+		Status:            gethcore.ReceiptStatusSuccessful,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Logs:              logs,
+		TxHash:            ethTxHash,
+		GasUsed:           evmResp.GasUsed,
+		BlockHash:         blockHash,
+		BlockNumber:       big.NewInt(height),
+		TransactionIndex:  uint(parsedTx.EthTxIndex),
+	}
+	if evmResp.Failed() {
+		receipt.Status = gethcore.ReceiptStatusFailed
+	}
+	if ethTx.To() == nil {
+		from, err := gethcore.Sender(gethcore.LatestSignerForChainID(ethTx.ChainId()), ethTx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recover sender of eth tx: %w", err)
+		}
+		receipt.ContractAddress = crypto.CreateAddress(from, ethTx.Nonce())
+	}
+	receipt.Bloom = gethcore.CreateBloom(gethcore.Receipts{receipt})
+	return receipt, nil
+}
+
+// PrecompileContract is a precompiled contract of the EVM module along with
+// the ABI of its embedded Solidity interface.
+type PrecompileContract struct {
+	Address gethcommon.Address
+	ABI     *gethabi.ABI
+}
+
+var (
+	PrecompileFunToken = PrecompileContract{
+		Address: precompile.PrecompileAddr_FunToken,
+		ABI:     embeds.SmartContract_FunToken.ABI,
+	}
+	PrecompileOracle = PrecompileContract{
+		Address: precompile.PrecompileAddr_Oracle,
+		ABI:     embeds.SmartContract_Oracle.ABI,
+	}
+	PrecompileWasm = PrecompileContract{
+		Address: precompile.PrecompileAddr_Wasm,
+		ABI:     embeds.SmartContract_Wasm.ABI,
+	}
+)
+
+// TxArgs returns the args of an Ethereum tx from the given sender that calls
+// a method of the precompile.
+func (p PrecompileContract) TxArgs(
+	from gethcommon.Address, method string, args ...any,
+) (EthTxArgs, error) {
+	input, err := p.ABI.Pack(method, args...)
+	if err != nil {
+		return EthTxArgs{}, err
+	}
+	return EthTxArgs{From: from, To: &p.Address, Input: input}, nil
+}
+
+// Call calls a method of the precompile with [NibiruSDK.EthCall] and returns
+// the unpacked outputs.
+func (p PrecompileContract) Call(
+	nc *NibiruSDK, from gethcommon.Address, method string, args ...any,
+) ([]any, error) {
+	txArgs, err := p.TxArgs(from, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := nc.EthCall(txArgs)
+	if err != nil {
+		return nil, err
+	}
+	return p.ABI.Unpack(method, resp.Ret)
+}
@@ -3,16 +3,24 @@ package gosdk
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc"
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	ethcryptocodec "github.com/NibiruChain/nibiru/v2/eth/crypto/codec"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -22,6 +30,7 @@ type NibiruSDK struct {
 	EncCfg           app.EncodingConfig
 	Querier          Querier
 	CometRPC         cmtrpcclient.Client
+	RPCEndpoint      string
 	AccountRetriever authtypes.AccountRetriever
 	GrpcClient       *grpc.ClientConn
 }
@@ -33,7 +42,8 @@ func NewNibiruSdk(
 ) (NibiruSDK, error) {
 	EnsureNibiruPrefix()
 	encCfg := app.MakeEncodingConfig()
-	keyring := keyring.NewInMemory(encCfg.Codec)
+	ethcryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+	keyring := keyring.NewInMemory(encCfg.Codec, ethhd.EthSecp256k1Option())
 	queryClient, err := NewQuerier(grpcConn)
 	if err != nil {
 		return NibiruSDK{}, err
@@ -48,6 +58,7 @@ func NewNibiruSdk(
 		EncCfg:           encCfg,
 		Querier:          queryClient,
 		CometRPC:         cometRpc,
+		RPCEndpoint:      rpcEndpt,
 		AccountRetriever: authtypes.AccountRetriever{},
 		GrpcClient:       grpcConn,
 	}, err
@@ -72,6 +83,60 @@ func (nc *NibiruSDK) TxByHash(txHashHex string) (*cmtcoretypes.ResultTx, error)
 	return res, err
 }
 
+// txPollInterval is the time between queries for a tx in [NibiruSDK.WaitForTx].
+const txPollInterval = 500 * time.Millisecond
+
+// WaitForTx queries the node for the tx with the given hash until it is
+// included in a block or the context is done. It returns the decoded tx
+// response along with the typed events that the tx emitted. A tx that is
+// included but fails returns its response without an error, so callers should
+// check the response code.
+func (nc *NibiruSDK) WaitForTx(
+	ctx context.Context, txHashHex string,
+) (txResp *sdk.TxResponse, typedEvents []gogoproto.Message, err error) {
+	txClient := sdktx.NewServiceClient(nc.Querier.ClientConn)
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+	for {
+		resp, queryErr := txClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: txHashHex})
+		if queryErr == nil {
+			return resp.TxResponse, ParseTypedEvents(resp.TxResponse.Events), nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf(
+				"tx %s not found before %w: %s", txHashHex, ctx.Err(), queryErr,
+			)
+		case <-ticker.C:
+		}
+	}
+}
+
+// ParseTypedEvents returns the typed events, or those emitted from protobuf
+// messages, among the given events. Other events are skipped.
+func ParseTypedEvents(events []abci.Event) (typedEvents []gogoproto.Message) {
+	for _, event := range events {
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		typedEvents = append(typedEvents, typedEvent)
+	}
+	return typedEvents
+}
+
+// NodeMinGasPrices returns the minimum gas prices that the node accepts for
+// txs in its mempool.
+func (nc *NibiruSDK) NodeMinGasPrices() (sdk.DecCoins, error) {
+	resp, err := node.NewServiceClient(nc.Querier.ClientConn).Config(
+		context.Background(), &node.ConfigRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.ParseDecCoins(resp.MinimumGasPrice)
+}
+
 func TxHashHexToBytes(txHashHex string) ([]byte, error) {
 	return hex.DecodeString(txHashHex)
 }
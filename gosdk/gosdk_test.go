@@ -1,18 +1,32 @@
 package gosdk_test
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
+	ethhd "github.com/NibiruChain/nibiru/v2/eth/crypto/hd"
 	"github.com/NibiruChain/nibiru/v2/gosdk"
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testnetwork"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -89,6 +103,22 @@ func (s *TestSuite) TestNewNibiruSdk() {
 		}
 		s.DoTestBroadcastMsgsGrpc()
 	})
+	s.Run("DoTestBroadcastMsgsWithOptions", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestBroadcastMsgsWithOptions()
+	})
+	s.Run("DoTestEthTx", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestEthTx()
+	})
+	s.Run("DoTestSequenceManager", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestSequenceManager()
+	})
+	s.Run("DoTestSubscribe", func() {
+		s.NoError(s.network.WaitForNextBlock())
+		s.DoTestSubscribe()
+	})
 	s.Run("DoTestNewQueryClient", func() {
 		_, err := gosdk.NewQuerier(s.grpcConn)
 		s.NoError(err)
@@ -141,6 +171,275 @@ func (s *TestSuite) DoTestBroadcastMsgsGrpc() (txHashHex string) {
 	return txHashHex
 }
 
+func (s *TestSuite) DoTestBroadcastMsgsWithOptions() {
+	from := s.val.Address
+	msg := &tftypes.MsgCreateDenom{Sender: from.String(), Subdenom: "gosdk"}
+
+	args := s.nibiruSdk.NewBroadcastArgs(gosdk.BroadcasterTmRpc{RPC: s.nibiruSdk.CometRPC})
+	args.SimulateGas = true
+	args.UseNodeMinGasPrices = true
+	args.Memo = "gosdk memo"
+	txResp, err := gosdk.BroadcastMsgs(args, from, msg)
+	s.Require().NoError(err)
+	txHashHex := s.AssertTxResponseSuccess(txResp)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	txResp, typedEvents, err := s.nibiruSdk.WaitForTx(ctx, txHashHex)
+	s.Require().NoError(err)
+	s.EqualValuesf(0, txResp.Code, "raw log: %s", txResp.RawLog)
+	s.Less(txResp.GasWanted, int64(gosdk.DefaultGasLimit))
+	s.LessOrEqual(txResp.GasUsed, txResp.GasWanted)
+
+	var createDenomEvent *tftypes.EventCreateDenom
+	for _, typedEvent := range typedEvents {
+		if event, ok := typedEvent.(*tftypes.EventCreateDenom); ok {
+			createDenomEvent = event
+		}
+	}
+	s.Require().NotNil(createDenomEvent)
+	s.Equal(tftypes.TFDenom{Creator: from.String(), Subdenom: "gosdk"}.Denom().String(), createDenomEvent.Denom)
+
+	minGasPrices, err := s.nibiruSdk.NodeMinGasPrices()
+	s.Require().NoError(err)
+	s.False(minGasPrices.Empty())
+
+	s.Run("WaitForTx: unknown tx hash", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, _, err := s.nibiruSdk.WaitForTx(ctx, strings.Repeat("AB", 32))
+		s.ErrorContains(err, "not found")
+	})
+}
+
+func (s *TestSuite) DoTestEthTx() {
+	s.T().Log("Create an eth_secp256k1 key and fund it from the validator")
+	ethSdk, err := gosdk.NewNibiruSdk(s.cfg.ChainID, s.grpcConn, s.val.RPCAddress)
+	s.Require().NoError(err)
+	record, _, err := ethSdk.Keyring.NewMnemonic(
+		"evm", keyring.English, eth.BIP44HDPath, keyring.DefaultBIP39Passphrase, ethhd.EthSecp256k1,
+	)
+	s.Require().NoError(err)
+	nibiAddr, err := record.GetAddress()
+	s.Require().NoError(err)
+	sender := eth.NibiruAddrToEthAddr(nibiAddr)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	txResp, err := s.nibiruSdk.BroadcastMsgs(s.val.Address, banktypes.NewMsgSend(
+		s.val.Address, nibiAddr, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 100_000_000)),
+	))
+	s.Require().NoError(err)
+	_, _, err = s.nibiruSdk.WaitForTx(ctx, s.AssertTxResponseSuccess(txResp))
+	s.Require().NoError(err)
+
+	s.T().Log("Deploy an ERC20 with a legacy tx")
+	erc20Addr, msg, txResp, err := ethSdk.DeployContract(sender, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+	s.Equal(uint8(gethcore.LegacyTxType), msg.AsTransaction().Type())
+	receipt, err := ethSdk.WaitForEthReceipt(ctx, msg.AsTransaction().Hash())
+	s.Require().NoError(err)
+	s.Equal(gethcore.ReceiptStatusSuccessful, receipt.Status)
+	s.Equal(erc20Addr, receipt.ContractAddress)
+
+	erc20Abi := embeds.SmartContract_TestERC20.ABI
+	balanceOf := func(addr gethcommon.Address) *big.Int {
+		input, err := erc20Abi.Pack("balanceOf", addr)
+		s.Require().NoError(err)
+		resp, err := ethSdk.EthCall(gosdk.EthTxArgs{From: sender, To: &erc20Addr, Input: input})
+		s.Require().NoError(err)
+		out, err := erc20Abi.Unpack("balanceOf", resp.Ret)
+		s.Require().NoError(err)
+		return out[0].(*big.Int)
+	}
+	initialBalance := balanceOf(sender)
+	s.Equal(1, initialBalance.Sign())
+
+	s.T().Log("Transfer ERC20 tokens with a dynamic fee tx")
+	recipient := gethcommon.BytesToAddress(testutil.AccAddress())
+	input, err := erc20Abi.Pack("transfer", recipient, big.NewInt(420))
+	s.Require().NoError(err)
+	baseFee, err := ethSdk.EthBaseFee()
+	s.Require().NoError(err)
+	msg, txResp, err = ethSdk.SendEthTx(gosdk.EthTxArgs{
+		From:      sender,
+		To:        &erc20Addr,
+		Input:     input,
+		GasFeeCap: new(big.Int).Mul(baseFee, big.NewInt(2)),
+	})
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+	s.Equal(uint8(gethcore.DynamicFeeTxType), msg.AsTransaction().Type())
+	receipt, err = ethSdk.WaitForEthReceipt(ctx, msg.AsTransaction().Hash())
+	s.Require().NoError(err)
+	s.Equal(gethcore.ReceiptStatusSuccessful, receipt.Status)
+	s.Require().Len(receipt.Logs, 1)
+	s.Equal(erc20Abi.Events["Transfer"].ID, receipt.Logs[0].Topics[0])
+	s.Equal(big.NewInt(420), balanceOf(recipient))
+
+	s.T().Log("The receipt matches the one of eth_getTransactionReceipt")
+	rpcReceipt, err := s.val.EthRpcBackend.GetTransactionReceipt(msg.AsTransaction().Hash())
+	s.Require().NoError(err)
+	s.Require().NotNil(rpcReceipt)
+	s.Equal(rpcReceipt.BlockHash, receipt.BlockHash)
+	s.Equal(rpcReceipt.BlockNumber, receipt.BlockNumber)
+	s.Equal(rpcReceipt.TransactionIndex, receipt.TransactionIndex)
+	s.Equal(rpcReceipt.CumulativeGasUsed, receipt.CumulativeGasUsed)
+	s.Equal(rpcReceipt.GasUsed, receipt.GasUsed)
+
+	s.T().Log("Call the FunToken precompile")
+	out, err := gosdk.PrecompileFunToken.Call(&ethSdk, sender, "whoAmI", nibiAddr.String())
+	s.Require().NoError(err)
+	s.Len(out, 1)
+}
+
+func (s *TestSuite) DoTestSequenceManager() {
+	args := s.nibiruSdk.NewBroadcastArgs(gosdk.BroadcasterTmRpc{RPC: s.nibiruSdk.CometRPC})
+	from, _, _, msgSend := s.msgSendVars()
+	seqManager := gosdk.NewSequenceManager(args, from)
+
+	broadcastConcurrently := func(numTxs int, broadcast func() (*sdk.TxResponse, error)) {
+		var wg sync.WaitGroup
+		txResps := make([]*sdk.TxResponse, numTxs)
+		errs := make([]error, numTxs)
+		for idx := 0; idx < numTxs; idx++ {
+			wg.Add(1)
+			go func(idx int) {
+				defer wg.Done()
+				txResps[idx], errs[idx] = broadcast()
+			}(idx)
+		}
+		wg.Wait()
+		for idx := range txResps {
+			s.Require().NoError(errs[idx])
+			s.EqualValuesf(0, txResps[idx].Code, "raw log: %s", txResps[idx].RawLog)
+		}
+	}
+
+	s.T().Log("Broadcast concurrently from the same account")
+	broadcastConcurrently(8, func() (*sdk.TxResponse, error) {
+		return seqManager.BroadcastMsgs(from, msgSend)
+	})
+
+	s.T().Log("Resync after a tx is broadcast without the manager")
+	s.NoError(s.network.WaitForNextBlock())
+	_, _, _, msgSend = s.msgSendVars()
+	txResp, err := s.nibiruSdk.BroadcastMsgs(from, msgSend)
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+	_, _, _, msgSend = s.msgSendVars()
+	txResp, err = seqManager.BroadcastMsgs(from, msgSend)
+	s.Require().NoError(err)
+	s.EqualValuesf(0, txResp.Code, "raw log: %s", txResp.RawLog)
+
+	s.T().Log("Fan out across two senders")
+	otherSender := testnetwork.NewAccount(s.network, "seq-manager")
+	txResp, err = seqManager.BroadcastMsgs(from, banktypes.NewMsgSend(
+		from, otherSender, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10_000_000)),
+	))
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+	s.NoError(s.network.WaitForNextBlock())
+	s.NoError(s.network.WaitForNextBlock())
+
+	seqManager = gosdk.NewSequenceManager(args, from, otherSender)
+	var mu sync.Mutex
+	usedSenders := make(map[string]int)
+	broadcastConcurrently(6, func() (*sdk.TxResponse, error) {
+		sender, txResp, err := seqManager.BroadcastMsgsFromAny(func(sender sdk.AccAddress) []sdk.Msg {
+			return []sdk.Msg{banktypes.NewMsgSend(
+				sender, testutil.AccAddress(), sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1)),
+			)}
+		})
+		mu.Lock()
+		defer mu.Unlock()
+		usedSenders[sender.String()]++
+		return txResp, err
+	})
+	s.Equal(3, usedSenders[from.String()])
+	s.Equal(3, usedSenders[otherSender.String()])
+}
+
+func (s *TestSuite) DoTestSubscribe() {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	s.T().Log("Resume from the first block: EVM txs sent by earlier tests")
+	evmEvents, _, err := s.nibiruSdk.Subscribe(ctx, gosdk.QueryEvmTxs, 1)
+	s.Require().NoError(err)
+	var (
+		ethTxEvent         *evm.EventEthereumTx
+		contractDeployedEv *evm.EventContractDeployed
+	)
+	for ethTxEvent == nil || contractDeployedEv == nil {
+		event, ok := <-evmEvents
+		s.Require().True(ok, "subscription closed before the EVM events")
+		s.NotEmpty(event.TxHash)
+		s.Positive(event.Height)
+		switch typedEvent := event.Event.(type) {
+		case *evm.EventEthereumTx:
+			ethTxEvent = typedEvent
+		case *evm.EventContractDeployed:
+			contractDeployedEv = typedEvent
+		}
+	}
+	s.NotEmpty(ethTxEvent.EthHash)
+	s.NotEmpty(contractDeployedEv.ContractAddr)
+
+	s.T().Log("Follow new txs")
+	createDenomQuery := fmt.Sprintf(
+		"tm.event='Tx' AND %s.denom EXISTS", gogoproto.MessageName(new(tftypes.EventCreateDenom)),
+	)
+	tfEvents, tfErrs, err := s.nibiruSdk.Subscribe(ctx, createDenomQuery, 0)
+	s.Require().NoError(err)
+	from := s.val.Address
+	txResp, err := s.nibiruSdk.BroadcastMsgs(
+		from, &tftypes.MsgCreateDenom{Sender: from.String(), Subdenom: "subscribe"},
+	)
+	s.Require().NoError(err)
+	txHashHex := s.AssertTxResponseSuccess(txResp)
+	for event := range tfEvents {
+		createDenomEvent, ok := event.Event.(*tftypes.EventCreateDenom)
+		if !ok {
+			continue
+		}
+		s.Equal(txHashHex, event.TxHash)
+		s.Equal(tftypes.TFDenom{Creator: from.String(), Subdenom: "subscribe"}.Denom().String(), createDenomEvent.Denom)
+		break
+	}
+
+	s.T().Log("Follow EndBlock events")
+	bloomEvents, _, err := s.nibiruSdk.Subscribe(
+		ctx, "tm.event='NewBlock' AND eth.evm.v1.EventBlockBloom.bloom EXISTS", 0,
+	)
+	s.Require().NoError(err)
+	event := <-bloomEvents
+	s.Empty(event.TxHash)
+	s.IsType(new(evm.EventBlockBloom), event.Event)
+
+	s.T().Log("Failures of the subscription are sent to the error channel")
+	unreachableSdk := s.nibiruSdk
+	unreachableSdk.RPCEndpoint = "tcp://127.0.0.1:1"
+	_, unreachableErrs, err := unreachableSdk.Subscribe(ctx, gosdk.QueryEvmTxs, 1)
+	s.Require().NoError(err)
+	select {
+	case err := <-unreachableErrs:
+		s.ErrorContains(err, "subscription failed at height 1")
+	case <-ctx.Done():
+		s.Fail("no error from a subscription to an unreachable node")
+	}
+
+	s.T().Log("Channels close when the context is done")
+	cancel()
+	for range tfEvents { //nolint:revive // drains the channel until it closes
+	}
+	for range tfErrs { //nolint:revive // drains the channel until it closes
+	}
+	_, _, err = s.nibiruSdk.Subscribe(ctx, "tm.event=", 0)
+	s.ErrorContains(err, "invalid event query")
+}
+
 func (s *TestSuite) TearDownSuite() {
 	s.T().Log("tearing down integration test suite")
 	s.network.Cleanup()
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/NibiruChain/nibiru/v2/app"
 )
 
 // GetGRPCConnection establishes a connection to a gRPC server using either
@@ -27,6 +30,11 @@ func GetGRPCConnection(
 	options := []grpc.DialOption{
 		grpc.WithBlock(),
 		grpc.WithTransportCredentials(creds),
+		// The gogoproto codec is needed to decode fields with custom types,
+		// like the "sdkmath.Int" fields of the EVM module queries.
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(
+			codec.NewProtoCodec(app.MakeEncodingConfig().InterfaceRegistry).GRPCCodec(),
+		)),
 	}
 	timeout := time.Duration(timeoutSeconds) * time.Second
 	ctx, cancel := context.WithTimeout(
@@ -0,0 +1,149 @@
+package gosdk
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SequenceManager hands out account sequences to txs broadcast concurrently
+// from the same accounts, so that callers don't have to query the sequence of
+// an account for every tx or serialize their txs to avoid "account sequence
+// mismatch" errors.
+//
+// Like "eth/rpc.AddrLocker" does for EVM nonces in the JSON-RPC server, each
+// account has a mutex that is held while a tx is signed and broadcast, which
+// makes the sequences of an account monotonically increasing. A sequence is
+// only used up when the tx passes CheckTx. On a sequence mismatch, the
+// sequence of the account is resynced from the node and the tx is retried once.
+type SequenceManager struct {
+	args    BroadcastArgs
+	senders []sdk.AccAddress
+
+	// mu protects access to the accounts map and the index of the next sender
+	mu         sync.Mutex
+	accounts   map[string]*accountSequence
+	nextSender int
+}
+
+// accountSequence is the next sequence of an account. The sequence is
+// queried from the node when it isn't synced.
+type accountSequence struct {
+	mu     sync.Mutex
+	seq    uint64
+	synced bool
+}
+
+// NewSequenceManager returns a SequenceManager that broadcasts txs with the
+// given args. The senders are the accounts used by [SequenceManager.BroadcastMsgsFromAny].
+func NewSequenceManager(
+	args BroadcastArgs, senders ...sdk.AccAddress,
+) *SequenceManager {
+	return &SequenceManager{
+		args:     args,
+		senders:  senders,
+		accounts: make(map[string]*accountSequence),
+	}
+}
+
+// account returns the sequence of the given account, creating it if needed.
+func (m *SequenceManager) account(addr sdk.AccAddress) *accountSequence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.accounts[addr.String()]; !ok {
+		m.accounts[addr.String()] = new(accountSequence)
+	}
+	return m.accounts[addr.String()]
+}
+
+// BroadcastMsgs broadcasts the msgs from the given account with its next
+// sequence. It blocks while another tx from the same account is broadcast.
+func (m *SequenceManager) BroadcastMsgs(
+	from sdk.AccAddress, msgs ...sdk.Msg,
+) (*sdk.TxResponse, error) {
+	acc := m.account(from)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		if !acc.synced {
+			nums, err := m.args.gosdk.GetAccountNumbers(from.String())
+			if err != nil {
+				return nil, err
+			}
+			acc.seq, acc.synced = nums.Sequence, true
+		}
+
+		txResp, err := BroadcastMsgsWithSeq(m.args, from, acc.seq, msgs...)
+		switch {
+		case err != nil:
+			// The tx may or may not have reached the mempool.
+			acc.synced = false
+			return txResp, err
+		case txResp.Code == 0:
+			acc.seq++
+			return txResp, nil
+		case isSequenceMismatch(txResp) && attempt == 0:
+			if expectedSeq, ok := expectedSequence(txResp.RawLog); ok {
+				acc.seq = expectedSeq
+			} else {
+				acc.synced = false
+			}
+			continue
+		default:
+			return txResp, nil
+		}
+	}
+}
+
+// BroadcastMsgsFromAny broadcasts msgs from the next sender of the manager in
+// round-robin order, fanning txs out across the senders. The msgs are built
+// with newMsgs for the chosen sender, which is returned.
+func (m *SequenceManager) BroadcastMsgsFromAny(
+	newMsgs func(from sdk.AccAddress) []sdk.Msg,
+) (from sdk.AccAddress, txResp *sdk.TxResponse, err error) {
+	m.mu.Lock()
+	if len(m.senders) == 0 {
+		m.mu.Unlock()
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("sequence manager has no senders")
+	}
+	from = m.senders[m.nextSender%len(m.senders)]
+	m.nextSender++
+	m.mu.Unlock()
+
+	txResp, err = m.BroadcastMsgs(from, newMsgs(from)...)
+	return from, txResp, err
+}
+
+// Resync makes the next tx from the given account query its sequence from
+// the node. This is needed after broadcasting txs from the account without
+// the manager.
+func (m *SequenceManager) Resync(addr sdk.AccAddress) {
+	acc := m.account(addr)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	acc.synced = false
+}
+
+func isSequenceMismatch(txResp *sdk.TxResponse) bool {
+	return txResp.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+		txResp.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+var expectedSequenceRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// expectedSequence parses the sequence expected by the node from the log of a
+// tx that failed with a sequence mismatch. The node checks the sequence
+// against its mempool state, which is ahead of the committed state returned
+// by the account query when there are pending txs.
+func expectedSequence(rawLog string) (seq uint64, ok bool) {
+	matches := expectedSequenceRegex.FindStringSubmatch(rawLog)
+	if len(matches) != 2 {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(matches[1], 10, 64)
+	return seq, err == nil
+}
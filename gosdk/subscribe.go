@@ -0,0 +1,279 @@
+package gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+)
+
+// Queries for common events, to be passed to [NibiruSDK.Subscribe]. The
+// attribute values of typed events are JSON encoded, so string values in a
+// query must be quoted, e.g. `nibiru.oracle.v1.EventPriceUpdate.pair='"ubtc:unusd"'`.
+const (
+	// QueryEvmTxs matches the txs that execute Ethereum txs. These txs emit
+	// the events of "Keeper.EmitEthereumTxEvents", such as
+	// "eth.evm.v1.EventEthereumTx", and "eth.evm.v1.EventTxLog".
+	QueryEvmTxs = "tm.event='Tx' AND message.module='evm'"
+	// QueryOraclePriceUpdates matches the blocks in which the oracle posts
+	// prices, which emit "nibiru.oracle.v1.EventPriceUpdate" in EndBlock.
+	QueryOraclePriceUpdates = "tm.event='NewBlock' AND nibiru.oracle.v1.EventPriceUpdate.pair EXISTS"
+	// QueryDevGasPayouts matches the txs that pay out gas fees to the
+	// developers of the contracts they execute.
+	QueryDevGasPayouts = "tm.event='Tx' AND nibiru.devgas.v1.EventPayoutDevGas.payouts EXISTS"
+)
+
+const (
+	// subscribeRetryInterval is the time before the first reconnection
+	// attempt of a subscription. It doubles after each failed attempt, up to
+	// subscribeMaxRetryInterval.
+	subscribeRetryInterval = 2 * time.Second
+	// subscribeMaxRetryInterval is the longest time between reconnection
+	// attempts of a subscription.
+	subscribeMaxRetryInterval = time.Minute
+	// subscribeStallTimeout is the time after which a subscription that has
+	// received no new block header reconnects.
+	subscribeStallTimeout = 30 * time.Second
+	// subscribeBufferSize is the capacity of the channel of subscribed events.
+	subscribeBufferSize = 100
+)
+
+// SubscribedEvent is a typed event delivered by [NibiruSDK.Subscribe].
+type SubscribedEvent struct {
+	// Height: Block height at which the event was emitted.
+	Height int64
+	// TxHash: Hex hash of the tx that emitted the event. It is empty for
+	// events emitted in BeginBlock or EndBlock.
+	TxHash string
+	// Event: Decoded typed event, for example [*evm.EventEthereumTx].
+	Event gogoproto.Message
+}
+
+// Subscribe returns a channel of the typed events emitted by the txs and
+// blocks that match the given CometBFT event query, such as [QueryEvmTxs].
+// Like the CometBFT event bus, tx events are matched with the "tm.event='Tx'",
+// "tx.hash", and "tx.height" keys, while BeginBlock and EndBlock events are
+// matched with "tm.event='NewBlock'". Events that aren't typed are skipped.
+//
+// Events are delivered from fromHeight onwards, or from the next block if
+// fromHeight is not positive, in the order they were emitted. The
+// subscription follows new block headers over a websocket and reads the
+// events of each block from its block results, so it resumes from the last
+// delivered block after reconnecting, without missing or repeating events.
+// Reconnection attempts back off exponentially while they keep failing.
+//
+// Each failure of the subscription, such as a disconnect or a failed query,
+// is sent to the returned error channel before reconnecting. Errors are
+// dropped while the error channel is full, so the events keep flowing for
+// callers that don't read it. Both channels are closed when ctx is done.
+func (nc *NibiruSDK) Subscribe(
+	ctx context.Context, query string, fromHeight int64,
+) (<-chan SubscribedEvent, <-chan error, error) {
+	q, err := cmtquery.New(query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid event query %q: %w", query, err)
+	}
+	if fromHeight <= 0 {
+		status, err := nc.CometRPC.Status(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		fromHeight = status.SyncInfo.LatestBlockHeight + 1
+	}
+
+	out := make(chan SubscribedEvent, subscribeBufferSize)
+	errs := make(chan error, subscribeBufferSize)
+	go func() {
+		defer close(out)
+		defer close(errs)
+		nextHeight := fromHeight
+		retryInterval := subscribeRetryInterval
+		for {
+			// followBlocks only returns when the subscription fails or ctx
+			// is done. The next connection resumes from nextHeight.
+			startHeight := nextHeight
+			err := nc.followBlocks(ctx, q, &nextHeight, out)
+			if ctx.Err() != nil {
+				return
+			}
+			if nextHeight > startHeight {
+				retryInterval = subscribeRetryInterval
+			}
+			select {
+			case errs <- fmt.Errorf(
+				"subscription failed at height %d, reconnecting in %s: %w",
+				nextHeight, retryInterval, err,
+			):
+			default:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+			retryInterval = min(2*retryInterval, subscribeMaxRetryInterval)
+		}
+	}()
+	return out, errs, nil
+}
+
+// followBlocks connects to the websocket of the node and delivers the
+// events of every block from nextHeight up to the latest block, then of
+// each new block as its header is received. nextHeight is advanced once all
+// of the events of a block are delivered.
+func (nc *NibiruSDK) followBlocks(
+	ctx context.Context,
+	q *cmtquery.Query,
+	nextHeight *int64,
+	out chan<- SubscribedEvent,
+) error {
+	client, err := NewRPCClient(nc.RPCEndpoint, "/websocket")
+	if err != nil {
+		return err
+	}
+	if err := client.Start(); err != nil {
+		return err
+	}
+	defer client.Stop() //nolint:errcheck
+
+	headers, err := client.Subscribe(
+		ctx, "gosdk", cmttypes.QueryForEvent(cmttypes.EventNewBlockHeader).String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	latestHeight := status.SyncInfo.LatestBlockHeight
+	for {
+		for ; *nextHeight <= latestHeight; *nextHeight++ {
+			if err := deliverBlockEvents(ctx, client, q, *nextHeight, out); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(subscribeStallTimeout):
+			return fmt.Errorf("no new block header in %s", subscribeStallTimeout)
+		case resultEvent, ok := <-headers:
+			if !ok {
+				return errors.New("block header subscription closed")
+			}
+			if header, ok := resultEvent.Data.(cmttypes.EventDataNewBlockHeader); ok {
+				latestHeight = header.Header.Height
+			}
+		}
+	}
+}
+
+// deliverBlockEvents sends the typed events of the block at the given height
+// that match the query to out: first those of BeginBlock, then those of each
+// tx in order, and last those of EndBlock.
+func deliverBlockEvents(
+	ctx context.Context,
+	client cmtrpcclient.Client,
+	q *cmtquery.Query,
+	height int64,
+	out chan<- SubscribedEvent,
+) error {
+	block, err := client.Block(ctx, &height)
+	if err != nil {
+		return err
+	}
+	blockResults, err := client.BlockResults(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	var events []SubscribedEvent
+	blockMatches, err := q.Matches(eventsByCompositeKey(
+		map[string]string{cmttypes.EventTypeKey: cmttypes.EventNewBlock},
+		blockResults.BeginBlockEvents, blockResults.EndBlockEvents,
+	))
+	if err != nil {
+		return err
+	}
+	if blockMatches {
+		events = appendTypedEvents(events, height, "", blockResults.BeginBlockEvents)
+	}
+	for idx, txResult := range blockResults.TxsResults {
+		txHash := fmt.Sprintf("%X", block.Block.Txs[idx].Hash())
+		txMatches, err := q.Matches(eventsByCompositeKey(
+			map[string]string{
+				cmttypes.EventTypeKey: cmttypes.EventTx,
+				cmttypes.TxHashKey:    txHash,
+				cmttypes.TxHeightKey:  fmt.Sprintf("%d", height),
+			},
+			txResult.Events,
+		))
+		if err != nil {
+			return err
+		}
+		if txMatches {
+			events = appendTypedEvents(events, height, txHash, txResult.Events)
+		}
+	}
+	if blockMatches {
+		events = appendTypedEvents(events, height, "", blockResults.EndBlockEvents)
+	}
+
+	for _, event := range events {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case out <- event:
+		}
+	}
+	return nil
+}
+
+// eventsByCompositeKey maps the "{eventType}.{attrKey}" composite keys of the
+// given events to their attribute values, together with the predefined keys,
+// in the same way as the CometBFT event bus does before matching queries.
+func eventsByCompositeKey(
+	predefined map[string]string, eventLists ...[]abci.Event,
+) map[string][]string {
+	compositeKeys := make(map[string][]string)
+	for key, value := range predefined {
+		compositeKeys[key] = append(compositeKeys[key], value)
+	}
+	for _, events := range eventLists {
+		for _, event := range events {
+			if len(event.Type) == 0 {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if len(attr.Key) == 0 {
+					continue
+				}
+				compositeKey := event.Type + "." + attr.Key
+				compositeKeys[compositeKey] = append(compositeKeys[compositeKey], attr.Value)
+			}
+		}
+	}
+	return compositeKeys
+}
+
+func appendTypedEvents(
+	subscribed []SubscribedEvent, height int64, txHash string, events []abci.Event,
+) []SubscribedEvent {
+	for _, typedEvent := range ParseTypedEvents(events) {
+		subscribed = append(subscribed, SubscribedEvent{
+			Height: height,
+			TxHash: txHash,
+			Event:  typedEvent,
+		})
+	}
+	return subscribed
+}
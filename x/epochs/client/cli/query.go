@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdEpochInfos(),
 		GetCmdCurrentEpoch(),
+		GetCmdEpochSubscribers(),
 	)
 
 	return cmd
@@ -104,3 +105,44 @@ $ %s query epochs current-epoch day
 
 	return cmd
 }
+
+// GetCmdEpochSubscribers provides the contracts subscribed to epoch hooks.
+func GetCmdEpochSubscribers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribers [identifier]",
+		Short: "Query the contracts subscribed to the hooks of an epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the contracts subscribed to the hooks of an epoch. Returns the
+subscribers of every epoch if no identifier is given.
+
+Example:
+$ %s query epochs subscribers day
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEpochSubscribersRequest{}
+			if len(args) == 1 {
+				req.Identifier = args[0]
+			}
+			res, err := queryClient.EpochSubscribers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
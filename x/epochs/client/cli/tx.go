@@ -2,15 +2,24 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
 
-	// "github.com/cosmos/cosmos-sdk/client/flags".
 	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
 )
 
+const (
+	FlagStartTime = "start-time"
+	FlagGasLimit  = "hook-gas-limit"
+)
+
 // GetTxCmd returns the transaction commands for this module.
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -21,5 +30,206 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(
+		CmdCreateEpoch(),
+		CmdDeleteEpoch(),
+		CmdSubscribeEpochHooks(),
+		CmdUnsubscribeEpochHooks(),
+	)
+
+	return cmd
+}
+
+// CmdCreateEpoch adds a new epoch. Only callable by the module authority.
+func CmdCreateEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-epoch [identifier] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add a new epoch. Only callable by the module authority",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add a new epoch. Only callable by the module authority, which is the x/gov
+module account unless configured otherwise.
+The start time is given in RFC3339 format and defaults to the block time.
+
+Example:
+$ %s tx epochs create-epoch 2h 2h --start-time=2024-01-01T00:00:00Z --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid epoch duration: %w", err)
+			}
+
+			msg := &types.MsgCreateEpoch{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Identifier: args[0],
+				Duration:   duration,
+			}
+
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if startTimeStr != "" {
+				if msg.StartTime, err = time.Parse(time.RFC3339, startTimeStr); err != nil {
+					return fmt.Errorf("invalid start time: %w", err)
+				}
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "start time of the epoch in RFC3339 format")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdDeleteEpoch removes an epoch and its subscribers. Only callable by the
+// module authority.
+func CmdDeleteEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-epoch [identifier]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove an epoch and its subscribers. Only callable by the module authority",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove an epoch and its subscribers. Only callable by the module
+authority. The default epochs can't be removed.
+
+Example:
+$ %s tx epochs delete-epoch 2h --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeleteEpoch{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Identifier: args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSubscribeEpochHooks subscribes a sudo contract to the hooks of an epoch.
+// Only callable by the module authority.
+func CmdSubscribeEpochHooks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe [identifier] [contract]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Subscribe a sudo contract to the hooks of an epoch. Only callable by the module authority",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Subscribe a sudo contract to the "after_epoch_end" and
+"before_epoch_start" hooks of an epoch. The hooks are sent to the "sudo" entry
+point of the contract with a gas limit of --%s (default %d, max %d). An epoch
+has at most %d subscribers.
+
+EVM contracts are given by their hex address and only receive the end of each
+epoch through "onEpochEnd(string,uint256)" (see IEpochHooks.sol).
+
+Example:
+$ %s tx epochs subscribe day <contract-address> --%s=500000 --from=<key_or_address>
+$ %s tx epochs subscribe day 0x<evm-contract-address> --from=<key_or_address>
+`,
+				FlagGasLimit, types.DefaultSubscriberGasLimit, types.MaxSubscriberGasLimit,
+				types.MaxSubscribersPerEpoch,
+				version.AppName, FlagGasLimit, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(FlagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubscribeEpochHooks{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Identifier: args[0],
+				Contract:   args[1],
+				GasLimit:   gasLimit,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagGasLimit, 0, "gas limit of each hook call")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdUnsubscribeEpochHooks removes a contract from the subscribers of an
+// epoch. Only callable by the module authority.
+func CmdUnsubscribeEpochHooks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsubscribe [identifier] [contract]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove a contract from the subscribers of an epoch. Only callable by the module authority",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove a contract from the subscribers of an epoch.
+
+Example:
+$ %s tx epochs unsubscribe day <contract-address> --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnsubscribeEpochHooks{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Identifier: args[0],
+				Contract:   args[1],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
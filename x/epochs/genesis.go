@@ -1,6 +1,7 @@
 package epochs
 
 import (
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/v2/x/epochs/keeper"
@@ -18,6 +19,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			return err
 		}
 	}
+	for _, sub := range genState.Subscribers {
+		contract, _ := types.ContractAccAddr(sub.Contract)
+		k.Subscribers.Insert(ctx, collections.Join(sub.Identifier, contract), sub)
+	}
 	return
 }
 
@@ -25,6 +30,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesisFromTime(ctx.BlockTime())
 	genesis.Epochs = k.AllEpochInfos(ctx)
+	genesis.Subscribers = k.AllSubscribers(ctx)
 
 	return genesis
 }
@@ -42,3 +42,20 @@ func (q Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochReq
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// EpochSubscribers provides the contracts subscribed to the hooks of an epoch,
+// or of every epoch if no identifier is given.
+func (q Querier) EpochSubscribers(
+	c context.Context, req *types.QueryEpochSubscribersRequest,
+) (*types.QueryEpochSubscribersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Identifier == "" {
+		return &types.QueryEpochSubscribersResponse{
+			Subscribers: q.Keeper.AllSubscribers(ctx),
+		}, nil
+	}
+	return &types.QueryEpochSubscribersResponse{
+		Subscribers: q.Keeper.GetSubscribers(ctx, req.Identifier),
+	}, nil
+}
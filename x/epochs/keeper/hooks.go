@@ -2,14 +2,18 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
 )
 
 // AfterEpochEnd epoch hook
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber uint64) {
 	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	k.callSubscribers(ctx, types.HookAfterEpochEnd, identifier, epochNumber)
 }
 
 // BeforeEpochStart epoch hook
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber uint64) {
 	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	k.callSubscribers(ctx, types.HookBeforeEpochStart, identifier, epochNumber)
 }
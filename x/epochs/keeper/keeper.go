@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"github.com/cometbft/cometbft/libs/log"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
 
@@ -11,19 +13,42 @@ import (
 )
 
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey storetypes.StoreKey
-	hooks    types.EpochHooks
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	hooks      types.EpochHooks
+	sudoKeeper types.SudoKeeper
+	evmKeeper  types.EvmKeeper
+	wasmKeeper types.WasmKeeper
+	// authority is the address allowed to create and delete epochs and to
+	// manage their subscribers. Defaults to the x/gov module account.
+	authority string
 
 	Epochs collections.Map[string, types.EpochInfo]
+	// Subscribers: Contracts subscribed to the hooks of an epoch, keyed by
+	// (epoch identifier, contract address).
+	Subscribers collections.Map[collections.Pair[string, sdk.AccAddress], types.EpochSubscriber]
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	sudoKeeper types.SudoKeeper,
+	evmKeeper types.EvmKeeper,
+	authority string,
+) *Keeper {
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		sudoKeeper: sudoKeeper,
+		evmKeeper:  evmKeeper,
+		authority:  authority,
 
 		Epochs: collections.NewMap[string, types.EpochInfo](storeKey, 1, collections.StringKeyEncoder, collections.ProtoValueEncoder[types.EpochInfo](cdc)),
+		Subscribers: collections.NewMap(
+			storeKey, 2,
+			collections.PairKeyEncoder(collections.StringKeyEncoder, collections.AccAddressKeyEncoder),
+			collections.ProtoValueEncoder[types.EpochSubscriber](cdc),
+		),
 	}
 }
 
@@ -31,3 +56,20 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
 func (k *Keeper) SetHooks(eh types.EpochHooks) {
 	k.hooks = eh
 }
+
+// SetWasmKeeper sets the Wasm keeper used to call subscribed contracts. The
+// Wasm keeper is created after the x/epochs keeper, so it can't be passed to
+// [NewKeeper]. Calls to Wasm subscribers fail while it is unset.
+func (k *Keeper) SetWasmKeeper(wk types.WasmKeeper) {
+	k.wasmKeeper = wk
+}
+
+// GetAuthority returns the address allowed to send the x/epochs messages.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the epochs MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// checkAuthority returns an error if "sender" is not the module authority.
+func (ms msgServer) checkAuthority(sender string) error {
+	if sender != ms.authority {
+		return govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, sender,
+		)
+	}
+	return nil
+}
+
+// CreateEpoch: gRPC tx msg for adding a new epoch. The start time defaults to
+// the block time if left unset.
+// Only callable by the module authority.
+func (ms msgServer) CreateEpoch(
+	goCtx context.Context, msg *types.MsgCreateEpoch,
+) (*types.MsgCreateEpochResponse, error) {
+	if err := ms.checkAuthority(msg.Sender); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Stateless field validation was already performed in msg.ValidateBasic()
+	// before the current scope is reached.
+	if err := ms.AddEpochInfo(ctx, msg.EpochInfo()); err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// DeleteEpoch: gRPC tx msg for removing an epoch and its subscribers. The
+// reserved epochs other modules depend on can't be deleted.
+// Only callable by the module authority.
+func (ms msgServer) DeleteEpoch(
+	goCtx context.Context, msg *types.MsgDeleteEpoch,
+) (*types.MsgDeleteEpochResponse, error) {
+	if err := ms.checkAuthority(msg.Sender); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if types.IsReservedEpochID(msg.Identifier) {
+		return nil, fmt.Errorf("%w: %s", types.ErrReservedEpoch, msg.Identifier)
+	}
+	if err := ms.DeleteEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, fmt.Errorf("%w: %s", types.ErrEpochNotFound, msg.Identifier)
+	}
+
+	for _, sub := range ms.GetSubscribers(ctx, msg.Identifier) {
+		contract, _ := types.ContractAccAddr(sub.Contract)
+		_ = ms.Subscribers.Delete(ctx, collections.Join(msg.Identifier, contract))
+	}
+	return &types.MsgDeleteEpochResponse{}, nil
+}
+
+// SubscribeEpochHooks: gRPC tx msg for subscribing a sudo contract to the
+// hooks of an epoch.
+// Only callable by the module authority.
+func (ms msgServer) SubscribeEpochHooks(
+	goCtx context.Context, msg *types.MsgSubscribeEpochHooks,
+) (*types.MsgSubscribeEpochHooksResponse, error) {
+	if err := ms.checkAuthority(msg.Sender); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.AddSubscriber(ctx, msg.Subscriber()); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubscribeEpochHooksResponse{}, nil
+}
+
+// UnsubscribeEpochHooks: gRPC tx msg for removing a contract from the
+// subscribers of an epoch.
+// Only callable by the module authority.
+func (ms msgServer) UnsubscribeEpochHooks(
+	goCtx context.Context, msg *types.MsgUnsubscribeEpochHooks,
+) (*types.MsgUnsubscribeEpochHooksResponse, error) {
+	if err := ms.checkAuthority(msg.Sender); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contract, _ := types.ContractAccAddr(msg.Contract)
+	if err := ms.RemoveSubscriber(ctx, msg.Identifier, contract); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnsubscribeEpochHooksResponse{}, nil
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/epochs/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
+)
+
+func TestMsgServer_CreateDeleteEpoch(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(*nibiruApp.EpochsKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	require.Equal(t, authority, nibiruApp.EpochsKeeper.GetAuthority())
+
+	t.Log("create fails for senders other than the authority")
+	msgCreate := &types.MsgCreateEpoch{
+		Sender:     testutil.AccAddress().String(),
+		Identifier: "2h",
+		Duration:   2 * time.Hour,
+	}
+	_, err := msgServer.CreateEpoch(goCtx, msgCreate)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	require.False(t, nibiruApp.EpochsKeeper.EpochExists(ctx, "2h"))
+
+	t.Log("create fails for the sudo root")
+	msgCreate.Sender = testutil.ADDR_SUDO_ROOT
+	_, err = msgServer.CreateEpoch(goCtx, msgCreate)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	t.Log("create as the authority")
+	msgCreate.Sender = authority
+	_, err = msgServer.CreateEpoch(goCtx, msgCreate)
+	require.NoError(t, err)
+	epoch, err := nibiruApp.EpochsKeeper.GetEpochInfo(ctx, "2h")
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, epoch.Duration)
+	require.Equal(t, ctx.BlockTime(), epoch.StartTime)
+
+	t.Log("create fails for an existing epoch")
+	_, err = msgServer.CreateEpoch(goCtx, msgCreate)
+	require.ErrorContains(t, err, "already exists")
+
+	t.Log("delete fails for senders other than the authority")
+	msgDelete := &types.MsgDeleteEpoch{
+		Sender:     testutil.AccAddress().String(),
+		Identifier: "2h",
+	}
+	_, err = msgServer.DeleteEpoch(goCtx, msgDelete)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	t.Log("delete as the authority")
+	msgDelete.Sender = authority
+	_, err = msgServer.DeleteEpoch(goCtx, msgDelete)
+	require.NoError(t, err)
+	require.False(t, nibiruApp.EpochsKeeper.EpochExists(ctx, "2h"))
+
+	t.Log("delete fails for an unknown epoch")
+	_, err = msgServer.DeleteEpoch(goCtx, msgDelete)
+	require.ErrorIs(t, err, types.ErrEpochNotFound)
+
+	t.Log("delete fails for reserved epochs")
+	msgDelete.Identifier = types.DayEpochID
+	_, err = msgServer.DeleteEpoch(goCtx, msgDelete)
+	require.ErrorIs(t, err, types.ErrReservedEpoch)
+	require.True(t, nibiruApp.EpochsKeeper.EpochExists(ctx, types.DayEpochID))
+}
+
+func TestMsgServer_SubscribeEpochHooks(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(*nibiruApp.EpochsKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	authority := nibiruApp.EpochsKeeper.GetAuthority()
+	// The sudo root is a sudo contract, so it can be subscribed.
+	root := testutil.ADDR_SUDO_ROOT
+
+	_, err := msgServer.CreateEpoch(goCtx, &types.MsgCreateEpoch{
+		Sender: authority, Identifier: "2h", Duration: 2 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	t.Log("subscribing fails for senders other than the authority")
+	_, err = msgServer.SubscribeEpochHooks(goCtx, &types.MsgSubscribeEpochHooks{
+		Sender: root, Contract: root, Identifier: "2h",
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	t.Log("subscribing a contract that isn't a sudo contract fails")
+	msgSub := &types.MsgSubscribeEpochHooks{
+		Sender:     authority,
+		Contract:   testutil.AccAddress().String(),
+		Identifier: "2h",
+	}
+	_, err = msgServer.SubscribeEpochHooks(goCtx, msgSub)
+	require.ErrorIs(t, err, types.ErrSubscriberNotSudoer)
+
+	t.Log("subscribing to an unknown epoch fails")
+	msgSub.Contract = root
+	msgSub.Identifier = "unknown"
+	_, err = msgServer.SubscribeEpochHooks(goCtx, msgSub)
+	require.ErrorIs(t, err, types.ErrEpochNotFound)
+
+	t.Log("subscribe a sudo contract with the default gas limit")
+	msgSub.Identifier = "2h"
+	_, err = msgServer.SubscribeEpochHooks(goCtx, msgSub)
+	require.NoError(t, err)
+	require.Equal(t,
+		[]types.EpochSubscriber{{Contract: root, Identifier: "2h", GasLimit: types.DefaultSubscriberGasLimit}},
+		nibiruApp.EpochsKeeper.GetSubscribers(ctx, "2h"),
+	)
+	require.Empty(t, nibiruApp.EpochsKeeper.GetSubscribers(ctx, types.DayEpochID))
+
+	t.Log("unsubscribe")
+	msgUnsub := &types.MsgUnsubscribeEpochHooks{
+		Sender: root, Contract: root, Identifier: "2h",
+	}
+	_, err = msgServer.UnsubscribeEpochHooks(goCtx, msgUnsub)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	msgUnsub.Sender = authority
+	_, err = msgServer.UnsubscribeEpochHooks(goCtx, msgUnsub)
+	require.NoError(t, err)
+	require.Empty(t, nibiruApp.EpochsKeeper.GetSubscribers(ctx, "2h"))
+	_, err = msgServer.UnsubscribeEpochHooks(goCtx, msgUnsub)
+	require.ErrorIs(t, err, types.ErrSubscriberNotFound)
+
+	t.Log("deleting an epoch removes its subscribers")
+	_, err = msgServer.SubscribeEpochHooks(goCtx, msgSub)
+	require.NoError(t, err)
+	_, err = msgServer.DeleteEpoch(goCtx, &types.MsgDeleteEpoch{Sender: authority, Identifier: "2h"})
+	require.NoError(t, err)
+	require.Empty(t, nibiruApp.EpochsKeeper.AllSubscribers(ctx))
+}
+
+func TestMsgServer_MaxSubscribersPerEpoch(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(*nibiruApp.EpochsKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	authority := nibiruApp.EpochsKeeper.GetAuthority()
+
+	var contracts []sdk.AccAddress
+	sudoers, err := nibiruApp.SudoKeeper.Sudoers.Get(ctx)
+	require.NoError(t, err)
+	for i := 0; i <= types.MaxSubscribersPerEpoch; i++ {
+		contract := testutil.AccAddress()
+		contracts = append(contracts, contract)
+		sudoers.Contracts = append(sudoers.Contracts, contract.String())
+	}
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudoers)
+
+	for _, contract := range contracts[:types.MaxSubscribersPerEpoch] {
+		_, err := msgServer.SubscribeEpochHooks(goCtx, &types.MsgSubscribeEpochHooks{
+			Sender: authority, Contract: contract.String(), Identifier: types.DayEpochID,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Log("subscribing past the limit fails")
+	extra := contracts[types.MaxSubscribersPerEpoch]
+	_, err = msgServer.SubscribeEpochHooks(goCtx, &types.MsgSubscribeEpochHooks{
+		Sender: authority, Contract: extra.String(), Identifier: types.DayEpochID,
+	})
+	require.ErrorIs(t, err, types.ErrTooManySubscribers)
+	require.Len(t, nibiruApp.EpochsKeeper.GetSubscribers(ctx, types.DayEpochID), types.MaxSubscribersPerEpoch)
+
+	t.Log("subscribing an existing subscriber again updates its gas limit")
+	_, err = msgServer.SubscribeEpochHooks(goCtx, &types.MsgSubscribeEpochHooks{
+		Sender: authority, Contract: contracts[0].String(), Identifier: types.DayEpochID, GasLimit: 42,
+	})
+	require.NoError(t, err)
+
+	t.Log("other epochs have their own limit")
+	_, err = msgServer.SubscribeEpochHooks(goCtx, &types.MsgSubscribeEpochHooks{
+		Sender: authority, Contract: extra.String(), Identifier: types.WeekEpochID,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
+)
+
+// AddSubscriber registers a contract to receive the hooks of an existing
+// epoch. The contract must be a sudo contract, and an epoch has at most
+// [types.MaxSubscribersPerEpoch] subscribers. Subscribing a contract again
+// updates its gas limit.
+func (k Keeper) AddSubscriber(ctx sdk.Context, sub types.EpochSubscriber) error {
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+	if !k.EpochExists(ctx, sub.Identifier) {
+		return fmt.Errorf("%w: %s", types.ErrEpochNotFound, sub.Identifier)
+	}
+
+	contract, _ := types.ContractAccAddr(sub.Contract)
+	if err := k.sudoKeeper.CheckPermissions(contract, ctx); err != nil {
+		return fmt.Errorf("%w: %s", types.ErrSubscriberNotSudoer, err)
+	}
+
+	key := collections.Join(sub.Identifier, contract)
+	if _, err := k.Subscribers.Get(ctx, key); err != nil &&
+		len(k.GetSubscribers(ctx, sub.Identifier)) >= types.MaxSubscribersPerEpoch {
+		return fmt.Errorf(
+			"%w: epoch %s already has %d subscribers",
+			types.ErrTooManySubscribers, sub.Identifier, types.MaxSubscribersPerEpoch,
+		)
+	}
+
+	k.Subscribers.Insert(ctx, key, sub)
+	return nil
+}
+
+// RemoveSubscriber removes a contract from the subscribers of an epoch.
+func (k Keeper) RemoveSubscriber(
+	ctx sdk.Context, identifier string, contract sdk.AccAddress,
+) error {
+	if err := k.Subscribers.Delete(ctx, collections.Join(identifier, contract)); err != nil {
+		return fmt.Errorf(
+			"%w: contract %s, epoch %s", types.ErrSubscriberNotFound, contract, identifier,
+		)
+	}
+	return nil
+}
+
+// GetSubscribers returns the contracts subscribed to the hooks of an epoch.
+func (k Keeper) GetSubscribers(ctx sdk.Context, identifier string) []types.EpochSubscriber {
+	return k.Subscribers.Iterate(
+		ctx, collections.PairRange[string, sdk.AccAddress]{}.Prefix(identifier),
+	).Values()
+}
+
+// AllSubscribers returns the subscribers of every epoch.
+func (k Keeper) AllSubscribers(ctx sdk.Context) []types.EpochSubscriber {
+	return k.Subscribers.Iterate(
+		ctx, collections.PairRange[string, sdk.AccAddress]{},
+	).Values()
+}
+
+// callSubscribers runs an epoch hook on every contract subscribed to the epoch
+// "identifier". Wasm contracts receive both hooks through their "sudo" entry
+// point, and EVM contracts receive "AfterEpochEnd" through "onEpochEnd" (see
+// "IEpochHooks.sol").
+//
+// Each call runs in its own cached context with a gas meter bounded by the
+// subscriber's gas limit, so a failing or out of gas contract is reverted and
+// reported in an [types.EventEpochSubscriberError] without affecting the epoch
+// or the other subscribers.
+func (k Keeper) callSubscribers(
+	ctx sdk.Context, hook string, identifier string, epochNumber uint64,
+) {
+	// Subscribers are read before any contract is called so that a contract
+	// call can't change the set being iterated.
+	subscribers := k.GetSubscribers(ctx, identifier)
+	if len(subscribers) == 0 {
+		return
+	}
+
+	for _, sub := range subscribers {
+		if sub.IsEvm() && hook != types.HookAfterEpochEnd {
+			continue
+		}
+		if err := k.callSubscriber(ctx, sub, hook, epochNumber); err != nil {
+			k.Logger(ctx).Error(
+				"epoch hook failed for subscriber",
+				"contract", sub.Contract,
+				"identifier", identifier,
+				"hook", hook,
+				"error", err,
+			)
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventEpochSubscriberError{
+				Contract:    sub.Contract,
+				Identifier:  identifier,
+				EpochNumber: epochNumber,
+				Hook:        hook,
+				Error:       err.Error(),
+			})
+		}
+	}
+}
+
+// callSubscriber calls a single subscriber, only committing its state changes
+// if the call succeeds.
+func (k Keeper) callSubscriber(
+	ctx sdk.Context, sub types.EpochSubscriber, hook string, epochNumber uint64,
+) (err error) {
+	contract, err := types.ContractAccAddr(sub.Contract)
+	if err != nil {
+		return err
+	}
+	// Contracts removed from the x/sudo contracts keep their subscription but
+	// are not called.
+	if err := k.sudoKeeper.CheckPermissions(contract, ctx); err != nil {
+		return fmt.Errorf("%w: %s", types.ErrSubscriberNotSudoer, err)
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+	// EVM calls are bounded by the EVM gas limit like Ethereum txs, so the
+	// store writes of the committed state DB aren't metered again.
+	if sub.IsEvm() {
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	} else {
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(sub.GasLimit))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = fmt.Errorf("out of gas in location: %s, gas limit: %d", oog.Descriptor, sub.GasLimit)
+				return
+			}
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	if sub.IsEvm() {
+		err = k.evmKeeper.CallOnEpochEnd(
+			cacheCtx, eth.NibiruAddrToEthAddr(contract), sub.Identifier, epochNumber, sub.GasLimit,
+		)
+	} else {
+		err = k.callWasmSubscriber(cacheCtx, contract, sub.Identifier, hook, epochNumber)
+	}
+	if err != nil {
+		return err
+	}
+	commit()
+	return nil
+}
+
+// callWasmSubscriber sends a [types.SudoMsgEpochHook] to the "sudo" entry
+// point of a Wasm contract.
+func (k Keeper) callWasmSubscriber(
+	ctx sdk.Context, contract sdk.AccAddress, identifier string, hook string, epochNumber uint64,
+) error {
+	if k.wasmKeeper == nil {
+		return fmt.Errorf("wasm keeper is not set")
+	}
+
+	args := &types.EpochHookArgs{Identifier: identifier, EpochNumber: epochNumber}
+	sudoMsg := types.SudoMsgEpochHook{}
+	switch hook {
+	case types.HookAfterEpochEnd:
+		sudoMsg.AfterEpochEnd = args
+	case types.HookBeforeEpochStart:
+		sudoMsg.BeforeEpochStart = args
+	}
+	msgBz, err := json.Marshal(sudoMsg)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.wasmKeeper.Sudo(ctx, contract, msgBz)
+	return err
+}
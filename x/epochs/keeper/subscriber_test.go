@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/epochs/types"
+)
+
+// mockWasmKeeper records the sudo calls it receives and runs "onSudo" for
+// each of them.
+type mockWasmKeeper struct {
+	calls  []types.SudoMsgEpochHook
+	onSudo func(ctx sdk.Context, contract sdk.AccAddress) error
+}
+
+func (m *mockWasmKeeper) Sudo(
+	ctx sdk.Context, contract sdk.AccAddress, msg []byte,
+) ([]byte, error) {
+	var sudoMsg types.SudoMsgEpochHook
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	m.calls = append(m.calls, sudoMsg)
+	return nil, m.onSudo(ctx, contract)
+}
+
+func TestEpochSubscribers(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	k := nibiruApp.EpochsKeeper
+	k.SetHooks(types.NewMultiEpochHooks())
+
+	okContract := testutil.AccAddress()
+	failContract := testutil.AccAddress()
+	oogContract := testutil.AccAddress()
+	sudoers, err := nibiruApp.SudoKeeper.Sudoers.Get(ctx)
+	require.NoError(t, err)
+	contracts := []sdk.AccAddress{okContract, failContract, oogContract}
+	for _, contract := range contracts {
+		sudoers.Contracts = append(sudoers.Contracts, contract.String())
+	}
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudoers)
+	for _, contract := range contracts {
+		require.NoError(t, k.AddSubscriber(ctx, types.EpochSubscriber{
+			Contract: contract.String(), Identifier: types.DayEpochID, GasLimit: 50_000,
+		}))
+	}
+
+	// Each contract writes to the store. Only the successful call should be
+	// committed.
+	wasm := &mockWasmKeeper{
+		onSudo: func(ctx sdk.Context, contract sdk.AccAddress) error {
+			k.Epochs.Insert(ctx, contract.String(), types.EpochInfo{Identifier: contract.String()})
+			switch {
+			case contract.Equals(failContract):
+				return errors.New("contract error")
+			case contract.Equals(oogContract):
+				ctx.GasMeter().ConsumeGas(100_000, "loop")
+			}
+			return nil
+		},
+	}
+	k.SetWasmKeeper(wasm)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.AfterEpochEnd(ctx, types.DayEpochID, 7)
+
+	require.Len(t, wasm.calls, 3)
+	for _, call := range wasm.calls {
+		require.Nil(t, call.BeforeEpochStart)
+		require.Equal(t, &types.EpochHookArgs{Identifier: types.DayEpochID, EpochNumber: 7}, call.AfterEpochEnd)
+	}
+
+	require.True(t, k.EpochExists(ctx, okContract.String()))
+	require.False(t, k.EpochExists(ctx, failContract.String()))
+	require.False(t, k.EpochExists(ctx, oogContract.String()))
+
+	var failedContracts []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "nibiru.epochs.v1.EventEpochSubscriberError" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "contract" {
+				failedContracts = append(failedContracts, attr.Value)
+			}
+		}
+	}
+	require.ElementsMatch(t, []string{
+		`"` + failContract.String() + `"`, `"` + oogContract.String() + `"`,
+	}, failedContracts)
+
+	t.Log("contracts removed from the sudoers are no longer called")
+	sudoers.Contracts = sudoers.Contracts[:len(sudoers.Contracts)-len(contracts)]
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudoers)
+	wasm.calls = nil
+	k.BeforeEpochStart(ctx, types.DayEpochID, 8)
+	require.Empty(t, wasm.calls)
+
+	t.Log("other epochs don't call the subscribers")
+	k.BeforeEpochStart(ctx, types.WeekEpochID, 8)
+	require.Empty(t, wasm.calls)
+}
+
+func TestEpochSubscribers_Evm(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	k := nibiruApp.EpochsKeeper
+	k.SetHooks(types.NewMultiEpochHooks())
+
+	// Hand-assembled runtime bytecode, since the hooks only need the call to
+	// succeed, revert, or run out of gas.
+	var (
+		// SSTORE(0, CALLDATALOAD(0x24)): stores the "epochNumber" argument of
+		// "onEpochEnd(string,uint256)" in slot 0.
+		recordCode = gethcommon.FromHex("0x602435600055" + "00")
+		// REVERT(0, 0)
+		revertCode = gethcommon.FromHex("0x60006000fd")
+		// JUMPDEST, JUMP(0): loops until out of gas
+		loopCode = gethcommon.FromHex("0x5b600056")
+	)
+	okContract := gethcommon.BytesToAddress([]byte("ok-contract"))
+	revertContract := gethcommon.BytesToAddress([]byte("revert-contract"))
+	loopContract := gethcommon.BytesToAddress([]byte("loop-contract"))
+
+	txConfig := nibiruApp.EvmKeeper.TxConfig(ctx, gethcommon.Hash{})
+	stateDB := nibiruApp.EvmKeeper.NewStateDB(ctx, txConfig)
+	stateDB.SetCode(okContract, recordCode)
+	stateDB.SetCode(revertContract, revertCode)
+	stateDB.SetCode(loopContract, loopCode)
+	require.NoError(t, stateDB.Commit())
+
+	sudoers, err := nibiruApp.SudoKeeper.Sudoers.Get(ctx)
+	require.NoError(t, err)
+	contracts := []gethcommon.Address{okContract, revertContract, loopContract}
+	for _, contract := range contracts {
+		sudoers.Contracts = append(sudoers.Contracts, eth.EthAddrToNibiruAddr(contract).String())
+	}
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudoers)
+	for _, contract := range contracts {
+		require.NoError(t, k.AddSubscriber(ctx, types.EpochSubscriber{
+			Contract: contract.Hex(), Identifier: types.DayEpochID, GasLimit: 100_000,
+		}))
+	}
+
+	t.Log("EVM contracts don't receive BeforeEpochStart")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.BeforeEpochStart(ctx, types.DayEpochID, 7)
+	require.Empty(t, ctx.EventManager().Events())
+
+	t.Log("AfterEpochEnd calls onEpochEnd on every EVM subscriber")
+	k.AfterEpochEnd(ctx, types.DayEpochID, 7)
+
+	slot := nibiruApp.EvmKeeper.NewStateDB(ctx, txConfig).GetState(okContract, gethcommon.Hash{})
+	require.Equal(t, gethcommon.BigToHash(big.NewInt(7)), slot)
+
+	var failedContracts []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "nibiru.epochs.v1.EventEpochSubscriberError" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "contract" {
+				failedContracts = append(failedContracts, attr.Value)
+			}
+		}
+	}
+	require.ElementsMatch(t, []string{
+		`"` + revertContract.Hex() + `"`, `"` + loopContract.Hex() + `"`,
+	}, failedContracts)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/epochs interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateEpoch{}, "epochs/MsgCreateEpoch", nil)
+	cdc.RegisterConcrete(&MsgDeleteEpoch{}, "epochs/MsgDeleteEpoch", nil)
+	cdc.RegisterConcrete(&MsgSubscribeEpochHooks{}, "epochs/MsgSubscribeEpochHooks", nil)
+	cdc.RegisterConcrete(&MsgUnsubscribeEpochHooks{}, "epochs/MsgUnsubscribeEpochHooks", nil)
+}
+
+// RegisterInterfaces registers the x/epochs interfaces types with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateEpoch{},
+		&MsgDeleteEpoch{},
+		&MsgSubscribeEpochHooks{},
+		&MsgUnsubscribeEpochHooks{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/epochs module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as
+	// Amino is still used for that purpose.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
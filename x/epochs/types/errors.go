@@ -8,5 +8,10 @@ import (
 
 // x/epochs module sentinel errors.
 var (
-	ErrSample = sdkioerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample              = sdkioerrors.Register(ModuleName, 1100, "sample error")
+	ErrReservedEpoch       = sdkioerrors.Register(ModuleName, 1101, "epoch identifier is reserved")
+	ErrEpochNotFound       = sdkioerrors.Register(ModuleName, 1102, "epoch not found")
+	ErrSubscriberNotFound  = sdkioerrors.Register(ModuleName, 1103, "epoch subscriber not found")
+	ErrSubscriberNotSudoer = sdkioerrors.Register(ModuleName, 1104, "epoch subscriber is not a sudo contract")
+	ErrTooManySubscribers  = sdkioerrors.Register(ModuleName, 1105, "too many epoch subscribers")
 )
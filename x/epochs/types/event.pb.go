@@ -126,33 +126,118 @@ func (m *EventEpochEnd) GetEpochNumber() uint64 {
 	return 0
 }
 
+// EventEpochSubscriberError: emitted when an epoch hook call to a subscribed
+// contract fails. The state changes of the failed call are reverted.
+type EventEpochSubscriberError struct {
+	Contract    string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Identifier  string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	EpochNumber uint64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// Name of the hook, "after_epoch_end" or "before_epoch_start".
+	// EVM contracts only receive "after_epoch_end".
+	Hook  string `protobuf:"bytes,4,opt,name=hook,proto3" json:"hook,omitempty"`
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventEpochSubscriberError) Reset()         { *m = EventEpochSubscriberError{} }
+func (m *EventEpochSubscriberError) String() string { return proto.CompactTextString(m) }
+func (*EventEpochSubscriberError) ProtoMessage()    {}
+func (*EventEpochSubscriberError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af14d87a2487e5d, []int{2}
+}
+func (m *EventEpochSubscriberError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochSubscriberError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochSubscriberError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochSubscriberError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochSubscriberError.Merge(m, src)
+}
+func (m *EventEpochSubscriberError) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochSubscriberError) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochSubscriberError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochSubscriberError proto.InternalMessageInfo
+
+func (m *EventEpochSubscriberError) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventEpochSubscriberError) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EventEpochSubscriberError) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EventEpochSubscriberError) GetHook() string {
+	if m != nil {
+		return m.Hook
+	}
+	return ""
+}
+
+func (m *EventEpochSubscriberError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventEpochStart)(nil), "nibiru.epochs.v1.EventEpochStart")
 	proto.RegisterType((*EventEpochEnd)(nil), "nibiru.epochs.v1.EventEpochEnd")
+	proto.RegisterType((*EventEpochSubscriberError)(nil), "nibiru.epochs.v1.EventEpochSubscriberError")
 }
 
 func init() { proto.RegisterFile("nibiru/epochs/v1/event.proto", fileDescriptor_7af14d87a2487e5d) }
 
 var fileDescriptor_7af14d87a2487e5d = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xea, 0x41, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x7c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98, 0x97, 0x54, 0x9a, 0xa6, 0x5f, 0x92, 0x99,
-	0x9b, 0x5a, 0x5c, 0x92, 0x98, 0x5b, 0x00, 0x51, 0xa0, 0xd4, 0xc2, 0xc8, 0xc5, 0xef, 0x0a, 0x32,
-	0xd8, 0x15, 0x64, 0x52, 0x70, 0x49, 0x62, 0x51, 0x89, 0x90, 0x22, 0x17, 0x0f, 0xd8, 0xdc, 0xf8,
-	0xbc, 0xd2, 0xdc, 0xa4, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x6e, 0xb0, 0x98,
-	0x1f, 0x58, 0x48, 0xc8, 0x8f, 0x4b, 0x00, 0xa2, 0xa4, 0x18, 0xa4, 0x23, 0x1e, 0x64, 0xaa, 0x04,
-	0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x94, 0x1e, 0xc4, 0x4a, 0x3d, 0x98, 0x95, 0x7a, 0x21, 0x30,
-	0x2b, 0x9d, 0x38, 0x4e, 0xdc, 0x93, 0x67, 0x98, 0x70, 0x5f, 0x9e, 0x31, 0x88, 0x2f, 0x15, 0x6e,
-	0x1d, 0x48, 0x5a, 0xc9, 0x88, 0x8b, 0x17, 0xe1, 0x0a, 0xd7, 0xbc, 0x14, 0x22, 0xdc, 0xe0, 0xe4,
-	0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x7e, 0xe0, 0x80, 0x72, 0xce, 0x48, 0xcc, 0xcc, 0xd3,
-	0x87, 0x06, 0x69, 0x99, 0x91, 0x7e, 0x05, 0x2c, 0x5c, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x8e, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x87, 0x6a, 0xdc, 0x59, 0x75, 0x01, 0x00,
-	0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4e, 0xc2, 0x40,
+	0x10, 0xee, 0x2a, 0x18, 0x58, 0xfc, 0x21, 0x0d, 0x87, 0xda, 0x98, 0x82, 0x9c, 0x38, 0x75, 0x03,
+	0xbe, 0x01, 0xa6, 0x07, 0x2f, 0x1c, 0xaa, 0x27, 0x2f, 0xa4, 0x5b, 0x96, 0x76, 0xa3, 0xdd, 0x6d,
+	0xb6, 0xdb, 0x46, 0xef, 0x3e, 0x00, 0x0f, 0xe1, 0xc3, 0x70, 0xe4, 0xe8, 0x49, 0x0d, 0xbc, 0x88,
+	0xd9, 0x5d, 0x41, 0x12, 0x2e, 0xde, 0x66, 0xbe, 0x6f, 0xe6, 0xfb, 0xbe, 0xcc, 0xc0, 0x2b, 0x46,
+	0x31, 0x15, 0x25, 0x22, 0x39, 0x8f, 0xd3, 0x02, 0x55, 0x43, 0x44, 0x2a, 0xc2, 0xa4, 0x9f, 0x0b,
+	0x2e, 0xb9, 0xdd, 0x36, 0xac, 0x6f, 0x58, 0xbf, 0x1a, 0xba, 0x9d, 0x84, 0x27, 0x5c, 0x93, 0x48,
+	0x55, 0x66, 0xce, 0xed, 0x26, 0x9c, 0x27, 0xcf, 0x04, 0xe9, 0x0e, 0x97, 0x73, 0x24, 0x69, 0x46,
+	0x0a, 0x19, 0x65, 0xb9, 0x19, 0xe8, 0xbf, 0x01, 0x78, 0x11, 0x28, 0xe1, 0x40, 0x29, 0xdd, 0xcb,
+	0x48, 0x48, 0xfb, 0x1a, 0x9e, 0x6a, 0xdd, 0x29, 0x2b, 0x33, 0x4c, 0x84, 0x03, 0x7a, 0x60, 0x50,
+	0x0b, 0x5b, 0x1a, 0x9b, 0x68, 0xc8, 0x9e, 0xc0, 0xb6, 0x19, 0x29, 0xd4, 0xc6, 0x54, 0xa9, 0x3a,
+	0x47, 0x3d, 0x30, 0x68, 0x8d, 0x5c, 0xdf, 0x58, 0xfa, 0x5b, 0x4b, 0xff, 0x61, 0x6b, 0x39, 0x6e,
+	0x2c, 0x3f, 0xbb, 0xd6, 0xe2, 0xab, 0x0b, 0xc2, 0x73, 0xb2, 0xb3, 0x53, 0x74, 0x7f, 0x04, 0xcf,
+	0xfe, 0x52, 0x04, 0x6c, 0xf6, 0x8f, 0x0c, 0xfd, 0x77, 0x00, 0x2f, 0xf7, 0xa2, 0x97, 0xb8, 0x88,
+	0x05, 0xc5, 0x44, 0x04, 0x42, 0x70, 0x61, 0xbb, 0xb0, 0x11, 0x73, 0x26, 0x45, 0x14, 0x4b, 0xbd,
+	0xdc, 0x0c, 0x77, 0xbd, 0xed, 0x41, 0x48, 0x67, 0x84, 0x49, 0x3a, 0xa7, 0x44, 0xe8, 0xdc, 0xcd,
+	0x70, 0x0f, 0x39, 0x30, 0x3f, 0x3e, 0x3c, 0x80, 0x0d, 0x6b, 0x29, 0xe7, 0x4f, 0x4e, 0x4d, 0x2f,
+	0xeb, 0xda, 0xee, 0xc0, 0x3a, 0x51, 0xde, 0x4e, 0x5d, 0x83, 0xa6, 0x19, 0xdf, 0x2d, 0xd7, 0x1e,
+	0x58, 0xad, 0x3d, 0xf0, 0xbd, 0xf6, 0xc0, 0x62, 0xe3, 0x59, 0xab, 0x8d, 0x67, 0x7d, 0x6c, 0x3c,
+	0xeb, 0x11, 0x25, 0x54, 0xa6, 0x25, 0xf6, 0x63, 0x9e, 0xa1, 0x89, 0xfe, 0xe7, 0x6d, 0x1a, 0x51,
+	0x86, 0x7e, 0x3f, 0x5f, 0x8d, 0xd0, 0xcb, 0xf6, 0xfd, 0xf2, 0x35, 0x27, 0x05, 0x3e, 0xd1, 0x37,
+	0xbd, 0xf9, 0x19, 0x00, 0xa3, 0x98, 0x81, 0xfd, 0x1c, 0x02, 0x00, 0x00,
 }
 
 func (m *EventEpochStart) Marshal() (dAtA []byte, err error) {
@@ -219,6 +304,62 @@ func (m *EventEpochEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEpochSubscriberError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochSubscriberError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochSubscriberError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hook) > 0 {
+		i -= len(m.Hook)
+		copy(dAtA[i:], m.Hook)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Hook)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -256,6 +397,34 @@ func (m *EventEpochEnd) Size() (n int) {
 	return n
 }
 
+func (m *EventEpochSubscriberError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovEvent(uint64(m.EpochNumber))
+	}
+	l = len(m.Hook)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -433,6 +602,203 @@ func (m *EventEpochEnd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventEpochSubscriberError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochSubscriberError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochSubscriberError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -74,5 +75,33 @@ func (gs GenesisState) Validate() error {
 		epochIdentifiers[epoch.Identifier] = true
 	}
 
+	subscribers := map[string]bool{}
+	subscriberCount := map[string]int{}
+	for _, sub := range gs.Subscribers {
+		if err := sub.Validate(); err != nil {
+			return err
+		}
+		if !epochIdentifiers[sub.Identifier] {
+			return fmt.Errorf("epoch subscriber %s references unknown epoch %s", sub.Contract, sub.Identifier)
+		}
+
+		// Subscribers are keyed by account address, so the hex and bech32
+		// addresses of an EVM contract are the same subscriber.
+		contract, _ := ContractAccAddr(sub.Contract)
+		key := sub.Identifier + "/" + contract.String()
+		if subscribers[key] {
+			return fmt.Errorf("duplicate epoch subscriber %s for epoch %s", sub.Contract, sub.Identifier)
+		}
+		subscribers[key] = true
+
+		subscriberCount[sub.Identifier]++
+		if subscriberCount[sub.Identifier] > MaxSubscribersPerEpoch {
+			return fmt.Errorf(
+				"%w: epoch %s has more than %d subscribers",
+				ErrTooManySubscribers, sub.Identifier, MaxSubscribersPerEpoch,
+			)
+		}
+	}
+
 	return nil
 }
@@ -27,7 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
-	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Epochs      []EpochInfo       `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Subscribers []EpochSubscriber `protobuf:"bytes,2,rep,name=subscribers,proto3" json:"subscribers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +71,13 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetSubscribers() []EpochSubscriber {
+	if m != nil {
+		return m.Subscribers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.epochs.v1.GenesisState")
 }
@@ -77,7 +85,7 @@ func init() {
 func init() { proto.RegisterFile("nibiru/epochs/v1/genesis.proto", fileDescriptor_0e52385b95ea69b9) }
 
 var fileDescriptor_0e52385b95ea69b9 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
+	// 268 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0xcb, 0x4c, 0xca,
 	0x2c, 0x2a, 0xd5, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0x41,
@@ -85,14 +93,16 @@ var fileDescriptor_0e52385b95ea69b9 = []byte{
 	0x9d, 0x94, 0x5c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98, 0x97, 0x54, 0x9a, 0xa6, 0x9f,
 	0x52, 0x5a, 0x94, 0x58, 0x92, 0x99, 0x9f, 0x07, 0x95, 0x97, 0x47, 0x97, 0x2f, 0xc9, 0xcc, 0x4d,
 	0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0x80, 0x2a, 0x90, 0xc1, 0x70, 0x48, 0x71, 0x49, 0x62, 0x49, 0x2a,
-	0x44, 0x56, 0xc9, 0x93, 0x8b, 0xc7, 0x1d, 0xe2, 0xae, 0x60, 0x90, 0xa8, 0x90, 0x25, 0x17, 0x1b,
-	0x44, 0xa1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0xb4, 0x1e, 0xba, 0x3b, 0xf5, 0x5c, 0x41,
-	0x2c, 0xcf, 0xbc, 0xb4, 0x7c, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x1a, 0x9c, 0x3c,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x0f, 0x6c, 0x9c, 0x73, 0x46, 0x62, 0x66, 0x9e, 0x3e,
-	0xd4, 0x65, 0x65, 0x46, 0xfa, 0x15, 0x30, 0xe7, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x1d, 0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x86, 0xe6, 0x4b, 0x7e, 0x45, 0x01, 0x00, 0x00,
+	0x44, 0x56, 0x69, 0x0a, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x61, 0xc1, 0x20, 0x61, 0x21, 0x4b, 0x2e,
+	0x36, 0x88, 0x4a, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x74, 0x87, 0xea, 0xb9,
+	0x82, 0x58, 0x9e, 0x79, 0x69, 0xf9, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x35, 0x08,
+	0x79, 0x72, 0x71, 0x17, 0x97, 0x26, 0x15, 0x27, 0x17, 0x65, 0x26, 0xa5, 0x16, 0x15, 0x4b, 0x30,
+	0x81, 0xf5, 0x2b, 0xe2, 0xd0, 0x1f, 0x0c, 0x57, 0x09, 0x35, 0x05, 0x59, 0xaf, 0x93, 0xe7, 0x89,
+	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
+	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26,
+	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xfb, 0x81, 0x4d, 0x76, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0xfa,
+	0xb2, 0xcc, 0x48, 0xbf, 0x02, 0xe6, 0xd5, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x47,
+	0x8d, 0x01, 0x03, 0x00, 0xe7, 0xa0, 0x16, 0xe7, 0x91, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -115,6 +125,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Subscribers) > 0 {
+		for iNdEx := len(m.Subscribers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscribers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -155,6 +179,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Subscribers) > 0 {
+		for _, e := range m.Subscribers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -227,6 +257,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscribers = append(m.Subscribers, EpochSubscriber{})
+			if err := m.Subscribers[len(m.Subscribers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,10 +1,26 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+)
+
+var (
+	subscriberContract  = sdk.AccAddress("subscriber-contract").String()
+	evmSubscriber       = gethcommon.BytesToAddress([]byte("evm-subscriber"))
+	epochForSubscribers = EpochInfo{
+		Identifier:   "firstOne",
+		StartTime:    time.Now(),
+		Duration:     10,
+		CurrentEpoch: 2,
+	}
 )
 
 func TestGenesisState_Validate(t *testing.T) {
@@ -83,6 +99,74 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			errString: "epoch duration should NOT be 0",
 		},
+		{
+			name: "subscriber to unknown epoch",
+			genState: GenesisState{
+				Epochs: []EpochInfo{epochForSubscribers},
+				Subscribers: []EpochSubscriber{
+					{Contract: subscriberContract, Identifier: "unknown", GasLimit: 1},
+				},
+			},
+			errString: "references unknown epoch",
+		},
+		{
+			name: "duplicate subscriber",
+			genState: GenesisState{
+				Epochs: []EpochInfo{epochForSubscribers},
+				Subscribers: []EpochSubscriber{
+					{Contract: subscriberContract, Identifier: "firstOne", GasLimit: 1},
+					{Contract: subscriberContract, Identifier: "firstOne", GasLimit: 2},
+				},
+			},
+			errString: "duplicate epoch subscriber",
+		},
+		{
+			name: "subscriber gas limit above max",
+			genState: GenesisState{
+				Epochs: []EpochInfo{epochForSubscribers},
+				Subscribers: []EpochSubscriber{
+					{Contract: subscriberContract, Identifier: "firstOne", GasLimit: MaxSubscriberGasLimit + 1},
+				},
+			},
+			errString: "subscriber gas limit",
+		},
+		{
+			name: "duplicate EVM subscriber as hex and bech32",
+			genState: GenesisState{
+				Epochs: []EpochInfo{epochForSubscribers},
+				Subscribers: []EpochSubscriber{
+					{Contract: evmSubscriber.Hex(), Identifier: "firstOne", GasLimit: 1},
+					{Contract: eth.EthAddrToNibiruAddr(evmSubscriber).String(), Identifier: "firstOne", GasLimit: 1},
+				},
+			},
+			errString: "duplicate epoch subscriber",
+		},
+		{
+			name: "too many subscribers for an epoch",
+			genState: GenesisState{
+				Epochs: []EpochInfo{epochForSubscribers},
+				Subscribers: func() (subs []EpochSubscriber) {
+					for i := 0; i <= MaxSubscribersPerEpoch; i++ {
+						contract := gethcommon.BigToAddress(big.NewInt(int64(i + 1)))
+						subs = append(subs, EpochSubscriber{
+							Contract: contract.Hex(), Identifier: "firstOne", GasLimit: 1,
+						})
+					}
+					return subs
+				}(),
+			},
+			errString: "too many epoch subscribers",
+		},
+		{
+			name: "invalid contract address",
+			genState: GenesisState{
+				Epochs: []EpochInfo{epochForSubscribers},
+				Subscribers: []EpochSubscriber{
+					{Contract: "0xnot-a-hex-address", Identifier: "firstOne", GasLimit: 1},
+				},
+			},
+			errString: "invalid",
+		},
 	}
 
 	for _, tc := range tests {
@@ -124,6 +208,16 @@ func TestGenesisState_HappyPath(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with subscribers",
+			genState: GenesisState{
+				Epochs: []EpochInfo{epochForSubscribers},
+				Subscribers: []EpochSubscriber{
+					{Contract: subscriberContract, Identifier: "firstOne", GasLimit: DefaultSubscriberGasLimit},
+					{Contract: evmSubscriber.Hex(), Identifier: "firstOne", GasLimit: DefaultSubscriberGasLimit},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -20,6 +20,18 @@ const (
 	FifteenMinuteEpochID = "15 min"
 )
 
+// IsReservedEpochID returns true for the identifiers of the default genesis
+// epochs. Other modules hook into these epochs, so they can't be deleted with
+// [MsgDeleteEpoch].
+func IsReservedEpochID(identifier string) bool {
+	switch identifier {
+	case ThirtyMinuteEpochID, DayEpochID, WeekEpochID, MonthEpochID:
+		return true
+	default:
+		return false
+	}
+}
+
 // ValidateEpochIdentifierInterface performs a stateless
 // validation of the epoch ID interface.
 func ValidateEpochIdentifierInterface(i any) error {
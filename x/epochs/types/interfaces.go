@@ -0,0 +1,30 @@
+package types // noalias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// SudoKeeper defines the x/sudo permission check used to restrict epoch
+// subscribers to sudo contracts.
+type SudoKeeper interface {
+	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
+}
+
+// WasmKeeper defines the Wasm entry point used to run the epoch hooks of
+// subscribed contracts.
+type WasmKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// EvmKeeper defines the EVM call used to run the "onEpochEnd" hook of
+// subscribed EVM contracts.
+type EvmKeeper interface {
+	CallOnEpochEnd(
+		ctx sdk.Context,
+		contract gethcommon.Address,
+		identifier string,
+		epochNumber uint64,
+		gasLimit uint64,
+	) error
+}
@@ -0,0 +1,181 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ legacytx.LegacyMsg = &MsgCreateEpoch{}
+	_ legacytx.LegacyMsg = &MsgDeleteEpoch{}
+	_ legacytx.LegacyMsg = &MsgSubscribeEpochHooks{}
+	_ legacytx.LegacyMsg = &MsgUnsubscribeEpochHooks{}
+)
+
+// epochs message types
+const (
+	TypeMsgCreateEpoch           = "create_epoch"
+	TypeMsgDeleteEpoch           = "delete_epoch"
+	TypeMsgSubscribeEpochHooks   = "subscribe_epoch_hooks"
+	TypeMsgUnsubscribeEpochHooks = "unsubscribe_epoch_hooks"
+)
+
+// -------------------------------------------------
+// MsgCreateEpoch
+
+// Route implements legacytx.LegacyMsg
+func (m MsgCreateEpoch) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (m MsgCreateEpoch) Type() string { return TypeMsgCreateEpoch }
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (m MsgCreateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgCreateEpoch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+	if m.Duration <= 0 {
+		return fmt.Errorf("epoch duration must be positive, got %s", m.Duration)
+	}
+	return nil
+}
+
+// EpochInfo returns the initial [EpochInfo] of the epoch to create.
+func (m MsgCreateEpoch) EpochInfo() EpochInfo {
+	return EpochInfo{
+		Identifier:            m.Identifier,
+		StartTime:             m.StartTime,
+		Duration:              m.Duration,
+		CurrentEpoch:          0,
+		CurrentEpochStartTime: m.StartTime,
+		EpochCountingStarted:  false,
+	}
+}
+
+// -------------------------------------------------
+// MsgDeleteEpoch
+
+// Route implements legacytx.LegacyMsg
+func (m MsgDeleteEpoch) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (m MsgDeleteEpoch) Type() string { return TypeMsgDeleteEpoch }
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (m MsgDeleteEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgDeleteEpoch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return err
+	}
+	if IsReservedEpochID(m.Identifier) {
+		return fmt.Errorf("%w: %s", ErrReservedEpoch, m.Identifier)
+	}
+	return nil
+}
+
+// -------------------------------------------------
+// MsgSubscribeEpochHooks
+
+// Route implements legacytx.LegacyMsg
+func (m MsgSubscribeEpochHooks) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (m MsgSubscribeEpochHooks) Type() string { return TypeMsgSubscribeEpochHooks }
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (m MsgSubscribeEpochHooks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgSubscribeEpochHooks) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgSubscribeEpochHooks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return m.Subscriber().Validate()
+}
+
+// Subscriber returns the [EpochSubscriber] to register, applying the default
+// gas limit if none is given.
+func (m MsgSubscribeEpochHooks) Subscriber() EpochSubscriber {
+	gasLimit := m.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultSubscriberGasLimit
+	}
+	return EpochSubscriber{
+		Contract:   m.Contract,
+		Identifier: m.Identifier,
+		GasLimit:   gasLimit,
+	}
+}
+
+// -------------------------------------------------
+// MsgUnsubscribeEpochHooks
+
+// Route implements legacytx.LegacyMsg
+func (m MsgUnsubscribeEpochHooks) Route() string { return RouterKey }
+
+// Type implements legacytx.LegacyMsg
+func (m MsgUnsubscribeEpochHooks) Type() string { return TypeMsgUnsubscribeEpochHooks }
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (m MsgUnsubscribeEpochHooks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgUnsubscribeEpochHooks) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Sender)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgUnsubscribeEpochHooks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	if _, err := ContractAccAddr(m.Contract); err != nil {
+		return err
+	}
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+func mustAccAddress(bech32 string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(bech32)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
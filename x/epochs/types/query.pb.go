@@ -198,43 +198,138 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() uint64 {
 	return 0
 }
 
+type QueryEpochSubscribersRequest struct {
+	// Epoch identifier. Returns the subscribers of every epoch if empty.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryEpochSubscribersRequest) Reset()         { *m = QueryEpochSubscribersRequest{} }
+func (m *QueryEpochSubscribersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSubscribersRequest) ProtoMessage()    {}
+func (*QueryEpochSubscribersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d273c3d69b40555, []int{4}
+}
+func (m *QueryEpochSubscribersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSubscribersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSubscribersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSubscribersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSubscribersRequest.Merge(m, src)
+}
+func (m *QueryEpochSubscribersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSubscribersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSubscribersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSubscribersRequest proto.InternalMessageInfo
+
+func (m *QueryEpochSubscribersRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type QueryEpochSubscribersResponse struct {
+	Subscribers []EpochSubscriber `protobuf:"bytes,1,rep,name=subscribers,proto3" json:"subscribers"`
+}
+
+func (m *QueryEpochSubscribersResponse) Reset()         { *m = QueryEpochSubscribersResponse{} }
+func (m *QueryEpochSubscribersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochSubscribersResponse) ProtoMessage()    {}
+func (*QueryEpochSubscribersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d273c3d69b40555, []int{5}
+}
+func (m *QueryEpochSubscribersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochSubscribersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochSubscribersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochSubscribersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochSubscribersResponse.Merge(m, src)
+}
+func (m *QueryEpochSubscribersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochSubscribersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochSubscribersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochSubscribersResponse proto.InternalMessageInfo
+
+func (m *QueryEpochSubscribersResponse) GetSubscribers() []EpochSubscriber {
+	if m != nil {
+		return m.Subscribers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochInfosRequest)(nil), "nibiru.epochs.v1.QueryEpochInfosRequest")
 	proto.RegisterType((*QueryEpochInfosResponse)(nil), "nibiru.epochs.v1.QueryEpochInfosResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "nibiru.epochs.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "nibiru.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryEpochSubscribersRequest)(nil), "nibiru.epochs.v1.QueryEpochSubscribersRequest")
+	proto.RegisterType((*QueryEpochSubscribersResponse)(nil), "nibiru.epochs.v1.QueryEpochSubscribersResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/epochs/v1/query.proto", fileDescriptor_2d273c3d69b40555) }
 
 var fileDescriptor_2d273c3d69b40555 = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xce, 0xd4, 0x5a, 0x70, 0xac, 0x20, 0x83, 0x68, 0x8c, 0x35, 0x2d, 0xf1, 0x83, 0x5a, 0x25,
-	0x43, 0xea, 0x49, 0x4f, 0xd2, 0xe2, 0xa1, 0x17, 0xc1, 0xe0, 0xc9, 0x8b, 0x4c, 0xe2, 0x34, 0x1d,
-	0xb0, 0x33, 0x69, 0x66, 0x12, 0xec, 0xd5, 0x93, 0x47, 0x41, 0xfc, 0x13, 0xfe, 0x92, 0x1e, 0x0b,
-	0x7b, 0xd9, 0xd3, 0xb2, 0xb4, 0xfb, 0x43, 0x96, 0xce, 0xa4, 0xbb, 0xdd, 0x7e, 0x40, 0x6f, 0xc9,
-	0x3c, 0x1f, 0xef, 0xf3, 0xbc, 0x33, 0xb0, 0xc1, 0x59, 0xc4, 0xb2, 0x1c, 0xd3, 0x54, 0xc4, 0x23,
-	0x89, 0x8b, 0x00, 0x4f, 0x72, 0x9a, 0x4d, 0xfd, 0x34, 0x13, 0x4a, 0xa0, 0xfb, 0x06, 0xf5, 0x0d,
-	0xea, 0x17, 0x81, 0xf3, 0x20, 0x11, 0x89, 0xd0, 0x20, 0x5e, 0x7d, 0x19, 0x9e, 0xd3, 0x48, 0x84,
-	0x48, 0x7e, 0x50, 0x4c, 0x52, 0x86, 0x09, 0xe7, 0x42, 0x11, 0xc5, 0x04, 0x97, 0x25, 0xda, 0x89,
-	0x85, 0x1c, 0x0b, 0x89, 0x23, 0x22, 0xa9, 0xb1, 0xc7, 0x45, 0x10, 0x51, 0x45, 0x02, 0x9c, 0x92,
-	0x84, 0x71, 0x4d, 0x5e, 0x3b, 0xed, 0xe4, 0x91, 0x8a, 0x28, 0x6a, 0x50, 0xcf, 0x86, 0x0f, 0x3f,
-	0xaf, 0xf4, 0x1f, 0x57, 0xe8, 0x80, 0x0f, 0x85, 0x0c, 0xe9, 0x24, 0xa7, 0x52, 0x79, 0x5f, 0xe0,
-	0xa3, 0x1d, 0x44, 0xa6, 0x82, 0x4b, 0x8a, 0xde, 0xc1, 0x9a, 0x71, 0xb3, 0x41, 0xeb, 0x56, 0xfb,
-	0x6e, 0xf7, 0x89, 0xbf, 0xdd, 0xca, 0xbf, 0x52, 0xf5, 0xaa, 0xb3, 0xb3, 0xa6, 0x15, 0x96, 0x02,
-	0xef, 0x3d, 0xb4, 0xb5, 0x6b, 0x3f, 0xcf, 0x32, 0xca, 0x95, 0xa6, 0x95, 0x13, 0x91, 0x0b, 0x21,
-	0xfb, 0x4e, 0xb9, 0x62, 0x43, 0x46, 0x33, 0x1b, 0xb4, 0x40, 0xfb, 0x4e, 0xb8, 0x71, 0xe2, 0x7d,
-	0x80, 0x8f, 0xf7, 0x68, 0xcb, 0x4c, 0xcf, 0xe0, 0xbd, 0xd8, 0x9c, 0x7f, 0xd3, 0xa3, 0xb4, 0xbe,
-	0x1a, 0xd6, 0xe3, 0x0d, 0x72, 0xf7, 0x7f, 0x05, 0xde, 0xd6, 0x16, 0xe8, 0x37, 0x80, 0xf0, 0xba,
-	0x19, 0x6a, 0xef, 0x36, 0xd8, 0xbf, 0x16, 0xe7, 0xd5, 0x11, 0x4c, 0x13, 0xc9, 0x7b, 0xf1, 0xeb,
-	0xe4, 0xe2, 0x6f, 0xa5, 0x89, 0x9e, 0xe2, 0xed, 0x2b, 0x30, 0x57, 0x65, 0x7e, 0xd1, 0x3f, 0x00,
-	0xeb, 0x9b, 0x95, 0x50, 0xe7, 0xc0, 0x88, 0x3d, 0x3b, 0x73, 0x5e, 0x1f, 0xc5, 0x2d, 0x03, 0xbd,
-	0xd1, 0x81, 0x5e, 0xa2, 0xe7, 0x07, 0x02, 0xdd, 0x58, 0x60, 0x6f, 0x30, 0x5b, 0xb8, 0x60, 0xbe,
-	0x70, 0xc1, 0xf9, 0xc2, 0x05, 0x7f, 0x96, 0xae, 0x35, 0x5f, 0xba, 0xd6, 0xe9, 0xd2, 0xb5, 0xbe,
-	0xe2, 0x84, 0xa9, 0x51, 0x1e, 0xf9, 0xb1, 0x18, 0xe3, 0x4f, 0xda, 0xa9, 0x3f, 0x22, 0x8c, 0xaf,
-	0x5d, 0x8b, 0x2e, 0xfe, 0xb9, 0xb6, 0x56, 0xd3, 0x94, 0xca, 0xa8, 0xa6, 0x1f, 0xdb, 0xdb, 0xcb,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x31, 0x41, 0xe0, 0x1c, 0x03, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0x8e, 0x69, 0xa9, 0xc4, 0x6b, 0x91, 0x2a, 0x0b, 0x41, 0x38, 0xd2, 0x6b, 0x39, 0x0a, 0x0a,
+	0x01, 0x9d, 0x95, 0x30, 0xc1, 0x80, 0x50, 0x2b, 0x86, 0x2c, 0x48, 0x04, 0x26, 0x16, 0xe4, 0x3b,
+	0xdc, 0x8b, 0x11, 0xb5, 0xaf, 0xb6, 0x2f, 0xa2, 0x2b, 0x13, 0x23, 0x12, 0x62, 0xe6, 0xdf, 0xe9,
+	0xc0, 0x50, 0x89, 0x85, 0x09, 0xa1, 0x84, 0x3f, 0x04, 0xc5, 0x76, 0xc8, 0x91, 0xe4, 0xd0, 0x6d,
+	0x89, 0xbf, 0x1f, 0xef, 0xf3, 0xfb, 0x7c, 0xd0, 0x12, 0x3c, 0xe1, 0xaa, 0x20, 0x2c, 0x97, 0xe9,
+	0x50, 0x93, 0x51, 0x97, 0x9c, 0x14, 0x4c, 0x9d, 0xc6, 0xb9, 0x92, 0x46, 0xe2, 0x6d, 0x87, 0xc6,
+	0x0e, 0x8d, 0x47, 0xdd, 0xe0, 0x4a, 0x26, 0x33, 0x69, 0x41, 0x32, 0xfd, 0xe5, 0x78, 0x41, 0x2b,
+	0x93, 0x32, 0x7b, 0xc7, 0x08, 0xcd, 0x39, 0xa1, 0x42, 0x48, 0x43, 0x0d, 0x97, 0x42, 0x7b, 0xb4,
+	0x93, 0x4a, 0x7d, 0x2c, 0x35, 0x49, 0xa8, 0x66, 0xce, 0x9e, 0x8c, 0xba, 0x09, 0x33, 0xb4, 0x4b,
+	0x72, 0x9a, 0x71, 0x61, 0xc9, 0x33, 0xa7, 0xa5, 0x3c, 0xda, 0x50, 0xc3, 0x1c, 0x1a, 0x35, 0xe1,
+	0xea, 0xf3, 0xa9, 0xfe, 0xe9, 0x14, 0xed, 0x8b, 0x23, 0xa9, 0x07, 0xec, 0xa4, 0x60, 0xda, 0x44,
+	0x2f, 0xe1, 0xda, 0x12, 0xa2, 0x73, 0x29, 0x34, 0xc3, 0x0f, 0x61, 0xc3, 0xb9, 0x35, 0xd1, 0xde,
+	0x5a, 0x7b, 0xb3, 0x77, 0x23, 0x5e, 0xbc, 0x55, 0xfc, 0x57, 0x75, 0xb0, 0x7e, 0xf6, 0x73, 0xb7,
+	0x31, 0xf0, 0x82, 0xe8, 0x11, 0x34, 0xad, 0xeb, 0x61, 0xa1, 0x14, 0x13, 0xc6, 0xd2, 0xfc, 0x44,
+	0x1c, 0x02, 0xf0, 0x37, 0x4c, 0x18, 0x7e, 0xc4, 0x99, 0x6a, 0xa2, 0x3d, 0xd4, 0xbe, 0x34, 0x28,
+	0x9d, 0x44, 0x4f, 0xe0, 0xfa, 0x0a, 0xad, 0xcf, 0x74, 0x0b, 0x2e, 0xa7, 0xee, 0xfc, 0xb5, 0x1d,
+	0x65, 0xf5, 0xeb, 0x83, 0xad, 0xb4, 0x44, 0x8e, 0x1e, 0x43, 0x6b, 0x7e, 0xa7, 0x17, 0x45, 0xa2,
+	0x53, 0xc5, 0x13, 0xa6, 0x74, 0xdd, 0x04, 0x6f, 0x61, 0xa7, 0x42, 0xef, 0x53, 0xf4, 0x61, 0x53,
+	0xcf, 0x8f, 0xfd, 0x7a, 0x6e, 0x56, 0xac, 0x67, 0x6e, 0xe0, 0x97, 0x54, 0xd6, 0xf6, 0xbe, 0xad,
+	0xc1, 0x45, 0x3b, 0x0c, 0x7f, 0x44, 0x00, 0xf3, 0x16, 0x70, 0x7b, 0xd9, 0x6e, 0x75, 0x85, 0xc1,
+	0xdd, 0x1a, 0x4c, 0x17, 0x3c, 0xba, 0xfd, 0xe1, 0xfb, 0xef, 0xcf, 0x17, 0x76, 0xf1, 0x0e, 0x59,
+	0x7c, 0x2e, 0xee, 0x59, 0xb9, 0xbf, 0xf8, 0x0b, 0x82, 0xad, 0xf2, 0xfa, 0x71, 0xa7, 0x62, 0xc4,
+	0x8a, 0x7e, 0x83, 0x7b, 0xb5, 0xb8, 0x3e, 0xd0, 0x7d, 0x1b, 0xe8, 0x0e, 0xde, 0xaf, 0x08, 0xf4,
+	0x4f, 0xd9, 0xf8, 0x2b, 0x82, 0xed, 0xc5, 0x52, 0x70, 0xfc, 0xbf, 0xeb, 0x2f, 0xb7, 0x1f, 0x90,
+	0xda, 0x7c, 0x9f, 0xb1, 0x63, 0x33, 0xee, 0xe3, 0xa8, 0x22, 0x63, 0xa9, 0xce, 0x83, 0xfe, 0xd9,
+	0x38, 0x44, 0xe7, 0xe3, 0x10, 0xfd, 0x1a, 0x87, 0xe8, 0xd3, 0x24, 0x6c, 0x9c, 0x4f, 0xc2, 0xc6,
+	0x8f, 0x49, 0xd8, 0x78, 0x45, 0x32, 0x6e, 0x86, 0x45, 0x12, 0xa7, 0xf2, 0x98, 0x3c, 0xb3, 0x3e,
+	0x87, 0x43, 0xca, 0xc5, 0xcc, 0x73, 0xd4, 0x23, 0xef, 0x67, 0xc6, 0xe6, 0x34, 0x67, 0x3a, 0xd9,
+	0xb0, 0x9f, 0xee, 0x83, 0x3f, 0x03, 0x00, 0x80, 0x27, 0xce, 0x45, 0x6a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -253,6 +348,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochInfosRequest, opts ...grpc.CallOption) (*QueryEpochInfosResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochSubscribers provide the contracts subscribed to the hooks of an
+	// epoch identifier
+	EpochSubscribers(ctx context.Context, in *QueryEpochSubscribersRequest, opts ...grpc.CallOption) (*QueryEpochSubscribersResponse, error)
 }
 
 type queryClient struct {
@@ -281,12 +379,24 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) EpochSubscribers(ctx context.Context, in *QueryEpochSubscribersRequest, opts ...grpc.CallOption) (*QueryEpochSubscribersResponse, error) {
+	out := new(QueryEpochSubscribersResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.epochs.v1.Query/EpochSubscribers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochInfosRequest) (*QueryEpochInfosResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochSubscribers provide the contracts subscribed to the hooks of an
+	// epoch identifier
+	EpochSubscribers(context.Context, *QueryEpochSubscribersRequest) (*QueryEpochSubscribersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -299,6 +409,9 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) EpochSubscribers(ctx context.Context, req *QueryEpochSubscribersRequest) (*QueryEpochSubscribersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSubscribers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -340,6 +453,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.epochs.v1.Query/EpochSubscribers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSubscribers(ctx, req.(*QueryEpochSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.epochs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -352,6 +483,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "EpochSubscribers",
+			Handler:    _Query_EpochSubscribers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/epochs/v1/query.proto",
@@ -475,6 +610,73 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEpochSubscribersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSubscribersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSubscribersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSubscribersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSubscribersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSubscribersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscribers) > 0 {
+		for iNdEx := len(m.Subscribers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscribers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -535,6 +737,34 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEpochSubscribersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochSubscribersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscribers) > 0 {
+		for _, e := range m.Subscribers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -826,6 +1056,172 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEpochSubscribersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSubscribersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSubscribersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochSubscribersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSubscribersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSubscribersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscribers = append(m.Subscribers, EpochSubscriber{})
+			if err := m.Subscribers[len(m.Subscribers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -128,42 +128,114 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+// EpochSubscriber is a sudo contract that receives the hooks of an epoch
+// identifier. Wasm contracts receive "AfterEpochEnd" and "BeforeEpochStart"
+// through the Wasm "sudo" entry point. EVM contracts receive "AfterEpochEnd"
+// through "onEpochEnd(string,uint256)" (see "IEpochHooks.sol").
+type EpochSubscriber struct {
+	// Bech32 address of the Wasm contract or hex address of the EVM contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Identifier of the epoch the contract subscribes to.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Gas limit given to each hook call. A call that runs out of gas or fails
+	// is reverted without affecting the epoch or the other subscribers.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *EpochSubscriber) Reset()         { *m = EpochSubscriber{} }
+func (m *EpochSubscriber) String() string { return proto.CompactTextString(m) }
+func (*EpochSubscriber) ProtoMessage()    {}
+func (*EpochSubscriber) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bd50db1722dd5e6, []int{1}
+}
+func (m *EpochSubscriber) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSubscriber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSubscriber.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSubscriber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSubscriber.Merge(m, src)
+}
+func (m *EpochSubscriber) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSubscriber) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSubscriber.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSubscriber proto.InternalMessageInfo
+
+func (m *EpochSubscriber) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EpochSubscriber) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochSubscriber) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "nibiru.epochs.v1.EpochInfo")
+	proto.RegisterType((*EpochSubscriber)(nil), "nibiru.epochs.v1.EpochSubscriber")
 }
 
 func init() { proto.RegisterFile("nibiru/epochs/v1/state.proto", fileDescriptor_8bd50db1722dd5e6) }
 
 var fileDescriptor_8bd50db1722dd5e6 = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0x73, 0x34, 0x94, 0xe6, 0x00, 0x01, 0xa7, 0x02, 0xc6, 0x82, 0xb3, 0x65, 0x16, 0x4b,
-	0x20, 0x9f, 0x52, 0x98, 0x60, 0x4b, 0x41, 0xa2, 0x0b, 0x83, 0xcb, 0x80, 0x58, 0x22, 0xdb, 0xb9,
-	0xd8, 0x27, 0xd5, 0x77, 0x96, 0xfd, 0x1c, 0x91, 0x8d, 0x99, 0xa9, 0x23, 0x7f, 0x52, 0xc7, 0x8e,
-	0x4c, 0x06, 0x25, 0x1b, 0x63, 0xff, 0x02, 0xe4, 0x3b, 0xbb, 0x04, 0x02, 0x62, 0xb3, 0xdf, 0xef,
-	0x7b, 0xdf, 0x77, 0xef, 0xe9, 0xe1, 0x87, 0x52, 0xc4, 0xa2, 0xac, 0x19, 0x2f, 0x54, 0x92, 0x55,
-	0x6c, 0x31, 0x66, 0x15, 0x44, 0xc0, 0x83, 0xa2, 0x54, 0xa0, 0xc8, 0x6d, 0x43, 0x03, 0x43, 0x83,
-	0xc5, 0xd8, 0xde, 0x4f, 0x55, 0xaa, 0x34, 0x64, 0xed, 0x97, 0xd1, 0xd9, 0x34, 0x55, 0x2a, 0x3d,
-	0xe1, 0x4c, 0xff, 0xc5, 0xf5, 0x9c, 0xcd, 0xea, 0x32, 0x02, 0xa1, 0x64, 0xc7, 0x9d, 0x3f, 0x39,
-	0x88, 0x9c, 0x57, 0x10, 0xe5, 0x85, 0x11, 0x78, 0x9f, 0x87, 0x78, 0xf4, 0xba, 0x0d, 0x39, 0x92,
-	0x73, 0x45, 0x28, 0xc6, 0x62, 0xc6, 0x25, 0x88, 0xb9, 0xe0, 0xa5, 0x85, 0x5c, 0xe4, 0x8f, 0xc2,
-	0x8d, 0x0a, 0x79, 0x8f, 0x71, 0x05, 0x51, 0x09, 0xd3, 0xd6, 0xc6, 0xba, 0xe2, 0x22, 0xff, 0xfa,
-	0x81, 0x1d, 0x98, 0x8c, 0xa0, 0xcf, 0x08, 0xde, 0xf5, 0x19, 0x93, 0x47, 0x67, 0x8d, 0x33, 0xb8,
-	0x68, 0x9c, 0x3b, 0xcb, 0x28, 0x3f, 0x79, 0xe1, 0xfd, 0xea, 0xf5, 0x4e, 0xbf, 0x39, 0x28, 0x1c,
-	0xe9, 0x42, 0x2b, 0x27, 0x19, 0xde, 0xeb, 0x9f, 0x6e, 0xed, 0x68, 0xdf, 0x07, 0x5b, 0xbe, 0xaf,
-	0x3a, 0xc1, 0x64, 0xdc, 0xda, 0xfe, 0x68, 0x1c, 0xd2, 0xb7, 0x3c, 0x55, 0xb9, 0x00, 0x9e, 0x17,
-	0xb0, 0xbc, 0x68, 0x9c, 0x5b, 0x26, 0xac, 0x67, 0xde, 0x97, 0x36, 0xea, 0xd2, 0x9d, 0x3c, 0xc6,
-	0x37, 0x93, 0xba, 0x2c, 0xb9, 0x84, 0xa9, 0xde, 0xae, 0x35, 0x74, 0x91, 0x3f, 0x0c, 0x6f, 0x74,
-	0x45, 0xbd, 0x0c, 0xf2, 0x09, 0x61, 0xeb, 0x37, 0xd5, 0x74, 0x63, 0xee, 0xab, 0xff, 0x9d, 0xfb,
-	0x49, 0x37, 0xb7, 0x63, 0x9e, 0xf2, 0x2f, 0x27, 0xb3, 0x85, 0xbb, 0x9b, 0xc9, 0xc7, 0x97, 0x1b,
-	0x79, 0x8e, 0xef, 0x19, 0x7d, 0xa2, 0x6a, 0x09, 0x42, 0xa6, 0xa6, 0x91, 0xcf, 0xac, 0x5d, 0x17,
-	0xf9, 0x7b, 0xe1, 0xbe, 0xa6, 0x87, 0x1d, 0x3c, 0x36, 0x8c, 0xbc, 0xc4, 0xf6, 0xdf, 0xd2, 0x32,
-	0x2e, 0xd2, 0x0c, 0xac, 0x6b, 0x2e, 0xf2, 0x77, 0xc2, 0xfb, 0x5b, 0x81, 0x6f, 0x34, 0x9e, 0x1c,
-	0x9d, 0xad, 0x28, 0x3a, 0x5f, 0x51, 0xf4, 0x7d, 0x45, 0xd1, 0xe9, 0x9a, 0x0e, 0xce, 0xd7, 0x74,
-	0xf0, 0x75, 0x4d, 0x07, 0x1f, 0x58, 0x2a, 0x20, 0xab, 0xe3, 0x20, 0x51, 0x39, 0x7b, 0xab, 0x4f,
-	0xf3, 0x30, 0x8b, 0x84, 0x64, 0xdd, 0x11, 0x2f, 0x0e, 0xd8, 0xc7, 0xfe, 0x92, 0x61, 0x59, 0xf0,
-	0x2a, 0xde, 0xd5, 0x5b, 0x79, 0xf6, 0x33, 0x00, 0x00, 0xff, 0xff, 0x11, 0xb3, 0x3e, 0x23, 0xe7,
-	0x02, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xb5, 0xa1, 0x24, 0x07, 0xa8, 0x60, 0x15, 0x30, 0x06, 0x6c, 0xcb, 0x2c, 0x96, 0x40,
+	0x3e, 0xa5, 0x30, 0xc1, 0x96, 0x82, 0x44, 0x25, 0xc4, 0xe0, 0x32, 0x20, 0x96, 0xc8, 0x76, 0x2e,
+	0xf6, 0xa1, 0xf8, 0xce, 0x3a, 0x3f, 0x47, 0x64, 0x63, 0x66, 0xea, 0xc8, 0x4f, 0xea, 0xd8, 0x91,
+	0x29, 0xa0, 0x64, 0x63, 0xec, 0x2f, 0x40, 0xbe, 0xb3, 0x43, 0x68, 0x40, 0x6c, 0x7e, 0xef, 0xfb,
+	0xde, 0xf7, 0xf9, 0xbd, 0x7b, 0x0f, 0x3f, 0xe0, 0x2c, 0x66, 0xb2, 0x22, 0xb4, 0x10, 0x49, 0x56,
+	0x92, 0xd9, 0x80, 0x94, 0x10, 0x01, 0x0d, 0x0a, 0x29, 0x40, 0x18, 0x37, 0x35, 0x1a, 0x68, 0x34,
+	0x98, 0x0d, 0xac, 0x83, 0x54, 0xa4, 0x42, 0x81, 0xa4, 0xfe, 0xd2, 0x3c, 0xcb, 0x4e, 0x85, 0x48,
+	0xa7, 0x94, 0xa8, 0x28, 0xae, 0x26, 0x64, 0x5c, 0xc9, 0x08, 0x98, 0xe0, 0x0d, 0xee, 0x5c, 0xc6,
+	0x81, 0xe5, 0xb4, 0x84, 0x28, 0x2f, 0x34, 0xc1, 0xfb, 0xd2, 0xc5, 0xfd, 0x57, 0xb5, 0xc9, 0x31,
+	0x9f, 0x08, 0xc3, 0xc6, 0x98, 0x8d, 0x29, 0x07, 0x36, 0x61, 0x54, 0x9a, 0xc8, 0x45, 0x7e, 0x3f,
+	0xdc, 0xc8, 0x18, 0xef, 0x31, 0x2e, 0x21, 0x92, 0x30, 0xaa, 0x65, 0xcc, 0x1d, 0x17, 0xf9, 0xd7,
+	0x0e, 0xad, 0x40, 0x7b, 0x04, 0xad, 0x47, 0xf0, 0xae, 0xf5, 0x18, 0x3e, 0x3c, 0x5b, 0x38, 0x9d,
+	0x8b, 0x85, 0x73, 0x6b, 0x1e, 0xe5, 0xd3, 0xe7, 0xde, 0xef, 0x5a, 0xef, 0xf4, 0xbb, 0x83, 0xc2,
+	0xbe, 0x4a, 0xd4, 0x74, 0x23, 0xc3, 0xbd, 0xf6, 0xd7, 0xcd, 0x5d, 0xa5, 0x7b, 0x6f, 0x4b, 0xf7,
+	0x65, 0x43, 0x18, 0x0e, 0x6a, 0xd9, 0x9f, 0x0b, 0xc7, 0x68, 0x4b, 0x9e, 0x88, 0x9c, 0x01, 0xcd,
+	0x0b, 0x98, 0x5f, 0x2c, 0x9c, 0x7d, 0x6d, 0xd6, 0x62, 0xde, 0xd7, 0xda, 0x6a, 0xad, 0x6e, 0x3c,
+	0xc2, 0x37, 0x92, 0x4a, 0x4a, 0xca, 0x61, 0xa4, 0xa6, 0x6b, 0x76, 0x5d, 0xe4, 0x77, 0xc3, 0xeb,
+	0x4d, 0x52, 0x0d, 0xc3, 0xf8, 0x8c, 0xb0, 0xf9, 0x07, 0x6b, 0xb4, 0xd1, 0xf7, 0x95, 0xff, 0xf6,
+	0xfd, 0xb8, 0xe9, 0xdb, 0xd1, 0xbf, 0xf2, 0x2f, 0x25, 0x3d, 0x85, 0xdb, 0x9b, 0xce, 0x27, 0xeb,
+	0x89, 0x3c, 0xc3, 0x77, 0x34, 0x3f, 0x11, 0x15, 0x07, 0xc6, 0x53, 0x5d, 0x48, 0xc7, 0xe6, 0x9e,
+	0x8b, 0xfc, 0x5e, 0x78, 0xa0, 0xd0, 0xa3, 0x06, 0x3c, 0xd1, 0x98, 0xf1, 0x02, 0x5b, 0x7f, 0x73,
+	0xcb, 0x28, 0x4b, 0x33, 0x30, 0xaf, 0xba, 0xc8, 0xdf, 0x0d, 0xef, 0x6e, 0x19, 0xbe, 0x56, 0xb0,
+	0xf7, 0x11, 0xef, 0xeb, 0x5c, 0x15, 0x97, 0x89, 0x64, 0x31, 0x95, 0x86, 0x85, 0x7b, 0x89, 0xe0,
+	0x20, 0xa3, 0x04, 0x9a, 0x7d, 0x58, 0xc7, 0x97, 0xb6, 0x65, 0x67, 0x6b, 0x5b, 0xee, 0xe3, 0x7e,
+	0x1a, 0x95, 0xa3, 0x29, 0xcb, 0x19, 0xa8, 0x47, 0xed, 0x86, 0xbd, 0x34, 0x2a, 0xdf, 0xd4, 0xf1,
+	0xf0, 0xf8, 0x6c, 0x69, 0xa3, 0xf3, 0xa5, 0x8d, 0x7e, 0x2c, 0x6d, 0x74, 0xba, 0xb2, 0x3b, 0xe7,
+	0x2b, 0xbb, 0xf3, 0x6d, 0x65, 0x77, 0x3e, 0x90, 0x94, 0x41, 0x56, 0xc5, 0x41, 0x22, 0x72, 0xf2,
+	0x56, 0x9d, 0xc1, 0x51, 0x16, 0x31, 0x4e, 0x9a, 0x83, 0x99, 0x1d, 0x92, 0x4f, 0xed, 0xd5, 0xc0,
+	0xbc, 0xa0, 0x65, 0xbc, 0xa7, 0x5e, 0xe0, 0xe9, 0xaf, 0x01, 0x00, 0x39, 0xeb, 0x01, 0xaa, 0x53,
+	0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -240,6 +312,48 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochSubscriber) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSubscriber) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSubscriber) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -279,6 +393,26 @@ func (m *EpochInfo) Size() (n int) {
 	return n
 }
 
+func (m *EpochSubscriber) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovState(uint64(m.GasLimit))
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -524,6 +658,139 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochSubscriber) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSubscriber: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSubscriber: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
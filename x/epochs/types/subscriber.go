@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+)
+
+const (
+	// DefaultSubscriberGasLimit is the gas limit of an epoch hook call when a
+	// subscriber doesn't specify one.
+	DefaultSubscriberGasLimit uint64 = 1_000_000
+	// MaxSubscriberGasLimit is the upper bound on the gas limit of an epoch
+	// hook call.
+	MaxSubscriberGasLimit uint64 = 10_000_000
+	// MaxSubscribersPerEpoch is the maximum number of contracts subscribed to
+	// the hooks of a single epoch. Together with [MaxSubscriberGasLimit], it
+	// bounds the gas spent on epoch hooks in a block.
+	MaxSubscribersPerEpoch = 20
+)
+
+// Hook names used in [SudoMsgEpochHook] and [EventEpochSubscriberError].
+const (
+	HookAfterEpochEnd    = "after_epoch_end"
+	HookBeforeEpochStart = "before_epoch_start"
+)
+
+// Validate performs stateless validation of the subscriber.
+func (s EpochSubscriber) Validate() error {
+	if _, err := ContractAccAddr(s.Contract); err != nil {
+		return fmt.Errorf("invalid subscriber contract address: %w", err)
+	}
+	if err := ValidateEpochIdentifierString(s.Identifier); err != nil {
+		return err
+	}
+	if s.GasLimit == 0 || s.GasLimit > MaxSubscriberGasLimit {
+		return fmt.Errorf(
+			"subscriber gas limit must be in (0, %d], got %d", MaxSubscriberGasLimit, s.GasLimit,
+		)
+	}
+	return nil
+}
+
+// IsEvm returns true if the subscriber is an EVM contract, given by its hex
+// address. Otherwise, the subscriber is a Wasm contract given by its Bech32
+// address.
+func (s EpochSubscriber) IsEvm() bool {
+	return strings.HasPrefix(s.Contract, "0x")
+}
+
+// ContractAccAddr parses the address of a subscriber contract, given either as
+// a Bech32 address (Wasm) or as a hex address (EVM). For EVM contracts, the
+// account address is the Bech32 form of the hex address, which is the address
+// that needs to be a sudo contract.
+func ContractAccAddr(contract string) (sdk.AccAddress, error) {
+	if strings.HasPrefix(contract, "0x") {
+		if !gethcommon.IsHexAddress(contract) {
+			return nil, fmt.Errorf("invalid hex address: %s", contract)
+		}
+		return eth.EthAddrToNibiruAddr(gethcommon.HexToAddress(contract)), nil
+	}
+	return sdk.AccAddressFromBech32(contract)
+}
+
+// SudoMsgEpochHook is the JSON message passed to the "sudo" entry point of a
+// subscribed Wasm contract. Exactly one of the fields is set.
+//
+// Example:
+//
+//	{"after_epoch_end": {"identifier": "day", "epoch_number": 42}}
+type SudoMsgEpochHook struct {
+	AfterEpochEnd    *EpochHookArgs `json:"after_epoch_end,omitempty"`
+	BeforeEpochStart *EpochHookArgs `json:"before_epoch_start,omitempty"`
+}
+
+// EpochHookArgs are the arguments of an epoch hook.
+type EpochHookArgs struct {
+	Identifier  string `json:"identifier"`
+	EpochNumber uint64 `json:"epoch_number"`
+}
@@ -0,0 +1,1994 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nibiru/epochs/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateEpoch: Msg to add a new epoch identifier.
+type MsgCreateEpoch struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// A string identifier for the epoch. e.g. "15min" or "1hour"
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// When the epoch repetition should start. Defaults to the block time if
+	// left unset.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// How long each epoch lasts for.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgCreateEpoch) Reset()         { *m = MsgCreateEpoch{} }
+func (m *MsgCreateEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEpoch) ProtoMessage()    {}
+func (*MsgCreateEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ffb05e3f0f3990, []int{0}
+}
+func (m *MsgCreateEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEpoch.Merge(m, src)
+}
+func (m *MsgCreateEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEpoch proto.InternalMessageInfo
+
+func (m *MsgCreateEpoch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCreateEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *MsgCreateEpoch) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateEpoch) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgCreateEpochResponse struct {
+}
+
+func (m *MsgCreateEpochResponse) Reset()         { *m = MsgCreateEpochResponse{} }
+func (m *MsgCreateEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEpochResponse) ProtoMessage()    {}
+func (*MsgCreateEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ffb05e3f0f3990, []int{1}
+}
+func (m *MsgCreateEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEpochResponse.Merge(m, src)
+}
+func (m *MsgCreateEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEpochResponse proto.InternalMessageInfo
+
+// MsgDeleteEpoch: Msg to remove an epoch identifier.
+type MsgDeleteEpoch struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *MsgDeleteEpoch) Reset()         { *m = MsgDeleteEpoch{} }
+func (m *MsgDeleteEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteEpoch) ProtoMessage()    {}
+func (*MsgDeleteEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ffb05e3f0f3990, []int{2}
+}
+func (m *MsgDeleteEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteEpoch.Merge(m, src)
+}
+func (m *MsgDeleteEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteEpoch proto.InternalMessageInfo
+
+func (m *MsgDeleteEpoch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgDeleteEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type MsgDeleteEpochResponse struct {
+}
+
+func (m *MsgDeleteEpochResponse) Reset()         { *m = MsgDeleteEpochResponse{} }
+func (m *MsgDeleteEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteEpochResponse) ProtoMessage()    {}
+func (*MsgDeleteEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ffb05e3f0f3990, []int{3}
+}
+func (m *MsgDeleteEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteEpochResponse.Merge(m, src)
+}
+func (m *MsgDeleteEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteEpochResponse proto.InternalMessageInfo
+
+// MsgSubscribeEpochHooks: Msg to register a contract as a subscriber to the
+// hooks of an epoch identifier. The contract must be a sudo contract.
+type MsgSubscribeEpochHooks struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Bech32 address of the Wasm contract or hex address of the EVM contract.
+	Contract   string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Identifier string `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Gas limit given to each hook call. Defaults to
+	// "DefaultSubscriberGasLimit" if zero.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *MsgSubscribeEpochHooks) Reset()         { *m = MsgSubscribeEpochHooks{} }
+func (m *MsgSubscribeEpochHooks) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscribeEpochHooks) ProtoMessage()    {}
+func (*MsgSubscribeEpochHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ffb05e3f0f3990, []int{4}
+}
+func (m *MsgSubscribeEpochHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubscribeEpochHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubscribeEpochHooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubscribeEpochHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscribeEpochHooks.Merge(m, src)
+}
+func (m *MsgSubscribeEpochHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubscribeEpochHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscribeEpochHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubscribeEpochHooks proto.InternalMessageInfo
+
+func (m *MsgSubscribeEpochHooks) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSubscribeEpochHooks) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgSubscribeEpochHooks) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *MsgSubscribeEpochHooks) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+type MsgSubscribeEpochHooksResponse struct {
+}
+
+func (m *MsgSubscribeEpochHooksResponse) Reset()         { *m = MsgSubscribeEpochHooksResponse{} }
+func (m *MsgSubscribeEpochHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscribeEpochHooksResponse) ProtoMessage()    {}
+func (*MsgSubscribeEpochHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ffb05e3f0f3990, []int{5}
+}
+func (m *MsgSubscribeEpochHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubscribeEpochHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubscribeEpochHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubscribeEpochHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscribeEpochHooksResponse.Merge(m, src)
+}
+func (m *MsgSubscribeEpochHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubscribeEpochHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscribeEpochHooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubscribeEpochHooksResponse proto.InternalMessageInfo
+
+// MsgUnsubscribeEpochHooks: Msg to remove a contract from the subscribers
+// of an epoch identifier.
+type MsgUnsubscribeEpochHooks struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Bech32 address of the Wasm contract or hex address of the EVM contract.
+	Contract   string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Identifier string `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *MsgUnsubscribeEpochHooks) Reset()         { *m = MsgUnsubscribeEpochHooks{} }
+func (m *MsgUnsubscribeEpochHooks) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsubscribeEpochHooks) ProtoMessage()    {}
+func (*MsgUnsubscribeEpochHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ffb05e3f0f3990, []int{6}
+}
+func (m *MsgUnsubscribeEpochHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsubscribeEpochHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsubscribeEpochHooks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnsubscribeEpochHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsubscribeEpochHooks.Merge(m, src)
+}
+func (m *MsgUnsubscribeEpochHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsubscribeEpochHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsubscribeEpochHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnsubscribeEpochHooks proto.InternalMessageInfo
+
+func (m *MsgUnsubscribeEpochHooks) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUnsubscribeEpochHooks) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgUnsubscribeEpochHooks) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type MsgUnsubscribeEpochHooksResponse struct {
+}
+
+func (m *MsgUnsubscribeEpochHooksResponse) Reset()         { *m = MsgUnsubscribeEpochHooksResponse{} }
+func (m *MsgUnsubscribeEpochHooksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsubscribeEpochHooksResponse) ProtoMessage()    {}
+func (*MsgUnsubscribeEpochHooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ffb05e3f0f3990, []int{7}
+}
+func (m *MsgUnsubscribeEpochHooksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsubscribeEpochHooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsubscribeEpochHooksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnsubscribeEpochHooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsubscribeEpochHooksResponse.Merge(m, src)
+}
+func (m *MsgUnsubscribeEpochHooksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsubscribeEpochHooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsubscribeEpochHooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnsubscribeEpochHooksResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateEpoch)(nil), "nibiru.epochs.v1.MsgCreateEpoch")
+	proto.RegisterType((*MsgCreateEpochResponse)(nil), "nibiru.epochs.v1.MsgCreateEpochResponse")
+	proto.RegisterType((*MsgDeleteEpoch)(nil), "nibiru.epochs.v1.MsgDeleteEpoch")
+	proto.RegisterType((*MsgDeleteEpochResponse)(nil), "nibiru.epochs.v1.MsgDeleteEpochResponse")
+	proto.RegisterType((*MsgSubscribeEpochHooks)(nil), "nibiru.epochs.v1.MsgSubscribeEpochHooks")
+	proto.RegisterType((*MsgSubscribeEpochHooksResponse)(nil), "nibiru.epochs.v1.MsgSubscribeEpochHooksResponse")
+	proto.RegisterType((*MsgUnsubscribeEpochHooks)(nil), "nibiru.epochs.v1.MsgUnsubscribeEpochHooks")
+	proto.RegisterType((*MsgUnsubscribeEpochHooksResponse)(nil), "nibiru.epochs.v1.MsgUnsubscribeEpochHooksResponse")
+}
+
+func init() { proto.RegisterFile("nibiru/epochs/v1/tx.proto", fileDescriptor_95ffb05e3f0f3990) }
+
+var fileDescriptor_95ffb05e3f0f3990 = []byte{
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x1c, 0xc5, 0x33, 0x4d, 0x28, 0xc9, 0x14, 0x44, 0x56, 0x2d, 0xdb, 0xad, 0xdd, 0x2c, 0x2b, 0x4a,
+	0xf0, 0xb0, 0x63, 0xe3, 0x1f, 0x20, 0x34, 0x15, 0x2a, 0x18, 0x0f, 0x51, 0x2f, 0x5e, 0xc2, 0xec,
+	0x66, 0x3a, 0x19, 0x4c, 0x66, 0x96, 0x9d, 0xd9, 0x50, 0x8f, 0x7a, 0xf3, 0x56, 0x10, 0xc4, 0x93,
+	0xff, 0x8e, 0x3d, 0x49, 0xc1, 0x8b, 0x27, 0x95, 0xc4, 0x3f, 0x44, 0x76, 0xf6, 0x87, 0x69, 0x76,
+	0x4b, 0x03, 0x82, 0xb7, 0xcc, 0xbe, 0x37, 0xef, 0x7d, 0xbe, 0xbb, 0x33, 0x81, 0x3b, 0x9c, 0xf9,
+	0x2c, 0x8a, 0x11, 0x09, 0x45, 0x30, 0x96, 0x68, 0xb6, 0x8f, 0xd4, 0x89, 0x17, 0x46, 0x42, 0x09,
+	0xe3, 0x7a, 0x2a, 0x79, 0xa9, 0xe4, 0xcd, 0xf6, 0xad, 0x9b, 0x54, 0x50, 0xa1, 0x45, 0x94, 0xfc,
+	0x4a, 0x7d, 0xd6, 0x6d, 0x2a, 0x04, 0x9d, 0x10, 0x84, 0x43, 0x86, 0x30, 0xe7, 0x42, 0x61, 0xc5,
+	0x04, 0x97, 0x99, 0x6a, 0x67, 0xaa, 0x5e, 0xf9, 0xf1, 0x31, 0x1a, 0xc5, 0x91, 0x36, 0x64, 0x7a,
+	0x7b, 0x55, 0x57, 0x6c, 0x4a, 0xa4, 0xc2, 0xd3, 0x30, 0x35, 0xb8, 0x5f, 0x01, 0xbc, 0xd6, 0x97,
+	0xb4, 0x17, 0x11, 0xac, 0xc8, 0xe3, 0x84, 0xc5, 0xd8, 0x86, 0x9b, 0x92, 0xf0, 0x11, 0x89, 0x4c,
+	0xe0, 0x80, 0x4e, 0x6b, 0x90, 0xad, 0x0c, 0x1b, 0x42, 0x36, 0x22, 0x5c, 0xb1, 0x63, 0x46, 0x22,
+	0x73, 0x43, 0x6b, 0x4b, 0x4f, 0x8c, 0x1e, 0x84, 0x52, 0xe1, 0x48, 0x0d, 0x93, 0x0e, 0xb3, 0xee,
+	0x80, 0xce, 0x56, 0xd7, 0xf2, 0x52, 0x00, 0x2f, 0x07, 0xf0, 0x5e, 0xe4, 0x00, 0x07, 0xcd, 0xb3,
+	0x1f, 0xed, 0xda, 0xe9, 0xcf, 0x36, 0x18, 0xb4, 0xf4, 0xbe, 0x44, 0x31, 0x1e, 0xc1, 0x66, 0x3e,
+	0x82, 0xd9, 0xd0, 0x11, 0x3b, 0xa5, 0x88, 0xc3, 0xcc, 0x90, 0x26, 0x7c, 0x4a, 0x12, 0x8a, 0x4d,
+	0xae, 0x09, 0xb7, 0x2f, 0xce, 0x33, 0x20, 0x32, 0x14, 0x5c, 0x12, 0xf7, 0x48, 0x4f, 0x7a, 0x48,
+	0x26, 0xe4, 0x1f, 0x27, 0xcd, 0x3a, 0x96, 0x92, 0x8a, 0x8e, 0xf7, 0x40, 0x4b, 0xcf, 0x63, 0x5f,
+	0x06, 0x11, 0xf3, 0x53, 0xf5, 0x48, 0x88, 0xd7, 0xf2, 0xd2, 0x32, 0x0b, 0x36, 0x03, 0xc1, 0x55,
+	0x84, 0x03, 0x95, 0x55, 0x15, 0xeb, 0x15, 0x90, 0x7a, 0xe9, 0x95, 0xef, 0xc2, 0x16, 0xc5, 0x72,
+	0x38, 0x61, 0x53, 0xa6, 0xf4, 0xeb, 0x6a, 0x0c, 0x9a, 0x14, 0xcb, 0xa7, 0xc9, 0xda, 0x75, 0xa0,
+	0x5d, 0x8d, 0x52, 0xd0, 0x72, 0x68, 0xf6, 0x25, 0x7d, 0xc9, 0xe5, 0xff, 0xc1, 0x75, 0x5d, 0xe8,
+	0x5c, 0xd6, 0x97, 0x33, 0x75, 0xbf, 0x34, 0x60, 0xbd, 0x2f, 0xa9, 0xf1, 0x16, 0xc0, 0xad, 0xe5,
+	0x53, 0xe9, 0x78, 0xab, 0x17, 0xc6, 0xbb, 0xf8, 0x9d, 0xad, 0xce, 0x55, 0x8e, 0x62, 0xee, 0x7b,
+	0xef, 0xbe, 0xfd, 0xfe, 0xb0, 0xe1, 0xb8, 0x36, 0x2a, 0xdd, 0xcf, 0x40, 0xdb, 0x87, 0xfa, 0x81,
+	0x66, 0x58, 0x3e, 0x2f, 0xd5, 0x0c, 0x4b, 0x0e, 0xab, 0x73, 0x95, 0x63, 0x1d, 0x86, 0x91, 0xb6,
+	0x67, 0x0c, 0x1f, 0x01, 0xbc, 0x51, 0x75, 0x9c, 0xaa, 0x9b, 0x2a, 0x9c, 0xd6, 0x83, 0x75, 0x9d,
+	0x05, 0xdb, 0x1d, 0xcd, 0xb6, 0xe7, 0xee, 0x96, 0xd9, 0x8a, 0x4f, 0x67, 0x7c, 0x06, 0xf0, 0x56,
+	0xf5, 0xd1, 0xb9, 0x5f, 0x59, 0x58, 0xe9, 0xb5, 0xba, 0xeb, 0x7b, 0x0b, 0xbc, 0xbb, 0x1a, 0xaf,
+	0xed, 0xee, 0x95, 0xf1, 0xe2, 0xbf, 0x1b, 0x0f, 0x9e, 0x9c, 0xcd, 0x6d, 0x70, 0x3e, 0xb7, 0xc1,
+	0xaf, 0xb9, 0x0d, 0x4e, 0x17, 0x76, 0xed, 0x7c, 0x61, 0xd7, 0xbe, 0x2f, 0xec, 0xda, 0x2b, 0x44,
+	0x99, 0x1a, 0xc7, 0xbe, 0x17, 0x88, 0x29, 0x7a, 0xa6, 0x23, 0x7a, 0x63, 0xcc, 0x78, 0x1e, 0x37,
+	0xeb, 0xa2, 0x93, 0x3c, 0x53, 0xbd, 0x09, 0x89, 0xf4, 0x37, 0xf5, 0x7f, 0xcf, 0xc3, 0x3f, 0x03,
+	0x00, 0x78, 0x63, 0xe2, 0xc3, 0xd0, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateEpoch adds a new epoch identifier at runtime.
+	CreateEpoch(ctx context.Context, in *MsgCreateEpoch, opts ...grpc.CallOption) (*MsgCreateEpochResponse, error)
+	// DeleteEpoch removes an epoch identifier and all of its subscribers.
+	DeleteEpoch(ctx context.Context, in *MsgDeleteEpoch, opts ...grpc.CallOption) (*MsgDeleteEpochResponse, error)
+	// SubscribeEpochHooks registers a sudo contract to receive the
+	// "AfterEpochEnd" and "BeforeEpochStart" hooks of an epoch identifier.
+	SubscribeEpochHooks(ctx context.Context, in *MsgSubscribeEpochHooks, opts ...grpc.CallOption) (*MsgSubscribeEpochHooksResponse, error)
+	// UnsubscribeEpochHooks removes a contract from the subscribers of an
+	// epoch identifier.
+	UnsubscribeEpochHooks(ctx context.Context, in *MsgUnsubscribeEpochHooks, opts ...grpc.CallOption) (*MsgUnsubscribeEpochHooksResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateEpoch(ctx context.Context, in *MsgCreateEpoch, opts ...grpc.CallOption) (*MsgCreateEpochResponse, error) {
+	out := new(MsgCreateEpochResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.epochs.v1.Msg/CreateEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteEpoch(ctx context.Context, in *MsgDeleteEpoch, opts ...grpc.CallOption) (*MsgDeleteEpochResponse, error) {
+	out := new(MsgDeleteEpochResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.epochs.v1.Msg/DeleteEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubscribeEpochHooks(ctx context.Context, in *MsgSubscribeEpochHooks, opts ...grpc.CallOption) (*MsgSubscribeEpochHooksResponse, error) {
+	out := new(MsgSubscribeEpochHooksResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.epochs.v1.Msg/SubscribeEpochHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnsubscribeEpochHooks(ctx context.Context, in *MsgUnsubscribeEpochHooks, opts ...grpc.CallOption) (*MsgUnsubscribeEpochHooksResponse, error) {
+	out := new(MsgUnsubscribeEpochHooksResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.epochs.v1.Msg/UnsubscribeEpochHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateEpoch adds a new epoch identifier at runtime.
+	CreateEpoch(context.Context, *MsgCreateEpoch) (*MsgCreateEpochResponse, error)
+	// DeleteEpoch removes an epoch identifier and all of its subscribers.
+	DeleteEpoch(context.Context, *MsgDeleteEpoch) (*MsgDeleteEpochResponse, error)
+	// SubscribeEpochHooks registers a sudo contract to receive the
+	// "AfterEpochEnd" and "BeforeEpochStart" hooks of an epoch identifier.
+	SubscribeEpochHooks(context.Context, *MsgSubscribeEpochHooks) (*MsgSubscribeEpochHooksResponse, error)
+	// UnsubscribeEpochHooks removes a contract from the subscribers of an
+	// epoch identifier.
+	UnsubscribeEpochHooks(context.Context, *MsgUnsubscribeEpochHooks) (*MsgUnsubscribeEpochHooksResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateEpoch(ctx context.Context, req *MsgCreateEpoch) (*MsgCreateEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEpoch not implemented")
+}
+func (*UnimplementedMsgServer) DeleteEpoch(ctx context.Context, req *MsgDeleteEpoch) (*MsgDeleteEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpoch not implemented")
+}
+func (*UnimplementedMsgServer) SubscribeEpochHooks(ctx context.Context, req *MsgSubscribeEpochHooks) (*MsgSubscribeEpochHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeEpochHooks not implemented")
+}
+func (*UnimplementedMsgServer) UnsubscribeEpochHooks(ctx context.Context, req *MsgUnsubscribeEpochHooks) (*MsgUnsubscribeEpochHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeEpochHooks not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.epochs.v1.Msg/CreateEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEpoch(ctx, req.(*MsgCreateEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.epochs.v1.Msg/DeleteEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteEpoch(ctx, req.(*MsgDeleteEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubscribeEpochHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubscribeEpochHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubscribeEpochHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.epochs.v1.Msg/SubscribeEpochHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubscribeEpochHooks(ctx, req.(*MsgSubscribeEpochHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnsubscribeEpochHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsubscribeEpochHooks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnsubscribeEpochHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.epochs.v1.Msg/UnsubscribeEpochHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsubscribeEpochHooks(ctx, req.(*MsgUnsubscribeEpochHooks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.epochs.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEpoch",
+			Handler:    _Msg_CreateEpoch_Handler,
+		},
+		{
+			MethodName: "DeleteEpoch",
+			Handler:    _Msg_DeleteEpoch_Handler,
+		},
+		{
+			MethodName: "SubscribeEpochHooks",
+			Handler:    _Msg_SubscribeEpochHooks_Handler,
+		},
+		{
+			MethodName: "UnsubscribeEpochHooks",
+			Handler:    _Msg_UnsubscribeEpochHooks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nibiru/epochs/v1/tx.proto",
+}
+
+func (m *MsgCreateEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubscribeEpochHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeEpochHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeEpochHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubscribeEpochHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeEpochHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeEpochHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsubscribeEpochHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsubscribeEpochHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsubscribeEpochHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsubscribeEpochHooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsubscribeEpochHooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsubscribeEpochHooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubscribeEpochHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MsgSubscribeEpochHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnsubscribeEpochHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnsubscribeEpochHooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeEpochHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubscribeEpochHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubscribeEpochHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeEpochHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubscribeEpochHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubscribeEpochHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsubscribeEpochHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsubscribeEpochHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsubscribeEpochHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsubscribeEpochHooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsubscribeEpochHooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsubscribeEpochHooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
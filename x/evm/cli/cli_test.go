@@ -79,6 +79,26 @@ func (s *Suite) TestCmdCreateFunToken() {
 			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
 			wantErr:   "exactly one of the flags --bank-denom or --erc20 must be specified",
 		},
+		{
+			name: "happy: create-funtoken (erc20) with scaling exponent",
+			args: []string{
+				"create-funtoken",
+				fmt.Sprintf("--erc20=%s", dummyEthAddr),
+				"--scaling-exponent=12",
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "",
+		},
+		{
+			name: "sad: scaling exponent for bank coin",
+			args: []string{
+				"create-funtoken",
+				fmt.Sprintf("--bank-denom=%s", dummyFuntoken.BankDenom),
+				"--scaling-exponent=12",
+			},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "can only be set with",
+		},
 	}
 
 	for _, tc := range testCases {
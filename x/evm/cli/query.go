@@ -30,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 	// Add subcommands
 	cmds := []*cobra.Command{
 		CmdQueryFunToken(),
+		CmdQueryFunTokenEscrow(),
+		CmdQueryFunNFT(),
 		CmdQueryAccount(),
 	}
 	for _, cmd := range cmds {
@@ -75,6 +77,82 @@ $ %s query %s get-fun-token 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
 	return cmd
 }
 
+// CmdQueryFunTokenEscrow returns the escrow of a fungible token mapping created
+// from an ERC20 against the supply of its bank coin
+func CmdQueryFunTokenEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funtoken-escrow [coin-or-erc20addr]",
+		Short: "Query the ERC20 escrow of an evm fungible token mapping against its bank coin supply",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ERC20 escrow of an evm fungible token mapping against its bank coin supply.
+
+Examples:
+$ %s query %s funtoken-escrow erc20/0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
+$ %s query %s funtoken-escrow 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FunTokenEscrow(cmd.Context(), &evm.QueryFunTokenEscrowRequest{
+				Token: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryFunNFT returns the non-fungible token mapping for either an x/nft
+// class or erc721 addr
+func CmdQueryFunNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funnft [class-or-erc721addr]",
+		Short: "Query evm non-fungible token mapping",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query evm non-fungible token mapping.
+
+Examples:
+$ %s query %s funnft erc721/0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
+$ %s query %s funnft 0x7D4B7B8CA7E1a24928Bb96D59249c7a5bd1DfBe6
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := evm.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FunNFTMapping(cmd.Context(), &evm.QueryFunNFTMappingRequest{
+				Token: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdQueryAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "account [address]",
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -28,6 +29,11 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		CmdCreateFunToken(),
 		CmdConvertCoinToEvm(),
+		CmdConvertEvmToCoin(),
+		CmdReconcileFunTokenEscrow(),
+		CmdCreateFunNFT(),
+		CmdConvertEvmToNFT(),
+		CmdConvertNFTToEvm(),
 	}
 	for _, cmd := range cmds {
 		txCmd.AddCommand(cmd)
@@ -49,6 +55,11 @@ func CmdCreateFunToken() *cobra.Command {
 	Example: Creating a fungible token mapping from an ERC20.
 
 	create-funtoken --erc20=[erc20-address]
+
+	Example: Creating a fungible token mapping from an ERC20 with 18 decimals
+	to a bank coin with 6 decimals.
+
+	create-funtoken --erc20=[erc20-address] --scaling-exponent=12
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -80,6 +91,10 @@ func CmdCreateFunToken() *cobra.Command {
 				}
 				msg.FromErc20 = &erc20Addr
 			}
+			msg.ScalingExponent, _ = cmd.Flags().GetUint32("scaling-exponent")
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -87,6 +102,7 @@ func CmdCreateFunToken() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String("bank-denom", "", "The bank denom to create a fungible token from")
 	cmd.Flags().String("erc20", "", "The ERC20 address to create a fungible token from")
+	cmd.Flags().Uint32("scaling-exponent", 0, "Number of ERC20 decimals to drop in the bank coin (only with --erc20)")
 
 	return cmd
 }
@@ -123,3 +139,238 @@ func CmdConvertCoinToEvm() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdConvertEvmToCoin broadcast MsgConvertEvmToCoin
+func CmdConvertEvmToCoin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-evm-to-coin [erc20] [amount] [flags]",
+		Short: `Convert [amount] of [erc20] tokens to their bank coin representation and send to the --to account"`,
+		Long: heredoc.Doc(`
+	Converts ERC20 tokens with a FunToken mapping held by the EVM address of the
+	sender to bank coins. The coins are sent to the sender unless a bech32 or hex
+	recipient address is given with --to.
+
+	Example:
+
+	convert-evm-to-coin [erc20-address] 1000000 --to=[nibi-address]
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			erc20Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			toAddr, _ := cmd.Flags().GetString("to")
+			if toAddr == "" {
+				toAddr = clientCtx.GetFromAddress().String()
+			}
+
+			msg := &evm.MsgConvertEvmToCoin{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Erc20Addr: erc20Addr,
+				Amount:    amount,
+				ToAddr:    toAddr,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String("to", "", "The bech32 or hex address receiving the bank coins. Defaults to the sender")
+	return cmd
+}
+
+// CmdReconcileFunTokenEscrow broadcast MsgReconcileFunTokenEscrow
+func CmdReconcileFunTokenEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile-funtoken-escrow [erc20] [flags]",
+		Short: "Reconcile the escrow of the FunToken mapping of [erc20] with its bank coin supply (sudo only)",
+		Long: heredoc.Doc(`
+	Compares the ERC20 tokens escrowed by the EVM module for a FunToken created
+	from an ERC20 with the supply of its bank coin. Pass --rebasing to flag the
+	mapping as rebasing, so that conversions are pro-rated against the escrow.
+	A mapping whose escrow shrank is flagged regardless. Only callable by the
+	x/sudo root or sudo contracts.
+
+	Example:
+
+	reconcile-funtoken-escrow [erc20-address] --rebasing
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			erc20Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			isRebasing, _ := cmd.Flags().GetBool("rebasing")
+
+			msg := &evm.MsgReconcileFunTokenEscrow{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Erc20Addr:  erc20Addr,
+				IsRebasing: isRebasing,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool("rebasing", false, "Flag the FunToken mapping as rebasing")
+	return cmd
+}
+
+// CmdCreateFunNFT broadcast MsgCreateFunNFT
+func CmdCreateFunNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-funnft [erc721] [flags]",
+		Short: "Create a non-fungible token mapping between an ERC721 collection and an x/nft class",
+		Long: heredoc.Doc(`
+	Creates a FunNFT mapping for an ERC721 collection. The mapping gets a new
+	x/nft class with the ID "erc721/[erc721-address]". Costs the same fee as the
+	creation of a FunToken mapping.
+
+	Classes created in x/nft can't be mapped to an ERC721 collection.
+
+	Example:
+
+	create-funnft [erc721-address]
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			erc721Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &evm.MsgCreateFunNFT{
+				Sender:     clientCtx.GetFromAddress().String(),
+				FromErc721: erc721Addr,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdConvertEvmToNFT broadcast MsgConvertEvmToNFT
+func CmdConvertEvmToNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-evm-to-nft [erc721] [token-id] [flags]",
+		Short: "Convert the [token-id] token of [erc721] to its x/nft representation and send it to the --to account",
+		Long: heredoc.Doc(`
+	Converts an ERC721 token with a FunNFT mapping held by the EVM address of the
+	sender to an x/nft token. The ERC721 token is escrowed by the EVM module. The
+	x/nft token is sent to the sender unless a bech32 or hex recipient address is
+	given with --to.
+
+	Example:
+
+	convert-evm-to-nft [erc721-address] 42 --to=[nibi-address]
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			erc721Addr, err := eth.NewEIP55AddrFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenID, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid token ID %s", args[1])
+			}
+
+			toAddr, _ := cmd.Flags().GetString("to")
+			if toAddr == "" {
+				toAddr = clientCtx.GetFromAddress().String()
+			}
+
+			msg := &evm.MsgConvertEvmToNFT{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Erc721Addr: erc721Addr,
+				TokenId:    tokenID,
+				ToAddr:     toAddr,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String("to", "", "The bech32 or hex address receiving the x/nft token. Defaults to the sender")
+	return cmd
+}
+
+// CmdConvertNFTToEvm broadcast MsgConvertNFTToEvm
+func CmdConvertNFTToEvm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-nft-to-evm [class-id] [nft-id] [to-eth-addr] [flags]",
+		Short: "Convert the [nft-id] token of the x/nft class [class-id] to its ERC721 representation",
+		Long: heredoc.Doc(`
+	Converts an x/nft token of a FunNFT class owned by the sender to the ERC721
+	token of the same ID, sent to [to-eth-addr]. The x/nft token is burned.
+
+	Example:
+
+	convert-nft-to-evm erc721/[erc721-address] 42 [to-eth-addr]
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toEthAddr, err := eth.NewEIP55AddrFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &evm.MsgConvertNFTToEvm{
+				Sender:    clientCtx.GetFromAddress().String(),
+				ClassId:   args[0],
+				NftId:     args[1],
+				ToEthAddr: toEthAddr,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -65,6 +65,13 @@ const (
 	KeyPrefixFunTokenIdxErc20
 	// KV store prefix for indexing `FunToken` by bank coin denomination
 	KeyPrefixFunTokenIdxBankDenom
+
+	// KV store prefix for `FunNFT` mappings
+	KeyPrefixFunNFTs
+	// KV store prefix for indexing `FunNFT` by ERC-721 address
+	KeyPrefixFunNFTIdxErc721
+	// KV store prefix for indexing `FunNFT` by "x/nft" class ID
+	KeyPrefixFunNFTIdxClassID
 )
 
 // KVStore transient prefix namespaces for the EVM Module. Transient stores only
@@ -4,6 +4,7 @@ package evm
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -36,3 +37,19 @@ type StakingKeeper interface {
 	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
 	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
 }
+
+// SudoKeeper defines the x/sudo permission check used to gate the sudo
+// messages of the x/evm module.
+type SudoKeeper interface {
+	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
+}
+
+// NFTKeeper defines the expected interface of the Cosmos SDK NFT Module
+// ("x/nft") used to convert ERC-721 tokens with a "FunNFT" mapping.
+type NFTKeeper interface {
+	SaveClass(ctx sdk.Context, class nft.Class) error
+	HasClass(ctx sdk.Context, classID string) bool
+	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID string, nftID string) error
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+}
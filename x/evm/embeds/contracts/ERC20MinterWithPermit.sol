@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity >=0.8.19;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "@openzeppelin/contracts/token/ERC20/extensions/ERC20Burnable.sol";
+import "@openzeppelin/contracts/token/ERC20/extensions/IERC20Permit.sol";
+import "@openzeppelin/contracts/interfaces/IERC5267.sol";
+import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/utils/cryptography/ECDSA.sol";
+
+/// @dev {ERC20} token, including:
+///
+///  - an "owner" that can mint tokens and update the token metadata
+///  - ability for holders to burn (destroy) their tokens
+///  - gasless approvals signed off-chain with {permit} (EIP-2612)
+///  - the EIP-712 domain of the permits through {eip712Domain} (EIP-5267)
+///
+/// The storage layout extends "ERC20MinterWithMetadataUpdates", so a contract
+/// running that bytecode can be upgraded in place.
+///
+/// The EIP-712 domain uses the current {name} of the token and version "1".
+/// Updating the name changes the domain and emits {EIP712DomainChanged}.
+contract ERC20MinterWithPermit is
+    ERC20,
+    ERC20Burnable,
+    Ownable,
+    IERC20Permit,
+    IERC5267
+{
+    uint8 private _decimals;
+
+    // use our own state variables instead of the ones from ERC20 to allow for updating
+    string private _name;
+    string private _symbol;
+
+    mapping(address => uint256) private _nonces;
+
+    bytes32 private constant _TYPE_HASH =
+        keccak256(
+            "EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"
+        );
+    bytes32 private constant _PERMIT_TYPEHASH =
+        keccak256(
+            "Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"
+        );
+    string private constant _VERSION = "1";
+
+    /// @dev Grants "owner" status to the account that deploys the contract and
+    /// customizes tokens decimals.
+    ///
+    /// See {ERC20-constructor}.
+    constructor(
+        string memory name_,
+        string memory symbol_,
+        uint8 decimals_
+    ) ERC20(name_, symbol_) {
+        _decimals = decimals_;
+        _name = name_;
+        _symbol = symbol_;
+    }
+
+    /// @dev Overrides the `decimals()` method with custom `_decimals`
+    function decimals() public view virtual override returns (uint8) {
+        return _decimals;
+    }
+
+    /// @dev Overrides the `name()` method to return the current name of the token.
+    function name() public view virtual override returns (string memory) {
+        return _name;
+    }
+
+    /// @dev Overrides the `symbol()` method to return the current symbol of the token.
+    function symbol() public view virtual override returns (string memory) {
+        return _symbol;
+    }
+
+    /// @dev Allows the owner to update the decimals of the token. Changing the
+    /// decimals rescales every balance, so it should be avoided after tokens
+    /// are minted.
+    function setDecimals(uint8 decimals_) public onlyOwner {
+        _decimals = decimals_;
+    }
+
+    /// @dev Allows the owner to update the name of the token. The name is part
+    /// of the EIP-712 domain, so permits signed for the previous name are no
+    /// longer valid.
+    function setName(string memory name_) public onlyOwner {
+        _name = name_;
+        emit EIP712DomainChanged();
+    }
+
+    /// @dev Allows the owner to update the symbol of the token.
+    function setSymbol(string memory symbol_) public onlyOwner {
+        _symbol = symbol_;
+    }
+
+    /// @dev Creates `amount` new tokens for `to`.
+    ///
+    /// See {ERC20-_mint}.
+    function mint(address to, uint256 amount) public virtual onlyOwner {
+        _mint(to, amount);
+    }
+
+    /// @dev Destroys `amount` new tokens for `to`. Suitable when the contract owner
+    /// should have authority to burn tokens from an account directly, such as in
+    /// the case of regulatory compliance, or actions selected via
+    /// decentralized governance.
+    ///
+    /// See {ERC20-_burn}.
+    function burnFromAuthority(
+        address from,
+        uint256 amount
+    ) public virtual onlyOwner {
+        _burn(from, amount);
+    }
+
+    /// @dev See {IERC20Permit-permit}.
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public virtual override {
+        require(block.timestamp <= deadline, "ERC20Permit: expired deadline");
+
+        bytes32 structHash = keccak256(
+            abi.encode(
+                _PERMIT_TYPEHASH,
+                owner,
+                spender,
+                value,
+                _useNonce(owner),
+                deadline
+            )
+        );
+        bytes32 hash = ECDSA.toTypedDataHash(DOMAIN_SEPARATOR(), structHash);
+
+        address signer = ECDSA.recover(hash, v, r, s);
+        require(signer == owner, "ERC20Permit: invalid signature");
+
+        _approve(owner, spender, value);
+    }
+
+    /// @dev See {IERC20Permit-nonces}.
+    function nonces(
+        address owner
+    ) public view virtual override returns (uint256) {
+        return _nonces[owner];
+    }
+
+    /// @dev See {IERC20Permit-DOMAIN_SEPARATOR}.
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() public view override returns (bytes32) {
+        return
+            keccak256(
+                abi.encode(
+                    _TYPE_HASH,
+                    keccak256(bytes(name())),
+                    keccak256(bytes(_VERSION)),
+                    block.chainid,
+                    address(this)
+                )
+            );
+    }
+
+    /// @dev See {IERC5267-eip712Domain}.
+    function eip712Domain()
+        public
+        view
+        virtual
+        override
+        returns (
+            bytes1 fields,
+            string memory name_,
+            string memory version,
+            uint256 chainId,
+            address verifyingContract,
+            bytes32 salt,
+            uint256[] memory extensions
+        )
+    {
+        return (
+            hex"0f", // 01111
+            name(),
+            _VERSION,
+            block.chainid,
+            address(this),
+            bytes32(0),
+            new uint256[](0)
+        );
+    }
+
+    /// @dev Consumes the current nonce of `owner` and returns it.
+    function _useNonce(address owner) internal virtual returns (uint256 current) {
+        current = _nonces[owner];
+        _nonces[owner] = current + 1;
+    }
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+/// @notice Interface of EVM contracts subscribed to the epoch hooks of the
+/// "x/epochs" module. The chain calls "onEpochEnd" at the end of every epoch
+/// of the subscribed identifier.
+interface IEpochHooks {
+    /// @notice Called by the chain at the end of an epoch.
+    /// @param identifier The epoch identifier. For example, "day" or "week".
+    /// @param epochNumber The number of the epoch that ended, starting from 1.
+    /// @dev The call is made from the EVM module account with a bounded gas
+    /// limit. A revert only rolls back the state changes of this call.
+    function onEpochEnd(string memory identifier, uint256 epochNumber) external;
+}
@@ -14,15 +14,52 @@ interface IFunToken is INibiruEvm {
     /// @param erc20 - the address of the ERC20 token contract
     /// @param amount - the amount of tokens to send
     /// @param to - the receiving Nibiru base account address as a string
-    /// @return sentAmount - amount of tokens received by the recipient. This may
-    /// not be equal to `amount` if the corresponding ERC20 contract has a fee or
-    /// deduction on transfer.
+    /// @return sentAmount - amount of bank coins received by the recipient. This
+    /// may not be equal to `amount` if the corresponding ERC20 contract has a fee
+    /// or deduction on transfer.
+    /// @dev If the FunToken mapping is scaled, one bank coin unit is worth
+    /// 10^scalingExponent ERC20 units. Only whole bank coin units of `amount`
+    /// are sent, and the remainder stays with the caller.
     function sendToBank(
         address erc20,
         uint256 amount,
         string calldata to
     ) external returns (uint256 sentAmount);
 
+    struct Recipient {
+        string to;
+        uint256 amount;
+    }
+
+    /// @notice sendToBankBatch is the same as `sendToBank` for multiple
+    /// recipients. The transfers execute atomically: if any of them fails,
+    /// the whole call reverts.
+    /// @param erc20 - the address of the ERC20 token contract
+    /// @param recipients - the receiving Nibiru account addresses (hex or
+    /// bech32) and the amounts of tokens to send to each
+    /// @return sentAmounts - amount of bank coins received by each recipient,
+    /// in the order of `recipients`
+    function sendToBankBatch(
+        address erc20,
+        Recipient[] calldata recipients
+    ) external returns (uint256[] memory sentAmounts);
+
+    /// @notice sendToBankFrom is the same as `sendToBank`, except that the
+    /// ERC20 tokens are moved from `owner` with `transferFrom` and consume
+    /// the ERC20 allowance `owner` gave to the caller. This lets routers and
+    /// vault contracts send user funds to bank without taking custody first.
+    /// @param owner - the account holding the ERC20 tokens
+    /// @param erc20 - the address of the ERC20 token contract
+    /// @param amount - the amount of tokens to send
+    /// @param to - the receiving Nibiru base account address as a string
+    /// @return sentAmount - amount of bank coins received by the recipient
+    function sendToBankFrom(
+        address owner,
+        address erc20,
+        uint256 amount,
+        string calldata to
+    ) external returns (uint256 sentAmount);
+
     /// @notice Retrieves the ERC20 contract address associated with a given bank denomination.
     /// @param bankDenom The bank denomination string (e.g., "unibi", "erc20/0x...", "ibc/...").
     /// @return erc20Address The corresponding ERC20 contract address, or address(0) if no mapping exists.
@@ -40,7 +77,8 @@ interface IFunToken is INibiruEvm {
     }
 
     /// @notice Method "balance" returns the ERC20 balance and Bank Coin balance
-    /// of some fungible token held by the given account.
+    /// of some fungible token held by the given account. Both balances are in
+    /// units of the ERC20, even if the FunToken mapping is scaled.
     function balance(
         address who,
         address funtoken
@@ -103,4 +141,44 @@ interface IFunToken is INibiruEvm {
         string calldata bankDenom,
         uint256 amount
     ) external returns (bool success);
+
+    /// @notice bankMsgSendBatch performs a
+    /// `cosmos.bank.v1beta1.MsgMultiSend` transaction message to transfer
+    /// Bank Coin funds from the caller to multiple recipients at once.
+    ///
+    /// @param bankDenom The bank coin denom to send.
+    /// @param recipients The recipient addresses (hex or bech32) and the
+    /// number of coins to send to each.
+    /// @return success True if the bank send succeeded, false otherwise.
+    function bankMsgSendBatch(
+        string calldata bankDenom,
+        Recipient[] calldata recipients
+    ) external returns (bool success);
+
+    /// @notice sendNftToBank sends an ERC-721 token with a "FunNFT" mapping
+    /// to a Nibiru account as a token of the NFT Module ("x/nft"). The
+    /// ERC-721 token is escrowed by the EVM module account.
+    /// @param erc721 - the address of the ERC-721 collection
+    /// @param tokenId - the ID of the ERC-721 token to send
+    /// @param to - the receiving Nibiru account address (hex or bech32)
+    /// @return nftId - ID of the "x/nft" token received by the recipient,
+    /// which is the decimal representation of `tokenId`.
+    function sendNftToBank(
+        address erc721,
+        uint256 tokenId,
+        string calldata to
+    ) external returns (string memory nftId);
+
+    /// @notice sendNftToEvm sends the caller's "x/nft" token of a "FunNFT"
+    /// class to its ERC-721 representation. The "x/nft" token is burned and
+    /// the ERC-721 token leaves the escrow of the EVM module account.
+    /// @param classId - the ID of the "x/nft" class
+    /// @param nftId - the ID of the "x/nft" token to send
+    /// @param to - the Ethereum hex or bech32 address receiving the ERC-721
+    /// @return tokenId - ID of the ERC-721 token received by the recipient
+    function sendNftToEvm(
+        string calldata classId,
+        string calldata nftId,
+        string calldata to
+    ) external returns (uint256 tokenId);
 }
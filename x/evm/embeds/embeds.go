@@ -25,6 +25,10 @@ var (
 	funtokenPrecompileJSON []byte
 	//go:embed artifacts/contracts/Wasm.sol/IWasm.json
 	wasmPrecompileJSON []byte
+	//go:embed artifacts/contracts/IEpochHooks.sol/IEpochHooks.json
+	epochHooksJSON []byte
+	//go:embed artifacts/contracts/IERC721.sol/IERC721.json
+	erc721JSON []byte
 	//go:embed artifacts/contracts/TestERC20.sol/TestERC20.json
 	testErc20Json []byte
 	//go:embed artifacts/contracts/TestERC20MaliciousName.sol/TestERC20MaliciousName.json
@@ -89,6 +93,19 @@ var (
 		Name:      "Oracle.sol",
 		EmbedJSON: oracleContractJSON,
 	}
+	// SmartContract_EpochHooks: Interface "IEpochHooks.sol" implemented by EVM
+	// contracts subscribed to the epoch hooks of the "x/epochs" module. Only the
+	// ABI is used.
+	SmartContract_EpochHooks = CompiledEvmContract{
+		Name:      "IEpochHooks.sol",
+		EmbedJSON: epochHooksJSON,
+	}
+	// SmartContract_ERC721: Interface "IERC721.sol" of the ERC-721 collections
+	// that can have a "FunNFT" mapping. Only the ABI is used.
+	SmartContract_ERC721 = CompiledEvmContract{
+		Name:      "IERC721.sol",
+		EmbedJSON: erc721JSON,
+	}
 	SmartContract_TestERC20 = CompiledEvmContract{
 		Name:      "TestERC20.sol",
 		EmbedJSON: testErc20Json,
@@ -180,10 +197,13 @@ var (
 )
 
 func init() {
+	SmartContract_ERC20Minter.MustLoad()
 	SmartContract_ERC20MinterWithMetadataUpdates.MustLoad()
 	SmartContract_FunToken.MustLoad()
 	SmartContract_Wasm.MustLoad()
 	SmartContract_Oracle.MustLoad()
+	SmartContract_EpochHooks.MustLoad()
+	SmartContract_ERC721.MustLoad()
 	SmartContract_TestERC20.MustLoad()
 	SmartContract_TestERC20MaliciousName.MustLoad()
 	SmartContract_TestERC20MaliciousTransfer.MustLoad()
@@ -207,6 +227,9 @@ type CompiledEvmContract struct {
 	// filled in post-load
 	ABI      *gethabi.ABI `json:"abi"`
 	Bytecode []byte       `json:"bytecode"`
+	// DeployedBytecode is the runtime bytecode stored in the state of the
+	// contract once the constructor runs.
+	DeployedBytecode []byte `json:"deployedBytecode"`
 }
 
 func (sc *CompiledEvmContract) MustLoad() {
@@ -232,5 +255,12 @@ func (sc *CompiledEvmContract) MustLoad() {
 		panic(err)
 	}
 	sc.Bytecode = gethcommon.FromHex(bytecodeStr)
+
+	var deployedBytecodeStr string
+	err = json.Unmarshal(rawJsonBz["deployedBytecode"], &deployedBytecodeStr)
+	if err != nil {
+		panic(err)
+	}
+	sc.DeployedBytecode = gethcommon.FromHex(deployedBytecodeStr)
 	sc.ABI = abi
 }
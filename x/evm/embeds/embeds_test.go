@@ -11,8 +11,11 @@ import (
 
 func TestLoadContracts(t *testing.T) {
 	require.NotPanics(t, func() {
+		embeds.SmartContract_ERC20Minter.MustLoad()
 		embeds.SmartContract_ERC20MinterWithMetadataUpdates.MustLoad()
 		embeds.SmartContract_FunToken.MustLoad()
+		embeds.SmartContract_EpochHooks.MustLoad()
+		embeds.SmartContract_ERC721.MustLoad()
 		embeds.SmartContract_TestERC20.MustLoad()
 		embeds.SmartContract_TestERC20MaliciousName.MustLoad()
 		embeds.SmartContract_TestERC20MaliciousTransfer.MustLoad()
@@ -354,6 +354,354 @@ func (m *EventConvertCoinToEvm) GetBankCoin() types.Coin {
 	return types.Coin{}
 }
 
+// EventConvertEvmToCoin defines sending erc20 to fun token event.
+type EventConvertEvmToCoin struct {
+	Sender               string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc20ContractAddress string     `protobuf:"bytes,2,opt,name=erc20_contract_address,json=erc20ContractAddress,proto3" json:"erc20_contract_address,omitempty"`
+	ToAddr               string     `protobuf:"bytes,3,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	BankCoin             types.Coin `protobuf:"bytes,4,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
+}
+
+func (m *EventConvertEvmToCoin) Reset()         { *m = EventConvertEvmToCoin{} }
+func (m *EventConvertEvmToCoin) String() string { return proto.CompactTextString(m) }
+func (*EventConvertEvmToCoin) ProtoMessage()    {}
+func (*EventConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{5}
+}
+func (m *EventConvertEvmToCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertEvmToCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertEvmToCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertEvmToCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertEvmToCoin.Merge(m, src)
+}
+func (m *EventConvertEvmToCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertEvmToCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertEvmToCoin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertEvmToCoin proto.InternalMessageInfo
+
+func (m *EventConvertEvmToCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetErc20ContractAddress() string {
+	if m != nil {
+		return m.Erc20ContractAddress
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToCoin) GetBankCoin() types.Coin {
+	if m != nil {
+		return m.BankCoin
+	}
+	return types.Coin{}
+}
+
+// EventFunTokenEscrowReconciled defines the event for the reconciliation of
+// the escrow of a `FunToken` created from an ERC20.
+type EventFunTokenEscrowReconciled struct {
+	Sender   string         `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	FunToken FunToken       `protobuf:"bytes,2,opt,name=fun_token,json=funToken,proto3" json:"fun_token"`
+	Escrow   FunTokenEscrow `protobuf:"bytes,3,opt,name=escrow,proto3" json:"escrow"`
+}
+
+func (m *EventFunTokenEscrowReconciled) Reset()         { *m = EventFunTokenEscrowReconciled{} }
+func (m *EventFunTokenEscrowReconciled) String() string { return proto.CompactTextString(m) }
+func (*EventFunTokenEscrowReconciled) ProtoMessage()    {}
+func (*EventFunTokenEscrowReconciled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{6}
+}
+func (m *EventFunTokenEscrowReconciled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFunTokenEscrowReconciled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFunTokenEscrowReconciled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFunTokenEscrowReconciled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFunTokenEscrowReconciled.Merge(m, src)
+}
+func (m *EventFunTokenEscrowReconciled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFunTokenEscrowReconciled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFunTokenEscrowReconciled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFunTokenEscrowReconciled proto.InternalMessageInfo
+
+func (m *EventFunTokenEscrowReconciled) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventFunTokenEscrowReconciled) GetFunToken() FunToken {
+	if m != nil {
+		return m.FunToken
+	}
+	return FunToken{}
+}
+
+func (m *EventFunTokenEscrowReconciled) GetEscrow() FunTokenEscrow {
+	if m != nil {
+		return m.Escrow
+	}
+	return FunTokenEscrow{}
+}
+
+// EventFunNFTCreated defines the event for the creation of a `FunNFT` mapping.
+type EventFunNFTCreated struct {
+	ClassId               string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Erc721ContractAddress string `protobuf:"bytes,2,opt,name=erc721_contract_address,json=erc721ContractAddress,proto3" json:"erc721_contract_address,omitempty"`
+	Creator               string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *EventFunNFTCreated) Reset()         { *m = EventFunNFTCreated{} }
+func (m *EventFunNFTCreated) String() string { return proto.CompactTextString(m) }
+func (*EventFunNFTCreated) ProtoMessage()    {}
+func (*EventFunNFTCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{7}
+}
+func (m *EventFunNFTCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFunNFTCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFunNFTCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFunNFTCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFunNFTCreated.Merge(m, src)
+}
+func (m *EventFunNFTCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFunNFTCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFunNFTCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFunNFTCreated proto.InternalMessageInfo
+
+func (m *EventFunNFTCreated) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventFunNFTCreated) GetErc721ContractAddress() string {
+	if m != nil {
+		return m.Erc721ContractAddress
+	}
+	return ""
+}
+
+func (m *EventFunNFTCreated) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// EventConvertEvmToNFT defines the event for sending an ERC-721 token to its
+// "x/nft" representation.
+type EventConvertEvmToNFT struct {
+	Sender                string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc721ContractAddress string `protobuf:"bytes,2,opt,name=erc721_contract_address,json=erc721ContractAddress,proto3" json:"erc721_contract_address,omitempty"`
+	ToAddr                string `protobuf:"bytes,3,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	ClassId               string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId                 string `protobuf:"bytes,5,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *EventConvertEvmToNFT) Reset()         { *m = EventConvertEvmToNFT{} }
+func (m *EventConvertEvmToNFT) String() string { return proto.CompactTextString(m) }
+func (*EventConvertEvmToNFT) ProtoMessage()    {}
+func (*EventConvertEvmToNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{8}
+}
+func (m *EventConvertEvmToNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertEvmToNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertEvmToNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertEvmToNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertEvmToNFT.Merge(m, src)
+}
+func (m *EventConvertEvmToNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertEvmToNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertEvmToNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertEvmToNFT proto.InternalMessageInfo
+
+func (m *EventConvertEvmToNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToNFT) GetErc721ContractAddress() string {
+	if m != nil {
+		return m.Erc721ContractAddress
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToNFT) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventConvertEvmToNFT) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+// EventConvertNFTToEvm defines the event for sending an "x/nft" token to its
+// ERC-721 representation.
+type EventConvertNFTToEvm struct {
+	Sender                string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Erc721ContractAddress string `protobuf:"bytes,2,opt,name=erc721_contract_address,json=erc721ContractAddress,proto3" json:"erc721_contract_address,omitempty"`
+	ToEthAddr             string `protobuf:"bytes,3,opt,name=to_eth_addr,json=toEthAddr,proto3" json:"to_eth_addr,omitempty"`
+	ClassId               string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId                 string `protobuf:"bytes,5,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *EventConvertNFTToEvm) Reset()         { *m = EventConvertNFTToEvm{} }
+func (m *EventConvertNFTToEvm) String() string { return proto.CompactTextString(m) }
+func (*EventConvertNFTToEvm) ProtoMessage()    {}
+func (*EventConvertNFTToEvm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8bc26b53c788f17, []int{9}
+}
+func (m *EventConvertNFTToEvm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConvertNFTToEvm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConvertNFTToEvm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConvertNFTToEvm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConvertNFTToEvm.Merge(m, src)
+}
+func (m *EventConvertNFTToEvm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConvertNFTToEvm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConvertNFTToEvm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConvertNFTToEvm proto.InternalMessageInfo
+
+func (m *EventConvertNFTToEvm) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventConvertNFTToEvm) GetErc721ContractAddress() string {
+	if m != nil {
+		return m.Erc721ContractAddress
+	}
+	return ""
+}
+
+func (m *EventConvertNFTToEvm) GetToEthAddr() string {
+	if m != nil {
+		return m.ToEthAddr
+	}
+	return ""
+}
+
+func (m *EventConvertNFTToEvm) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventConvertNFTToEvm) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
 // EventTransfer defines event for EVM transfer
 type EventTransfer struct {
 	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -365,7 +713,7 @@ func (m *EventTransfer) Reset()         { *m = EventTransfer{} }
 func (m *EventTransfer) String() string { return proto.CompactTextString(m) }
 func (*EventTransfer) ProtoMessage()    {}
 func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{5}
+	return fileDescriptor_f8bc26b53c788f17, []int{10}
 }
 func (m *EventTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +773,7 @@ func (m *EventContractDeployed) Reset()         { *m = EventContractDeployed{} }
 func (m *EventContractDeployed) String() string { return proto.CompactTextString(m) }
 func (*EventContractDeployed) ProtoMessage()    {}
 func (*EventContractDeployed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{6}
+	return fileDescriptor_f8bc26b53c788f17, []int{11}
 }
 func (m *EventContractDeployed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +826,7 @@ func (m *EventContractExecuted) Reset()         { *m = EventContractExecuted{} }
 func (m *EventContractExecuted) String() string { return proto.CompactTextString(m) }
 func (*EventContractExecuted) ProtoMessage()    {}
 func (*EventContractExecuted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8bc26b53c788f17, []int{7}
+	return fileDescriptor_f8bc26b53c788f17, []int{12}
 }
 func (m *EventContractExecuted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,6 +875,11 @@ func init() {
 	proto.RegisterType((*EventBlockBloom)(nil), "eth.evm.v1.EventBlockBloom")
 	proto.RegisterType((*EventFunTokenCreated)(nil), "eth.evm.v1.EventFunTokenCreated")
 	proto.RegisterType((*EventConvertCoinToEvm)(nil), "eth.evm.v1.EventConvertCoinToEvm")
+	proto.RegisterType((*EventConvertEvmToCoin)(nil), "eth.evm.v1.EventConvertEvmToCoin")
+	proto.RegisterType((*EventFunTokenEscrowReconciled)(nil), "eth.evm.v1.EventFunTokenEscrowReconciled")
+	proto.RegisterType((*EventFunNFTCreated)(nil), "eth.evm.v1.EventFunNFTCreated")
+	proto.RegisterType((*EventConvertEvmToNFT)(nil), "eth.evm.v1.EventConvertEvmToNFT")
+	proto.RegisterType((*EventConvertNFTToEvm)(nil), "eth.evm.v1.EventConvertNFTToEvm")
 	proto.RegisterType((*EventTransfer)(nil), "eth.evm.v1.EventTransfer")
 	proto.RegisterType((*EventContractDeployed)(nil), "eth.evm.v1.EventContractDeployed")
 	proto.RegisterType((*EventContractExecuted)(nil), "eth.evm.v1.EventContractExecuted")
@@ -535,47 +888,58 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/events.proto", fileDescriptor_f8bc26b53c788f17) }
 
 var fileDescriptor_f8bc26b53c788f17 = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0xdb, 0xf4, 0x4f, 0xb6, 0xbf, 0xfe, 0x0a, 0x56, 0x28, 0x6e, 0x05, 0x6e, 0x65, 0x24,
-	0x68, 0x2f, 0x36, 0x09, 0x48, 0x48, 0x9c, 0x20, 0x69, 0x2a, 0x0e, 0x05, 0xa1, 0x28, 0x5c, 0x90,
-	0x90, 0xb5, 0xb6, 0xa7, 0xb6, 0xd5, 0xec, 0x4e, 0xb5, 0xbb, 0xb6, 0xd2, 0xb7, 0xe0, 0x51, 0x78,
-	0x06, 0x4e, 0x3d, 0xf6, 0x06, 0xa7, 0x0a, 0xb5, 0x6f, 0xc0, 0x13, 0xa0, 0x5d, 0xbb, 0x4d, 0x03,
-	0xea, 0x05, 0x6e, 0x33, 0xdf, 0xfc, 0xd9, 0x99, 0x6f, 0x3e, 0x2d, 0xb9, 0x0f, 0x2a, 0x0b, 0xa0,
-	0x64, 0x41, 0xd9, 0x09, 0xa0, 0x04, 0xae, 0xa4, 0x7f, 0x2c, 0x50, 0xa1, 0x4d, 0x40, 0x65, 0x3e,
-	0x94, 0xcc, 0x2f, 0x3b, 0x9b, 0x6e, 0x8c, 0x92, 0xa1, 0x0c, 0x22, 0x2a, 0x21, 0x28, 0x3b, 0x11,
-	0x28, 0xda, 0x09, 0x62, 0xcc, 0x79, 0x95, 0xbb, 0xd9, 0x4e, 0x31, 0x45, 0x63, 0x06, 0xda, 0xba,
-	0x42, 0x67, 0x5a, 0xb3, 0x0a, 0xf5, 0xbe, 0x5a, 0x64, 0x6d, 0xa0, 0x1f, 0x1a, 0xa8, 0x0c, 0x04,
-	0x14, 0x6c, 0x34, 0xb1, 0xd7, 0xc9, 0x22, 0x65, 0x58, 0x70, 0xe5, 0x58, 0xdb, 0xd6, 0x4e, 0x6b,
-	0x58, 0x7b, 0xf6, 0x06, 0x59, 0x06, 0x95, 0x85, 0x19, 0x95, 0x99, 0x33, 0x67, 0x22, 0x4b, 0xa0,
-	0xb2, 0x37, 0x54, 0x66, 0x76, 0x9b, 0x2c, 0xe4, 0x3c, 0x81, 0x89, 0x33, 0x6f, 0xf0, 0xca, 0xd1,
-	0x05, 0x29, 0x95, 0x61, 0x21, 0x21, 0x71, 0x9a, 0x55, 0x41, 0x4a, 0xe5, 0x07, 0x09, 0x89, 0x6d,
-	0x93, 0xa6, 0xe9, 0xb3, 0x60, 0x60, 0x63, 0xdb, 0x0f, 0x48, 0x4b, 0x40, 0x9c, 0x1f, 0xe7, 0xc0,
-	0x95, 0xb3, 0x68, 0x02, 0x53, 0x40, 0x37, 0x2b, 0x59, 0x08, 0x42, 0xa0, 0x70, 0x96, 0xaa, 0x66,
-	0x25, 0x1b, 0x68, 0xd7, 0x7b, 0x41, 0x88, 0xd9, 0x61, 0x34, 0x39, 0xc0, 0xd4, 0xde, 0x25, 0xcd,
-	0x31, 0xa6, 0xd2, 0xb1, 0xb6, 0xe7, 0x77, 0x56, 0xba, 0x6b, 0xfe, 0x94, 0x39, 0xff, 0x00, 0xd3,
-	0x5e, 0xf3, 0xf4, 0x7c, 0xab, 0x31, 0x34, 0x29, 0xde, 0x93, 0x7a, 0xf9, 0xde, 0x18, 0xe3, 0xa3,
-	0xde, 0x18, 0x91, 0xe9, 0x4d, 0x22, 0x6d, 0xd4, 0xbb, 0x57, 0x8e, 0xf7, 0xc5, 0x22, 0x6d, 0x93,
-	0xb9, 0x5f, 0xf0, 0x11, 0x1e, 0x01, 0xef, 0x0b, 0xa0, 0x0a, 0x12, 0xfb, 0x21, 0x21, 0x11, 0xe5,
-	0x47, 0x61, 0x02, 0xfc, 0xba, 0xa6, 0xa5, 0x91, 0x3d, 0x0d, 0xd8, 0xcf, 0xc9, 0x3a, 0x88, 0xb8,
-	0xfb, 0x34, 0x8c, 0x91, 0x2b, 0x41, 0x63, 0x15, 0xd2, 0x24, 0x11, 0x20, 0x65, 0x4d, 0x60, 0xdb,
-	0x44, 0xfb, 0x75, 0xf0, 0x75, 0x15, 0xb3, 0x1d, 0xb2, 0x14, 0xeb, 0xfe, 0x28, 0x6a, 0x3e, 0xaf,
-	0x5c, 0x7b, 0x97, 0xdc, 0xcd, 0x65, 0xc8, 0x68, 0x02, 0xe1, 0xa1, 0x40, 0x16, 0xea, 0xab, 0x1b,
-	0x6a, 0x97, 0x87, 0xff, 0xe7, 0xf2, 0x2d, 0x4d, 0x60, 0x5f, 0x20, 0xeb, 0x63, 0xce, 0xbd, 0x6f,
-	0x16, 0xb9, 0x67, 0x46, 0xee, 0x23, 0x2f, 0x41, 0x28, 0x0d, 0x8e, 0x70, 0x50, 0x32, 0x7d, 0x5f,
-	0x09, 0x3c, 0x01, 0x71, 0x75, 0xdf, 0xca, 0xfb, 0xcb, 0x61, 0x5d, 0xb2, 0xa2, 0x30, 0xd4, 0xc2,
-	0xd0, 0xd9, 0xf5, 0xc0, 0x2d, 0x85, 0x03, 0x95, 0xe9, 0x14, 0xfb, 0x3d, 0x31, 0x7c, 0x4c, 0x47,
-	0x5d, 0xe9, 0x6e, 0xf8, 0x95, 0x82, 0x7d, 0xad, 0x60, 0xbf, 0x56, 0xb0, 0xaf, 0x07, 0xec, 0x39,
-	0xfa, 0x3a, 0x3f, 0xcf, 0xb7, 0xee, 0x9c, 0x50, 0x36, 0x7e, 0xe9, 0x5d, 0x57, 0x7a, 0xc3, 0x65,
-	0x6d, 0x9b, 0xcd, 0x3e, 0x91, 0xd5, 0xea, 0xdc, 0x82, 0x72, 0x79, 0x08, 0xe2, 0xd6, 0x85, 0x66,
-	0x04, 0x35, 0xf7, 0xbb, 0xa0, 0xa6, 0x32, 0x9f, 0xbf, 0x29, 0x73, 0x6f, 0x34, 0xe5, 0xcd, 0x2c,
-	0xba, 0x07, 0xc7, 0x63, 0x3c, 0x81, 0xe4, 0xd6, 0x67, 0x1e, 0x91, 0xd5, 0x19, 0xc6, 0xea, 0xa7,
-	0xfe, 0x8b, 0x6f, 0x30, 0xf5, 0x47, 0xd7, 0xc1, 0x04, 0xe2, 0x42, 0xfd, 0x63, 0xd7, 0xde, 0xab,
-	0xd3, 0x0b, 0xd7, 0x3a, 0xbb, 0x70, 0xad, 0x1f, 0x17, 0xae, 0xf5, 0xf9, 0xd2, 0x6d, 0x9c, 0x5d,
-	0xba, 0x8d, 0xef, 0x97, 0x6e, 0xe3, 0xe3, 0xe3, 0x34, 0x57, 0x59, 0x11, 0xf9, 0x31, 0xb2, 0xe0,
-	0x5d, 0x1e, 0xe5, 0xa2, 0xe8, 0x67, 0x34, 0xe7, 0x01, 0x37, 0x76, 0x50, 0x76, 0x83, 0x89, 0xfe,
-	0x06, 0xa2, 0x45, 0xf3, 0x0f, 0x3c, 0xfb, 0x15, 0x00, 0x00, 0xff, 0xff, 0x52, 0xaf, 0x8b, 0xfe,
-	0x7a, 0x04, 0x00, 0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x36, 0x8e, 0xff, 0x8c, 0x29, 0x85, 0x95, 0xd3, 0x6c, 0x22, 0xea, 0x56, 0x8b, 0x04,
+	0xed, 0x65, 0x17, 0x1b, 0x44, 0x10, 0x27, 0xb0, 0x6b, 0x8b, 0x4a, 0x25, 0x42, 0xd6, 0x72, 0x41,
+	0x42, 0xab, 0xf1, 0xec, 0xb3, 0x77, 0x15, 0xcf, 0xbc, 0x68, 0x66, 0xbc, 0xb8, 0x47, 0xbe, 0x01,
+	0x9f, 0x83, 0x13, 0xdc, 0x38, 0x73, 0xea, 0x31, 0x37, 0x38, 0x55, 0x28, 0xf9, 0x06, 0x7c, 0x02,
+	0x34, 0xb3, 0xeb, 0xda, 0x0e, 0x38, 0x40, 0xa0, 0xb7, 0xf7, 0x7f, 0x7e, 0xbf, 0x37, 0xef, 0xcd,
+	0x90, 0x03, 0xd0, 0x69, 0x08, 0x39, 0x0f, 0xf3, 0x4e, 0x08, 0x39, 0x08, 0xad, 0x82, 0x33, 0x89,
+	0x1a, 0x5d, 0x02, 0x3a, 0x0d, 0x20, 0xe7, 0x41, 0xde, 0x39, 0x6a, 0x33, 0x54, 0x1c, 0x55, 0x38,
+	0xa6, 0x0a, 0xc2, 0xbc, 0x33, 0x06, 0x4d, 0x3b, 0x21, 0xc3, 0x4c, 0x14, 0xb1, 0x47, 0xad, 0x29,
+	0x4e, 0xd1, 0x8a, 0xa1, 0x91, 0x96, 0xd6, 0x8d, 0xd2, 0xbc, 0xb0, 0xfa, 0x3f, 0x3b, 0xe4, 0xce,
+	0xc0, 0x1c, 0x34, 0xd0, 0x29, 0x48, 0x98, 0xf3, 0x68, 0xe1, 0xde, 0x25, 0x55, 0xca, 0x71, 0x2e,
+	0xb4, 0xe7, 0x3c, 0x70, 0x1e, 0x36, 0x46, 0xa5, 0xe6, 0x1e, 0x92, 0x3a, 0xe8, 0x34, 0x4e, 0xa9,
+	0x4a, 0xbd, 0x5b, 0xd6, 0x53, 0x03, 0x9d, 0x7e, 0x46, 0x55, 0xea, 0xb6, 0xc8, 0x5e, 0x26, 0x12,
+	0x58, 0x78, 0xbb, 0xd6, 0x5e, 0x28, 0x26, 0x61, 0x4a, 0x55, 0x3c, 0x57, 0x90, 0x78, 0x95, 0x22,
+	0x61, 0x4a, 0xd5, 0x97, 0x0a, 0x12, 0xd7, 0x25, 0x15, 0x5b, 0x67, 0xcf, 0x9a, 0xad, 0xec, 0xbe,
+	0x45, 0x1a, 0x12, 0x58, 0x76, 0x96, 0x81, 0xd0, 0x5e, 0xd5, 0x3a, 0x56, 0x06, 0x53, 0x2c, 0xe7,
+	0x31, 0x48, 0x89, 0xd2, 0xab, 0x15, 0xc5, 0x72, 0x3e, 0x30, 0xaa, 0x7f, 0x4c, 0x88, 0xe5, 0x10,
+	0x2d, 0x9e, 0xe2, 0xd4, 0x7d, 0x44, 0x2a, 0x33, 0x9c, 0x2a, 0xcf, 0x79, 0xb0, 0xfb, 0xb0, 0xd9,
+	0xbd, 0x13, 0xac, 0x3a, 0x17, 0x3c, 0xc5, 0x69, 0xaf, 0xf2, 0xfc, 0xc5, 0xfd, 0x9d, 0x91, 0x0d,
+	0xf1, 0xdf, 0x2d, 0xc9, 0xf7, 0x66, 0xc8, 0x4e, 0x7b, 0x33, 0x44, 0x6e, 0x98, 0x8c, 0x8d, 0x50,
+	0x72, 0x2f, 0x14, 0xff, 0x07, 0x87, 0xb4, 0x6c, 0xe4, 0x70, 0x2e, 0x22, 0x3c, 0x05, 0xd1, 0x97,
+	0x40, 0x35, 0x24, 0xee, 0x3d, 0x42, 0xc6, 0x54, 0x9c, 0xc6, 0x09, 0x88, 0x97, 0x39, 0x0d, 0x63,
+	0x79, 0x6c, 0x0c, 0xee, 0x07, 0xe4, 0x2e, 0x48, 0xd6, 0x7d, 0x2f, 0x66, 0x28, 0xb4, 0xa4, 0x4c,
+	0xc7, 0x34, 0x49, 0x24, 0x28, 0x55, 0x36, 0xb0, 0x65, 0xbd, 0xfd, 0xd2, 0xf9, 0x69, 0xe1, 0x73,
+	0x3d, 0x52, 0x63, 0xa6, 0x3e, 0xca, 0xb2, 0x9f, 0x4b, 0xd5, 0x7d, 0x44, 0xde, 0xcc, 0x54, 0xcc,
+	0x69, 0x02, 0xf1, 0x44, 0x22, 0x8f, 0xcd, 0xad, 0xdb, 0xd6, 0xd6, 0x47, 0xaf, 0x67, 0xea, 0x73,
+	0x9a, 0xc0, 0x50, 0x22, 0xef, 0x63, 0x26, 0xfc, 0x5f, 0x1c, 0xb2, 0x6f, 0x21, 0xf7, 0x51, 0xe4,
+	0x20, 0xb5, 0x31, 0x46, 0x38, 0xc8, 0xb9, 0xb9, 0x5f, 0x05, 0x22, 0x01, 0xb9, 0xbc, 0xdf, 0x42,
+	0xbb, 0x21, 0xd8, 0x36, 0x69, 0x6a, 0x8c, 0xcd, 0x60, 0x98, 0xe8, 0x12, 0x70, 0x43, 0xe3, 0x40,
+	0xa7, 0x26, 0xc4, 0xfd, 0x82, 0xd8, 0x7e, 0xac, 0xa0, 0x36, 0xbb, 0x87, 0x41, 0x31, 0xc1, 0x81,
+	0x99, 0xe0, 0xa0, 0x9c, 0xe0, 0xc0, 0x00, 0xec, 0x79, 0xe6, 0x76, 0x7e, 0x7f, 0x71, 0xff, 0x8d,
+	0x67, 0x94, 0xcf, 0x3e, 0xf6, 0x5f, 0x66, 0xfa, 0xa3, 0xba, 0x91, 0x2d, 0xb3, 0xf3, 0x2b, 0xcc,
+	0x06, 0x39, 0x8f, 0xd0, 0x78, 0xfe, 0x67, 0x66, 0x07, 0xa4, 0xa6, 0x71, 0x9d, 0x55, 0x55, 0xe3,
+	0x2b, 0xa2, 0xf4, 0xbd, 0x43, 0xee, 0x6d, 0xcc, 0xd7, 0x40, 0x31, 0x89, 0xdf, 0x8c, 0x80, 0xa1,
+	0x60, 0xd9, 0x0c, 0x92, 0xad, 0xd4, 0x8e, 0x49, 0x63, 0x32, 0x17, 0xb1, 0x36, 0x49, 0x96, 0x4d,
+	0xb3, 0xdb, 0x5a, 0x1f, 0xf9, 0x65, 0xc1, 0x72, 0xee, 0xeb, 0x93, 0x52, 0x77, 0x3f, 0x22, 0x55,
+	0xb0, 0x87, 0x58, 0x72, 0xcd, 0xee, 0xd1, 0x5f, 0x65, 0x15, 0x30, 0xca, 0xdc, 0x32, 0xde, 0xff,
+	0xd6, 0x21, 0xee, 0x12, 0xec, 0xc9, 0x30, 0x5a, 0xae, 0xc2, 0x21, 0xa9, 0xb3, 0x19, 0x55, 0x2a,
+	0xce, 0x92, 0x12, 0x63, 0xcd, 0xea, 0x4f, 0x12, 0xf7, 0x43, 0x72, 0x00, 0x92, 0x1d, 0x77, 0x3b,
+	0xdb, 0x2e, 0x60, 0xbf, 0x70, 0xff, 0xe3, 0x45, 0xf0, 0x7f, 0x5c, 0x2e, 0xe4, 0xfa, 0x0c, 0x9c,
+	0x0c, 0xa3, 0xad, 0x7d, 0xba, 0x29, 0x84, 0xad, 0x43, 0xb0, 0x4e, 0xb7, 0xb2, 0x49, 0x77, 0x9f,
+	0x54, 0xc5, 0x44, 0x1b, 0x47, 0xf1, 0xbc, 0xed, 0x89, 0x89, 0x7e, 0x92, 0xf8, 0x3f, 0x5d, 0xc1,
+	0x7c, 0x32, 0x8c, 0xae, 0x5f, 0xc8, 0x9b, 0x62, 0xfe, 0xbb, 0x95, 0xfc, 0xf7, 0xd0, 0xbf, 0x26,
+	0xb7, 0x8b, 0x17, 0x56, 0x52, 0xa1, 0x26, 0x20, 0xb7, 0x42, 0xde, 0x78, 0xc3, 0x6f, 0x5d, 0x7d,
+	0xc3, 0x57, 0x3f, 0xcb, 0xee, 0xfa, 0xcf, 0xe2, 0x47, 0xab, 0x85, 0xb6, 0x44, 0x1e, 0xc3, 0xd9,
+	0x0c, 0x9f, 0x5d, 0x33, 0xf5, 0x6f, 0x93, 0xdb, 0x1b, 0x2d, 0x29, 0x8f, 0x7a, 0x8d, 0xad, 0x75,
+	0xe2, 0x4f, 0x55, 0x07, 0x0b, 0x60, 0x73, 0xfd, 0x1f, 0xab, 0xf6, 0x3e, 0x79, 0x7e, 0xd1, 0x76,
+	0xce, 0x2f, 0xda, 0xce, 0x6f, 0x17, 0x6d, 0xe7, 0xbb, 0xcb, 0xf6, 0xce, 0xf9, 0x65, 0x7b, 0xe7,
+	0xd7, 0xcb, 0xf6, 0xce, 0x57, 0xef, 0x4c, 0x33, 0x9d, 0xce, 0xc7, 0x01, 0x43, 0x1e, 0x9e, 0x64,
+	0xe3, 0x4c, 0xce, 0xfb, 0x29, 0xcd, 0x44, 0x28, 0xac, 0x1c, 0xe6, 0xdd, 0x70, 0x61, 0x7e, 0xde,
+	0x71, 0xd5, 0x7e, 0xbd, 0xef, 0xff, 0x31, 0x00, 0xf7, 0x0c, 0xd8, 0xa1, 0xed, 0x07, 0x00, 0x00,
 }
 
 func (m *EventEthereumTx) Marshal() (dAtA []byte, err error) {
@@ -825,7 +1189,7 @@ func (m *EventConvertCoinToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
+func (m *EventConvertEvmToCoin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -835,27 +1199,37 @@ func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventConvertEvmToCoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToAddr) > 0 {
+		i -= len(m.ToAddr)
+		copy(dAtA[i:], m.ToAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToAddr)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+	if len(m.Erc20ContractAddress) > 0 {
+		i -= len(m.Erc20ContractAddress)
+		copy(dAtA[i:], m.Erc20ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20ContractAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -869,7 +1243,7 @@ func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContractDeployed) Marshal() (dAtA []byte, err error) {
+func (m *EventFunTokenEscrowReconciled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -879,24 +1253,278 @@ func (m *EventContractDeployed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContractDeployed) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFunTokenEscrowReconciled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContractDeployed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFunTokenEscrowReconciled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractAddr) > 0 {
-		i -= len(m.ContractAddr)
-		copy(dAtA[i:], m.ContractAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFunNFTCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFunNFTCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFunNFTCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc721ContractAddress) > 0 {
+		i -= len(m.Erc721ContractAddress)
+		copy(dAtA[i:], m.Erc721ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc721ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConvertEvmToNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConvertEvmToNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConvertEvmToNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ToAddr) > 0 {
+		i -= len(m.ToAddr)
+		copy(dAtA[i:], m.ToAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc721ContractAddress) > 0 {
+		i -= len(m.Erc721ContractAddress)
+		copy(dAtA[i:], m.Erc721ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc721ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventConvertNFTToEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConvertNFTToEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConvertNFTToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ToEthAddr) > 0 {
+		i -= len(m.ToEthAddr)
+		copy(dAtA[i:], m.ToEthAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToEthAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc721ContractAddress) > 0 {
+		i -= len(m.Erc721ContractAddress)
+		copy(dAtA[i:], m.Erc721ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc721ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractDeployed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractDeployed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractDeployed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
@@ -1066,7 +1694,7 @@ func (m *EventConvertCoinToEvm) Size() (n int) {
 	return n
 }
 
-func (m *EventTransfer) Size() (n int) {
+func (m *EventConvertEvmToCoin) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1076,18 +1704,20 @@ func (m *EventTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.Recipient)
+	l = len(m.Erc20ContractAddress)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.Amount)
+	l = len(m.ToAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
-func (m *EventContractDeployed) Size() (n int) {
+func (m *EventFunTokenEscrowReconciled) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1097,14 +1727,35 @@ func (m *EventContractDeployed) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.ContractAddr)
+	l = m.FunToken.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Escrow.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventFunNFTCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc721ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventContractExecuted) Size() (n int) {
+func (m *EventConvertEvmToNFT) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1114,20 +1765,116 @@ func (m *EventContractExecuted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.ContractAddr)
+	l = len(m.Erc721ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NftId)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
+func (m *EventConvertNFTToEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc721ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToEthAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractDeployed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventContractExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1279,14 +2026,850 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasUsed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTxLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockBloom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlockBloom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlockBloom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bloom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFunTokenCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFunTokenCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMadeFromCoin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMadeFromCoin = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConvertCoinToEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConvertCoinToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToEthAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConvertEvmToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConvertEvmToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFunTokenEscrowReconciled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasUsed = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFunTokenEscrowReconciled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFunTokenEscrowReconciled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1314,13 +2897,13 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1330,29 +2913,30 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1362,23 +2946,24 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1401,7 +2986,7 @@ func (m *EventEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTxLog) Unmarshal(dAtA []byte) error {
+func (m *EventFunNFTCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1424,17 +3009,17 @@ func (m *EventTxLog) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTxLog: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFunNFTCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTxLog: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFunNFTCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1444,79 +3029,59 @@ func (m *EventTxLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc721ContractAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBlockBloom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventBlockBloom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBlockBloom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Erc721ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1544,7 +3109,7 @@ func (m *EventBlockBloom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bloom = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1567,7 +3132,7 @@ func (m *EventBlockBloom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
+func (m *EventConvertEvmToNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1590,15 +3155,15 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFunTokenCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConvertEvmToNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFunTokenCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConvertEvmToNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1626,11 +3191,11 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc721ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1658,11 +3223,11 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Erc721ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1690,13 +3255,13 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ToAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsMadeFromCoin", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1706,12 +3271,56 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsMadeFromCoin = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1733,7 +3342,7 @@ func (m *EventFunTokenCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
+func (m *EventConvertNFTToEvm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1756,10 +3365,10 @@ func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventConvertCoinToEvm: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConvertNFTToEvm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConvertCoinToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConvertNFTToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1796,7 +3405,7 @@ func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc721ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1824,7 +3433,7 @@ func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Erc721ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1860,9 +3469,9 @@ func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1872,24 +3481,55 @@ func (m *EventConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
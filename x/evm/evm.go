@@ -3,7 +3,9 @@ package evm
 
 import (
 	"fmt"
+	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -30,10 +32,90 @@ func (fun FunToken) Validate() error {
 	if err := sdk.ValidateDenom(fun.BankDenom); err != nil {
 		return funTokenValidationError(err)
 	}
+	if fun.ScalingExponent > MaxFunTokenScalingExponent {
+		return funTokenValidationError(fmt.Errorf(
+			"scaling exponent %d exceeds the maximum of %d", fun.ScalingExponent, MaxFunTokenScalingExponent,
+		))
+	}
+	if fun.IsMadeFromCoin && fun.ScalingExponent != 0 {
+		return funTokenValidationError(fmt.Errorf(
+			"a FunToken created from a coin can't have a scaling exponent",
+		))
+	}
+	if fun.IsMadeFromCoin && fun.IsRebasing {
+		return funTokenValidationError(fmt.Errorf(
+			"a FunToken created from a coin can't be rebasing",
+		))
+	}
 
 	return nil
 }
 
+// MaxFunTokenScalingExponent is the maximum [FunToken.ScalingExponent].
+const MaxFunTokenScalingExponent = 18
+
+// ScalingFactor returns the number of ERC20 base units worth one base unit of
+// the Bank Coin, 10^[FunToken.ScalingExponent].
+func (fun FunToken) ScalingFactor() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fun.ScalingExponent)), nil)
+}
+
+// ERC20ToBankAmount converts an amount of ERC20 tokens to the Bank Coin
+// amount it's worth. The "dust" is the remainder of ERC20 tokens that is
+// smaller than one base unit of the Bank Coin.
+func (fun FunToken) ERC20ToBankAmount(erc20Amount *big.Int) (bankAmount, dust *big.Int) {
+	return new(big.Int).QuoRem(erc20Amount, fun.ScalingFactor(), new(big.Int))
+}
+
+// BankToERC20Amount converts an amount of the Bank Coin to the amount of
+// ERC20 tokens it's worth.
+func (fun FunToken) BankToERC20Amount(bankAmount *big.Int) *big.Int {
+	return new(big.Int).Mul(bankAmount, fun.ScalingFactor())
+}
+
+// ERC20ToBankShares converts an amount of ERC20 tokens added to an escrow of
+// "escrow" tokens backing "bankSupply" Bank Coins to the Bank Coin amount it's
+// worth pro rata, rounding down. Without escrow or supply to pro-rate against,
+// the amount converts at the [FunToken.ScalingFactor].
+func (fun FunToken) ERC20ToBankShares(erc20Amount, escrow, bankSupply *big.Int) *big.Int {
+	if escrow.Sign() != 1 || bankSupply.Sign() != 1 {
+		bankAmount, _ := fun.ERC20ToBankAmount(erc20Amount)
+		return bankAmount
+	}
+	shares := new(big.Int).Mul(erc20Amount, bankSupply)
+	return shares.Quo(shares, escrow)
+}
+
+// BankSharesToERC20 converts an amount of the Bank Coin, out of a supply of
+// "bankSupply" backed by an escrow of "escrow" ERC20 tokens, to the amount of
+// ERC20 tokens it's worth pro rata, rounding down. Without supply to pro-rate
+// against, the amount converts at the [FunToken.ScalingFactor].
+func (fun FunToken) BankSharesToERC20(bankAmount, escrow, bankSupply *big.Int) *big.Int {
+	if bankSupply.Sign() != 1 {
+		return fun.BankToERC20Amount(bankAmount)
+	}
+	erc20Amount := new(big.Int).Mul(bankAmount, escrow)
+	return erc20Amount.Quo(erc20Amount, bankSupply)
+}
+
+// NewFunTokenEscrow compares the ERC20 tokens escrowed for a [FunToken]
+// created from an ERC20 with the supply of its Bank Coin.
+func NewFunTokenEscrow(fun FunToken, escrow, bankSupply *big.Int) FunTokenEscrow {
+	expected := fun.BankToERC20Amount(bankSupply)
+	drift := new(big.Int).Sub(escrow, expected)
+	invariantHolds := drift.Sign() >= 0
+	if fun.IsRebasing {
+		invariantHolds = bankSupply.Sign() == 0 || escrow.Sign() == 1
+	}
+	return FunTokenEscrow{
+		EscrowBalance:  sdkmath.NewIntFromBigInt(escrow),
+		BankSupply:     sdkmath.NewIntFromBigInt(bankSupply),
+		ExpectedEscrow: sdkmath.NewIntFromBigInt(expected),
+		Drift:          sdkmath.NewIntFromBigInt(drift),
+		InvariantHolds: invariantHolds,
+	}
+}
+
 // NewFunToken is a canonical constructor for the [FunToken] type. Using this
 // function helps guarantee a consistent string representation from the
 // hex-encoded Ethereum address.
@@ -48,3 +130,51 @@ func NewFunToken(
 		IsMadeFromCoin: isMadeFromCoin,
 	}
 }
+
+// ID returns the primary key of the [FunNFT] mapping.
+func (fun FunNFT) ID() []byte {
+	return NewFunNFTID(fun.Erc721Addr.Address, fun.ClassId)
+}
+
+func NewFunNFTID(erc721 gethcommon.Address, classID string) []byte {
+	return tmhash.Sum([]byte(erc721.String() + "|" + classID))
+}
+
+func (fun FunNFT) Validate() error {
+	if fun.Erc721Addr.Address == (gethcommon.Address{}) {
+		return fmt.Errorf("FunNFTError: empty ERC-721 address")
+	}
+	if fun.ClassId != FunNFTClassID(fun.Erc721Addr.Address) {
+		return fmt.Errorf(
+			"FunNFTError: class ID \"%s\" of ERC-721 \"%s\" must be \"%s\"",
+			fun.ClassId, fun.Erc721Addr, FunNFTClassID(fun.Erc721Addr.Address),
+		)
+	}
+	return nil
+}
+
+// FunNFTClassID returns the "x/nft" class ID of the [FunNFT] mapping created
+// from the given ERC-721 collection.
+func FunNFTClassID(erc721 gethcommon.Address) string {
+	return fmt.Sprintf("erc721/%s", erc721.String())
+}
+
+// NewFunNFT is a canonical constructor for the [FunNFT] type.
+func NewFunNFT(erc721 gethcommon.Address) FunNFT {
+	return FunNFT{
+		Erc721Addr: eth.EIP55Addr{
+			Address: erc721,
+		},
+		ClassId: FunNFTClassID(erc721),
+	}
+}
+
+// FunNFTTokenID parses the ID of an "x/nft" token of a [FunNFT] class as the
+// ID of the corresponding ERC-721 token.
+func FunNFTTokenID(nftID string) (*big.Int, error) {
+	tokenID, ok := new(big.Int).SetString(nftID, 10)
+	if !ok || tokenID.Sign() < 0 || tokenID.BitLen() > 256 || tokenID.String() != nftID {
+		return nil, fmt.Errorf("invalid NFT ID \"%s\": must be the decimal ID of an ERC-721 token", nftID)
+	}
+	return tokenID, nil
+}
@@ -38,6 +38,19 @@ type FunToken struct {
 	// the ERC-20 contract gets deployed by the module account. False if the
 	// mapping was created from an externally owned ERC-20 contract.
 	IsMadeFromCoin bool `protobuf:"varint,3,opt,name=is_made_from_coin,json=isMadeFromCoin,proto3" json:"is_made_from_coin,omitempty"`
+	// scaling_exponent: Number of ERC-20 decimals dropped in the Bank Coin
+	// representation. One base unit of the Bank Coin is worth
+	// 10^scaling_exponent base units of the ERC-20, so that, for example, an
+	// ERC-20 with 18 decimals can map to a Bank Coin with 6 decimals. Zero
+	// converts amounts 1:1. Only mappings created from an ERC-20 can be scaled,
+	// and the scaling can't change after the mapping is created.
+	ScalingExponent uint32 `protobuf:"varint,4,opt,name=scaling_exponent,json=scalingExponent,proto3" json:"scaling_exponent,omitempty"`
+	// is_rebasing: True if the ERC-20 balance escrowed by the EVM module changes
+	// on its own, as with rebasing tokens. The Bank Coins of a rebasing
+	// `FunToken` are shares of the escrow, so conversions are pro-rated by the
+	// ratio of the escrowed balance to the Bank Coin supply instead of using a
+	// fixed rate. Set by `MsgReconcileFunTokenEscrow`.
+	IsRebasing bool `protobuf:"varint,5,opt,name=is_rebasing,json=isRebasing,proto3" json:"is_rebasing,omitempty"`
 }
 
 func (m *FunToken) Reset()         { *m = FunToken{} }
@@ -87,6 +100,138 @@ func (m *FunToken) GetIsMadeFromCoin() bool {
 	return false
 }
 
+func (m *FunToken) GetScalingExponent() uint32 {
+	if m != nil {
+		return m.ScalingExponent
+	}
+	return 0
+}
+
+func (m *FunToken) GetIsRebasing() bool {
+	if m != nil {
+		return m.IsRebasing
+	}
+	return false
+}
+
+// FunNFT is a non-fungible token mapping between an ERC-721 collection and a
+// corresponding class of the Cosmos SDK NFT Module ("x/nft"). An ERC-721
+// token converted to its "x/nft" representation is escrowed by the EVM module
+// account, and the "x/nft" token of the same ID is minted in its place.
+//
+// Mappings are only created from existing ERC-721 collections. Classes created
+// in "x/nft" have no ERC-721 representation: unlike a `FunToken` created from
+// a bank coin, there is no ERC-721 contract owned by the EVM module to mint
+// them from.
+type FunNFT struct {
+	// Hexadecimal address of the ERC-721 collection to which the `FunNFT` maps
+	Erc721Addr github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,1,opt,name=erc721_addr,json=erc721Addr,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"erc721_addr"`
+	// class_id: ID of the class in the NFT Module. The ID of each "x/nft" token
+	// in the class is the decimal token ID of the ERC-721 token.
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *FunNFT) Reset()         { *m = FunNFT{} }
+func (m *FunNFT) String() string { return proto.CompactTextString(m) }
+func (*FunNFT) ProtoMessage()    {}
+func (*FunNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{1}
+}
+func (m *FunNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunNFT.Merge(m, src)
+}
+func (m *FunNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunNFT proto.InternalMessageInfo
+
+func (m *FunNFT) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+// FunTokenEscrow: Supply invariant of a `FunToken` created from an ERC20. The
+// ERC20 tokens escrowed by the EVM module back the supply of the Bank Coin.
+type FunTokenEscrow struct {
+	// escrow_balance: ERC20 balance of the EVM module account
+	EscrowBalance cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=escrow_balance,json=escrowBalance,proto3,customtype=cosmossdk.io/math.Int" json:"escrow_balance"`
+	// bank_supply: Supply of the Bank Coin
+	BankSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=bank_supply,json=bankSupply,proto3,customtype=cosmossdk.io/math.Int" json:"bank_supply"`
+	// expected_escrow: ERC20 tokens needed to convert the whole Bank Coin supply
+	// at the scaling factor of the mapping
+	ExpectedEscrow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=expected_escrow,json=expectedEscrow,proto3,customtype=cosmossdk.io/math.Int" json:"expected_escrow"`
+	// drift: Escrow balance minus the expected escrow. Positive drift comes from
+	// dust and fees left in escrow or from positive rebases. Negative drift
+	// means the escrow shrank on its own.
+	Drift cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=drift,proto3,customtype=cosmossdk.io/math.Int" json:"drift"`
+	// invariant_holds: False if conversions at a fixed rate could no longer be
+	// honored, meaning the escrow is short of the expected escrow for a mapping
+	// that isn't flagged as rebasing. Rebasing mappings are pro-rated and hold
+	// as long as any escrow backs a nonzero supply.
+	InvariantHolds bool `protobuf:"varint,5,opt,name=invariant_holds,json=invariantHolds,proto3" json:"invariant_holds,omitempty"`
+}
+
+func (m *FunTokenEscrow) Reset()         { *m = FunTokenEscrow{} }
+func (m *FunTokenEscrow) String() string { return proto.CompactTextString(m) }
+func (*FunTokenEscrow) ProtoMessage()    {}
+func (*FunTokenEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{2}
+}
+func (m *FunTokenEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunTokenEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunTokenEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunTokenEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunTokenEscrow.Merge(m, src)
+}
+func (m *FunTokenEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunTokenEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunTokenEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunTokenEscrow proto.InternalMessageInfo
+
+func (m *FunTokenEscrow) GetInvariantHolds() bool {
+	if m != nil {
+		return m.InvariantHolds
+	}
+	return false
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// extra_eips defines the additional EIPs for the vm.Config
@@ -102,7 +247,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{1}
+	return fileDescriptor_98abbdadb327b7d0, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +302,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{2}
+	return fileDescriptor_98abbdadb327b7d0, []int{4}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,7 +378,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{3}
+	return fileDescriptor_98abbdadb327b7d0, []int{5}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +482,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{4}
+	return fileDescriptor_98abbdadb327b7d0, []int{6}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,17 +511,20 @@ func (m *AccessTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
 
-// TracerConfig stores additional tracer args. For geth it's only one attr:
-// onlyTopCall
+// TracerConfig stores additional tracer args:
+//   - onlyTopCall: for the "callTracer", only trace the top-level call.
+//   - diffMode: for the "prestateTracer", return the pre and post state of the
+//     accounts modified by the tx instead of only the pre state.
 type TracerConfig struct {
 	OnlyTopCall bool `protobuf:"varint,1,opt,name=only_top_call,json=onlyTopCall,proto3" json:"onlyTopCall"`
+	DiffMode    bool `protobuf:"varint,2,opt,name=diff_mode,json=diffMode,proto3" json:"diffMode"`
 }
 
 func (m *TracerConfig) Reset()         { *m = TracerConfig{} }
 func (m *TracerConfig) String() string { return proto.CompactTextString(m) }
 func (*TracerConfig) ProtoMessage()    {}
 func (*TracerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{5}
+	return fileDescriptor_98abbdadb327b7d0, []int{7}
 }
 func (m *TracerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,6 +560,13 @@ func (m *TracerConfig) GetOnlyTopCall() bool {
 	return false
 }
 
+func (m *TracerConfig) GetDiffMode() bool {
+	if m != nil {
+		return m.DiffMode
+	}
+	return false
+}
+
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
 	// tracer is a custom javascript tracer
@@ -441,7 +596,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{6}
+	return fileDescriptor_98abbdadb327b7d0, []int{8}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,6 +697,8 @@ func (m *TraceConfig) GetTracerConfig() *TracerConfig {
 
 func init() {
 	proto.RegisterType((*FunToken)(nil), "eth.evm.v1.FunToken")
+	proto.RegisterType((*FunNFT)(nil), "eth.evm.v1.FunNFT")
+	proto.RegisterType((*FunTokenEscrow)(nil), "eth.evm.v1.FunTokenEscrow")
 	proto.RegisterType((*Params)(nil), "eth.evm.v1.Params")
 	proto.RegisterType((*State)(nil), "eth.evm.v1.State")
 	proto.RegisterType((*Log)(nil), "eth.evm.v1.Log")
@@ -553,67 +710,80 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/evm.proto", fileDescriptor_98abbdadb327b7d0) }
 
 var fileDescriptor_98abbdadb327b7d0 = []byte{
-	// 954 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x1b, 0x27, 0x71, 0x26, 0xe9, 0xd6, 0x9d, 0x16, 0x64, 0x21, 0x6d, 0x1c, 0xf9, 0x80,
-	0x82, 0xb4, 0x4a, 0xd8, 0xae, 0xca, 0xa1, 0x5c, 0x68, 0xb2, 0xad, 0x68, 0xa0, 0x4b, 0x35, 0x5b,
-	0x38, 0x70, 0xb1, 0x26, 0xf6, 0x6b, 0x62, 0xc5, 0xf6, 0x44, 0x33, 0xe3, 0x28, 0xf9, 0x07, 0x1c,
-	0xf9, 0x09, 0x7b, 0xe7, 0x8f, 0xac, 0x38, 0xed, 0x11, 0x71, 0xb0, 0x50, 0x7b, 0x41, 0x39, 0x72,
-	0x42, 0x9c, 0xd0, 0x8c, 0xdd, 0x4d, 0x0a, 0x12, 0x9c, 0xfc, 0xbe, 0xef, 0xcd, 0x7b, 0xf3, 0xe6,
-	0xfb, 0xc6, 0x36, 0x3a, 0x02, 0x39, 0xed, 0xc3, 0x22, 0xe9, 0x2f, 0x9e, 0xab, 0x47, 0x6f, 0xce,
-	0x99, 0x64, 0x18, 0x81, 0x9c, 0xf6, 0x14, 0x5c, 0x3c, 0xff, 0xe8, 0x68, 0xc2, 0x26, 0x4c, 0xd3,
-	0x7d, 0x15, 0x15, 0x2b, 0xbc, 0x9f, 0x0c, 0x64, 0x5d, 0x64, 0xe9, 0x0d, 0x9b, 0x41, 0x8a, 0xbf,
-	0x45, 0x08, 0x78, 0x70, 0xfc, 0xa9, 0x4f, 0xc3, 0x90, 0x3b, 0x46, 0xc7, 0xe8, 0x36, 0x06, 0x9f,
-	0xbd, 0xcd, 0xdd, 0x9d, 0x5f, 0x73, 0xb7, 0x37, 0x89, 0xe4, 0x34, 0x1b, 0xf7, 0x02, 0x96, 0xf4,
-	0x5f, 0x45, 0xe3, 0x88, 0x67, 0xc3, 0x29, 0x8d, 0xd2, 0x7e, 0xaa, 0xe3, 0xfe, 0xe2, 0xb8, 0xaf,
-	0xf6, 0x3a, 0xbf, 0xbc, 0x3e, 0x39, 0x39, 0x0b, 0x43, 0x4e, 0x1a, 0xba, 0x93, 0x0a, 0xf1, 0x53,
-	0x84, 0xc6, 0x34, 0x9d, 0xf9, 0x21, 0xa4, 0x2c, 0x71, 0x76, 0x55, 0x5b, 0xd2, 0x50, 0xcc, 0x4b,
-	0x45, 0xe0, 0x4f, 0xd0, 0x41, 0x24, 0xfc, 0x84, 0x86, 0xe0, 0xdf, 0x72, 0x96, 0xf8, 0x01, 0x8b,
-	0x52, 0xa7, 0xd2, 0x31, 0xba, 0x16, 0x79, 0x12, 0x89, 0x2b, 0x1a, 0xc2, 0x05, 0x67, 0xc9, 0x90,
-	0x45, 0xa9, 0xf7, 0xa7, 0x81, 0x6a, 0xd7, 0x94, 0xd3, 0x44, 0xe0, 0x33, 0x84, 0x60, 0x29, 0x39,
-	0xf5, 0x21, 0x9a, 0x0b, 0xc7, 0xec, 0x54, 0xba, 0x95, 0x81, 0x77, 0x97, 0xbb, 0x8d, 0x73, 0xc5,
-	0x9e, 0x5f, 0x5e, 0x8b, 0x3f, 0x72, 0xf7, 0x60, 0x45, 0x93, 0xf8, 0xd4, 0xdb, 0x2c, 0xf4, 0x48,
-	0x43, 0x83, 0xf3, 0x68, 0x2e, 0xf0, 0x31, 0x6a, 0xc1, 0x22, 0xf1, 0x83, 0x29, 0x4d, 0x53, 0x88,
-	0x85, 0x63, 0x75, 0x2a, 0xdd, 0xc6, 0x60, 0xff, 0x2e, 0x77, 0x9b, 0xe7, 0xdf, 0x5d, 0x0d, 0x4b,
-	0x9a, 0x34, 0x61, 0x91, 0x3c, 0x00, 0x7c, 0x85, 0x0e, 0x03, 0x0e, 0x54, 0x82, 0x7f, 0x9b, 0xa5,
-	0x52, 0xa9, 0xe6, 0xdf, 0x02, 0x38, 0x0d, 0xad, 0xd5, 0xd3, 0x52, 0xab, 0x0f, 0x02, 0x26, 0x12,
-	0x26, 0x44, 0x38, 0xeb, 0x45, 0xac, 0x9f, 0x50, 0x39, 0xed, 0x5d, 0xa6, 0x92, 0x1c, 0x14, 0x95,
-	0x17, 0x65, 0xe1, 0x05, 0xc0, 0xa9, 0xf9, 0xfb, 0x1b, 0xd7, 0x18, 0x99, 0x96, 0x61, 0xef, 0x8e,
-	0x4c, 0x6b, 0xd7, 0xae, 0x8c, 0x4c, 0xab, 0x62, 0x9b, 0x23, 0xd3, 0xaa, 0xda, 0xb5, 0x91, 0x69,
-	0xd5, 0xec, 0xfa, 0xc8, 0xb4, 0xea, 0xb6, 0xe5, 0xf5, 0x51, 0xf5, 0xb5, 0xa4, 0x12, 0xb0, 0x8d,
-	0x2a, 0x33, 0x58, 0x15, 0xee, 0x10, 0x15, 0xe2, 0x23, 0x54, 0x5d, 0xd0, 0x38, 0x83, 0x52, 0xda,
-	0x02, 0x78, 0x3f, 0xef, 0xa2, 0xca, 0xd7, 0x6c, 0x82, 0x1d, 0x54, 0x57, 0x76, 0x82, 0x10, 0x65,
-	0xcd, 0x03, 0xc4, 0x1f, 0xa2, 0x9a, 0x64, 0xf3, 0x28, 0x10, 0xce, 0xae, 0x3a, 0x39, 0x29, 0x11,
-	0xc6, 0xc8, 0x0c, 0xa9, 0xa4, 0xda, 0x83, 0x16, 0xd1, 0xb1, 0xd2, 0x6a, 0x1c, 0xb3, 0x60, 0xe6,
-	0xa7, 0x59, 0x32, 0x06, 0xee, 0x98, 0x1d, 0xa3, 0x6b, 0x0e, 0xf6, 0xd7, 0xb9, 0xdb, 0xd4, 0xfc,
-	0x2b, 0x4d, 0x93, 0x6d, 0x80, 0x9f, 0xa1, 0xba, 0x5c, 0xfa, 0x53, 0x2a, 0xa6, 0x4e, 0x55, 0xeb,
-	0x73, 0xb8, 0xce, 0xdd, 0x7d, 0xc9, 0x69, 0x2a, 0x68, 0x20, 0x23, 0x96, 0x7e, 0x49, 0xc5, 0x94,
-	0xd4, 0xe4, 0x52, 0x3d, 0x71, 0x1f, 0x59, 0x72, 0xe9, 0x47, 0x69, 0x08, 0x4b, 0xa7, 0xa6, 0xbb,
-	0x1f, 0xad, 0x73, 0xd7, 0xde, 0x5a, 0x7e, 0xa9, 0x72, 0xa4, 0x2e, 0x97, 0x3a, 0xc0, 0xcf, 0x10,
-	0x2a, 0x46, 0xd2, 0x3b, 0xd4, 0xf5, 0x0e, 0x7b, 0xeb, 0xdc, 0x6d, 0x68, 0x56, 0xf7, 0xde, 0x84,
-	0xd8, 0x43, 0xd5, 0xa2, 0xb7, 0xa5, 0x7b, 0xb7, 0xd6, 0xb9, 0x6b, 0xc5, 0x6c, 0x52, 0xf4, 0x2c,
-	0x52, 0x4a, 0x2a, 0x0e, 0x09, 0x5b, 0x40, 0xa8, 0x0d, 0xb5, 0xc8, 0x03, 0xf4, 0x28, 0x6a, 0x9e,
-	0x05, 0x01, 0x08, 0x71, 0x93, 0xcd, 0x63, 0xf8, 0x0f, 0x4d, 0x8f, 0x51, 0x4b, 0x48, 0xc6, 0xe9,
-	0x04, 0xfc, 0x19, 0xac, 0x4a, 0x65, 0x0b, 0x9d, 0x4a, 0xfe, 0x2b, 0x58, 0x09, 0xb2, 0x0d, 0x4e,
-	0xcd, 0x1f, 0xde, 0xb8, 0x3b, 0xde, 0x10, 0xb5, 0x6e, 0x38, 0x0d, 0x80, 0x0f, 0x59, 0x7a, 0x1b,
-	0x4d, 0xf0, 0x0b, 0xb4, 0xc7, 0xd2, 0x78, 0xe5, 0x4b, 0x36, 0xf7, 0x03, 0x1a, 0xc7, 0x7a, 0x27,
-	0xab, 0x68, 0xa5, 0x12, 0x37, 0x6c, 0x3e, 0xa4, 0x71, 0x4c, 0xb6, 0x81, 0xf7, 0x57, 0x05, 0x35,
-	0x75, 0x97, 0xb2, 0x89, 0xb2, 0x58, 0x37, 0x2d, 0xe7, 0x2c, 0x91, 0x3a, 0x80, 0x8c, 0x12, 0x60,
-	0x99, 0x2c, 0x2f, 0xcd, 0x03, 0x54, 0x15, 0x1c, 0x60, 0x09, 0x81, 0xb6, 0xdf, 0x24, 0x25, 0xc2,
-	0x27, 0x68, 0x2f, 0x8c, 0x04, 0x1d, 0xc7, 0xe0, 0x0b, 0x49, 0x83, 0x99, 0xb6, 0xd4, 0x1a, 0xd8,
-	0xeb, 0xdc, 0x6d, 0x95, 0x89, 0xd7, 0x8a, 0x27, 0x8f, 0x10, 0xfe, 0x1c, 0xed, 0x6f, 0xca, 0xf4,
-	0x91, 0xb5, 0xb9, 0xd6, 0x00, 0xaf, 0x73, 0xf7, 0xc9, 0xfb, 0xa5, 0x3a, 0x43, 0xfe, 0x81, 0xd5,
-	0xc5, 0x0e, 0x61, 0x9c, 0x4d, 0xb4, 0x67, 0x16, 0x29, 0x80, 0x62, 0xe3, 0x28, 0x89, 0xa4, 0xf6,
-	0xa8, 0x4a, 0x0a, 0xa0, 0xe6, 0x83, 0x54, 0xef, 0x93, 0x40, 0xc2, 0xf8, 0xca, 0x69, 0x6e, 0xe6,
-	0x2b, 0x12, 0x57, 0x9a, 0x27, 0x8f, 0x10, 0x1e, 0x20, 0x5c, 0x96, 0x71, 0x90, 0x19, 0x4f, 0x7d,
-	0x7d, 0xf3, 0x5b, 0xba, 0x56, 0xdf, 0xbf, 0x22, 0x4b, 0x74, 0xf2, 0x25, 0x95, 0x94, 0xfc, 0x8b,
-	0xc1, 0xdf, 0xa0, 0xbd, 0x42, 0x56, 0x3f, 0xd0, 0xaa, 0x3b, 0x7b, 0x1d, 0xa3, 0xdb, 0x3c, 0x76,
-	0x7a, 0x9b, 0xaf, 0x6f, 0x6f, 0xdb, 0xda, 0x62, 0x28, 0xb9, 0xc5, 0x90, 0x47, 0x68, 0x64, 0x5a,
-	0xa6, 0x5d, 0x2d, 0xde, 0xfb, 0x91, 0x69, 0x21, 0xbb, 0xf9, 0x5e, 0x99, 0xf2, 0x70, 0xe4, 0xf0,
-	0x01, 0x6f, 0x4d, 0x3d, 0xf8, 0xe2, 0xed, 0x5d, 0xdb, 0x78, 0x77, 0xd7, 0x36, 0x7e, 0xbb, 0x6b,
-	0x1b, 0x3f, 0xde, 0xb7, 0x77, 0xde, 0xdd, 0xb7, 0x77, 0x7e, 0xb9, 0x6f, 0xef, 0x7c, 0xff, 0xf1,
-	0xff, 0x7e, 0xbc, 0x97, 0xea, 0xaf, 0x31, 0xae, 0xe9, 0x9f, 0xc2, 0x8b, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0x26, 0x36, 0x60, 0x4e, 0x06, 0x00, 0x00,
+	// 1166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x8e, 0x1b, 0xc5,
+	0x16, 0x1e, 0xdb, 0x6d, 0xbb, 0x5d, 0xb6, 0xc7, 0x4e, 0x65, 0xee, 0x55, 0xdf, 0x2b, 0x65, 0x7a,
+	0xe4, 0x05, 0x4c, 0xa4, 0xc8, 0x26, 0x13, 0x05, 0xa4, 0x20, 0x21, 0xe2, 0x89, 0xad, 0x8c, 0x61,
+	0x42, 0x54, 0x19, 0x40, 0x62, 0xd3, 0x2a, 0x77, 0x1f, 0xdb, 0x25, 0x77, 0x57, 0x59, 0x5d, 0x65,
+	0x63, 0x4b, 0x3c, 0x00, 0x4b, 0x1e, 0x21, 0x0f, 0xc2, 0x03, 0x44, 0xac, 0xb2, 0x44, 0x2c, 0x2c,
+	0x34, 0xd9, 0x20, 0x2f, 0xd9, 0x80, 0x58, 0xa1, 0xaa, 0x6a, 0xcf, 0x38, 0x20, 0x91, 0x2c, 0x58,
+	0xf5, 0xf9, 0xbe, 0x53, 0xe7, 0xff, 0x54, 0x77, 0xa3, 0x03, 0x50, 0x93, 0x0e, 0x2c, 0x92, 0xce,
+	0xe2, 0xae, 0x7e, 0xb4, 0x67, 0xa9, 0x50, 0x02, 0x23, 0x50, 0x93, 0xb6, 0x86, 0x8b, 0xbb, 0xff,
+	0x3f, 0x18, 0x8b, 0xb1, 0x30, 0x74, 0x47, 0x4b, 0xf6, 0x44, 0xeb, 0xb7, 0x1c, 0x72, 0xfb, 0x73,
+	0x7e, 0x21, 0xa6, 0xc0, 0xf1, 0xe7, 0x08, 0x41, 0x1a, 0x9e, 0xbc, 0x17, 0xd0, 0x28, 0x4a, 0xbd,
+	0xdc, 0x51, 0xee, 0xb8, 0xd2, 0x7d, 0xff, 0xc5, 0xda, 0xdf, 0xfb, 0x69, 0xed, 0xb7, 0xc7, 0x4c,
+	0x4d, 0xe6, 0xc3, 0x76, 0x28, 0x92, 0xce, 0x13, 0x36, 0x64, 0xe9, 0xfc, 0x74, 0x42, 0x19, 0xef,
+	0x70, 0x23, 0x77, 0x16, 0x27, 0x1d, 0x1d, 0xab, 0x77, 0xf6, 0xf4, 0xfe, 0xfd, 0x87, 0x51, 0x94,
+	0x92, 0x8a, 0xf1, 0xa4, 0x45, 0x7c, 0x0b, 0xa1, 0x21, 0xe5, 0xd3, 0x20, 0x02, 0x2e, 0x12, 0x2f,
+	0xaf, 0xdd, 0x92, 0x8a, 0x66, 0x1e, 0x69, 0x02, 0xdf, 0x46, 0x37, 0x98, 0x0c, 0x12, 0x1a, 0x41,
+	0x30, 0x4a, 0x45, 0x12, 0x84, 0x82, 0x71, 0xaf, 0x70, 0x94, 0x3b, 0x76, 0xc9, 0x3e, 0x93, 0xe7,
+	0x34, 0x82, 0x7e, 0x2a, 0x92, 0x53, 0xc1, 0x38, 0xbe, 0x8d, 0x9a, 0x32, 0xa4, 0x31, 0xe3, 0xe3,
+	0x00, 0x96, 0x33, 0xc1, 0x81, 0x2b, 0xcf, 0x39, 0xca, 0x1d, 0xd7, 0x49, 0x23, 0xe3, 0x7b, 0x19,
+	0x8d, 0x7d, 0x54, 0x65, 0x32, 0x48, 0x61, 0x48, 0x25, 0xe3, 0x63, 0xaf, 0x68, 0xfc, 0x21, 0x26,
+	0x49, 0xc6, 0xb4, 0xbe, 0x41, 0xa5, 0xfe, 0x9c, 0x3f, 0xe9, 0x5f, 0xe0, 0x2f, 0x51, 0x15, 0xd2,
+	0xf0, 0x83, 0x93, 0xbb, 0xff, 0x46, 0xdd, 0xc8, 0xba, 0x32, 0x85, 0xff, 0x0f, 0xb9, 0x61, 0x4c,
+	0xa5, 0x0c, 0x58, 0x94, 0x95, 0x5d, 0x36, 0xf8, 0x2c, 0x6a, 0x7d, 0x9f, 0x47, 0xfb, 0xdb, 0xbe,
+	0xf7, 0x64, 0x98, 0x8a, 0xaf, 0xf1, 0x23, 0xb4, 0x0f, 0x46, 0x0a, 0x86, 0x34, 0xa6, 0x3c, 0x84,
+	0x2c, 0x93, 0x5b, 0x59, 0x26, 0xff, 0x09, 0x85, 0x4c, 0x84, 0x94, 0xd1, 0xb4, 0xcd, 0x44, 0x27,
+	0xa1, 0x6a, 0xd2, 0x3e, 0xe3, 0x8a, 0xd4, 0xad, 0x51, 0xd7, 0xda, 0xe0, 0x8f, 0x50, 0xd5, 0x34,
+	0x5b, 0xce, 0x67, 0xb3, 0x78, 0xe5, 0xe5, 0xdf, 0xc6, 0x85, 0x19, 0xcf, 0x33, 0x63, 0x80, 0xfb,
+	0xa8, 0x01, 0xcb, 0x19, 0x84, 0x0a, 0xa2, 0xc0, 0x7a, 0xf6, 0x0a, 0x6f, 0xe3, 0x63, 0x7f, 0x6b,
+	0x95, 0x55, 0x73, 0x0f, 0x15, 0xa3, 0x94, 0x8d, 0xec, 0x7c, 0xde, 0x68, 0x6d, 0xcf, 0xe2, 0x77,
+	0x51, 0x83, 0xf1, 0x05, 0x4d, 0x19, 0xe5, 0x2a, 0x98, 0x88, 0x38, 0x92, 0xd9, 0xe0, 0xf6, 0xaf,
+	0xe8, 0xc7, 0x9a, 0x6d, 0xfd, 0x9e, 0x43, 0xa5, 0xa7, 0x34, 0xa5, 0x89, 0xc4, 0x0f, 0x11, 0x82,
+	0xa5, 0x4a, 0x69, 0x00, 0x6c, 0x26, 0x3d, 0xe7, 0xa8, 0x70, 0x5c, 0xe8, 0xb6, 0x2e, 0xd7, 0x7e,
+	0xa5, 0xa7, 0xd9, 0xde, 0xd9, 0x53, 0xf9, 0xeb, 0xda, 0xbf, 0xb1, 0xa2, 0x49, 0xfc, 0xa0, 0x75,
+	0x7d, 0xb0, 0x45, 0x2a, 0x06, 0xf4, 0xd8, 0x4c, 0xe2, 0x13, 0x54, 0x83, 0x45, 0x12, 0x84, 0x13,
+	0xca, 0x39, 0xc4, 0xd2, 0x73, 0x8f, 0x0a, 0xc7, 0x95, 0x6e, 0xe3, 0x72, 0xed, 0x57, 0x7b, 0x5f,
+	0x9c, 0x9f, 0x66, 0x34, 0xa9, 0xc2, 0x22, 0xd9, 0x02, 0x7c, 0x8e, 0x6e, 0x86, 0x29, 0x50, 0x05,
+	0xc1, 0x68, 0xce, 0x95, 0x1e, 0x63, 0x30, 0x02, 0xf0, 0x2a, 0x6f, 0x53, 0xed, 0x0d, 0x6b, 0xd9,
+	0xcf, 0x0c, 0xfb, 0x00, 0x0f, 0x9c, 0x5f, 0x9e, 0xfb, 0xb9, 0x81, 0xe3, 0xe6, 0x9a, 0xf9, 0x81,
+	0xe3, 0xe6, 0x9b, 0x85, 0x81, 0xe3, 0x16, 0x9a, 0xce, 0xc0, 0x71, 0x8b, 0xcd, 0xd2, 0xc0, 0x71,
+	0x4b, 0xcd, 0xf2, 0xc0, 0x71, 0xcb, 0x4d, 0xb7, 0xd5, 0x41, 0xc5, 0x67, 0x8a, 0x2a, 0xc0, 0x4d,
+	0x54, 0x98, 0xc2, 0xca, 0x2e, 0x09, 0xd1, 0x22, 0x3e, 0x40, 0xc5, 0x05, 0x8d, 0xe7, 0x90, 0x2d,
+	0x9b, 0x05, 0xad, 0x1f, 0xf2, 0xa8, 0xf0, 0xa9, 0x18, 0x63, 0x0f, 0x95, 0xf5, 0x7e, 0x83, 0x94,
+	0x99, 0xcd, 0x16, 0xe2, 0xff, 0xa2, 0x92, 0x12, 0x33, 0x16, 0x4a, 0x2f, 0xaf, 0x2b, 0x27, 0x19,
+	0xc2, 0x18, 0x39, 0x11, 0x55, 0xd4, 0x2c, 0x40, 0x8d, 0x18, 0x59, 0xf7, 0x6a, 0x18, 0x8b, 0x70,
+	0x1a, 0xf0, 0x79, 0x32, 0x84, 0xd4, 0x8c, 0xd7, 0xe9, 0x36, 0x36, 0x6b, 0xbf, 0x6a, 0xf8, 0x27,
+	0x86, 0x26, 0xbb, 0x00, 0xdf, 0x41, 0x65, 0xb5, 0x0c, 0x26, 0x54, 0x4e, 0xcc, 0x38, 0x2b, 0xdd,
+	0x9b, 0x9b, 0xb5, 0xdf, 0x50, 0x29, 0xe5, 0x92, 0x86, 0x8a, 0x09, 0xfe, 0x98, 0xca, 0x09, 0x29,
+	0xa9, 0xa5, 0x7e, 0xe2, 0x0e, 0x72, 0xd5, 0x32, 0x60, 0x3c, 0x82, 0xa5, 0x57, 0x32, 0xde, 0x0f,
+	0x36, 0x6b, 0xbf, 0xb9, 0x73, 0xfc, 0x4c, 0xeb, 0x48, 0x59, 0x2d, 0x8d, 0x80, 0xef, 0x20, 0x64,
+	0x53, 0x32, 0x11, 0xca, 0x26, 0x42, 0x7d, 0xb3, 0xf6, 0x2b, 0x86, 0x35, 0xbe, 0xaf, 0x45, 0xdc,
+	0x42, 0x45, 0xeb, 0xdb, 0x35, 0xbe, 0x6b, 0x9b, 0xb5, 0xef, 0xc6, 0x62, 0x6c, 0x7d, 0x5a, 0x95,
+	0x6e, 0x55, 0x0a, 0x89, 0x58, 0x40, 0x64, 0x06, 0xea, 0x92, 0x2d, 0x6c, 0x51, 0x54, 0x7d, 0x18,
+	0x86, 0x20, 0xe5, 0xc5, 0x7c, 0x16, 0xc3, 0x3f, 0xf4, 0xf4, 0x04, 0xd5, 0xa4, 0x12, 0x29, 0x1d,
+	0x43, 0x30, 0x85, 0x55, 0xd6, 0x59, 0xdb, 0xa7, 0x8c, 0xff, 0x04, 0x56, 0x92, 0xec, 0x82, 0x07,
+	0xce, 0xb7, 0xcf, 0xfd, 0xbd, 0x16, 0x47, 0xb5, 0x8b, 0x94, 0x86, 0x90, 0x9e, 0x0a, 0x3e, 0x62,
+	0x63, 0x7c, 0x0f, 0xd5, 0x05, 0x8f, 0x57, 0x81, 0x12, 0xb3, 0x20, 0xa4, 0x71, 0x6c, 0x22, 0xb9,
+	0xd6, 0x95, 0x56, 0x5c, 0x88, 0xd9, 0x29, 0x8d, 0x63, 0xb2, 0x0b, 0xf0, 0x6d, 0x54, 0x89, 0xd8,
+	0x68, 0x14, 0x24, 0x22, 0xb2, 0xeb, 0xe0, 0xda, 0x4a, 0x35, 0x79, 0x2e, 0x22, 0x20, 0x57, 0x52,
+	0xeb, 0x8f, 0x02, 0xaa, 0x9a, 0x80, 0x59, 0x3c, 0xbd, 0x0d, 0x26, 0x7e, 0x56, 0x52, 0x86, 0x74,
+	0xad, 0x8a, 0x25, 0x20, 0xe6, 0x6a, 0xfb, 0x32, 0xcb, 0xa0, 0xb6, 0x48, 0x01, 0x96, 0x10, 0x9a,
+	0x4d, 0x71, 0x48, 0x86, 0xf0, 0x7d, 0x54, 0x8f, 0x98, 0xa4, 0xc3, 0x18, 0x02, 0xa9, 0x68, 0x38,
+	0xb5, 0x97, 0xb9, 0xdb, 0xdc, 0xac, 0xfd, 0x5a, 0xa6, 0x78, 0xa6, 0x79, 0xf2, 0x1a, 0xc2, 0x1f,
+	0xa2, 0xc6, 0xb5, 0x99, 0xe9, 0x8e, 0xd9, 0x03, 0xb7, 0x8b, 0x37, 0x6b, 0x7f, 0xff, 0xea, 0xa8,
+	0xd1, 0x90, 0xbf, 0x60, 0x7d, 0x07, 0x22, 0x18, 0xce, 0xc7, 0x66, 0xbc, 0x2e, 0xb1, 0x40, 0xb3,
+	0x31, 0x4b, 0x98, 0x32, 0xe3, 0x2c, 0x12, 0x0b, 0x74, 0x7e, 0xc0, 0x4d, 0x9c, 0x04, 0x12, 0x91,
+	0xae, 0xbc, 0xea, 0x75, 0x7e, 0x56, 0x71, 0x6e, 0x78, 0xf2, 0x1a, 0xc2, 0x5d, 0x84, 0x33, 0xb3,
+	0x14, 0xd4, 0x3c, 0xe5, 0x81, 0xb9, 0x24, 0x35, 0x63, 0x6b, 0x56, 0xd5, 0x6a, 0x89, 0x51, 0x3e,
+	0xa2, 0x8a, 0x92, 0xbf, 0x31, 0xf8, 0x33, 0x54, 0xb7, 0x6d, 0x0d, 0x42, 0xd3, 0x75, 0xaf, 0x7e,
+	0x94, 0x3b, 0xae, 0x9e, 0x78, 0xed, 0xeb, 0x2f, 0x76, 0x7b, 0x77, 0x0b, 0x6c, 0x52, 0x6a, 0x87,
+	0x21, 0xaf, 0xa1, 0x81, 0xe3, 0x3a, 0xcd, 0xa2, 0x7d, 0x45, 0x0c, 0x1c, 0x17, 0x35, 0xab, 0x57,
+	0x9d, 0xc9, 0x8a, 0x23, 0x37, 0xb7, 0x78, 0x27, 0xeb, 0xee, 0xc7, 0x2f, 0x2e, 0x0f, 0x73, 0x2f,
+	0x2f, 0x0f, 0x73, 0x3f, 0x5f, 0x1e, 0xe6, 0xbe, 0x7b, 0x75, 0xb8, 0xf7, 0xf2, 0xd5, 0xe1, 0xde,
+	0x8f, 0xaf, 0x0e, 0xf7, 0xbe, 0x7a, 0xe7, 0x8d, 0x1f, 0xbe, 0xa5, 0xfe, 0xd3, 0x18, 0x96, 0xcc,
+	0x8f, 0xc4, 0xbd, 0x3f, 0x07, 0x00, 0xf5, 0x34, 0x5f, 0x0d, 0x82, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -676,6 +846,21 @@ func (m *FunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsRebasing {
+		i--
+		if m.IsRebasing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ScalingExponent != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ScalingExponent))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.IsMadeFromCoin {
 		i--
 		if m.IsMadeFromCoin {
@@ -706,6 +891,119 @@ func (m *FunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FunNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Erc721Addr.Size()
+		i -= size
+		if _, err := m.Erc721Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FunTokenEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunTokenEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunTokenEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvariantHolds {
+		i--
+		if m.InvariantHolds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Drift.Size()
+		i -= size
+		if _, err := m.Drift.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExpectedEscrow.Size()
+		i -= size
+		if _, err := m.ExpectedEscrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BankSupply.Size()
+		i -= size
+		if _, err := m.BankSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.EscrowBalance.Size()
+		i -= size
+		if _, err := m.EscrowBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -948,6 +1246,16 @@ func (m *TracerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DiffMode {
+		i--
+		if m.DiffMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.OnlyTopCall {
 		i--
 		if m.OnlyTopCall {
@@ -1096,6 +1404,47 @@ func (m *FunToken) Size() (n int) {
 	if m.IsMadeFromCoin {
 		n += 2
 	}
+	if m.ScalingExponent != 0 {
+		n += 1 + sovEvm(uint64(m.ScalingExponent))
+	}
+	if m.IsRebasing {
+		n += 2
+	}
+	return n
+}
+
+func (m *FunNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Erc721Addr.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *FunTokenEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EscrowBalance.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.BankSupply.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.ExpectedEscrow.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.Drift.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.InvariantHolds {
+		n += 2
+	}
 	return n
 }
 
@@ -1211,6 +1560,9 @@ func (m *TracerConfig) Size() (n int) {
 	if m.OnlyTopCall {
 		n += 2
 	}
+	if m.DiffMode {
+		n += 2
+	}
 	return n
 }
 
@@ -1377,6 +1729,45 @@ func (m *FunToken) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsMadeFromCoin = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingExponent", wireType)
+			}
+			m.ScalingExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScalingExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRebasing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRebasing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -1398,7 +1789,7 @@ func (m *FunToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FunNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,18 +1812,340 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FunNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FunNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvm
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc721Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc721Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunTokenEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunTokenEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunTokenEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedEscrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Drift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantHolds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InvariantHolds = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvm
 					}
 					if iNdEx >= l {
 						return io.ErrUnexpectedEOF
@@ -2156,6 +2869,26 @@ func (m *TracerConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.OnlyTopCall = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiffMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DiffMode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
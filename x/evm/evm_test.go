@@ -117,6 +117,91 @@ func (s *TestSuite) TestFunToken() {
 	}
 }
 
+func (s *TestSuite) TestFunTokenScaling() {
+	erc20 := gethcommon.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+
+	s.Run("validate", func() {
+		funtoken := evm.NewFunToken(erc20, "unibi", false)
+		funtoken.ScalingExponent = evm.MaxFunTokenScalingExponent
+		s.Require().NoError(funtoken.Validate())
+
+		funtoken.ScalingExponent = evm.MaxFunTokenScalingExponent + 1
+		s.Require().ErrorContains(funtoken.Validate(), "exceeds the maximum")
+
+		funtoken = evm.NewFunToken(erc20, "unibi", true)
+		funtoken.ScalingExponent = 1
+		s.Require().ErrorContains(funtoken.Validate(), "created from a coin")
+	})
+
+	s.Run("unscaled amounts convert 1:1", func() {
+		funtoken := evm.NewFunToken(erc20, "unibi", false)
+		bankAmount, dust := funtoken.ERC20ToBankAmount(big.NewInt(123))
+		s.Equal("123", bankAmount.String())
+		s.Equal("0", dust.String())
+		s.Equal("123", funtoken.BankToERC20Amount(big.NewInt(123)).String())
+	})
+
+	s.Run("scaled amounts", func() {
+		funtoken := evm.NewFunToken(erc20, "unibi", false)
+		funtoken.ScalingExponent = 12
+		s.Equal("1000000000000", funtoken.ScalingFactor().String())
+
+		bankAmount, dust := funtoken.ERC20ToBankAmount(big.NewInt(3_000_000_000_005))
+		s.Equal("3", bankAmount.String())
+		s.Equal("5", dust.String())
+
+		bankAmount, dust = funtoken.ERC20ToBankAmount(big.NewInt(999_999_999_999))
+		s.Equal("0", bankAmount.String())
+		s.Equal("999999999999", dust.String())
+
+		s.Equal("3000000000000", funtoken.BankToERC20Amount(big.NewInt(3)).String())
+	})
+}
+
+func (s *TestSuite) TestFunTokenEscrow() {
+	erc20 := gethcommon.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+
+	s.Run("validate", func() {
+		funtoken := evm.NewFunToken(erc20, "unibi", true)
+		funtoken.IsRebasing = true
+		s.Require().ErrorContains(funtoken.Validate(), "can't be rebasing")
+	})
+
+	s.Run("pro-rated amounts", func() {
+		funtoken := evm.NewFunToken(erc20, "unibi", false)
+		funtoken.IsRebasing = true
+		// 150 tokens escrowed for a supply of 100 coins
+		s.Equal("20", funtoken.ERC20ToBankShares(big.NewInt(30), big.NewInt(150), big.NewInt(100)).String())
+		s.Equal("30", funtoken.BankSharesToERC20(big.NewInt(20), big.NewInt(150), big.NewInt(100)).String())
+		s.Equal("1", funtoken.BankSharesToERC20(big.NewInt(1), big.NewInt(150), big.NewInt(100)).String())
+
+		// Without supply, amounts convert at the scaling factor
+		funtoken.ScalingExponent = 2
+		s.Equal("3", funtoken.ERC20ToBankShares(big.NewInt(345), big.NewInt(0), big.NewInt(0)).String())
+		s.Equal("300", funtoken.BankSharesToERC20(big.NewInt(3), big.NewInt(7), big.NewInt(0)).String())
+	})
+
+	s.Run("supply invariant", func() {
+		funtoken := evm.NewFunToken(erc20, "unibi", false)
+		funtoken.ScalingExponent = 2
+
+		escrow := evm.NewFunTokenEscrow(funtoken, big.NewInt(1005), big.NewInt(10))
+		s.Equal("1000", escrow.ExpectedEscrow.String())
+		s.Equal("5", escrow.Drift.String())
+		s.True(escrow.InvariantHolds)
+
+		escrow = evm.NewFunTokenEscrow(funtoken, big.NewInt(999), big.NewInt(10))
+		s.Equal("-1", escrow.Drift.String())
+		s.False(escrow.InvariantHolds)
+
+		funtoken.IsRebasing = true
+		escrow = evm.NewFunTokenEscrow(funtoken, big.NewInt(999), big.NewInt(10))
+		s.True(escrow.InvariantHolds)
+		escrow = evm.NewFunTokenEscrow(funtoken, big.NewInt(0), big.NewInt(10))
+		s.False(escrow.InvariantHolds)
+	})
+}
+
 func (s *TestSuite) TestModuleAddressEVM() {
 	addr := evm.EVM_MODULE_ADDRESS
 	s.Equal(addr.Hex(), "0x603871c2ddd41c26Ee77495E2E31e6De7f9957e0")
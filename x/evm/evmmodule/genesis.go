@@ -71,14 +71,26 @@ func InitGenesis(
 
 	// Create fungible token mappings
 	for _, funToken := range genState.FuntokenMappings {
-		err := k.FunTokens.SafeInsert(
-			ctx, gethcommon.HexToAddress(funToken.Erc20Addr.String()), funToken.BankDenom, funToken.IsMadeFromCoin,
+		mapping := evm.NewFunToken(
+			gethcommon.HexToAddress(funToken.Erc20Addr.String()), funToken.BankDenom, funToken.IsMadeFromCoin,
 		)
+		mapping.ScalingExponent = funToken.ScalingExponent
+		mapping.IsRebasing = funToken.IsRebasing
+		err := k.FunTokens.SafeInsertFunToken(ctx, mapping)
 		if err != nil {
 			panic(fmt.Errorf("failed creating funtoken: %w", err))
 		}
 	}
 
+	// Create non-fungible token mappings. The "x/nft" classes of the mappings
+	// are part of the genesis state of the NFT module.
+	for _, funNFT := range genState.FunnftMappings {
+		err := k.FunNFTs.SafeInsert(ctx, funNFT)
+		if err != nil {
+			panic(fmt.Errorf("failed creating funnft: %w", err))
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -121,9 +133,13 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak evm.AccountKeeper) *evm
 		funTokens = append(funTokens, iter.Value())
 	}
 
+	// 3. Export non-fungible token mappings
+	funNFTs := k.FunNFTs.Iterate(ctx, collections.Range[[]byte]{}).Values()
+
 	return &evm.GenesisState{
 		Params:           k.GetParams(ctx),
 		Accounts:         genesisAccounts,
 		FuntokenMappings: funTokens,
+		FunnftMappings:   funNFTs,
 	}
 }
@@ -276,6 +276,8 @@ type EvmInputs struct {
 	AccountKeeper authkeeper.AccountKeeper
 	BankKeeper    bankkeeper.Keeper
 	StakingKeeper evm.StakingKeeper
+	SudoKeeper    evm.SudoKeeper
+	NFTKeeper     evm.NFTKeeper
 }
 
 type EvmOutputs struct {
@@ -292,7 +294,7 @@ func ProvideModule(in EvmInputs) EvmOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
-	k := keeper.NewKeeper(in.Cdc, in.Key, in.TransientKey, authority, in.AccountKeeper, in.BankKeeper.(*evmkeeper.NibiruBankKeeper), in.StakingKeeper, cast.ToString(in.AppOpts.Get("evm.tracer")))
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.TransientKey, authority, in.AccountKeeper, in.BankKeeper.(*evmkeeper.NibiruBankKeeper), in.StakingKeeper, in.SudoKeeper, in.NFTKeeper, cast.ToString(in.AppOpts.Get("evm.tracer")))
 
 	m := NewAppModule(&k, in.AccountKeeper)
 
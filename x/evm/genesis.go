@@ -38,5 +38,16 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	seenFunNFTs := make(map[string]bool)
+	for _, funnft := range gs.FunnftMappings {
+		if err := funnft.Validate(); err != nil {
+			return err
+		}
+		if seenFunNFTs[funnft.ClassId] {
+			return fmt.Errorf("duplicate FunNFT mapping for class %s", funnft.ClassId)
+		}
+		seenFunNFTs[funnft.ClassId] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+// CallOnEpochEnd calls "onEpochEnd(string identifier, uint256 epochNumber)"
+// (see "IEpochHooks.sol") on "contract" from the EVM module account with a gas
+// limit of "gasLimit", committing the resulting state changes to "ctx".
+//
+// Callers are expected to pass a cached context so that a failed call can be
+// discarded without side effects.
+func (k *Keeper) CallOnEpochEnd(
+	ctx sdk.Context,
+	contract gethcommon.Address,
+	identifier string,
+	epochNumber uint64,
+	gasLimit uint64,
+) error {
+	input, err := embeds.SmartContract_EpochHooks.ABI.Pack(
+		"onEpochEnd", identifier, new(big.Int).SetUint64(epochNumber),
+	)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "failed to pack ABI args")
+	}
+
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &contract,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            unusedBigInt, // amount
+		GasLimit:         gasLimit,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             input,
+		AccessList:       gethcore.AccessList{},
+		SkipNonceChecks:  false,
+		SkipFromEOACheck: false,
+	}
+	evmCfg := k.GetEVMConfig(ctx)
+	txConfig := k.TxConfig(ctx, gethcommon.BigToHash(big.NewInt(0)))
+	stateDB := k.NewStateDB(ctx, txConfig)
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+	evmObj := k.NewEVM(ctx, evmMsg, evmCfg, nil /*tracer*/, stateDB)
+	evmResp, err := k.CallContractWithInput(
+		ctx, evmObj, evm.EVM_MODULE_ADDRESS, &contract, true /*commit*/, input, gasLimit,
+	)
+	if err != nil {
+		return sdkioerrors.Wrap(err, "onEpochEnd call failed")
+	}
+
+	if err = stateDB.Commit(); err != nil {
+		return sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	// Emit the logs from the EVM contract execution
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmResp.Logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(0))
+	}
+	return nil
+}
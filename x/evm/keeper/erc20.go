@@ -138,6 +138,61 @@ func (e erc20Calls) Transfer(
 	return balanceIncrease, resp, err
 }
 
+/*
+TransferFrom implements "ERC20.transferFrom"
+
+	```solidity
+	/// @dev Moves `amount` tokens from `from` to `to` using the allowance
+	/// mechanism. `amount` is then deducted from the caller's allowance.
+	/// Returns a boolean value indicating whether the operation succeeded.
+	/// Emits a {Transfer} event.
+	function transferFrom(address from, address to, uint256 amount) external returns (bool);
+	```
+*/
+func (e erc20Calls) TransferFrom(
+	erc20Contract, spender, from, recipient gethcommon.Address, amount *big.Int,
+	ctx sdk.Context, evmObj *vm.EVM,
+) (balanceIncrease *big.Int, resp *evm.MsgEthereumTxResponse, err error) {
+	recipientBalanceBefore, err := e.BalanceOf(erc20Contract, recipient, ctx, evmObj)
+	if err != nil {
+		return balanceIncrease, nil, sdkioerrors.Wrap(err, "failed to retrieve recipient balance")
+	}
+
+	contractInput, err := e.ABI.Pack("transferFrom", from, recipient, amount)
+	if err != nil {
+		return balanceIncrease, nil, err
+	}
+	resp, err = e.CallContractWithInput(ctx, evmObj, spender, &erc20Contract, false /*commit*/, contractInput, getCallGasWithLimit(ctx, Erc20GasLimitExecute))
+	if err != nil {
+		return balanceIncrease, nil, err
+	}
+
+	var erc20Bool ERC20Bool
+	err = e.ABI.UnpackIntoInterface(&erc20Bool, "transferFrom", resp.Ret)
+	if err != nil {
+		return balanceIncrease, nil, err
+	}
+	if !erc20Bool.Value {
+		return balanceIncrease, nil, fmt.Errorf("transferFrom executed but returned success=false")
+	}
+
+	recipientBalanceAfter, err := e.BalanceOf(erc20Contract, recipient, ctx, evmObj)
+	if err != nil {
+		return balanceIncrease, nil, sdkioerrors.Wrap(err, "failed to retrieve recipient balance")
+	}
+
+	// As with "Transfer", the amount received may differ from "amount".
+	balanceIncrease = new(big.Int).Sub(recipientBalanceAfter, recipientBalanceBefore)
+	if balanceIncrease.Sign() <= 0 {
+		return balanceIncrease, nil, fmt.Errorf(
+			"amount of ERC20 tokens received MUST be positive: the balance of recipient %s would've changed by %v for token %s",
+			recipient.Hex(), balanceIncrease.String(), erc20Contract.Hex(),
+		)
+	}
+
+	return balanceIncrease, resp, err
+}
+
 // BalanceOf retrieves the balance of an ERC20 token for a specific account.
 // Implements "ERC20.balanceOf".
 func (e erc20Calls) BalanceOf(
@@ -175,6 +230,44 @@ func (e erc20Calls) Burn(
 	return e.CallContractWithInput(ctx, evmObj, sender, &erc20Contract, false /*commit*/, contractInput, getCallGasWithLimit(ctx, Erc20GasLimitExecute))
 }
 
+/*
+SetName implements "ERC20MinterWithMetadataUpdates.setName"
+
+	```solidity
+	/// @dev Allows the owner to update the name of the token.
+	function setName(string memory name_) public onlyOwner {
+	```
+*/
+func (e erc20Calls) SetName(
+	erc20Contract, sender gethcommon.Address, name string,
+	ctx sdk.Context, evmObj *vm.EVM,
+) (evmResp *evm.MsgEthereumTxResponse, err error) {
+	contractInput, err := e.ABI.Pack("setName", name)
+	if err != nil {
+		return nil, err
+	}
+	return e.CallContractWithInput(ctx, evmObj, sender, &erc20Contract, false /*commit*/, contractInput, getCallGasWithLimit(ctx, Erc20GasLimitExecute))
+}
+
+/*
+SetSymbol implements "ERC20MinterWithMetadataUpdates.setSymbol"
+
+	```solidity
+	/// @dev Allows the owner to update the symbol of the token.
+	function setSymbol(string memory symbol_) public onlyOwner {
+	```
+*/
+func (e erc20Calls) SetSymbol(
+	erc20Contract, sender gethcommon.Address, symbol string,
+	ctx sdk.Context, evmObj *vm.EVM,
+) (evmResp *evm.MsgEthereumTxResponse, err error) {
+	contractInput, err := e.ABI.Pack("setSymbol", symbol)
+	if err != nil {
+		return nil, err
+	}
+	return e.CallContractWithInput(ctx, evmObj, sender, &erc20Contract, false /*commit*/, contractInput, getCallGasWithLimit(ctx, Erc20GasLimitExecute))
+}
+
 func (e erc20Calls) LoadERC20Name(
 	ctx sdk.Context, evmObj *vm.EVM, abi *gethabi.ABI, erc20 gethcommon.Address,
 ) (out string, err error) {
@@ -0,0 +1,141 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+)
+
+// ERC721 returns a mutable reference to the keeper with an ERC-721 contract
+// ABI and Go functions corresponding to contract calls in the ERC-721
+// standard like "ownerOf" and "transferFrom".
+func (k Keeper) ERC721() erc721Calls {
+	return erc721Calls{
+		Keeper: &k,
+		ABI:    embeds.SmartContract_ERC721.ABI,
+	}
+}
+
+type erc721Calls struct {
+	*Keeper
+	ABI *gethabi.ABI
+}
+
+// ERC721Address: Unpacking type for "address" from Solidity. This is only used
+// in the "ERC721.ownerOf" function.
+type ERC721Address struct{ Value gethcommon.Address }
+
+// OwnerOf returns the owner of an ERC-721 token. Implements "ERC721.ownerOf".
+func (e erc721Calls) OwnerOf(
+	erc721Contract gethcommon.Address, tokenID *big.Int,
+	ctx sdk.Context, evmObj *vm.EVM,
+) (owner gethcommon.Address, err error) {
+	input, err := e.ABI.Pack("ownerOf", tokenID)
+	if err != nil {
+		return owner, err
+	}
+	evmResp, err := e.CallContractWithInput(
+		ctx, evmObj, evm.EVM_MODULE_ADDRESS, &erc721Contract, false /*commit*/, input,
+		getCallGasWithLimit(ctx, Erc20GasLimitQuery),
+	)
+	if err != nil {
+		return owner, err
+	}
+	out := new(ERC721Address)
+	if err := e.ABI.UnpackIntoInterface(out, "ownerOf", evmResp.Ret); err != nil {
+		return owner, err
+	}
+	return out.Value, nil
+}
+
+/*
+TransferFrom implements "ERC721.transferFrom" and checks that "recipient"
+owns the token after the call.
+
+	```solidity
+	/// @notice Transfers `tokenId` token from `from` to `to`.
+	/// @dev The caller must own the token or be approved to move it.
+	function transferFrom(address from, address to, uint256 tokenId) external;
+	```
+*/
+func (e erc721Calls) TransferFrom(
+	erc721Contract, sender, from, recipient gethcommon.Address, tokenID *big.Int,
+	ctx sdk.Context, evmObj *vm.EVM,
+) (evmResp *evm.MsgEthereumTxResponse, err error) {
+	input, err := e.ABI.Pack("transferFrom", from, recipient, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	evmResp, err = e.CallContractWithInput(
+		ctx, evmObj, sender, &erc721Contract, false /*commit*/, input,
+		getCallGasWithLimit(ctx, Erc20GasLimitExecute),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like with ERC20 transfers, the contract is not trusted to do what the
+	// call says.
+	owner, err := e.OwnerOf(erc721Contract, tokenID, ctx, evmObj)
+	if err != nil {
+		return nil, err
+	}
+	if owner != recipient {
+		return nil, fmt.Errorf(
+			"ERC-721 token %s of %s MUST be owned by the recipient %s after the transfer, but it is owned by %s",
+			tokenID, erc721Contract.Hex(), recipient.Hex(), owner.Hex(),
+		)
+	}
+	return evmResp, nil
+}
+
+func (e erc721Calls) LoadERC721Name(
+	ctx sdk.Context, evmObj *vm.EVM, erc721 gethcommon.Address,
+) (out string, err error) {
+	return e.loadERC721String(ctx, evmObj, erc721, "name")
+}
+
+func (e erc721Calls) LoadERC721Symbol(
+	ctx sdk.Context, evmObj *vm.EVM, erc721 gethcommon.Address,
+) (out string, err error) {
+	return e.loadERC721String(ctx, evmObj, erc721, "symbol")
+}
+
+func (e erc721Calls) LoadERC721TokenURI(
+	ctx sdk.Context, evmObj *vm.EVM, erc721 gethcommon.Address, tokenID *big.Int,
+) (out string, err error) {
+	return e.loadERC721String(ctx, evmObj, erc721, "tokenURI", tokenID)
+}
+
+func (e erc721Calls) loadERC721String(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	erc721Contract gethcommon.Address,
+	methodName string,
+	args ...any,
+) (out string, err error) {
+	input, err := e.ABI.Pack(methodName, args...)
+	if err != nil {
+		return out, err
+	}
+	evmResp, err := e.CallContractWithInput(
+		ctx, evmObj, evm.EVM_MODULE_ADDRESS, &erc721Contract, false /*commit*/, input,
+		getCallGasWithLimit(ctx, Erc20GasLimitQuery),
+	)
+	if err != nil {
+		return out, err
+	}
+	val := new(ERC20String)
+	if err := e.ABI.UnpackIntoInterface(val, methodName, evmResp.Ret); err != nil {
+		return out, fmt.Errorf("failed to decode response for method %s: %w", methodName, err)
+	}
+	return val.Value, nil
+}
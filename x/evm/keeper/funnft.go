@@ -0,0 +1,216 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// GetFunNFTByClassID returns the FunNFT mapping of an "x/nft" class.
+//
+// Only classes created for an ERC-721 collection with [Keeper.CreateFunNFT]
+// have a mapping. Classes created in the NFT Module can't be converted to
+// ERC-721 tokens: that needs an ERC-721 contract owned by the EVM module that
+// mints and burns the tokens, like the ERC20 of a FunToken created from a bank
+// coin ("IsMadeFromCoin"), which FunNFT mappings don't support.
+func (k Keeper) GetFunNFTByClassID(
+	ctx sdk.Context, classID string,
+) (funnft evm.FunNFT, err error) {
+	funnft, found := k.FunNFTs.GetByClassID(ctx, classID)
+	if found {
+		return funnft, nil
+	}
+	if k.nftKeeper.HasClass(ctx, classID) {
+		return funnft, fmt.Errorf(
+			"no FunNFT mapping exists for nft class \"%s\": only classes created from an ERC721 with a FunNFT mapping can be converted", classID,
+		)
+	}
+	return funnft, fmt.Errorf("no FunNFT mapping exists for nft class \"%s\"", classID)
+}
+
+// createFunNFTFromERC721 creates a new FunNFT mapping from an existing ERC-721
+// collection.
+//
+// This function performs the following steps:
+//  1. Checks if the ERC-721 collection is already registered as a FunNFT.
+//  2. Retrieves the optional metadata of the ERC-721 collection.
+//  3. Verifies that the corresponding "x/nft" class is not already registered.
+//  4. Saves the "x/nft" class in the NFT Module.
+//  5. Creates and inserts the new FunNFT mapping.
+func (k *Keeper) createFunNFTFromERC721(
+	ctx sdk.Context, erc721 gethcommon.Address,
+) (funnft *evm.FunNFT, err error) {
+	// 1 | ERC-721 already registered with FunNFT?
+	if _, found := k.FunNFTs.GetByERC721(ctx, erc721); found {
+		return nil, fmt.Errorf("funnft mapping already created for ERC721 \"%s\"", erc721)
+	}
+	if acc := k.GetAccount(ctx, erc721); acc == nil || !acc.IsContract() {
+		return nil, fmt.Errorf("ERC721 \"%s\" is not a contract", erc721)
+	}
+
+	// 2 | Get the ERC-721 metadata, which is an optional extension of the
+	// standard.
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash())))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+	evmMsg := core.Message{
+		To:               &erc721,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            evm.Big0, // amount
+		GasLimit:         0,
+		GasPrice:         evm.Big0,
+		GasFeeCap:        evm.Big0,
+		GasTipCap:        evm.Big0,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		SkipNonceChecks:  false,
+		SkipFromEOACheck: false,
+	}
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil, stateDB)
+	name, _ := k.ERC721().LoadERC721Name(ctx, evmObj, erc721)
+	symbol, _ := k.ERC721().LoadERC721Symbol(ctx, evmObj, erc721)
+
+	// 3 | Class already registered?
+	classID := evm.FunNFTClassID(erc721)
+	if k.nftKeeper.HasClass(ctx, classID) {
+		return nil, fmt.Errorf("nft class already registered with ID \"%s\"", classID)
+	}
+	if _, found := k.FunNFTs.GetByClassID(ctx, classID); found {
+		return nil, fmt.Errorf("funnft mapping already created for nft class \"%s\"", classID)
+	}
+
+	// 4 | Save the "x/nft" class
+	if err := k.nftKeeper.SaveClass(ctx, nft.Class{
+		Id:     classID,
+		Name:   name,
+		Symbol: symbol,
+		Description: fmt.Sprintf(
+			"ERC721 collection \"%s\" represented as an NFT class with a corresponding FunNFT mapping", erc721.String(),
+		),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to save nft class: %w", err)
+	}
+
+	// 5 | Officially create the funnft mapping
+	mapping := evm.NewFunNFT(erc721)
+	funnft = &mapping
+
+	err = stateDB.Commit()
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	return funnft, k.FunNFTs.SafeInsert(ctx, mapping)
+}
+
+// SendERC721ToNFT transfers the ERC-721 token "tokenID" of "funnft" from
+// "sender" to the EVM module account, where it stays in escrow, and mints the
+// "x/nft" token of the same ID to "to". The token URI of the ERC-721 token, if
+// any, is copied to the "x/nft" token.
+//
+// The caller must commit the [statedb.StateDB] of "evmObj". The returned
+// [evm.MsgEthereumTxResponse] holds the logs of the ERC-721 transfer.
+func (k *Keeper) SendERC721ToNFT(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	funnft evm.FunNFT,
+	sender gethcommon.Address,
+	to gethcommon.Address,
+	tokenID *big.Int,
+) (nftID string, evmResp *evm.MsgEthereumTxResponse, err error) {
+	if tokenID == nil || tokenID.Sign() < 0 {
+		return nftID, nil, fmt.Errorf("invalid ERC721 token ID %s", tokenID)
+	}
+
+	// Sender transfers the ERC-721 token to the EVM module account
+	erc721 := funnft.Erc721Addr.Address
+	evmResp, err = k.ERC721().TransferFrom(
+		erc721,                 /*erc721*/
+		sender,                 /*sender*/
+		sender,                 /*from*/
+		evm.EVM_MODULE_ADDRESS, /*to*/
+		tokenID,
+		ctx,
+		evmObj,
+	)
+	if err != nil {
+		return nftID, nil, fmt.Errorf(
+			"error in ERC721.transferFrom from caller to EVM account: %w: from %s, erc721 %s, token ID %s",
+			err, sender, erc721, tokenID,
+		)
+	}
+
+	// The token URI is part of the optional metadata extension
+	uri, _ := k.ERC721().LoadERC721TokenURI(ctx, evmObj, erc721, tokenID)
+
+	nftID = tokenID.String()
+	if err := k.nftKeeper.Mint(ctx, nft.NFT{
+		ClassId: funnft.ClassId,
+		Id:      nftID,
+		Uri:     uri,
+	}, eth.EthAddrToNibiruAddr(to)); err != nil {
+		return nftID, nil, fmt.Errorf("failed to mint nft: %w", err)
+	}
+	return nftID, evmResp, nil
+}
+
+// SendNFTToERC721 burns the "x/nft" token "nftID" of "funnft" owned by
+// "sender" and transfers the ERC-721 token of the same ID out of the escrow of
+// the EVM module account to "to".
+//
+// The caller must commit the [statedb.StateDB] of "evmObj". The returned
+// [evm.MsgEthereumTxResponse] holds the logs of the ERC-721 transfer.
+func (k *Keeper) SendNFTToERC721(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	funnft evm.FunNFT,
+	sender sdk.AccAddress,
+	nftID string,
+	to gethcommon.Address,
+) (evmResp *evm.MsgEthereumTxResponse, err error) {
+	tokenID, err := evm.FunNFTTokenID(nftID)
+	if err != nil {
+		return nil, err
+	}
+	if owner := k.nftKeeper.GetOwner(ctx, funnft.ClassId, nftID); !owner.Equals(sender) {
+		return nil, fmt.Errorf("nft \"%s\" of class \"%s\" is not owned by %s", nftID, funnft.ClassId, sender)
+	}
+
+	// The "x/nft" token was minted when the ERC-721 token got escrowed, so it
+	// is burned when the ERC-721 token leaves the escrow.
+	if err := k.nftKeeper.Burn(ctx, funnft.ClassId, nftID); err != nil {
+		return nil, fmt.Errorf("failed to burn nft: %w", err)
+	}
+
+	erc721 := funnft.Erc721Addr.Address
+	evmResp, err = k.ERC721().TransferFrom(
+		erc721,                 /*erc721*/
+		evm.EVM_MODULE_ADDRESS, /*sender*/
+		evm.EVM_MODULE_ADDRESS, /*from*/
+		to,                     /*to*/
+		tokenID,
+		ctx,
+		evmObj,
+	)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to transfer ERC721 token")
+	}
+	return evmResp, nil
+}
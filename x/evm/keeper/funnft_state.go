@@ -0,0 +1,102 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdkcodec "github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+// FunNFTState isolates the key-value stores (collections) for non-fungible
+// token mappings. This struct is written as an extension of the default
+// indexed map to add utility functions.
+type FunNFTState struct {
+	collections.IndexedMap[[]byte, evm.FunNFT, IndexesFunNFT]
+}
+
+func NewFunNFTState(
+	cdc sdkcodec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+) FunNFTState {
+	primaryKeyEncoder := eth.KeyEncoderBytes
+	valueEncoder := collections.ProtoValueEncoder[evm.FunNFT](cdc)
+	idxMap := collections.NewIndexedMap(
+		storeKey, evm.KeyPrefixFunNFTs, primaryKeyEncoder, valueEncoder,
+		IndexesFunNFT{
+			ERC721Addr: collections.NewMultiIndex(
+				storeKey, evm.KeyPrefixFunNFTIdxErc721,
+				eth.KeyEncoderEthAddr, //  indexing key (IK): ERC-721 addr
+				primaryKeyEncoder,
+				func(v evm.FunNFT) gethcommon.Address {
+					return v.Erc721Addr.Address
+				},
+			),
+			ClassID: collections.NewMultiIndex(
+				storeKey, evm.KeyPrefixFunNFTIdxClassID,
+				collections.StringKeyEncoder, //  indexing key (IK): Class ID
+				primaryKeyEncoder,
+				func(v evm.FunNFT) string { return v.ClassId },
+			),
+		},
+	)
+	return FunNFTState{IndexedMap: idxMap}
+}
+
+func (idxs IndexesFunNFT) IndexerList() []collections.Indexer[[]byte, evm.FunNFT] {
+	return []collections.Indexer[[]byte, evm.FunNFT]{
+		idxs.ERC721Addr,
+		idxs.ClassID,
+	}
+}
+
+// IndexesFunNFT: Abstraction for indexing over the FunNFT store.
+type IndexesFunNFT struct {
+	// ERC721Addr (MultiIndex): Index FunNFT by ERC-721 contract address.
+	//  - indexing key (IK): ERC-721 addr
+	//  - primary key (PK): FunNFT ID
+	//  - value (V): FunNFT value
+	ERC721Addr collections.MultiIndex[gethcommon.Address, []byte, evm.FunNFT]
+
+	// ClassID (MultiIndex): Index FunNFT by "x/nft" class ID
+	//  - indexing key (IK): Class ID
+	//  - primary key (PK): FunNFT ID
+	//  - value (V): FunNFT value
+	ClassID collections.MultiIndex[string, []byte, evm.FunNFT]
+}
+
+// SafeInsert adds an [evm.FunNFT] to state with defensive validation. Errors
+// if the mapping is invalid.
+func (fun FunNFTState) SafeInsert(ctx sdk.Context, funnft evm.FunNFT) error {
+	if err := funnft.Validate(); err != nil {
+		return err
+	}
+	fun.Insert(ctx, funnft.ID(), funnft)
+	return nil
+}
+
+// GetByERC721 returns the [evm.FunNFT] mapping of an ERC-721 collection.
+func (fun FunNFTState) GetByERC721(
+	ctx sdk.Context, erc721 gethcommon.Address,
+) (funnft evm.FunNFT, found bool) {
+	funnfts := fun.Collect(ctx, fun.Indexes.ERC721Addr.ExactMatch(ctx, erc721))
+	if len(funnfts) != 1 {
+		return funnft, false
+	}
+	return funnfts[0], true
+}
+
+// GetByClassID returns the [evm.FunNFT] mapping of an "x/nft" class.
+func (fun FunNFTState) GetByClassID(
+	ctx sdk.Context, classID string,
+) (funnft evm.FunNFT, found bool) {
+	funnfts := fun.Collect(ctx, fun.Indexes.ClassID.ExactMatch(ctx, classID))
+	if len(funnfts) != 1 {
+		return funnft, false
+	}
+	return funnfts[0], true
+}
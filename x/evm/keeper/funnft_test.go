@@ -0,0 +1,210 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
+	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+)
+
+// testERC721Code returns hand-assembled runtime bytecode of a minimal ERC-721
+// collection, where storage slot "tokenId" holds the owner of the token. Only
+// "ownerOf" and "transferFrom" are implemented, and "transferFrom" can only be
+// called by the owner of the token. Every other call reverts, including the
+// optional metadata methods.
+func testERC721Code() []byte {
+	abi := embeds.SmartContract_ERC721.ABI
+	ownerOf := gethcommon.Bytes2Hex(abi.Methods["ownerOf"].ID)
+	transferFrom := gethcommon.Bytes2Hex(abi.Methods["transferFrom"].ID)
+	transferEvent := abi.Events["Transfer"].ID.Hex()[2:]
+	return gethcommon.FromHex("0x" +
+		// 0x00: selector := SHR(0xe0, CALLDATALOAD(0))
+		"600035" + "60e01c" +
+		// 0x06: JUMPI(0x20, selector == ownerOf)
+		"80" + "63" + ownerOf + "14" + "610020" + "57" +
+		// 0x11: JUMPI(0x2d, selector == transferFrom), else REVERT(0, 0)
+		"63" + transferFrom + "14" + "61002d" + "57" + "60006000fd" +
+		// 0x20: ownerOf: RETURN SLOAD(tokenId)
+		"5b" + "600435" + "54" + "600052" + "60206000f3" +
+		// 0x2d: transferFrom: from, to, tokenId := CALLDATALOAD(4, 0x24, 0x44)
+		"5b" + "600435" + "602435" + "604435" +
+		// 0x37: JUMPI(0x48, SLOAD(tokenId) == from && CALLER == from), else
+		// REVERT(0, 0)
+		"80" + "54" + "83" + "14" + "33" + "84" + "14" + "16" + "610048" + "57" + "60006000fd" +
+		// 0x48: SSTORE(tokenId, to), LOG4(0, 0, Transfer, from, to, tokenId)
+		"5b" + "81" + "81" + "55" + "80" + "82" + "84" + "7f" + transferEvent + "6000" + "6000" + "a4" + "00",
+	)
+}
+
+// TestFunNFT tests the creation of a FunNFT mapping and the conversion of an
+// ERC-721 token to its "x/nft" representation and back, with both the
+// messages and the FunToken precompile.
+func (s *Suite) TestFunNFT() {
+	deps := evmtest.NewTestDeps()
+	erc721Addr := gethcommon.BytesToAddress([]byte("test-erc721"))
+	classID := evm.FunNFTClassID(erc721Addr)
+	otherAcc := testutil.AccAddress()
+
+	s.T().Log("Deploy ERC721 with tokens 42 and 7, owned by the sender and another account")
+	stateDB := deps.NewStateDB()
+	stateDB.SetCode(erc721Addr, testERC721Code())
+	stateDB.SetState(erc721Addr, gethcommon.BigToHash(big.NewInt(42)), gethcommon.BytesToHash(deps.Sender.EthAddr.Bytes()))
+	stateDB.SetState(erc721Addr, gethcommon.BigToHash(big.NewInt(7)), gethcommon.BytesToHash(eth.NibiruAddrToEthAddr(otherAcc).Bytes()))
+	s.Require().NoError(stateDB.Commit())
+
+	ownerOf := func(tokenID int64) gethcommon.Address {
+		evmObj, _ := deps.NewEVM()
+		owner, err := deps.EvmKeeper.ERC721().OwnerOf(erc721Addr, big.NewInt(tokenID), deps.Ctx, evmObj)
+		s.Require().NoError(err)
+		return owner
+	}
+	s.Require().Equal(deps.Sender.EthAddr, ownerOf(42))
+
+	s.Run("sad: create FunNFT without the fee", func() {
+		_, err := deps.EvmKeeper.CreateFunNFT(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgCreateFunNFT{FromErc721: eth.EIP55Addr{Address: erc721Addr}, Sender: deps.Sender.NibiruAddr.String()},
+		)
+		s.Require().ErrorContains(err, "create_fun_token_fee")
+	})
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx).MulInt(sdkmath.NewInt(3)),
+	))
+
+	s.Run("sad: create FunNFT for an account that isn't a contract", func() {
+		_, err := deps.EvmKeeper.CreateFunNFT(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgCreateFunNFT{FromErc721: eth.EIP55Addr{Address: evmtest.NewEthPrivAcc().EthAddr}, Sender: deps.Sender.NibiruAddr.String()},
+		)
+		s.Require().ErrorContains(err, "is not a contract")
+	})
+
+	s.T().Log("Create FunNFT")
+	createResp, err := deps.EvmKeeper.CreateFunNFT(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunNFT{FromErc721: eth.EIP55Addr{Address: erc721Addr}, Sender: deps.Sender.NibiruAddr.String()},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(evm.NewFunNFT(erc721Addr), createResp.FunnftMapping)
+	s.Require().True(deps.App.NFTKeeper.HasClass(deps.Ctx, classID))
+	for _, token := range []string{classID, erc721Addr.Hex()} {
+		queryResp, err := deps.EvmKeeper.FunNFTMapping(
+			sdk.WrapSDKContext(deps.Ctx), &evm.QueryFunNFTMappingRequest{Token: token},
+		)
+		s.Require().NoError(err)
+		s.Require().Equal(createResp.FunnftMapping, *queryResp.FunNft)
+	}
+
+	s.Run("sad: create FunNFT twice", func() {
+		_, err := deps.EvmKeeper.CreateFunNFT(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgCreateFunNFT{FromErc721: eth.EIP55Addr{Address: erc721Addr}, Sender: deps.Sender.NibiruAddr.String()},
+		)
+		s.Require().ErrorContains(err, "funnft mapping already created")
+	})
+
+	convertEvmToNFT := func(tokenID int64) (*evm.MsgConvertEvmToNFTResponse, error) {
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		return deps.EvmKeeper.ConvertEvmToNFT(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToNFT{
+				Sender:     deps.Sender.NibiruAddr.String(),
+				Erc721Addr: eth.EIP55Addr{Address: erc721Addr},
+				TokenId:    sdkmath.NewInt(tokenID),
+				ToAddr:     otherAcc.String(),
+			},
+		)
+	}
+	convertNFTToEvm := func(sender sdk.AccAddress, nftID string) error {
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := deps.EvmKeeper.ConvertNFTToEvm(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertNFTToEvm{
+				Sender:    sender.String(),
+				ClassId:   classID,
+				NftId:     nftID,
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			},
+		)
+		return err
+	}
+
+	s.Run("sad: convert a token of an x/nft class without a FunNFT mapping", func() {
+		bornClassID := "born-in-x-nft"
+		s.Require().NoError(deps.App.NFTKeeper.SaveClass(deps.Ctx, nft.Class{Id: bornClassID}))
+		_, err := deps.EvmKeeper.ConvertNFTToEvm(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertNFTToEvm{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				ClassId:   bornClassID,
+				NftId:     "1",
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			},
+		)
+		s.Require().ErrorContains(err, "only classes created from an ERC721")
+	})
+
+	s.Run("sad: convert an ERC721 token owned by another account", func() {
+		_, err := convertEvmToNFT(7)
+		s.Require().ErrorContains(err, "ERC721.transferFrom")
+	})
+
+	s.T().Log("Convert ERC721 token 42 to an x/nft token")
+	convertResp, err := convertEvmToNFT(42)
+	s.Require().NoError(err)
+	s.Require().Equal(classID, convertResp.ClassId)
+	s.Require().Equal("42", convertResp.NftId)
+	s.Require().Equal(evm.EVM_MODULE_ADDRESS, ownerOf(42))
+	s.Require().Equal(otherAcc, deps.App.NFTKeeper.GetOwner(deps.Ctx, classID, "42"))
+
+	s.Run("sad: convert an x/nft token owned by another account", func() {
+		s.Require().ErrorContains(convertNFTToEvm(deps.Sender.NibiruAddr, "42"), "is not owned by")
+	})
+
+	s.T().Log("Convert x/nft token 42 back to the ERC721 token")
+	s.Require().NoError(convertNFTToEvm(otherAcc, "42"))
+	s.Require().Equal(deps.Sender.EthAddr, ownerOf(42))
+	s.Require().False(deps.App.NFTKeeper.HasNFT(deps.Ctx, classID, "42"))
+
+	s.T().Log("Precompile: sendNftToBank and sendNftToEvm")
+	callPrecompile := func(method string, args ...any) []any {
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(method, args...)
+		s.Require().NoError(err)
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_FunToken, true /*commit*/, contractInput, keeper.Erc20GasLimitExecute,
+		)
+		s.Require().NoError(err, fmt.Sprintf("%s: %s", method, resp.VmError))
+		out, err := embeds.SmartContract_FunToken.ABI.Unpack(method, resp.Ret)
+		s.Require().NoError(err)
+		return out
+	}
+
+	out := callPrecompile("sendNftToBank", erc721Addr, big.NewInt(42), deps.Sender.NibiruAddr.String())
+	s.Require().Equal("42", out[0])
+	s.Require().Equal(evm.EVM_MODULE_ADDRESS, ownerOf(42))
+	s.Require().Equal(deps.Sender.NibiruAddr, deps.App.NFTKeeper.GetOwner(deps.Ctx, classID, "42"))
+
+	out = callPrecompile("sendNftToEvm", classID, "42", deps.Sender.EthAddr.Hex())
+	s.Require().Equal(big.NewInt(42), out[0])
+	s.Require().Equal(deps.Sender.EthAddr, ownerOf(42))
+	s.Require().False(deps.App.NFTKeeper.HasNFT(deps.Ctx, classID, "42"))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+	"github.com/NibiruChain/nibiru/v2/x/evm/statedb"
+)
+
+// GetFunTokenEscrow compares the ERC20 tokens escrowed by the EVM module
+// account for a FunToken created from an ERC20 with the supply of its Bank
+// Coin. See [evm.FunTokenEscrow].
+func (k *Keeper) GetFunTokenEscrow(
+	ctx sdk.Context, evmObj *vm.EVM, funtoken evm.FunToken,
+) (escrow evm.FunTokenEscrow, err error) {
+	escrowBal, err := k.funTokenEscrowBalance(ctx, evmObj, funtoken)
+	if err != nil {
+		return escrow, err
+	}
+	bankSupply := k.Bank.GetSupply(ctx, funtoken.BankDenom).Amount.BigInt()
+	return evm.NewFunTokenEscrow(funtoken, escrowBal, bankSupply), nil
+}
+
+// CheckFunTokenEscrow checks the supply invariant of a FunToken created from
+// an ERC20: the ERC20 tokens escrowed by the EVM module account must be worth
+// at least the supply of the Bank Coin. Dust and fees left in escrow only make
+// the escrow worth more. A rebasing FunToken is pro-rated against its escrow,
+// so it only needs some escrow to back its supply.
+//
+// An escrow that shrank on its own breaks the invariant of a FunToken that
+// isn't flagged as rebasing, which blocks its conversions until the mapping is
+// reconciled with [Keeper.ReconcileFunTokenEscrow].
+func (k *Keeper) CheckFunTokenEscrow(
+	ctx sdk.Context, evmObj *vm.EVM, funtoken evm.FunToken,
+) error {
+	if funtoken.IsMadeFromCoin {
+		return nil
+	}
+	escrow, err := k.GetFunTokenEscrow(ctx, evmObj, funtoken)
+	if err != nil {
+		return err
+	}
+	if !escrow.InvariantHolds {
+		return fmt.Errorf(
+			"FunToken supply invariant broken: %s escrowed ERC20 tokens of %s are worth less than the %s%s supply",
+			escrow.EscrowBalance, funtoken.Erc20Addr.Hex(), escrow.BankSupply, funtoken.BankDenom,
+		)
+	}
+	return nil
+}
+
+// ReconcileFunTokenEscrowDrift sets whether a FunToken created from an ERC20
+// is rebasing and saves the mapping. A mapping whose escrow shrank on its own
+// is flagged as rebasing regardless of "isRebasing", since its Bank Coins can
+// only be converted back pro rata. Returns the saved mapping and its escrow.
+// Callers are responsible for permission checks.
+func (k *Keeper) ReconcileFunTokenEscrowDrift(
+	ctx sdk.Context, funtoken evm.FunToken, isRebasing bool,
+) (evm.FunToken, evm.FunTokenEscrow, error) {
+	evmObj := k.newFunTokenEscrowEVM(ctx, funtoken)
+	escrow, err := k.GetFunTokenEscrow(ctx, evmObj, funtoken)
+	if err != nil {
+		return funtoken, escrow, err
+	}
+	funtoken.IsRebasing = isRebasing || escrow.Drift.IsNegative()
+	escrow = evm.NewFunTokenEscrow(funtoken, escrow.EscrowBalance.BigInt(), escrow.BankSupply.BigInt())
+	if err := k.FunTokens.SafeInsertFunToken(ctx, funtoken); err != nil {
+		return funtoken, escrow, err
+	}
+	return funtoken, escrow, nil
+}
+
+// FunTokenBankToERC20 converts an amount of the Bank Coin of a FunToken to
+// the ERC20 tokens it's worth. Rebasing FunTokens are pro-rated against the
+// escrow, so the converted coins must still count toward the Bank Coin supply,
+// meaning they can't be burned yet.
+func (k *Keeper) FunTokenBankToERC20(
+	ctx sdk.Context, evmObj *vm.EVM, funtoken evm.FunToken, bankAmount *big.Int,
+) (*big.Int, error) {
+	if !funtoken.IsRebasing {
+		return funtoken.BankToERC20Amount(bankAmount), nil
+	}
+	escrowBal, err := k.funTokenEscrowBalance(ctx, evmObj, funtoken)
+	if err != nil {
+		return nil, err
+	}
+	bankSupply := k.Bank.GetSupply(ctx, funtoken.BankDenom).Amount.BigInt()
+	return funtoken.BankSharesToERC20(bankAmount, escrowBal, bankSupply), nil
+}
+
+func (k *Keeper) funTokenEscrowBalance(
+	ctx sdk.Context, evmObj *vm.EVM, funtoken evm.FunToken,
+) (*big.Int, error) {
+	escrowBal, err := k.ERC20().BalanceOf(funtoken.Erc20Addr.Address, evm.EVM_MODULE_ADDRESS, ctx, evmObj)
+	if err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to retrieve escrowed ERC20 balance")
+	}
+	return escrowBal, nil
+}
+
+// newFunTokenEscrowEVM returns an EVM to read the escrow of a FunToken outside
+// of a transaction that converts it. Its state DB isn't meant to be committed.
+func (k *Keeper) newFunTokenEscrowEVM(ctx sdk.Context, funtoken evm.FunToken) *vm.EVM {
+	erc20 := funtoken.Erc20Addr.Address
+	evmMsg := core.Message{
+		To:               &erc20,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            evm.Big0, // amount
+		GasLimit:         0,
+		GasPrice:         evm.Big0,
+		GasFeeCap:        evm.Big0,
+		GasTipCap:        evm.Big0,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		SkipNonceChecks:  false,
+		SkipFromEOACheck: false,
+	}
+	stateDB := k.NewStateDB(ctx, statedb.NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash())))
+	return k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil, stateDB)
+}
@@ -127,3 +127,58 @@ func (k *Keeper) deployERC20ForBankCoin(
 
 	return erc20Addr, nil
 }
+
+// UpdateFunTokenMetadata propagates new bank metadata of a Bank Coin to the
+// name and symbol of the ERC20 that the EVM module deployed for its FunToken
+// mapping. Coins without a FunToken mapping, or with one created from an
+// ERC20, are left alone since the EVM module doesn't own those contracts. The
+// decimals are never updated because that would change the value of existing
+// ERC20 balances.
+func (k *Keeper) UpdateFunTokenMetadata(
+	ctx sdk.Context, bankMetadata bank.Metadata,
+) error {
+	funtokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankMetadata.Base))
+	if len(funtokens) != 1 || !funtokens[0].IsMadeFromCoin {
+		return nil
+	}
+	erc20Addr := funtokens[0].Erc20Addr.Address
+
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc20Addr,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            unusedBigInt, // amount
+		GasLimit:         Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+
+	if _, err := k.ERC20().SetName(erc20Addr, evm.EVM_MODULE_ADDRESS, bankMetadata.Name, ctx, evmObj); err != nil {
+		return sdkioerrors.Wrapf(err, "failed to update the name of ERC20 %s", erc20Addr.Hex())
+	}
+	if _, err := k.ERC20().SetSymbol(erc20Addr, evm.EVM_MODULE_ADDRESS, bankMetadata.Symbol, ctx, evmObj); err != nil {
+		return sdkioerrors.Wrapf(err, "failed to update the symbol of ERC20 %s", erc20Addr.Hex())
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 	"github.com/NibiruChain/nibiru/v2/x/evm/keeper"
 	"github.com/NibiruChain/nibiru/v2/x/evm/precompile"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
 )
 
 func (s *FunTokenFromCoinSuite) TestCreateFunTokenFromCoin() {
@@ -335,6 +336,97 @@ func (s *FunTokenFromCoinSuite) TestConvertCoinToEvmAndBack() {
 	s.Require().NotZero(deps.Ctx.GasMeter().GasConsumed())
 }
 
+// TestConvertEvmToCoin tests converting ERC20 tokens back to bank coins with
+// the Cosmos "MsgConvertEvmToCoin" instead of the FunToken precompile.
+func (s *FunTokenFromCoinSuite) TestConvertEvmToCoin() {
+	deps := evmtest.NewTestDeps()
+	alice := evmtest.NewEthPrivAcc()
+
+	// Initial setup
+	funToken := s.fundAndCreateFunToken(deps, 100)
+
+	s.T().Log("Convert bank coin to erc-20 held by the sender's EVM address")
+	_, err := deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(10)),
+			ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+		},
+	)
+	s.Require().NoError(err)
+
+	s.Run("sad: no FunToken mapping", func() {
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: eth.EIP55Addr{Address: alice.EthAddr},
+				Amount:    sdk.NewInt(10),
+				ToAddr:    alice.NibiruAddr.String(),
+			},
+		)
+		s.Require().ErrorContains(err, "no FunToken mapping exists")
+	})
+
+	s.Run("sad: insufficient erc-20 balance", func() {
+		_, err := deps.EvmKeeper.ConvertEvmToCoin(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertEvmToCoin{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				Erc20Addr: funToken.Erc20Addr,
+				Amount:    sdk.NewInt(11),
+				ToAddr:    alice.NibiruAddr.String(),
+			},
+		)
+		s.Require().ErrorContains(err, "transfer amount exceeds balance")
+	})
+
+	s.T().Log("Convert erc-20 to bank coin sent to alice")
+	deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	resp, err := deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: funToken.Erc20Addr,
+			Amount:    sdk.NewInt(4),
+			ToAddr:    alice.EthAddr.Hex(),
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(4)), resp.BankCoin)
+	s.Require().NotZero(deps.Ctx.GasMeter().GasConsumed())
+
+	s.T().Log("Check typed event ConvertEvmToCoin")
+	testutil.RequireContainsTypedEvent(
+		s.T(),
+		deps.Ctx,
+		&evm.EventConvertEvmToCoin{
+			Sender:               deps.Sender.NibiruAddr.String(),
+			Erc20ContractAddress: funToken.Erc20Addr.Hex(),
+			ToAddr:               alice.NibiruAddr.String(),
+			BankCoin:             sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(4)),
+		},
+	)
+
+	// Check 1: module balance
+	moduleBalance := deps.App.BankKeeper.GetBalance(deps.Ctx, authtypes.NewModuleAddress(evm.ModuleName), evm.EVMBankDenom)
+	s.Require().Equal(sdk.NewInt(6), moduleBalance.Amount)
+
+	// Check 2: alice balance
+	aliceBalance := deps.App.BankKeeper.GetBalance(deps.Ctx, alice.NibiruAddr, evm.EVMBankDenom)
+	s.Require().Equal(sdk.NewInt(4), aliceBalance.Amount)
+
+	// Check 3: erc-20 balance and supply
+	evmObj, _ := deps.NewEVM()
+	balance, err := deps.EvmKeeper.ERC20().BalanceOf(funToken.Erc20Addr.Address, deps.Sender.EthAddr, deps.Ctx, evmObj)
+	s.Require().NoError(err)
+	s.Require().Equal("6", balance.String())
+	supply, err := deps.EvmKeeper.ERC20().TotalSupply(funToken.Erc20Addr.Address, deps.Ctx, evmObj)
+	s.Require().NoError(err)
+	s.Require().Equal("6", supply.String())
+}
+
 // TestNativeSendThenPrecompileSend tests a race condition where the state DB
 // commit may overwrite the state after the precompile execution, potentially
 // causing a loss of funds.
@@ -861,6 +953,69 @@ func (s *FunTokenFromCoinSuite) TestPrecompileSendToBankThenErc20Transfer() {
 	}.Assert(s.T(), deps, evmObj)
 }
 
+// TestUpdateFunTokenMetadata tests that updating the bank metadata of a
+// "x/tokenfactory" coin also updates the name and symbol of its ERC20, while
+// the decimals stay the same.
+func (s *FunTokenFromCoinSuite) TestUpdateFunTokenMetadata() {
+	deps := evmtest.NewTestDeps()
+
+	s.T().Log("Setup: Create a tokenfactory denom and a FunToken for it")
+	tfDenom := tftypes.TFDenom{Creator: deps.Sender.NibiruAddr.String(), Subdenom: "fun"}
+	_, err := deps.App.TokenFactoryKeeper.CreateDenom(
+		sdk.WrapSDKContext(deps.Ctx),
+		&tftypes.MsgCreateDenom{Sender: tfDenom.Creator, Subdenom: tfDenom.Subdenom},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	createResp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromBankDenom: tfDenom.Denom().String(),
+			Sender:        deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	erc20Addr := createResp.FuntokenMapping.Erc20Addr.Address
+
+	erc20Metadata := func() (name, symbol string, decimals uint8) {
+		evmObj, _ := deps.NewEVM()
+		erc20Abi := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI
+		name, err := deps.EvmKeeper.ERC20().LoadERC20Name(deps.Ctx, evmObj, erc20Abi, erc20Addr)
+		s.Require().NoError(err)
+		symbol, err = deps.EvmKeeper.ERC20().LoadERC20Symbol(deps.Ctx, evmObj, erc20Abi, erc20Addr)
+		s.Require().NoError(err)
+		decimals, err = deps.EvmKeeper.ERC20().LoadERC20Decimals(deps.Ctx, evmObj, erc20Abi, erc20Addr)
+		s.Require().NoError(err)
+		return name, symbol, decimals
+	}
+	name, symbol, decimals := erc20Metadata()
+	s.Require().Equal(tfDenom.Denom().String(), name)
+	s.Require().Equal(tfDenom.Denom().String(), symbol)
+
+	s.T().Log("Update the bank metadata with x/tokenfactory")
+	newMetadata := tfDenom.DefaultBankMetadata()
+	newMetadata.Name = "Fun Token"
+	newMetadata.Symbol = "FUN"
+	_, err = deps.App.TokenFactoryKeeper.SetDenomMetadata(
+		sdk.WrapSDKContext(deps.Ctx),
+		&tftypes.MsgSetDenomMetadata{
+			Sender:   deps.Sender.NibiruAddr.String(),
+			Metadata: newMetadata,
+		},
+	)
+	s.Require().NoError(err)
+
+	newName, newSymbol, newDecimals := erc20Metadata()
+	s.Require().Equal("Fun Token", newName)
+	s.Require().Equal("FUN", newSymbol)
+	s.Require().Equal(decimals, newDecimals)
+}
+
 // fundAndCreateFunToken creates initial setup for tests
 func (s *FunTokenFromCoinSuite) fundAndCreateFunToken(deps evmtest.TestDeps, unibiAmount int64) evm.FunToken {
 	bankDenom := evm.EVMBankDenom
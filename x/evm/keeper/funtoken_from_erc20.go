@@ -108,6 +108,8 @@ type (
 // Parameters:
 //   - ctx: The SDK context for the transaction.
 //   - erc20: The Ethereum address of the ERC20 token in HexAddr format.
+//   - scalingExponent: Number of ERC20 decimals dropped in the bank coin. See
+//     [evm.FunToken.ScalingExponent].
 //
 // Returns:
 //   - funtoken: The created FunToken mapping.
@@ -116,11 +118,12 @@ type (
 // Possible errors:
 //   - If the ERC20 token is already registered as a FunToken.
 //   - If the ERC20 metadata cannot be retrieved.
+//   - If the scaling exponent exceeds the decimals of the ERC20.
 //   - If the bank coin denom is already registered.
 //   - If the bank metadata validation fails.
 //   - If the FunToken insertion fails.
 func (k *Keeper) createFunTokenFromERC20(
-	ctx sdk.Context, erc20 gethcommon.Address,
+	ctx sdk.Context, erc20 gethcommon.Address, scalingExponent uint32,
 ) (funtoken *evm.FunToken, err error) {
 	// 1 | ERC20 already registered with FunToken?
 	if funtokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20)); len(funtokens) > 0 {
@@ -156,6 +159,12 @@ func (k *Keeper) createFunTokenFromERC20(
 	if err != nil {
 		return nil, err
 	}
+	if scalingExponent > uint32(erc20Info.Decimals) {
+		return nil, fmt.Errorf(
+			"scaling exponent %d exceeds the %d decimals of ERC20 \"%s\"",
+			scalingExponent, erc20Info.Decimals, erc20,
+		)
+	}
 
 	bankDenom := fmt.Sprintf("erc20/%s", erc20.String())
 
@@ -168,8 +177,11 @@ func (k *Keeper) createFunTokenFromERC20(
 		return nil, fmt.Errorf("funtoken mapping already created for bank denom \"%s\"", bankDenom)
 	}
 
-	// 4 | Set bank coin denom metadata in state
-	bankMetadata := erc20Info.ToBankMetadata(bankDenom, erc20)
+	// 4 | Set bank coin denom metadata in state. A scaled bank coin has fewer
+	// decimals than the ERC20.
+	bankInfo := *erc20Info
+	bankInfo.Decimals -= uint8(scalingExponent)
+	bankMetadata := bankInfo.ToBankMetadata(bankDenom, erc20)
 
 	err = bankMetadata.Validate()
 	if err != nil {
@@ -182,8 +194,9 @@ func (k *Keeper) createFunTokenFromERC20(
 		Erc20Addr: eth.EIP55Addr{
 			Address: erc20,
 		},
-		BankDenom:      bankDenom,
-		IsMadeFromCoin: false,
+		BankDenom:       bankDenom,
+		IsMadeFromCoin:  false,
+		ScalingExponent: scalingExponent,
 	}
 
 	err = stateDB.Commit()
@@ -191,9 +204,7 @@ func (k *Keeper) createFunTokenFromERC20(
 		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
 	}
 
-	return funtoken, k.FunTokens.SafeInsert(
-		ctx, erc20, bankDenom, false,
-	)
+	return funtoken, k.FunTokens.SafeInsertFunToken(ctx, *funtoken)
 }
 
 // ToBankMetadata produces the "bank.Metadata" corresponding to a FunToken
@@ -355,6 +355,163 @@ func (s *FunTokenFromErc20Suite) TestSendFromEvmToBank_MadeFromErc20() {
 	s.Require().Error(err)
 }
 
+// TestScaledFunTokenFromERC20 tests a FunToken mapping an ERC20 with 18
+// decimals to a bank coin with 6 decimals.
+func (s *FunTokenFromErc20Suite) TestScaledFunTokenFromERC20() {
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx).MulInt(sdk.NewInt(2)),
+	))
+
+	s.T().Log("Deploy ERC20")
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_ERC20MinterWithMetadataUpdates,
+		"erc20name", "TOKEN", uint8(18),
+	)
+	s.Require().NoError(err)
+	erc20Addr := deployResp.ContractAddr
+
+	s.Run("sad: scaling exponent above the ERC20 decimals", func() {
+		deployResp, err := evmtest.DeployContract(
+			&deps, embeds.SmartContract_ERC20MinterWithMetadataUpdates,
+			"erc20name", "TOKEN6", uint8(6),
+		)
+		s.Require().NoError(err)
+		_, err = deps.EvmKeeper.CreateFunToken(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgCreateFunToken{
+				FromErc20:       &eth.EIP55Addr{Address: deployResp.ContractAddr},
+				Sender:          deps.Sender.NibiruAddr.String(),
+				ScalingExponent: 12,
+			},
+		)
+		s.Require().ErrorContains(err, "exceeds the 6 decimals")
+	})
+
+	s.T().Log("CreateFunToken for the ERC20 with 12 decimals dropped")
+	resp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromErc20:       &eth.EIP55Addr{Address: erc20Addr},
+			Sender:          deps.Sender.NibiruAddr.String(),
+			ScalingExponent: 12,
+		},
+	)
+	s.Require().NoError(err)
+	funtoken := resp.FuntokenMapping
+	s.Require().EqualValues(12, funtoken.ScalingExponent)
+
+	bankMetadata, _ := deps.App.BankKeeper.GetDenomMetaData(deps.Ctx, funtoken.BankDenom)
+	s.Require().EqualValues(6, bankMetadata.DenomUnits[1].Exponent)
+
+	unit := big.NewInt(1_000_000_000_000) // 10^12 ERC20 tokens per bank coin
+	mintAmount := new(big.Int).Add(new(big.Int).Mul(big.NewInt(5), unit), big.NewInt(7))
+	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("mint", deps.Sender.EthAddr, mintAmount)
+	s.Require().NoError(err)
+	evmObj, _ := deps.NewEVM()
+	_, err = deps.EvmKeeper.CallContractWithInput(
+		deps.Ctx, evmObj, deps.Sender.EthAddr, &erc20Addr, true /*commit*/, contractInput, keeper.Erc20GasLimitExecute,
+	)
+	s.Require().NoError(err)
+
+	randomAcc := testutil.AccAddress()
+	sendToBank := func(amount *big.Int) error {
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+			"sendToBank", erc20Addr, amount, randomAcc.String(),
+		)
+		s.Require().NoError(err)
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		evmObj, _ := deps.NewEVM()
+		_, err = deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx,
+			evmObj,
+			deps.Sender.EthAddr,                 /*from*/
+			&precompile.PrecompileAddr_FunToken, /*to*/
+			true,                                /*commit*/
+			contractInput,
+			evmtest.FunTokenGasLimitSendToEvm,
+		)
+		return err
+	}
+
+	s.Run("sad: send less than one bank coin unit", func() {
+		err := sendToBank(new(big.Int).Sub(unit, big.NewInt(1)))
+		s.Require().ErrorContains(err, "smaller than one unit")
+	})
+
+	s.Run("happy: send erc20 tokens to bank, keeping the dust", func() {
+		// 3 units and 5 dust tokens
+		amount := new(big.Int).Add(new(big.Int).Mul(big.NewInt(3), unit), big.NewInt(5))
+		s.Require().NoError(sendToBank(amount))
+
+		evmObj, _ := deps.NewEVM()
+		evmtest.AssertERC20BalanceEqualWithDescription(
+			s.T(), deps, evmObj, erc20Addr, deps.Sender.EthAddr,
+			new(big.Int).Add(new(big.Int).Mul(big.NewInt(2), unit), big.NewInt(7)),
+			"sender keeps the dust",
+		)
+		evmtest.AssertERC20BalanceEqualWithDescription(
+			s.T(), deps, evmObj, erc20Addr, evm.EVM_MODULE_ADDRESS,
+			new(big.Int).Mul(big.NewInt(3), unit),
+			"escrow",
+		)
+		s.Require().Equal(sdk.NewInt(3),
+			deps.App.BankKeeper.GetBalance(deps.Ctx, randomAcc, funtoken.BankDenom).Amount,
+		)
+	})
+
+	s.Run("happy: balance view in erc20 units", func() {
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+			"balance", eth.NibiruAddrToEthAddr(randomAcc), erc20Addr,
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		evmResp, err := deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx,
+			evmObj,
+			deps.Sender.EthAddr,                 /*from*/
+			&precompile.PrecompileAddr_FunToken, /*to*/
+			false,                               /*commit*/
+			contractInput,
+			evmtest.FunTokenGasLimitSendToEvm,
+		)
+		s.Require().NoError(err)
+		out, err := embeds.SmartContract_FunToken.ABI.Unpack("balance", evmResp.Ret)
+		s.Require().NoError(err)
+		s.Require().Equal(new(big.Int).Mul(big.NewInt(3), unit).String(), out[1].(*big.Int).String())
+	})
+
+	s.Run("happy: send bank coins back to erc20", func() {
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgConvertCoinToEvm{
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+				Sender:    randomAcc.String(),
+				BankCoin:  sdk.NewCoin(funtoken.BankDenom, sdk.NewInt(2)),
+			},
+		)
+		s.Require().NoError(err)
+
+		evmObj, _ := deps.NewEVM()
+		evmtest.AssertERC20BalanceEqualWithDescription(
+			s.T(), deps, evmObj, erc20Addr, deps.Sender.EthAddr,
+			new(big.Int).Add(new(big.Int).Mul(big.NewInt(4), unit), big.NewInt(7)),
+			"sender",
+		)
+		evmtest.AssertERC20BalanceEqualWithDescription(
+			s.T(), deps, evmObj, erc20Addr, evm.EVM_MODULE_ADDRESS, unit, "escrow",
+		)
+		s.Require().Equal(sdk.NewInt(1),
+			deps.App.BankKeeper.GetBalance(deps.Ctx, randomAcc, funtoken.BankDenom).Amount,
+		)
+		s.Require().Equal(sdk.NewInt(1),
+			deps.App.BankKeeper.GetSupply(deps.Ctx, funtoken.BankDenom).Amount,
+		)
+	})
+}
+
 // TestCreateFunTokenFromERC20MaliciousName tries to create funtoken from a contract
 // with a malicious (gas intensive) name() function.
 // Fun token should fail creation with "out of gas"
@@ -679,6 +836,168 @@ func (s *FunTokenFromErc20Suite) TestFindMKRMetadata() {
 	s.Require().Equal(actualMetadata, *info)
 }
 
+// TestRebasingFunTokenFromERC20 tests the escrow of a FunToken mapping an
+// ERC20 whose escrowed balance changes on its own, simulated by the owner of
+// the ERC20 minting to and burning from the EVM module account.
+func (s *FunTokenFromErc20Suite) TestRebasingFunTokenFromERC20() {
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx,
+		deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+
+	s.T().Log("Deploy ERC20 and create FunToken")
+	deployResp, err := evmtest.DeployContract(
+		&deps, embeds.SmartContract_ERC20MinterWithMetadataUpdates,
+		"erc20name", "TOKEN", uint8(18),
+	)
+	s.Require().NoError(err)
+	erc20Addr := deployResp.ContractAddr
+	resp, err := deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			FromErc20: &eth.EIP55Addr{Address: erc20Addr},
+			Sender:    deps.Sender.NibiruAddr.String(),
+		},
+	)
+	s.Require().NoError(err)
+	bankDenom := resp.FuntokenMapping.BankDenom
+
+	callERC20 := func(method string, args ...any) {
+		contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack(method, args...)
+		s.Require().NoError(err)
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		evmObj, _ := deps.NewEVM()
+		_, err = deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &erc20Addr, true /*commit*/, contractInput, keeper.Erc20GasLimitExecute,
+		)
+		s.Require().NoError(err)
+	}
+	queryEscrow := func() evm.QueryFunTokenEscrowResponse {
+		resp, err := deps.EvmKeeper.FunTokenEscrow(
+			sdk.WrapSDKContext(deps.Ctx), &evm.QueryFunTokenEscrowRequest{Token: bankDenom},
+		)
+		s.Require().NoError(err)
+		return *resp
+	}
+	reconcile := func(sender string, isRebasing bool) (*evm.MsgReconcileFunTokenEscrowResponse, error) {
+		return deps.EvmKeeper.ReconcileFunTokenEscrow(
+			sdk.WrapSDKContext(deps.Ctx),
+			&evm.MsgReconcileFunTokenEscrow{
+				Sender:     sender,
+				Erc20Addr:  eth.EIP55Addr{Address: erc20Addr},
+				IsRebasing: isRebasing,
+			},
+		)
+	}
+	randomAcc := testutil.AccAddress()
+	// Like a tx, the conversion only writes state if it succeeds
+	convertCoinToEvm := func(amount int64) error {
+		cacheCtx, commit := deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(
+			sdk.WrapSDKContext(cacheCtx),
+			&evm.MsgConvertCoinToEvm{
+				Sender:    randomAcc.String(),
+				BankCoin:  sdk.NewInt64Coin(bankDenom, amount),
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			},
+		)
+		if err == nil {
+			commit()
+		}
+		return err
+	}
+
+	s.T().Log("Convert 100 ERC20 tokens to bank coins")
+	callERC20("mint", deps.Sender.EthAddr, big.NewInt(1000))
+	deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: eth.EIP55Addr{Address: erc20Addr},
+			Amount:    sdk.NewInt(100),
+			ToAddr:    randomAcc.String(),
+		},
+	)
+	s.Require().NoError(err)
+	escrow := queryEscrow().Escrow
+	s.Require().Equal("100", escrow.EscrowBalance.String())
+	s.Require().Equal("100", escrow.BankSupply.String())
+	s.Require().True(escrow.Drift.IsZero())
+	s.Require().True(escrow.InvariantHolds)
+
+	s.T().Log("Positive rebase of the escrow")
+	callERC20("mint", evm.EVM_MODULE_ADDRESS, big.NewInt(100))
+	escrow = queryEscrow().Escrow
+	s.Require().Equal("200", escrow.EscrowBalance.String())
+	s.Require().Equal("100", escrow.Drift.String())
+	s.Require().True(escrow.InvariantHolds)
+
+	s.Run("sad: reconcile as a non-sudoer", func() {
+		_, err := reconcile(deps.Sender.NibiruAddr.String(), true)
+		s.Require().Error(err)
+	})
+
+	s.T().Log("Flag the FunToken as rebasing")
+	reconcileResp, err := reconcile(testutil.ADDR_SUDO_ROOT, true)
+	s.Require().NoError(err)
+	s.Require().True(reconcileResp.FunToken.IsRebasing)
+	s.Require().True(reconcileResp.Escrow.InvariantHolds)
+	s.Require().True(queryEscrow().FunToken.IsRebasing)
+
+	s.T().Log("Bank coins convert back to their share of the escrow")
+	s.Require().NoError(convertCoinToEvm(50))
+	evmObj, _ := deps.NewEVM()
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		s.T(), deps, evmObj, erc20Addr, deps.Sender.EthAddr, big.NewInt(1000), "50 coins are worth 100 tokens",
+	)
+	escrow = queryEscrow().Escrow
+	s.Require().Equal("100", escrow.EscrowBalance.String())
+	s.Require().Equal("50", escrow.BankSupply.String())
+
+	s.T().Log("ERC20 tokens convert to their share of the escrow")
+	deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	convertResp, err := deps.EvmKeeper.ConvertEvmToCoin(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgConvertEvmToCoin{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			Erc20Addr: eth.EIP55Addr{Address: erc20Addr},
+			Amount:    sdk.NewInt(100),
+			ToAddr:    randomAcc.String(),
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(bankDenom, 50), convertResp.BankCoin)
+
+	s.T().Log("Unflag the FunToken while the escrow covers the supply")
+	reconcileResp, err = reconcile(testutil.ADDR_SUDO_ROOT, false)
+	s.Require().NoError(err)
+	s.Require().False(reconcileResp.FunToken.IsRebasing)
+
+	s.T().Log("Negative rebase breaks the invariant of a FunToken that isn't rebasing")
+	callERC20("burnFromAuthority", evm.EVM_MODULE_ADDRESS, big.NewInt(150))
+	escrow = queryEscrow().Escrow
+	s.Require().Equal("50", escrow.EscrowBalance.String())
+	s.Require().Equal("-50", escrow.Drift.String())
+	s.Require().False(escrow.InvariantHolds)
+	s.Require().ErrorContains(convertCoinToEvm(10), "supply invariant broken")
+
+	s.T().Log("Reconciling flags a shrunk escrow as rebasing")
+	reconcileResp, err = reconcile(testutil.ADDR_SUDO_ROOT, false)
+	s.Require().NoError(err)
+	s.Require().True(reconcileResp.FunToken.IsRebasing)
+	s.Require().True(reconcileResp.Escrow.InvariantHolds)
+
+	s.Require().NoError(convertCoinToEvm(50))
+	evmObj, _ = deps.NewEVM()
+	evmtest.AssertERC20BalanceEqualWithDescription(
+		s.T(), deps, evmObj, erc20Addr, deps.Sender.EthAddr, big.NewInt(925), "50 coins are worth 25 tokens",
+	)
+}
+
 type FunTokenFromErc20Suite struct {
 	suite.Suite
 }
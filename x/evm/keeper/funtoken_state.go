@@ -74,10 +74,24 @@ type IndexesFunToken struct {
 func (fun FunTokenState) SafeInsert(
 	ctx sdk.Context, erc20 gethcommon.Address, bankDenom string, isMadeFromCoin bool,
 ) error {
-	funtoken := evm.NewFunToken(erc20, bankDenom, isMadeFromCoin)
+	return fun.SafeInsertFunToken(ctx, evm.NewFunToken(erc20, bankDenom, isMadeFromCoin))
+}
+
+// SafeInsertFunToken adds an [evm.FunToken] to state with defensive
+// validation, keeping fields like the [evm.FunToken.ScalingExponent] that
+// [FunTokenState.SafeInsert] leaves unset.
+func (fun FunTokenState) SafeInsertFunToken(ctx sdk.Context, funtoken evm.FunToken) error {
 	if err := funtoken.Validate(); err != nil {
 		return err
 	}
 	fun.Insert(ctx, funtoken.ID(), funtoken)
 	return nil
 }
+
+// HasFunTokenForBankDenom returns true if a FunToken mapping exists for the
+// bank coin "bankDenom".
+func (k *Keeper) HasFunTokenForBankDenom(ctx sdk.Context, bankDenom string) bool {
+	iter := k.FunTokens.Indexes.BankDenom.ExactMatch(ctx, bankDenom)
+	defer iter.Close()
+	return iter.Valid()
+}
@@ -188,6 +188,54 @@ func (k Keeper) Storage(
 	}, nil
 }
 
+// StorageProof: Implements the gRPC query for
+// "/eth.evm.v1.Query/StorageProof". StorageProof builds the Ethereum storage
+// trie of an account from its contract storage and proves the requested
+// storage slots against the root of the trie.
+//
+// Parameters:
+//   - goCtx: The context.Context object representing the request context.
+//   - req: Request with the Ethereum address and the storage keys to prove.
+//
+// Returns:
+//   - Response containing the storage root and the proofs of the slots.
+//   - An error if the trie can't be built or the slots can't be proven.
+func (k Keeper) StorageProof(
+	goCtx context.Context, req *evm.QueryStorageProofRequest,
+) (*evm.QueryStorageProofResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address := gethcommon.HexToAddress(req.Address)
+	storageTrie, err := k.StorageTrie(ctx, address)
+	if errors.Is(err, evm.ErrStorageTrieTooLarge) {
+		return nil, grpcstatus.Error(grpccodes.ResourceExhausted, err.Error())
+	} else if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+
+	proofs := make([]evm.StorageSlotProof, len(req.Keys))
+	for i, keyHex := range req.Keys {
+		key := gethcommon.HexToHash(keyHex)
+		proof, err := evm.StorageProof(storageTrie, key)
+		if err != nil {
+			return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+		}
+		proofs[i] = evm.StorageSlotProof{
+			Key:   keyHex,
+			Value: k.GetState(ctx, address, key).Hex(),
+			Proof: proof,
+		}
+	}
+
+	return &evm.QueryStorageProofResponse{
+		StorageRoot: storageTrie.Hash().Hex(),
+		Proofs:      proofs,
+	}, nil
+}
+
 // Code: Implements the gRPC query for "/eth.evm.v1.Query/Code".
 // Code retrieves the smart contract bytecode associated with a given Ethereum
 // address.
@@ -719,10 +767,16 @@ func (k Keeper) TraceBlock(
 	}, nil
 }
 
-// gasRemainingTxPartial returns a [gethcore.Transaction] that only has its "Gas"
-// field set.
-func gasRemainingTxPartial(gasLimit uint64) *gethcore.Transaction {
-	txData := gethcore.LegacyTx{Gas: gasLimit}
+// tracerTxPartial returns a [gethcore.Transaction] that only has the "Gas",
+// "To", and "Value" fields of the message set, which is what the tracers read
+// when a tx starts. The "prestateTracer", for instance, records the state of
+// the recipient.
+func tracerTxPartial(msg core.Message) *gethcore.Transaction {
+	txData := gethcore.LegacyTx{
+		Gas:   msg.GasLimit,
+		To:    msg.To,
+		Value: msg.Value,
+	}
 	return gethcore.NewTx(&txData)
 }
 
@@ -874,3 +928,49 @@ func (k Keeper) FunTokenMapping(
 
 	return nil, grpcstatus.Errorf(grpccodes.NotFound, "token mapping not found for %s", req.Token)
 }
+
+// FunTokenEscrow reports the ERC20 tokens escrowed by the EVM module for a
+// FunToken created from an ERC20 against the supply of its Bank Coin.
+func (k Keeper) FunTokenEscrow(
+	goCtx context.Context, req *evm.QueryFunTokenEscrowRequest,
+) (*evm.QueryFunTokenEscrowResponse, error) {
+	mapping, err := k.FunTokenMapping(goCtx, &evm.QueryFunTokenMappingRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+	funtoken := *mapping.FunToken
+	if funtoken.IsMadeFromCoin {
+		return nil, grpcstatus.Errorf(
+			grpccodes.InvalidArgument, "FunToken mapping for %s was created from a coin and has no escrow", req.Token,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	escrow, err := k.GetFunTokenEscrow(ctx, k.newFunTokenEscrowEVM(ctx, funtoken), funtoken)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return &evm.QueryFunTokenEscrowResponse{
+		FunToken: funtoken,
+		Escrow:   escrow,
+	}, nil
+}
+
+// FunNFTMapping returns the FunNFT mapping of an ERC-721 collection or "x/nft"
+// class.
+func (k Keeper) FunNFTMapping(
+	goCtx context.Context, req *evm.QueryFunNFTMappingRequest,
+) (*evm.QueryFunNFTMappingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// first try lookup by class ID
+	if funnft, found := k.FunNFTs.GetByClassID(ctx, req.Token); found {
+		return &evm.QueryFunNFTMappingResponse{FunNft: &funnft}, nil
+	}
+
+	if funnft, found := k.FunNFTs.GetByERC721(ctx, gethcommon.HexToAddress(req.Token)); found {
+		return &evm.QueryFunNFTMappingResponse{FunNft: &funnft}, nil
+	}
+
+	return nil, grpcstatus.Errorf(grpccodes.NotFound, "nft mapping not found for %s", req.Token)
+}
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	gethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -368,6 +369,98 @@ func (s *Suite) TestQueryStorage() {
 	}
 }
 
+func (s *Suite) TestQueryStorageProof() {
+	type In = *evm.QueryStorageProofRequest
+	type Out = *evm.QueryStorageProofResponse
+	testCases := []TestCase[In, Out]{
+		{
+			name: "sad: msg validation",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				req = &evm.QueryStorageProofRequest{
+					Address: InvalidEthAddr(),
+				}
+				return req, wantResp
+			},
+			wantErr: "InvalidArgument",
+		},
+		{
+			name: "happy",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				addr := evmtest.NewEthPrivAcc().EthAddr
+				storageKey := gethcommon.BytesToHash([]byte("storagekey"))
+				absentKey := gethcommon.BytesToHash([]byte("absentkey"))
+				req = &evm.QueryStorageProofRequest{
+					Address: addr.Hex(),
+					Keys:    []string{storageKey.String(), absentKey.String()},
+				}
+
+				stateDB := deps.NewStateDB()
+				storageValue := gethcommon.BytesToHash([]byte("value"))
+				stateDB.SetState(addr, storageKey, storageValue)
+				s.NoError(stateDB.Commit())
+
+				storageTrie, err := evm.StorageTrie(
+					map[gethcommon.Hash]gethcommon.Hash{storageKey: storageValue},
+				)
+				s.Require().NoError(err)
+				storageProof, err := evm.StorageProof(storageTrie, storageKey)
+				s.Require().NoError(err)
+				absentProof, err := evm.StorageProof(storageTrie, absentKey)
+				s.Require().NoError(err)
+
+				wantResp = &evm.QueryStorageProofResponse{
+					StorageRoot: storageTrie.Hash().Hex(),
+					Proofs: []evm.StorageSlotProof{
+						{Key: storageKey.String(), Value: storageValue.Hex(), Proof: storageProof},
+						{Key: absentKey.String(), Value: gethcommon.Hash{}.Hex(), Proof: absentProof},
+					},
+				}
+				return req, wantResp
+			},
+			wantErr: "",
+		},
+		{
+			name: "happy: no committed state",
+			scenario: func(deps *evmtest.TestDeps) (req In, wantResp Out) {
+				addr := evmtest.NewEthPrivAcc().EthAddr
+				storageKey := gethcommon.BytesToHash([]byte("storagekey"))
+				req = &evm.QueryStorageProofRequest{
+					Address: addr.Hex(),
+					Keys:    []string{storageKey.String()},
+				}
+
+				wantResp = &evm.QueryStorageProofResponse{
+					StorageRoot: gethcore.EmptyRootHash.Hex(),
+					Proofs: []evm.StorageSlotProof{
+						{Key: storageKey.String(), Value: gethcommon.Hash{}.Hex()},
+					},
+				}
+				return req, wantResp
+			},
+			wantErr: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			deps := evmtest.NewTestDeps()
+			if tc.setup != nil {
+				tc.setup(&deps)
+			}
+			req, wantResp := tc.scenario(&deps)
+			goCtx := sdk.WrapSDKContext(deps.Ctx)
+
+			gotResp, err := deps.EvmKeeper.StorageProof(goCtx, req)
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Assert().NoError(err)
+			s.EqualValues(wantResp, gotResp)
+		})
+	}
+}
+
 func (s *Suite) TestQueryCode() {
 	type In = *evm.QueryCodeRequest
 	type Out = *evm.QueryCodeResponse
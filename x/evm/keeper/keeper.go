@@ -32,6 +32,10 @@ type Keeper struct {
 	// mappings.
 	FunTokens FunTokenState
 
+	// FunNFTs isolates the key-value stores (collections) for non-fungible
+	// token mappings between ERC-721 collections and "x/nft" classes.
+	FunNFTs FunNFTState
+
 	// the address capable of executing a MsgUpdateParams message. Typically,
 	// this should be the x/gov module account.
 	authority sdk.AccAddress
@@ -39,6 +43,8 @@ type Keeper struct {
 	Bank          *NibiruBankKeeper
 	accountKeeper evm.AccountKeeper
 	stakingKeeper evm.StakingKeeper
+	sudoKeeper    evm.SudoKeeper
+	nftKeeper     evm.NFTKeeper
 
 	// tracer: Configures the output type for a geth `vm.EVMLogger`. Tracer types
 	// include "access_list", "json", "struct", and "markdown". If any other
@@ -55,6 +61,8 @@ func NewKeeper(
 	accKeeper evm.AccountKeeper,
 	bankKeeper *NibiruBankKeeper,
 	stakingKeeper evm.StakingKeeper,
+	sudoKeeper evm.SudoKeeper,
+	nftKeeper evm.NFTKeeper,
 	tracer string,
 ) Keeper {
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -68,9 +76,12 @@ func NewKeeper(
 		authority:     authority,
 		EvmState:      NewEvmState(cdc, storeKey, transientKey),
 		FunTokens:     NewFunTokenState(cdc, storeKey),
+		FunNFTs:       NewFunNFTState(cdc, storeKey),
 		accountKeeper: accKeeper,
 		Bank:          bankKeeper,
 		stakingKeeper: stakingKeeper,
+		sudoKeeper:    sudoKeeper,
+		nftKeeper:     nftKeeper,
 		tracer:        tracer,
 	}
 }
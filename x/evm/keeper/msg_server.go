@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -286,7 +287,7 @@ func (k *Keeper) ApplyEvmMsg(
 	if tracer != nil {
 		// Formerly: evmObj.Config.Tracer.CaptureTxStart in geth v1.10
 		if tracer.OnTxStart != nil {
-			ethTx := gasRemainingTxPartial(msg.GasLimit)
+			ethTx := tracerTxPartial(msg)
 			tracer.OnTxStart(
 				evmObj.GetVMContext(),
 				ethTx,
@@ -479,7 +480,7 @@ func (k *Keeper) CreateFunToken(
 
 	// Deduct fee upon registration.
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	err = k.deductCreateFunTokenFee(ctx, msg)
+	err = k.deductCreateFunTokenFee(ctx, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
@@ -487,7 +488,7 @@ func (k *Keeper) CreateFunToken(
 	emptyErc20 := msg.FromErc20 == nil || msg.FromErc20.Size() == 0
 	switch {
 	case !emptyErc20 && msg.FromBankDenom == "":
-		funtoken, err = k.createFunTokenFromERC20(ctx, msg.FromErc20.Address)
+		funtoken, err = k.createFunTokenFromERC20(ctx, msg.FromErc20.Address, msg.ScalingExponent)
 	case emptyErc20 && msg.FromBankDenom != "":
 		funtoken, err = k.createFunTokenFromCoin(ctx, msg.FromBankDenom)
 	default:
@@ -511,9 +512,9 @@ func (k *Keeper) CreateFunToken(
 	}, err
 }
 
-func (k Keeper) deductCreateFunTokenFee(ctx sdk.Context, msg *evm.MsgCreateFunToken) error {
+func (k Keeper) deductCreateFunTokenFee(ctx sdk.Context, sender string) error {
 	fee := k.FeeForCreateFunToken(ctx)
-	from := sdk.MustAccAddressFromBech32(msg.Sender) // validation in msg.ValidateBasic
+	from := sdk.MustAccAddressFromBech32(sender) // validation in msg.ValidateBasic
 
 	if err := k.Bank.SendCoinsFromAccountToModule(
 		ctx, from, evm.ModuleName, fee); err != nil {
@@ -679,27 +680,6 @@ func (k Keeper) convertCoinToEvmBornERC20(
 		return nil, sdkioerrors.Wrap(err, "error sending Bank Coins to the EVM")
 	}
 
-	// 3 | In the FunToken ERC20 → BC conversion process that preceded this
-	// TxMsg, the Bank Coins were minted. Consequently, to preserve an invariant
-	// on the sum of the FunToken's bank and ERC20 supply, we burn the coins here
-	// in the BC → ERC20 conversion.
-	if err := k.Bank.BurnCoins(ctx, evm.ModuleName, sdk.NewCoins(coin)); err != nil {
-		return nil, sdkioerrors.Wrap(err, "failed to burn coins")
-	}
-
-	// 2 | EVM sends ERC20 tokens to the "to" account.
-	// This should never fail due to the EVM account lacking ERc20 fund because
-	// the account must have sent the EVM module ERC20 tokens in the mapping
-	// in order to create the coins originally.
-	//
-	// Said another way, if an asset is created as an ERC20 and some amount is
-	// converted to its Bank Coin representation, a balance of the ERC20 is left
-	// inside the EVM module account in order to convert the coins back to
-	// ERC20s.
-	contractInput, err := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI.Pack("transfer", recipient, coin.Amount.BigInt())
-	if err != nil {
-		return nil, err
-	}
 	unusedBigInt := big.NewInt(0)
 	evmMsg := core.Message{
 		To:               &erc20Addr,
@@ -710,7 +690,7 @@ func (k Keeper) convertCoinToEvmBornERC20(
 		GasPrice:         unusedBigInt,
 		GasFeeCap:        unusedBigInt,
 		GasTipCap:        unusedBigInt,
-		Data:             contractInput,
+		Data:             []byte{},
 		AccessList:       gethcore.AccessList{},
 		BlobGasFeeCap:    &big.Int{},
 		BlobHashes:       []gethcommon.Hash{},
@@ -718,11 +698,31 @@ func (k Keeper) convertCoinToEvmBornERC20(
 		SkipFromEOACheck: true,
 	}
 	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+
+	// 2 | EVM sends ERC20 tokens to the "to" account.
+	// This should never fail due to the EVM account lacking ERc20 fund because
+	// the account must have sent the EVM module ERC20 tokens in the mapping
+	// in order to create the coins originally.
+	//
+	// Said another way, if an asset is created as an ERC20 and some amount is
+	// converted to its Bank Coin representation, a balance of the ERC20 is left
+	// inside the EVM module account in order to convert the coins back to
+	// ERC20s.
+	//
+	// The amount of a rebasing FunToken is pro-rated against the supply that
+	// still includes the coins, so they're burned after.
+	erc20Amount, err := k.FunTokenBankToERC20(ctx, evmObj, funTokenMapping, coin.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	if erc20Amount.Sign() != 1 {
+		return nil, fmt.Errorf("%s is worth less than one base unit of ERC20 %s", coin, erc20Addr.Hex())
+	}
 	_, evmResp, err := k.ERC20().Transfer(
 		erc20Addr,
 		evm.EVM_MODULE_ADDRESS,
 		recipient,
-		coin.Amount.BigInt(),
+		erc20Amount,
 		ctx,
 		evmObj,
 	)
@@ -730,6 +730,17 @@ func (k Keeper) convertCoinToEvmBornERC20(
 		return nil, sdkioerrors.Wrap(err, "failed to transfer ERC-20 tokens")
 	}
 
+	// 3 | In the FunToken ERC20 → BC conversion process that preceded this
+	// TxMsg, the Bank Coins were minted. Consequently, to preserve an invariant
+	// on the sum of the FunToken's bank and ERC20 supply, we burn the coins here
+	// in the BC → ERC20 conversion.
+	if err := k.Bank.BurnCoins(ctx, evm.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to burn coins")
+	}
+	if err := k.CheckFunTokenEscrow(ctx, evmObj, funTokenMapping); err != nil {
+		return nil, err
+	}
+
 	// Commit the stateDB to the BankKeeperExtension because we don't go through
 	// ApplyEvmMsg at all in this tx.
 	if err := stateDB.Commit(); err != nil {
@@ -753,6 +764,475 @@ func (k Keeper) convertCoinToEvmBornERC20(
 	return &evm.MsgConvertCoinToEvmResponse{}, nil
 }
 
+// ConvertEvmToCoin Sends ERC20 tokens with a valid "FunToken" mapping from the
+// EVM address of the sender to the given recipient ("to_addr") in the
+// corresponding Bank Coin representation. It is the Cosmos equivalent of
+// "sendToBank" in the FunToken precompile, which lets Cosmos wallets, ICA
+// controllers, and authz grantees convert FunTokens back to Bank Coins.
+func (k *Keeper) ConvertEvmToCoin(
+	goCtx context.Context, msg *evm.MsgConvertEvmToCoin,
+) (*evm.MsgConvertEvmToCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := eth.NibiruAddrToEthAddr(sdk.MustAccAddressFromBech32(msg.Sender))
+	erc20Addr := msg.Erc20Addr.Address
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20Addr))
+	if len(funTokens) != 1 {
+		return nil, fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20Addr.Hex())
+	}
+	toAddr, err := eth.ParseBech32OrHexAddr(msg.ToAddr)
+	if err != nil {
+		return nil, fmt.Errorf("recipient address invalid (%s): %w", msg.ToAddr, err)
+	}
+
+	// needs to run first to populate the StateDB on the BankKeeperExtension
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc20Addr,
+		From:             sender,
+		Nonce:            k.GetAccNonce(ctx, sender),
+		Value:            unusedBigInt, // amount
+		GasLimit:         Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	coin, evmResp, err := k.SendERC20ToBank(
+		ctx, evmObj, funTokens[0], sender, toAddr, msg.Amount.BigInt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the stateDB to the BankKeeperExtension because we don't go through
+	// ApplyEvmMsg at all in this tx.
+	if err := stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertEvmToCoin{
+		Sender:               msg.Sender,
+		Erc20ContractAddress: erc20Addr.Hex(),
+		ToAddr:               eth.EthAddrToNibiruAddr(toAddr).String(),
+		BankCoin:             coin,
+	})
+
+	// Emit tx logs of the Transfer and Burn events
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmResp.Logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)))
+	}
+
+	return &evm.MsgConvertEvmToCoinResponse{BankCoin: coin}, nil
+}
+
+// ReconcileFunTokenEscrow compares the ERC20 tokens escrowed for a FunToken
+// created from an ERC20 with the supply of its Bank Coin and sets whether the
+// mapping is rebasing. A mapping whose escrow shrank on its own is flagged as
+// rebasing regardless of the message, since its Bank Coins can only be
+// converted back pro rata. Only the x/sudo root or sudo contracts can
+// reconcile a mapping.
+func (k *Keeper) ReconcileFunTokenEscrow(
+	goCtx context.Context, msg *evm.MsgReconcileFunTokenEscrow,
+) (*evm.MsgReconcileFunTokenEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := k.sudoKeeper.CheckPermissions(sender, ctx); err != nil {
+		return nil, err
+	}
+
+	erc20Addr := msg.Erc20Addr.Address
+	funTokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20Addr))
+	if len(funTokens) != 1 {
+		return nil, fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20Addr.Hex())
+	}
+	funtoken := funTokens[0]
+	if funtoken.IsMadeFromCoin {
+		return nil, fmt.Errorf(
+			"FunToken mapping for ERC20 \"%s\" was created from a coin and has no escrow", erc20Addr.Hex(),
+		)
+	}
+
+	funtoken, escrow, err := k.ReconcileFunTokenEscrowDrift(ctx, funtoken, msg.IsRebasing)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunTokenEscrowReconciled{
+		Sender:   msg.Sender,
+		FunToken: funtoken,
+		Escrow:   escrow,
+	})
+	return &evm.MsgReconcileFunTokenEscrowResponse{
+		FunToken: funtoken,
+		Escrow:   escrow,
+	}, nil
+}
+
+// SendERC20ToBank transfers "amount" of the ERC20 tokens of "funtoken" from
+// "sender" to the EVM module account and sends the amount received as Bank
+// Coins to "to". For a FunToken created from a Bank Coin, the ERC20 tokens are
+// burned and the escrowed coins are sent. Otherwise, the ERC20 tokens stay in
+// escrow and the coins are minted.
+//
+// For a scaled FunToken (see [evm.FunToken.ScalingExponent]), "amount" is
+// rounded down to a whole number of Bank Coin base units so that the dust
+// stays with the sender. Dust from a fee on transfer stays in escrow. For a
+// rebasing FunToken, the coins are instead the share of the escrow that the
+// ERC20 tokens received add to it.
+//
+// The bank operations go through the [NibiruBankKeeper], so the caller must
+// make sure "k.Bank.StateDB" is the [statedb.StateDB] of "evmObj" and commit it.
+// The returned [evm.MsgEthereumTxResponse] holds the logs of the ERC20 calls.
+//
+// Because [SendERC20ToBank] uses "SendCoinsFromModuleToAccount" to send Bank
+// Coins, this method correctly avoids sending funds to addresses blocked by the
+// Bank module.
+func (k *Keeper) SendERC20ToBank(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	funtoken evm.FunToken,
+	sender gethcommon.Address,
+	to gethcommon.Address,
+	amount *big.Int,
+) (coin sdk.Coin, evmResp *evm.MsgEthereumTxResponse, err error) {
+	return k.SendERC20ToBankFrom(ctx, evmObj, funtoken, sender, sender, to, amount)
+}
+
+// SendERC20ToBankFrom is the same as [Keeper.SendERC20ToBank], except that
+// "spender" moves the ERC20 tokens of "sender" with "ERC20.transferFrom",
+// consuming the allowance "sender" gave to "spender". When "spender" is
+// "sender", the tokens are moved with "ERC20.transfer" and no allowance is
+// needed.
+func (k *Keeper) SendERC20ToBankFrom(
+	ctx sdk.Context,
+	evmObj *vm.EVM,
+	funtoken evm.FunToken,
+	spender gethcommon.Address,
+	sender gethcommon.Address,
+	to gethcommon.Address,
+	amount *big.Int,
+) (coin sdk.Coin, evmResp *evm.MsgEthereumTxResponse, err error) {
+	// Amount should be positive
+	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
+		return coin, nil, fmt.Errorf("transfer amount must be positive")
+	}
+
+	erc20 := funtoken.Erc20Addr.Address
+	var escrowBefore, bankSupply *big.Int
+	if funtoken.IsRebasing {
+		// Rebasing Bank Coins are shares of the escrow before the transfer
+		escrowBefore, err = k.funTokenEscrowBalance(ctx, evmObj, funtoken)
+		if err != nil {
+			return coin, nil, err
+		}
+		bankSupply = k.Bank.GetSupply(ctx, funtoken.BankDenom).Amount.BigInt()
+	} else {
+		// Only whole Bank Coin base units are transferred
+		bankAmount, dust := funtoken.ERC20ToBankAmount(amount)
+		if bankAmount.Sign() != 1 {
+			return coin, nil, fmt.Errorf(
+				"transfer amount %s is smaller than one unit of \"%s\" (%s ERC20 tokens)",
+				amount, funtoken.BankDenom, funtoken.ScalingFactor(),
+			)
+		}
+		amount = new(big.Int).Sub(amount, dust)
+	}
+
+	// Sender transfers ERC20 to the EVM module account
+	var gotAmount *big.Int
+	if spender == sender {
+		gotAmount, evmResp, err = k.ERC20().Transfer(
+			erc20,                  /*erc20*/
+			sender,                 /*from*/
+			evm.EVM_MODULE_ADDRESS, /*to*/
+			amount,                 /*value*/
+			ctx,
+			evmObj,
+		)
+		if err != nil {
+			return coin, nil, fmt.Errorf(
+				"error in ERC20.transfer from caller to EVM account: %w: from %s, erc20 %s, amount: %s",
+				err, sender, erc20, amount,
+			)
+		}
+	} else {
+		gotAmount, evmResp, err = k.ERC20().TransferFrom(
+			erc20,                  /*erc20*/
+			spender,                /*spender*/
+			sender,                 /*from*/
+			evm.EVM_MODULE_ADDRESS, /*to*/
+			amount,                 /*value*/
+			ctx,
+			evmObj,
+		)
+		if err != nil {
+			return coin, nil, fmt.Errorf(
+				"error in ERC20.transferFrom to EVM account: %w: spender %s, from %s, erc20 %s, amount: %s",
+				err, spender, sender, erc20, amount,
+			)
+		}
+	}
+
+	// EVM account mints FunToken.BankDenom to module account
+	var bankAmount *big.Int
+	if funtoken.IsRebasing {
+		bankAmount = funtoken.ERC20ToBankShares(gotAmount, escrowBefore, bankSupply)
+	} else {
+		bankAmount, _ = funtoken.ERC20ToBankAmount(gotAmount)
+	}
+	if bankAmount.Sign() != 1 {
+		return coin, nil, fmt.Errorf(
+			"amount received %s is worth less than one unit of \"%s\"",
+			gotAmount, funtoken.BankDenom,
+		)
+	}
+	coin = sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(bankAmount))
+	if funtoken.IsMadeFromCoin {
+		// If the FunToken mapping was created from a bank coin, then the EVM account
+		// owns the ERC20 contract and was the original minter of the ERC20 tokens.
+		// Since we're sending them away and want accurate total supply tracking, the
+		// tokens need to be burned.
+		evmResp, err = k.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
+		if err != nil {
+			return coin, nil, fmt.Errorf("ERC20.Burn: %w", err)
+		}
+	} else {
+		// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
+		// any operation that has the potential to use Bank send methods. This will
+		// guarantee that [Keeper.SetAccBalance] journal changes are
+		// recorded if wei (NIBI) is transferred.
+		err = k.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coin))
+		if err != nil {
+			return coin, nil, fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
+				evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), sender.Hex(), err,
+			)
+		}
+	}
+
+	// Transfer the bank coin
+	//
+	// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
+	// any operation that has the potential to use Bank send methods. This will
+	// guarantee that [Keeper.SetAccBalance] journal changes are
+	// recorded if wei (NIBI) is transferred.
+	err = k.Bank.SendCoinsFromModuleToAccount(
+		ctx,
+		evm.ModuleName,
+		eth.EthAddrToNibiruAddr(to),
+		sdk.NewCoins(coin),
+	)
+	if err != nil {
+		return coin, nil, fmt.Errorf("send failed for module \"%s\" (%s): contract caller %s: %w",
+			evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), sender.Hex(), err,
+		)
+	}
+
+	if err := k.CheckFunTokenEscrow(ctx, evmObj, funtoken); err != nil {
+		return coin, nil, err
+	}
+	return coin, evmResp, nil
+}
+
+// CreateFunNFT is a gRPC transaction message for creating a non-fungible token
+// ("FunNFT") mapping between an ERC-721 collection and a new class of the NFT
+// Module ("x/nft"). The creation costs the same fee as a FunToken mapping.
+func (k *Keeper) CreateFunNFT(
+	goCtx context.Context, msg *evm.MsgCreateFunNFT,
+) (*evm.MsgCreateFunNFTResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Deduct fee upon registration.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.deductCreateFunTokenFee(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	funnft, err := k.createFunNFTFromERC721(ctx, msg.FromErc721.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventFunNFTCreated{
+		Creator:               msg.Sender,
+		ClassId:               funnft.ClassId,
+		Erc721ContractAddress: funnft.Erc721Addr.String(),
+	})
+
+	return &evm.MsgCreateFunNFTResponse{
+		FunnftMapping: *funnft,
+	}, nil
+}
+
+// ConvertEvmToNFT Sends an ERC-721 token with a valid "FunNFT" mapping from
+// the EVM address of the sender to the given recipient ("to_addr") as the
+// "x/nft" token of the same ID. The ERC-721 token stays in the escrow of the
+// EVM module account until it is converted back.
+func (k *Keeper) ConvertEvmToNFT(
+	goCtx context.Context, msg *evm.MsgConvertEvmToNFT,
+) (*evm.MsgConvertEvmToNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := eth.NibiruAddrToEthAddr(sdk.MustAccAddressFromBech32(msg.Sender))
+	erc721Addr := msg.Erc721Addr.Address
+	funnft, found := k.FunNFTs.GetByERC721(ctx, erc721Addr)
+	if !found {
+		return nil, fmt.Errorf("no FunNFT mapping exists for ERC721 \"%s\"", erc721Addr.Hex())
+	}
+	toAddr, err := eth.ParseBech32OrHexAddr(msg.ToAddr)
+	if err != nil {
+		return nil, fmt.Errorf("recipient address invalid (%s): %w", msg.ToAddr, err)
+	}
+
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc721Addr,
+		From:             sender,
+		Nonce:            k.GetAccNonce(ctx, sender),
+		Value:            unusedBigInt, // amount
+		GasLimit:         Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	nftID, evmResp, err := k.SendERC721ToNFT(
+		ctx, evmObj, funnft, sender, toAddr, msg.TokenId.BigInt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the stateDB to the BankKeeperExtension because we don't go through
+	// ApplyEvmMsg at all in this tx.
+	if err := stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertEvmToNFT{
+		Sender:                msg.Sender,
+		Erc721ContractAddress: erc721Addr.Hex(),
+		ToAddr:                eth.EthAddrToNibiruAddr(toAddr).String(),
+		ClassId:               funnft.ClassId,
+		NftId:                 nftID,
+	})
+
+	// Emit tx logs of the Transfer event
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmResp.Logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)))
+	}
+
+	return &evm.MsgConvertEvmToNFTResponse{
+		ClassId: funnft.ClassId,
+		NftId:   nftID,
+	}, nil
+}
+
+// ConvertNFTToEvm Sends an "x/nft" token with a valid "FunNFT" mapping to the
+// given recipient address ("to_eth_addr") as the ERC-721 token of the same ID.
+// The "x/nft" token is burned and the ERC-721 token leaves the escrow of the
+// EVM module account.
+func (k *Keeper) ConvertNFTToEvm(
+	goCtx context.Context, msg *evm.MsgConvertNFTToEvm,
+) (*evm.MsgConvertNFTToEvmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	funnft, err := k.GetFunNFTByClassID(ctx, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	stateDB := k.Bank.StateDB
+	if stateDB == nil {
+		stateDB = k.NewStateDB(ctx, k.TxConfig(ctx, gethcommon.Hash{}))
+	}
+	defer func() {
+		k.Bank.StateDB = nil
+	}()
+
+	erc721Addr := funnft.Erc721Addr.Address
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &erc721Addr,
+		From:             evm.EVM_MODULE_ADDRESS,
+		Nonce:            k.GetAccNonce(ctx, evm.EVM_MODULE_ADDRESS),
+		Value:            unusedBigInt, // amount
+		GasLimit:         Erc20GasLimitExecute,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             []byte{},
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  true,
+		SkipFromEOACheck: true,
+	}
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, stateDB)
+	evmResp, err := k.SendNFTToERC721(
+		ctx, evmObj, funnft, sender, msg.NftId, msg.ToEthAddr.Address,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the stateDB to the BankKeeperExtension because we don't go through
+	// ApplyEvmMsg at all in this tx.
+	if err := stateDB.Commit(); err != nil {
+		return nil, sdkioerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&evm.EventConvertNFTToEvm{
+		Sender:                msg.Sender,
+		Erc721ContractAddress: erc721Addr.Hex(),
+		ToEthAddr:             msg.ToEthAddr.String(),
+		ClassId:               funnft.ClassId,
+		NftId:                 msg.NftId,
+	})
+
+	// Emit tx logs of the Transfer event
+	err = ctx.EventManager().EmitTypedEvent(&evm.EventTxLog{Logs: evmResp.Logs})
+	if err == nil {
+		k.updateBlockBloom(ctx, evmResp, uint64(k.EvmState.BlockTxIndex.GetOr(ctx, 0)))
+	}
+
+	return &evm.MsgConvertNFTToEvmResponse{}, nil
+}
+
 // EmitEthereumTxEvents emits all types of EVM events applicable to a particular execution case
 func (k *Keeper) EmitEthereumTxEvents(
 	ctx sdk.Context,
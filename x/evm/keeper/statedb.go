@@ -2,6 +2,7 @@
 package keeper
 
 import (
+	"fmt"
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
@@ -10,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/trie"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/x/evm"
@@ -66,6 +68,25 @@ func (k *Keeper) ForEachStorage(
 	}
 }
 
+// StorageTrie: Ethereum storage trie of the account, built from its contract
+// storage. The root hash of the trie is the "storageHash" of the account in
+// "eth_getProof". See [evm.StorageTrie]. Returns
+// [evm.ErrStorageTrieTooLarge] if the account has more than
+// [evm.MaxStorageTrieSlots] slots.
+func (k *Keeper) StorageTrie(
+	ctx sdk.Context, addr gethcommon.Address,
+) (*trie.Trie, error) {
+	slots := make(map[gethcommon.Hash]gethcommon.Hash)
+	k.ForEachStorage(ctx, addr, func(key, value gethcommon.Hash) bool {
+		slots[key] = value
+		return len(slots) <= evm.MaxStorageTrieSlots
+	})
+	if len(slots) > evm.MaxStorageTrieSlots {
+		return nil, fmt.Errorf("account %s: %w", addr.Hex(), evm.ErrStorageTrieTooLarge)
+	}
+	return evm.StorageTrie(slots)
+}
+
 // SetAccBalance update account's balance, compare with current balance first,
 // then decide to mint or burn.
 // Implements the `statedb.Keeper` interface.
@@ -13,6 +13,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 )
 
 // TestStateDBBalance tests the behavior of the StateDB with regards to account
@@ -99,3 +100,47 @@ func (s *Suite) TestStateDBBalance() {
 		)
 	}
 }
+
+func (s *Suite) TestStorageTrie() {
+	deps := evmtest.NewTestDeps()
+	addr := evmtest.NewEthPrivAcc().EthAddr
+
+	storageTrie, err := deps.EvmKeeper.StorageTrie(deps.Ctx, addr)
+	s.Require().NoError(err)
+	s.Equal(gethcore.EmptyRootHash, storageTrie.Hash())
+
+	slots := map[gethcommon.Hash]gethcommon.Hash{
+		gethcommon.HexToHash("0x00"): gethcommon.HexToHash("0x2a"),
+		gethcommon.HexToHash("0x01"): gethcommon.HexToHash("0xd3c21bcecceda1000000"),
+	}
+	for key, value := range slots {
+		deps.EvmKeeper.SetState(deps.Ctx, addr, key, value.Bytes())
+	}
+	// The storage of other accounts is not in the trie.
+	deps.EvmKeeper.SetState(
+		deps.Ctx, deps.Sender.EthAddr, gethcommon.HexToHash("0x00"), gethcommon.HexToHash("0x01").Bytes(),
+	)
+
+	storageTrie, err = deps.EvmKeeper.StorageTrie(deps.Ctx, addr)
+	s.Require().NoError(err)
+	wantTrie, err := evm.StorageTrie(slots)
+	s.Require().NoError(err)
+	s.Equal(wantTrie.Hash(), storageTrie.Hash())
+
+	proof, err := evm.StorageProof(storageTrie, gethcommon.HexToHash("0x01"))
+	s.Require().NoError(err)
+	value, err := evm.VerifyStorageProof(storageTrie.Hash(), gethcommon.HexToHash("0x01"), proof)
+	s.Require().NoError(err)
+	s.Equal(slots[gethcommon.HexToHash("0x01")], value)
+
+	s.Run("storage above the slot limit", func() {
+		bigAddr := evmtest.NewEthPrivAcc().EthAddr
+		for i := range evm.MaxStorageTrieSlots + 1 {
+			deps.EvmKeeper.SetState(
+				deps.Ctx, bigAddr, gethcommon.BigToHash(big.NewInt(int64(i))), gethcommon.HexToHash("0x01").Bytes(),
+			)
+		}
+		_, err := deps.EvmKeeper.StorageTrie(deps.Ctx, bigAddr)
+		s.Require().ErrorIs(err, evm.ErrStorageTrieTooLarge)
+	})
+}
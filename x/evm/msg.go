@@ -491,6 +491,13 @@ func (m *MsgCreateFunToken) ValidateBasic() error {
 		return fmt.Errorf("either the \"from_erc20\" or \"from_bank_denom\" must be set (but not both)")
 	}
 
+	if m.ScalingExponent != 0 && emptyErc20 {
+		return fmt.Errorf("\"scaling_exponent\" can only be set with \"from_erc20\"")
+	}
+	if m.ScalingExponent > MaxFunTokenScalingExponent {
+		return fmt.Errorf("\"scaling_exponent\" must not exceed %d", MaxFunTokenScalingExponent)
+	}
+
 	return nil
 }
 
@@ -520,3 +527,132 @@ func (m *MsgConvertCoinToEvm) ValidateBasic() error {
 func (m MsgConvertCoinToEvm) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgConvertEvmToCoin message.
+func (m MsgConvertEvmToCoin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertEvmToCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.Erc20Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty erc20_addr")
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	if _, err := eth.ParseBech32OrHexAddr(m.ToAddr); err != nil {
+		return fmt.Errorf("invalid to_addr: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertEvmToCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgReconcileFunTokenEscrow
+// message.
+func (m MsgReconcileFunTokenEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgReconcileFunTokenEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.Erc20Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty erc20_addr")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgReconcileFunTokenEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCreateFunNFT message.
+func (m MsgCreateFunNFT) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateFunNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.FromErc721.Address == (common.Address{}) {
+		return fmt.Errorf("empty from_erc721")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateFunNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgConvertEvmToNFT message.
+func (m MsgConvertEvmToNFT) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertEvmToNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.Erc721Addr.Address == (common.Address{}) {
+		return fmt.Errorf("empty erc721_addr")
+	}
+	if m.TokenId.IsNil() || m.TokenId.IsNegative() || m.TokenId.BigInt().BitLen() > 256 {
+		return fmt.Errorf("token_id must be a uint256")
+	}
+	if _, err := eth.ParseBech32OrHexAddr(m.ToAddr); err != nil {
+		return fmt.Errorf("invalid to_addr: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertEvmToNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgConvertNFTToEvm message.
+func (m MsgConvertNFTToEvm) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertNFTToEvm) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender addr")
+	}
+	if m.ClassId == "" {
+		return fmt.Errorf("empty class_id")
+	}
+	if _, err := FunNFTTokenID(m.NftId); err != nil {
+		return err
+	}
+	if m.ToEthAddr.Address == (common.Address{}) {
+		return fmt.Errorf("empty to_eth_addr")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertNFTToEvm) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
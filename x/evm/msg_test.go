@@ -995,3 +995,123 @@ func (s *MsgsSuite) TestMarshalJSON() {
 	s.Equal(addrHex, goType.FromErc20.Hex())
 	s.Equal(sender, goType.Sender)
 }
+
+func (s *MsgsSuite) TestMsgConvertEvmToCoin_ValidateBasic() {
+	sender := "nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl"
+	erc20, err := eth.NewEIP55AddrFromStr("0x1111111111111111122222222222222222222222")
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name    string
+		msg     evm.MsgConvertEvmToCoin
+		wantErr string
+	}{
+		{
+			name: "happy: bech32 recipient",
+			msg:  evm.MsgConvertEvmToCoin{Sender: sender, Erc20Addr: erc20, Amount: sdkmath.NewInt(1), ToAddr: sender},
+		},
+		{
+			name: "happy: hex recipient",
+			msg:  evm.MsgConvertEvmToCoin{Sender: sender, Erc20Addr: erc20, Amount: sdkmath.NewInt(1), ToAddr: erc20.Hex()},
+		},
+		{
+			name:    "invalid sender",
+			msg:     evm.MsgConvertEvmToCoin{Sender: "invalid", Erc20Addr: erc20, Amount: sdkmath.NewInt(1), ToAddr: sender},
+			wantErr: "invalid sender addr",
+		},
+		{
+			name:    "empty erc20",
+			msg:     evm.MsgConvertEvmToCoin{Sender: sender, Amount: sdkmath.NewInt(1), ToAddr: sender},
+			wantErr: "empty erc20_addr",
+		},
+		{
+			name:    "zero amount",
+			msg:     evm.MsgConvertEvmToCoin{Sender: sender, Erc20Addr: erc20, Amount: sdkmath.ZeroInt(), ToAddr: sender},
+			wantErr: "amount must be positive",
+		},
+		{
+			name:    "invalid recipient",
+			msg:     evm.MsgConvertEvmToCoin{Sender: sender, Erc20Addr: erc20, Amount: sdkmath.NewInt(1), ToAddr: "invalid"},
+			wantErr: "invalid to_addr",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *MsgsSuite) TestMsgConvertNFT_ValidateBasic() {
+	sender := "nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl"
+	erc721, err := eth.NewEIP55AddrFromStr("0x1111111111111111122222222222222222222222")
+	s.Require().NoError(err)
+	classID := evm.FunNFTClassID(erc721.Address)
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr string
+	}{
+		{
+			name: "happy: evm to nft",
+			msg:  &evm.MsgConvertEvmToNFT{Sender: sender, Erc721Addr: erc721, TokenId: sdkmath.NewInt(42), ToAddr: sender},
+		},
+		{
+			name:    "evm to nft: empty erc721",
+			msg:     &evm.MsgConvertEvmToNFT{Sender: sender, TokenId: sdkmath.NewInt(42), ToAddr: sender},
+			wantErr: "empty erc721_addr",
+		},
+		{
+			name:    "evm to nft: negative token id",
+			msg:     &evm.MsgConvertEvmToNFT{Sender: sender, Erc721Addr: erc721, TokenId: sdkmath.NewInt(-1), ToAddr: sender},
+			wantErr: "token_id must be a uint256",
+		},
+		{
+			name:    "evm to nft: invalid recipient",
+			msg:     &evm.MsgConvertEvmToNFT{Sender: sender, Erc721Addr: erc721, TokenId: sdkmath.NewInt(42), ToAddr: "invalid"},
+			wantErr: "invalid to_addr",
+		},
+		{
+			name: "happy: nft to evm",
+			msg:  &evm.MsgConvertNFTToEvm{Sender: sender, ClassId: classID, NftId: "42", ToEthAddr: erc721},
+		},
+		{
+			name:    "nft to evm: invalid sender",
+			msg:     &evm.MsgConvertNFTToEvm{Sender: "invalid", ClassId: classID, NftId: "42", ToEthAddr: erc721},
+			wantErr: "invalid sender addr",
+		},
+		{
+			name:    "nft to evm: empty class id",
+			msg:     &evm.MsgConvertNFTToEvm{Sender: sender, NftId: "42", ToEthAddr: erc721},
+			wantErr: "empty class_id",
+		},
+		{
+			name:    "nft to evm: non-canonical nft id",
+			msg:     &evm.MsgConvertNFTToEvm{Sender: sender, ClassId: classID, NftId: "042", ToEthAddr: erc721},
+			wantErr: "invalid NFT ID",
+		},
+		{
+			name:    "nft to evm: empty recipient",
+			msg:     &evm.MsgConvertNFTToEvm{Sender: sender, ClassId: classID, NftId: "42"},
+			wantErr: "empty to_eth_addr",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
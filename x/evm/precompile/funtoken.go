@@ -50,6 +50,12 @@ const (
 	FunTokenMethod_sendToEvm       PrecompileMethod = "sendToEvm"
 	FunTokenMethod_bankMsgSend     PrecompileMethod = "bankMsgSend"
 	FunTokenMethod_getErc20Address PrecompileMethod = "getErc20Address"
+	FunTokenMethod_sendNftToBank   PrecompileMethod = "sendNftToBank"
+	FunTokenMethod_sendNftToEvm    PrecompileMethod = "sendNftToEvm"
+
+	FunTokenMethod_sendToBankBatch  PrecompileMethod = "sendToBankBatch"
+	FunTokenMethod_sendToBankFrom   PrecompileMethod = "sendToBankFrom"
+	FunTokenMethod_bankMsgSendBatch PrecompileMethod = "bankMsgSendBatch"
 )
 
 // Run runs the precompiled contract
@@ -93,6 +99,16 @@ func (p precompileFunToken) Run(
 		bz, err = p.bankMsgSend(startResult, trueCaller, readonly)
 	case FunTokenMethod_getErc20Address:
 		bz, err = p.getErc20Address(startResult, contract)
+	case FunTokenMethod_sendNftToBank:
+		bz, err = p.sendNftToBank(startResult, trueCaller, readonly, evm)
+	case FunTokenMethod_sendNftToEvm:
+		bz, err = p.sendNftToEvm(startResult, trueCaller, readonly, evm)
+	case FunTokenMethod_sendToBankBatch:
+		bz, err = p.sendToBankBatch(startResult, trueCaller, readonly, evm)
+	case FunTokenMethod_sendToBankFrom:
+		bz, err = p.sendToBankFrom(startResult, trueCaller, readonly, evm)
+	case FunTokenMethod_bankMsgSendBatch:
+		bz, err = p.bankMsgSendBatch(startResult, trueCaller, readonly)
 	default:
 		// Note that this code path should be impossible to reach since
 		// "[decomposeInput]" parses methods directly from the ABI.
@@ -147,7 +163,8 @@ type precompileFunToken struct {
 //
 // Because [sendToBank] uses "SendCoinsFromModuleToAccount" to send Bank Coins,
 // this method correctly avoids sending funds to addresses blocked by the Bank
-// module.
+// module. The same conversion is available to Cosmos accounts through
+// "MsgConvertEvmToCoin".
 func (p precompileFunToken) sendToBank(
 	startResult OnRunStartResult,
 	caller gethcommon.Address,
@@ -165,85 +182,37 @@ func (p precompileFunToken) sendToBank(
 	}
 
 	// ERC20 must have FunToken mapping
-	funtokens := p.evmKeeper.FunTokens.Collect(
-		ctx, p.evmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20),
-	)
-	if len(funtokens) != 1 {
-		err = fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20.Hex())
-		return
-	}
-	funtoken := funtokens[0]
-
-	// Amount should be positive
-	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
-		return nil, fmt.Errorf("transfer amount must be positive")
+	funtoken, err := p.funTokenForERC20(ctx, erc20)
+	if err != nil {
+		return nil, err
 	}
 
 	// The "to" argument must be a valid nibi or EVM address
-	toAddr, err := parseToAddr(to)
+	toAddr, err := eth.ParseBech32OrHexAddr(to)
 	if err != nil {
 		return nil, fmt.Errorf("recipient address invalid (%s): %w", to, err)
 	}
 
-	// Caller transfers ERC20 to the EVM module account
-	gotAmount, _, err := p.evmKeeper.ERC20().Transfer(
-		erc20,                  /*erc20*/
-		caller,                 /*from*/
-		evm.EVM_MODULE_ADDRESS, /*to*/
-		amount,                 /*value*/
-		ctx,
-		evmObj,
-	)
+	// Caller transfers ERC20 to the EVM module account, which then sends the
+	// FunToken.BankDenom coins to the recipient.
+	coinSent, _, err := p.evmKeeper.SendERC20ToBank(ctx, evmObj, funtoken, caller, toAddr, amount)
 	if err != nil {
-		return nil, fmt.Errorf(
-			"error in ERC20.transfer from caller to EVM account: %w: from %s, erc20 %s, amount: %s",
-			err, caller, erc20, amount,
-		)
+		return nil, err
 	}
 
-	// EVM account mints FunToken.BankDenom to module account
-	coinToSend := sdk.NewCoin(funtoken.BankDenom, sdkmath.NewIntFromBigInt(gotAmount))
-	if funtoken.IsMadeFromCoin {
-		// If the FunToken mapping was created from a bank coin, then the EVM account
-		// owns the ERC20 contract and was the original minter of the ERC20 tokens.
-		// Since we're sending them away and want accurate total supply tracking, the
-		// tokens need to be burned.
-		_, err := p.evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
-		if err != nil {
-			return nil, fmt.Errorf("ERC20.Burn: %w", err)
-		}
-	} else {
-		// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
-		// any operation that has the potential to use Bank send methods. This will
-		// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
-		// recorded if wei (NIBI) is transferred.
-		err = p.evmKeeper.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coinToSend))
-		if err != nil {
-			return nil, fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
-				evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), caller.Hex(), err,
-			)
-		}
-	}
+	return method.Outputs.Pack(coinSent.Amount.BigInt())
+}
 
-	// Transfer the bank coin
-	//
-	// NOTE: The NibiruBankKeeper needs to reference the current [vm.StateDB] before
-	// any operation that has the potential to use Bank send methods. This will
-	// guarantee that [evmkeeper.Keeper.SetAccBalance] journal changes are
-	// recorded if wei (NIBI) is transferred.
-	err = p.evmKeeper.Bank.SendCoinsFromModuleToAccount(
-		ctx,
-		evm.ModuleName,
-		eth.EthAddrToNibiruAddr(toAddr),
-		sdk.NewCoins(coinToSend),
+func (p precompileFunToken) funTokenForERC20(
+	ctx sdk.Context, erc20 gethcommon.Address,
+) (funtoken evm.FunToken, err error) {
+	funtokens := p.evmKeeper.FunTokens.Collect(
+		ctx, p.evmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20),
 	)
-	if err != nil {
-		return nil, fmt.Errorf("send failed for module \"%s\" (%s): contract caller %s: %w",
-			evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), caller.Hex(), err,
-		)
+	if len(funtokens) != 1 {
+		return funtoken, fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20.Hex())
 	}
-
-	return method.Outputs.Pack(gotAmount)
+	return funtokens[0], nil
 }
 
 func (p precompileFunToken) parseArgsSendToBank(args []any) (
@@ -323,7 +292,15 @@ func (p precompileFunToken) balance(
 	if err != nil {
 		return
 	}
-	bankBal := p.evmKeeper.Bank.GetBalance(ctx, addrBech32, funtoken.BankDenom).Amount.BigInt()
+	// The bank balance is given in ERC20 units, so that the two balances add
+	// up for a scaled or rebasing FunToken.
+	bankBal, err := p.evmKeeper.FunTokenBankToERC20(
+		ctx, evmObj, funtoken,
+		p.evmKeeper.Bank.GetBalance(ctx, addrBech32, funtoken.BankDenom).Amount.BigInt(),
+	)
+	if err != nil {
+		return
+	}
 
 	return method.Outputs.Pack([]any{
 		erc20Bal,
@@ -576,7 +553,7 @@ func (p precompileFunToken) sendToEvm(
 	}
 
 	// parse the `to` argument as hex or bech32 address
-	toEthAddr, err := parseToAddr(toStr)
+	toEthAddr, err := eth.ParseBech32OrHexAddr(toStr)
 	if err != nil {
 		return nil, fmt.Errorf("recipient address invalid: %w", err)
 	}
@@ -592,14 +569,22 @@ func (p precompileFunToken) sendToEvm(
 		return nil, fmt.Errorf("failed to send coins to module: %w", err)
 	}
 
-	// 2) mint (or unescrow) the ERC20
+	// 2) mint (or unescrow) the ERC20, scaled up for a scaled FunToken and
+	// pro-rated for a rebasing one
 	erc20Addr := funtoken.Erc20Addr.Address
+	erc20Amount, err := p.evmKeeper.FunTokenBankToERC20(ctx, evmObj, funtoken, coinToSend.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	if erc20Amount.Sign() != 1 {
+		return nil, fmt.Errorf("%s is worth less than one base unit of ERC20 %s", coinToSend, erc20Addr.Hex())
+	}
 	actualAmt, err := p.mintOrUnescrowERC20(
 		ctx,
-		erc20Addr,                  /*erc20Contract*/
-		toEthAddr,                  /*to*/
-		coinToSend.Amount.BigInt(), /*amount*/
-		funtoken,                   /*funtoken*/
+		erc20Addr,   /*erc20Contract*/
+		toEthAddr,   /*to*/
+		erc20Amount, /*amount*/
+		funtoken,    /*funtoken*/
 		evmObj,
 	)
 	if err != nil {
@@ -613,6 +598,9 @@ func (p precompileFunToken) sendToEvm(
 		if err != nil {
 			return nil, fmt.Errorf("failed to burn coins: %w", err)
 		}
+		if err := p.evmKeeper.CheckFunTokenEscrow(ctx, evmObj, funtoken); err != nil {
+			return nil, err
+		}
 	}
 
 	// return the number of tokens minted
@@ -681,21 +669,6 @@ func parseArgsSendToEvm(args []any) (bankDenom string, amount *big.Int, to strin
 	return
 }
 
-// parse a user-specified address "toStr" that might be bech32 or hex
-func parseToAddr(toStr string) (gethcommon.Address, error) {
-	// check if bech32 or hex
-	if err := eth.ValidateAddress(toStr); err == nil {
-		// hex address
-		return gethcommon.HexToAddress(toStr), nil
-	}
-	// else try bech32
-	nibAddr, e := sdk.AccAddressFromBech32(toStr)
-	if e != nil {
-		return gethcommon.Address{}, fmt.Errorf("invalid bech32 or hex address: %w", e)
-	}
-	return eth.NibiruAddrToEthAddr(nibAddr), nil
-}
-
 func (p precompileFunToken) bankMsgSend(
 	startResult OnRunStartResult,
 	caller gethcommon.Address,
@@ -713,7 +686,7 @@ func (p precompileFunToken) bankMsgSend(
 	}
 
 	// parse toStr (bech32 or hex)
-	toEthAddr, e := parseToAddr(toStr)
+	toEthAddr, e := eth.ParseBech32OrHexAddr(toStr)
 	if e != nil {
 		return nil, e
 	}
@@ -854,3 +827,443 @@ func parseArgsBankMsgSend(args []any) (toStr, denom string, amount *big.Int, err
 
 	return
 }
+
+// sendNftToBank: Implements "IFunToken.sendNftToBank"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function sendNftToBank(
+//	    address erc721,
+//	    uint256 tokenId,
+//	    string calldata to
+//	) external returns (string memory nftId);
+//	```
+//
+// The caller transfers the ERC-721 token to the EVM module account, which
+// mints the "x/nft" token of the same ID to the recipient. The same conversion
+// is available to Cosmos accounts through "MsgConvertEvmToNFT".
+func (p precompileFunToken) sendNftToBank(
+	startResult OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+	evmObj *vm.EVM,
+) (bz []byte, err error) {
+	ctx, method, args := startResult.CacheCtx, startResult.Method, startResult.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	erc721, tokenID, to, err := p.parseArgsSendNftToBank(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	// ERC-721 must have FunNFT mapping
+	funnft, found := p.evmKeeper.FunNFTs.GetByERC721(ctx, erc721)
+	if !found {
+		return nil, fmt.Errorf("no FunNFT mapping exists for ERC721 \"%s\"", erc721.Hex())
+	}
+
+	// The "to" argument must be a valid nibi or EVM address
+	toAddr, err := eth.ParseBech32OrHexAddr(to)
+	if err != nil {
+		return nil, fmt.Errorf("recipient address invalid (%s): %w", to, err)
+	}
+
+	nftID, _, err := p.evmKeeper.SendERC721ToNFT(ctx, evmObj, funnft, caller, toAddr, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(nftID)
+}
+
+func (p precompileFunToken) parseArgsSendNftToBank(args []any) (
+	erc721 gethcommon.Address,
+	tokenID *big.Int,
+	to string,
+	err error,
+) {
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	erc721, ok := args[argIdx].(gethcommon.Address)
+	if !ok {
+		err = ErrArgTypeValidation("address erc721", args[argIdx])
+		return
+	}
+
+	argIdx++
+	tokenID, ok = args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 tokenId", args[argIdx])
+		return
+	}
+
+	argIdx++
+	to, ok = args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string to", args[argIdx])
+		return
+	}
+
+	return
+}
+
+// sendNftToEvm: Implements "IFunToken.sendNftToEvm"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function sendNftToEvm(
+//	    string calldata classId,
+//	    string calldata nftId,
+//	    string calldata to
+//	) external returns (uint256 tokenId);
+//	```
+//
+// The caller's "x/nft" token is burned and the EVM module account transfers
+// the escrowed ERC-721 token of the same ID to the recipient.
+func (p precompileFunToken) sendNftToEvm(
+	startResult OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+	evmObj *vm.EVM,
+) (bz []byte, err error) {
+	ctx, method, args := startResult.CacheCtx, startResult.Method, startResult.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	classID, nftID, to, err := parseArgsSendNftToEvm(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	funnft, err := p.evmKeeper.GetFunNFTByClassID(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse the `to` argument as hex or bech32 address
+	toEthAddr, err := eth.ParseBech32OrHexAddr(to)
+	if err != nil {
+		return nil, fmt.Errorf("recipient address invalid: %w", err)
+	}
+
+	_, err = p.evmKeeper.SendNFTToERC721(
+		ctx, evmObj, funnft, eth.EthAddrToNibiruAddr(caller), nftID, toEthAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID, err := evm.FunNFTTokenID(nftID)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(tokenID)
+}
+
+// parse the arguments: (string classId, string nftId, string to)
+func parseArgsSendNftToEvm(args []any) (classID, nftID, to string, err error) {
+	if e := assertNumArgs(args, 3); e != nil {
+		return "", "", "", e
+	}
+	var ok bool
+	// 0) classId
+	classID, ok = args[0].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string classId", args[0])
+		return
+	}
+	// 1) nftId
+	nftID, ok = args[1].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string nftId", args[1])
+		return
+	}
+	// 2) to
+	to, ok = args[2].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string to", args[2])
+		return
+	}
+	return
+}
+
+// funTokenRecipient is the Go type of the "IFunToken.Recipient" struct as
+// unpacked from the ABI.
+type funTokenRecipient = struct {
+	To     string   `json:"to"`
+	Amount *big.Int `json:"amount"`
+}
+
+// sendToBankBatch: Implements "IFunToken.sendToBankBatch"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function sendToBankBatch(
+//	    address erc20,
+//	    Recipient[] calldata recipients
+//	) external returns (uint256[] memory sentAmounts);
+//	```
+//
+// Each recipient is handled exactly like a call to [sendToBank]. Because all
+// of the transfers happen in the cache context of a single precompile call,
+// either all of them succeed or the whole call reverts.
+func (p precompileFunToken) sendToBankBatch(
+	startResult OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+	evmObj *vm.EVM,
+) (bz []byte, err error) {
+	ctx, method, args := startResult.CacheCtx, startResult.Method, startResult.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	erc20, recipients, err := parseArgsSendToBankBatch(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	funtoken, err := p.funTokenForERC20(ctx, erc20)
+	if err != nil {
+		return nil, err
+	}
+
+	sentAmounts := make([]*big.Int, len(recipients))
+	for i, recipient := range recipients {
+		toAddr, err := eth.ParseBech32OrHexAddr(recipient.To)
+		if err != nil {
+			return nil, fmt.Errorf("sendToBank failed at index %d: recipient address invalid (%s): %w", i, recipient.To, err)
+		}
+		coinSent, _, err := p.evmKeeper.SendERC20ToBank(ctx, evmObj, funtoken, caller, toAddr, recipient.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("sendToBank failed at index %d: %w", i, err)
+		}
+		sentAmounts[i] = coinSent.Amount.BigInt()
+	}
+
+	return method.Outputs.Pack(sentAmounts)
+}
+
+// parse the arguments: (address erc20, Recipient[] recipients)
+func parseArgsSendToBankBatch(args []any) (
+	erc20 gethcommon.Address,
+	recipients []funTokenRecipient,
+	err error,
+) {
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+
+	erc20, ok := args[0].(gethcommon.Address)
+	if !ok {
+		err = ErrArgTypeValidation("address erc20", args[0])
+		return
+	}
+
+	recipients, ok = args[1].([]funTokenRecipient)
+	if !ok {
+		err = ErrArgTypeValidation("Recipient[] recipients", args[1])
+		return
+	}
+	if len(recipients) == 0 {
+		err = fmt.Errorf("recipients must not be empty")
+		return
+	}
+
+	return
+}
+
+// sendToBankFrom: Implements "IFunToken.sendToBankFrom"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function sendToBankFrom(
+//	    address owner,
+//	    address erc20,
+//	    uint256 amount,
+//	    string calldata to
+//	) external returns (uint256 sentAmount);
+//	```
+//
+// Same as [sendToBank], except that the caller moves the ERC20 tokens of
+// "owner" to the EVM module account with "ERC20.transferFrom", which consumes
+// the allowance "owner" gave to the caller.
+func (p precompileFunToken) sendToBankFrom(
+	startResult OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+	evmObj *vm.EVM,
+) (bz []byte, err error) {
+	ctx, method, args := startResult.CacheCtx, startResult.Method, startResult.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	owner, erc20, amount, to, err := parseArgsSendToBankFrom(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	funtoken, err := p.funTokenForERC20(ctx, erc20)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddr, err := eth.ParseBech32OrHexAddr(to)
+	if err != nil {
+		return nil, fmt.Errorf("recipient address invalid (%s): %w", to, err)
+	}
+
+	coinSent, _, err := p.evmKeeper.SendERC20ToBankFrom(ctx, evmObj, funtoken, caller, owner, toAddr, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(coinSent.Amount.BigInt())
+}
+
+// parse the arguments: (address owner, address erc20, uint256 amount, string to)
+func parseArgsSendToBankFrom(args []any) (
+	owner gethcommon.Address,
+	erc20 gethcommon.Address,
+	amount *big.Int,
+	to string,
+	err error,
+) {
+	if e := assertNumArgs(args, 4); e != nil {
+		err = e
+		return
+	}
+
+	owner, ok := args[0].(gethcommon.Address)
+	if !ok {
+		err = ErrArgTypeValidation("address owner", args[0])
+		return
+	}
+
+	erc20, ok = args[1].(gethcommon.Address)
+	if !ok {
+		err = ErrArgTypeValidation("address erc20", args[1])
+		return
+	}
+
+	amount, ok = args[2].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[2])
+		return
+	}
+
+	to, ok = args[3].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string to", args[3])
+		return
+	}
+
+	return
+}
+
+// bankMsgSendBatch: Implements "IFunToken.bankMsgSendBatch"
+//
+// The "args" populate the following function signature in Solidity:
+//
+//	```solidity
+//	function bankMsgSendBatch(
+//	    string calldata bankDenom,
+//	    Recipient[] calldata recipients
+//	) external returns (bool success);
+//	```
+//
+// The coins are sent with a single "cosmos.bank.v1beta1.MsgMultiSend" from
+// the caller to all of the recipients, which is atomic and, like
+// [bankMsgSend], refuses to send funds to addresses blocked by the Bank module.
+func (p precompileFunToken) bankMsgSendBatch(
+	startResult OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) ([]byte, error) {
+	ctx, method, args := startResult.CacheCtx, startResult.Method, startResult.Args
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+
+	denom, recipients, err := parseArgsBankMsgSendBatch(args)
+	if err != nil {
+		return nil, ErrInvalidArgs(err)
+	}
+
+	total := new(big.Int)
+	outputs := make([]bank.Output, len(recipients))
+	for i, recipient := range recipients {
+		toEthAddr, err := eth.ParseBech32OrHexAddr(recipient.To)
+		if err != nil {
+			return nil, fmt.Errorf("bankMsgSendBatch: invalid recipient at index %d: %w", i, err)
+		}
+		outputs[i] = bank.Output{
+			Address: eth.EthAddrToNibiruAddr(toEthAddr).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(recipient.Amount))),
+		}
+		total.Add(total, recipient.Amount)
+	}
+	if total.BitLen() > sdkmath.MaxBitLen {
+		return nil, fmt.Errorf("bankMsgSendBatch: total amount overflows")
+	}
+
+	bankMsg := &bank.MsgMultiSend{
+		Inputs: []bank.Input{{
+			Address: eth.EthAddrToNibiruAddr(caller).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(total))),
+		}},
+		Outputs: outputs,
+	}
+	if err := bankMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if _, err := bankkeeper.NewMsgServerImpl(p.evmKeeper.Bank).MultiSend(
+		sdk.WrapSDKContext(ctx), bankMsg,
+	); err != nil {
+		return nil, fmt.Errorf("bankMsgSendBatch: %w", err)
+	}
+	return method.Outputs.Pack(true)
+}
+
+// parse the arguments: (string bankDenom, Recipient[] recipients)
+func parseArgsBankMsgSendBatch(args []any) (
+	denom string,
+	recipients []funTokenRecipient,
+	err error,
+) {
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string bankDenom", args[0])
+		return
+	}
+
+	recipients, ok = args[1].([]funTokenRecipient)
+	if !ok {
+		err = ErrArgTypeValidation("Recipient[] recipients", args[1])
+		return
+	}
+	if len(recipients) == 0 {
+		err = fmt.Errorf("recipients must not be empty")
+		return
+	}
+
+	return
+}
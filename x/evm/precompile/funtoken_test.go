@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -801,3 +802,182 @@ func (s *FuntokenSuite) TestGetErc20Address() {
 		s.Require().ErrorContains(err, "invalid method args") // Error comes from arg parsing/validation
 	})
 }
+
+// funTokenRecipients builds the "IFunToken.Recipient[]" argument of the batch
+// methods of the FunToken precompile.
+func funTokenRecipients(tos []string, amounts []*big.Int) []struct {
+	To     string   `json:"to"`
+	Amount *big.Int `json:"amount"`
+} {
+	recipients := make([]struct {
+		To     string   `json:"to"`
+		Amount *big.Int `json:"amount"`
+	}, len(tos))
+	for i := range tos {
+		recipients[i].To = tos[i]
+		recipients[i].Amount = amounts[i]
+	}
+	return recipients
+}
+
+func (s *FuntokenSuite) TestSendToBankBatchAndFrom() {
+	deps := evmtest.NewTestDeps()
+	alice := evmtest.NewEthPrivAcc()
+	bob := evmtest.NewEthPrivAcc()
+	router := evmtest.NewEthPrivAcc()
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		deps.EvmKeeper.FeeForCreateFunToken(deps.Ctx),
+	))
+	erc20Resp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+	s.Require().NoError(err)
+	erc20Addr := erc20Resp.ContractAddr
+	bankDenom := "erc20/" + erc20Addr.Hex()
+	_, err = deps.EvmKeeper.CreateFunToken(
+		sdk.WrapSDKContext(deps.Ctx),
+		&evm.MsgCreateFunToken{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			FromErc20: &eth.EIP55Addr{Address: erc20Addr},
+		},
+	)
+	s.Require().NoError(err)
+
+	callContract := func(
+		from, contract gethcommon.Address, abi *gethabi.ABI, method string, args ...any,
+	) (*evm.MsgEthereumTxResponse, error) {
+		contractInput, err := abi.Pack(method, args...)
+		s.Require().NoError(err)
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		evmObj, _ := deps.NewEVM()
+		return deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx, evmObj, from, &contract, true /*commit*/, contractInput, evmtest.FunTokenGasLimitSendToEvm,
+		)
+	}
+	assertBalances := func(erc20Bob, bankAlice, bankBob *big.Int) {
+		evmObj, _ := deps.NewEVM()
+		evmtest.AssertERC20BalanceEqualWithDescription(s.T(), deps, evmObj, erc20Addr, bob.EthAddr, erc20Bob, "bob erc20")
+		evmtest.AssertBankBalanceEqualWithDescription(s.T(), deps, bankDenom, alice.EthAddr, bankAlice, "alice bank")
+		evmtest.AssertBankBalanceEqualWithDescription(s.T(), deps, bankDenom, bob.EthAddr, bankBob, "bob bank")
+	}
+
+	_, err = callContract(deps.Sender.EthAddr, erc20Addr, embeds.SmartContract_TestERC20.ABI, "transfer", bob.EthAddr, bigTokens(500))
+	s.Require().NoError(err)
+
+	s.Run("sendToBankBatch", func() {
+		resp, err := callContract(
+			bob.EthAddr, precompile.PrecompileAddr_FunToken, embeds.SmartContract_FunToken.ABI,
+			string(precompile.FunTokenMethod_sendToBankBatch),
+			erc20Addr,
+			funTokenRecipients(
+				[]string{alice.NibiruAddr.String(), bob.EthAddr.Hex()},
+				[]*big.Int{bigTokens(100), bigTokens(50)},
+			),
+		)
+		s.Require().NoError(err)
+		out, err := embeds.SmartContract_FunToken.ABI.Unpack(
+			string(precompile.FunTokenMethod_sendToBankBatch), resp.Ret,
+		)
+		s.Require().NoError(err)
+		s.Require().Equal([]*big.Int{bigTokens(100), bigTokens(50)}, out[0])
+		assertBalances(bigTokens(350), bigTokens(100), bigTokens(50))
+	})
+
+	s.Run("sendToBankBatch is atomic", func() {
+		_, err := callContract(
+			bob.EthAddr, precompile.PrecompileAddr_FunToken, embeds.SmartContract_FunToken.ABI,
+			string(precompile.FunTokenMethod_sendToBankBatch),
+			erc20Addr,
+			funTokenRecipients(
+				[]string{alice.NibiruAddr.String(), "invalid"},
+				[]*big.Int{bigTokens(100), bigTokens(50)},
+			),
+		)
+		s.Require().ErrorContains(err, "sendToBank failed at index 1")
+		assertBalances(bigTokens(350), bigTokens(100), bigTokens(50))
+	})
+
+	sendToBankFrom := func(amount *big.Int) error {
+		_, err := callContract(
+			router.EthAddr, precompile.PrecompileAddr_FunToken, embeds.SmartContract_FunToken.ABI,
+			string(precompile.FunTokenMethod_sendToBankFrom),
+			bob.EthAddr, erc20Addr, amount, alice.NibiruAddr.String(),
+		)
+		return err
+	}
+
+	s.Run("sendToBankFrom without allowance", func() {
+		s.Require().ErrorContains(sendToBankFrom(bigTokens(10)), "ERC20.transferFrom")
+		assertBalances(bigTokens(350), bigTokens(100), bigTokens(50))
+	})
+
+	s.Run("sendToBankFrom consumes the allowance", func() {
+		_, err := callContract(bob.EthAddr, erc20Addr, embeds.SmartContract_TestERC20.ABI, "approve", router.EthAddr, bigTokens(100))
+		s.Require().NoError(err)
+
+		s.Require().NoError(sendToBankFrom(bigTokens(60)))
+		assertBalances(bigTokens(290), bigTokens(160), bigTokens(50))
+
+		s.Require().ErrorContains(sendToBankFrom(bigTokens(60)), "ERC20.transferFrom")
+		assertBalances(bigTokens(290), bigTokens(160), bigTokens(50))
+	})
+}
+
+func (s *FuntokenSuite) TestBankMsgSendBatch() {
+	deps := evmtest.NewTestDeps()
+	alice := evmtest.NewEthPrivAcc()
+	bob := evmtest.NewEthPrivAcc()
+	bankDenom := evm.EVMBankDenom
+
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx, deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(bankDenom, 1000)),
+	))
+
+	bankMsgSendBatch := func(tos []string, amounts []*big.Int) error {
+		contractInput, err := embeds.SmartContract_FunToken.ABI.Pack(
+			string(precompile.FunTokenMethod_bankMsgSendBatch),
+			bankDenom, funTokenRecipients(tos, amounts),
+		)
+		s.Require().NoError(err)
+		deps.Ctx = deps.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		evmObj, _ := deps.NewEVM()
+		_, err = deps.EvmKeeper.CallContractWithInput(
+			deps.Ctx, evmObj, deps.Sender.EthAddr, &precompile.PrecompileAddr_FunToken, true /*commit*/, contractInput, keeper.Erc20GasLimitExecute,
+		)
+		return err
+	}
+	assertBalances := func(sender, aliceBal, bobBal int64) {
+		evmtest.AssertBankBalanceEqualWithDescription(s.T(), deps, bankDenom, deps.Sender.EthAddr, big.NewInt(sender), "sender")
+		evmtest.AssertBankBalanceEqualWithDescription(s.T(), deps, bankDenom, alice.EthAddr, big.NewInt(aliceBal), "alice")
+		evmtest.AssertBankBalanceEqualWithDescription(s.T(), deps, bankDenom, bob.EthAddr, big.NewInt(bobBal), "bob")
+	}
+
+	s.Run("happy: send to hex and bech32 recipients", func() {
+		s.Require().NoError(bankMsgSendBatch(
+			[]string{alice.EthAddr.Hex(), bob.NibiruAddr.String()},
+			[]*big.Int{big.NewInt(300), big.NewInt(200)},
+		))
+		assertBalances(500, 300, 200)
+	})
+
+	s.Run("sad: insufficient funds", func() {
+		s.Require().ErrorContains(bankMsgSendBatch(
+			[]string{alice.EthAddr.Hex(), bob.NibiruAddr.String()},
+			[]*big.Int{big.NewInt(300), big.NewInt(300)},
+		), "insufficient funds")
+		assertBalances(500, 300, 200)
+	})
+
+	s.Run("sad: blocked recipient", func() {
+		s.Require().ErrorContains(bankMsgSendBatch(
+			[]string{alice.EthAddr.Hex(), evm.EVM_MODULE_ADDRESS.Hex()},
+			[]*big.Int{big.NewInt(1), big.NewInt(1)},
+		), "not allowed to receive funds")
+		assertBalances(500, 300, 200)
+	})
+
+	s.Run("sad: no recipients", func() {
+		s.Require().ErrorContains(bankMsgSendBatch(nil, nil), "recipients must not be empty")
+	})
+}
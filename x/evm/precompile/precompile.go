@@ -234,8 +234,14 @@ var isMutation map[PrecompileMethod]bool = map[PrecompileMethod]bool{
 	FunTokenMethod_whoAmI:          false,
 	FunTokenMethod_getErc20Address: false,
 
-	FunTokenMethod_sendToEvm:   true,
-	FunTokenMethod_bankMsgSend: true,
+	FunTokenMethod_sendToEvm:     true,
+	FunTokenMethod_bankMsgSend:   true,
+	FunTokenMethod_sendNftToBank: true,
+	FunTokenMethod_sendNftToEvm:  true,
+
+	FunTokenMethod_sendToBankBatch:  true,
+	FunTokenMethod_sendToBankFrom:   true,
+	FunTokenMethod_bankMsgSendBatch: true,
 
 	OracleMethod_queryExchangeRate: false,
 }
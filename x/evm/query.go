@@ -99,6 +99,19 @@ func (req *QueryStorageRequest) Validate() error {
 	return nil
 }
 
+func (req *QueryStorageProofRequest) Validate() error {
+	if req == nil {
+		return common.ErrNilGrpcMsg
+	}
+	if err := eth.ValidateAddress(req.Address); err != nil {
+		return status.Error(
+			codes.InvalidArgument,
+			ErrZeroAddress.Error(),
+		)
+	}
+	return nil
+}
+
 func (req *QueryCodeRequest) Validate() error {
 	if req == nil {
 		return common.ErrNilGrpcMsg
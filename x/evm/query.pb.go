@@ -455,6 +455,172 @@ func (m *QueryStorageResponse) GetValue() string {
 	return ""
 }
 
+// QueryStorageProofRequest is the request type for the Query/StorageProof RPC
+// method.
+type QueryStorageProofRequest struct {
+	// address is the ethereum hex address to query the storage proofs for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// keys defines the keys of the storage slots to prove.
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *QueryStorageProofRequest) Reset()         { *m = QueryStorageProofRequest{} }
+func (m *QueryStorageProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProofRequest) ProtoMessage()    {}
+func (*QueryStorageProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{8}
+}
+func (m *QueryStorageProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProofRequest.Merge(m, src)
+}
+func (m *QueryStorageProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProofRequest proto.InternalMessageInfo
+
+// QueryStorageProofResponse is the response type for the Query/StorageProof
+// RPC method.
+type QueryStorageProofResponse struct {
+	// storage_root is the hex-encoded root hash of the Ethereum storage trie of
+	// the account.
+	StorageRoot string `protobuf:"bytes,1,opt,name=storage_root,json=storageRoot,proto3" json:"storage_root,omitempty"`
+	// proofs defines the proofs of the storage slots, in the order of the keys
+	// of the request.
+	Proofs []StorageSlotProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs"`
+}
+
+func (m *QueryStorageProofResponse) Reset()         { *m = QueryStorageProofResponse{} }
+func (m *QueryStorageProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProofResponse) ProtoMessage()    {}
+func (*QueryStorageProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{9}
+}
+func (m *QueryStorageProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProofResponse.Merge(m, src)
+}
+func (m *QueryStorageProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageProofResponse proto.InternalMessageInfo
+
+func (m *QueryStorageProofResponse) GetStorageRoot() string {
+	if m != nil {
+		return m.StorageRoot
+	}
+	return ""
+}
+
+func (m *QueryStorageProofResponse) GetProofs() []StorageSlotProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+// StorageSlotProof is the value of a storage slot and its Merkle proof against
+// the storage root of the account.
+type StorageSlotProof struct {
+	// key defines the key of the storage slot.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value defines the storage state value hash of the slot.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// proof is the list of RLP-encoded trie nodes on the path from the storage
+	// root to the slot.
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *StorageSlotProof) Reset()         { *m = StorageSlotProof{} }
+func (m *StorageSlotProof) String() string { return proto.CompactTextString(m) }
+func (*StorageSlotProof) ProtoMessage()    {}
+func (*StorageSlotProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{10}
+}
+func (m *StorageSlotProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageSlotProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageSlotProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageSlotProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageSlotProof.Merge(m, src)
+}
+func (m *StorageSlotProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageSlotProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageSlotProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageSlotProof proto.InternalMessageInfo
+
+func (m *StorageSlotProof) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageSlotProof) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *StorageSlotProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	// address is the ethereum hex address to query the code for.
@@ -465,7 +631,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{8}
+	return fileDescriptor_ffa36cdc5add14ed, []int{11}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +671,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{9}
+	return fileDescriptor_ffa36cdc5add14ed, []int{12}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,7 +719,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{10}
+	return fileDescriptor_ffa36cdc5add14ed, []int{13}
 }
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,7 +760,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{11}
+	return fileDescriptor_ffa36cdc5add14ed, []int{14}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +811,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{12}
+	return fileDescriptor_ffa36cdc5add14ed, []int{15}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +850,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{13}
+	return fileDescriptor_ffa36cdc5add14ed, []int{16}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,7 +902,7 @@ func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{14}
+	return fileDescriptor_ffa36cdc5add14ed, []int{17}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +969,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{15}
+	return fileDescriptor_ffa36cdc5add14ed, []int{18}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,7 +1032,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{16}
+	return fileDescriptor_ffa36cdc5add14ed, []int{19}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1134,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{17}
+	return fileDescriptor_ffa36cdc5add14ed, []int{20}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1194,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{18}
+	return fileDescriptor_ffa36cdc5add14ed, []int{21}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1123,7 +1289,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{19}
+	return fileDescriptor_ffa36cdc5add14ed, []int{22}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,7 +1334,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{20}
+	return fileDescriptor_ffa36cdc5add14ed, []int{23}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1210,7 +1376,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{21}
+	return fileDescriptor_ffa36cdc5add14ed, []int{24}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1249,7 +1415,7 @@ func (m *QueryFunTokenMappingRequest) Reset()         { *m = QueryFunTokenMappin
 func (m *QueryFunTokenMappingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFunTokenMappingRequest) ProtoMessage()    {}
 func (*QueryFunTokenMappingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{22}
+	return fileDescriptor_ffa36cdc5add14ed, []int{25}
 }
 func (m *QueryFunTokenMappingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,7 +1453,7 @@ func (m *QueryFunTokenMappingResponse) Reset()         { *m = QueryFunTokenMappi
 func (m *QueryFunTokenMappingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFunTokenMappingResponse) ProtoMessage()    {}
 func (*QueryFunTokenMappingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{23}
+	return fileDescriptor_ffa36cdc5add14ed, []int{26}
 }
 func (m *QueryFunTokenMappingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,6 +1482,164 @@ func (m *QueryFunTokenMappingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryFunTokenMappingResponse proto.InternalMessageInfo
 
+type QueryFunTokenEscrowRequest struct {
+	// Either the hexadecimal-encoded ERC20 contract address or denomination of the
+	// Bank Coin.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryFunTokenEscrowRequest) Reset()         { *m = QueryFunTokenEscrowRequest{} }
+func (m *QueryFunTokenEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenEscrowRequest) ProtoMessage()    {}
+func (*QueryFunTokenEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{27}
+}
+func (m *QueryFunTokenEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenEscrowRequest.Merge(m, src)
+}
+func (m *QueryFunTokenEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenEscrowRequest proto.InternalMessageInfo
+
+type QueryFunTokenEscrowResponse struct {
+	// fun_token is a mapping between the Bank Coin and the ERC20 contract address
+	FunToken FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token"`
+	// escrow compares the ERC20 tokens escrowed for the mapping with the supply
+	// of its Bank Coin
+	Escrow FunTokenEscrow `protobuf:"bytes,2,opt,name=escrow,proto3" json:"escrow"`
+}
+
+func (m *QueryFunTokenEscrowResponse) Reset()         { *m = QueryFunTokenEscrowResponse{} }
+func (m *QueryFunTokenEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunTokenEscrowResponse) ProtoMessage()    {}
+func (*QueryFunTokenEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{28}
+}
+func (m *QueryFunTokenEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunTokenEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunTokenEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunTokenEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunTokenEscrowResponse.Merge(m, src)
+}
+func (m *QueryFunTokenEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunTokenEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunTokenEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunTokenEscrowResponse proto.InternalMessageInfo
+
+type QueryFunNFTMappingRequest struct {
+	// Either the hexadecimal-encoded ERC-721 contract address or ID of the
+	// "x/nft" class.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryFunNFTMappingRequest) Reset()         { *m = QueryFunNFTMappingRequest{} }
+func (m *QueryFunNFTMappingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunNFTMappingRequest) ProtoMessage()    {}
+func (*QueryFunNFTMappingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{29}
+}
+func (m *QueryFunNFTMappingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunNFTMappingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunNFTMappingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunNFTMappingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunNFTMappingRequest.Merge(m, src)
+}
+func (m *QueryFunNFTMappingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunNFTMappingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunNFTMappingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunNFTMappingRequest proto.InternalMessageInfo
+
+type QueryFunNFTMappingResponse struct {
+	// fun_nft is a mapping between the "x/nft" class and the ERC-721 contract
+	// address
+	FunNft *FunNFT `protobuf:"bytes,1,opt,name=fun_nft,json=funNft,proto3" json:"fun_nft,omitempty"`
+}
+
+func (m *QueryFunNFTMappingResponse) Reset()         { *m = QueryFunNFTMappingResponse{} }
+func (m *QueryFunNFTMappingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunNFTMappingResponse) ProtoMessage()    {}
+func (*QueryFunNFTMappingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{30}
+}
+func (m *QueryFunNFTMappingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunNFTMappingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunNFTMappingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunNFTMappingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunNFTMappingResponse.Merge(m, src)
+}
+func (m *QueryFunNFTMappingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunNFTMappingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunNFTMappingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunNFTMappingResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryEthAccountRequest)(nil), "eth.evm.v1.QueryEthAccountRequest")
 	proto.RegisterType((*QueryEthAccountResponse)(nil), "eth.evm.v1.QueryEthAccountResponse")
@@ -1325,6 +1649,9 @@ func init() {
 	proto.RegisterType((*QueryBalanceResponse)(nil), "eth.evm.v1.QueryBalanceResponse")
 	proto.RegisterType((*QueryStorageRequest)(nil), "eth.evm.v1.QueryStorageRequest")
 	proto.RegisterType((*QueryStorageResponse)(nil), "eth.evm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryStorageProofRequest)(nil), "eth.evm.v1.QueryStorageProofRequest")
+	proto.RegisterType((*QueryStorageProofResponse)(nil), "eth.evm.v1.QueryStorageProofResponse")
+	proto.RegisterType((*StorageSlotProof)(nil), "eth.evm.v1.StorageSlotProof")
 	proto.RegisterType((*QueryCodeRequest)(nil), "eth.evm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "eth.evm.v1.QueryCodeResponse")
 	proto.RegisterType((*QueryTxLogsRequest)(nil), "eth.evm.v1.QueryTxLogsRequest")
@@ -1341,112 +1668,133 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "eth.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryFunTokenMappingRequest)(nil), "eth.evm.v1.QueryFunTokenMappingRequest")
 	proto.RegisterType((*QueryFunTokenMappingResponse)(nil), "eth.evm.v1.QueryFunTokenMappingResponse")
+	proto.RegisterType((*QueryFunTokenEscrowRequest)(nil), "eth.evm.v1.QueryFunTokenEscrowRequest")
+	proto.RegisterType((*QueryFunTokenEscrowResponse)(nil), "eth.evm.v1.QueryFunTokenEscrowResponse")
+	proto.RegisterType((*QueryFunNFTMappingRequest)(nil), "eth.evm.v1.QueryFunNFTMappingRequest")
+	proto.RegisterType((*QueryFunNFTMappingResponse)(nil), "eth.evm.v1.QueryFunNFTMappingResponse")
 }
 
 func init() { proto.RegisterFile("eth/evm/v1/query.proto", fileDescriptor_ffa36cdc5add14ed) }
 
 var fileDescriptor_ffa36cdc5add14ed = []byte{
-	// 1592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0x5b, 0xc5,
-	0x16, 0x8f, 0x63, 0x27, 0x76, 0x8e, 0xd3, 0x24, 0x6f, 0x9a, 0x36, 0x89, 0x93, 0xd8, 0xc9, 0xcd,
-	0x7b, 0x49, 0xda, 0xd7, 0xde, 0xfb, 0xe2, 0x3e, 0xbd, 0xa7, 0x57, 0xbd, 0x0a, 0xea, 0x28, 0x0d,
-	0xa5, 0x7f, 0xd4, 0x9a, 0x00, 0x12, 0x08, 0x59, 0x63, 0x7b, 0x72, 0x7d, 0x15, 0xfb, 0x8e, 0xeb,
-	0x19, 0xbb, 0x0e, 0x25, 0x1b, 0xba, 0x41, 0x42, 0x95, 0x2a, 0xf1, 0x05, 0xba, 0xe2, 0x23, 0xc0,
-	0x57, 0xe8, 0x8e, 0x4a, 0x6c, 0x10, 0x8b, 0x82, 0x5a, 0x16, 0xac, 0x59, 0xb2, 0x42, 0xf3, 0xcf,
-	0xbe, 0xb6, 0xaf, 0x13, 0xaa, 0xc2, 0x8e, 0xd5, 0x9d, 0x39, 0x73, 0xfe, 0xfc, 0xe6, 0xcc, 0xb9,
-	0xe7, 0xfc, 0xe0, 0x2c, 0xe1, 0x15, 0x87, 0xb4, 0x6a, 0x4e, 0x6b, 0xcb, 0xb9, 0xd7, 0x24, 0x8d,
-	0x43, 0xbb, 0xde, 0xa0, 0x9c, 0x22, 0x20, 0xbc, 0x62, 0x93, 0x56, 0xcd, 0x6e, 0x6d, 0xa5, 0xce,
-	0x97, 0x28, 0xab, 0x51, 0xe6, 0x14, 0x31, 0x23, 0x4a, 0xc9, 0x69, 0x6d, 0x15, 0x09, 0xc7, 0x5b,
-	0x4e, 0x1d, 0xbb, 0x9e, 0x8f, 0xb9, 0x47, 0x7d, 0x65, 0x97, 0x9a, 0x0d, 0xf8, 0x13, 0xe6, 0x4a,
-	0x7a, 0x3a, 0x20, 0xe5, 0x6d, 0xa3, 0xea, 0x52, 0x97, 0xca, 0xa5, 0x23, 0x56, 0x5a, 0xba, 0xe4,
-	0x52, 0xea, 0x56, 0x89, 0x83, 0xeb, 0x9e, 0x83, 0x7d, 0x9f, 0x72, 0xe9, 0x9d, 0xe9, 0xd3, 0x8c,
-	0x3e, 0x95, 0xbb, 0x62, 0x73, 0xdf, 0xe1, 0x5e, 0x8d, 0x30, 0x8e, 0x6b, 0x75, 0xa5, 0x60, 0xfd,
-	0x1f, 0xce, 0xde, 0x15, 0x08, 0x77, 0x78, 0xe5, 0x6a, 0xa9, 0x44, 0x9b, 0x3e, 0xcf, 0x93, 0x7b,
-	0x4d, 0xc2, 0x38, 0x9a, 0x87, 0x38, 0x2e, 0x97, 0x1b, 0x84, 0xb1, 0xf9, 0xc8, 0x4a, 0x64, 0x73,
-	0x22, 0x6f, 0xb6, 0x97, 0x13, 0x9f, 0x3d, 0xc9, 0x8c, 0xfc, 0xfc, 0x24, 0x33, 0x62, 0x7d, 0x13,
-	0x81, 0xb9, 0x01, 0x73, 0x56, 0xa7, 0x3e, 0x23, 0xc2, 0xbe, 0x88, 0xab, 0xd8, 0x2f, 0x11, 0x63,
-	0xaf, 0xb7, 0x28, 0x03, 0x49, 0xbd, 0x2c, 0xdc, 0x27, 0xde, 0xfc, 0xa8, 0x3c, 0x05, 0x2d, 0x7a,
-	0x9f, 0x78, 0x68, 0x11, 0x26, 0x4a, 0xb4, 0x4c, 0x0a, 0x15, 0xcc, 0x2a, 0xf3, 0x51, 0x79, 0x9c,
-	0x10, 0x82, 0xb7, 0x30, 0xab, 0xa0, 0x59, 0x18, 0xf3, 0xa9, 0xf0, 0x1a, 0x5b, 0x89, 0x6c, 0xc6,
-	0xf2, 0x6a, 0x23, 0x7c, 0x12, 0x5e, 0x29, 0x18, 0xc4, 0x63, 0xca, 0x27, 0xe1, 0x95, 0xab, 0x4a,
-	0x82, 0xfe, 0x01, 0x53, 0x45, 0x52, 0xaa, 0x5c, 0xca, 0x76, 0x74, 0xc6, 0xa5, 0xce, 0x29, 0x25,
-	0xd5, 0x6a, 0xd6, 0x0d, 0x58, 0x92, 0x17, 0x7a, 0x0f, 0x57, 0xbd, 0x32, 0xe6, 0xb4, 0xd1, 0x97,
-	0x95, 0x55, 0x98, 0x2c, 0x51, 0x9f, 0x15, 0x7a, 0x53, 0x93, 0x14, 0xb2, 0xab, 0x03, 0xe9, 0xf9,
-	0x3c, 0x02, 0xcb, 0x43, 0xbc, 0xe9, 0x24, 0x6d, 0xc0, 0x34, 0x56, 0xa2, 0x3e, 0x8f, 0x53, 0x5a,
-	0x6c, 0xe0, 0xa7, 0x20, 0xc1, 0x04, 0x04, 0x71, 0xf1, 0x51, 0x79, 0xf1, 0xce, 0x5e, 0x5c, 0xcd,
-	0x38, 0xf1, 0x9b, 0xb5, 0x22, 0x69, 0xc8, 0x9c, 0xc5, 0xf2, 0xa7, 0xb4, 0xf4, 0xb6, 0x14, 0x5a,
-	0xff, 0x83, 0xd3, 0x12, 0x4c, 0x4e, 0x25, 0xfa, 0x55, 0xde, 0xf9, 0x2e, 0xcc, 0xf6, 0x9a, 0xbe,
-	0xf6, 0x1b, 0x5b, 0x37, 0x34, 0x9a, 0x77, 0x38, 0x6d, 0x60, 0xf7, 0x64, 0x34, 0x68, 0x06, 0xa2,
-	0x07, 0xe4, 0x50, 0x7b, 0x12, 0xcb, 0x00, 0xbe, 0x0b, 0x1a, 0x5f, 0xc7, 0x99, 0xc6, 0x37, 0x0b,
-	0x63, 0x2d, 0x5c, 0x6d, 0x1a, 0x74, 0x6a, 0x63, 0xfd, 0x07, 0x66, 0xa4, 0xf6, 0x36, 0x2d, 0xbf,
-	0x52, 0x16, 0x36, 0xe0, 0x6f, 0x01, 0x3b, 0x1d, 0x02, 0x41, 0x4c, 0x94, 0xa6, 0xb4, 0x9a, 0xcc,
-	0xcb, 0xb5, 0xf5, 0x31, 0x20, 0xa9, 0xb8, 0xd7, 0xbe, 0x49, 0x5d, 0x66, 0x42, 0x20, 0x88, 0xc9,
-	0x82, 0x56, 0xfe, 0xe5, 0x1a, 0x5d, 0x03, 0xe8, 0xb6, 0x04, 0x79, 0xb7, 0x64, 0x76, 0xdd, 0x56,
-	0xfd, 0xc3, 0x16, 0xfd, 0xc3, 0x56, 0x4d, 0x46, 0xf7, 0x0f, 0xfb, 0x4e, 0x37, 0x55, 0xf9, 0x80,
-	0x65, 0x00, 0xe4, 0xc3, 0x88, 0x4e, 0xac, 0x09, 0xae, 0x71, 0xae, 0x41, 0xac, 0x4a, 0x5d, 0x71,
-	0xbb, 0xe8, 0x66, 0x32, 0x3b, 0x6d, 0x77, 0xfb, 0x95, 0x7d, 0x93, 0xba, 0x79, 0x79, 0x88, 0x76,
-	0x43, 0xe0, 0x6c, 0x9c, 0x08, 0x47, 0x45, 0x08, 0xe2, 0xb1, 0x66, 0x75, 0x06, 0xee, 0xe0, 0x06,
-	0xae, 0x99, 0x0c, 0x58, 0xbb, 0x1a, 0x9a, 0x91, 0x6a, 0x68, 0xff, 0x82, 0xf1, 0xba, 0x94, 0xc8,
-	0xd4, 0x24, 0xb3, 0x28, 0x08, 0x4e, 0xe9, 0xe6, 0x62, 0x4f, 0x9f, 0x67, 0x46, 0xf2, 0x5a, 0xcf,
-	0xfa, 0x3a, 0x02, 0x53, 0x3b, 0xbc, 0xb2, 0x8d, 0xab, 0xd5, 0x40, 0x76, 0x71, 0xc3, 0x65, 0xe6,
-	0x1d, 0xc4, 0x1a, 0xcd, 0x41, 0xdc, 0xc5, 0xac, 0x50, 0xc2, 0x75, 0xfd, 0xcf, 0x8c, 0xbb, 0x98,
-	0x6d, 0xe3, 0x3a, 0xfa, 0x08, 0x66, 0xea, 0x0d, 0x5a, 0xa7, 0x8c, 0x34, 0x3a, 0xff, 0x9d, 0xf8,
-	0x67, 0x26, 0x73, 0xd9, 0x5f, 0x9f, 0x67, 0x6c, 0xd7, 0xe3, 0x95, 0x66, 0xd1, 0x2e, 0xd1, 0x9a,
-	0xa3, 0x5b, 0xb9, 0xfa, 0x5c, 0x64, 0xe5, 0x03, 0x87, 0x1f, 0xd6, 0x09, 0xb3, 0xb7, 0xbb, 0x3f,
-	0x7c, 0x7e, 0xda, 0xf8, 0x32, 0x3f, 0xeb, 0x02, 0x24, 0x4a, 0x15, 0xec, 0xf9, 0x05, 0xaf, 0x2c,
-	0xbb, 0x54, 0x34, 0x1f, 0x97, 0xfb, 0xeb, 0x65, 0x6b, 0x03, 0x4e, 0xef, 0x30, 0xee, 0xd5, 0x30,
-	0x27, 0xbb, 0xb8, 0x9b, 0x82, 0x19, 0x88, 0xba, 0x58, 0x81, 0x8f, 0xe5, 0xc5, 0xd2, 0xfa, 0x25,
-	0x6a, 0xde, 0xb1, 0x81, 0x4b, 0x64, 0xaf, 0x6d, 0xee, 0xf9, 0x4f, 0x88, 0xd6, 0x98, 0xab, 0x33,
-	0xb5, 0x10, 0xcc, 0xd4, 0x2d, 0xe6, 0xee, 0xf0, 0x0a, 0x69, 0x90, 0x66, 0x6d, 0xaf, 0x9d, 0x17,
-	0x5a, 0xe8, 0x32, 0x4c, 0x72, 0x61, 0x5e, 0x28, 0x51, 0x7f, 0xdf, 0x73, 0xe5, 0x1d, 0x93, 0xd9,
-	0xb9, 0xa0, 0x95, 0x74, 0xbf, 0x2d, 0x8f, 0xf3, 0x49, 0xde, 0xdd, 0xa0, 0x2b, 0x30, 0x59, 0x6f,
-	0x90, 0x32, 0x29, 0x11, 0xc6, 0x68, 0x83, 0xcd, 0xc7, 0x64, 0xe1, 0x1c, 0x13, 0xb1, 0x47, 0x5d,
-	0x34, 0xca, 0x62, 0x95, 0x96, 0x0e, 0x4c, 0x4b, 0x1a, 0x93, 0x79, 0x48, 0x4a, 0x99, 0x6a, 0x48,
-	0x68, 0x19, 0x40, 0xa9, 0xc8, 0xdf, 0x42, 0xb5, 0xe3, 0x09, 0x29, 0x91, 0x8d, 0x7e, 0xdb, 0x1c,
-	0x8b, 0x99, 0x35, 0x1f, 0x97, 0xd0, 0x53, 0xb6, 0x1a, 0x68, 0xb6, 0x19, 0x68, 0xf6, 0x9e, 0x19,
-	0x68, 0xb9, 0x84, 0x28, 0x91, 0xc7, 0x3f, 0x64, 0x22, 0xda, 0x89, 0x38, 0x09, 0x7d, 0xe9, 0xc4,
-	0x9f, 0xf3, 0xd2, 0x13, 0x3d, 0x2f, 0x8d, 0x2c, 0x38, 0xa5, 0xe0, 0xd7, 0x70, 0xbb, 0x20, 0x1e,
-	0x17, 0x02, 0x19, 0xb8, 0x85, 0xdb, 0xbb, 0x98, 0xbd, 0x1d, 0x4b, 0x8c, 0xce, 0x44, 0xf3, 0x09,
-	0xde, 0x2e, 0x78, 0x7e, 0x99, 0xb4, 0xad, 0xf3, 0xba, 0x8f, 0x75, 0xde, 0xbc, 0xdb, 0x64, 0xca,
-	0x98, 0x63, 0x53, 0xdc, 0x62, 0x6d, 0x7d, 0x19, 0xd5, 0xa3, 0x5b, 0x2a, 0xe7, 0x84, 0xd7, 0x40,
-	0x8d, 0xf0, 0xb6, 0xf9, 0xd5, 0x8f, 0xab, 0x11, 0xde, 0x66, 0xaf, 0x55, 0x23, 0x7f, 0x3d, 0xf2,
-	0xc9, 0x8f, 0x6c, 0x5d, 0xd4, 0x1c, 0x29, 0xf8, 0x4e, 0xc7, 0xbc, 0xeb, 0x99, 0xce, 0x98, 0x66,
-	0xe4, 0x1a, 0x31, 0xdd, 0xde, 0x7a, 0x14, 0xe9, 0xcc, 0x60, 0x2d, 0xd7, 0x3e, 0xfe, 0x0d, 0x09,
-	0xd1, 0x99, 0x0b, 0xfb, 0x44, 0x8f, 0xb9, 0xdc, 0xc2, 0xf7, 0xcf, 0x33, 0x67, 0xd4, 0x15, 0x59,
-	0xf9, 0xc0, 0xf6, 0xa8, 0x53, 0xc3, 0xbc, 0x62, 0x5f, 0xf7, 0xb9, 0x98, 0xcf, 0xd2, 0x1a, 0xbd,
-	0x01, 0x53, 0xc6, 0xaa, 0xd0, 0xf4, 0xbd, 0xa2, 0x1e, 0xd1, 0xc7, 0xd9, 0x4e, 0x6a, 0xdb, 0x77,
-	0x85, 0xba, 0x75, 0x05, 0x16, 0x25, 0x9c, 0x6b, 0x4d, 0x7f, 0x8f, 0x1e, 0x10, 0xff, 0x16, 0xae,
-	0xd7, 0x3d, 0xdf, 0x35, 0x25, 0x38, 0x0b, 0x63, 0x5c, 0x88, 0xcd, 0xe4, 0x95, 0x9b, 0xc0, 0x98,
-	0xfa, 0x50, 0xf3, 0xac, 0x01, 0x73, 0x7d, 0xab, 0x2d, 0x98, 0xd8, 0x6f, 0xfa, 0x85, 0xae, 0x8f,
-	0x64, 0x76, 0x36, 0x58, 0x92, 0xc6, 0x2e, 0x9f, 0xd8, 0xd7, 0xab, 0xae, 0xf3, 0xec, 0x57, 0x93,
-	0x30, 0x26, 0xbd, 0xa3, 0x87, 0x11, 0x80, 0x2e, 0x37, 0x45, 0x56, 0xd0, 0x45, 0x38, 0xef, 0x4d,
-	0xad, 0x1d, 0xab, 0xa3, 0xe0, 0x59, 0x17, 0x3e, 0xfd, 0xf6, 0xa7, 0x2f, 0x46, 0xd7, 0xd1, 0xdf,
-	0x1d, 0x91, 0x8c, 0x46, 0xb3, 0x43, 0xe1, 0x05, 0x07, 0x55, 0xba, 0xce, 0x03, 0x5d, 0x8a, 0x47,
-	0xe8, 0x49, 0x04, 0x66, 0xfa, 0x29, 0x20, 0xda, 0x1c, 0x88, 0x33, 0x84, 0x73, 0xa6, 0xce, 0xfd,
-	0x0e, 0x4d, 0x8d, 0xeb, 0xbf, 0x12, 0xd7, 0x16, 0x72, 0xfa, 0x70, 0xb5, 0x8c, 0x41, 0x17, 0x5d,
-	0x90, 0xc6, 0x1e, 0xa1, 0xfb, 0x10, 0xcf, 0x19, 0xea, 0x36, 0x10, 0xae, 0x97, 0x31, 0xa6, 0x56,
-	0x86, 0x2b, 0x68, 0x18, 0xe7, 0x24, 0x8c, 0x35, 0xb4, 0xda, 0x07, 0x43, 0xf3, 0x3f, 0x16, 0xc8,
-	0xcd, 0x27, 0x10, 0xd7, 0xac, 0x2d, 0x24, 0x70, 0x2f, 0x39, 0x0c, 0x09, 0xdc, 0x47, 0xf8, 0x2c,
-	0x5b, 0x06, 0xde, 0x44, 0xeb, 0x7d, 0x81, 0x99, 0xd2, 0xeb, 0xc6, 0x75, 0x1e, 0x1c, 0x90, 0xc3,
-	0x23, 0x74, 0x00, 0x31, 0xc1, 0xe6, 0xd0, 0xd2, 0x80, 0xe7, 0x00, 0x39, 0x4c, 0x2d, 0x0f, 0x39,
-	0xd5, 0x41, 0xd7, 0x65, 0xd0, 0x15, 0x94, 0xee, 0x0b, 0x2a, 0xb8, 0x60, 0xf0, 0xaa, 0x15, 0x18,
-	0x57, 0x6c, 0x06, 0xa5, 0x07, 0x1c, 0xf6, 0x10, 0xa5, 0x54, 0x66, 0xe8, 0xb9, 0x0e, 0xb9, 0x2c,
-	0x43, 0xce, 0xa1, 0x33, 0x7d, 0x21, 0x15, 0x3f, 0x42, 0x1e, 0xc4, 0x35, 0x3d, 0x42, 0xa9, 0xa0,
-	0xab, 0x5e, 0xce, 0x94, 0x5a, 0x1d, 0x3e, 0x1a, 0x4c, 0xa0, 0x8c, 0x0c, 0xb4, 0x80, 0xe6, 0x42,
-	0x0a, 0xbd, 0x24, 0xfc, 0x53, 0x48, 0x06, 0x08, 0xcd, 0xb1, 0xe1, 0x7a, 0x6e, 0x15, 0xc2, 0x82,
-	0xac, 0x35, 0x19, 0x6c, 0x19, 0x2d, 0xf6, 0x07, 0xd3, 0xba, 0xa2, 0xc3, 0xa2, 0x1a, 0xc4, 0xf5,
-	0x78, 0x0c, 0x29, 0x98, 0x5e, 0xb2, 0x14, 0x52, 0x30, 0x7d, 0x93, 0x75, 0xe8, 0xfd, 0xd4, 0x48,
-	0xe4, 0x6d, 0x74, 0x08, 0xd0, 0x6d, 0xdc, 0x21, 0x0d, 0x64, 0x60, 0xfa, 0x86, 0x34, 0x90, 0xc1,
-	0xce, 0x6f, 0x59, 0x32, 0xee, 0x12, 0x4a, 0x85, 0xc6, 0x95, 0xe3, 0x03, 0xdd, 0x83, 0x09, 0x35,
-	0x79, 0x45, 0x9e, 0xff, 0x80, 0xbb, 0xae, 0xca, 0x98, 0x8b, 0x68, 0x21, 0x34, 0xa6, 0x7c, 0xcd,
-	0x9a, 0x68, 0x03, 0x6a, 0x42, 0x84, 0xb5, 0x81, 0xe0, 0x44, 0x0a, 0x6d, 0x03, 0x3d, 0xa3, 0x69,
-	0x68, 0x72, 0xcd, 0xe4, 0x41, 0x8f, 0x22, 0x30, 0xdd, 0x37, 0x01, 0xd0, 0xc6, 0x80, 0xdb, 0xf0,
-	0x11, 0x93, 0xda, 0x3c, 0x59, 0x51, 0xe3, 0xd8, 0x90, 0x38, 0x56, 0x51, 0xa6, 0x0f, 0xc7, 0x7e,
-	0xd3, 0x97, 0x03, 0xc6, 0x79, 0x20, 0x3f, 0x47, 0xb9, 0x37, 0x9f, 0xbe, 0x48, 0x47, 0x9e, 0xbd,
-	0x48, 0x47, 0x7e, 0x7c, 0x91, 0x8e, 0x3c, 0x7e, 0x99, 0x1e, 0x79, 0xf6, 0x32, 0x3d, 0xf2, 0xdd,
-	0xcb, 0xf4, 0xc8, 0x07, 0xeb, 0x01, 0x12, 0x71, 0x5b, 0x3a, 0xd9, 0x16, 0x14, 0xc0, 0x38, 0x6c,
-	0x65, 0x9d, 0xb6, 0xf0, 0x5a, 0x1c, 0x97, 0x9c, 0xe5, 0xd2, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0x31, 0x27, 0xb5, 0x28, 0x12, 0x00, 0x00,
+	// 1858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x8a, 0x94, 0x44, 0x3d, 0xd2, 0x92, 0xbe, 0x23, 0xda, 0xa2, 0x56, 0x3f, 0x48, 0xad,
+	0x62, 0x49, 0xf9, 0xb5, 0xfb, 0x15, 0x53, 0x34, 0xad, 0x9b, 0xa0, 0xb5, 0x04, 0xc9, 0x4d, 0x13,
+	0x1b, 0xce, 0x5a, 0x6d, 0x81, 0x16, 0x05, 0x31, 0x24, 0x87, 0x4b, 0x42, 0xe4, 0x0e, 0xbd, 0x33,
+	0x94, 0xa9, 0xb8, 0xbe, 0x34, 0x28, 0x50, 0x20, 0x08, 0x90, 0xa2, 0xb7, 0x9e, 0x7c, 0xea, 0xbf,
+	0xd0, 0x7f, 0x21, 0xb7, 0x06, 0xe8, 0xa5, 0xe8, 0xc1, 0x0d, 0xec, 0x1e, 0x7a, 0xee, 0xb1, 0xa7,
+	0x62, 0x7e, 0x2c, 0xb9, 0x4b, 0x2e, 0x45, 0x1b, 0x69, 0x6f, 0x3d, 0xed, 0xcc, 0x9b, 0xf7, 0xe3,
+	0x33, 0x6f, 0x66, 0xdf, 0xfb, 0x0c, 0xdc, 0x20, 0xbc, 0xe9, 0x90, 0x8b, 0x8e, 0x73, 0x71, 0xe8,
+	0x3c, 0xec, 0x91, 0xe0, 0xd2, 0xee, 0x06, 0x94, 0x53, 0x04, 0x84, 0x37, 0x6d, 0x72, 0xd1, 0xb1,
+	0x2f, 0x0e, 0xcd, 0x37, 0x6a, 0x94, 0x75, 0x28, 0x73, 0xaa, 0x98, 0x11, 0xa5, 0xe4, 0x5c, 0x1c,
+	0x56, 0x09, 0xc7, 0x87, 0x4e, 0x17, 0x7b, 0x2d, 0x1f, 0xf3, 0x16, 0xf5, 0x95, 0x9d, 0x99, 0x8f,
+	0xf8, 0x13, 0xe6, 0x4a, 0xba, 0x1a, 0x91, 0xf2, 0x7e, 0xa8, 0xea, 0x51, 0x8f, 0xca, 0xa1, 0x23,
+	0x46, 0x5a, 0xba, 0xe9, 0x51, 0xea, 0xb5, 0x89, 0x83, 0xbb, 0x2d, 0x07, 0xfb, 0x3e, 0xe5, 0xd2,
+	0x3b, 0xd3, 0xab, 0x45, 0xbd, 0x2a, 0x67, 0xd5, 0x5e, 0xc3, 0xe1, 0xad, 0x0e, 0x61, 0x1c, 0x77,
+	0xba, 0x4a, 0xc1, 0x7a, 0x0f, 0x6e, 0x7c, 0x2c, 0x10, 0x9e, 0xf0, 0xe6, 0xed, 0x5a, 0x8d, 0xf6,
+	0x7c, 0xee, 0x92, 0x87, 0x3d, 0xc2, 0x38, 0x2a, 0xc0, 0x02, 0xae, 0xd7, 0x03, 0xc2, 0x58, 0xc1,
+	0x28, 0x19, 0x07, 0x8b, 0x6e, 0x38, 0xbd, 0x95, 0xf9, 0xcd, 0xd3, 0xe2, 0xcc, 0x3f, 0x9e, 0x16,
+	0x67, 0xac, 0x3f, 0x19, 0xb0, 0x36, 0x66, 0xce, 0xba, 0xd4, 0x67, 0x44, 0xd8, 0x57, 0x71, 0x1b,
+	0xfb, 0x35, 0x12, 0xda, 0xeb, 0x29, 0x2a, 0x42, 0x56, 0x0f, 0x2b, 0x8f, 0x48, 0xab, 0x30, 0x2b,
+	0x57, 0x41, 0x8b, 0x7e, 0x4a, 0x5a, 0x68, 0x03, 0x16, 0x6b, 0xb4, 0x4e, 0x2a, 0x4d, 0xcc, 0x9a,
+	0x85, 0x94, 0x5c, 0xce, 0x08, 0xc1, 0x0f, 0x31, 0x6b, 0xa2, 0x3c, 0xcc, 0xf9, 0x54, 0x78, 0x4d,
+	0x97, 0x8c, 0x83, 0xb4, 0xab, 0x26, 0xc2, 0x27, 0xe1, 0xcd, 0x4a, 0x88, 0x78, 0x4e, 0xf9, 0x24,
+	0xbc, 0x79, 0x5b, 0x49, 0xd0, 0x4d, 0x58, 0xaa, 0x92, 0x5a, 0xf3, 0x9d, 0xf2, 0x40, 0x67, 0x5e,
+	0xea, 0x5c, 0x53, 0x52, 0xad, 0x66, 0x7d, 0x08, 0x9b, 0x72, 0x43, 0x3f, 0xc1, 0xed, 0x56, 0x1d,
+	0x73, 0x1a, 0x8c, 0x64, 0x65, 0x07, 0x72, 0x35, 0xea, 0xb3, 0x4a, 0x3c, 0x35, 0x59, 0x21, 0xbb,
+	0x3d, 0x96, 0x9e, 0xcf, 0x0c, 0xd8, 0x9a, 0xe0, 0x4d, 0x27, 0x69, 0x1f, 0x96, 0xb1, 0x12, 0x8d,
+	0x78, 0x5c, 0xd2, 0xe2, 0x10, 0xbe, 0x09, 0x19, 0x26, 0x20, 0x88, 0x8d, 0xcf, 0xca, 0x8d, 0x0f,
+	0xe6, 0x62, 0x6b, 0xa1, 0x13, 0xbf, 0xd7, 0xa9, 0x92, 0x40, 0xe6, 0x2c, 0xed, 0x5e, 0xd3, 0xd2,
+	0x7b, 0x52, 0x68, 0x7d, 0x17, 0x56, 0x25, 0x98, 0x23, 0x95, 0xe8, 0x57, 0x39, 0xe7, 0x8f, 0x21,
+	0x1f, 0x37, 0xfd, 0xc6, 0x67, 0x6c, 0x7d, 0xa8, 0xd1, 0x3c, 0xe0, 0x34, 0xc0, 0xde, 0x74, 0x34,
+	0x68, 0x05, 0x52, 0xe7, 0xe4, 0x52, 0x7b, 0x12, 0xc3, 0x08, 0xbe, 0xb7, 0x20, 0x1f, 0x77, 0xa6,
+	0xf1, 0xe5, 0x61, 0xee, 0x02, 0xb7, 0x7b, 0x21, 0x3a, 0x35, 0xb1, 0x5c, 0x28, 0x44, 0xb5, 0xef,
+	0x07, 0x94, 0x36, 0xa6, 0xc7, 0x47, 0x90, 0x3e, 0x27, 0x97, 0xac, 0x30, 0x5b, 0x4a, 0x1d, 0x2c,
+	0xba, 0x72, 0x1c, 0x41, 0xf0, 0x09, 0xac, 0x27, 0xf8, 0xd4, 0x30, 0x76, 0x20, 0xc7, 0x94, 0xbc,
+	0x12, 0x50, 0xca, 0xc3, 0x4b, 0xa3, 0x65, 0x2e, 0xa5, 0x1c, 0xdd, 0x82, 0xf9, 0xae, 0xb0, 0x51,
+	0xfe, 0xb3, 0xe5, 0x4d, 0x7b, 0x58, 0x50, 0x6c, 0xed, 0xf4, 0x41, 0x9b, 0x72, 0xe9, 0xf8, 0x28,
+	0xfd, 0xe5, 0xb3, 0xe2, 0x8c, 0xab, 0x2d, 0xac, 0xfb, 0xb0, 0x32, 0xaa, 0x11, 0x66, 0xcb, 0x18,
+	0x64, 0x6b, 0x98, 0x8b, 0xd9, 0x48, 0x2e, 0x84, 0x54, 0x7a, 0x29, 0xa4, 0x4a, 0xa9, 0x83, 0x9c,
+	0xab, 0x26, 0xd6, 0xb7, 0x61, 0x45, 0xee, 0xe6, 0x98, 0xd6, 0x5f, 0xe9, 0x9e, 0xec, 0xc3, 0xff,
+	0x45, 0xec, 0xf4, 0xee, 0x11, 0xa4, 0xc5, 0xcf, 0x2b, 0xad, 0x72, 0xae, 0x1c, 0x5b, 0x9f, 0x00,
+	0x92, 0x8a, 0x67, 0xfd, 0x8f, 0xa8, 0xc7, 0xc2, 0x10, 0x08, 0xd2, 0xf2, 0x97, 0x57, 0xfe, 0xe5,
+	0x18, 0x9d, 0x02, 0x0c, 0x8b, 0xa6, 0xc4, 0x9e, 0x2d, 0xef, 0xd9, 0xaa, 0xc2, 0xda, 0xa2, 0xc2,
+	0xda, 0xaa, 0x0c, 0xeb, 0x0a, 0x6b, 0xdf, 0x1f, 0x5e, 0x26, 0x37, 0x62, 0x19, 0x01, 0xf9, 0xa9,
+	0x01, 0xab, 0xb1, 0xe0, 0x1a, 0xe7, 0x2e, 0xa4, 0xdb, 0xd4, 0x13, 0xbb, 0x13, 0x07, 0xb0, 0x1c,
+	0x3d, 0x80, 0x8f, 0xa8, 0xe7, 0xca, 0x45, 0x74, 0x27, 0x01, 0xce, 0xfe, 0x54, 0x38, 0x2a, 0x42,
+	0x14, 0x8f, 0x95, 0xd7, 0x19, 0xb8, 0x8f, 0x03, 0xdc, 0x09, 0x33, 0x60, 0xdd, 0x81, 0xd5, 0x98,
+	0x54, 0x43, 0xfb, 0x7f, 0x98, 0xef, 0x4a, 0x89, 0x4c, 0x4d, 0xb6, 0x8c, 0xa2, 0xe0, 0x94, 0xee,
+	0xe0, 0x4e, 0xc8, 0x99, 0xf5, 0x47, 0x03, 0x96, 0x4e, 0x78, 0xf3, 0x18, 0xb7, 0xdb, 0x91, 0xec,
+	0xe2, 0xc0, 0x63, 0xe1, 0x39, 0x88, 0x31, 0x5a, 0x83, 0x05, 0x0f, 0xb3, 0x4a, 0x0d, 0x77, 0x75,
+	0x55, 0x99, 0xf7, 0x30, 0x3b, 0xc6, 0x5d, 0xf4, 0x0b, 0x58, 0xe9, 0x06, 0xb4, 0x4b, 0x19, 0x09,
+	0x06, 0x95, 0x49, 0x54, 0x95, 0xdc, 0x51, 0xf9, 0x5f, 0xcf, 0x8a, 0xb6, 0xd7, 0xe2, 0xcd, 0x5e,
+	0xd5, 0xae, 0xd1, 0x8e, 0xa3, 0x9b, 0x9d, 0xfa, 0xbc, 0xcd, 0xea, 0xe7, 0x0e, 0xbf, 0xec, 0x12,
+	0x66, 0x1f, 0x0f, 0x4b, 0xa2, 0xbb, 0x1c, 0xfa, 0xd2, 0x02, 0xb4, 0x0e, 0x99, 0x5a, 0x13, 0xb7,
+	0xfc, 0x4a, 0xab, 0x2e, 0xeb, 0x78, 0xca, 0x5d, 0x90, 0xf3, 0x0f, 0xea, 0xd6, 0x3e, 0xac, 0x9e,
+	0x30, 0xde, 0xea, 0x60, 0x4e, 0xee, 0xe0, 0x61, 0x0a, 0x56, 0x20, 0xe5, 0x61, 0x05, 0x3e, 0xed,
+	0x8a, 0xa1, 0xf5, 0xcf, 0x54, 0x78, 0x8e, 0x01, 0xae, 0x91, 0xb3, 0x7e, 0xb8, 0xcf, 0x37, 0x21,
+	0xd5, 0x61, 0x9e, 0xce, 0xd4, 0x7a, 0x34, 0x53, 0x77, 0x99, 0x77, 0xc2, 0x9b, 0x24, 0x20, 0xbd,
+	0xce, 0x59, 0xdf, 0x15, 0x5a, 0xe8, 0x16, 0xe4, 0xb8, 0x30, 0xaf, 0xd4, 0xa8, 0xdf, 0x68, 0x79,
+	0x72, 0x8f, 0xd9, 0xf2, 0x5a, 0xd4, 0x4a, 0xba, 0x3f, 0x96, 0xcb, 0x6e, 0x96, 0x0f, 0x27, 0xe8,
+	0x7d, 0xc8, 0x75, 0x03, 0x52, 0x27, 0x35, 0xc2, 0x18, 0x0d, 0x58, 0x21, 0x5d, 0x4a, 0x5d, 0x1d,
+	0x31, 0xa6, 0x2e, 0xaa, 0x42, 0xb5, 0x4d, 0x6b, 0xe7, 0x61, 0xd1, 0x9e, 0x93, 0x79, 0xc8, 0x4a,
+	0x99, 0x2a, 0xd9, 0x68, 0x0b, 0x40, 0xa9, 0xc8, 0xdf, 0x42, 0x35, 0xac, 0x45, 0x29, 0x91, 0xad,
+	0xf0, 0x38, 0x5c, 0x16, 0x5d, 0xbd, 0xb0, 0x20, 0xa1, 0x9b, 0xb6, 0x6a, 0xf9, 0x76, 0xd8, 0xf2,
+	0xed, 0xb3, 0xb0, 0xe5, 0x1f, 0x65, 0xc4, 0x15, 0xf9, 0xe2, 0x6f, 0x45, 0x43, 0x3b, 0x11, 0x2b,
+	0x89, 0x27, 0x9d, 0xf9, 0xef, 0x9c, 0xf4, 0x62, 0xec, 0xa4, 0x91, 0x05, 0xd7, 0x14, 0xfc, 0x0e,
+	0xee, 0x57, 0xc4, 0xe1, 0x42, 0x24, 0x03, 0x77, 0x71, 0xff, 0x0e, 0x66, 0x3f, 0x4a, 0x67, 0x66,
+	0x57, 0x52, 0x6e, 0x86, 0xf7, 0x2b, 0x2d, 0xbf, 0x4e, 0xfa, 0xd6, 0x1b, 0xba, 0xd2, 0x0f, 0xce,
+	0x7c, 0x58, 0x64, 0xea, 0x98, 0xe3, 0xf0, 0x72, 0x8b, 0xb1, 0xf5, 0x87, 0x14, 0xdc, 0x18, 0x2a,
+	0x1f, 0x09, 0xaf, 0x91, 0x3b, 0xc2, 0xfb, 0xe1, 0xaf, 0x7e, 0xd5, 0x1d, 0xe1, 0x7d, 0xf6, 0x8d,
+	0xee, 0xc8, 0xff, 0x0e, 0x79, 0xfa, 0x21, 0x5b, 0x6f, 0x6b, 0x16, 0x19, 0x3d, 0xa7, 0x2b, 0xce,
+	0xf5, 0xfa, 0x80, 0xc8, 0x30, 0x72, 0x4a, 0xc2, 0x6a, 0x6f, 0x7d, 0x6e, 0x40, 0x3e, 0x2e, 0xd7,
+	0x3e, 0xbe, 0x05, 0x19, 0x51, 0x99, 0x2b, 0x0d, 0xa2, 0x89, 0xc0, 0xd1, 0xfa, 0x5f, 0x9f, 0x15,
+	0xaf, 0xab, 0x2d, 0xb2, 0xfa, 0xb9, 0xdd, 0xa2, 0x4e, 0x07, 0xf3, 0xa6, 0xfd, 0x81, 0xcf, 0x05,
+	0x83, 0x91, 0xd6, 0xe8, 0xfb, 0xb0, 0x14, 0x5a, 0x55, 0x7a, 0x7e, 0xab, 0xaa, 0x49, 0xcc, 0x55,
+	0xb6, 0x39, 0x6d, 0xfb, 0x63, 0xa1, 0x6e, 0xbd, 0x0f, 0x1b, 0x12, 0xce, 0x69, 0xcf, 0x3f, 0xa3,
+	0xe7, 0xc4, 0xbf, 0x8b, 0xbb, 0xdd, 0x96, 0xef, 0x85, 0x57, 0x30, 0x0f, 0x73, 0x5c, 0x88, 0x43,
+	0x6e, 0x22, 0x27, 0x91, 0x36, 0xf5, 0x73, 0xd8, 0x4c, 0x36, 0xd7, 0xbb, 0x3a, 0x84, 0xc5, 0x46,
+	0xcf, 0xaf, 0x0c, 0x7d, 0x64, 0xcb, 0xf9, 0xe8, 0x95, 0x0c, 0xed, 0xdc, 0x4c, 0x43, 0x8f, 0x22,
+	0xce, 0xdf, 0x03, 0x33, 0xe6, 0xfc, 0x84, 0xd5, 0x02, 0xfa, 0xe8, 0x65, 0xa1, 0xfd, 0xde, 0x80,
+	0x8d, 0x44, 0x73, 0x0d, 0xed, 0xdd, 0x97, 0x84, 0xa6, 0x7b, 0xd6, 0x00, 0x20, 0xfa, 0x0e, 0xcc,
+	0x13, 0xe9, 0x4a, 0x77, 0x56, 0x33, 0xc9, 0x4a, 0x05, 0x0b, 0xfb, 0x9d, 0xd2, 0x8f, 0x80, 0xfb,
+	0x9e, 0x66, 0x62, 0xa7, 0x3d, 0xff, 0xde, 0xe9, 0xd9, 0x2b, 0x26, 0xfd, 0x01, 0x98, 0x49, 0xc6,
+	0x7a, 0x5f, 0x6f, 0xc2, 0x82, 0xd8, 0x97, 0xdf, 0xe0, 0x49, 0x7d, 0x58, 0xd9, 0xb8, 0xf3, 0x8d,
+	0x9e, 0x7f, 0xaf, 0xc1, 0x87, 0x4e, 0xcb, 0x5f, 0x2f, 0xc3, 0x9c, 0xf4, 0x8a, 0x3e, 0x35, 0x00,
+	0x86, 0x4f, 0x25, 0x64, 0x45, 0xcd, 0x93, 0x9f, 0x61, 0xe6, 0xee, 0x95, 0x3a, 0x0a, 0x98, 0xf5,
+	0xd6, 0xaf, 0xfe, 0xfc, 0xf7, 0xdf, 0xcd, 0xee, 0xa1, 0xd7, 0x1c, 0x71, 0xf3, 0x82, 0xde, 0xe0,
+	0x45, 0x29, 0x9e, 0x44, 0x4a, 0xd7, 0x79, 0xac, 0xff, 0xfb, 0x27, 0xe8, 0xa9, 0x01, 0x2b, 0xa3,
+	0x2f, 0x12, 0x74, 0x30, 0x16, 0x67, 0xc2, 0x13, 0xc8, 0x7c, 0xfd, 0x25, 0x34, 0x35, 0xae, 0x77,
+	0x25, 0xae, 0x43, 0xe4, 0x8c, 0xe0, 0xba, 0x08, 0x0d, 0x86, 0xe8, 0xa2, 0xaf, 0xaa, 0x27, 0xe8,
+	0x11, 0x2c, 0x1c, 0x85, 0x2f, 0x89, 0xb1, 0x70, 0xf1, 0x07, 0x8c, 0x59, 0x9a, 0xac, 0xa0, 0x61,
+	0xbc, 0x2e, 0x61, 0xec, 0xa2, 0x9d, 0x11, 0x18, 0xfa, 0x39, 0xc2, 0x22, 0xb9, 0xf9, 0x25, 0x2c,
+	0x68, 0x2e, 0x9d, 0x10, 0x38, 0xfe, 0x56, 0x31, 0x4b, 0x93, 0x15, 0x74, 0x60, 0x5b, 0x06, 0x3e,
+	0x40, 0x7b, 0x23, 0x81, 0x35, 0xf3, 0x1f, 0xc6, 0x75, 0x1e, 0x9f, 0x93, 0xcb, 0x27, 0xe8, 0x33,
+	0x03, 0x72, 0xd1, 0x17, 0x04, 0x7a, 0x6d, 0x52, 0x88, 0xe8, 0xa3, 0xc5, 0xbc, 0x39, 0x45, 0xeb,
+	0xe5, 0xd0, 0x54, 0x24, 0xf7, 0x8f, 0xe4, 0xe2, 0x1c, 0xd2, 0x82, 0xc8, 0xa3, 0xcd, 0x31, 0xf7,
+	0x91, 0x77, 0x81, 0xb9, 0x35, 0x61, 0x55, 0x07, 0xdd, 0x93, 0x41, 0x4b, 0x68, 0x7b, 0x24, 0xa8,
+	0x78, 0x06, 0x44, 0x13, 0xdf, 0x84, 0x79, 0x45, 0x64, 0xd1, 0xf6, 0x98, 0xc3, 0x18, 0x47, 0x36,
+	0x8b, 0x13, 0xd7, 0x75, 0xc8, 0x2d, 0x19, 0x72, 0x0d, 0x5d, 0x1f, 0x09, 0xa9, 0xa8, 0x31, 0x6a,
+	0xc1, 0x82, 0x66, 0xc6, 0x28, 0x56, 0x5f, 0xe2, 0x74, 0xd9, 0xdc, 0x99, 0xcc, 0x0a, 0xc2, 0x40,
+	0x45, 0x19, 0x68, 0x1d, 0xad, 0x25, 0xfc, 0x76, 0x35, 0xe1, 0x9f, 0x42, 0x36, 0xc2, 0x65, 0xaf,
+	0x0c, 0x17, 0xdb, 0x55, 0x02, 0x01, 0xb6, 0x76, 0x65, 0xb0, 0x2d, 0xb4, 0x31, 0x1a, 0x4c, 0xeb,
+	0x8a, 0xe6, 0x8a, 0x3a, 0xb0, 0xa0, 0x99, 0x51, 0xc2, 0xf5, 0x8d, 0xf3, 0x64, 0xb3, 0x34, 0x59,
+	0x61, 0xca, 0xfe, 0x14, 0x1b, 0xe2, 0x7d, 0x74, 0x09, 0x30, 0xec, 0xd9, 0x09, 0xe5, 0x6c, 0x8c,
+	0x78, 0x99, 0xbb, 0x57, 0xea, 0xe8, 0xb8, 0x96, 0x8c, 0xbb, 0x89, 0xcc, 0xc4, 0xb8, 0x92, 0x39,
+	0xa0, 0x87, 0xb0, 0xa8, 0x48, 0x97, 0xc8, 0xf3, 0x7f, 0x60, 0xaf, 0x3b, 0x32, 0xe6, 0x06, 0x5a,
+	0x4f, 0x8c, 0x29, 0x4f, 0xb3, 0x23, 0x8a, 0x92, 0x22, 0x07, 0x49, 0x45, 0x29, 0x4a, 0x46, 0xcc,
+	0xd2, 0x64, 0x85, 0x29, 0xc9, 0x0d, 0x49, 0x07, 0xfa, 0xdc, 0x80, 0xe5, 0x91, 0xe6, 0x8f, 0xf6,
+	0xc7, 0xdc, 0x26, 0xb3, 0x0b, 0xf3, 0x60, 0xba, 0xa2, 0xc6, 0xb1, 0x2f, 0x71, 0xec, 0xa0, 0xe2,
+	0x08, 0x8e, 0x46, 0xcf, 0x97, 0xdd, 0xd1, 0x79, 0x2c, 0x3f, 0x4f, 0xd0, 0x6f, 0x0d, 0x58, 0x8a,
+	0xf7, 0x60, 0xb4, 0x37, 0x31, 0x4a, 0x8c, 0x50, 0x98, 0xfb, 0x53, 0xf5, 0xa6, 0x94, 0xa8, 0x10,
+	0x4c, 0x45, 0xb5, 0xfb, 0x01, 0xa6, 0x5f, 0x1b, 0x70, 0x2d, 0xd6, 0xab, 0xd1, 0xcd, 0xa4, 0x50,
+	0x63, 0x44, 0xc0, 0xdc, 0x9b, 0xa6, 0xa6, 0x01, 0xdd, 0x94, 0x80, 0x8a, 0x68, 0x6b, 0x1c, 0x90,
+	0xdf, 0xe0, 0x21, 0x8e, 0xa3, 0x1f, 0x7c, 0xf9, 0x7c, 0xdb, 0xf8, 0xea, 0xf9, 0xb6, 0xf1, 0xf5,
+	0xf3, 0x6d, 0xe3, 0x8b, 0x17, 0xdb, 0x33, 0x5f, 0xbd, 0xd8, 0x9e, 0xf9, 0xcb, 0x8b, 0xed, 0x99,
+	0x9f, 0xed, 0x45, 0xb8, 0xf5, 0x3d, 0xe9, 0xe2, 0x58, 0x30, 0xe3, 0xd0, 0xdd, 0x45, 0xd9, 0xe9,
+	0x0b, 0x9f, 0xd5, 0x79, 0x49, 0xe5, 0xdf, 0xf9, 0xf7, 0x00, 0x6c, 0x4c, 0x1e, 0x00, 0x61, 0x16,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1472,6 +1820,12 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// StorageProof queries the root of the Ethereum storage trie of an account,
+	// built from its contract storage, and the Merkle proofs of storage slots
+	// against it. It implements the storage part of "eth_getProof". The root is
+	// not committed by consensus, so the proofs are not verifiable against a
+	// block header. Accounts with more than 100,000 storage slots are refused.
+	StorageProof(ctx context.Context, in *QueryStorageProofRequest, opts ...grpc.CallOption) (*QueryStorageProofResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
@@ -1490,6 +1844,12 @@ type QueryClient interface {
 	// Similar to feemarket module's method
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(ctx context.Context, in *QueryFunTokenMappingRequest, opts ...grpc.CallOption) (*QueryFunTokenMappingResponse, error)
+	// FunTokenEscrow reports the ERC20 tokens escrowed by the EVM module for a
+	// "FunToken" created from an ERC20 against the supply of its Bank Coin.
+	FunTokenEscrow(ctx context.Context, in *QueryFunTokenEscrowRequest, opts ...grpc.CallOption) (*QueryFunTokenEscrowResponse, error)
+	// FunNFTMapping returns the "FunNFT" mapping of an ERC-721 collection or
+	// "x/nft" class.
+	FunNFTMapping(ctx context.Context, in *QueryFunNFTMappingRequest, opts ...grpc.CallOption) (*QueryFunNFTMappingResponse, error)
 }
 
 type queryClient struct {
@@ -1536,6 +1896,15 @@ func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) StorageProof(ctx context.Context, in *QueryStorageProofRequest, opts ...grpc.CallOption) (*QueryStorageProofResponse, error) {
+	out := new(QueryStorageProofResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/StorageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
 	out := new(QueryCodeResponse)
 	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/Code", in, out, opts...)
@@ -1617,6 +1986,24 @@ func (c *queryClient) FunTokenMapping(ctx context.Context, in *QueryFunTokenMapp
 	return out, nil
 }
 
+func (c *queryClient) FunTokenEscrow(ctx context.Context, in *QueryFunTokenEscrowRequest, opts ...grpc.CallOption) (*QueryFunTokenEscrowResponse, error) {
+	out := new(QueryFunTokenEscrowResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunTokenEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunNFTMapping(ctx context.Context, in *QueryFunNFTMappingRequest, opts ...grpc.CallOption) (*QueryFunNFTMappingResponse, error) {
+	out := new(QueryFunNFTMappingResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/FunNFTMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EthAccount queries a Nibiru account using its EVM address or Bech32 Nibiru
@@ -1630,6 +2017,12 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// StorageProof queries the root of the Ethereum storage trie of an account,
+	// built from its contract storage, and the Merkle proofs of storage slots
+	// against it. It implements the storage part of "eth_getProof". The root is
+	// not committed by consensus, so the proofs are not verifiable against a
+	// block header. Accounts with more than 100,000 storage slots are refused.
+	StorageProof(context.Context, *QueryStorageProofRequest) (*QueryStorageProofResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/evm module.
@@ -1648,6 +2041,12 @@ type QueryServer interface {
 	// Similar to feemarket module's method
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	FunTokenMapping(context.Context, *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error)
+	// FunTokenEscrow reports the ERC20 tokens escrowed by the EVM module for a
+	// "FunToken" created from an ERC20 against the supply of its Bank Coin.
+	FunTokenEscrow(context.Context, *QueryFunTokenEscrowRequest) (*QueryFunTokenEscrowResponse, error)
+	// FunNFTMapping returns the "FunNFT" mapping of an ERC-721 collection or
+	// "x/nft" class.
+	FunNFTMapping(context.Context, *QueryFunNFTMappingRequest) (*QueryFunNFTMappingResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1666,6 +2065,9 @@ func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceR
 func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
+func (*UnimplementedQueryServer) StorageProof(ctx context.Context, req *QueryStorageProofRequest) (*QueryStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProof not implemented")
+}
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
@@ -1693,6 +2095,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) FunTokenMapping(ctx context.Context, req *QueryFunTokenMappingRequest) (*QueryFunTokenMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FunTokenMapping not implemented")
 }
+func (*UnimplementedQueryServer) FunTokenEscrow(ctx context.Context, req *QueryFunTokenEscrowRequest) (*QueryFunTokenEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunTokenEscrow not implemented")
+}
+func (*UnimplementedQueryServer) FunNFTMapping(ctx context.Context, req *QueryFunNFTMappingRequest) (*QueryFunNFTMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunNFTMapping not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1770,6 +2178,24 @@ func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/StorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProof(ctx, req.(*QueryStorageProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeRequest)
 	if err := dec(in); err != nil {
@@ -1932,16 +2358,52 @@ func _Query_FunTokenMapping_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "eth.evm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EthAccount",
-			Handler:    _Query_EthAccount_Handler,
-		},
-		{
-			MethodName: "ValidatorAccount",
+func _Query_FunTokenEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunTokenEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunTokenEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunTokenEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunTokenEscrow(ctx, req.(*QueryFunTokenEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunNFTMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunNFTMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunNFTMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/FunNFTMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunNFTMapping(ctx, req.(*QueryFunNFTMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eth.evm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EthAccount",
+			Handler:    _Query_EthAccount_Handler,
+		},
+		{
+			MethodName: "ValidatorAccount",
 			Handler:    _Query_ValidatorAccount_Handler,
 		},
 		{
@@ -1952,6 +2414,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
 		},
+		{
+			MethodName: "StorageProof",
+			Handler:    _Query_StorageProof_Handler,
+		},
 		{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
@@ -1988,6 +2454,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FunTokenMapping",
 			Handler:    _Query_FunTokenMapping_Handler,
 		},
+		{
+			MethodName: "FunTokenEscrow",
+			Handler:    _Query_FunTokenEscrow_Handler,
+		},
+		{
+			MethodName: "FunNFTMapping",
+			Handler:    _Query_FunNFTMapping_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eth/evm/v1/query.proto",
@@ -2290,6 +2764,135 @@ func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryStorageProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StorageRoot) > 0 {
+		i -= len(m.StorageRoot)
+		copy(dAtA[i:], m.StorageRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageSlotProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageSlotProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageSlotProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2951,81 +3554,219 @@ func (m *QueryFunTokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFunTokenEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEthAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryFunTokenEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEthAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFunTokenEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunTokenEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunTokenEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BalanceWei)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Bech32Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFunNFTMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryFunNFTMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunNFTMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunNFTMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunNFTMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunNFTMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FunNft != nil {
+		{
+			size, err := m.FunNft.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEthAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEthAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BalanceWei)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Bech32Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.AccountAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -3099,6 +3840,67 @@ func (m *QueryStorageResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStorageProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStorageProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StorageSlotProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCodeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3374,6 +4176,58 @@ func (m *QueryFunTokenMappingResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFunTokenEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunTokenEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FunToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Escrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFunNFTMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunNFTMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FunNft != nil {
+		l = m.FunNft.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4285,7 +5139,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4308,10 +5162,10 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4346,6 +5200,38 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4367,7 +5253,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4390,17 +5276,17 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRoot", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4410,24 +5296,56 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.StorageRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, StorageSlotProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4451,7 +5369,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *StorageSlotProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4474,15 +5392,15 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageSlotProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageSlotProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4510,13 +5428,13 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4526,27 +5444,55 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4569,7 +5515,7 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4592,51 +5538,17 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4646,27 +5558,23 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4689,7 +5597,7 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4712,12 +5620,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4739,7 +5681,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4762,15 +5704,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4797,7 +5771,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4822,7 +5799,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4845,17 +5822,620 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4865,31 +6445,29 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			m.GasCap = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4899,12 +6477,26 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasCap |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -4938,7 +6530,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -4957,6 +6549,25 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4978,7 +6589,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5001,17 +6612,17 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Gas = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5021,11 +6632,26 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5047,7 +6673,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5070,15 +6696,15 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5105,10 +6731,8 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5148,40 +6772,6 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
@@ -5359,7 +6949,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5382,10 +6972,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5443,7 +7033,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5466,104 +7056,65 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5591,13 +7142,17 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUnibi", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5607,30 +7162,83 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.BaseFeeUnibi = &v
+			if err := m.BaseFeeUnibi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunTokenMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunTokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5640,64 +7248,24 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-			}
-			m.BlockMaxGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5719,7 +7287,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5742,17 +7310,17 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5762,24 +7330,26 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.FunToken == nil {
+				m.FunToken = &FunToken{}
+			}
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5803,7 +7373,7 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5826,12 +7396,44 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5853,7 +7455,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunTokenEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5876,17 +7478,17 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunTokenEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunTokenEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5896,33 +7498,30 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeUnibi", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5932,25 +7531,22 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFeeUnibi = &v
-			if err := m.BaseFeeUnibi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5975,7 +7571,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFunNFTMappingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5998,10 +7594,10 @@ func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunTokenMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunNFTMappingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunTokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunNFTMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6057,7 +7653,7 @@ func (m *QueryFunTokenMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFunNFTMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6080,15 +7676,15 @@ func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunTokenMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFunNFTMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunTokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFunNFTMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunNft", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6115,10 +7711,10 @@ func (m *QueryFunTokenMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FunToken == nil {
-				m.FunToken = &FunToken{}
+			if m.FunNft == nil {
+				m.FunNft = &FunNFT{}
 			}
-			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FunNft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
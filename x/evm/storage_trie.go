@@ -0,0 +1,119 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+// MaxStorageTrieSlots is the maximum number of storage slots of an account
+// whose storage trie is built for "eth_getProof". The trie is built from all
+// of the slots on each query, so larger storages are refused.
+const MaxStorageTrieSlots = 100_000
+
+// ErrStorageTrieTooLarge is returned when the storage of an account has more
+// than [MaxStorageTrieSlots] slots.
+var ErrStorageTrieTooLarge = fmt.Errorf(
+	"storage has more than %d slots, the limit to build its storage trie", MaxStorageTrieSlots,
+)
+
+// StorageTrie returns the Ethereum storage trie of an account with the given
+// storage slots. Nibiru stores contract storage as plain key-value pairs in
+// the EVM module store, so the trie is built from them on demand.
+//
+// Like in Ethereum, the trie is a Merkle-Patricia trie that maps the
+// Keccak-256 hash of each slot to the RLP encoding of its value without
+// leading zeros, and slots with a zero value are not in the trie. Its root
+// hash is equal to the "storageRoot" that Ethereum would compute for the same
+// storage. Unlike in Ethereum, the root is not part of the state committed by
+// consensus: the app hash commits to the slots in the EVM module store, not
+// to this trie.
+func StorageTrie(slots map[gethcommon.Hash]gethcommon.Hash) (*trie.Trie, error) {
+	storageTrie := trie.NewEmpty(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	for key, value := range slots {
+		if value == (gethcommon.Hash{}) {
+			continue
+		}
+		valueRlp, err := rlp.EncodeToBytes(gethcommon.TrimLeftZeroes(value[:]))
+		if err != nil {
+			return nil, err
+		}
+		if err := storageTrie.Update(crypto.Keccak256(key[:]), valueRlp); err != nil {
+			return nil, err
+		}
+	}
+	return storageTrie, nil
+}
+
+// StorageProof returns the Merkle proof of the slot in the storage trie, which
+// is the list of RLP encoded trie nodes on the path from the root to the slot,
+// in the format of "eth_getProof". The proof of a slot that isn't in the trie
+// proves its absence. See [VerifyStorageProof].
+func StorageProof(storageTrie *trie.Trie, key gethcommon.Hash) ([][]byte, error) {
+	var proof proofList
+	if err := storageTrie.Prove(crypto.Keccak256(key[:]), &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// VerifyStorageProof verifies the Merkle proof of the slot against the
+// storage root of the account and returns the value of the slot, which is
+// zero if the proof proves that the slot isn't in the trie.
+//
+// Since the storage root is not committed by consensus, a valid proof only
+// shows that the value is consistent with the root reported by the node. It
+// is not verifiable by a light client against a block header. To trust the
+// value, the verifier has to trust the node, or recompute the root with
+// [StorageTrie] from the storage of the contract.
+func VerifyStorageProof(
+	storageRoot, key gethcommon.Hash, proof [][]byte,
+) (gethcommon.Hash, error) {
+	if storageRoot == gethcore.EmptyRootHash {
+		// The empty trie has no nodes, so any slot is absent.
+		if len(proof) != 0 {
+			return gethcommon.Hash{}, errors.New("invalid storage proof: non-empty proof for the empty trie")
+		}
+		return gethcommon.Hash{}, nil
+	}
+
+	proofDb := memorydb.New()
+	for _, node := range proof {
+		if err := proofDb.Put(crypto.Keccak256(node), node); err != nil {
+			return gethcommon.Hash{}, err
+		}
+	}
+	valueRlp, err := trie.VerifyProof(storageRoot, crypto.Keccak256(key[:]), proofDb)
+	if err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("invalid storage proof: %w", err)
+	}
+	if len(valueRlp) == 0 {
+		return gethcommon.Hash{}, nil
+	}
+	var value []byte
+	if err := rlp.DecodeBytes(valueRlp, &value); err != nil {
+		return gethcommon.Hash{}, fmt.Errorf("invalid storage proof value: %w", err)
+	}
+	return gethcommon.BytesToHash(value), nil
+}
+
+// proofList collects the trie nodes of a Merkle proof in order.
+type proofList [][]byte
+
+func (l *proofList) Put(_ []byte, value []byte) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func (l *proofList) Delete([]byte) error {
+	return errors.New("proofList: delete is not supported")
+}
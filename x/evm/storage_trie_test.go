@@ -0,0 +1,69 @@
+package evm_test
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/evm"
+)
+
+func TestStorageTrie(t *testing.T) {
+	addr := gethcommon.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	slots := map[gethcommon.Hash]gethcommon.Hash{
+		gethcommon.HexToHash("0x00"): gethcommon.HexToHash("0xd3c21bcecceda1000000"),
+		gethcommon.HexToHash("0x01"): gethcommon.HexToHash("0x2a"),
+		gethcommon.HexToHash("0x02"): {}, // zero values are not in the trie
+		gethcommon.HexToHash("0xb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6"): gethcommon.HexToHash("0xff"),
+	}
+
+	storageTrie, err := evm.StorageTrie(slots)
+	require.NoError(t, err)
+
+	// The storage root is the one Ethereum computes for the same storage.
+	gethState, err := state.New(gethcore.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	gethState.CreateAccount(addr)
+	gethState.SetNonce(addr, 1)
+	for key, value := range slots {
+		gethState.SetState(addr, key, value)
+	}
+	gethState.IntermediateRoot(true)
+	require.Equal(t, gethState.GetStorageRoot(addr), storageTrie.Hash())
+
+	for key, value := range slots {
+		proof, err := evm.StorageProof(storageTrie, key)
+		require.NoError(t, err)
+		gotValue, err := evm.VerifyStorageProof(storageTrie.Hash(), key, proof)
+		require.NoError(t, err)
+		require.Equal(t, value, gotValue)
+	}
+
+	// A proof of absence proves a zero value.
+	absentKey := gethcommon.HexToHash("0x03")
+	proof, err := evm.StorageProof(storageTrie, absentKey)
+	require.NoError(t, err)
+	gotValue, err := evm.VerifyStorageProof(storageTrie.Hash(), absentKey, proof)
+	require.NoError(t, err)
+	require.Equal(t, gethcommon.Hash{}, gotValue)
+
+	// A proof doesn't verify against another root.
+	key := gethcommon.HexToHash("0x01")
+	proof, err = evm.StorageProof(storageTrie, key)
+	require.NoError(t, err)
+	_, err = evm.VerifyStorageProof(gethcommon.HexToHash("0x1234"), key, proof)
+	require.ErrorContains(t, err, "invalid storage proof")
+
+	// An account without storage has the empty storage root.
+	emptyTrie, err := evm.StorageTrie(nil)
+	require.NoError(t, err)
+	require.Equal(t, gethcore.EmptyRootHash, emptyTrie.Hash())
+	proof, err = evm.StorageProof(emptyTrie, key)
+	require.NoError(t, err)
+	gotValue, err = evm.VerifyStorageProof(emptyTrie.Hash(), key, proof)
+	require.NoError(t, err)
+	require.Equal(t, gethcommon.Hash{}, gotValue)
+}
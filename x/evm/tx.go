@@ -34,17 +34,41 @@ type EvmTxArgs struct {
 var DefaultPriorityReduction = sdk.DefaultPowerReduction
 
 // GetTxPriority returns the priority of a given Ethereum tx. It relies on the
-// priority reduction global variable to calculate the tx priority given the tx
-// tip price:
+// priority reduction global variable to calculate the tx priority given the
+// effective tip of the tx (see [EffectiveGasTipWei]):
 //
-//	tx_priority = tip_price / priority_reduction
-func GetTxPriority(txData TxData, baseFee *big.Int) (priority int64) {
-	// calculate priority based on effective gas price
-	tipPrice := txData.EffectiveGasPriceWeiPerGas(baseFee)
+//	tx_priority = effective_tip / priority_reduction
+func GetTxPriority(txData TxData, baseFeeWei *big.Int) (priority int64) {
+	return txPriorityFromTipWei(EffectiveGasTipWei(txData, baseFeeWei))
+}
 
+// GetCosmosTxPriority returns the priority of a Cosmos tx in the same unit as
+// [GetTxPriority], so that the mempool orders Cosmos and Ethereum txs by the
+// tip they pay on top of the base fee. The gas price of a Cosmos tx is its fee
+// in [EVMBankDenom] per unit of gas:
+//
+//	tx_priority = (fee_wei / gas_limit - base_fee) / priority_reduction
+func GetCosmosTxPriority(fee sdk.Coins, gasLimit uint64, baseFeeWei *big.Int) (priority int64) {
+	if gasLimit == 0 {
+		return 0
+	}
+	gasPriceWei := new(big.Int).Quo(
+		NativeToWei(fee.AmountOf(EVMBankDenom).BigInt()),
+		new(big.Int).SetUint64(gasLimit),
+	)
+	tip := gasPriceWei.Sub(gasPriceWei, baseFeeWei)
+	if tip.Sign() < 0 {
+		return 0
+	}
+	return txPriorityFromTipWei(tip)
+}
+
+// txPriorityFromTipWei scales down a tip in units of wei per gas to a tx
+// priority.
+func txPriorityFromTipWei(tip *big.Int) (priority int64) {
 	// Return the min of the max possible priorty and the derived priority
 	priority = math.MaxInt64
-	derivedPriority := new(big.Int).Quo(tipPrice, DefaultPriorityReduction.BigInt())
+	derivedPriority := new(big.Int).Quo(tip, DefaultPriorityReduction.BigInt())
 
 	// Overflow safety check
 	var priorityBigI64 int64
@@ -56,6 +80,28 @@ func GetTxPriority(txData TxData, baseFee *big.Int) (priority int64) {
 	return min(priority, priorityBigI64)
 }
 
+// EffectiveGasTipWei returns the tip of a given Ethereum tx in units of wei
+// per gas, which is the part of the gas price paid on top of the base fee:
+//
+//	effective_tip = min(gas_tip_cap, gas_fee_cap - base_fee)
+//
+// For a [LegacyTx] or an [AccessListTx], both caps are the gas price. The tip
+// is zero if the fee cap does not cover the base fee.
+func EffectiveGasTipWei(txData TxData, baseFeeWei *big.Int) *big.Int {
+	gasFeeCap, gasTipCap := txData.GetGasFeeCapWei(), txData.GetGasTipCapWei()
+	if gasFeeCap == nil || gasTipCap == nil {
+		return big.NewInt(0)
+	}
+	tip := new(big.Int).Sub(gasFeeCap, baseFeeWei)
+	if tip.Cmp(gasTipCap) > 0 {
+		tip.Set(gasTipCap)
+	}
+	if tip.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return tip
+}
+
 // Failed returns if the contract execution failed in vm errors
 func (m *MsgEthereumTxResponse) Failed() bool {
 	return len(m.VmError) > 0
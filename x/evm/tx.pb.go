@@ -484,6 +484,10 @@ type MsgCreateFunToken struct {
 	FromBankDenom string `protobuf:"bytes,2,opt,name=from_bank_denom,json=fromBankDenom,proto3" json:"from_bank_denom,omitempty"`
 	// Sender: Address for the signer of the transaction.
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Number of ERC-20 decimals to drop in the Bank Coin representation. See
+	// "FunToken.scaling_exponent". Only valid with "from_erc20". Defaults to
+	// zero, which keeps the decimals of the ERC-20.
+	ScalingExponent uint32 `protobuf:"varint,4,opt,name=scaling_exponent,json=scalingExponent,proto3" json:"scaling_exponent,omitempty"`
 }
 
 func (m *MsgCreateFunToken) Reset()         { *m = MsgCreateFunToken{} }
@@ -533,6 +537,13 @@ func (m *MsgCreateFunToken) GetSender() string {
 	return ""
 }
 
+func (m *MsgCreateFunToken) GetScalingExponent() uint32 {
+	if m != nil {
+		return m.ScalingExponent
+	}
+	return 0
+}
+
 type MsgCreateFunTokenResponse struct {
 	// Fungible token mapping corresponding to ERC20 tokens.
 	FuntokenMapping FunToken `protobuf:"bytes,1,opt,name=funtoken_mapping,json=funtokenMapping,proto3" json:"funtoken_mapping"`
@@ -671,858 +682,1101 @@ func (m *MsgConvertCoinToEvmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertCoinToEvmResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgEthereumTx)(nil), "eth.evm.v1.MsgEthereumTx")
-	proto.RegisterType((*LegacyTx)(nil), "eth.evm.v1.LegacyTx")
-	proto.RegisterType((*AccessListTx)(nil), "eth.evm.v1.AccessListTx")
-	proto.RegisterType((*DynamicFeeTx)(nil), "eth.evm.v1.DynamicFeeTx")
-	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "eth.evm.v1.ExtensionOptionsEthereumTx")
-	proto.RegisterType((*MsgEthereumTxResponse)(nil), "eth.evm.v1.MsgEthereumTxResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "eth.evm.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "eth.evm.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateFunToken)(nil), "eth.evm.v1.MsgCreateFunToken")
-	proto.RegisterType((*MsgCreateFunTokenResponse)(nil), "eth.evm.v1.MsgCreateFunTokenResponse")
-	proto.RegisterType((*MsgConvertCoinToEvm)(nil), "eth.evm.v1.MsgConvertCoinToEvm")
-	proto.RegisterType((*MsgConvertCoinToEvmResponse)(nil), "eth.evm.v1.MsgConvertCoinToEvmResponse")
+// MsgConvertEvmToCoin: Arguments to send ERC20 tokens to their Bank Coin
+// representation
+type MsgConvertEvmToCoin struct {
+	// Sender: Address for the signer of the transaction. The ERC20 tokens are
+	// sent from the EVM address of the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token of the `FunToken` mapping
+	Erc20Addr github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"erc20_addr"`
+	// Amount of ERC20 tokens to convert
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// Bech32 or hexadecimal address of the recipient of the Bank Coins
+	ToAddr string `protobuf:"bytes,4,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+}
+
+func (m *MsgConvertEvmToCoin) Reset()         { *m = MsgConvertEvmToCoin{} }
+func (m *MsgConvertEvmToCoin) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToCoin) ProtoMessage()    {}
+func (*MsgConvertEvmToCoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{12}
+}
+func (m *MsgConvertEvmToCoin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() { proto.RegisterFile("eth/evm/v1/tx.proto", fileDescriptor_82a0bfe4f0bab953) }
-
-var fileDescriptor_82a0bfe4f0bab953 = []byte{
-	// 1247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x8e, 0xff, 0x3c, 0xbb, 0x49, 0xd8, 0xa6, 0xd4, 0x76, 0x5b, 0x6f, 0xd8, 0x8a,
-	0x12, 0x90, 0xb2, 0xdb, 0x18, 0xb5, 0x52, 0x73, 0x22, 0x4e, 0x5c, 0x54, 0x94, 0x40, 0xb4, 0x38,
-	0x3d, 0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x59, 0xaf, 0x92, 0x9d, 0x59, 0xed, 0x8c, 0x57, 0x0e, 0xc7,
-	0x9e, 0x90, 0x38, 0x00, 0xe2, 0x0b, 0x70, 0xe0, 0xd4, 0x13, 0x87, 0x1e, 0xf8, 0x08, 0x15, 0xa7,
-	0x0a, 0x0e, 0xa0, 0x1e, 0x0c, 0x4a, 0x91, 0x90, 0x7a, 0xec, 0x81, 0x1b, 0x12, 0x9a, 0xd9, 0xb5,
-	0x63, 0x27, 0x38, 0x85, 0x08, 0x71, 0x9b, 0x37, 0xef, 0xcf, 0xbc, 0xf7, 0xfb, 0xbd, 0x79, 0x33,
-	0x70, 0x11, 0xf3, 0xae, 0x89, 0x23, 0xdf, 0x8c, 0x56, 0x4d, 0xde, 0x37, 0x82, 0x90, 0x72, 0xaa,
-	0x02, 0xe6, 0x5d, 0x03, 0x47, 0xbe, 0x11, 0xad, 0x56, 0x2f, 0xdb, 0x94, 0xf9, 0x94, 0x99, 0x3e,
-	0x73, 0x85, 0x8d, 0xcf, 0xdc, 0xd8, 0xa8, 0x5a, 0x4b, 0x14, 0x1d, 0xc4, 0xb0, 0x19, 0xad, 0x76,
-	0x30, 0x47, 0xab, 0xa6, 0x4d, 0x3d, 0x92, 0xe8, 0x2b, 0xb1, 0xbe, 0x2d, 0x25, 0x33, 0x16, 0x12,
-	0xd5, 0xe2, 0xd8, 0xa1, 0xe2, 0x98, 0x64, 0xd7, 0xa5, 0x2e, 0x8d, 0xad, 0xc5, 0x2a, 0xd9, 0xbd,
-	0xea, 0x52, 0xea, 0x1e, 0x60, 0x13, 0x05, 0x9e, 0x89, 0x08, 0xa1, 0x1c, 0x71, 0x8f, 0x92, 0x61,
-	0xa4, 0x4a, 0xa2, 0x95, 0x52, 0xa7, 0xb7, 0x67, 0x22, 0x72, 0x18, 0xab, 0xf4, 0xcf, 0x15, 0xb8,
-	0xb0, 0xcd, 0xdc, 0x26, 0xef, 0xe2, 0x10, 0xf7, 0xfc, 0x56, 0x5f, 0x5d, 0x86, 0x8c, 0x83, 0x38,
-	0x2a, 0x2b, 0x4b, 0xca, 0x72, 0xb1, 0xbe, 0x68, 0xc4, 0xbe, 0xc6, 0xd0, 0xd7, 0x58, 0x27, 0x87,
-	0x96, 0xb4, 0x50, 0x2b, 0x90, 0x61, 0xde, 0x27, 0xb8, 0x9c, 0x5a, 0x52, 0x96, 0x95, 0xc6, 0xec,
-	0xf3, 0x81, 0xa6, 0xac, 0x58, 0x72, 0x4b, 0xd5, 0x20, 0xd3, 0x45, 0xac, 0x5b, 0x4e, 0x2f, 0x29,
-	0xcb, 0x85, 0x46, 0xf1, 0xc5, 0x40, 0xcb, 0x85, 0x07, 0xc1, 0x9a, 0xbe, 0xa2, 0x5b, 0x52, 0xa1,
-	0xaa, 0x90, 0xd9, 0x0b, 0xa9, 0x5f, 0xce, 0x08, 0x03, 0x4b, 0xae, 0xd7, 0x32, 0x9f, 0x7e, 0xad,
-	0xcd, 0xe8, 0x5f, 0xa6, 0x20, 0xbf, 0x85, 0x5d, 0x64, 0x1f, 0xb6, 0xfa, 0xea, 0x22, 0xcc, 0x12,
-	0x4a, 0x6c, 0x2c, 0xb3, 0xc9, 0x58, 0xb1, 0xa0, 0xde, 0x86, 0x82, 0x8b, 0x04, 0x66, 0x9e, 0x1d,
-	0x9f, 0x5e, 0x68, 0x54, 0x9e, 0x0e, 0xb4, 0x4b, 0x31, 0x7c, 0xcc, 0xd9, 0x37, 0x3c, 0x6a, 0xfa,
-	0x88, 0x77, 0x8d, 0x7b, 0x84, 0x5b, 0x79, 0x17, 0xb1, 0x1d, 0x61, 0xaa, 0xd6, 0x20, 0xed, 0x22,
-	0x26, 0x93, 0xca, 0x34, 0x4a, 0x47, 0x03, 0x2d, 0xff, 0x2e, 0x62, 0x5b, 0x9e, 0xef, 0x71, 0x4b,
-	0x28, 0xd4, 0x39, 0x48, 0x71, 0x9a, 0xa4, 0x94, 0xe2, 0x54, 0xbd, 0x03, 0xb3, 0x11, 0x3a, 0xe8,
-	0xe1, 0xf2, 0xac, 0x3c, 0xe3, 0xfa, 0xd4, 0x33, 0x8e, 0x06, 0x5a, 0x76, 0xdd, 0xa7, 0x3d, 0xc2,
-	0xad, 0xd8, 0x43, 0xd4, 0x27, 0x51, 0xcc, 0x2e, 0x29, 0xcb, 0xa5, 0x04, 0xaf, 0x12, 0x28, 0x51,
-	0x39, 0x27, 0x37, 0x94, 0x48, 0x48, 0x61, 0x39, 0x1f, 0x4b, 0xa1, 0x90, 0x58, 0xb9, 0x10, 0x4b,
-	0x6c, 0x6d, 0x4e, 0x20, 0xf1, 0xfd, 0xa3, 0x95, 0x6c, 0xab, 0xbf, 0x89, 0x38, 0xd2, 0xbf, 0x4b,
-	0x43, 0x69, 0xdd, 0xb6, 0x31, 0x63, 0x5b, 0x1e, 0xe3, 0xad, 0xbe, 0xfa, 0x1e, 0xe4, 0xed, 0x2e,
-	0xf2, 0x48, 0xdb, 0x73, 0x24, 0x34, 0x85, 0x86, 0x79, 0x56, 0x72, 0xb9, 0x0d, 0x61, 0x7c, 0x6f,
-	0xf3, 0xf9, 0x40, 0xcb, 0xd9, 0xf1, 0xd2, 0x4a, 0x16, 0xce, 0x31, 0xc6, 0xa9, 0xa9, 0x18, 0xa7,
-	0xff, 0x35, 0xc6, 0x99, 0xb3, 0x31, 0x9e, 0x3d, 0x8d, 0x71, 0xf6, 0xdc, 0x18, 0xe7, 0xc6, 0x30,
-	0xde, 0x85, 0x3c, 0x92, 0x40, 0x61, 0x56, 0xce, 0x2f, 0xa5, 0x97, 0x8b, 0xf5, 0xcb, 0xc6, 0xf1,
-	0x3d, 0x35, 0x62, 0x10, 0x5b, 0xbd, 0xe0, 0x00, 0x37, 0x96, 0x1e, 0x0f, 0xb4, 0x99, 0xe7, 0x03,
-	0x0d, 0xd0, 0x08, 0xd9, 0x87, 0xbf, 0x68, 0x70, 0x8c, 0xb3, 0x35, 0x0a, 0x15, 0x53, 0x57, 0x98,
-	0xa0, 0x0e, 0x26, 0xa8, 0x2b, 0x4e, 0xa3, 0xee, 0x8f, 0x34, 0x94, 0x36, 0x0f, 0x09, 0xf2, 0x3d,
-	0xfb, 0x2e, 0xc6, 0xff, 0x0b, 0x75, 0x77, 0xa0, 0x28, 0xa8, 0xe3, 0x5e, 0xd0, 0xb6, 0x51, 0xf0,
-	0x72, 0xf2, 0x04, 0xd1, 0x2d, 0x2f, 0xd8, 0x40, 0xc1, 0xd0, 0x75, 0x0f, 0x63, 0xe9, 0x9a, 0xf9,
-	0x27, 0xae, 0x77, 0x31, 0x16, 0xae, 0x09, 0xf1, 0xb3, 0x67, 0x13, 0x9f, 0x3d, 0x4d, 0x7c, 0xee,
-	0xdc, 0xc4, 0xe7, 0xa7, 0x10, 0x5f, 0xf8, 0x8f, 0x89, 0x87, 0x09, 0xe2, 0x8b, 0x13, 0xc4, 0x97,
-	0xa6, 0x11, 0xaf, 0x43, 0xb5, 0xd9, 0xe7, 0x98, 0x30, 0x8f, 0x92, 0x0f, 0x02, 0x39, 0x8e, 0x8f,
-	0xa7, 0x6c, 0x32, 0xeb, 0xbe, 0x51, 0xe0, 0xd2, 0xc4, 0xf4, 0xb5, 0x30, 0x0b, 0x28, 0x61, 0xb2,
-	0x44, 0x39, 0x40, 0x95, 0x78, 0x3e, 0xca, 0x99, 0xf9, 0x26, 0x64, 0x0e, 0xa8, 0xcb, 0xca, 0x29,
-	0x59, 0xde, 0xfc, 0x78, 0x79, 0x5b, 0xd4, 0x6d, 0x64, 0x44, 0x59, 0x96, 0x34, 0x51, 0x17, 0x20,
-	0x1d, 0x62, 0x2e, 0xa9, 0x2f, 0x59, 0x62, 0xa9, 0x56, 0x20, 0x1f, 0xf9, 0x6d, 0x1c, 0x86, 0x34,
-	0x4c, 0x26, 0x5c, 0x2e, 0xf2, 0x9b, 0x42, 0x14, 0x2a, 0x41, 0x7a, 0x8f, 0x61, 0x27, 0xa6, 0xcf,
-	0xca, 0xb9, 0x88, 0xed, 0x32, 0xec, 0x24, 0x69, 0x7e, 0xa6, 0xc0, 0xfc, 0x36, 0x73, 0x77, 0x03,
-	0x07, 0x71, 0xbc, 0x83, 0x42, 0xe4, 0x33, 0x31, 0x1f, 0x50, 0x8f, 0x77, 0x69, 0xe8, 0xf1, 0xc3,
-	0xa4, 0x8f, 0xcb, 0x3f, 0x3c, 0x5a, 0x59, 0x4c, 0x9e, 0xb0, 0x75, 0xc7, 0x09, 0x31, 0x63, 0x1f,
-	0xf2, 0xd0, 0x23, 0xae, 0x75, 0x6c, 0xaa, 0xde, 0x84, 0x6c, 0x20, 0x23, 0xc8, 0x9e, 0x2d, 0xd6,
-	0xd5, 0xf1, 0x32, 0xe2, 0xd8, 0x49, 0x25, 0x89, 0xdd, 0xda, 0xdc, 0x83, 0xdf, 0xbf, 0x7d, 0xeb,
-	0x38, 0x82, 0x5e, 0x81, 0xcb, 0x27, 0x92, 0x19, 0xa2, 0xa6, 0x3f, 0x54, 0xe0, 0x95, 0x6d, 0xe6,
-	0x6e, 0x84, 0x18, 0x71, 0x7c, 0xb7, 0x47, 0x5a, 0x74, 0x1f, 0x13, 0x75, 0x17, 0x40, 0xbc, 0x2f,
-	0x6d, 0x1c, 0xda, 0xf5, 0x9b, 0x49, 0xae, 0xb7, 0x1f, 0x0f, 0x34, 0xe5, 0xe9, 0x40, 0x33, 0x5c,
-	0x8f, 0x77, 0x7b, 0x1d, 0xc3, 0xa6, 0xbe, 0xf9, 0xbe, 0xd7, 0xf1, 0xc2, 0x9e, 0xbc, 0x6f, 0x26,
-	0x91, 0x6b, 0x33, 0xaa, 0x9b, 0x22, 0xbd, 0xe6, 0xbd, 0x9d, 0x5b, 0xb7, 0x44, 0x49, 0x56, 0x41,
-	0x44, 0x6a, 0x8a, 0x40, 0xea, 0x0d, 0x98, 0x97, 0x61, 0x3b, 0x88, 0xec, 0xb7, 0x1d, 0x4c, 0xa8,
-	0x1f, 0xbf, 0x45, 0xd6, 0x05, 0xb1, 0xdd, 0x40, 0x64, 0x7f, 0x53, 0x6c, 0xaa, 0xaf, 0x42, 0x96,
-	0x61, 0xe2, 0xe0, 0x30, 0xbe, 0x89, 0x56, 0x22, 0xe9, 0x1d, 0xa8, 0x9c, 0xca, 0x75, 0xc4, 0x7f,
-	0x13, 0x16, 0xf6, 0x7a, 0x84, 0x8b, 0xbd, 0xb6, 0x8f, 0x82, 0xc0, 0x23, 0xee, 0xe8, 0x45, 0x1e,
-	0x03, 0x6c, 0xe8, 0x97, 0x40, 0x36, 0x3f, 0xf4, 0xd9, 0x8e, 0x5d, 0xf4, 0x9f, 0x14, 0xb8, 0x28,
-	0x0e, 0xa1, 0x24, 0xc2, 0x21, 0xdf, 0xa0, 0x1e, 0x69, 0xd1, 0x66, 0xe4, 0xab, 0xf7, 0xa1, 0xc8,
-	0x69, 0x1b, 0xf3, 0x6e, 0x1b, 0x39, 0x4e, 0x38, 0x86, 0xc9, 0xcc, 0x79, 0x30, 0xe1, 0xb4, 0xc9,
-	0xbb, 0x62, 0x39, 0x56, 0x6b, 0x6a, 0xbc, 0x56, 0x75, 0x07, 0x0a, 0x12, 0x26, 0xf1, 0xf3, 0x91,
-	0x30, 0x14, 0xeb, 0x15, 0x23, 0x69, 0x15, 0xf1, 0x35, 0x32, 0x92, 0xaf, 0x91, 0x21, 0x52, 0x6c,
-	0x94, 0x45, 0x22, 0x2f, 0x06, 0xda, 0xc2, 0x21, 0xf2, 0x0f, 0xd6, 0xf4, 0x91, 0xa7, 0x6e, 0xe5,
-	0xc5, 0x5a, 0xd8, 0xe8, 0xd7, 0xe0, 0xca, 0xdf, 0x14, 0x36, 0xc4, 0xaf, 0xfe, 0x67, 0x0a, 0xd2,
-	0xdb, 0xcc, 0x55, 0x09, 0xc0, 0xd8, 0xdf, 0xa6, 0x32, 0x8e, 0xdd, 0xc4, 0xc5, 0xab, 0xbe, 0x36,
-	0x55, 0x35, 0xea, 0x2e, 0xfd, 0xc1, 0x8f, 0xbf, 0x7d, 0x95, 0xba, 0xaa, 0x57, 0x87, 0x48, 0x0c,
-	0x3f, 0x67, 0x89, 0x69, 0x9b, 0xf7, 0xd5, 0x1d, 0x28, 0x4d, 0x5c, 0x93, 0x2b, 0x27, 0xc2, 0x8e,
-	0x2b, 0xab, 0xd7, 0xcf, 0x50, 0x8e, 0x3a, 0xe1, 0x3e, 0xcc, 0x9d, 0xe8, 0xe7, 0x6b, 0x27, 0xdc,
-	0x26, 0xd5, 0xd5, 0xd7, 0xcf, 0x54, 0x8f, 0xe2, 0x7e, 0x0c, 0x0b, 0xa7, 0xda, 0x42, 0x3b, 0xe9,
-	0x7a, 0xc2, 0xa0, 0xfa, 0xc6, 0x4b, 0x0c, 0x86, 0xd1, 0x1b, 0xef, 0x3c, 0x3e, 0xaa, 0x29, 0x4f,
-	0x8e, 0x6a, 0xca, 0xaf, 0x47, 0x35, 0xe5, 0x8b, 0x67, 0xb5, 0x99, 0x27, 0xcf, 0x6a, 0x33, 0x3f,
-	0x3f, 0xab, 0xcd, 0x7c, 0x74, 0xe3, 0xa5, 0xdd, 0xd5, 0x17, 0xc0, 0x76, 0xb2, 0xf2, 0xc7, 0xf9,
-	0xf6, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xdd, 0x8f, 0x11, 0x7c, 0x0b, 0x00, 0x00,
+func (m *MsgConvertEvmToCoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToCoin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertEvmToCoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToCoin.Merge(m, src)
+}
+func (m *MsgConvertEvmToCoin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToCoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToCoin.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgConvertEvmToCoin proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// EthereumTx defines a method submitting Ethereum transactions.
-	EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
-	// UpdateParams defined a governance operation for updating the x/evm module
-	// parameters. The authority is hard-coded to the x/gov module account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateFunToken: Create a "FunToken" mapping. Either the ERC20 contract
-	// address can be given to create the mapping to a Bank Coin, or the
-	// denomination for a Bank Coin can be given to create the mapping to an
-	// ERC20.
-	CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error)
-	// ConvertCoinToEvm: Sends a coin with a valid "FunToken" mapping to the
-	// given recipient address ("to_eth_addr") in the corresponding ERC20
-	// representation.
-	ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error)
+func (m *MsgConvertEvmToCoin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgConvertEvmToCoin) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgConvertEvmToCoinResponse struct {
+	// Bank Coin received by "to_addr". Its amount may be lower than the amount
+	// of the message for ERC20 tokens that take a fee on transfer.
+	BankCoin types1.Coin `protobuf:"bytes,1,opt,name=bank_coin,json=bankCoin,proto3" json:"bank_coin" yaml:"bank_coin"`
 }
 
-func (c *msgClient) EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
-	out := new(MsgEthereumTxResponse)
-	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/EthereumTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgConvertEvmToCoinResponse) Reset()         { *m = MsgConvertEvmToCoinResponse{} }
+func (m *MsgConvertEvmToCoinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToCoinResponse) ProtoMessage()    {}
+func (*MsgConvertEvmToCoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{13}
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToCoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToCoinResponse.Merge(m, src)
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToCoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToCoinResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgConvertEvmToCoinResponse proto.InternalMessageInfo
+
+func (m *MsgConvertEvmToCoinResponse) GetBankCoin() types1.Coin {
+	if m != nil {
+		return m.BankCoin
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-func (c *msgClient) CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error) {
-	out := new(MsgCreateFunTokenResponse)
-	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/CreateFunToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgReconcileFunTokenEscrow: Arguments to reconcile the escrow of a
+// `FunToken` created from an ERC20 with the supply of its Bank Coin.
+type MsgReconcileFunTokenEscrow struct {
+	// Sender: Address of the x/sudo root or a sudo contract.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC20 token of the `FunToken` mapping
+	Erc20Addr github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,2,opt,name=erc20_addr,json=erc20Addr,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"erc20_addr"`
+	// is_rebasing: Flags the mapping as rebasing. A mapping whose escrow is
+	// worth less than the supply of its Bank Coin is flagged regardless, since
+	// only the token itself can shrink the escrow.
+	IsRebasing bool `protobuf:"varint,3,opt,name=is_rebasing,json=isRebasing,proto3" json:"is_rebasing,omitempty"`
+}
+
+func (m *MsgReconcileFunTokenEscrow) Reset()         { *m = MsgReconcileFunTokenEscrow{} }
+func (m *MsgReconcileFunTokenEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileFunTokenEscrow) ProtoMessage()    {}
+func (*MsgReconcileFunTokenEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{14}
+}
+func (m *MsgReconcileFunTokenEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcileFunTokenEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileFunTokenEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgReconcileFunTokenEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileFunTokenEscrow.Merge(m, src)
+}
+func (m *MsgReconcileFunTokenEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileFunTokenEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileFunTokenEscrow.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error) {
-	out := new(MsgConvertCoinToEvmResponse)
-	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertCoinToEvm", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReconcileFunTokenEscrow proto.InternalMessageInfo
+
+func (m *MsgReconcileFunTokenEscrow) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// EthereumTx defines a method submitting Ethereum transactions.
-	EthereumTx(context.Context, *MsgEthereumTx) (*MsgEthereumTxResponse, error)
-	// UpdateParams defined a governance operation for updating the x/evm module
-	// parameters. The authority is hard-coded to the x/gov module account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateFunToken: Create a "FunToken" mapping. Either the ERC20 contract
-	// address can be given to create the mapping to a Bank Coin, or the
-	// denomination for a Bank Coin can be given to create the mapping to an
-	// ERC20.
-	CreateFunToken(context.Context, *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error)
-	// ConvertCoinToEvm: Sends a coin with a valid "FunToken" mapping to the
-	// given recipient address ("to_eth_addr") in the corresponding ERC20
-	// representation.
-	ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error)
+func (m *MsgReconcileFunTokenEscrow) GetIsRebasing() bool {
+	if m != nil {
+		return m.IsRebasing
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgReconcileFunTokenEscrowResponse struct {
+	// The reconciled `FunToken` mapping
+	FunToken FunToken `protobuf:"bytes,1,opt,name=fun_token,json=funToken,proto3" json:"fun_token"`
+	// Escrow of the mapping at the time of the reconciliation
+	Escrow FunTokenEscrow `protobuf:"bytes,2,opt,name=escrow,proto3" json:"escrow"`
 }
 
-func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumTx) (*MsgEthereumTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EthereumTx not implemented")
+func (m *MsgReconcileFunTokenEscrowResponse) Reset()         { *m = MsgReconcileFunTokenEscrowResponse{} }
+func (m *MsgReconcileFunTokenEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileFunTokenEscrowResponse) ProtoMessage()    {}
+func (*MsgReconcileFunTokenEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{15}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgReconcileFunTokenEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateFunToken(ctx context.Context, req *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFunToken not implemented")
+func (m *MsgReconcileFunTokenEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileFunTokenEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ConvertCoinToEvm(ctx context.Context, req *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToEvm not implemented")
+func (m *MsgReconcileFunTokenEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileFunTokenEscrowResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgReconcileFunTokenEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileFunTokenEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileFunTokenEscrowResponse.DiscardUnknown(m)
 }
 
-func _Msg_EthereumTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEthereumTx)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EthereumTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/eth.evm.v1.Msg/EthereumTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EthereumTx(ctx, req.(*MsgEthereumTx))
+var xxx_messageInfo_MsgReconcileFunTokenEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgReconcileFunTokenEscrowResponse) GetFunToken() FunToken {
+	if m != nil {
+		return m.FunToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return FunToken{}
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/eth.evm.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgReconcileFunTokenEscrowResponse) GetEscrow() FunTokenEscrow {
+	if m != nil {
+		return m.Escrow
 	}
-	return interceptor(ctx, in, info, handler)
+	return FunTokenEscrow{}
 }
 
-func _Msg_CreateFunToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFunToken)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFunToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/eth.evm.v1.Msg/CreateFunToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFunToken(ctx, req.(*MsgCreateFunToken))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCreateFunNFT: Arguments to create a "FunNFT" mapping from an ERC-721
+// collection.
+type MsgCreateFunNFT struct {
+	// Hexadecimal address of the ERC-721 collection to which the `FunNFT` maps
+	FromErc721 github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,1,opt,name=from_erc721,json=fromErc721,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"from_erc721"`
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func _Msg_ConvertCoinToEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCoinToEvm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCoinToEvm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/eth.evm.v1.Msg/ConvertCoinToEvm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCoinToEvm(ctx, req.(*MsgConvertCoinToEvm))
+func (m *MsgCreateFunNFT) Reset()         { *m = MsgCreateFunNFT{} }
+func (m *MsgCreateFunNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFunNFT) ProtoMessage()    {}
+func (*MsgCreateFunNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{16}
+}
+func (m *MsgCreateFunNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFunNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFunNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "eth.evm.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EthereumTx",
-			Handler:    _Msg_EthereumTx_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateFunToken",
-			Handler:    _Msg_CreateFunToken_Handler,
-		},
-		{
-			MethodName: "ConvertCoinToEvm",
-			Handler:    _Msg_ConvertCoinToEvm_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "eth/evm/v1/tx.proto",
+func (m *MsgCreateFunNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFunNFT.Merge(m, src)
+}
+func (m *MsgCreateFunNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFunNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFunNFT.DiscardUnknown(m)
 }
 
-func (m *MsgEthereumTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateFunNFT proto.InternalMessageInfo
+
+func (m *MsgCreateFunNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgEthereumTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCreateFunNFTResponse struct {
+	// Non-fungible token mapping corresponding to the ERC-721 collection.
+	FunnftMapping FunNFT `protobuf:"bytes,1,opt,name=funnft_mapping,json=funnftMapping,proto3" json:"funnft_mapping"`
 }
 
-func (m *MsgEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Size_ != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Size_))))
-		i--
-		dAtA[i] = 0x11
-	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCreateFunNFTResponse) Reset()         { *m = MsgCreateFunNFTResponse{} }
+func (m *MsgCreateFunNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFunNFTResponse) ProtoMessage()    {}
+func (*MsgCreateFunNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{17}
+}
+func (m *MsgCreateFunNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFunNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFunNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateFunNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFunNFTResponse.Merge(m, src)
+}
+func (m *MsgCreateFunNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFunNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFunNFTResponse.DiscardUnknown(m)
 }
 
-func (m *LegacyTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateFunNFTResponse proto.InternalMessageInfo
+
+func (m *MsgCreateFunNFTResponse) GetFunnftMapping() FunNFT {
+	if m != nil {
+		return m.FunnftMapping
 	}
-	return dAtA[:n], nil
+	return FunNFT{}
 }
 
-func (m *LegacyTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgConvertEvmToNFT: Arguments to send an ERC-721 token to its "x/nft"
+// representation
+type MsgConvertEvmToNFT struct {
+	// Sender: Address for the signer of the transaction. The ERC-721 token is
+	// sent from the EVM address of the sender.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Hexadecimal address of the ERC-721 collection of the `FunNFT` mapping
+	Erc721Addr github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,2,opt,name=erc721_addr,json=erc721Addr,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"erc721_addr"`
+	// ID of the ERC-721 token to convert
+	TokenId cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3,customtype=cosmossdk.io/math.Int" json:"token_id"`
+	// Bech32 or hexadecimal address of the recipient of the "x/nft" token
+	ToAddr string `protobuf:"bytes,4,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
 }
 
-func (m *LegacyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0x42
+func (m *MsgConvertEvmToNFT) Reset()         { *m = MsgConvertEvmToNFT{} }
+func (m *MsgConvertEvmToNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToNFT) ProtoMessage()    {}
+func (*MsgConvertEvmToNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{18}
+}
+func (m *MsgConvertEvmToNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.V) > 0 {
-		i -= len(m.V)
-		copy(dAtA[i:], m.V)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *MsgConvertEvmToNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToNFT.Merge(m, src)
+}
+func (m *MsgConvertEvmToNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertEvmToNFT proto.InternalMessageInfo
+
+func (m *MsgConvertEvmToNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *MsgConvertEvmToNFT) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
 	}
-	if m.Amount != nil {
-		{
-			size := m.Amount.Size()
-			i -= size
-			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	return ""
+}
+
+type MsgConvertEvmToNFTResponse struct {
+	// ID of the "x/nft" class of the token
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// ID of the "x/nft" token received by "to_addr"
+	NftId string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *MsgConvertEvmToNFTResponse) Reset()         { *m = MsgConvertEvmToNFTResponse{} }
+func (m *MsgConvertEvmToNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertEvmToNFTResponse) ProtoMessage()    {}
+func (*MsgConvertEvmToNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{19}
+}
+func (m *MsgConvertEvmToNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertEvmToNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertEvmToNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *MsgConvertEvmToNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertEvmToNFTResponse.Merge(m, src)
+}
+func (m *MsgConvertEvmToNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertEvmToNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertEvmToNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertEvmToNFTResponse proto.InternalMessageInfo
+
+func (m *MsgConvertEvmToNFTResponse) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *MsgConvertEvmToNFTResponse) GetNftId() string {
+	if m != nil {
+		return m.NftId
 	}
-	if m.GasPrice != nil {
-		{
-			size := m.GasPrice.Size()
-			i -= size
-			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	return ""
+}
+
+// MsgConvertNFTToEvm: Arguments to send an "x/nft" token to its ERC-721
+// representation
+type MsgConvertNFTToEvm struct {
+	// Sender: Address for the signer of the transaction and owner of the
+	// "x/nft" token.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ID of the "x/nft" class of the `FunNFT` mapping
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// ID of the "x/nft" token to convert
+	NftId string `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// Hexadecimal address of the recipient of the ERC-721 token
+	ToEthAddr github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,4,opt,name=to_eth_addr,json=toEthAddr,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"to_eth_addr"`
+}
+
+func (m *MsgConvertNFTToEvm) Reset()         { *m = MsgConvertNFTToEvm{} }
+func (m *MsgConvertNFTToEvm) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertNFTToEvm) ProtoMessage()    {}
+func (*MsgConvertNFTToEvm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{20}
+}
+func (m *MsgConvertNFTToEvm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertNFTToEvm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertNFTToEvm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgConvertNFTToEvm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertNFTToEvm.Merge(m, src)
+}
+func (m *MsgConvertNFTToEvm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertNFTToEvm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertNFTToEvm.DiscardUnknown(m)
 }
 
-func (m *AccessListTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgConvertNFTToEvm proto.InternalMessageInfo
+
+func (m *MsgConvertNFTToEvm) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AccessListTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgConvertNFTToEvm) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
 }
 
-func (m *AccessListTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.V) > 0 {
-		i -= len(m.V)
-		copy(dAtA[i:], m.V)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Accesses) > 0 {
-		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x3a
+func (m *MsgConvertNFTToEvm) GetNftId() string {
+	if m != nil {
+		return m.NftId
 	}
-	if m.Amount != nil {
-		{
-			size := m.Amount.Size()
-			i -= size
-			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	return ""
+}
+
+type MsgConvertNFTToEvmResponse struct {
+}
+
+func (m *MsgConvertNFTToEvmResponse) Reset()         { *m = MsgConvertNFTToEvmResponse{} }
+func (m *MsgConvertNFTToEvmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertNFTToEvmResponse) ProtoMessage()    {}
+func (*MsgConvertNFTToEvmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82a0bfe4f0bab953, []int{21}
+}
+func (m *MsgConvertNFTToEvmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertNFTToEvmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertNFTToEvmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *MsgConvertNFTToEvmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertNFTToEvmResponse.Merge(m, src)
+}
+func (m *MsgConvertNFTToEvmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertNFTToEvmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertNFTToEvmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertNFTToEvmResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgEthereumTx)(nil), "eth.evm.v1.MsgEthereumTx")
+	proto.RegisterType((*LegacyTx)(nil), "eth.evm.v1.LegacyTx")
+	proto.RegisterType((*AccessListTx)(nil), "eth.evm.v1.AccessListTx")
+	proto.RegisterType((*DynamicFeeTx)(nil), "eth.evm.v1.DynamicFeeTx")
+	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "eth.evm.v1.ExtensionOptionsEthereumTx")
+	proto.RegisterType((*MsgEthereumTxResponse)(nil), "eth.evm.v1.MsgEthereumTxResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "eth.evm.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "eth.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateFunToken)(nil), "eth.evm.v1.MsgCreateFunToken")
+	proto.RegisterType((*MsgCreateFunTokenResponse)(nil), "eth.evm.v1.MsgCreateFunTokenResponse")
+	proto.RegisterType((*MsgConvertCoinToEvm)(nil), "eth.evm.v1.MsgConvertCoinToEvm")
+	proto.RegisterType((*MsgConvertCoinToEvmResponse)(nil), "eth.evm.v1.MsgConvertCoinToEvmResponse")
+	proto.RegisterType((*MsgConvertEvmToCoin)(nil), "eth.evm.v1.MsgConvertEvmToCoin")
+	proto.RegisterType((*MsgConvertEvmToCoinResponse)(nil), "eth.evm.v1.MsgConvertEvmToCoinResponse")
+	proto.RegisterType((*MsgReconcileFunTokenEscrow)(nil), "eth.evm.v1.MsgReconcileFunTokenEscrow")
+	proto.RegisterType((*MsgReconcileFunTokenEscrowResponse)(nil), "eth.evm.v1.MsgReconcileFunTokenEscrowResponse")
+	proto.RegisterType((*MsgCreateFunNFT)(nil), "eth.evm.v1.MsgCreateFunNFT")
+	proto.RegisterType((*MsgCreateFunNFTResponse)(nil), "eth.evm.v1.MsgCreateFunNFTResponse")
+	proto.RegisterType((*MsgConvertEvmToNFT)(nil), "eth.evm.v1.MsgConvertEvmToNFT")
+	proto.RegisterType((*MsgConvertEvmToNFTResponse)(nil), "eth.evm.v1.MsgConvertEvmToNFTResponse")
+	proto.RegisterType((*MsgConvertNFTToEvm)(nil), "eth.evm.v1.MsgConvertNFTToEvm")
+	proto.RegisterType((*MsgConvertNFTToEvmResponse)(nil), "eth.evm.v1.MsgConvertNFTToEvmResponse")
+}
+
+func init() { proto.RegisterFile("eth/evm/v1/tx.proto", fileDescriptor_82a0bfe4f0bab953) }
+
+var fileDescriptor_82a0bfe4f0bab953 = []byte{
+	// 1676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x14, 0x3f, 0x1e, 0x69, 0x49, 0xdd, 0xd8, 0x15, 0x49, 0xdb, 0xa4, 0xba, 0x46,
+	0x15, 0xa5, 0x80, 0xb9, 0x11, 0x0b, 0xc7, 0x89, 0x2e, 0xad, 0x69, 0x53, 0x85, 0x0a, 0x4b, 0x15,
+	0xb6, 0x54, 0x82, 0x06, 0x05, 0x88, 0xe1, 0xee, 0x70, 0xb9, 0x30, 0x77, 0x86, 0xdd, 0x19, 0xb2,
+	0x54, 0x8f, 0x3e, 0x15, 0xe8, 0xa1, 0x2d, 0x7a, 0xe8, 0xb5, 0x87, 0x9e, 0x8a, 0x1e, 0x7a, 0x08,
+	0x8a, 0xfe, 0x09, 0x46, 0x4f, 0x41, 0x3f, 0x83, 0x1c, 0xd8, 0x42, 0x2e, 0x50, 0xc0, 0xc7, 0x1c,
+	0x7a, 0x2e, 0x66, 0x76, 0xb8, 0x22, 0x29, 0x2d, 0xed, 0x38, 0x42, 0x6e, 0x33, 0xf3, 0x3e, 0xe6,
+	0xbd, 0xdf, 0x7b, 0xef, 0x37, 0x4b, 0xc2, 0x1b, 0x98, 0xf7, 0x4c, 0x3c, 0xf2, 0xcd, 0xd1, 0xae,
+	0xc9, 0xc7, 0xb5, 0x41, 0x40, 0x39, 0xd5, 0x01, 0xf3, 0x5e, 0x0d, 0x8f, 0xfc, 0xda, 0x68, 0xb7,
+	0xbc, 0x69, 0x53, 0xe6, 0x53, 0x66, 0xfa, 0xcc, 0x15, 0x3a, 0x3e, 0x73, 0x43, 0xa5, 0x72, 0x45,
+	0x09, 0x3a, 0x88, 0x61, 0x73, 0xb4, 0xdb, 0xc1, 0x1c, 0xed, 0x9a, 0x36, 0xf5, 0x88, 0x92, 0x97,
+	0x42, 0x79, 0x5b, 0xee, 0xcc, 0x70, 0xa3, 0x44, 0xd7, 0x67, 0x2e, 0x15, 0xd7, 0xa8, 0x53, 0x97,
+	0xba, 0x34, 0xd4, 0x16, 0x2b, 0x75, 0x7a, 0xcb, 0xa5, 0xd4, 0xed, 0x63, 0x13, 0x0d, 0x3c, 0x13,
+	0x11, 0x42, 0x39, 0xe2, 0x1e, 0x25, 0x53, 0x4f, 0x25, 0x25, 0x95, 0xbb, 0xce, 0xb0, 0x6b, 0x22,
+	0x72, 0x1a, 0x8a, 0x8c, 0x9f, 0x6b, 0x70, 0xed, 0x90, 0xb9, 0x4d, 0xde, 0xc3, 0x01, 0x1e, 0xfa,
+	0xad, 0xb1, 0xbe, 0x03, 0x29, 0x07, 0x71, 0x54, 0xd4, 0xb6, 0xb4, 0x9d, 0x7c, 0xfd, 0x7a, 0x2d,
+	0xb4, 0xad, 0x4d, 0x6d, 0x6b, 0x0f, 0xc8, 0xa9, 0x25, 0x35, 0xf4, 0x12, 0xa4, 0x98, 0xf7, 0x13,
+	0x5c, 0x4c, 0x6c, 0x69, 0x3b, 0x5a, 0x63, 0xf5, 0xc5, 0xa4, 0xaa, 0xdd, 0xb5, 0xe4, 0x91, 0x5e,
+	0x85, 0x54, 0x0f, 0xb1, 0x5e, 0x31, 0xb9, 0xa5, 0xed, 0xe4, 0x1a, 0xf9, 0xcf, 0x26, 0xd5, 0x4c,
+	0xd0, 0x1f, 0xec, 0x19, 0x77, 0x0d, 0x4b, 0x0a, 0x74, 0x1d, 0x52, 0xdd, 0x80, 0xfa, 0xc5, 0x94,
+	0x50, 0xb0, 0xe4, 0x7a, 0x2f, 0xf5, 0xd3, 0xdf, 0x54, 0x57, 0x8c, 0x5f, 0x26, 0x20, 0xfb, 0x18,
+	0xbb, 0xc8, 0x3e, 0x6d, 0x8d, 0xf5, 0xeb, 0xb0, 0x4a, 0x28, 0xb1, 0xb1, 0x8c, 0x26, 0x65, 0x85,
+	0x1b, 0xfd, 0x1d, 0xc8, 0xb9, 0x48, 0x60, 0xe6, 0xd9, 0xe1, 0xed, 0xb9, 0x46, 0xe9, 0xd3, 0x49,
+	0xf5, 0x46, 0x08, 0x1f, 0x73, 0x9e, 0xd4, 0x3c, 0x6a, 0xfa, 0x88, 0xf7, 0x6a, 0x07, 0x84, 0x5b,
+	0x59, 0x17, 0xb1, 0x63, 0xa1, 0xaa, 0x57, 0x20, 0xe9, 0x22, 0x26, 0x83, 0x4a, 0x35, 0x0a, 0x67,
+	0x93, 0x6a, 0xf6, 0x3b, 0x88, 0x3d, 0xf6, 0x7c, 0x8f, 0x5b, 0x42, 0xa0, 0xaf, 0x41, 0x82, 0x53,
+	0x15, 0x52, 0x82, 0x53, 0xfd, 0x3d, 0x58, 0x1d, 0xa1, 0xfe, 0x10, 0x17, 0x57, 0xe5, 0x1d, 0x77,
+	0x62, 0xef, 0x38, 0x9b, 0x54, 0xd3, 0x0f, 0x7c, 0x3a, 0x24, 0xdc, 0x0a, 0x2d, 0x44, 0x7e, 0x12,
+	0xc5, 0xf4, 0x96, 0xb6, 0x53, 0x50, 0x78, 0x15, 0x40, 0x1b, 0x15, 0x33, 0xf2, 0x40, 0x1b, 0x89,
+	0x5d, 0x50, 0xcc, 0x86, 0xbb, 0x40, 0xec, 0x58, 0x31, 0x17, 0xee, 0xd8, 0xde, 0x9a, 0x40, 0xe2,
+	0xcf, 0x1f, 0xdd, 0x4d, 0xb7, 0xc6, 0x8f, 0x10, 0x47, 0xc6, 0x9f, 0x92, 0x50, 0x78, 0x60, 0xdb,
+	0x98, 0xb1, 0xc7, 0x1e, 0xe3, 0xad, 0xb1, 0xfe, 0x5d, 0xc8, 0xda, 0x3d, 0xe4, 0x91, 0xb6, 0xe7,
+	0x48, 0x68, 0x72, 0x0d, 0x73, 0x59, 0x70, 0x99, 0x87, 0x42, 0xf9, 0xe0, 0xd1, 0x8b, 0x49, 0x35,
+	0x63, 0x87, 0x4b, 0x4b, 0x2d, 0x9c, 0x73, 0x8c, 0x13, 0xb1, 0x18, 0x27, 0x3f, 0x37, 0xc6, 0xa9,
+	0xe5, 0x18, 0xaf, 0x5e, 0xc4, 0x38, 0xfd, 0xda, 0x18, 0x67, 0x66, 0x30, 0x3e, 0x81, 0x2c, 0x92,
+	0x40, 0x61, 0x56, 0xcc, 0x6e, 0x25, 0x77, 0xf2, 0xf5, 0xcd, 0xda, 0xf9, 0x9c, 0xd6, 0x42, 0x10,
+	0x5b, 0xc3, 0x41, 0x1f, 0x37, 0xb6, 0x9e, 0x4d, 0xaa, 0x2b, 0x2f, 0x26, 0x55, 0x40, 0x11, 0xb2,
+	0xbf, 0xfb, 0x57, 0x15, 0xce, 0x71, 0xb6, 0x22, 0x57, 0x61, 0xe9, 0x72, 0x73, 0xa5, 0x83, 0xb9,
+	0xd2, 0xe5, 0xe3, 0x4a, 0xf7, 0xbf, 0x24, 0x14, 0x1e, 0x9d, 0x12, 0xe4, 0x7b, 0xf6, 0x3e, 0xc6,
+	0x5f, 0x4a, 0xe9, 0xde, 0x83, 0xbc, 0x28, 0x1d, 0xf7, 0x06, 0x6d, 0x1b, 0x0d, 0x5e, 0x5e, 0x3c,
+	0x51, 0xe8, 0x96, 0x37, 0x78, 0x88, 0x06, 0x53, 0xd3, 0x2e, 0xc6, 0xd2, 0x34, 0xf5, 0x2a, 0xa6,
+	0xfb, 0x18, 0x0b, 0x53, 0x55, 0xf8, 0xd5, 0xe5, 0x85, 0x4f, 0x5f, 0x2c, 0x7c, 0xe6, 0xb5, 0x0b,
+	0x9f, 0x8d, 0x29, 0x7c, 0xee, 0x8a, 0x0b, 0x0f, 0x73, 0x85, 0xcf, 0xcf, 0x15, 0xbe, 0x10, 0x57,
+	0x78, 0x03, 0xca, 0xcd, 0x31, 0xc7, 0x84, 0x79, 0x94, 0x7c, 0x6f, 0x20, 0xe9, 0xf8, 0x9c, 0x65,
+	0x15, 0xd7, 0xfd, 0x56, 0x83, 0x1b, 0x73, 0xec, 0x6b, 0x61, 0x36, 0xa0, 0x84, 0xc9, 0x14, 0x25,
+	0x81, 0x6a, 0x21, 0x3f, 0x8a, 0xb5, 0xfe, 0x16, 0xa4, 0xfa, 0xd4, 0x65, 0xc5, 0x84, 0x4c, 0x6f,
+	0x7d, 0x36, 0xbd, 0xc7, 0xd4, 0x6d, 0xa4, 0x44, 0x5a, 0x96, 0x54, 0xd1, 0x37, 0x20, 0x19, 0x60,
+	0x2e, 0x4b, 0x5f, 0xb0, 0xc4, 0x52, 0x2f, 0x41, 0x76, 0xe4, 0xb7, 0x71, 0x10, 0xd0, 0x40, 0x31,
+	0x5c, 0x66, 0xe4, 0x37, 0xc5, 0x56, 0x88, 0x44, 0xd1, 0x87, 0x0c, 0x3b, 0x61, 0xf9, 0xac, 0x8c,
+	0x8b, 0xd8, 0x09, 0xc3, 0x8e, 0x0a, 0xf3, 0x67, 0x1a, 0xac, 0x1f, 0x32, 0xf7, 0x64, 0xe0, 0x20,
+	0x8e, 0x8f, 0x51, 0x80, 0x7c, 0x26, 0xf8, 0x01, 0x0d, 0x79, 0x8f, 0x06, 0x1e, 0x3f, 0x55, 0x7d,
+	0x5c, 0xfc, 0xcb, 0x47, 0x77, 0xaf, 0xab, 0x27, 0xec, 0x81, 0xe3, 0x04, 0x98, 0xb1, 0xef, 0xf3,
+	0xc0, 0x23, 0xae, 0x75, 0xae, 0xaa, 0xbf, 0x0d, 0xe9, 0x81, 0xf4, 0x20, 0x7b, 0x36, 0x5f, 0xd7,
+	0x67, 0xd3, 0x08, 0x7d, 0xab, 0x4c, 0x94, 0xde, 0xde, 0xda, 0xd3, 0xff, 0xfe, 0xe1, 0x1b, 0xe7,
+	0x1e, 0x8c, 0x12, 0x6c, 0x2e, 0x04, 0x33, 0x45, 0xcd, 0xf8, 0xbb, 0x06, 0x5f, 0x39, 0x64, 0xee,
+	0xc3, 0x00, 0x23, 0x8e, 0xf7, 0x87, 0xa4, 0x45, 0x9f, 0x60, 0xa2, 0x9f, 0x00, 0x88, 0xf7, 0xa5,
+	0x8d, 0x03, 0xbb, 0xfe, 0xb6, 0x8a, 0xf5, 0x9d, 0x67, 0x93, 0xaa, 0xf6, 0xe9, 0xa4, 0x5a, 0x73,
+	0x3d, 0xde, 0x1b, 0x76, 0x6a, 0x36, 0xf5, 0xcd, 0x23, 0xaf, 0xe3, 0x05, 0x43, 0x39, 0x6f, 0x26,
+	0x91, 0x6b, 0x73, 0x54, 0x37, 0x45, 0x78, 0xcd, 0x83, 0xe3, 0x7b, 0xf7, 0x44, 0x4a, 0x56, 0x4e,
+	0x78, 0x6a, 0x0a, 0x47, 0xfa, 0x36, 0xac, 0x4b, 0xb7, 0x1d, 0x44, 0x9e, 0xb4, 0x1d, 0x4c, 0xa8,
+	0x1f, 0xbe, 0x45, 0xd6, 0x35, 0x71, 0xdc, 0x40, 0xe4, 0xc9, 0x23, 0x71, 0xa8, 0x7f, 0x15, 0xd2,
+	0x0c, 0x13, 0x07, 0x07, 0xe1, 0x24, 0x5a, 0x6a, 0xa7, 0xbf, 0x05, 0x1b, 0xcc, 0x46, 0x7d, 0x8f,
+	0xb8, 0x6d, 0x3c, 0x1e, 0x50, 0x82, 0x09, 0x97, 0x95, 0xb9, 0x66, 0xad, 0xab, 0xf3, 0xa6, 0x3a,
+	0x36, 0x3a, 0x50, 0xba, 0x90, 0x56, 0xd4, 0x2a, 0x4d, 0xd8, 0xe8, 0x0e, 0x09, 0x17, 0x67, 0x6d,
+	0x1f, 0x0d, 0x06, 0x1e, 0x71, 0xa3, 0xc7, 0x7b, 0x06, 0xdb, 0xa9, 0x9d, 0x42, 0x77, 0x7d, 0x6a,
+	0x73, 0x18, 0x9a, 0x18, 0xff, 0xd4, 0xe0, 0x0d, 0x71, 0x09, 0x25, 0x23, 0x1c, 0xf0, 0x87, 0xd4,
+	0x23, 0x2d, 0xda, 0x1c, 0xf9, 0xfa, 0xfb, 0x90, 0xe7, 0xb4, 0x8d, 0x79, 0xaf, 0x8d, 0x1c, 0x27,
+	0x98, 0x81, 0x6f, 0xe5, 0x75, 0xe0, 0xe3, 0xb4, 0xc9, 0x7b, 0x62, 0x39, 0x03, 0x4b, 0x62, 0x0e,
+	0x96, 0x63, 0xc8, 0x49, 0x44, 0xc5, 0x47, 0x92, 0x44, 0x2c, 0x5f, 0x2f, 0xd5, 0x54, 0x57, 0x89,
+	0xaf, 0xa8, 0x9a, 0xfa, 0x8a, 0xaa, 0x89, 0x10, 0x1b, 0x45, 0x11, 0xc8, 0x67, 0x93, 0xea, 0xc6,
+	0x29, 0xf2, 0xfb, 0x7b, 0x46, 0x64, 0x69, 0x58, 0x59, 0xb1, 0x16, 0x3a, 0xc6, 0x6d, 0xb8, 0x79,
+	0x49, 0x62, 0x51, 0xd3, 0xfc, 0x6d, 0x2e, 0xf1, 0xe6, 0xc8, 0x6f, 0x51, 0xa1, 0x34, 0x13, 0xa0,
+	0x36, 0x17, 0xe0, 0x09, 0x80, 0xec, 0xa4, 0x10, 0x8f, 0xc4, 0x17, 0xc3, 0x43, 0x7a, 0x92, 0x78,
+	0xdc, 0x83, 0x34, 0x92, 0x2c, 0xa7, 0x08, 0xfb, 0xb6, 0x72, 0x19, 0xc3, 0xbc, 0x4a, 0x59, 0xdf,
+	0x84, 0x0c, 0xa7, 0x61, 0x28, 0xe1, 0x58, 0xa7, 0x39, 0x15, 0xfe, 0x0c, 0x0a, 0x37, 0x2f, 0xc9,
+	0x2a, 0xea, 0x9a, 0x39, 0x98, 0xb5, 0xab, 0x80, 0xf9, 0xf7, 0x1a, 0x94, 0x0f, 0x99, 0x6b, 0x61,
+	0x9b, 0x12, 0xdb, 0xeb, 0x47, 0x8d, 0xda, 0x64, 0x76, 0x40, 0x7f, 0xfc, 0x65, 0xc3, 0x59, 0x85,
+	0xbc, 0xc7, 0xda, 0x01, 0xee, 0x20, 0x26, 0x06, 0x42, 0x60, 0x9a, 0xb5, 0xc0, 0x63, 0x96, 0x3a,
+	0x31, 0x7e, 0xad, 0x81, 0x11, 0x1f, 0x6e, 0x84, 0xd3, 0x7d, 0xc8, 0x75, 0x87, 0xa4, 0x2d, 0x47,
+	0xe5, 0x15, 0xc6, 0x2a, 0xdb, 0x55, 0x7b, 0xfd, 0x5d, 0x48, 0x63, 0xe9, 0x4a, 0x11, 0x5d, 0xf9,
+	0x32, 0xab, 0xf0, 0xb2, 0x29, 0xe1, 0x85, 0xfa, 0xc6, 0xd3, 0x90, 0x6e, 0xa3, 0x71, 0x3f, 0xda,
+	0x6f, 0xe9, 0x1f, 0x40, 0x7e, 0xca, 0x61, 0xf7, 0xeb, 0xbb, 0x5f, 0x70, 0x0a, 0x41, 0x91, 0xd8,
+	0xfd, 0xfa, 0x6e, 0xdc, 0x18, 0x1a, 0x1f, 0xc2, 0xe6, 0x42, 0x0c, 0x11, 0x24, 0xdf, 0x82, 0xb5,
+	0xee, 0x90, 0x90, 0x2e, 0x5f, 0xa0, 0x1b, 0x7d, 0x21, 0xc3, 0xa3, 0xfd, 0x96, 0xca, 0xec, 0x5a,
+	0xa8, 0x3f, 0xa5, 0x9a, 0x4f, 0x34, 0xd0, 0x17, 0x7a, 0x53, 0xe4, 0x18, 0xd7, 0x21, 0x1f, 0x40,
+	0x3e, 0x4c, 0xfb, 0x2a, 0x5a, 0x04, 0x42, 0x57, 0x62, 0xad, 0xbf, 0x0b, 0xd9, 0x90, 0x36, 0x3d,
+	0xe7, 0xd5, 0x86, 0x2e, 0x23, 0xd5, 0x0f, 0x9c, 0xf8, 0xa9, 0x3b, 0x82, 0xf2, 0xc5, 0xcc, 0x22,
+	0xe4, 0x4a, 0x90, 0xb5, 0xfb, 0x88, 0xb1, 0xe8, 0xdb, 0xcf, 0xca, 0xc8, 0xfd, 0x81, 0xa3, 0xdf,
+	0x80, 0xb4, 0x40, 0xd4, 0x73, 0x54, 0x1d, 0x56, 0x49, 0x97, 0x1f, 0x38, 0xc6, 0x1f, 0xe7, 0xa0,
+	0x3a, 0xda, 0x6f, 0x85, 0xa4, 0x1c, 0x07, 0xd5, 0xec, 0x05, 0x89, 0xb8, 0x0b, 0x92, 0x33, 0x17,
+	0x2c, 0xd2, 0x7b, 0xea, 0x8a, 0xe8, 0xdd, 0xb8, 0x05, 0xe5, 0x8b, 0x71, 0x4f, 0x81, 0xa8, 0xff,
+	0x23, 0x0d, 0xc9, 0x43, 0xe6, 0xea, 0x04, 0x60, 0xe6, 0xa7, 0x67, 0x69, 0xb6, 0x81, 0xe6, 0xbe,
+	0x8b, 0xca, 0x5f, 0x8b, 0x15, 0x45, 0x3c, 0x6e, 0x3c, 0xfd, 0xeb, 0x7f, 0x7e, 0x95, 0xb8, 0x65,
+	0x94, 0xa7, 0xe1, 0x4d, 0x7f, 0x3b, 0x2b, 0xd5, 0x36, 0x1f, 0xeb, 0xc7, 0x50, 0x98, 0xfb, 0x8a,
+	0xb9, 0xb9, 0xe0, 0x76, 0x56, 0x58, 0xbe, 0xb3, 0x44, 0x18, 0x95, 0xf4, 0x7d, 0x58, 0x5b, 0xf8,
+	0xdc, 0xb8, 0xbd, 0x60, 0x36, 0x2f, 0x2e, 0x7f, 0x7d, 0xa9, 0x38, 0xf2, 0xfb, 0x43, 0xd8, 0xb8,
+	0xf0, 0x14, 0x57, 0x17, 0x4d, 0x17, 0x14, 0xca, 0x6f, 0xbe, 0x44, 0xe1, 0x12, 0xef, 0xe7, 0xef,
+	0x5d, 0x8c, 0xf7, 0x48, 0xa1, 0xfc, 0xe6, 0x4b, 0x14, 0x22, 0xef, 0x3f, 0x82, 0xcd, 0xb8, 0x57,
+	0x60, 0x7b, 0xc1, 0x47, 0x8c, 0x5e, 0xb9, 0xf6, 0x6a, 0x7a, 0x33, 0xcf, 0x59, 0x61, 0x8e, 0x2f,
+	0x6f, 0xc6, 0xa1, 0x7c, 0xb4, 0xdf, 0x2a, 0xdf, 0x59, 0x22, 0x8c, 0x3c, 0xfe, 0x00, 0xd6, 0x17,
+	0x09, 0xaa, 0xb2, 0x04, 0x00, 0xe1, 0x77, 0x7b, 0xb9, 0xfc, 0x12, 0xd7, 0xd1, 0x40, 0xc7, 0xb8,
+	0x9e, 0xca, 0xcb, 0xdb, 0xcb, 0xe5, 0x53, 0xd7, 0x8d, 0x6f, 0x3f, 0x3b, 0xab, 0x68, 0x1f, 0x9f,
+	0x55, 0xb4, 0x7f, 0x9f, 0x55, 0xb4, 0x5f, 0x3c, 0xaf, 0xac, 0x7c, 0xfc, 0xbc, 0xb2, 0xf2, 0xc9,
+	0xf3, 0xca, 0xca, 0x87, 0xdb, 0x2f, 0x9d, 0xe5, 0xb1, 0x98, 0x98, 0x4e, 0x5a, 0xfe, 0xd3, 0xf3,
+	0xcd, 0xff, 0x0f, 0x00, 0xc1, 0x6e, 0xd7, 0x58, 0xf4, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// EthereumTx defines a method submitting Ethereum transactions.
+	EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// UpdateParams defined a governance operation for updating the x/evm module
+	// parameters. The authority is hard-coded to the x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateFunToken: Create a "FunToken" mapping. Either the ERC20 contract
+	// address can be given to create the mapping to a Bank Coin, or the
+	// denomination for a Bank Coin can be given to create the mapping to an
+	// ERC20.
+	CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error)
+	// ConvertCoinToEvm: Sends a coin with a valid "FunToken" mapping to the
+	// given recipient address ("to_eth_addr") in the corresponding ERC20
+	// representation.
+	ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+	// the EVM address of the sender to the given recipient ("to_addr") in the
+	// corresponding Bank Coin representation. It is the Cosmos equivalent of
+	// "sendToBank" in the FunToken precompile.
+	ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error)
+	// ReconcileFunTokenEscrow: Compares the ERC20 tokens escrowed by the EVM
+	// module for a "FunToken" created from an ERC20 with the supply of its Bank
+	// Coin and flags the mapping as rebasing if the escrow drifted. Only callable
+	// by the x/sudo root or sudo contracts.
+	ReconcileFunTokenEscrow(ctx context.Context, in *MsgReconcileFunTokenEscrow, opts ...grpc.CallOption) (*MsgReconcileFunTokenEscrowResponse, error)
+	// CreateFunNFT: Create a "FunNFT" mapping between an ERC-721 collection and
+	// a new class of the NFT Module ("x/nft"). Existing "x/nft" classes can't
+	// be mapped to an ERC-721 collection.
+	CreateFunNFT(ctx context.Context, in *MsgCreateFunNFT, opts ...grpc.CallOption) (*MsgCreateFunNFTResponse, error)
+	// ConvertEvmToNFT: Sends an ERC-721 token with a valid "FunNFT" mapping from
+	// the EVM address of the sender to the given recipient ("to_addr") as an
+	// "x/nft" token. It is the Cosmos equivalent of "sendNftToBank" in the
+	// FunToken precompile.
+	ConvertEvmToNFT(ctx context.Context, in *MsgConvertEvmToNFT, opts ...grpc.CallOption) (*MsgConvertEvmToNFTResponse, error)
+	// ConvertNFTToEvm: Sends an "x/nft" token with a valid "FunNFT" mapping to
+	// the given recipient address ("to_eth_addr") as the corresponding ERC-721
+	// token.
+	ConvertNFTToEvm(ctx context.Context, in *MsgConvertNFTToEvm, opts ...grpc.CallOption) (*MsgConvertNFTToEvmResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) EthereumTx(ctx context.Context, in *MsgEthereumTx, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error) {
+	out := new(MsgEthereumTxResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/EthereumTx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GasPrice != nil {
-		{
-			size := m.GasPrice.Size()
-			i -= size
-			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *msgClient) CreateFunToken(ctx context.Context, in *MsgCreateFunToken, opts ...grpc.CallOption) (*MsgCreateFunTokenResponse, error) {
+	out := new(MsgCreateFunTokenResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/CreateFunToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ChainID != nil {
-		{
-			size := m.ChainID.Size()
-			i -= size
-			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ConvertCoinToEvm(ctx context.Context, in *MsgConvertCoinToEvm, opts ...grpc.CallOption) (*MsgConvertCoinToEvmResponse, error) {
+	out := new(MsgConvertCoinToEvmResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertCoinToEvm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DynamicFeeTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConvertEvmToCoin(ctx context.Context, in *MsgConvertEvmToCoin, opts ...grpc.CallOption) (*MsgConvertEvmToCoinResponse, error) {
+	out := new(MsgConvertEvmToCoinResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertEvmToCoin", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DynamicFeeTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReconcileFunTokenEscrow(ctx context.Context, in *MsgReconcileFunTokenEscrow, opts ...grpc.CallOption) (*MsgReconcileFunTokenEscrowResponse, error) {
+	out := new(MsgReconcileFunTokenEscrowResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ReconcileFunTokenEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DynamicFeeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x62
+func (c *msgClient) CreateFunNFT(ctx context.Context, in *MsgCreateFunNFT, opts ...grpc.CallOption) (*MsgCreateFunNFTResponse, error) {
+	out := new(MsgCreateFunNFTResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/CreateFunNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0x5a
+	return out, nil
+}
+
+func (c *msgClient) ConvertEvmToNFT(ctx context.Context, in *MsgConvertEvmToNFT, opts ...grpc.CallOption) (*MsgConvertEvmToNFTResponse, error) {
+	out := new(MsgConvertEvmToNFTResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertEvmToNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.V) > 0 {
-		i -= len(m.V)
-		copy(dAtA[i:], m.V)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
-		i--
-		dAtA[i] = 0x52
+	return out, nil
+}
+
+func (c *msgClient) ConvertNFTToEvm(ctx context.Context, in *MsgConvertNFTToEvm, opts ...grpc.CallOption) (*MsgConvertNFTToEvmResponse, error) {
+	out := new(MsgConvertNFTToEvmResponse)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Msg/ConvertNFTToEvm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Accesses) > 0 {
-		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// EthereumTx defines a method submitting Ethereum transactions.
+	EthereumTx(context.Context, *MsgEthereumTx) (*MsgEthereumTxResponse, error)
+	// UpdateParams defined a governance operation for updating the x/evm module
+	// parameters. The authority is hard-coded to the x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateFunToken: Create a "FunToken" mapping. Either the ERC20 contract
+	// address can be given to create the mapping to a Bank Coin, or the
+	// denomination for a Bank Coin can be given to create the mapping to an
+	// ERC20.
+	CreateFunToken(context.Context, *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error)
+	// ConvertCoinToEvm: Sends a coin with a valid "FunToken" mapping to the
+	// given recipient address ("to_eth_addr") in the corresponding ERC20
+	// representation.
+	ConvertCoinToEvm(context.Context, *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error)
+	// ConvertEvmToCoin: Sends ERC20 tokens with a valid "FunToken" mapping from
+	// the EVM address of the sender to the given recipient ("to_addr") in the
+	// corresponding Bank Coin representation. It is the Cosmos equivalent of
+	// "sendToBank" in the FunToken precompile.
+	ConvertEvmToCoin(context.Context, *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error)
+	// ReconcileFunTokenEscrow: Compares the ERC20 tokens escrowed by the EVM
+	// module for a "FunToken" created from an ERC20 with the supply of its Bank
+	// Coin and flags the mapping as rebasing if the escrow drifted. Only callable
+	// by the x/sudo root or sudo contracts.
+	ReconcileFunTokenEscrow(context.Context, *MsgReconcileFunTokenEscrow) (*MsgReconcileFunTokenEscrowResponse, error)
+	// CreateFunNFT: Create a "FunNFT" mapping between an ERC-721 collection and
+	// a new class of the NFT Module ("x/nft"). Existing "x/nft" classes can't
+	// be mapped to an ERC-721 collection.
+	CreateFunNFT(context.Context, *MsgCreateFunNFT) (*MsgCreateFunNFTResponse, error)
+	// ConvertEvmToNFT: Sends an ERC-721 token with a valid "FunNFT" mapping from
+	// the EVM address of the sender to the given recipient ("to_addr") as an
+	// "x/nft" token. It is the Cosmos equivalent of "sendNftToBank" in the
+	// FunToken precompile.
+	ConvertEvmToNFT(context.Context, *MsgConvertEvmToNFT) (*MsgConvertEvmToNFTResponse, error)
+	// ConvertNFTToEvm: Sends an "x/nft" token with a valid "FunNFT" mapping to
+	// the given recipient address ("to_eth_addr") as the corresponding ERC-721
+	// token.
+	ConvertNFTToEvm(context.Context, *MsgConvertNFTToEvm) (*MsgConvertNFTToEvmResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumTx) (*MsgEthereumTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumTx not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateFunToken(ctx context.Context, req *MsgCreateFunToken) (*MsgCreateFunTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunToken not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCoinToEvm(ctx context.Context, req *MsgConvertCoinToEvm) (*MsgConvertCoinToEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoinToEvm not implemented")
+}
+func (*UnimplementedMsgServer) ConvertEvmToCoin(ctx context.Context, req *MsgConvertEvmToCoin) (*MsgConvertEvmToCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToCoin not implemented")
+}
+func (*UnimplementedMsgServer) ReconcileFunTokenEscrow(ctx context.Context, req *MsgReconcileFunTokenEscrow) (*MsgReconcileFunTokenEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileFunTokenEscrow not implemented")
+}
+func (*UnimplementedMsgServer) CreateFunNFT(ctx context.Context, req *MsgCreateFunNFT) (*MsgCreateFunNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunNFT not implemented")
+}
+func (*UnimplementedMsgServer) ConvertEvmToNFT(ctx context.Context, req *MsgConvertEvmToNFT) (*MsgConvertEvmToNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertEvmToNFT not implemented")
+}
+func (*UnimplementedMsgServer) ConvertNFTToEvm(ctx context.Context, req *MsgConvertNFTToEvm) (*MsgConvertNFTToEvmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertNFTToEvm not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_EthereumTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumTx)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Amount != nil {
-		{
-			size := m.Amount.Size()
-			i -= size
-			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).EthereumTx(ctx, in)
 	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/EthereumTx",
 	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EthereumTx(ctx, req.(*MsgEthereumTx))
 	}
-	if m.GasFeeCap != nil {
-		{
-			size := m.GasFeeCap.Size()
-			i -= size
-			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GasTipCap != nil {
-		{
-			size := m.GasTipCap.Size()
-			i -= size
-			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/UpdateParams",
 	}
-	if m.ChainID != nil {
-		{
-			size := m.ChainID.Size()
-			i -= size
-			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateFunToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFunToken)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFunToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/CreateFunToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFunToken(ctx, req.(*MsgCreateFunToken))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ConvertCoinToEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoinToEvm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoinToEvm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertCoinToEvm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoinToEvm(ctx, req.(*MsgConvertCoinToEvm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ConvertEvmToCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertEvmToCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertEvmToCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertEvmToCoin(ctx, req.(*MsgConvertEvmToCoin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ReconcileFunTokenEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReconcileFunTokenEscrow)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ReconcileFunTokenEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ReconcileFunTokenEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReconcileFunTokenEscrow(ctx, req.(*MsgReconcileFunTokenEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CreateFunNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFunNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFunNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/CreateFunNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFunNFT(ctx, req.(*MsgCreateFunNFT))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasUsed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
-		i--
-		dAtA[i] = 0x28
+func _Msg_ConvertEvmToNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertEvmToNFT)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
-		i--
-		dAtA[i] = 0x22
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertEvmToNFT(ctx, in)
 	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertEvmToNFT",
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertEvmToNFT(ctx, req.(*MsgConvertEvmToNFT))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ConvertNFTToEvm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertNFTToEvm)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertNFTToEvm(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Msg/ConvertNFTToEvm",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertNFTToEvm(ctx, req.(*MsgConvertNFTToEvm))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eth.evm.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EthereumTx",
+			Handler:    _Msg_EthereumTx_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateFunToken",
+			Handler:    _Msg_CreateFunToken_Handler,
+		},
+		{
+			MethodName: "ConvertCoinToEvm",
+			Handler:    _Msg_ConvertCoinToEvm_Handler,
+		},
+		{
+			MethodName: "ConvertEvmToCoin",
+			Handler:    _Msg_ConvertEvmToCoin_Handler,
+		},
+		{
+			MethodName: "ReconcileFunTokenEscrow",
+			Handler:    _Msg_ReconcileFunTokenEscrow_Handler,
+		},
+		{
+			MethodName: "CreateFunNFT",
+			Handler:    _Msg_CreateFunNFT_Handler,
+		},
+		{
+			MethodName: "ConvertEvmToNFT",
+			Handler:    _Msg_ConvertEvmToNFT_Handler,
+		},
+		{
+			MethodName: "ConvertNFTToEvm",
+			Handler:    _Msg_ConvertNFTToEvm_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eth/evm/v1/tx.proto",
 }
 
-func (m *MsgCreateFunToken) Marshal() (dAtA []byte, err error) {
+func (m *MsgEthereumTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1532,37 +1786,43 @@ func (m *MsgCreateFunToken) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateFunToken) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEthereumTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateFunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.FromBankDenom) > 0 {
-		i -= len(m.FromBankDenom)
-		copy(dAtA[i:], m.FromBankDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromBankDenom)))
+	if m.Size_ != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Size_))))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x11
 	}
-	if m.FromErc20 != nil {
+	if m.Data != nil {
 		{
-			size := m.FromErc20.Size()
-			i -= size
-			if _, err := m.FromErc20.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
@@ -1571,7 +1831,7 @@ func (m *MsgCreateFunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateFunTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *LegacyTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1581,30 +1841,89 @@ func (m *MsgCreateFunTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateFunTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LegacyTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateFunTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LegacyTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FuntokenMapping.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasPrice != nil {
+		{
+			size := m.GasPrice.Size()
+			i -= size
+			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertCoinToEvm) Marshal() (dAtA []byte, err error) {
+func (m *AccessListTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1614,47 +1933,115 @@ func (m *MsgConvertCoinToEvm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgConvertCoinToEvm) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccessListTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConvertCoinToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccessListTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x52
 	}
-	{
-		size := m.ToEthAddr.Size()
-		i -= size
-		if _, err := m.ToEthAddr.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasPrice != nil {
+		{
+			size := m.GasPrice.Size()
+			i -= size
+			if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertCoinToEvmResponse) Marshal() (dAtA []byte, err error) {
+func (m *DynamicFeeTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1664,367 +2051,2073 @@ func (m *MsgConvertCoinToEvmResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgConvertCoinToEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DynamicFeeTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConvertCoinToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DynamicFeeTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.Size_ != 0 {
-		n += 9
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	return n
-}
-
-func (m *LegacyTx) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasFeeCap != nil {
+		{
+			size := m.GasFeeCap.Size()
+			i -= size
+			if _, err := m.GasFeeCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasTipCap != nil {
+		{
+			size := m.GasTipCap.Size()
+			i -= size
+			if _, err := m.GasTipCap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.V)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ChainID != nil {
+		{
+			size := m.ChainID.Size()
+			i -= size
+			if _, err := m.ChainID.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AccessListTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != nil {
-		l = m.ChainID.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Accesses) > 0 {
-		for _, e := range m.Accesses {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.V)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DynamicFeeTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != nil {
-		l = m.ChainID.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	if m.GasTipCap != nil {
-		l = m.GasTipCap.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasFeeCap != nil {
-		l = m.GasFeeCap.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Amount != nil {
-		l = m.Amount.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Accesses) > 0 {
-		for _, e := range m.Accesses {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.V)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.R)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.S)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExtensionOptionsEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgEthereumTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Ret)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasUsed != 0 {
-		n += 1 + sovTx(uint64(m.GasUsed))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateFunToken) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateFunToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFunToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFunToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FromErc20 != nil {
-		l = m.FromErc20.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.ScalingExponent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScalingExponent))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.FromBankDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FromBankDenom) > 0 {
+		i -= len(m.FromBankDenom)
+		copy(dAtA[i:], m.FromBankDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromBankDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.FromErc20 != nil {
+		{
+			size := m.FromErc20.Size()
+			i -= size
+			if _, err := m.FromErc20.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateFunTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateFunTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFunTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFunTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.FuntokenMapping.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	{
+		size, err := m.FuntokenMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertCoinToEvm) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConvertCoinToEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinToEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ToEthAddr.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.BankCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.ToEthAddr.Size()
+		i -= size
+		if _, err := m.ToEthAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertCoinToEvmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConvertCoinToEvmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinToEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgConvertEvmToCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgConvertEvmToCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgConvertEvmToCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddr) > 0 {
+		i -= len(m.ToAddr)
+		copy(dAtA[i:], m.ToAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc20Addr.Size()
+		i -= size
+		if _, err := m.Erc20Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertEvmToCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BankCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconcileFunTokenEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileFunTokenEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileFunTokenEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsRebasing {
+		i--
+		if m.IsRebasing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Erc20Addr.Size()
+		i -= size
+		if _, err := m.Erc20Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconcileFunTokenEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileFunTokenEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileFunTokenEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FunToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFunNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFunNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFunNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.FromErc721.Size()
+		i -= size
+		if _, err := m.FromErc721.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFunNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFunNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFunNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FunnftMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertEvmToNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddr) > 0 {
+		i -= len(m.ToAddr)
+		copy(dAtA[i:], m.ToAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.TokenId.Size()
+		i -= size
+		if _, err := m.TokenId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc721Addr.Size()
+		i -= size
+		if _, err := m.Erc721Addr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertEvmToNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertEvmToNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertEvmToNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertNFTToEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertNFTToEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertNFTToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ToEthAddr.Size()
+		i -= size
+		if _, err := m.ToEthAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertNFTToEvmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertNFTToEvmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertNFTToEvmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 9
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LegacyTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AccessListTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicFeeTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != nil {
+		l = m.ChainID.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasTipCap != nil {
+		l = m.GasTipCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasFeeCap != nil {
+		l = m.GasFeeCap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEthereumTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateFunToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromErc20 != nil {
+		l = m.FromErc20.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromBankDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScalingExponent != 0 {
+		n += 1 + sovTx(uint64(m.ScalingExponent))
+	}
+	return n
+}
+
+func (m *MsgCreateFunTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FuntokenMapping.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertCoinToEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ToEthAddr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BankCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertCoinToEvmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertEvmToCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc20Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ToAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertEvmToCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BankCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReconcileFunTokenEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc20Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.IsRebasing {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgReconcileFunTokenEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FunToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Escrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateFunNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromErc721.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateFunNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FunnftMapping.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertEvmToNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Erc721Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ToAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertEvmToNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertNFTToEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ToEthAddr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertNFTToEvmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Size_ = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if iNdEx >= l {
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v cosmossdk_io_math.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -2050,29 +4143,50 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Size_ = float64(math.Float64frombits(v))
-		case 3:
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2082,29 +4196,31 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2114,23 +4230,25 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2153,7 +4271,7 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2176,13 +4294,49 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -2201,9 +4355,9 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2232,12 +4386,48 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -2256,7 +4446,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -2288,7 +4478,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2324,7 +4514,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -2358,7 +4548,41 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -2392,7 +4616,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -2426,7 +4650,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -2481,7 +4705,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2504,15 +4728,65 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2540,17 +4814,47 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
-			m.Nonce = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2560,14 +4864,29 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2595,17 +4914,13 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.GasLimit = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2615,14 +4930,64 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2650,13 +5015,13 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2666,67 +5031,130 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFunToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFunToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromErc20", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2736,31 +5164,33 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_NibiruChain_nibiru_v2_eth.EIP55Addr
+			m.FromErc20 = &v
+			if err := m.FromErc20.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBankDenom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2770,31 +5200,29 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromBankDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2804,31 +5232,29 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
-			if m.R == nil {
-				m.R = []byte{}
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingExponent", wireType)
 			}
-			var byteLen int
+			m.ScalingExponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2838,26 +5264,11 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ScalingExponent |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2879,7 +5290,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2902,17 +5313,17 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateFunTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateFunTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FuntokenMapping", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2922,50 +5333,78 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FuntokenMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinToEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinToEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2993,15 +5432,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasTipCap = &v
-			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ToEthAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3029,36 +5466,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.GasFeeCap = &v
-			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
-			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3068,99 +5482,130 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinToEvmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinToEvmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertEvmToCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertEvmToCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3170,31 +5615,29 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3204,31 +5647,31 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
+			if err := m.Erc20Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3238,31 +5681,31 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
-			if m.R == nil {
-				m.R = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3272,25 +5715,23 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
-			}
+			m.ToAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3313,7 +5754,7 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertEvmToCoinResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3336,12 +5777,45 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertEvmToCoinResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertEvmToCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3363,7 +5837,7 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReconcileFunTokenEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3386,15 +5860,15 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReconcileFunTokenEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReconcileFunTokenEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3422,13 +5896,13 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3438,31 +5912,101 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Erc20Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRebasing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRebasing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReconcileFunTokenEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReconcileFunTokenEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReconcileFunTokenEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunToken", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3472,31 +6016,30 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
+			if err := m.FunToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3506,43 +6049,25 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3564,7 +6089,7 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateFunNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3587,15 +6112,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateFunNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateFunNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromErc721", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3623,13 +6148,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.FromErc721.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3639,24 +6166,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3679,7 +6205,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateFunNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3702,12 +6228,45 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateFunNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateFunNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunnftMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FunnftMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3729,7 +6288,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertEvmToNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3752,15 +6311,15 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFunToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertEvmToNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFunToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertEvmToNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromErc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3788,15 +6347,11 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_NibiruChain_nibiru_v2_eth.EIP55Addr
-			m.FromErc20 = &v
-			if err := m.FromErc20.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromBankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc721Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3824,11 +6379,13 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromBankDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Erc721Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3856,7 +6413,41 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3879,7 +6470,7 @@ func (m *MsgCreateFunToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertEvmToNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3902,17 +6493,17 @@ func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFunTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertEvmToNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFunTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertEvmToNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuntokenMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3922,24 +6513,55 @@ func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FuntokenMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3962,7 +6584,7 @@ func (m *MsgCreateFunTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinToEvm) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertNFTToEvm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3985,15 +6607,15 @@ func (m *MsgConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinToEvm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertNFTToEvm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertNFTToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4021,13 +6643,11 @@ func (m *MsgConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ToEthAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4055,13 +6675,13 @@ func (m *MsgConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4071,22 +6691,55 @@ func (m *MsgConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BankCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEthAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToEthAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4111,7 +6764,7 @@ func (m *MsgConvertCoinToEvm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinToEvmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertNFTToEvmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4134,10 +6787,10 @@ func (m *MsgConvertCoinToEvmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinToEvmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertNFTToEvmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertNFTToEvmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,12 +5,15 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/evm/evmtest"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -53,3 +56,106 @@ func (suite *Suite) SetupTest() {
 func TestTxDataTestSuite(t *testing.T) {
 	suite.Run(t, new(Suite))
 }
+
+func TestGetTxPriority(t *testing.T) {
+	baseFeeWei := evm.BASE_FEE_WEI
+	priorityReduction := evm.DefaultPriorityReduction.BigInt()
+	for _, tc := range []struct {
+		name     string
+		txData   evm.TxData
+		wantTip  *big.Int
+		priority int64
+	}{
+		{
+			name: "dynamic fee tx: tip below the fee cap",
+			txData: &evm.DynamicFeeTx{
+				GasTipCap: sdkInt(new(big.Int).Mul(big.NewInt(5), priorityReduction)),
+				GasFeeCap: sdkInt(new(big.Int).Mul(big.NewInt(2), baseFeeWei)),
+			},
+			wantTip:  new(big.Int).Mul(big.NewInt(5), priorityReduction),
+			priority: 5,
+		},
+		{
+			name: "dynamic fee tx: tip capped by the fee cap",
+			txData: &evm.DynamicFeeTx{
+				GasTipCap: sdkInt(baseFeeWei),
+				GasFeeCap: sdkInt(new(big.Int).Add(baseFeeWei, priorityReduction)),
+			},
+			wantTip:  priorityReduction,
+			priority: 1,
+		},
+		{
+			name: "dynamic fee tx: fee cap below the base fee",
+			txData: &evm.DynamicFeeTx{
+				GasTipCap: sdkInt(baseFeeWei),
+				GasFeeCap: sdkInt(big.NewInt(1)),
+			},
+			wantTip:  big.NewInt(0),
+			priority: 0,
+		},
+		{
+			name: "legacy tx: gas price above the base fee",
+			txData: &evm.LegacyTx{
+				GasPrice: sdkInt(new(big.Int).Mul(big.NewInt(3), baseFeeWei)),
+			},
+			wantTip:  new(big.Int).Mul(big.NewInt(2), baseFeeWei),
+			priority: new(big.Int).Quo(new(big.Int).Mul(big.NewInt(2), baseFeeWei), priorityReduction).Int64(),
+		},
+		{
+			name:     "legacy tx: gas price below the base fee",
+			txData:   &evm.LegacyTx{GasPrice: sdkInt(big.NewInt(1))},
+			wantTip:  big.NewInt(0),
+			priority: 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.wantTip, evm.EffectiveGasTipWei(tc.txData, baseFeeWei))
+			require.Equal(t, tc.priority, evm.GetTxPriority(tc.txData, baseFeeWei))
+		})
+	}
+}
+
+func TestGetCosmosTxPriority(t *testing.T) {
+	baseFeeWei := evm.BASE_FEE_WEI
+	for _, tc := range []struct {
+		name     string
+		fee      sdk.Coins
+		gasLimit uint64
+		priority int64
+	}{
+		{
+			name:     "gas price of 3 unibi: tip of 2 unibi",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(evm.EVMBankDenom, 300)),
+			gasLimit: 100,
+			// 2 unibi = 2 * 10^12 wei, reduced by 10^6
+			priority: 2_000_000,
+		},
+		{
+			name:     "gas price below the base fee",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(evm.EVMBankDenom, 99)),
+			gasLimit: 100,
+			priority: 0,
+		},
+		{
+			name:     "fee in another denom",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+			gasLimit: 100,
+			priority: 0,
+		},
+		{
+			name:     "zero gas limit",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(evm.EVMBankDenom, 300)),
+			gasLimit: 0,
+			priority: 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.priority, evm.GetCosmosTxPriority(tc.fee, tc.gasLimit, baseFeeWei))
+		})
+	}
+}
+
+func sdkInt(i *big.Int) *sdkmath.Int {
+	sdkI := sdkmath.NewIntFromBigInt(i)
+	return &sdkI
+}
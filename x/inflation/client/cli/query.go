@@ -3,6 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCirculatingSupply(),
 		GetInflationRate(),
 		GetParams(),
+		GetInflationSchedule(),
 	)
 
 	return cmd
@@ -211,3 +215,115 @@ func GetParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetInflationSchedule implements a command to project the inflation schedule
+// for the current parameters or candidate parameters given by flags.
+func GetInflationSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Project the amounts minted and distributed in each remaining inflation period",
+		Long: strings.TrimSpace(`
+Project the amounts minted and distributed in each remaining inflation period.
+
+Without flags, the projection uses the current inflation parameters. Any of the
+flags below replace the corresponding current parameter, which makes it possible
+to evaluate a "MsgEditInflationParams" before it is executed. The flags match
+those of "tx inflation edit-params".
+
+$ nibid q inflation schedule
+$ nibid q inflation schedule --max-period 120 --polynomial-factors 0.1,0.2,0.3
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInflationScheduleRequest{}
+			if cmd.Flags().NFlag() > 0 {
+				paramsRes, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+				if err != nil {
+					return err
+				}
+				params, err := candidateParamsFromFlags(cmd, paramsRes.Params)
+				if err != nil {
+					return err
+				}
+				req.Params = &params
+			}
+
+			res, err := queryClient.InflationSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	cmd.Flags().String("staking-proportion", "", "the proportion of minted tokens to be distributed to stakers")
+	cmd.Flags().String("community-pool-proportion", "", "the proportion of minted tokens to be distributed to the community pool")
+	cmd.Flags().String("strategic-reserves-proportion", "", "the proportion of minted tokens to be distributed to validators")
+	cmd.Flags().String("recipients", "", "additional inflation recipients as comma-separated address=weight pairs")
+	cmd.Flags().String("polynomial-factors", "", "the polynomial factors of the inflation distribution curve")
+	cmd.Flags().Uint64("epochs-per-period", 0, "the number of epochs per period")
+	cmd.Flags().Uint64("periods-per-year", 0, "the number of periods per year")
+	cmd.Flags().Uint64("max-period", 0, "the maximum number of periods")
+
+	return cmd
+}
+
+// candidateParamsFromFlags overrides the fields of "params" that are set by the
+// flags of [GetInflationSchedule].
+func candidateParamsFromFlags(cmd *cobra.Command, params types.Params) (types.Params, error) {
+	for flag, target := range map[string]*sdkmath.LegacyDec{
+		"staking-proportion":            &params.InflationDistribution.StakingRewards,
+		"community-pool-proportion":     &params.InflationDistribution.CommunityPool,
+		"strategic-reserves-proportion": &params.InflationDistribution.StrategicReserves,
+	} {
+		if val, _ := cmd.Flags().GetString(flag); val != "" {
+			dec, err := sdkmath.LegacyNewDecFromStr(val)
+			if err != nil {
+				return params, fmt.Errorf("invalid --%s: %w", flag, err)
+			}
+			*target = dec
+		}
+	}
+
+	if recipients, _ := cmd.Flags().GetString("recipients"); recipients != "" {
+		var err error
+		params.InflationDistribution.Recipients, err = parseRecipients(recipients)
+		if err != nil {
+			return params, err
+		}
+	}
+
+	if polynomialFactors, _ := cmd.Flags().GetString("polynomial-factors"); polynomialFactors != "" {
+		factors := strings.Split(polynomialFactors, ",")
+		params.PolynomialFactors = make([]sdkmath.LegacyDec, len(factors))
+		for i, factor := range factors {
+			dec, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(factor))
+			if err != nil {
+				return params, fmt.Errorf("invalid --polynomial-factors: %w", err)
+			}
+			params.PolynomialFactors[i] = dec
+		}
+	}
+
+	for flag, target := range map[string]*uint64{
+		"epochs-per-period": &params.EpochsPerPeriod,
+		"periods-per-year":  &params.PeriodsPerYear,
+		"max-period":        &params.MaxPeriod,
+	} {
+		if val, _ := cmd.Flags().GetUint64(flag); val != 0 {
+			*target = val
+		}
+	}
+
+	return params, params.Validate()
+}
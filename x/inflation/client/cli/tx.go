@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -81,6 +82,11 @@ Requires sudo permissions.
 --community-pool-proportion: the proportion of minted tokens to be distributed to the community pool
 --strategic-reserves-proportion: the proportion of minted tokens to be distributed to validators
 
+--recipients: additional weighted recipients as comma-separated address=weight
+  pairs, where each address is a bech32 address, an allowed module account
+  name ("fee_collector"), or a hex EVM address. Only used together with the
+  three proportion flags.
+
 --polynomial-factors: the polynomial factors of the inflation distribution curve
 --epochs-per-period: the number of epochs per period
 --periods-per-year: the number of periods per year
@@ -122,6 +128,12 @@ $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proporti
 					CommunityPool:     communityPoolProportionDec,
 					StrategicReserves: strategicReservesProportionDec,
 				}
+				if recipients, _ := cmd.Flags().GetString("recipients"); recipients != "" {
+					msg.InflationDistribution.Recipients, err = parseRecipients(recipients)
+					if err != nil {
+						return err
+					}
+				}
 			}
 
 			if polynomialFactors, _ := cmd.Flags().GetString("polynomial-factors"); polynomialFactors != "" {
@@ -162,6 +174,7 @@ $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proporti
 	cmd.Flags().String("staking-proportion", "", "the proportion of minted tokens to be distributed to stakers")
 	cmd.Flags().String("community-pool-proportion", "", "the proportion of minted tokens to be distributed to the community pool")
 	cmd.Flags().String("strategic-reserves-proportion", "", "the proportion of minted tokens to be distributed to validators")
+	cmd.Flags().String("recipients", "", "additional inflation recipients as comma-separated address=weight pairs")
 	cmd.Flags().String("polynomial-factors", "", "the polynomial factors of the inflation distribution curve")
 	cmd.Flags().Uint64("epochs-per-period", 0, "the number of epochs per period")
 	cmd.Flags().Uint64("periods-per-year", 0, "the number of periods per year")
@@ -169,3 +182,24 @@ $ nibid tx oracle edit-params --staking-proportion 0.6 --community-pool-proporti
 
 	return cmd
 }
+
+// parseRecipients parses comma-separated "address=weight" pairs into
+// inflation recipients.
+func parseRecipients(arg string) ([]types.InflationRecipient, error) {
+	var recipients []types.InflationRecipient
+	for _, pair := range strings.Split(arg, ",") {
+		address, weight, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid recipient %q, expected address=weight", pair)
+		}
+		weightDec, err := sdkmath.LegacyNewDecFromStr(weight)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for recipient %q: %w", address, err)
+		}
+		recipients = append(recipients, types.InflationRecipient{
+			Address: address,
+			Weight:  weightDec,
+		})
+	}
+	return recipients, nil
+}
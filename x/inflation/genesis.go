@@ -21,6 +21,9 @@ func InitGenesis(
 	}
 
 	// Set genesis state
+	if err := k.ValidateRecipients(ctx, data.Params.InflationDistribution.Recipients); err != nil {
+		panic(err)
+	}
 	k.Params.Set(ctx, data.Params)
 
 	period := data.Period
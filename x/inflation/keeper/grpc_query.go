@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -87,3 +89,44 @@ func (k Keeper) CirculatingSupply(
 
 	return &types.QueryCirculatingSupplyResponse{CirculatingSupply: coin}, nil
 }
+
+// InflationSchedule projects the inflation of each remaining period using the
+// current params or the candidate params from the request.
+func (k Keeper) InflationSchedule(
+	c context.Context,
+	req *types.QueryInflationScheduleRequest,
+) (*types.QueryInflationScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	if req.Params != nil {
+		params = *req.Params
+		if err := params.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid params: %s", err)
+		}
+	}
+
+	currentPeriod := k.CurrentPeriod.Peek(ctx)
+	if params.MaxPeriod > currentPeriod && params.MaxPeriod-currentPeriod > types.MaxProjectedPeriods {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "cannot project more than %d periods", types.MaxProjectedPeriods,
+		)
+	}
+	currentSupply := k.GetCirculatingSupply(ctx, denoms.NIBI)
+	epochsElapsed := k.EpochsElapsedInCurrentPeriod(ctx)
+	periods, totalMinted := types.ProjectInflationSchedule(
+		params, currentPeriod, epochsElapsed, currentSupply,
+	)
+
+	return &types.QueryInflationScheduleResponse{
+		Params:                     params,
+		CurrentPeriod:              currentPeriod,
+		CurrentSupply:              currentSupply,
+		Periods:                    periods,
+		TotalMinted:                totalMinted,
+		CurrentPeriodEpochsElapsed: epochsElapsed,
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
 
 	inflationtypes "github.com/NibiruChain/nibiru/v2/x/inflation/types"
 )
@@ -107,3 +108,62 @@ func (s *QueryServerSuite) TestQueryParams() {
 	s.NoError(err)
 	s.NotNil(resp2)
 }
+
+func (s *QueryServerSuite) TestQueryInflationSchedule() {
+	nibiruApp, ctx := s.nibiruApp, s.ctx
+	params := nibiruApp.InflationKeeper.GetParams(ctx)
+
+	resp, err := nibiruApp.InflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx), &inflationtypes.QueryInflationScheduleRequest{},
+	)
+	s.Require().NoError(err)
+	s.Equal(params, resp.Params)
+	s.Len(resp.Periods, int(params.MaxPeriod-resp.CurrentPeriod))
+
+	s.T().Log("epochs of the current period that already minted are not projected")
+	epochInfo, err := nibiruApp.EpochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+	s.Require().NoError(err)
+	epochInfo.EpochCountingStarted = true
+	epochInfo.CurrentEpoch = params.EpochsPerPeriod*resp.CurrentPeriod +
+		nibiruApp.InflationKeeper.NumSkippedEpochs.Peek(ctx) + 5 + 1
+	nibiruApp.EpochsKeeper.Epochs.Insert(ctx, epochstypes.DayEpochID, epochInfo)
+	resp, err = nibiruApp.InflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx), &inflationtypes.QueryInflationScheduleRequest{},
+	)
+	s.Require().NoError(err)
+	s.EqualValues(5, resp.CurrentPeriodEpochsElapsed)
+	s.Equal(
+		resp.Periods[0].EpochMintProvision.TruncateInt().MulRaw(int64(params.EpochsPerPeriod-5)),
+		resp.Periods[0].Minted,
+	)
+
+	s.T().Log("candidate params")
+	candidate := params
+	candidate.MaxPeriod = resp.CurrentPeriod + 3
+	resp, err = nibiruApp.InflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx), &inflationtypes.QueryInflationScheduleRequest{
+			Params: &candidate,
+		},
+	)
+	s.Require().NoError(err)
+	s.Equal(candidate, resp.Params)
+	s.Len(resp.Periods, 3)
+
+	s.T().Log("candidate params with too many periods to project")
+	candidate.MaxPeriod = resp.CurrentPeriod + inflationtypes.MaxProjectedPeriods + 1
+	_, err = nibiruApp.InflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx), &inflationtypes.QueryInflationScheduleRequest{
+			Params: &candidate,
+		},
+	)
+	s.Error(err)
+
+	s.T().Log("invalid candidate params")
+	candidate.EpochsPerPeriod = 0
+	_, err = nibiruApp.InflationKeeper.InflationSchedule(
+		sdk.WrapSDKContext(ctx), &inflationtypes.QueryInflationScheduleRequest{
+			Params: &candidate,
+		},
+	)
+	s.Error(err)
+}
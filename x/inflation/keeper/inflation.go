@@ -6,7 +6,10 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
 )
 
@@ -96,6 +99,11 @@ func (k Keeper) AllocatePolynomialInflation(
 		return staking, strategic, community, err
 	}
 
+	// Allocate to the additional recipients. A recipient that can't be
+	// credited leaves its share in the module account, which then goes to the
+	// strategic reserve with the remaining balance.
+	recipients := k.allocateToRecipients(ctx, mintedCoin, inflationDistribution.Recipients)
+
 	// Remaining balance is strategic reserve allocation to the root account
 	// of the x/sudo module
 	strategic = k.bankKeeper.GetBalance(ctx, inflationModuleAddr, denoms.NIBI)
@@ -119,7 +127,108 @@ func (k Keeper) AllocatePolynomialInflation(
 			StakingRewards:   staking,
 			StrategicReserve: strategic,
 			CommunityPool:    community,
+			Recipients:       recipients,
+		})
+}
+
+// allocateToRecipients credits each of the additional inflation recipients
+// with its weighted share of "mintedCoin". Each recipient is credited in its
+// own cached context so that a failure doesn't affect the other recipients.
+func (k Keeper) allocateToRecipients(
+	ctx sdk.Context,
+	mintedCoin sdk.Coin,
+	recipients []types.InflationRecipient,
+) (allocations []types.InflationRecipientAllocation) {
+	for _, recipient := range recipients {
+		allocation := types.InflationRecipientAllocation{
+			Address: recipient.Address,
+			Amount:  k.GetProportions(ctx, mintedCoin, recipient.Weight),
+		}
+		if allocation.Amount.IsPositive() {
+			cacheCtx, commit := ctx.CacheContext()
+			if err := k.creditRecipient(cacheCtx, recipient, allocation.Amount); err != nil {
+				k.Logger(ctx).Error(
+					"inflation error: failed to credit inflation recipient",
+					"recipient", recipient.Address,
+					"error", err,
+				)
+				allocation.Error = err.Error()
+				_ = ctx.EventManager().EmitTypedEvent(&types.EventInflationRecipientFallback{
+					Address: recipient.Address,
+					Amount:  allocation.Amount,
+					Error:   allocation.Error,
+				})
+			} else {
+				commit()
+			}
+		}
+		allocations = append(allocations, allocation)
+	}
+	return allocations
+}
+
+// ValidateRecipients checks that no account or EVM recipient is an address
+// blocked from receiving funds, such as a module account, and that EVM
+// recipients can be credited through a FunToken mapping of the mint denom.
+// Module recipients are restricted by [types.InflationRecipient.Validate].
+func (k Keeper) ValidateRecipients(
+	ctx sdk.Context, recipients []types.InflationRecipient,
+) error {
+	for _, recipient := range recipients {
+		if addr := recipient.AccAddress(); addr != nil && k.bankKeeper.BlockedAddr(addr) {
+			return fmt.Errorf(
+				"inflation recipient %q is blocked from receiving funds", recipient.Address,
+			)
+		}
+		if recipient.Kind() == types.RecipientKindEvm &&
+			!k.evmKeeper.HasFunTokenForBankDenom(ctx, denoms.NIBI) {
+			return fmt.Errorf(
+				"EVM inflation recipient %q needs a FunToken mapping for %q, create one with MsgCreateFunToken first",
+				recipient.Address, denoms.NIBI,
+			)
+		}
+	}
+	return nil
+}
+
+// creditRecipient sends "coin" from the inflation module to "recipient" in the
+// way given by [types.InflationRecipient.Kind].
+func (k Keeper) creditRecipient(
+	ctx sdk.Context,
+	recipient types.InflationRecipient,
+	coin sdk.Coin,
+) error {
+	switch recipient.Kind() {
+	case types.RecipientKindModule:
+		if !types.AllowedModuleRecipients.Has(recipient.Address) {
+			return fmt.Errorf("module account %q cannot receive inflation", recipient.Address)
+		}
+		if k.accountKeeper.GetModuleAddress(recipient.Address) == nil {
+			return fmt.Errorf("module account %q does not exist", recipient.Address)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx, types.ModuleName, recipient.Address, sdk.NewCoins(coin),
+		)
+	case types.RecipientKindEvm:
+		toEthAddr, err := eth.NewEIP55AddrFromStr(recipient.Address)
+		if err != nil {
+			return err
+		}
+		_, err = k.evmKeeper.ConvertCoinToEvm(sdk.WrapSDKContext(ctx), &evm.MsgConvertCoinToEvm{
+			ToEthAddr: toEthAddr,
+			Sender:    k.accountKeeper.GetModuleAddress(types.ModuleName).String(),
+			BankCoin:  coin,
 		})
+		return err
+	default:
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, addr, sdk.NewCoins(coin),
+		)
+	}
 }
 
 // GetAllocationProportion calculates the proportion of coins that is to be
@@ -172,3 +281,24 @@ func (k Keeper) GetEpochMintProvision(ctx sdk.Context) sdkmath.LegacyDec {
 		peek,
 	)
 }
+
+// EpochsElapsedInCurrentPeriod returns the number of epochs of the current
+// period that have already minted inflation. It mirrors the period accounting
+// of [Hooks.AfterEpochEnd]: the epochs that ended minus the skipped epochs
+// and the epochs of the previous periods.
+func (k Keeper) EpochsElapsedInCurrentPeriod(ctx sdk.Context) uint64 {
+	epochInfo, err := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+	if err != nil || !epochInfo.EpochCountingStarted || epochInfo.CurrentEpoch == 0 {
+		return 0
+	}
+	// The current epoch is in progress, so only the ones before it ended.
+	endedEpochs := epochInfo.CurrentEpoch - 1
+
+	params := k.GetParams(ctx)
+	mintedEpochsBeforePeriod := params.EpochsPerPeriod*k.CurrentPeriod.Peek(ctx) +
+		k.NumSkippedEpochs.Peek(ctx)
+	if endedEpochs <= mintedEpochsBeforePeriod {
+		return 0
+	}
+	return min(endedEpochs-mintedEpochsBeforePeriod, params.EpochsPerPeriod)
+}
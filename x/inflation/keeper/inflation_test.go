@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/app"
 	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
 	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
 	sudotypes "github.com/NibiruChain/nibiru/v2/x/sudo/types"
@@ -121,6 +123,78 @@ func TestMintAndAllocateInflation(t *testing.T) {
 	}
 }
 
+func TestAllocatePolynomialInflation_Recipients(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	root := testutil.AccAddress()
+	nibiruApp.SudoKeeper.Sudoers.Set(ctx, sudotypes.Sudoers{
+		Root:      root.String(),
+		Contracts: []string{},
+	})
+
+	accountRecipient := testutil.AccAddress()
+	params := types.DefaultParams()
+	params.InflationDistribution = types.InflationDistribution{
+		StakingRewards:    sdkmath.LegacyNewDecWithPrec(4, 1),
+		CommunityPool:     sdkmath.LegacyNewDecWithPrec(1, 1),
+		StrategicReserves: sdkmath.LegacyNewDecWithPrec(1, 1),
+		Recipients: []types.InflationRecipient{
+			{Address: accountRecipient.String(), Weight: sdkmath.LegacyNewDecWithPrec(2, 1)},
+			{Address: authtypes.FeeCollectorName, Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+			// No FunToken mapping exists for NIBI, so crediting fails and the
+			// share goes to the strategic reserve.
+			{Address: "0x000000000000000000000000000000000000dEaD", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+		},
+	}
+	require.NoError(t, params.Validate())
+
+	coin := sdk.NewCoin(denoms.NIBI, sdkmath.NewInt(1_000_000))
+	staking, strategic, community, err := nibiruApp.InflationKeeper.MintAndAllocateInflation(ctx, coin, params)
+	require.NoError(t, err)
+	assert.Equal(t, sdkmath.NewInt(400_000), staking.Amount)
+	assert.Equal(t, sdkmath.NewInt(100_000), community.Amount)
+	assert.Equal(t, sdkmath.NewInt(200_000), strategic.Amount)
+
+	assert.Equal(t,
+		sdkmath.NewInt(200_000),
+		nibiruApp.BankKeeper.GetBalance(ctx, accountRecipient, denoms.NIBI).Amount)
+	assert.Equal(t,
+		sdkmath.NewInt(500_000),
+		nibiruApp.BankKeeper.GetBalance(
+			ctx, nibiruApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), denoms.NIBI,
+		).Amount)
+	assert.Equal(t,
+		sdkmath.NewInt(200_000),
+		nibiruApp.BankKeeper.GetBalance(ctx, root, denoms.NIBI).Amount)
+
+	var (
+		event         *types.EventInflationDistribution
+		fallbackEvent *types.EventInflationRecipientFallback
+	)
+	for _, abciEvent := range ctx.EventManager().Events() {
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(abciEvent))
+		if err != nil {
+			continue
+		}
+		switch e := typedEvent.(type) {
+		case *types.EventInflationDistribution:
+			event = e
+		case *types.EventInflationRecipientFallback:
+			require.Nil(t, fallbackEvent, "only one recipient falls back")
+			fallbackEvent = e
+		}
+	}
+	require.NotNil(t, event)
+	require.Len(t, event.Recipients, 3)
+	assert.Empty(t, event.Recipients[0].Error)
+	assert.Empty(t, event.Recipients[1].Error)
+	assert.NotEmpty(t, event.Recipients[2].Error)
+
+	require.NotNil(t, fallbackEvent)
+	assert.Equal(t, event.Recipients[2].Address, fallbackEvent.Address)
+	assert.Equal(t, sdkmath.NewInt(100_000), fallbackEvent.Amount.Amount)
+	assert.Equal(t, event.Recipients[2].Error, fallbackEvent.Error)
+}
+
 func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -23,6 +23,8 @@ type Keeper struct {
 	distrKeeper   types.DistrKeeper
 	stakingKeeper types.StakingKeeper
 	sudoKeeper    types.SudoKeeper
+	evmKeeper     types.EvmKeeper
+	epochsKeeper  types.EpochsKeeper
 	// feeCollectorName is the name of x/auth module's fee collector module
 	// account, "fee_collector", which collects transaction fees for distribution
 	// to all stakers.
@@ -57,6 +59,8 @@ func NewKeeper(
 	distributionKeeper types.DistrKeeper,
 	stakingKeeper types.StakingKeeper,
 	sudoKeeper types.SudoKeeper,
+	evmKeeper types.EvmKeeper,
+	epochsKeeper types.EpochsKeeper,
 	feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
@@ -72,6 +76,8 @@ func NewKeeper(
 		distrKeeper:      distributionKeeper,
 		stakingKeeper:    stakingKeeper,
 		sudoKeeper:       sudoKeeper,
+		evmKeeper:        evmKeeper,
+		epochsKeeper:     epochsKeeper,
 		feeCollectorName: feeCollectorName,
 		CurrentPeriod:    collections.NewSequence(storeKey, 0),
 		NumSkippedEpochs: collections.NewSequence(storeKey, 1),
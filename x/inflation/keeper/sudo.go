@@ -41,8 +41,14 @@ func (k sudoExtension) EditInflationParams(
 	if err != nil {
 		return
 	}
+	if err = paramsAfter.Validate(); err != nil {
+		return
+	}
+	if err = k.ValidateRecipients(ctx, paramsAfter.InflationDistribution.Recipients); err != nil {
+		return
+	}
 	k.Params.Set(ctx, paramsAfter)
-	return paramsAfter.Validate()
+	return nil
 }
 
 // ToggleInflation disables (pauses) or enables (unpauses) inflation.
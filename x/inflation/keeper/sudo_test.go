@@ -5,10 +5,15 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/NibiruChain/nibiru/v2/x/common/denoms"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 	inflationKeeper "github.com/NibiruChain/nibiru/v2/x/inflation/keeper"
 	"github.com/NibiruChain/nibiru/v2/x/inflation/types"
 )
@@ -115,6 +120,45 @@ func (s *SuiteInflationSudo) TestEditInflationParams() {
 	s.Require().EqualValues(1234, paramsAfter.MaxPeriod)
 	s.Require().EqualValues(polynomialFactors, paramsAfter.PolynomialFactors)
 	s.Require().EqualValues(inflationDistribution, paramsAfter.InflationDistribution)
+
+	s.T().Log("Recipients blocked from receiving funds are rejected")
+	for _, blockedRecipient := range []string{
+		authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		gethcommon.BytesToAddress(authtypes.NewModuleAddress(evm.ModuleName)).Hex(),
+		stakingtypes.NotBondedPoolName,
+	} {
+		distribution := inflationDistribution
+		distribution.CommunityPool = sdkmath.LegacyMustNewDecFromStr("0.7")
+		distribution.Recipients = []types.InflationRecipient{
+			{Address: blockedRecipient, Weight: sdkmath.LegacyMustNewDecFromStr("0.1")},
+		}
+		err = nibiru.InflationKeeper.Sudo().EditInflationParams(
+			ctx, types.MsgEditInflationParams{InflationDistribution: &distribution}, okSender,
+		)
+		s.Require().Error(err, blockedRecipient)
+	}
+	paramsAfterErr, err := nibiru.InflationKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(paramsAfter, paramsAfterErr)
+
+	s.T().Log("EVM recipients need a FunToken mapping for the mint denom")
+	distribution := inflationDistribution
+	distribution.CommunityPool = sdkmath.LegacyMustNewDecFromStr("0.7")
+	distribution.Recipients = []types.InflationRecipient{
+		{Address: "0x000000000000000000000000000000000000dEaD", Weight: sdkmath.LegacyMustNewDecFromStr("0.1")},
+	}
+	msgEdit := types.MsgEditInflationParams{InflationDistribution: &distribution}
+	err = nibiru.InflationKeeper.Sudo().EditInflationParams(ctx, msgEdit, okSender)
+	s.Require().ErrorContains(err, "needs a FunToken mapping")
+
+	s.Require().NoError(nibiru.EvmKeeper.FunTokens.SafeInsert(
+		ctx, gethcommon.HexToAddress("0x0000000000000000000000000000000000000abc"), denoms.NIBI, true,
+	))
+	err = nibiru.InflationKeeper.Sudo().EditInflationParams(ctx, msgEdit, okSender)
+	s.Require().NoError(err)
+	paramsAfter, err = nibiru.InflationKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(distribution.Recipients, paramsAfter.InflationDistribution.Recipients)
 }
 
 func (s *SuiteInflationSudo) TestToggleInflation() {
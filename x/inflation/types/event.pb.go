@@ -30,6 +30,9 @@ type EventInflationDistribution struct {
 	StakingRewards   types.Coin `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards" yaml:"staking_rewards"`
 	StrategicReserve types.Coin `protobuf:"bytes,2,opt,name=strategic_reserve,json=strategicReserve,proto3" json:"strategic_reserve" yaml:"strategic_reserve"`
 	CommunityPool    types.Coin `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3" json:"community_pool" yaml:"community_pool"`
+	// recipients: Amounts credited to the additional inflation recipients from
+	// "InflationDistribution.recipients".
+	Recipients []InflationRecipientAllocation `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *EventInflationDistribution) Reset()         { *m = EventInflationDistribution{} }
@@ -86,35 +89,182 @@ func (m *EventInflationDistribution) GetCommunityPool() types.Coin {
 	return types.Coin{}
 }
 
+func (m *EventInflationDistribution) GetRecipients() []InflationRecipientAllocation {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// InflationRecipientAllocation: Amount of inflation allocated to one of the
+// additional inflation recipients.
+type InflationRecipientAllocation struct {
+	// address of the recipient, as given in "InflationRecipient.address".
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// error is non-empty if crediting the recipient failed. The amount then
+	// falls through to the strategic reserve.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *InflationRecipientAllocation) Reset()         { *m = InflationRecipientAllocation{} }
+func (m *InflationRecipientAllocation) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipientAllocation) ProtoMessage()    {}
+func (*InflationRecipientAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18fa0385facaf5d9, []int{1}
+}
+func (m *InflationRecipientAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecipientAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecipientAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecipientAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipientAllocation.Merge(m, src)
+}
+func (m *InflationRecipientAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecipientAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipientAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipientAllocation proto.InternalMessageInfo
+
+func (m *InflationRecipientAllocation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InflationRecipientAllocation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *InflationRecipientAllocation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EventInflationRecipientFallback: Emitted when an additional inflation
+// recipient can't be credited, so its share goes to the strategic reserve
+// instead.
+type EventInflationRecipientFallback struct {
+	// address of the recipient, as given in "InflationRecipient.address".
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount: The share of the recipient sent to the strategic reserve.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// error: Why crediting the recipient failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventInflationRecipientFallback) Reset()         { *m = EventInflationRecipientFallback{} }
+func (m *EventInflationRecipientFallback) String() string { return proto.CompactTextString(m) }
+func (*EventInflationRecipientFallback) ProtoMessage()    {}
+func (*EventInflationRecipientFallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18fa0385facaf5d9, []int{2}
+}
+func (m *EventInflationRecipientFallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInflationRecipientFallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInflationRecipientFallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventInflationRecipientFallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInflationRecipientFallback.Merge(m, src)
+}
+func (m *EventInflationRecipientFallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInflationRecipientFallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInflationRecipientFallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInflationRecipientFallback proto.InternalMessageInfo
+
+func (m *EventInflationRecipientFallback) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventInflationRecipientFallback) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventInflationRecipientFallback) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventInflationDistribution)(nil), "nibiru.inflation.v1.EventInflationDistribution")
+	proto.RegisterType((*InflationRecipientAllocation)(nil), "nibiru.inflation.v1.InflationRecipientAllocation")
+	proto.RegisterType((*EventInflationRecipientFallback)(nil), "nibiru.inflation.v1.EventInflationRecipientFallback")
 }
 
 func init() { proto.RegisterFile("nibiru/inflation/v1/event.proto", fileDescriptor_18fa0385facaf5d9) }
 
 var fileDescriptor_18fa0385facaf5d9 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
-	0x14, 0xc6, 0x93, 0x5e, 0xe9, 0x0e, 0xb9, 0xba, 0x05, 0xc2, 0x1f, 0x85, 0x4a, 0xb8, 0x55, 0x26,
-	0x26, 0x5b, 0x29, 0x1b, 0x63, 0x0b, 0x03, 0x12, 0x42, 0x28, 0x23, 0x4b, 0x64, 0x07, 0x93, 0x5a,
-	0x24, 0x3e, 0x95, 0xed, 0x04, 0xfa, 0x16, 0x3c, 0x56, 0x25, 0x96, 0x8e, 0x4c, 0x15, 0x6a, 0xdf,
-	0x80, 0x27, 0x40, 0xa9, 0xd3, 0x0a, 0xba, 0x74, 0x3b, 0xfa, 0xce, 0x39, 0xbf, 0xdf, 0xf0, 0x79,
-	0x5d, 0x29, 0x98, 0x50, 0x25, 0x11, 0xf2, 0x29, 0xa7, 0x46, 0x80, 0x24, 0x55, 0x44, 0x78, 0xc5,
-	0xa5, 0xc1, 0x63, 0x05, 0x06, 0xfc, 0x43, 0x7b, 0x80, 0x37, 0x07, 0xb8, 0x8a, 0x3a, 0x47, 0x19,
-	0x64, 0xb0, 0xda, 0x93, 0x7a, 0xb2, 0xa7, 0x1d, 0x94, 0x82, 0x2e, 0x40, 0x13, 0x46, 0x35, 0x27,
-	0x55, 0xc4, 0xb8, 0xa1, 0x11, 0x49, 0x41, 0x48, 0xbb, 0x0f, 0xdf, 0x5b, 0x5e, 0xe7, 0xba, 0x46,
-	0xdf, 0xac, 0x59, 0x57, 0x42, 0x1b, 0x25, 0x58, 0x59, 0xcf, 0x3e, 0xf3, 0xf6, 0xb4, 0xa1, 0xcf,
-	0x42, 0x66, 0x89, 0xe2, 0x2f, 0x54, 0x3d, 0xea, 0xc0, 0xed, 0xb9, 0xe7, 0xff, 0xfa, 0xa7, 0xd8,
-	0x82, 0x71, 0x0d, 0xc6, 0x0d, 0x18, 0x0f, 0x41, 0xc8, 0x01, 0x9a, 0xce, 0xbb, 0xce, 0xd7, 0xbc,
-	0x7b, 0x32, 0xa1, 0x45, 0x7e, 0x19, 0x6e, 0xfd, 0x87, 0x71, 0xbb, 0x49, 0x62, 0x1b, 0xf8, 0x23,
-	0xef, 0x40, 0x1b, 0x45, 0x0d, 0xcf, 0x44, 0x9a, 0x28, 0xae, 0xb9, 0xaa, 0x78, 0xd0, 0xda, 0x65,
-	0xe9, 0x35, 0x96, 0x60, 0x6d, 0xd9, 0x22, 0x84, 0xf1, 0xfe, 0x26, 0x8b, 0x6d, 0xe4, 0x27, 0x5e,
-	0x3b, 0x85, 0xa2, 0x28, 0xa5, 0x30, 0x93, 0x64, 0x0c, 0x90, 0x07, 0x7f, 0x76, 0x69, 0xce, 0x1a,
-	0xcd, 0xb1, 0xd5, 0xfc, 0x7e, 0x0f, 0xe3, 0xff, 0x9b, 0xe0, 0x1e, 0x20, 0x1f, 0xdc, 0x4e, 0x17,
-	0xc8, 0x9d, 0x2d, 0x90, 0xfb, 0xb9, 0x40, 0xee, 0xdb, 0x12, 0x39, 0xb3, 0x25, 0x72, 0x3e, 0x96,
-	0xc8, 0x79, 0xe8, 0x67, 0xc2, 0x8c, 0x4a, 0x86, 0x53, 0x28, 0xc8, 0xdd, 0xaa, 0xbd, 0xe1, 0x88,
-	0x0a, 0x49, 0x9a, 0xaa, 0xab, 0x3e, 0x79, 0xfd, 0xd1, 0xb7, 0x99, 0x8c, 0xb9, 0x66, 0x7f, 0x57,
-	0x15, 0x5d, 0x7c, 0x07, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x1d, 0x75, 0xa0, 0x10, 0x02, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xc1, 0x8a, 0x13, 0x31,
+	0x18, 0xc7, 0x3b, 0x76, 0x5d, 0xd9, 0x2c, 0xae, 0x3a, 0xae, 0x32, 0x16, 0x9d, 0x96, 0x39, 0xed,
+	0x29, 0x61, 0xea, 0x41, 0xf0, 0x66, 0x57, 0x05, 0x41, 0x44, 0x72, 0x11, 0xbc, 0x94, 0x4c, 0x1a,
+	0xa7, 0x61, 0x33, 0xf9, 0x4a, 0x92, 0x19, 0xed, 0x13, 0x08, 0x9e, 0x7c, 0x08, 0x1f, 0x66, 0x8f,
+	0x7b, 0xf4, 0xb4, 0x48, 0xfb, 0x06, 0x3e, 0x81, 0xcc, 0x64, 0x3a, 0xec, 0x16, 0xb1, 0x37, 0x6f,
+	0xc9, 0x3f, 0xff, 0xef, 0xff, 0x0b, 0x5f, 0xf2, 0xa1, 0xa1, 0x96, 0x99, 0x34, 0x25, 0x91, 0xfa,
+	0x93, 0x62, 0x4e, 0x82, 0x26, 0x55, 0x4a, 0x44, 0x25, 0xb4, 0xc3, 0x0b, 0x03, 0x0e, 0xc2, 0xfb,
+	0xde, 0x80, 0x3b, 0x03, 0xae, 0xd2, 0xc1, 0x71, 0x0e, 0x39, 0x34, 0xe7, 0xa4, 0x5e, 0x79, 0xeb,
+	0x20, 0xe6, 0x60, 0x0b, 0xb0, 0x24, 0x63, 0x56, 0x90, 0x2a, 0xcd, 0x84, 0x63, 0x29, 0xe1, 0x20,
+	0xb5, 0x3f, 0x4f, 0x7e, 0xf4, 0xd1, 0xe0, 0x55, 0x1d, 0xfd, 0x66, 0x93, 0xf5, 0x52, 0x5a, 0x67,
+	0x64, 0x56, 0xd6, 0xeb, 0x30, 0x43, 0x77, 0xac, 0x63, 0x67, 0x52, 0xe7, 0x53, 0x23, 0x3e, 0x33,
+	0x33, 0xb3, 0x51, 0x30, 0x0a, 0x4e, 0x0e, 0xc7, 0x8f, 0xb0, 0x0f, 0xc6, 0x75, 0x30, 0x6e, 0x83,
+	0xf1, 0x29, 0x48, 0x3d, 0x89, 0xcf, 0x2f, 0x87, 0xbd, 0xdf, 0x97, 0xc3, 0x87, 0x4b, 0x56, 0xa8,
+	0xe7, 0xc9, 0x56, 0x7d, 0x42, 0x8f, 0x5a, 0x85, 0x7a, 0x21, 0x9c, 0xa3, 0x7b, 0xd6, 0x19, 0xe6,
+	0x44, 0x2e, 0xf9, 0xd4, 0x08, 0x2b, 0x4c, 0x25, 0xa2, 0x1b, 0xbb, 0x28, 0xa3, 0x96, 0x12, 0x6d,
+	0x28, 0x5b, 0x09, 0x09, 0xbd, 0xdb, 0x69, 0xd4, 0x4b, 0xe1, 0x14, 0x1d, 0x71, 0x28, 0x8a, 0x52,
+	0x4b, 0xb7, 0x9c, 0x2e, 0x00, 0x54, 0xd4, 0xdf, 0x85, 0x79, 0xd2, 0x62, 0x1e, 0x78, 0xcc, 0xf5,
+	0xf2, 0x84, 0xde, 0xee, 0x84, 0xf7, 0x00, 0x2a, 0xfc, 0x80, 0x90, 0x11, 0x5c, 0x2e, 0xa4, 0xd0,
+	0xce, 0x46, 0x7b, 0xa3, 0xfe, 0xc9, 0xe1, 0x38, 0xc5, 0x7f, 0x79, 0x2d, 0xdc, 0xb5, 0x9b, 0x6e,
+	0xfc, 0x2f, 0x94, 0x02, 0xde, 0x48, 0x93, 0xbd, 0x1a, 0x4a, 0xaf, 0x44, 0x25, 0x5f, 0x03, 0xf4,
+	0xf8, 0x5f, 0x25, 0x61, 0x84, 0x6e, 0xb1, 0xd9, 0xcc, 0x08, 0xeb, 0x1f, 0xe8, 0x80, 0x6e, 0xb6,
+	0xe1, 0x33, 0xb4, 0xcf, 0x0a, 0x28, 0xb5, 0xdb, 0xdd, 0x53, 0xcf, 0x6d, 0xed, 0xe1, 0x31, 0xba,
+	0x29, 0x8c, 0x01, 0xd3, 0x34, 0xe9, 0x80, 0xfa, 0x4d, 0xf2, 0x2d, 0x40, 0xc3, 0xeb, 0x1f, 0xa6,
+	0xbb, 0xce, 0x6b, 0xa6, 0x54, 0xc6, 0xf8, 0xd9, 0x7f, 0xbb, 0xcc, 0xe4, 0xed, 0xf9, 0x2a, 0x0e,
+	0x2e, 0x56, 0x71, 0xf0, 0x6b, 0x15, 0x07, 0xdf, 0xd7, 0x71, 0xef, 0x62, 0x1d, 0xf7, 0x7e, 0xae,
+	0xe3, 0xde, 0xc7, 0x71, 0x2e, 0xdd, 0xbc, 0xcc, 0x30, 0x87, 0x82, 0xbc, 0x6b, 0xfa, 0x7f, 0x3a,
+	0x67, 0x52, 0x93, 0x76, 0xb4, 0xaa, 0x31, 0xf9, 0x72, 0x65, 0xbe, 0xdc, 0x72, 0x21, 0x6c, 0xb6,
+	0xdf, 0x8c, 0xc4, 0xd3, 0x3f, 0x03, 0x00, 0x60, 0xe8, 0xe7, 0x38, 0x80, 0x03, 0x00, 0x00,
 }
 
 func (m *EventInflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -137,6 +287,20 @@ func (m *EventInflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.CommunityPool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -170,6 +334,100 @@ func (m *EventInflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationRecipientAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationRecipientAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationRecipientAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventInflationRecipientFallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInflationRecipientFallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInflationRecipientFallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -193,6 +451,50 @@ func (m *EventInflationDistribution) Size() (n int) {
 	n += 1 + l + sovEvent(uint64(l))
 	l = m.CommunityPool.Size()
 	n += 1 + l + sovEvent(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationRecipientAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventInflationRecipientFallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -330,6 +632,334 @@ func (m *EventInflationDistribution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, InflationRecipientAllocation{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRecipientAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecipientAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecipientAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventInflationRecipientFallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInflationRecipientFallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInflationRecipientFallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
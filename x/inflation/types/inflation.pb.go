@@ -38,6 +38,10 @@ type InflationDistribution struct {
 	// strategic_reserves defines the proportion of the minted_denom that
 	// is to be allocated to the strategic reserves module address
 	StrategicReserves cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=strategic_reserves,json=strategicReserves,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"strategic_reserves"`
+	// recipients defines additional weighted recipients of the minted_denom.
+	// The weights of the recipients, together with the staking_rewards,
+	// community_pool, and strategic_reserves proportions, must sum to one.
+	Recipients []InflationRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *InflationDistribution) Reset()         { *m = InflationDistribution{} }
@@ -73,8 +77,71 @@ func (m *InflationDistribution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InflationDistribution proto.InternalMessageInfo
 
+func (m *InflationDistribution) GetRecipients() []InflationRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// InflationRecipient: A weighted recipient of inflation.
+type InflationRecipient struct {
+	// address of the recipient. It is one of:
+	//   - a bech32 account address, credited with a bank send. Addresses
+	//     blocked from receiving funds, such as module accounts, are rejected.
+	//   - the name of a module account allowed to receive inflation, such as
+	//     "fee_collector", credited with a module-to-module send.
+	//   - a hex ("0x") EVM address, credited with the ERC20 representation of
+	//     the minted denom through its FunToken mapping.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the proportion of the minted_denom that is to be
+	// allocated to the recipient.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *InflationRecipient) Reset()         { *m = InflationRecipient{} }
+func (m *InflationRecipient) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipient) ProtoMessage()    {}
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{1}
+}
+func (m *InflationRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipient.Merge(m, src)
+}
+func (m *InflationRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipient proto.InternalMessageInfo
+
+func (m *InflationRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InflationDistribution)(nil), "nibiru.inflation.v1.InflationDistribution")
+	proto.RegisterType((*InflationRecipient)(nil), "nibiru.inflation.v1.InflationRecipient")
 }
 
 func init() {
@@ -82,27 +149,31 @@ func init() {
 }
 
 var fileDescriptor_37da805e9a324a97 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd1, 0xc1, 0x4a, 0x02, 0x41,
-	0x18, 0x07, 0xf0, 0x5d, 0x83, 0xa0, 0x85, 0x8c, 0xb6, 0x02, 0x33, 0x18, 0xa3, 0x2e, 0x5d, 0xda,
-	0x41, 0x7b, 0x03, 0xf3, 0x12, 0x48, 0x84, 0xa7, 0xf0, 0xb2, 0xcd, 0x8e, 0xd3, 0xf8, 0xa1, 0x3b,
-	0x9f, 0xcc, 0xcc, 0x6e, 0xf9, 0x16, 0xbd, 0x49, 0x97, 0x1e, 0xc2, 0xa3, 0x74, 0x8a, 0x0e, 0x12,
-	0xfa, 0x22, 0xa1, 0xb3, 0x69, 0x67, 0x6f, 0xdf, 0x37, 0xff, 0x8f, 0xdf, 0x1c, 0xfe, 0xc1, 0xa5,
-	0x82, 0x04, 0x74, 0x46, 0x41, 0x3d, 0x0f, 0x99, 0x05, 0x54, 0x34, 0xaf, 0x6f, 0x96, 0x68, 0xa4,
-	0xd1, 0x62, 0x78, 0xe4, 0x8e, 0xa2, 0xcd, 0x7b, 0x5e, 0xaf, 0x1e, 0x4b, 0x94, 0xb8, 0xca, 0xe9,
-	0x72, 0x72, 0xa7, 0xd5, 0x53, 0x8e, 0x26, 0x45, 0x13, 0xbb, 0xc0, 0x2d, 0x2e, 0xba, 0x78, 0x2f,
-	0x05, 0x27, 0x77, 0x7f, 0x42, 0x0b, 0x8c, 0xd5, 0x90, 0x64, 0xcb, 0x39, 0xec, 0x06, 0x07, 0xc6,
-	0xb2, 0x01, 0x28, 0x19, 0x6b, 0xf1, 0xc2, 0x74, 0xcf, 0x54, 0xfc, 0x73, 0xff, 0x6a, 0xaf, 0x59,
-	0x9f, 0xcc, 0x6a, 0xde, 0xf7, 0xac, 0x76, 0xe6, 0x20, 0xd3, 0x1b, 0x44, 0x80, 0x34, 0x65, 0xb6,
-	0x1f, 0xb5, 0x85, 0x64, 0x7c, 0xdc, 0x12, 0xfc, 0xf3, 0xe3, 0x3a, 0x28, 0xfe, 0x69, 0x09, 0xde,
-	0x29, 0x17, 0x52, 0xc7, 0x41, 0xe1, 0x63, 0x50, 0xe6, 0x98, 0xa6, 0x99, 0x02, 0x3b, 0x8e, 0x47,
-	0x88, 0xc3, 0x4a, 0x69, 0x5b, 0x7a, 0x7f, 0x0d, 0x3d, 0x20, 0x0e, 0xc3, 0xa7, 0x20, 0x34, 0x56,
-	0x33, 0x2b, 0x24, 0xf0, 0x58, 0x0b, 0x23, 0x74, 0x2e, 0x4c, 0x65, 0x67, 0x5b, 0xfd, 0x70, 0x8d,
-	0x75, 0x0a, 0xab, 0xd9, 0x9e, 0xcc, 0x89, 0x3f, 0x9d, 0x13, 0xff, 0x67, 0x4e, 0xfc, 0xb7, 0x05,
-	0xf1, 0xa6, 0x0b, 0xe2, 0x7d, 0x2d, 0x88, 0xd7, 0x6d, 0x48, 0xb0, 0xfd, 0x2c, 0x89, 0x38, 0xa6,
-	0xf4, 0x7e, 0x55, 0xce, 0x6d, 0x9f, 0x81, 0xa2, 0x45, 0x9b, 0x79, 0x83, 0xbe, 0xfe, 0xab, 0xd4,
-	0x8e, 0x47, 0xc2, 0x24, 0xbb, 0xab, 0x1a, 0x6e, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0x97, 0x7c,
-	0x3f, 0xa9, 0xf3, 0x01, 0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x4e, 0xea, 0x40,
+	0x18, 0xc5, 0x5b, 0x20, 0xdc, 0xdc, 0x31, 0x62, 0x1c, 0x35, 0xa9, 0x98, 0x14, 0x82, 0x0b, 0xd9,
+	0xd8, 0x06, 0x7c, 0x03, 0x64, 0x43, 0x82, 0xc6, 0x74, 0x65, 0xd8, 0xd4, 0xe9, 0x74, 0x6c, 0x27,
+	0xd0, 0x4e, 0x33, 0x33, 0x2d, 0xf6, 0x2d, 0x7c, 0x18, 0x1f, 0x82, 0x25, 0x71, 0x65, 0x5c, 0x10,
+	0x03, 0xef, 0x61, 0x0c, 0xb4, 0xfc, 0x49, 0x74, 0xc5, 0xee, 0xfb, 0x7a, 0x4e, 0x7e, 0x9d, 0x39,
+	0x73, 0xc0, 0x65, 0x48, 0x1d, 0xca, 0x63, 0x93, 0x86, 0xcf, 0x23, 0x24, 0x29, 0x0b, 0xcd, 0xa4,
+	0xb5, 0x5d, 0x8c, 0x88, 0x33, 0xc9, 0xe0, 0x49, 0x66, 0x32, 0xb6, 0xdf, 0x93, 0x56, 0xf5, 0xd4,
+	0x63, 0x1e, 0x5b, 0xe9, 0xe6, 0x72, 0xca, 0xac, 0xd5, 0x73, 0xcc, 0x44, 0xc0, 0x84, 0x9d, 0x09,
+	0xd9, 0x92, 0x49, 0x8d, 0xef, 0x02, 0x38, 0xeb, 0xad, 0x09, 0x5d, 0x2a, 0x24, 0xa7, 0x4e, 0xbc,
+	0x9c, 0xe1, 0x00, 0x1c, 0x09, 0x89, 0x86, 0x34, 0xf4, 0x6c, 0x4e, 0xc6, 0x88, 0xbb, 0x42, 0x53,
+	0xeb, 0x6a, 0xf3, 0x7f, 0xa7, 0x35, 0x99, 0xd5, 0x94, 0xcf, 0x59, 0xed, 0x22, 0x03, 0x09, 0x77,
+	0x68, 0x50, 0x66, 0x06, 0x48, 0xfa, 0x46, 0x9f, 0x78, 0x08, 0xa7, 0x5d, 0x82, 0xdf, 0xdf, 0xae,
+	0x41, 0xfe, 0x9f, 0x2e, 0xc1, 0x56, 0x25, 0x27, 0x59, 0x19, 0x08, 0x3e, 0x82, 0x0a, 0x66, 0x41,
+	0x10, 0x87, 0x54, 0xa6, 0x76, 0xc4, 0xd8, 0x48, 0x2b, 0xec, 0x8b, 0x3e, 0xdc, 0x80, 0x1e, 0x18,
+	0x1b, 0xc1, 0x27, 0x00, 0x85, 0xe4, 0x48, 0x12, 0x8f, 0x62, 0x9b, 0x13, 0x41, 0x78, 0x42, 0x84,
+	0x56, 0xdc, 0x97, 0x7e, 0xbc, 0x81, 0x59, 0x39, 0x0b, 0xde, 0x01, 0xc0, 0x09, 0xa6, 0x11, 0x25,
+	0xa1, 0x14, 0x5a, 0xa9, 0x5e, 0x6c, 0x1e, 0xb4, 0xaf, 0x8c, 0x3f, 0x1e, 0xc3, 0xd8, 0xe4, 0x6a,
+	0xad, 0xfd, 0x9d, 0xd2, 0xf2, 0x08, 0xd6, 0x0e, 0xa0, 0x91, 0x02, 0xf8, 0xdb, 0x07, 0x35, 0xf0,
+	0x0f, 0xb9, 0x2e, 0x27, 0x22, 0x0f, 0xdd, 0x5a, 0xaf, 0xb0, 0x07, 0xca, 0x63, 0x42, 0x3d, 0x5f,
+	0xee, 0x1f, 0x59, 0x0e, 0xe8, 0xf4, 0x27, 0x73, 0x5d, 0x9d, 0xce, 0x75, 0xf5, 0x6b, 0xae, 0xab,
+	0xaf, 0x0b, 0x5d, 0x99, 0x2e, 0x74, 0xe5, 0x63, 0xa1, 0x2b, 0x83, 0xb6, 0x47, 0xa5, 0x1f, 0x3b,
+	0x06, 0x66, 0x81, 0x79, 0xbf, 0xba, 0xd9, 0xad, 0x8f, 0x68, 0x68, 0xe6, 0xbd, 0x4c, 0xda, 0xe6,
+	0xcb, 0x4e, 0x39, 0x65, 0x1a, 0x11, 0xe1, 0x94, 0x57, 0x85, 0xba, 0xf9, 0x19, 0x00, 0x3b, 0xff,
+	0x1e, 0x8c, 0xbd, 0x02, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -125,6 +196,20 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.StrategicReserves.Size()
 		i -= size
@@ -158,6 +243,46 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInflation(v)
 	base := offset
@@ -181,6 +306,27 @@ func (m *InflationDistribution) Size() (n int) {
 	n += 1 + l + sovInflation(uint64(l))
 	l = m.StrategicReserves.Size()
 	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInflation(uint64(l))
 	return n
 }
 
@@ -321,6 +467,156 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, InflationRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInflation(dAtA[iNdEx:])
@@ -38,3 +38,61 @@ func polynomial(factors []sdkmath.LegacyDec, x sdkmath.LegacyDec) sdkmath.Legacy
 	// 1 unibi = 1e6 nibi and the polynomial was fit on nibi token curve.
 	return result.Mul(sdkmath.LegacyNewDec(1_000_000))
 }
+
+// MaxProjectedPeriods bounds the number of periods the inflation schedule query
+// projects, since it may be called with candidate params from Wasm contracts.
+const MaxProjectedPeriods = 1_200
+
+// ProjectInflationSchedule projects the amounts minted and distributed in each
+// period from "startPeriod" up to "params.MaxPeriod", starting from a supply of
+// "startSupply". The first "startPeriodEpochsElapsed" epochs of "startPeriod"
+// have already minted and are part of "startSupply", so only the remaining
+// epochs of "startPeriod" are projected. The projection assumes inflation
+// stays enabled and every later epoch mints. Amounts are computed per epoch
+// and truncated the same way as the epoch hooks and
+// "Keeper.AllocatePolynomialInflation".
+func ProjectInflationSchedule(
+	params Params,
+	startPeriod uint64,
+	startPeriodEpochsElapsed uint64,
+	startSupply sdkmath.Int,
+) (projections []InflationPeriodProjection, totalMinted sdkmath.Int) {
+	params.InflationEnabled = true
+	totalMinted = sdkmath.ZeroInt()
+	dist := params.InflationDistribution
+
+	for period := startPeriod; period < params.MaxPeriod; period++ {
+		epochsInPeriod := params.EpochsPerPeriod
+		if period == startPeriod {
+			epochsInPeriod -= min(startPeriodEpochsElapsed, params.EpochsPerPeriod)
+		}
+		epochs := sdkmath.NewIntFromUint64(epochsInPeriod)
+
+		epochMintProvision := CalculateEpochMintProvision(params, period)
+		epochMinted := epochMintProvision.TruncateInt()
+		epochStaking := sdkmath.LegacyNewDecFromInt(epochMinted).Mul(dist.StakingRewards).TruncateInt()
+		epochCommunity := sdkmath.LegacyNewDecFromInt(epochMinted).Mul(dist.CommunityPool).TruncateInt()
+		epochStrategic := epochMinted.Sub(epochStaking).Sub(epochCommunity)
+		recipients := make([]sdkmath.Int, len(dist.Recipients))
+		for i, recipient := range dist.Recipients {
+			epochRecipient := sdkmath.LegacyNewDecFromInt(epochMinted).Mul(recipient.Weight).TruncateInt()
+			epochStrategic = epochStrategic.Sub(epochRecipient)
+			recipients[i] = epochRecipient.Mul(epochs)
+		}
+
+		minted := epochMinted.Mul(epochs)
+		totalMinted = totalMinted.Add(minted)
+		projections = append(projections, InflationPeriodProjection{
+			Period:             period,
+			EpochMintProvision: epochMintProvision,
+			Minted:             minted,
+			StakingRewards:     epochStaking.Mul(epochs),
+			CommunityPool:      epochCommunity.Mul(epochs),
+			StrategicReserves:  epochStrategic.Mul(epochs),
+			Recipients:         recipients,
+			CumulativeMinted:   totalMinted,
+			Supply:             startSupply.Add(totalMinted),
+		})
+	}
+	return projections, totalMinted
+}
@@ -92,6 +92,60 @@ func TestCalculateEpochMintProvision_ZeroEpochs(t *testing.T) {
 	require.Equal(t, epochMintProvisions, sdkmath.LegacyZeroDec())
 }
 
+func TestProjectInflationSchedule(t *testing.T) {
+	params := DefaultParams()
+	startSupply := sdkmath.NewInt(1_000)
+
+	projections, totalMinted := ProjectInflationSchedule(params, 0, 0, startSupply)
+	require.Len(t, projections, int(params.MaxPeriod))
+	require.NoError(t, withinRange(ExpectedTotalInflation, sdkmath.LegacyNewDecFromInt(totalMinted)))
+
+	cumulative := sdkmath.ZeroInt()
+	for i, p := range projections {
+		require.EqualValues(t, i, p.Period)
+		require.Equal(t, p.Minted, p.StakingRewards.Add(p.CommunityPool).Add(p.StrategicReserves))
+		cumulative = cumulative.Add(p.Minted)
+		require.Equal(t, cumulative, p.CumulativeMinted)
+		require.Equal(t, startSupply.Add(cumulative), p.Supply)
+	}
+	require.Equal(t, totalMinted, cumulative)
+
+	// Projection starts from the given period and ignores "InflationEnabled"
+	params.InflationEnabled = false
+	projections, _ = ProjectInflationSchedule(params, params.MaxPeriod-2, 0, startSupply)
+	require.Len(t, projections, 2)
+	require.True(t, projections[0].Minted.IsPositive())
+
+	projections, totalMinted = ProjectInflationSchedule(params, params.MaxPeriod, 0, startSupply)
+	require.Empty(t, projections)
+	require.True(t, totalMinted.IsZero())
+}
+
+func TestProjectInflationSchedule_EpochsElapsed(t *testing.T) {
+	params := DefaultParams()
+	startSupply := sdkmath.NewInt(1_000)
+	fullProjections, fullTotal := ProjectInflationSchedule(params, 0, 0, startSupply)
+
+	// The elapsed epochs of the start period already minted and are not
+	// projected again. The later periods are unchanged.
+	epochsElapsed := params.EpochsPerPeriod / 3
+	projections, totalMinted := ProjectInflationSchedule(params, 0, epochsElapsed, startSupply)
+	require.Len(t, projections, len(fullProjections))
+	epochMinted := projections[0].EpochMintProvision.TruncateInt()
+	wantMinted := epochMinted.MulRaw(int64(params.EpochsPerPeriod - epochsElapsed))
+	require.Equal(t, wantMinted, projections[0].Minted)
+	require.Equal(t, startSupply.Add(wantMinted), projections[0].Supply)
+	require.Equal(t, fullTotal.Sub(epochMinted.MulRaw(int64(epochsElapsed))), totalMinted)
+	for i := 1; i < len(projections); i++ {
+		require.Equal(t, fullProjections[i].Minted, projections[i].Minted)
+	}
+
+	// A start period that already fully minted projects nothing for it.
+	projections, _ = ProjectInflationSchedule(params, 0, params.EpochsPerPeriod+1, startSupply)
+	require.True(t, projections[0].Minted.IsZero())
+	require.Equal(t, startSupply, projections[0].Supply)
+}
+
 // withinRange returns an error if the actual value is not within the expected value +/- tolerance
 // tolerance is a percentage set to 0.01% by default
 func withinRange(expected, actual sdkmath.LegacyDec) error {
@@ -1,9 +1,14 @@
 package types // noalias
 
 import (
+	"context"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	epochstypes "github.com/NibiruChain/nibiru/v2/x/epochs/types"
+	"github.com/NibiruChain/nibiru/v2/x/evm"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -28,6 +33,7 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	HasSupply(ctx sdk.Context, denom string) bool
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper
@@ -47,3 +53,18 @@ type SudoKeeper interface {
 	GetRootAddr(ctx sdk.Context) (sdk.AccAddress, error)
 	CheckPermissions(contract sdk.AccAddress, ctx sdk.Context) error
 }
+
+// EvmKeeper defines the contract needed to credit EVM inflation recipients with
+// the ERC20 representation of the minted denom.
+type EvmKeeper interface {
+	ConvertCoinToEvm(
+		goCtx context.Context, msg *evm.MsgConvertCoinToEvm,
+	) (*evm.MsgConvertCoinToEvmResponse, error)
+	HasFunTokenForBankDenom(ctx sdk.Context, bankDenom string) bool
+}
+
+// EpochsKeeper defines the contract needed to read the epoch that drives
+// inflation.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, error)
+}
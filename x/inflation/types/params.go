@@ -101,6 +101,17 @@ func validateInflationDistribution(i any) error {
 	}
 
 	totalProportions := v.StakingRewards.Add(v.StrategicReserves).Add(v.CommunityPool)
+	seenRecipients := make(map[string]bool, len(v.Recipients))
+	for _, recipient := range v.Recipients {
+		if err := recipient.Validate(); err != nil {
+			return fmt.Errorf("invalid inflation recipient %q: %w", recipient.Address, err)
+		}
+		if seenRecipients[recipient.Address] {
+			return fmt.Errorf("duplicate inflation recipient %q", recipient.Address)
+		}
+		seenRecipients[recipient.Address] = true
+		totalProportions = totalProportions.Add(recipient.Weight)
+	}
 	if !totalProportions.Equal(sdkmath.LegacyOneDec()) {
 		return errors.New("total distributions ratio should be 1")
 	}
@@ -5,6 +5,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/NibiruChain/nibiru/v2/x/common/testutil"
 	inflationtypes "github.com/NibiruChain/nibiru/v2/x/inflation/types"
 
 	"github.com/stretchr/testify/require"
@@ -122,6 +123,104 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid - inflation distribution with recipients",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(1, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(1, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: testutil.AccAddress().String(), Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+						{Address: "0x000000000000000000000000000000000000dEaD", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+						{Address: "fee_collector", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				InflationEnabled:    true,
+				HasInflationStarted: true,
+				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:      inflationtypes.DefaultPeriodsPerYear,
+			},
+			false,
+		},
+		{
+			"invalid - inflation distribution - recipients make total unequal 1",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    inflationtypes.DefaultInflationDistribution.StakingRewards,
+					CommunityPool:     inflationtypes.DefaultInflationDistribution.CommunityPool,
+					StrategicReserves: inflationtypes.DefaultInflationDistribution.StrategicReserves,
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: "fee_collector", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				InflationEnabled:    true,
+				HasInflationStarted: true,
+				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:      inflationtypes.DefaultPeriodsPerYear,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - malformed recipient address",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(2, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(2, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: "0xnot-hex", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				InflationEnabled:    true,
+				HasInflationStarted: true,
+				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:      inflationtypes.DefaultPeriodsPerYear,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - module recipient not allowed",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(2, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(2, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: "bonded_tokens_pool", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				InflationEnabled:    true,
+				HasInflationStarted: true,
+				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:      inflationtypes.DefaultPeriodsPerYear,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - duplicate recipient",
+			inflationtypes.Params{
+				PolynomialFactors: inflationtypes.DefaultPolynomialFactors,
+				InflationDistribution: inflationtypes.InflationDistribution{
+					StakingRewards:    sdkmath.LegacyNewDecWithPrec(5, 1),
+					CommunityPool:     sdkmath.LegacyNewDecWithPrec(2, 1),
+					StrategicReserves: sdkmath.LegacyNewDecWithPrec(1, 1),
+					Recipients: []inflationtypes.InflationRecipient{
+						{Address: "fee_collector", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+						{Address: "fee_collector", Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+				},
+				InflationEnabled:    true,
+				HasInflationStarted: true,
+				EpochsPerPeriod:     inflationtypes.DefaultEpochsPerPeriod,
+				PeriodsPerYear:      inflationtypes.DefaultPeriodsPerYear,
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
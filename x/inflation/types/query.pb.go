@@ -532,6 +532,204 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryInflationScheduleRequest is the request type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleRequest struct {
+	// params: Candidate parameters to project the schedule with, such as those
+	// of a pending "MsgEditInflationParams". If unset, the current module
+	// parameters are used.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryInflationScheduleRequest) Reset()         { *m = QueryInflationScheduleRequest{} }
+func (m *QueryInflationScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleRequest) ProtoMessage()    {}
+func (*QueryInflationScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{12}
+}
+func (m *QueryInflationScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleRequest.Merge(m, src)
+}
+func (m *QueryInflationScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryInflationScheduleRequest) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// QueryInflationScheduleResponse is the response type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleResponse struct {
+	// params: The parameters used for the projection.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// current_period: The inflation period the projection starts from.
+	CurrentPeriod uint64 `protobuf:"varint,2,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"`
+	// current_supply: Circulating supply of the mint denom when the query was
+	// made, the same amount as the "CirculatingSupply" query.
+	CurrentSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=current_supply,json=currentSupply,proto3,customtype=cosmossdk.io/math.Int" json:"current_supply"`
+	// periods: Projection for each period from "current_period" up to
+	// "max_period".
+	Periods []InflationPeriodProjection `protobuf:"bytes,4,rep,name=periods,proto3" json:"periods"`
+	// total_minted: Sum of the amounts minted across all projected periods.
+	TotalMinted cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Int" json:"total_minted"`
+	// current_period_epochs_elapsed: Number of epochs of "current_period" that
+	// have already minted. Their provisions are part of "current_supply" and
+	// are not projected again.
+	CurrentPeriodEpochsElapsed uint64 `protobuf:"varint,6,opt,name=current_period_epochs_elapsed,json=currentPeriodEpochsElapsed,proto3" json:"current_period_epochs_elapsed,omitempty"`
+}
+
+func (m *QueryInflationScheduleResponse) Reset()         { *m = QueryInflationScheduleResponse{} }
+func (m *QueryInflationScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleResponse) ProtoMessage()    {}
+func (*QueryInflationScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{13}
+}
+func (m *QueryInflationScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleResponse.Merge(m, src)
+}
+func (m *QueryInflationScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryInflationScheduleResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryInflationScheduleResponse) GetCurrentPeriod() uint64 {
+	if m != nil {
+		return m.CurrentPeriod
+	}
+	return 0
+}
+
+func (m *QueryInflationScheduleResponse) GetPeriods() []InflationPeriodProjection {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *QueryInflationScheduleResponse) GetCurrentPeriodEpochsElapsed() uint64 {
+	if m != nil {
+		return m.CurrentPeriodEpochsElapsed
+	}
+	return 0
+}
+
+// InflationPeriodProjection: Projected amounts minted and distributed during a
+// single inflation period. Amounts are in the base denomination (unibi).
+type InflationPeriodProjection struct {
+	// period: The inflation period.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// epoch_mint_provision: Amount minted at the end of each epoch in the
+	// period.
+	EpochMintProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=epoch_mint_provision,json=epochMintProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_mint_provision"`
+	// minted: Total amount minted during the period. For the current period,
+	// only the epochs that have not elapsed yet are counted.
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// staking_rewards: Portion of "minted" sent to the fee collector.
+	StakingRewards cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=cosmossdk.io/math.Int" json:"staking_rewards"`
+	// community_pool: Portion of "minted" sent to the community pool.
+	CommunityPool cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.Int" json:"community_pool"`
+	// strategic_reserves: Portion of "minted" sent to the strategic reserve.
+	StrategicReserves cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=strategic_reserves,json=strategicReserves,proto3,customtype=cosmossdk.io/math.Int" json:"strategic_reserves"`
+	// recipients: Portion of "minted" sent to the additional inflation
+	// recipients, in the order of "InflationDistribution.recipients".
+	Recipients []cosmossdk_io_math.Int `protobuf:"bytes,9,rep,name=recipients,proto3,customtype=cosmossdk.io/math.Int" json:"recipients"`
+	// cumulative_minted: Sum of "minted" over this and all earlier projected
+	// periods.
+	CumulativeMinted cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=cumulative_minted,json=cumulativeMinted,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_minted"`
+	// supply: Projected supply of the mint denom at the end of the period.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+}
+
+func (m *InflationPeriodProjection) Reset()         { *m = InflationPeriodProjection{} }
+func (m *InflationPeriodProjection) String() string { return proto.CompactTextString(m) }
+func (*InflationPeriodProjection) ProtoMessage()    {}
+func (*InflationPeriodProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cef9ea5e4d20e5e, []int{14}
+}
+func (m *InflationPeriodProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationPeriodProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationPeriodProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationPeriodProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationPeriodProjection.Merge(m, src)
+}
+func (m *InflationPeriodProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationPeriodProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationPeriodProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationPeriodProjection proto.InternalMessageInfo
+
+func (m *InflationPeriodProjection) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryPeriodRequest)(nil), "nibiru.inflation.v1.QueryPeriodRequest")
 	proto.RegisterType((*QueryPeriodResponse)(nil), "nibiru.inflation.v1.QueryPeriodResponse")
@@ -545,58 +743,81 @@ func init() {
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "nibiru.inflation.v1.QueryInflationRateResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.inflation.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryInflationScheduleRequest)(nil), "nibiru.inflation.v1.QueryInflationScheduleRequest")
+	proto.RegisterType((*QueryInflationScheduleResponse)(nil), "nibiru.inflation.v1.QueryInflationScheduleResponse")
+	proto.RegisterType((*InflationPeriodProjection)(nil), "nibiru.inflation.v1.InflationPeriodProjection")
 }
 
 func init() { proto.RegisterFile("nibiru/inflation/v1/query.proto", fileDescriptor_9cef9ea5e4d20e5e) }
 
 var fileDescriptor_9cef9ea5e4d20e5e = []byte{
-	// 721 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x4e, 0x13, 0x41,
-	0x1c, 0xc7, 0xbb, 0x06, 0x6b, 0x1c, 0x03, 0x09, 0x03, 0x31, 0xb2, 0x85, 0x2d, 0x96, 0x10, 0x20,
-	0x84, 0x9d, 0xb4, 0xf5, 0xe2, 0x95, 0xe2, 0xc1, 0x04, 0x0d, 0x96, 0x8b, 0xf1, 0xd2, 0x4c, 0xb7,
-	0xe3, 0x76, 0x02, 0x9d, 0x59, 0x76, 0xa6, 0x8d, 0xbd, 0x19, 0x7d, 0x01, 0x13, 0xcf, 0x9e, 0x3c,
-	0x98, 0x98, 0x78, 0x21, 0x3e, 0x04, 0x47, 0xa2, 0x17, 0xe3, 0x01, 0x0d, 0xf8, 0x20, 0x66, 0x67,
-	0x66, 0x4b, 0x4b, 0xa7, 0xad, 0x1c, 0x3c, 0x75, 0x77, 0x7e, 0xff, 0xbe, 0xf3, 0xdd, 0xf9, 0x4c,
-	0x41, 0x9e, 0xd1, 0x3a, 0x8d, 0xdb, 0x88, 0xb2, 0x97, 0x87, 0x58, 0x52, 0xce, 0x50, 0xa7, 0x88,
-	0x8e, 0xda, 0x24, 0xee, 0xfa, 0x51, 0xcc, 0x25, 0x87, 0x73, 0x3a, 0xc1, 0xef, 0x25, 0xf8, 0x9d,
-	0xa2, 0xeb, 0x05, 0x5c, 0xb4, 0xb8, 0x40, 0x75, 0x2c, 0x08, 0xea, 0x14, 0xeb, 0x44, 0xe2, 0x22,
-	0x0a, 0x38, 0x65, 0xba, 0xc8, 0xbd, 0x6f, 0xeb, 0x1a, 0x12, 0x46, 0x04, 0x15, 0x26, 0x65, 0x3e,
-	0xe4, 0x21, 0x57, 0x8f, 0x28, 0x79, 0x32, 0xab, 0x8b, 0x21, 0xe7, 0xe1, 0x21, 0x41, 0x38, 0xa2,
-	0x08, 0x33, 0xc6, 0xa5, 0xaa, 0x4e, 0x6b, 0x16, 0xf4, 0xd8, 0x9a, 0x2e, 0xd3, 0x2f, 0x3a, 0x54,
-	0x98, 0x07, 0xf0, 0x59, 0xa2, 0x7a, 0x8f, 0xc4, 0x94, 0x37, 0xaa, 0xe4, 0xa8, 0x4d, 0x84, 0x2c,
-	0x6c, 0x81, 0xb9, 0x81, 0x55, 0x11, 0x71, 0x26, 0x08, 0xbc, 0x0b, 0xb2, 0x91, 0x5a, 0xb9, 0xe7,
-	0x2c, 0x3b, 0xeb, 0x53, 0x55, 0xf3, 0x56, 0x58, 0x06, 0x9e, 0x4a, 0x7f, 0x14, 0xf1, 0xa0, 0xf9,
-	0x84, 0x32, 0xb9, 0x17, 0xf3, 0x0e, 0x15, 0x94, 0xb3, 0xb4, 0xe1, 0x27, 0x07, 0xe4, 0x47, 0xa6,
-	0x98, 0xee, 0x6f, 0x1d, 0x30, 0x4f, 0x92, 0x70, 0xad, 0x45, 0x99, 0x4c, 0xc4, 0xea, 0x04, 0x35,
-	0xec, 0x4e, 0x69, 0xd1, 0x37, 0xc2, 0x13, 0xf3, 0x7c, 0x63, 0x9e, 0xbf, 0x43, 0x82, 0x0a, 0xa7,
-	0x6c, 0xbb, 0x7c, 0x72, 0x96, 0xcf, 0x7c, 0xfe, 0x95, 0xdf, 0x0c, 0xa9, 0x6c, 0xb6, 0xeb, 0x7e,
-	0xc0, 0x5b, 0x66, 0xa3, 0xe6, 0x67, 0x4b, 0x34, 0x0e, 0x90, 0xec, 0x46, 0x44, 0xa4, 0x35, 0xa2,
-	0x0a, 0xc9, 0x90, 0x9a, 0x42, 0x0e, 0x2c, 0x28, 0xa1, 0xfb, 0x07, 0x34, 0x8a, 0x48, 0x43, 0xe9,
-	0x15, 0xe9, 0x36, 0x2a, 0xc0, 0xb5, 0x05, 0xcd, 0x06, 0x56, 0xc1, 0x8c, 0xd0, 0x81, 0x9a, 0x6a,
-	0x2c, 0x8c, 0x4d, 0xd3, 0xa2, 0x3f, 0xbd, 0x90, 0x07, 0x4b, 0xaa, 0x49, 0x85, 0xc6, 0x41, 0x3b,
-	0xf9, 0xcc, 0x2c, 0xdc, 0x6f, 0x47, 0xd1, 0x61, 0x37, 0x9d, 0xf2, 0xd1, 0x31, 0x7e, 0x5a, 0x32,
-	0xcc, 0xa8, 0xd7, 0x0e, 0x80, 0xc1, 0x65, 0xb4, 0x26, 0x54, 0xf8, 0xff, 0x39, 0x35, 0x1b, 0x5c,
-	0x95, 0xd2, 0x33, 0xea, 0x71, 0x7a, 0x56, 0xab, 0x58, 0x92, 0x74, 0x0b, 0x1d, 0x63, 0xd4, 0x95,
-	0xa0, 0x51, 0xff, 0x1c, 0xcc, 0xf4, 0x4e, 0x78, 0x2d, 0xc6, 0x92, 0x28, 0xe1, 0xb7, 0xb7, 0x8b,
-	0x89, 0xb4, 0x9f, 0x67, 0xf9, 0x9c, 0x16, 0x22, 0x1a, 0x07, 0x3e, 0xe5, 0xa8, 0x85, 0x65, 0xd3,
-	0xdf, 0x25, 0x21, 0x0e, 0xba, 0x3b, 0x24, 0xf8, 0xf6, 0x75, 0x0b, 0x98, 0xed, 0xed, 0x90, 0xa0,
-	0x3a, 0x4d, 0xfb, 0x27, 0x5c, 0x1e, 0x67, 0x1c, 0xe3, 0x56, 0xef, 0xb3, 0xed, 0xa5, 0xc7, 0xd9,
-	0xac, 0x1a, 0x19, 0x0f, 0x41, 0x36, 0x52, 0x2b, 0xc6, 0xb7, 0x9c, 0x6f, 0x61, 0xd6, 0xd7, 0x45,
-	0xdb, 0x53, 0x89, 0xb6, 0xaa, 0x29, 0x28, 0x1d, 0xdf, 0x02, 0x37, 0x55, 0xcb, 0xe4, 0x4b, 0x64,
-	0x35, 0x26, 0x70, 0xcd, 0x5a, 0x3f, 0x8c, 0x97, 0xbb, 0x3e, 0x39, 0x51, 0x4b, 0x2c, 0xac, 0xbc,
-	0xf9, 0xfe, 0xe7, 0xfd, 0x8d, 0x25, 0x98, 0x43, 0xb6, 0x9b, 0x41, 0xe3, 0x07, 0x8f, 0x1d, 0x00,
-	0x87, 0xb9, 0x82, 0xe5, 0xd1, 0x53, 0x46, 0x82, 0xea, 0x3e, 0xb8, 0x5e, 0x91, 0x91, 0x59, 0x54,
-	0x32, 0x37, 0xe1, 0x86, 0x55, 0xa6, 0x0d, 0x6a, 0xf8, 0xc1, 0x01, 0xd3, 0x03, 0x18, 0x41, 0x7f,
-	0xf4, 0x68, 0x1b, 0x8c, 0x2e, 0xfa, 0xe7, 0x7c, 0xa3, 0x72, 0x53, 0xa9, 0x5c, 0x85, 0x2b, 0x56,
-	0x95, 0x83, 0xe8, 0xc2, 0x2f, 0x0e, 0x98, 0x1d, 0xe2, 0x0f, 0x96, 0x46, 0xcf, 0x1c, 0x85, 0xb3,
-	0x5b, 0xbe, 0x56, 0x8d, 0xd1, 0x8a, 0x94, 0xd6, 0x0d, 0xb8, 0x66, 0xd5, 0x3a, 0x8c, 0xbe, 0xf2,
-	0x73, 0x80, 0xb6, 0x71, 0x7e, 0xda, 0x98, 0x1d, 0xe7, 0xa7, 0x15, 0xe3, 0x09, 0x7e, 0x0e, 0x12,
-	0xae, 0x39, 0x51, 0xf0, 0x8c, 0xe5, 0xa4, 0x9f, 0xdb, 0xb1, 0x9c, 0x0c, 0xa0, 0x3c, 0x89, 0x13,
-	0x8d, 0xf0, 0xee, 0xc9, 0xb9, 0xe7, 0x9c, 0x9e, 0x7b, 0xce, 0xef, 0x73, 0xcf, 0x79, 0x77, 0xe1,
-	0x65, 0x4e, 0x2f, 0xbc, 0xcc, 0x8f, 0x0b, 0x2f, 0xf3, 0xa2, 0xd4, 0x77, 0x17, 0x3e, 0x55, 0x0d,
-	0x2a, 0x4d, 0x4c, 0x59, 0xda, 0xac, 0x53, 0x42, 0xaf, 0xfa, 0x3a, 0xaa, 0xbb, 0xb1, 0x9e, 0x55,
-	0x7f, 0xa0, 0xe5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x5d, 0x2c, 0xa8, 0x0a, 0x08, 0x00,
-	0x00,
+	// 1054 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x36, 0x89, 0xdb, 0xbe, 0x90, 0x80, 0x27, 0x01, 0x39, 0x9b, 0x7a, 0x1d, 0x5c, 0x45,
+	0x4d, 0x15, 0x65, 0x57, 0xb6, 0xb9, 0x70, 0x24, 0x4e, 0x0f, 0x11, 0x6d, 0x64, 0x9c, 0x1e, 0xaa,
+	0x5e, 0xac, 0xf5, 0x78, 0xb0, 0x87, 0xd8, 0x33, 0xdb, 0x9d, 0x59, 0x83, 0x6f, 0x08, 0xbe, 0x00,
+	0x12, 0x67, 0x4e, 0x3d, 0x20, 0x21, 0x71, 0x41, 0x5c, 0xf8, 0x06, 0xbd, 0x51, 0xc1, 0xa5, 0xe2,
+	0x50, 0x50, 0xc2, 0x07, 0x41, 0x3b, 0x33, 0xeb, 0xd8, 0x78, 0x37, 0x8d, 0x83, 0x38, 0xc5, 0x9e,
+	0x79, 0xef, 0xf7, 0x7e, 0xf3, 0xfe, 0xf9, 0x17, 0x28, 0x31, 0xda, 0xa6, 0x61, 0xe4, 0x51, 0xf6,
+	0x69, 0xdf, 0x97, 0x94, 0x33, 0x6f, 0x58, 0xf1, 0x9e, 0x45, 0x24, 0x1c, 0xb9, 0x41, 0xc8, 0x25,
+	0x47, 0xeb, 0xda, 0xc0, 0x1d, 0x1b, 0xb8, 0xc3, 0x8a, 0xed, 0x60, 0x2e, 0x06, 0x5c, 0x78, 0x6d,
+	0x5f, 0x10, 0x6f, 0x58, 0x69, 0x13, 0xe9, 0x57, 0x3c, 0xcc, 0x29, 0xd3, 0x4e, 0xf6, 0xfb, 0x69,
+	0xa8, 0x5d, 0xc2, 0x88, 0xa0, 0xc2, 0x98, 0x6c, 0x74, 0x79, 0x97, 0xab, 0x8f, 0x5e, 0xfc, 0xc9,
+	0x9c, 0xde, 0xe9, 0x72, 0xde, 0xed, 0x13, 0xcf, 0x0f, 0xa8, 0xe7, 0x33, 0xc6, 0xa5, 0xf2, 0x4e,
+	0x7c, 0x36, 0x75, 0xd8, 0x96, 0x76, 0xd3, 0x5f, 0xf4, 0x55, 0x79, 0x03, 0xd0, 0x27, 0x31, 0xeb,
+	0x06, 0x09, 0x29, 0xef, 0x34, 0xc9, 0xb3, 0x88, 0x08, 0x59, 0xde, 0x87, 0xf5, 0xa9, 0x53, 0x11,
+	0x70, 0x26, 0x08, 0x7a, 0x0f, 0x72, 0x81, 0x3a, 0x29, 0x58, 0xdb, 0xd6, 0xee, 0x52, 0xd3, 0x7c,
+	0x2b, 0x6f, 0x83, 0xa3, 0xcc, 0x1f, 0x04, 0x1c, 0xf7, 0x1e, 0x51, 0x26, 0x1b, 0x21, 0x1f, 0x52,
+	0x41, 0x39, 0x4b, 0x00, 0xbf, 0xb7, 0xa0, 0x94, 0x69, 0x62, 0xd0, 0xbf, 0xb6, 0x60, 0x83, 0xc4,
+	0xd7, 0xad, 0x01, 0x65, 0xb2, 0x15, 0x24, 0x06, 0x2a, 0xd8, 0x4a, 0xf5, 0x8e, 0x6b, 0x88, 0xc7,
+	0xc9, 0x73, 0x4d, 0xf2, 0xdc, 0x43, 0x82, 0xeb, 0x9c, 0xb2, 0x83, 0xda, 0x8b, 0xd7, 0xa5, 0x85,
+	0x1f, 0xfe, 0x2c, 0xed, 0x75, 0xa9, 0xec, 0x45, 0x6d, 0x17, 0xf3, 0x81, 0x79, 0xa8, 0xf9, 0xb3,
+	0x2f, 0x3a, 0xa7, 0x9e, 0x1c, 0x05, 0x44, 0x24, 0x3e, 0xa2, 0x89, 0xc8, 0x0c, 0x9b, 0xf2, 0x16,
+	0x6c, 0x2a, 0xa2, 0x27, 0xa7, 0x34, 0x08, 0x48, 0x47, 0xf1, 0x15, 0xc9, 0x33, 0xea, 0x60, 0xa7,
+	0x5d, 0x9a, 0x07, 0xec, 0xc0, 0x9a, 0xd0, 0x17, 0x2d, 0x05, 0x2c, 0x4c, 0x9a, 0x56, 0xc5, 0xa4,
+	0x79, 0xb9, 0x04, 0x45, 0x05, 0x52, 0xa7, 0x21, 0x8e, 0xe2, 0x32, 0xb3, 0xee, 0x49, 0x14, 0x04,
+	0xfd, 0x51, 0x12, 0xe5, 0xb9, 0x05, 0x4e, 0x96, 0x85, 0x09, 0xf5, 0xa5, 0x05, 0x08, 0x5f, 0xdc,
+	0xb6, 0x84, 0xba, 0xfe, 0xff, 0x32, 0x95, 0xc7, 0xff, 0xa6, 0x32, 0x4e, 0xd4, 0x51, 0xd2, 0xab,
+	0x4d, 0x5f, 0x92, 0xe4, 0x09, 0x43, 0xb0, 0xd3, 0x2e, 0x0d, 0xfb, 0x27, 0xb0, 0x36, 0xee, 0xf0,
+	0x56, 0xe8, 0x4b, 0xa2, 0x88, 0xdf, 0x3e, 0xa8, 0xc4, 0xd4, 0xfe, 0x78, 0x5d, 0xda, 0xd2, 0x44,
+	0x44, 0xe7, 0xd4, 0xa5, 0xdc, 0x1b, 0xf8, 0xb2, 0xe7, 0x3e, 0x24, 0x5d, 0x1f, 0x8f, 0x0e, 0x09,
+	0xfe, 0xed, 0xe7, 0x7d, 0x30, 0xcf, 0x3b, 0x24, 0xb8, 0xb9, 0x4a, 0x27, 0x23, 0x5c, 0xb4, 0xb3,
+	0x1f, 0xfa, 0x83, 0x71, 0xd9, 0x1a, 0xb0, 0x3e, 0x75, 0x6a, 0x68, 0x7c, 0x08, 0xb9, 0x40, 0x9d,
+	0x98, 0xbc, 0x6d, 0xb9, 0x29, 0x33, 0xeb, 0x6a, 0xa7, 0x83, 0xa5, 0x98, 0x5b, 0xd3, 0x38, 0x94,
+	0x1f, 0x43, 0x71, 0xfa, 0x7d, 0x27, 0xb8, 0x47, 0x3a, 0x51, 0x3f, 0x49, 0x00, 0xaa, 0xcd, 0x81,
+	0x3d, 0x46, 0xfd, 0x65, 0x11, 0x9c, 0x2c, 0xd8, 0xff, 0xcc, 0x39, 0x6e, 0x4f, 0x1c, 0x85, 0x21,
+	0x89, 0x67, 0x4b, 0x4f, 0xf1, 0x0d, 0xdd, 0x9e, 0xe6, 0x54, 0x0f, 0x3b, 0x6a, 0x5e, 0x98, 0x99,
+	0xae, 0x5a, 0x54, 0xc5, 0xd9, 0x33, 0xc5, 0x79, 0x77, 0xb6, 0x38, 0x47, 0x4c, 0x4e, 0x94, 0xe5,
+	0x88, 0xc9, 0x31, 0xa6, 0xee, 0x15, 0x74, 0x0c, 0x37, 0x75, 0x48, 0x51, 0x58, 0xda, 0x5e, 0xdc,
+	0x5d, 0xa9, 0xba, 0xa9, 0xb4, 0xc7, 0xcf, 0xd6, 0x54, 0x1a, 0x21, 0xff, 0x8c, 0xe0, 0xf8, 0xbb,
+	0x79, 0x49, 0x02, 0x82, 0x8e, 0xe1, 0x2d, 0xc9, 0xa5, 0xdf, 0x57, 0x9b, 0x82, 0x74, 0x0a, 0xcb,
+	0xf3, 0x33, 0x5c, 0x51, 0x00, 0x8f, 0x94, 0x3f, 0xfa, 0x08, 0x8a, 0xd3, 0xa9, 0x31, 0x03, 0xdc,
+	0x22, 0x7d, 0x3f, 0x10, 0xa4, 0x53, 0xc8, 0xa9, 0x4c, 0xd9, 0x53, 0x99, 0xd2, 0xe3, 0xfc, 0x40,
+	0x5b, 0x94, 0x7f, 0x5d, 0x86, 0xcd, 0x4c, 0xfe, 0x59, 0x9b, 0x13, 0xe1, 0x8c, 0x95, 0x77, 0xe3,
+	0xba, 0xf3, 0x90, 0xb2, 0xd2, 0x50, 0x1d, 0x72, 0x26, 0x4f, 0xd7, 0xa8, 0xa4, 0x71, 0x45, 0x8f,
+	0xe1, 0x6d, 0x21, 0xfd, 0xd3, 0x78, 0xd9, 0x84, 0xe4, 0x73, 0x3f, 0x54, 0xa5, 0x9c, 0x1b, 0x6d,
+	0xcd, 0x60, 0x34, 0x35, 0x84, 0x6a, 0x36, 0x3e, 0x18, 0x44, 0x8c, 0xca, 0x51, 0x2b, 0xe0, 0xbc,
+	0x7f, 0x9d, 0x52, 0xae, 0x8e, 0x21, 0x1a, 0x9c, 0xf7, 0xd1, 0x53, 0x40, 0x42, 0xc6, 0x5b, 0xa5,
+	0x4b, 0x71, 0x2b, 0x24, 0x82, 0x84, 0x43, 0x22, 0x0a, 0xb9, 0xf9, 0x71, 0xf3, 0x63, 0x98, 0xa6,
+	0x41, 0x41, 0x1f, 0x03, 0x84, 0x04, 0xd3, 0x80, 0x12, 0x26, 0x45, 0xe1, 0xf6, 0xf6, 0xe2, 0xbc,
+	0x98, 0x13, 0xee, 0xe8, 0x09, 0xe4, 0x71, 0x34, 0x50, 0x5b, 0x75, 0x48, 0x92, 0x56, 0xbe, 0x39,
+	0x3f, 0xcf, 0x77, 0x2e, 0x50, 0x4c, 0x3f, 0xd7, 0x21, 0x67, 0x66, 0xf7, 0xd6, 0x35, 0x2a, 0xae,
+	0x5d, 0xab, 0xaf, 0x6e, 0xc1, 0xb2, 0xda, 0x46, 0xf1, 0xaf, 0x4d, 0xce, 0x6c, 0x87, 0x7b, 0xa9,
+	0x83, 0x3b, 0x2b, 0x21, 0xec, 0xdd, 0x37, 0x1b, 0xea, 0x95, 0x56, 0xbe, 0xfb, 0xd5, 0xef, 0x7f,
+	0x7f, 0x7b, 0xa3, 0x88, 0xb6, 0xbc, 0x34, 0xf5, 0x63, 0x06, 0xe5, 0x27, 0x0b, 0xd0, 0xac, 0x76,
+	0x40, 0xb5, 0xec, 0x28, 0x99, 0x62, 0xc4, 0xfe, 0x60, 0x3e, 0x27, 0x43, 0xb3, 0xa2, 0x68, 0xee,
+	0xa1, 0xfb, 0xa9, 0x34, 0xd3, 0xa6, 0x18, 0x7d, 0x67, 0xc1, 0xea, 0x94, 0x54, 0x40, 0x6e, 0x76,
+	0xe8, 0x34, 0xc1, 0x61, 0x7b, 0x57, 0xb6, 0x37, 0x2c, 0xf7, 0x14, 0xcb, 0x1d, 0x74, 0x37, 0x95,
+	0xe5, 0xb4, 0x3c, 0x41, 0x3f, 0x5a, 0x90, 0x9f, 0xd1, 0x18, 0xa8, 0x9a, 0x1d, 0x33, 0x4b, 0xb2,
+	0xd8, 0xb5, 0xb9, 0x7c, 0x0c, 0x57, 0x4f, 0x71, 0xbd, 0x8f, 0xee, 0xa5, 0x72, 0x9d, 0x95, 0x37,
+	0x2a, 0x9f, 0x53, 0x8a, 0xe2, 0xb2, 0x7c, 0xa6, 0xe9, 0x12, 0xdb, 0xbb, 0xb2, 0xfd, 0x95, 0xf2,
+	0x39, 0xad, 0x62, 0xf4, 0x9c, 0xe8, 0x1f, 0xdb, 0xcb, 0xe6, 0x64, 0x52, 0x9b, 0xd8, 0xbb, 0x6f,
+	0x36, 0xbc, 0xda, 0x9c, 0xe8, 0xb8, 0xcf, 0x2d, 0xc8, 0xcf, 0xa8, 0x87, 0xcb, 0x4a, 0x9a, 0xa5,
+	0x60, 0xec, 0xda, 0x5c, 0x3e, 0x86, 0xe3, 0x8e, 0xe2, 0x58, 0x42, 0xc5, 0xf4, 0xf6, 0x33, 0xe6,
+	0x07, 0x0f, 0x5f, 0x9c, 0x39, 0xd6, 0xcb, 0x33, 0xc7, 0xfa, 0xeb, 0xcc, 0xb1, 0xbe, 0x39, 0x77,
+	0x16, 0x5e, 0x9e, 0x3b, 0x0b, 0xaf, 0xce, 0x9d, 0x85, 0xa7, 0xd5, 0x09, 0x55, 0x7a, 0xac, 0x20,
+	0xea, 0x3d, 0x9f, 0xb2, 0x04, 0x6e, 0x58, 0xf5, 0xbe, 0x98, 0xc0, 0x54, 0x2a, 0xb5, 0x9d, 0x53,
+	0xff, 0xca, 0xd4, 0xfe, 0x19, 0x00, 0x07, 0x3e, 0xc6, 0xee, 0x94, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -624,6 +845,10 @@ type QueryClient interface {
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// InflationSchedule projects the amounts minted and distributed in each
+	// remaining inflation period, using either the current parameters or
+	// candidate parameters supplied in the request.
+	InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -688,6 +913,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error) {
+	out := new(QueryInflationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.inflation.v1.Query/InflationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Period retrieves current period.
@@ -703,6 +937,10 @@ type QueryServer interface {
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// InflationSchedule projects the amounts minted and distributed in each
+	// remaining inflation period, using either the current parameters or
+	// candidate parameters supplied in the request.
+	InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -727,6 +965,9 @@ func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryIn
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) InflationSchedule(ctx context.Context, req *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -840,6 +1081,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.inflation.v1.Query/InflationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationSchedule(ctx, req.(*QueryInflationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.inflation.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -868,6 +1127,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "InflationSchedule",
+			Handler:    _Query_InflationSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/inflation/v1/query.proto",
@@ -1199,110 +1462,334 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryInflationScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPeriodRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryInflationScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInflationScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Period != 0 {
-		n += 1 + sovQuery(uint64(m.Period))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochMintProvisionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInflationScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEpochMintProvisionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.EpochMintProvision.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryInflationScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySkippedEpochsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInflationScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QuerySkippedEpochsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CurrentPeriodEpochsElapsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPeriodEpochsElapsed))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.SkippedEpochs != 0 {
-		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.CurrentSupply.Size()
+		i -= size
+		if _, err := m.CurrentSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCirculatingSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InflationPeriodProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCirculatingSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CirculatingSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *InflationPeriodProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInflationRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *InflationPeriodProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryInflationRateResponse) Size() (n int) {
-	if m == nil {
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Recipients[iNdEx].Size()
+				i -= size
+				if _, err := m.Recipients[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CumulativeMinted.Size()
+		i -= size
+		if _, err := m.CumulativeMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.StrategicReserves.Size()
+		i -= size
+		if _, err := m.StrategicReserves.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.StakingRewards.Size()
+		i -= size
+		if _, err := m.StakingRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EpochMintProvision.Size()
+		i -= size
+		if _, err := m.EpochMintProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *QueryEpochMintProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochMintProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySkippedEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySkippedEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SkippedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	}
+	return n
+}
+
+func (m *QueryCirculatingSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculatingSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationRateResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1332,6 +1819,78 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInflationScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CurrentPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPeriod))
+	}
+	l = m.CurrentSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CurrentPeriodEpochsElapsed != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPeriodEpochsElapsed))
+	}
+	return n
+}
+
+func (m *InflationPeriodProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StrategicReserves.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2109,6 +2668,658 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInflationScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriod", wireType)
+			}
+			m.CurrentPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, InflationPeriodProjection{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriodEpochsElapsed", wireType)
+			}
+			m.CurrentPeriodEpochsElapsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPeriodEpochsElapsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationPeriodProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationPeriodProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationPeriodProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserves", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrategicReserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Recipients = append(m.Recipients, v)
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/x/common/set"
+)
+
+// RecipientKind: The way an [InflationRecipient] is credited, determined by the
+// format of its address.
+type RecipientKind int
+
+const (
+	// RecipientKindAccount: A bech32 account address credited with a bank send.
+	RecipientKindAccount RecipientKind = iota
+	// RecipientKindModule: A module account name credited with a
+	// module-to-module send.
+	RecipientKindModule
+	// RecipientKindEvm: A hex EVM address credited with the ERC20
+	// representation of the minted coins through the FunToken mapping.
+	RecipientKindEvm
+)
+
+var moduleNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+
+// AllowedModuleRecipients: Module accounts that can receive inflation. Other
+// module accounts, such as the staking pools or the EVM escrow, track their
+// balances in module state, and crediting them directly would break their
+// accounting invariants.
+var AllowedModuleRecipients = set.New[string](
+	authtypes.FeeCollectorName,
+)
+
+// Kind returns how the recipient is credited based on the format of its
+// address. Hex addresses are EVM recipients, valid bech32 addresses are
+// accounts, and anything else is treated as a module account name.
+func (r InflationRecipient) Kind() RecipientKind {
+	switch {
+	case strings.HasPrefix(r.Address, "0x"):
+		return RecipientKindEvm
+	case isBech32(r.Address):
+		return RecipientKindAccount
+	default:
+		return RecipientKindModule
+	}
+}
+
+// Validate checks the format of the recipient address, that module recipients
+// are in [AllowedModuleRecipients], and that the weight is positive. Whether
+// an address is blocked from receiving funds can only be checked against
+// state.
+func (r InflationRecipient) Validate() error {
+	if r.Weight.IsNil() || !r.Weight.IsPositive() {
+		return errors.New("weight must be positive")
+	}
+	switch r.Kind() {
+	case RecipientKindEvm:
+		if !gethcommon.IsHexAddress(r.Address) {
+			return fmt.Errorf("invalid hex address %q", r.Address)
+		}
+	case RecipientKindModule:
+		if !moduleNameRegex.MatchString(r.Address) {
+			return fmt.Errorf(
+				"address %q is neither a bech32 address, a hex address, nor a module name",
+				r.Address,
+			)
+		}
+		if !AllowedModuleRecipients.Has(r.Address) {
+			return fmt.Errorf(
+				"module account %q cannot receive inflation, allowed modules: %v",
+				r.Address, AllowedModuleRecipients.ToSlice(),
+			)
+		}
+	}
+	return nil
+}
+
+// AccAddress returns the account credited by an account or EVM recipient, and
+// nil for module recipients.
+func (r InflationRecipient) AccAddress() sdk.AccAddress {
+	switch r.Kind() {
+	case RecipientKindEvm:
+		return gethcommon.HexToAddress(r.Address).Bytes()
+	case RecipientKindAccount:
+		addr, _ := sdk.AccAddressFromBech32(r.Address)
+		return addr
+	default:
+		return nil
+	}
+}
+
+func isBech32(addr string) bool {
+	_, err := sdk.AccAddressFromBech32(addr)
+	return err == nil
+}
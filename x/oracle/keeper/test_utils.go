@@ -234,7 +234,7 @@ func CreateTestFixture(t *testing.T) TestFixture {
 
 	bankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(denoms.NIBI, InitTokens.MulRaw(int64(len(Addrs))))))
 
-	sudoKeeper := sudokeeper.NewKeeper(appCodec, keySudo)
+	sudoKeeper := sudokeeper.NewKeeper(appCodec, keySudo, govModuleAddr)
 	sudoAcc := authtypes.NewEmptyModuleAccount(sudotypes.ModuleName)
 
 	accountKeeper.SetModuleAccount(ctx, feeCollectorAcc)
@@ -29,6 +29,8 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		CmdEditSudoers(),
 		CmdChangeRoot(),
+		CmdAcceptRoot(),
+		CmdCancelRootChange(),
 	)
 
 	return txCmd
@@ -118,14 +120,16 @@ func CmdChangeRoot() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "change-root [new-root-address]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Change the root address of the x/sudo state",
+		Short: "Propose a new root address for the x/sudo state",
 		Example: strings.TrimSpace(fmt.Sprintf(`
 			Example: 
 			$ %s tx sudo change-root <new-root-address> --from=<key_or_address>
 			`, version.AppName)),
 		Long: strings.TrimSpace(
-			`Change the root address of the x/sudo state, giving the
-				new address, should be executed by the current root address.
+			`Propose a new root address for the x/sudo state. Should be executed
+				by the current root address. The change only takes effect once the
+				new root runs "accept-root" after the root change delay, and it can
+				be canceled by the current root with "cancel-root-change".
 			`),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -159,6 +163,79 @@ func CmdChangeRoot() *cobra.Command {
 	return cmd
 }
 
+// CmdAcceptRoot is a terminal command corresponding to the AcceptRoot
+func CmdAcceptRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-root",
+		Args:  cobra.NoArgs,
+		Short: "Accept a pending root change of the x/sudo state",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo accept-root --from=<new_root_key_or_address>
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Accept a pending root change of the x/sudo state. Should be executed
+				by the proposed new root after the root change delay has elapsed.
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAcceptRoot{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelRootChange is a terminal command corresponding to the
+// CancelRootChange
+func CmdCancelRootChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-root-change",
+		Args:  cobra.NoArgs,
+		Short: "Cancel a pending root change of the x/sudo state",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo cancel-root-change --from=<root_key_or_address>
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Cancel a pending root change of the x/sudo state. Should be
+				executed by the current root address.
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelRootChange{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQuerySudoers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "state",
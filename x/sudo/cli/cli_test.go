@@ -116,6 +116,7 @@ func (s *TestSuite) SetupSuite() {
 	rootAddr := addrs[0]
 	sudoGenesis.Sudoers.Root = rootAddr.String()
 	sudoGenesis.Sudoers.Contracts = []string{rootAddr.String()}
+	sudoGenesis.Params.RootChangeDelay = 0
 
 	encoding := app.MakeEncodingConfig()
 	gen := app.ModuleBasics.DefaultGenesis(encoding.Codec)
@@ -244,15 +245,40 @@ func (s *TestSuite) Test_ZCmdChangeRoot() {
 	s.NoError(err)
 	initialRoot := sudoers.Sudoers.Root
 
+	s.T().Log("propose a root change and cancel it")
 	newRoot := testutil.AccAddress()
 	_, err = s.network.ExecTxCmd(
 		cli.CmdChangeRoot(), s.root.addr, []string{newRoot.String()})
 	require.NoError(s.T(), err)
 
+	sudoers, err = testnetwork.QuerySudoers(val.ClientCtx)
+	s.NoError(err)
+	require.Equal(s.T(), initialRoot, sudoers.Sudoers.Root)
+	require.NotNil(s.T(), sudoers.Sudoers.PendingRootChange)
+	require.Equal(s.T(), newRoot.String(), sudoers.Sudoers.PendingRootChange.NewRoot)
+
+	_, err = s.network.ExecTxCmd(
+		cli.CmdCancelRootChange(), s.root.addr, []string{})
+	require.NoError(s.T(), err)
+
+	sudoers, err = testnetwork.QuerySudoers(val.ClientCtx)
+	s.NoError(err)
+	require.Nil(s.T(), sudoers.Sudoers.PendingRootChange)
+
+	s.T().Log("propose a root change and accept it as the new root")
+	_, err = s.network.ExecTxCmd(
+		cli.CmdChangeRoot(), s.root.addr, []string{val.Address.String()})
+	require.NoError(s.T(), err)
+
+	_, err = s.network.ExecTxCmd(
+		cli.CmdAcceptRoot(), val.Address, []string{})
+	require.NoError(s.T(), err)
+
 	sudoers, err = testnetwork.QuerySudoers(val.ClientCtx)
 	s.NoError(err)
 	require.NotEqual(s.T(), sudoers.Sudoers.Root, initialRoot)
-	require.Equal(s.T(), sudoers.Sudoers.Root, newRoot.String())
+	require.Equal(s.T(), sudoers.Sudoers.Root, val.Address.String())
+	require.Nil(s.T(), sudoers.Sudoers.PendingRootChange)
 }
 
 // TestMarshal_EditSudoers verifies that the expected proto.Message for
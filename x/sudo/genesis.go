@@ -13,6 +13,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 	k.Sudoers.Set(ctx, genState.Sudoers)
+	k.Params.Set(ctx, genState.Params)
 }
 
 // ExportGenesis returns the module's exported genesis state.
@@ -25,6 +26,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	return &types.GenesisState{
 		Sudoers: pbSudoers,
+		Params:  k.GetParams(ctx),
 	}
 }
 
@@ -36,5 +38,6 @@ func DefaultGenesis() *types.GenesisState {
 			Root:      "",
 			Contracts: []string{},
 		},
+		Params: types.DefaultParams(),
 	}
 }
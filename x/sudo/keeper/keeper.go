@@ -15,17 +15,33 @@ import (
 
 type Keeper struct {
 	Sudoers collections.Item[sudotypes.Sudoers]
+
+	// Params: Module parameters. Chains that predate the params fall back to
+	// [sudotypes.DefaultParams].
+	Params collections.Item[sudotypes.Params]
+
+	// authority: Address allowed to update the module parameters, which is
+	// the x/gov module account by default.
+	authority string
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey types.StoreKey,
+	authority string,
 ) Keeper {
 	return Keeper{
-		Sudoers: collections.NewItem(storeKey, 1, SudoersValueEncoder(cdc)),
+		Sudoers:   collections.NewItem(storeKey, 1, SudoersValueEncoder(cdc)),
+		Params:    collections.NewItem(storeKey, 2, collections.ProtoValueEncoder[sudotypes.Params](cdc)),
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Returns the root address of the sudo module.
 func (k Keeper) GetRootAddr(ctx sdk.Context) (sdk.AccAddress, error) {
 	sudoers, err := k.Sudoers.Get(ctx)
@@ -41,6 +57,12 @@ func (k Keeper) GetRootAddr(ctx sdk.Context) (sdk.AccAddress, error) {
 	return addr, nil
 }
 
+// GetParams returns the module parameters, or the defaults if none have been
+// set in state.
+func (k Keeper) GetParams(ctx sdk.Context) sudotypes.Params {
+	return k.Params.GetOr(ctx, sudotypes.DefaultParams())
+}
+
 func (k Keeper) senderHasPermission(sender string, root string) error {
 	if sender != root {
 		return fmt.Errorf(`message must be sent by root user. root: "%s", sender: "%s"`,
@@ -77,7 +99,11 @@ func (k Keeper) AddContracts(
 	if err != nil {
 		return
 	}
-	pbSudoers := Sudoers{Root: sudoersBefore.Root, Contracts: contracts}.ToPb()
+	pbSudoers := Sudoers{
+		Root:              sudoersBefore.Root,
+		Contracts:         contracts,
+		PendingRootChange: sudoersBefore.PendingRootChange,
+	}.ToPb()
 	k.Sudoers.Set(ctx, pbSudoers)
 	msgResp = new(sudotypes.MsgEditSudoersResponse)
 	return msgResp, ctx.EventManager().EmitTypedEvent(&sudotypes.EventUpdateSudoers{
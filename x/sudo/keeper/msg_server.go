@@ -38,6 +38,9 @@ func (m MsgServer) EditSudoers(
 	}
 }
 
+// ChangeRoot proposes a transfer of the root user to "msg.NewRoot". The root
+// does not change until the new root accepts with [MsgServer.AcceptRoot] after
+// the root change delay. Proposing again replaces any pending change.
 func (m MsgServer) ChangeRoot(ctx context.Context, msg *sudotypes.MsgChangeRoot) (*sudotypes.MsgChangeRootResponse, error) {
 	sdkContext := sdk.UnwrapSDKContext(ctx)
 
@@ -46,24 +49,133 @@ func (m MsgServer) ChangeRoot(ctx context.Context, msg *sudotypes.MsgChangeRoot)
 		return nil, fmt.Errorf("failed to get sudoers: %w", err)
 	}
 
-	err = m.validateRootPermissions(pbSudoers, msg)
+	err = m.validateRootPermissions(pbSudoers, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	pbSudoers.Root = msg.NewRoot
+	newRoot, err := sdk.AccAddressFromBech32(msg.NewRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new root address: %w", err)
+	}
+
+	blockTime := sdkContext.BlockTime()
+	pending := sudotypes.PendingRootChange{
+		NewRoot:     newRoot.String(),
+		ProposedAt:  blockTime,
+		AcceptAfter: blockTime.Add(m.keeper.GetParams(sdkContext).RootChangeDelay),
+	}
+	pbSudoers.PendingRootChange = &pending
 	m.keeper.Sudoers.Set(sdkContext, pbSudoers)
 
-	return &sudotypes.MsgChangeRootResponse{}, nil
+	return &sudotypes.MsgChangeRootResponse{}, sdkContext.EventManager().EmitTypedEvent(
+		&sudotypes.EventProposeRootChange{
+			Root:              pbSudoers.Root,
+			PendingRootChange: pending,
+		},
+	)
 }
 
-func (m MsgServer) validateRootPermissions(pbSudoers sudotypes.Sudoers, msg *sudotypes.MsgChangeRoot) error {
+// AcceptRoot completes a pending root change. The sender must be the proposed
+// new root, and the block time must be at or after the pending change's
+// "AcceptAfter" time.
+func (m MsgServer) AcceptRoot(ctx context.Context, msg *sudotypes.MsgAcceptRoot) (*sudotypes.MsgAcceptRootResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	pbSudoers, err := m.keeper.Sudoers.Get(sdkContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sudoers: %w", err)
+	}
+
+	pending := pbSudoers.PendingRootChange
+	if pending == nil {
+		return nil, sudotypes.ErrNoPendingRootChange
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sender address: %w", err)
+	}
+	if sender.String() != pending.NewRoot {
+		return nil, sudotypes.ErrUnauthorized
+	}
+
+	if blockTime := sdkContext.BlockTime(); blockTime.Before(pending.AcceptAfter) {
+		return nil, fmt.Errorf(
+			"%w: root change can be accepted after %s, block time is %s",
+			sudotypes.ErrRootChangeDelay, pending.AcceptAfter, blockTime,
+		)
+	}
+
+	oldRoot := pbSudoers.Root
+	pbSudoers.Root = pending.NewRoot
+	pbSudoers.PendingRootChange = nil
+	m.keeper.Sudoers.Set(sdkContext, pbSudoers)
+
+	return &sudotypes.MsgAcceptRootResponse{}, sdkContext.EventManager().EmitTypedEvent(
+		&sudotypes.EventAcceptRootChange{
+			OldRoot: oldRoot,
+			NewRoot: pbSudoers.Root,
+		},
+	)
+}
+
+// CancelRootChange discards a pending root change. Only the current root can
+// cancel.
+func (m MsgServer) CancelRootChange(ctx context.Context, msg *sudotypes.MsgCancelRootChange) (*sudotypes.MsgCancelRootChangeResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	pbSudoers, err := m.keeper.Sudoers.Get(sdkContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sudoers: %w", err)
+	}
+
+	err = m.validateRootPermissions(pbSudoers, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := pbSudoers.PendingRootChange
+	if pending == nil {
+		return nil, sudotypes.ErrNoPendingRootChange
+	}
+
+	pbSudoers.PendingRootChange = nil
+	m.keeper.Sudoers.Set(sdkContext, pbSudoers)
+
+	return &sudotypes.MsgCancelRootChangeResponse{}, sdkContext.EventManager().EmitTypedEvent(
+		&sudotypes.EventCancelRootChange{
+			Root:              pbSudoers.Root,
+			PendingRootChange: *pending,
+		},
+	)
+}
+
+// UpdateParams sets the module parameters. Only the module authority, which
+// is the x/gov module account by default, can update them.
+func (m MsgServer) UpdateParams(ctx context.Context, msg *sudotypes.MsgUpdateParams) (*sudotypes.MsgUpdateParamsResponse, error) {
+	if msg.Authority != m.keeper.authority {
+		return nil, fmt.Errorf(
+			"%w: invalid authority, expected %s, got %s",
+			sudotypes.ErrUnauthorized, m.keeper.authority, msg.Authority,
+		)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+	m.keeper.Params.Set(sdkContext, msg.Params)
+	return &sudotypes.MsgUpdateParamsResponse{}, nil
+}
+
+func (m MsgServer) validateRootPermissions(pbSudoers sudotypes.Sudoers, senderBech32 string) error {
 	root, err := sdk.AccAddressFromBech32(pbSudoers.Root)
 	if err != nil {
 		return fmt.Errorf("failed to parse root address: %w", err)
 	}
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	sender, err := sdk.AccAddressFromBech32(senderBech32)
 	if err != nil {
 		return fmt.Errorf("failed to parse sender address: %w", err)
 	}
@@ -84,8 +196,9 @@ func SudoersValueEncoder(cdc codec.BinaryCodec) collections.ValueEncoder[sudotyp
 }
 
 type Sudoers struct {
-	Root      string          `json:"root"`
-	Contracts set.Set[string] `json:"contracts"`
+	Root              string                       `json:"root"`
+	Contracts         set.Set[string]              `json:"contracts"`
+	PendingRootChange *sudotypes.PendingRootChange `json:"pending_root_change"`
 }
 
 func (sudo Sudoers) String() string {
@@ -95,15 +208,17 @@ func (sudo Sudoers) String() string {
 
 func (sudo Sudoers) ToPb() sudotypes.Sudoers {
 	return sudotypes.Sudoers{
-		Root:      sudo.Root,
-		Contracts: sudo.Contracts.ToSlice(),
+		Root:              sudo.Root,
+		Contracts:         sudo.Contracts.ToSlice(),
+		PendingRootChange: sudo.PendingRootChange,
 	}
 }
 
 func SudoersFromPb(pbSudoers sudotypes.Sudoers) Sudoers {
 	return Sudoers{
-		Root:      pbSudoers.Root,
-		Contracts: set.New[string](pbSudoers.Contracts...),
+		Root:              pbSudoers.Root,
+		Contracts:         set.New[string](pbSudoers.Contracts...),
+		PendingRootChange: pbSudoers.PendingRootChange,
 	}
 }
 
@@ -2,12 +2,15 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/NibiruChain/nibiru/v2/x/sudo/keeper"
 
 	"github.com/NibiruChain/nibiru/v2/x/sudo/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -176,6 +179,8 @@ func TestMsgServer_ChangeRoot(t *testing.T) {
 	app.SudoKeeper.Sudoers.Set(ctx, types.Sudoers{
 		Root: actualRoot,
 	})
+	delay := time.Hour
+	app.SudoKeeper.Params.Set(ctx, types.Params{RootChangeDelay: delay})
 
 	// try to change root with non-root account
 	msgServer := keeper.NewMsgServer(app.SudoKeeper)
@@ -185,18 +190,136 @@ func TestMsgServer_ChangeRoot(t *testing.T) {
 	)
 	require.EqualError(t, err, "unauthorized: missing sudo permissions")
 
-	// try to change root with root account
+	// accept and cancel fail without a pending change
+	_, err = msgServer.AcceptRoot(
+		sdk.WrapSDKContext(ctx), &types.MsgAcceptRoot{Sender: newRoot},
+	)
+	require.ErrorIs(t, err, types.ErrNoPendingRootChange)
+	_, err = msgServer.CancelRootChange(
+		sdk.WrapSDKContext(ctx), &types.MsgCancelRootChange{Sender: actualRoot},
+	)
+	require.ErrorIs(t, err, types.ErrNoPendingRootChange)
+
+	// propose a root change with root account
 	_, err = msgServer.ChangeRoot(
 		sdk.WrapSDKContext(ctx),
 		&types.MsgChangeRoot{Sender: actualRoot, NewRoot: newRoot},
 	)
 	require.NoError(t, err)
 
-	// check that root has changed
+	// check that root has not changed yet and the change is pending
 	sudoers, err := app.SudoKeeper.Sudoers.Get(ctx)
 	require.NoError(t, err)
+	require.Equal(t, actualRoot, sudoers.Root)
+	require.NotNil(t, sudoers.PendingRootChange)
+	require.Equal(t, newRoot, sudoers.PendingRootChange.NewRoot)
+	require.Equal(t, ctx.BlockTime().Add(delay), sudoers.PendingRootChange.AcceptAfter)
+
+	// only the new root can accept
+	_, err = msgServer.AcceptRoot(
+		sdk.WrapSDKContext(ctx), &types.MsgAcceptRoot{Sender: fakeRoot},
+	)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the new root can't accept before the delay elapses
+	_, err = msgServer.AcceptRoot(
+		sdk.WrapSDKContext(ctx), &types.MsgAcceptRoot{Sender: newRoot},
+	)
+	require.ErrorIs(t, err, types.ErrRootChangeDelay)
+
+	// only the current root can cancel
+	_, err = msgServer.CancelRootChange(
+		sdk.WrapSDKContext(ctx), &types.MsgCancelRootChange{Sender: newRoot},
+	)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// accept after the delay
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(delay))
+	_, err = msgServer.AcceptRoot(
+		sdk.WrapSDKContext(ctx), &types.MsgAcceptRoot{Sender: newRoot},
+	)
+	require.NoError(t, err)
+
+	// check that root has changed
+	sudoers, err = app.SudoKeeper.Sudoers.Get(ctx)
+	require.NoError(t, err)
 
 	require.Equal(t, newRoot, sudoers.Root)
+	require.Nil(t, sudoers.PendingRootChange)
+}
+
+func TestMsgServer_CancelRootChange(t *testing.T) {
+	app, ctx := setup()
+
+	root := testutil.AccAddress().String()
+	newRoot := testutil.AccAddress().String()
+	app.SudoKeeper.Sudoers.Set(ctx, types.Sudoers{Root: root})
+
+	msgServer := keeper.NewMsgServer(app.SudoKeeper)
+	_, err := msgServer.ChangeRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgChangeRoot{Sender: root, NewRoot: newRoot},
+	)
+	require.NoError(t, err)
+
+	_, err = msgServer.CancelRootChange(
+		sdk.WrapSDKContext(ctx), &types.MsgCancelRootChange{Sender: root},
+	)
+	require.NoError(t, err)
+
+	sudoers, err := app.SudoKeeper.Sudoers.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, root, sudoers.Root)
+	require.Nil(t, sudoers.PendingRootChange)
+
+	// a canceled change can no longer be accepted
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultRootChangeDelay))
+	_, err = msgServer.AcceptRoot(
+		sdk.WrapSDKContext(ctx), &types.MsgAcceptRoot{Sender: newRoot},
+	)
+	require.ErrorIs(t, err, types.ErrNoPendingRootChange)
+}
+
+func TestMsgServer_UpdateParams(t *testing.T) {
+	app, ctx := setup()
+	msgServer := keeper.NewMsgServer(app.SudoKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	require.Equal(t, authority, app.SudoKeeper.GetAuthority())
+
+	newParams := types.Params{RootChangeDelay: 72 * time.Hour}
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgUpdateParams{Authority: testutil.AccAddress().String(), Params: newParams},
+	)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.UpdateParams(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgUpdateParams{Authority: authority, Params: types.Params{RootChangeDelay: -time.Hour}},
+	)
+	require.ErrorContains(t, err, "must not be negative")
+
+	_, err = msgServer.UpdateParams(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgUpdateParams{Authority: authority, Params: newParams},
+	)
+	require.NoError(t, err)
+	require.Equal(t, newParams, app.SudoKeeper.GetParams(ctx))
+
+	// root changes use the new delay
+	root := testutil.AccAddress().String()
+	newRoot := testutil.AccAddress().String()
+	app.SudoKeeper.Sudoers.Set(ctx, types.Sudoers{Root: root})
+	_, err = msgServer.ChangeRoot(
+		sdk.WrapSDKContext(ctx),
+		&types.MsgChangeRoot{Sender: root, NewRoot: newRoot},
+	)
+	require.NoError(t, err)
+	sudoers, err := app.SudoKeeper.Sudoers.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(newParams.RootChangeDelay), sudoers.PendingRootChange.AcceptAfter)
 }
 
 func TestSudo_FromPbSudoers(t *testing.T) {
@@ -34,5 +34,6 @@ func (q Querier) QuerySudoers(
 
 	return &types.QuerySudoersResponse{
 		Sudoers: sudoers,
+		Params:  q.keeper.GetParams(ctx),
 	}, err
 }
@@ -19,6 +19,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			Root:      rootAddress.String(),
 			Contracts: []string{},
 		},
+		Params: types.DefaultParams(),
 	}
 
 	bz, err := json.MarshalIndent(&genState, "", " ")
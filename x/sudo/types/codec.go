@@ -9,6 +9,10 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditSudoers{}, "sudo/edit_sudoers", nil)
+	cdc.RegisterConcrete(&MsgChangeRoot{}, "sudo/change_root", nil)
+	cdc.RegisterConcrete(&MsgAcceptRoot{}, "sudo/accept_root", nil)
+	cdc.RegisterConcrete(&MsgCancelRootChange{}, "sudo/cancel_root_change", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "sudo/update_params", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -16,6 +20,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		/* interface */ (*sdk.Msg)(nil),
 		/* implementations */
 		&MsgEditSudoers{},
+		&MsgChangeRoot{},
+		&MsgAcceptRoot{},
+		&MsgCancelRootChange{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/sudo/types"
+)
+
+func TestCodec(t *testing.T) {
+	msgs := []struct {
+		msg       sdk.Msg
+		aminoName string
+	}{
+		{&types.MsgEditSudoers{}, "sudo/edit_sudoers"},
+		{&types.MsgChangeRoot{}, "sudo/change_root"},
+		{&types.MsgAcceptRoot{}, "sudo/accept_root"},
+		{&types.MsgCancelRootChange{}, "sudo/cancel_root_change"},
+		{&types.MsgUpdateParams{}, "sudo/update_params"},
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewLegacyAmino()
+	types.RegisterLegacyAminoCodec(cdc)
+
+	for _, tc := range msgs {
+		typeURL := sdk.MsgTypeURL(tc.msg)
+		t.Run(typeURL, func(t *testing.T) {
+			resolved, err := registry.Resolve(typeURL)
+			require.NoError(t, err)
+			require.IsType(t, tc.msg, resolved)
+
+			aminoJSON, err := cdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Contains(t, string(aminoJSON), `"type":"`+tc.aminoName+`"`)
+		})
+	}
+}
@@ -10,6 +10,9 @@ var (
 	ErrUnauthorized = sdkioerrors.Register(ModuleName, 2, "unauthorized: missing sudo permissions")
 	errGenesis      = sdkioerrors.Register(ModuleName, 3, "sudo genesis error")
 	errSudoers      = sdkioerrors.Register(ModuleName, 4, "sudoers error")
+
+	ErrNoPendingRootChange = sdkioerrors.Register(ModuleName, 5, "no pending root change")
+	ErrRootChangeDelay     = sdkioerrors.Register(ModuleName, 6, "root change delay has not elapsed")
 )
 
 func ErrGenesis(errMsg string) error {
@@ -78,30 +78,204 @@ func (m *EventUpdateSudoers) GetAction() string {
 	return ""
 }
 
+// EventProposeRootChange: ABCI event emitted upon execution of "MsgChangeRoot".
+type EventProposeRootChange struct {
+	// Root: The current root that proposed the change.
+	Root              string            `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	PendingRootChange PendingRootChange `protobuf:"bytes,2,opt,name=pending_root_change,json=pendingRootChange,proto3" json:"pending_root_change"`
+}
+
+func (m *EventProposeRootChange) Reset()         { *m = EventProposeRootChange{} }
+func (m *EventProposeRootChange) String() string { return proto.CompactTextString(m) }
+func (*EventProposeRootChange) ProtoMessage()    {}
+func (*EventProposeRootChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{1}
+}
+func (m *EventProposeRootChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposeRootChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposeRootChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposeRootChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposeRootChange.Merge(m, src)
+}
+func (m *EventProposeRootChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposeRootChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposeRootChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposeRootChange proto.InternalMessageInfo
+
+func (m *EventProposeRootChange) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *EventProposeRootChange) GetPendingRootChange() PendingRootChange {
+	if m != nil {
+		return m.PendingRootChange
+	}
+	return PendingRootChange{}
+}
+
+// EventAcceptRootChange: ABCI event emitted upon execution of "MsgAcceptRoot".
+type EventAcceptRootChange struct {
+	// OldRoot: The root before the change.
+	OldRoot string `protobuf:"bytes,1,opt,name=old_root,json=oldRoot,proto3" json:"old_root,omitempty"`
+	// NewRoot: The root after the change.
+	NewRoot string `protobuf:"bytes,2,opt,name=new_root,json=newRoot,proto3" json:"new_root,omitempty"`
+}
+
+func (m *EventAcceptRootChange) Reset()         { *m = EventAcceptRootChange{} }
+func (m *EventAcceptRootChange) String() string { return proto.CompactTextString(m) }
+func (*EventAcceptRootChange) ProtoMessage()    {}
+func (*EventAcceptRootChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{2}
+}
+func (m *EventAcceptRootChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAcceptRootChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAcceptRootChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAcceptRootChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAcceptRootChange.Merge(m, src)
+}
+func (m *EventAcceptRootChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAcceptRootChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAcceptRootChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAcceptRootChange proto.InternalMessageInfo
+
+func (m *EventAcceptRootChange) GetOldRoot() string {
+	if m != nil {
+		return m.OldRoot
+	}
+	return ""
+}
+
+func (m *EventAcceptRootChange) GetNewRoot() string {
+	if m != nil {
+		return m.NewRoot
+	}
+	return ""
+}
+
+// EventCancelRootChange: ABCI event emitted upon execution of
+// "MsgCancelRootChange".
+type EventCancelRootChange struct {
+	// Root: The current root that canceled the change.
+	Root              string            `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	PendingRootChange PendingRootChange `protobuf:"bytes,2,opt,name=pending_root_change,json=pendingRootChange,proto3" json:"pending_root_change"`
+}
+
+func (m *EventCancelRootChange) Reset()         { *m = EventCancelRootChange{} }
+func (m *EventCancelRootChange) String() string { return proto.CompactTextString(m) }
+func (*EventCancelRootChange) ProtoMessage()    {}
+func (*EventCancelRootChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{3}
+}
+func (m *EventCancelRootChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelRootChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelRootChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelRootChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelRootChange.Merge(m, src)
+}
+func (m *EventCancelRootChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelRootChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelRootChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelRootChange proto.InternalMessageInfo
+
+func (m *EventCancelRootChange) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *EventCancelRootChange) GetPendingRootChange() PendingRootChange {
+	if m != nil {
+		return m.PendingRootChange
+	}
+	return PendingRootChange{}
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateSudoers)(nil), "nibiru.sudo.v1.EventUpdateSudoers")
+	proto.RegisterType((*EventProposeRootChange)(nil), "nibiru.sudo.v1.EventProposeRootChange")
+	proto.RegisterType((*EventAcceptRootChange)(nil), "nibiru.sudo.v1.EventAcceptRootChange")
+	proto.RegisterType((*EventCancelRootChange)(nil), "nibiru.sudo.v1.EventCancelRootChange")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/event.proto", fileDescriptor_7e6085948b018986) }
 
 var fileDescriptor_7e6085948b018986 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0x4d, 0xc9, 0xd7, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x4c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
-	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x16, 0xdd, 0xfc, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0x9c,
-	0x52, 0x2a, 0x97, 0x90, 0x2b, 0xc8, 0xba, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0xd4, 0xe0, 0xd2, 0x94,
-	0xfc, 0xd4, 0xa2, 0x62, 0x21, 0x73, 0x2e, 0xf6, 0x62, 0x08, 0x53, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x5c, 0x0f, 0xd5, 0x1d, 0x7a, 0x50, 0x95, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04,
-	0xc1, 0x54, 0x0b, 0x89, 0x71, 0xb1, 0x25, 0x26, 0x83, 0xec, 0x96, 0x60, 0x52, 0x60, 0xd4, 0xe0,
-	0x0c, 0x82, 0xf2, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x0f, 0x6c, 0x87, 0x73,
-	0x46, 0x62, 0x66, 0x9e, 0x3e, 0xd4, 0xcd, 0x65, 0x46, 0xfa, 0x15, 0x10, 0x87, 0x97, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0x9d, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xe3, 0x06,
-	0x82, 0x34, 0x01, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0x41, 0x4b, 0xc3, 0x40,
+	0x10, 0x85, 0xb3, 0x52, 0x5a, 0xbb, 0x05, 0xc1, 0xa8, 0xb5, 0x16, 0x89, 0x35, 0xa7, 0x5e, 0xcc,
+	0xd2, 0x7a, 0xf0, 0x6c, 0x8b, 0x78, 0x52, 0x4a, 0x44, 0x04, 0x2f, 0x65, 0x9b, 0x2c, 0x69, 0x20,
+	0xee, 0x2c, 0xc9, 0x26, 0xd5, 0xbb, 0xde, 0xfd, 0x59, 0x3d, 0xf6, 0xe8, 0x49, 0xa4, 0xfd, 0x23,
+	0xb2, 0xbb, 0x11, 0x6b, 0xff, 0x80, 0xb7, 0x19, 0xbe, 0xc7, 0x7b, 0x6f, 0x60, 0x70, 0x9b, 0xc7,
+	0x93, 0x38, 0xcd, 0x49, 0x96, 0x87, 0x40, 0x8a, 0x1e, 0x61, 0x05, 0xe3, 0xd2, 0x13, 0x29, 0x48,
+	0xb0, 0x77, 0x0c, 0xf3, 0x14, 0xf3, 0x8a, 0x5e, 0x7b, 0x3f, 0x82, 0x08, 0x34, 0x22, 0x6a, 0x32,
+	0xaa, 0xf6, 0x71, 0x04, 0x10, 0x25, 0x8c, 0x50, 0x11, 0x13, 0xca, 0x39, 0x48, 0x2a, 0x63, 0xe0,
+	0x59, 0x49, 0x37, 0xfd, 0x33, 0x49, 0x25, 0x33, 0xcc, 0x65, 0xd8, 0xbe, 0x52, 0x71, 0xf7, 0x22,
+	0xa4, 0x92, 0xdd, 0xe5, 0x21, 0xb0, 0x34, 0xb3, 0x2f, 0x70, 0x2d, 0x33, 0x63, 0x0b, 0x75, 0x50,
+	0xb7, 0xd1, 0x3f, 0xf4, 0xfe, 0xf6, 0xf0, 0x4a, 0xe5, 0xa0, 0x32, 0xff, 0x3c, 0xb1, 0xfc, 0x1f,
+	0xb5, 0xdd, 0xc4, 0x55, 0x1a, 0xa8, 0xec, 0xd6, 0x56, 0x07, 0x75, 0xeb, 0x7e, 0xb9, 0xb9, 0x6f,
+	0x08, 0x37, 0x75, 0xce, 0x28, 0x05, 0x01, 0x19, 0xf3, 0x01, 0xe4, 0x70, 0x4a, 0x79, 0xc4, 0x6c,
+	0x1b, 0x57, 0x52, 0x00, 0xa9, 0x83, 0xea, 0xbe, 0x9e, 0xed, 0x07, 0xbc, 0x27, 0x18, 0x0f, 0x63,
+	0x1e, 0x8d, 0xd5, 0x3e, 0x0e, 0xb4, 0x54, 0x7b, 0x36, 0xfa, 0xa7, 0x9b, 0x5d, 0x46, 0x46, 0xfa,
+	0xeb, 0x59, 0xb6, 0xda, 0x15, 0x9b, 0xc0, 0xbd, 0xc1, 0x07, 0xba, 0xc6, 0x65, 0x10, 0x30, 0x21,
+	0xd7, 0x5a, 0x1c, 0xe1, 0x6d, 0x48, 0xc2, 0xf1, 0x5a, 0x93, 0x1a, 0x24, 0xa1, 0x12, 0x28, 0xc4,
+	0xd9, 0xcc, 0x20, 0x73, 0x55, 0x8d, 0xb3, 0x99, 0x42, 0xee, 0x2b, 0x2a, 0xfd, 0x86, 0x94, 0x07,
+	0x2c, 0xf9, 0xa7, 0xab, 0x06, 0xd7, 0xf3, 0xa5, 0x83, 0x16, 0x4b, 0x07, 0x7d, 0x2d, 0x1d, 0xf4,
+	0xbe, 0x72, 0xac, 0xc5, 0xca, 0xb1, 0x3e, 0x56, 0x8e, 0xf5, 0x78, 0x16, 0xc5, 0x72, 0x9a, 0x4f,
+	0xbc, 0x00, 0x9e, 0xc8, 0xad, 0xf6, 0x1f, 0x4e, 0x69, 0xcc, 0x49, 0xf9, 0x11, 0x45, 0x9f, 0x3c,
+	0x9b, 0xb7, 0x90, 0x2f, 0x82, 0x65, 0x93, 0xaa, 0x7e, 0x8a, 0xf3, 0xef, 0x01, 0x00, 0xfc, 0x40,
+	0x98, 0x1f, 0x92, 0x02, 0x00, 0x00,
 }
 
 func (m *EventUpdateSudoers) Marshal() (dAtA []byte, err error) {
@@ -144,6 +318,123 @@ func (m *EventUpdateSudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventProposeRootChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposeRootChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposeRootChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingRootChange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAcceptRootChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAcceptRootChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAcceptRootChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewRoot) > 0 {
+		i -= len(m.NewRoot)
+		copy(dAtA[i:], m.NewRoot)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldRoot) > 0 {
+		i -= len(m.OldRoot)
+		copy(dAtA[i:], m.OldRoot)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OldRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelRootChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelRootChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelRootChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingRootChange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -170,6 +461,53 @@ func (m *EventUpdateSudoers) Size() (n int) {
 	return n
 }
 
+func (m *EventProposeRootChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.PendingRootChange.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventAcceptRootChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldRoot)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCancelRootChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.PendingRootChange.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -291,6 +629,350 @@ func (m *EventUpdateSudoers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventProposeRootChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposeRootChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposeRootChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingRootChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAcceptRootChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAcceptRootChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAcceptRootChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelRootChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelRootChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelRootChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingRootChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,8 @@ func (gen *GenesisState) Validate() error {
 		return ErrGenesis("nil contract state must be []string")
 	} else if err := gen.Sudoers.Validate(); err != nil {
 		return ErrGenesis(err.Error())
+	} else if err := gen.Params.Validate(); err != nil {
+		return ErrGenesis(err.Error())
 	}
 	return nil
 }
@@ -10,6 +10,9 @@ import (
 var (
 	_ legacytx.LegacyMsg = &MsgEditSudoers{}
 	_ legacytx.LegacyMsg = &MsgChangeRoot{}
+	_ legacytx.LegacyMsg = &MsgAcceptRoot{}
+	_ legacytx.LegacyMsg = &MsgCancelRootChange{}
+	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 )
 
 // MsgEditSudoers
@@ -91,3 +94,87 @@ func (msg MsgChangeRoot) Type() string { return "change_root" }
 func (m MsgChangeRoot) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
+
+// MsgAcceptRoot
+
+func (m MsgAcceptRoot) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgAcceptRoot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Route Implements Msg.
+func (msg MsgAcceptRoot) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgAcceptRoot) Type() string { return "accept_root" }
+
+// GetSignBytes Implements Msg.
+func (m MsgAcceptRoot) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgCancelRootChange
+
+func (m MsgCancelRootChange) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgCancelRootChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Route Implements Msg.
+func (msg MsgCancelRootChange) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgCancelRootChange) Type() string { return "cancel_root_change" }
+
+// GetSignBytes Implements Msg.
+func (m MsgCancelRootChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// MsgUpdateParams
+
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+	return m.Params.Validate()
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+
+// Type Implements Msg.
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// GetSignBytes Implements Msg.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
@@ -68,6 +68,9 @@ var xxx_messageInfo_QuerySudoersRequest proto.InternalMessageInfo
 // QuerySudoersResponse indicates the successful execution of MsgEditSudeors.
 type QuerySudoersResponse struct {
 	Sudoers Sudoers `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers"`
+	// Params: The module parameters, including the delay applied to root
+	// changes.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *QuerySudoersResponse) Reset()         { *m = QuerySudoersResponse{} }
@@ -110,6 +113,13 @@ func (m *QuerySudoersResponse) GetSudoers() Sudoers {
 	return Sudoers{}
 }
 
+func (m *QuerySudoersResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QuerySudoersRequest)(nil), "nibiru.sudo.v1.QuerySudoersRequest")
 	proto.RegisterType((*QuerySudoersResponse)(nil), "nibiru.sudo.v1.QuerySudoersResponse")
@@ -118,7 +128,7 @@ func init() {
 func init() { proto.RegisterFile("nibiru/sudo/v1/query.proto", fileDescriptor_3c5c8e03d8d77d77) }
 
 var fileDescriptor_3c5c8e03d8d77d77 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
+	// 305 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcb, 0x4c, 0xca,
 	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0x4d, 0xc9, 0xd7, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa,
 	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
@@ -126,17 +136,19 @@ var fileDescriptor_3c5c8e03d8d77d77 = []byte{
 	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
 	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x16, 0xdd, 0xfc, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0x9c,
 	0x92, 0x28, 0x97, 0x70, 0x20, 0xc8, 0xba, 0xe0, 0xd2, 0x94, 0xfc, 0xd4, 0xa2, 0xe2, 0xa0, 0xd4,
-	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x7f, 0x2e, 0x11, 0x54, 0xe1, 0xe2, 0x82, 0xfc, 0xbc, 0xe2,
-	0x54, 0x21, 0x73, 0x2e, 0xf6, 0x62, 0x88, 0x90, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb8,
-	0x1e, 0xaa, 0x03, 0xf5, 0xa0, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xa9, 0x36,
-	0x6a, 0x60, 0xe4, 0x62, 0x05, 0x9b, 0x28, 0x54, 0xce, 0xc5, 0x83, 0x6c, 0xb4, 0x90, 0x32, 0xba,
-	0x09, 0x58, 0xdc, 0x23, 0xa5, 0x82, 0x5f, 0x11, 0xc4, 0x75, 0x4a, 0x32, 0x4d, 0x97, 0x9f, 0x4c,
-	0x66, 0x12, 0x13, 0x12, 0xd1, 0x47, 0xf6, 0x31, 0xd4, 0x09, 0x4e, 0xee, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0xef, 0x07, 0xd6, 0xe9, 0x9c, 0x91, 0x98, 0x99, 0x07, 0x33, 0xa5, 0xcc, 0x48, 0xbf,
-	0x02, 0x62, 0x54, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xe8, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x54, 0x39, 0xc9, 0x2a, 0xb8, 0x01, 0x00, 0x00,
+	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0xa5, 0x56, 0x46, 0x2e, 0x11, 0x54, 0xf1, 0xe2, 0x82, 0xfc, 0xbc,
+	0xe2, 0x54, 0x21, 0x73, 0x2e, 0xf6, 0x62, 0x88, 0x90, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91,
+	0xb8, 0x1e, 0xaa, 0x0b, 0xf5, 0xa0, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xa9,
+	0x16, 0x32, 0xe1, 0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0x02, 0xeb, 0x13, 0x43,
+	0xd7, 0x17, 0x00, 0x96, 0x85, 0x6a, 0x83, 0xaa, 0x35, 0x6a, 0x60, 0xe4, 0x62, 0x05, 0xbb, 0x43,
+	0xa8, 0x9c, 0x8b, 0x07, 0xd9, 0x41, 0x42, 0xca, 0xe8, 0xfa, 0xb1, 0x78, 0x43, 0x4a, 0x05, 0xbf,
+	0x22, 0x88, 0x9f, 0x94, 0x64, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0x24, 0x26, 0x24, 0xa2, 0x8f, 0x1c,
+	0x50, 0x50, 0x87, 0x3b, 0xb9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
+	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
+	0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x1f, 0x58, 0xa7, 0x73,
+	0x46, 0x62, 0x66, 0x1e, 0xcc, 0x94, 0x32, 0x23, 0xfd, 0x0a, 0x88, 0x51, 0x25, 0x95, 0x05, 0xa9,
+	0xc5, 0x49, 0x6c, 0xe0, 0x10, 0x37, 0x06, 0x0c, 0x00, 0x71, 0x73, 0x1f, 0x05, 0xef, 0x01, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,6 +274,16 @@ func (m *QuerySudoersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -303,6 +325,8 @@ func (m *QuerySudoersResponse) Size() (n int) {
 	_ = l
 	l = m.Sudoers.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -424,6 +448,39 @@ func (m *QuerySudoersResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1,9 +1,16 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultRootChangeDelay: The default minimum time between proposing and
+// accepting a root change.
+const DefaultRootChangeDelay = 24 * time.Hour
+
 func (sudo Sudoers) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(sudo.Root); err != nil {
 		return ErrSudoers("root addr: " + err.Error())
@@ -13,6 +20,37 @@ func (sudo Sudoers) Validate() error {
 			return ErrSudoers("contract addr: " + err.Error())
 		}
 	}
+	if sudo.PendingRootChange != nil {
+		if err := sudo.PendingRootChange.Validate(); err != nil {
+			return ErrSudoers("pending root change: " + err.Error())
+		}
+	}
+	return nil
+}
+
+func (pending PendingRootChange) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pending.NewRoot); err != nil {
+		return fmt.Errorf("new root addr: %w", err)
+	}
+	if pending.AcceptAfter.Before(pending.ProposedAt) {
+		return fmt.Errorf(
+			"accept_after (%s) is before proposed_at (%s)",
+			pending.AcceptAfter, pending.ProposedAt,
+		)
+	}
+	return nil
+}
+
+func DefaultParams() Params {
+	return Params{
+		RootChangeDelay: DefaultRootChangeDelay,
+	}
+}
+
+func (p Params) Validate() error {
+	if p.RootChangeDelay < 0 {
+		return fmt.Errorf("root_change_delay must not be negative, got %s", p.RootChangeDelay)
+	}
 	return nil
 }
 
@@ -7,16 +7,21 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +34,10 @@ type Sudoers struct {
 	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
 	// Contracts: The set of contracts with elevated permissions.
 	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// PendingRootChange: A root transfer proposed with "MsgChangeRoot" that has
+	// not yet been accepted by the new root or canceled by the current root.
+	// Nil when there is no pending change.
+	PendingRootChange *PendingRootChange `protobuf:"bytes,3,opt,name=pending_root_change,json=pendingRootChange,proto3" json:"pending_root_change,omitempty"`
 }
 
 func (m *Sudoers) Reset()         { *m = Sudoers{} }
@@ -78,16 +87,138 @@ func (m *Sudoers) GetContracts() []string {
 	return nil
 }
 
+func (m *Sudoers) GetPendingRootChange() *PendingRootChange {
+	if m != nil {
+		return m.PendingRootChange
+	}
+	return nil
+}
+
+// PendingRootChange: A two-step transfer of the "root" user. The change is
+// proposed by the current root and only takes effect once the new root
+// accepts it after the "accept_after" time.
+type PendingRootChange struct {
+	// NewRoot: Address that will become root upon acceptance.
+	NewRoot string `protobuf:"bytes,1,opt,name=new_root,json=newRoot,proto3" json:"new_root,omitempty"`
+	// ProposedAt: Block time at which the change was proposed.
+	ProposedAt time.Time `protobuf:"bytes,2,opt,name=proposed_at,json=proposedAt,proto3,stdtime" json:"proposed_at"`
+	// AcceptAfter: Earliest block time at which the new root can accept.
+	AcceptAfter time.Time `protobuf:"bytes,3,opt,name=accept_after,json=acceptAfter,proto3,stdtime" json:"accept_after"`
+}
+
+func (m *PendingRootChange) Reset()         { *m = PendingRootChange{} }
+func (m *PendingRootChange) String() string { return proto.CompactTextString(m) }
+func (*PendingRootChange) ProtoMessage()    {}
+func (*PendingRootChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{1}
+}
+func (m *PendingRootChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRootChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRootChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRootChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRootChange.Merge(m, src)
+}
+func (m *PendingRootChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRootChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRootChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRootChange proto.InternalMessageInfo
+
+func (m *PendingRootChange) GetNewRoot() string {
+	if m != nil {
+		return m.NewRoot
+	}
+	return ""
+}
+
+func (m *PendingRootChange) GetProposedAt() time.Time {
+	if m != nil {
+		return m.ProposedAt
+	}
+	return time.Time{}
+}
+
+func (m *PendingRootChange) GetAcceptAfter() time.Time {
+	if m != nil {
+		return m.AcceptAfter
+	}
+	return time.Time{}
+}
+
+// Params: Module parameters for x/sudo.
+type Params struct {
+	// RootChangeDelay: Minimum time between proposing a root change and the new
+	// root accepting it. This gives the current root a window to cancel a change
+	// proposed with a compromised key.
+	RootChangeDelay time.Duration `protobuf:"bytes,1,opt,name=root_change_delay,json=rootChangeDelay,proto3,stdduration" json:"root_change_delay"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRootChangeDelay() time.Duration {
+	if m != nil {
+		return m.RootChangeDelay
+	}
+	return 0
+}
+
 // GenesisState: State for migrations and genesis for the x/sudo module.
 type GenesisState struct {
 	Sudoers Sudoers `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers"`
+	Params  Params  `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b462ff6aaf658cf, []int{1}
+	return fileDescriptor_4b462ff6aaf658cf, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,32 +254,54 @@ func (m *GenesisState) GetSudoers() Sudoers {
 	return Sudoers{}
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*Sudoers)(nil), "nibiru.sudo.v1.Sudoers")
+	proto.RegisterType((*PendingRootChange)(nil), "nibiru.sudo.v1.PendingRootChange")
+	proto.RegisterType((*Params)(nil), "nibiru.sudo.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "nibiru.sudo.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/state.proto", fileDescriptor_4b462ff6aaf658cf) }
 
 var fileDescriptor_4b462ff6aaf658cf = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x18, 0x84, 0x13, 0xa8, 0xa8, 0x62, 0x10, 0x43, 0x84, 0x44, 0x15, 0x55, 0xa6, 0xea, 0xd4, 0x05,
-	0x5b, 0x2d, 0x03, 0x03, 0x5b, 0x19, 0xb2, 0x31, 0xa4, 0x1b, 0x9b, 0x93, 0x5a, 0xae, 0x25, 0xf0,
-	0x1f, 0xd9, 0x7f, 0x22, 0x78, 0x0b, 0x1e, 0xab, 0x63, 0x47, 0x26, 0x84, 0x92, 0x17, 0x41, 0xb1,
-	0x8b, 0x10, 0xdb, 0xc9, 0xdf, 0xe9, 0x7c, 0xff, 0x91, 0xcc, 0xe8, 0x52, 0xdb, 0x86, 0xbb, 0x66,
-	0x0b, 0xbc, 0x5d, 0x72, 0x87, 0x02, 0x25, 0xab, 0x2d, 0x20, 0xa4, 0x97, 0x81, 0xb1, 0x81, 0xb1,
-	0x76, 0x99, 0x5d, 0x29, 0x50, 0xe0, 0x11, 0x1f, 0x54, 0x70, 0x65, 0x53, 0x05, 0xa0, 0x5e, 0x24,
-	0x17, 0xb5, 0xe6, 0xc2, 0x18, 0x40, 0x81, 0x1a, 0x8c, 0x0b, 0x74, 0xfe, 0x40, 0xc6, 0x9b, 0x66,
-	0x0b, 0xd2, 0xba, 0x34, 0x25, 0x23, 0x0b, 0x80, 0x93, 0x78, 0x16, 0x2f, 0x92, 0xc2, 0xeb, 0x74,
-	0x4a, 0x92, 0x0a, 0x0c, 0x5a, 0x51, 0xa1, 0x9b, 0x9c, 0xcc, 0x4e, 0x17, 0x49, 0xf1, 0xf7, 0x30,
-	0xcf, 0xc9, 0x45, 0x2e, 0x8d, 0x74, 0xda, 0x6d, 0x86, 0x5a, 0xe9, 0x3d, 0x19, 0xbb, 0x10, 0xe6,
-	0x43, 0xce, 0x57, 0xd7, 0xec, 0x7f, 0x45, 0x76, 0xfc, 0x6b, 0x3d, 0xda, 0x7f, 0xdd, 0x44, 0xc5,
-	0xaf, 0x7b, 0x9d, 0xef, 0x3b, 0x1a, 0x1f, 0x3a, 0x1a, 0x7f, 0x77, 0x34, 0xfe, 0xe8, 0x69, 0x74,
-	0xe8, 0x69, 0xf4, 0xd9, 0xd3, 0xe8, 0xf9, 0x56, 0x69, 0xdc, 0x35, 0x25, 0xab, 0xe0, 0x95, 0x3f,
-	0xf9, 0xac, 0xc7, 0x9d, 0xd0, 0x86, 0x1f, 0x67, 0x69, 0x57, 0xfc, 0x2d, 0x6c, 0x83, 0xef, 0xb5,
-	0x74, 0xe5, 0x99, 0xbf, 0xea, 0xee, 0x27, 0x00, 0x00, 0xff, 0xff, 0x84, 0x4f, 0x6d, 0x13, 0x37,
-	0x01, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0x13, 0x31,
+	0x18, 0xc6, 0x73, 0x4d, 0x95, 0x34, 0x4e, 0x05, 0x8a, 0x41, 0x70, 0x8d, 0xaa, 0x4b, 0xc8, 0x94,
+	0x85, 0xb3, 0x1a, 0x90, 0x98, 0x9b, 0x16, 0x65, 0x83, 0x72, 0x65, 0x81, 0xe5, 0xe4, 0xdc, 0xb9,
+	0x97, 0x93, 0x1a, 0xbf, 0x96, 0xed, 0x4b, 0xe9, 0xc0, 0x57, 0x40, 0x1d, 0xf9, 0x34, 0xcc, 0x1d,
+	0x3b, 0x32, 0x01, 0x4a, 0xbe, 0x08, 0xf2, 0x9f, 0x40, 0x49, 0x26, 0x36, 0xc7, 0xcf, 0xef, 0x7d,
+	0xf2, 0x3e, 0xef, 0xeb, 0x43, 0x5d, 0x5e, 0x4e, 0x4b, 0x59, 0x11, 0x55, 0xe5, 0x40, 0x16, 0x47,
+	0x44, 0x69, 0xaa, 0x59, 0x2c, 0x24, 0x68, 0xc0, 0x0f, 0x9c, 0x16, 0x1b, 0x2d, 0x5e, 0x1c, 0x75,
+	0x1f, 0x17, 0x50, 0x80, 0x95, 0x88, 0x39, 0x39, 0xaa, 0x7b, 0x58, 0x00, 0x14, 0x97, 0x8c, 0x50,
+	0x51, 0x12, 0xca, 0x39, 0x68, 0xaa, 0x4b, 0xe0, 0xca, 0xab, 0x91, 0x57, 0xed, 0xaf, 0x69, 0x75,
+	0x41, 0xf2, 0x4a, 0x5a, 0xc0, 0xeb, 0xbd, 0x4d, 0x5d, 0x97, 0x73, 0xa6, 0x34, 0x9d, 0x0b, 0x07,
+	0x0c, 0xbe, 0x04, 0xa8, 0x79, 0x5e, 0xe5, 0xc0, 0xa4, 0xc2, 0x18, 0xed, 0x4a, 0x00, 0x1d, 0x06,
+	0xfd, 0x60, 0xd8, 0x4a, 0xec, 0x19, 0x1f, 0xa2, 0x56, 0x06, 0x5c, 0x4b, 0x9a, 0x69, 0x15, 0xee,
+	0xf4, 0xeb, 0xc3, 0x56, 0xf2, 0xf7, 0x02, 0xbf, 0x43, 0x8f, 0x04, 0xe3, 0x79, 0xc9, 0x8b, 0xd4,
+	0xd0, 0x69, 0x36, 0xa3, 0xbc, 0x60, 0x61, 0xbd, 0x1f, 0x0c, 0xdb, 0xa3, 0x67, 0xf1, 0xbf, 0x01,
+	0xe3, 0x33, 0x87, 0x26, 0x00, 0xfa, 0xc4, 0x82, 0x49, 0x47, 0x6c, 0x5e, 0x0d, 0xbe, 0x05, 0xa8,
+	0xb3, 0x05, 0xe2, 0x03, 0xb4, 0xc7, 0xd9, 0x55, 0x7a, 0xaf, 0xbd, 0x26, 0x67, 0x57, 0x06, 0xc0,
+	0xaf, 0x51, 0x5b, 0x48, 0x10, 0xa0, 0x58, 0x9e, 0x52, 0x1d, 0xee, 0xd8, 0xff, 0xee, 0xc6, 0x2e,
+	0x78, 0xbc, 0x0e, 0x1e, 0xbf, 0x5f, 0x07, 0x1f, 0xef, 0xdd, 0xfe, 0xe8, 0xd5, 0x6e, 0x7e, 0xf6,
+	0x82, 0x04, 0xad, 0x0b, 0x8f, 0x35, 0x9e, 0xa0, 0x7d, 0x9a, 0x65, 0x4c, 0xe8, 0x94, 0x5e, 0x68,
+	0x26, 0xc3, 0xfa, 0x7f, 0xf8, 0xb4, 0x5d, 0xe5, 0xb1, 0x29, 0x1c, 0x7c, 0x40, 0x8d, 0x33, 0x2a,
+	0xe9, 0x5c, 0xe1, 0xb7, 0xa8, 0x73, 0x6f, 0x2a, 0x69, 0xce, 0x2e, 0xe9, 0xb5, 0xed, 0xbe, 0x3d,
+	0x3a, 0xd8, 0xf2, 0x3d, 0xf5, 0x8b, 0x73, 0xb6, 0x5f, 0x8d, 0xed, 0x43, 0xf9, 0x67, 0x00, 0xa7,
+	0xa6, 0x76, 0xf0, 0x19, 0xed, 0x4f, 0x18, 0x67, 0xaa, 0x54, 0xe7, 0xe6, 0x1d, 0xe1, 0x57, 0xa8,
+	0xa9, 0xdc, 0xee, 0xbc, 0xed, 0xd3, 0xcd, 0x91, 0xfb, 0xd5, 0x8e, 0x77, 0x8d, 0x69, 0xb2, 0xa6,
+	0xf1, 0x4b, 0xd4, 0x10, 0xb6, 0x47, 0x3f, 0xae, 0x27, 0x5b, 0xab, 0xb2, 0xaa, 0x2f, 0xf3, 0xec,
+	0x78, 0x72, 0xbb, 0x8c, 0x82, 0xbb, 0x65, 0x14, 0xfc, 0x5a, 0x46, 0xc1, 0xcd, 0x2a, 0xaa, 0xdd,
+	0xad, 0xa2, 0xda, 0xf7, 0x55, 0x54, 0xfb, 0xf8, 0xbc, 0x28, 0xf5, 0xac, 0x9a, 0xc6, 0x19, 0xcc,
+	0xc9, 0x1b, 0xeb, 0x74, 0x32, 0xa3, 0x25, 0x27, 0xfe, 0xf5, 0x2f, 0x46, 0xe4, 0x93, 0xfb, 0x04,
+	0xf4, 0xb5, 0x60, 0x6a, 0xda, 0xb0, 0xa9, 0x5f, 0xfc, 0x1e, 0x00, 0xfa, 0x73, 0x72, 0xb9, 0x1e,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Sudoers) Marshal() (dAtA []byte, err error) {
@@ -171,6 +324,18 @@ func (m *Sudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingRootChange != nil {
+		{
+			size, err := m.PendingRootChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Contracts[iNdEx])
@@ -190,6 +355,83 @@ func (m *Sudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingRootChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRootChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRootChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AcceptAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AcceptAfter):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintState(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ProposedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProposedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintState(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.NewRoot) > 0 {
+		i -= len(m.NewRoot)
+		copy(dAtA[i:], m.NewRoot)
+		i = encodeVarintState(dAtA, i, uint64(len(m.NewRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RootChangeDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RootChangeDelay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintState(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -210,6 +452,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -250,6 +502,38 @@ func (m *Sudoers) Size() (n int) {
 			n += 1 + l + sovState(uint64(l))
 		}
 	}
+	if m.PendingRootChange != nil {
+		l = m.PendingRootChange.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingRootChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProposedAt)
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AcceptAfter)
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RootChangeDelay)
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -261,6 +545,8 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Sudoers.Size()
 	n += 1 + l + sovState(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -363,6 +649,273 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 			}
 			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRootChange == nil {
+				m.PendingRootChange = &PendingRootChange{}
+			}
+			if err := m.PendingRootChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRootChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRootChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRootChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ProposedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AcceptAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootChangeDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RootChangeDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -446,6 +999,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -132,7 +132,8 @@ func (m *MsgEditSudoersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditSudoersResponse proto.InternalMessageInfo
 
-// MsgChangeRoot: Msg to update the "Sudoers" state.
+// MsgChangeRoot: Msg to propose a new "root" for the "Sudoers" state. The
+// proposal must be accepted by the new root with "MsgAcceptRoot".
 type MsgChangeRoot struct {
 	// Sender: Address for the signer of the transaction.
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -224,41 +225,322 @@ func (m *MsgChangeRootResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeRootResponse proto.InternalMessageInfo
 
+// MsgAcceptRoot: Msg for the proposed root to accept a pending root change.
+type MsgAcceptRoot struct {
+	// Sender: Address for the signer of the transaction. Must be the new root
+	// of the pending root change.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgAcceptRoot) Reset()         { *m = MsgAcceptRoot{} }
+func (m *MsgAcceptRoot) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptRoot) ProtoMessage()    {}
+func (*MsgAcceptRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{4}
+}
+func (m *MsgAcceptRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptRoot.Merge(m, src)
+}
+func (m *MsgAcceptRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptRoot proto.InternalMessageInfo
+
+func (m *MsgAcceptRoot) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgAcceptRootResponse indicates the successful execution of MsgAcceptRoot.
+type MsgAcceptRootResponse struct {
+}
+
+func (m *MsgAcceptRootResponse) Reset()         { *m = MsgAcceptRootResponse{} }
+func (m *MsgAcceptRootResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptRootResponse) ProtoMessage()    {}
+func (*MsgAcceptRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{5}
+}
+func (m *MsgAcceptRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptRootResponse.Merge(m, src)
+}
+func (m *MsgAcceptRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptRootResponse proto.InternalMessageInfo
+
+// MsgCancelRootChange: Msg for the current root to cancel a pending root
+// change.
+type MsgCancelRootChange struct {
+	// Sender: Address for the signer of the transaction. Must be the current
+	// root.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgCancelRootChange) Reset()         { *m = MsgCancelRootChange{} }
+func (m *MsgCancelRootChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRootChange) ProtoMessage()    {}
+func (*MsgCancelRootChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{6}
+}
+func (m *MsgCancelRootChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRootChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRootChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRootChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRootChange.Merge(m, src)
+}
+func (m *MsgCancelRootChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRootChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRootChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRootChange proto.InternalMessageInfo
+
+func (m *MsgCancelRootChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgCancelRootChangeResponse indicates the successful execution of
+// MsgCancelRootChange.
+type MsgCancelRootChangeResponse struct {
+}
+
+func (m *MsgCancelRootChangeResponse) Reset()         { *m = MsgCancelRootChangeResponse{} }
+func (m *MsgCancelRootChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRootChangeResponse) ProtoMessage()    {}
+func (*MsgCancelRootChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{7}
+}
+func (m *MsgCancelRootChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRootChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRootChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRootChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRootChangeResponse.Merge(m, src)
+}
+func (m *MsgCancelRootChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRootChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRootChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRootChangeResponse proto.InternalMessageInfo
+
+// MsgUpdateParams: Msg for the module authority to update the x/sudo
+// parameters.
+type MsgUpdateParams struct {
+	// Authority: Address of the module authority, which is the x/gov module
+	// account by default.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params: The new module parameters. All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse indicates the successful execution of
+// MsgUpdateParams.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEditSudoers)(nil), "nibiru.sudo.v1.MsgEditSudoers")
 	proto.RegisterType((*MsgEditSudoersResponse)(nil), "nibiru.sudo.v1.MsgEditSudoersResponse")
 	proto.RegisterType((*MsgChangeRoot)(nil), "nibiru.sudo.v1.MsgChangeRoot")
 	proto.RegisterType((*MsgChangeRootResponse)(nil), "nibiru.sudo.v1.MsgChangeRootResponse")
+	proto.RegisterType((*MsgAcceptRoot)(nil), "nibiru.sudo.v1.MsgAcceptRoot")
+	proto.RegisterType((*MsgAcceptRootResponse)(nil), "nibiru.sudo.v1.MsgAcceptRootResponse")
+	proto.RegisterType((*MsgCancelRootChange)(nil), "nibiru.sudo.v1.MsgCancelRootChange")
+	proto.RegisterType((*MsgCancelRootChangeResponse)(nil), "nibiru.sudo.v1.MsgCancelRootChangeResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "nibiru.sudo.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "nibiru.sudo.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/tx.proto", fileDescriptor_a610e3c1609cdcbc) }
 
 var fileDescriptor_a610e3c1609cdcbc = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4e, 0xea, 0x40,
-	0x18, 0x86, 0x29, 0x24, 0x9c, 0xc3, 0x9c, 0x1c, 0x16, 0xcd, 0x39, 0x50, 0x2a, 0x36, 0xd8, 0x44,
-	0xc3, 0xc6, 0x4e, 0xc0, 0x3b, 0x80, 0x18, 0x57, 0xb8, 0xa8, 0x3b, 0x17, 0x92, 0xa1, 0x9d, 0x0c,
-	0x93, 0xe8, 0x7c, 0x4d, 0x67, 0x0a, 0xb8, 0xf5, 0x0a, 0x4c, 0xbc, 0x29, 0x97, 0x24, 0x6e, 0x5c,
-	0x1a, 0xf0, 0x16, 0xdc, 0x9b, 0x4e, 0xf9, 0x69, 0x13, 0xc3, 0xae, 0xd3, 0xe7, 0x7b, 0x9f, 0x77,
-	0x66, 0x5a, 0xd4, 0x14, 0x7c, 0xc2, 0xe3, 0x04, 0xcb, 0x24, 0x04, 0x3c, 0xeb, 0x61, 0xb5, 0xf0,
-	0xa2, 0x18, 0x14, 0x98, 0xf5, 0x0c, 0x78, 0x29, 0xf0, 0x66, 0x3d, 0xfb, 0x1f, 0x03, 0x06, 0x1a,
-	0xe1, 0xf4, 0x29, 0x9b, 0xb2, 0xdb, 0x0c, 0x80, 0xdd, 0x53, 0x4c, 0x22, 0x8e, 0x89, 0x10, 0xa0,
-	0x88, 0xe2, 0x20, 0x64, 0x46, 0xdd, 0x3b, 0x54, 0x1f, 0x49, 0x76, 0x19, 0x72, 0x75, 0x93, 0x84,
-	0x40, 0x63, 0x69, 0x36, 0x50, 0x95, 0x04, 0xe9, 0x88, 0x65, 0x74, 0x8c, 0x6e, 0xcd, 0xdf, 0xac,
-	0xcc, 0x36, 0xaa, 0x05, 0x20, 0x54, 0x4c, 0x02, 0x25, 0xad, 0x72, 0xa7, 0xd2, 0xad, 0xf9, 0xfb,
-	0x17, 0x69, 0x4a, 0x52, 0x11, 0xd2, 0xd8, 0xaa, 0x64, 0xa9, 0x6c, 0xe5, 0x5a, 0xa8, 0x51, 0xf4,
-	0xfb, 0x54, 0x46, 0x20, 0x24, 0x75, 0x07, 0xe8, 0xef, 0x48, 0xb2, 0xe1, 0x94, 0x08, 0x46, 0x7d,
-	0x00, 0x95, 0x53, 0x18, 0x79, 0x85, 0xd9, 0x42, 0xbf, 0x05, 0x9d, 0x8f, 0x63, 0x00, 0x65, 0x95,
-	0x35, 0xf9, 0x25, 0xe8, 0x3c, 0x8d, 0xb8, 0x4d, 0xf4, 0xbf, 0xe0, 0xd8, 0xca, 0xfb, 0x5f, 0x06,
-	0xaa, 0x8c, 0x24, 0x33, 0x17, 0xe8, 0x4f, 0xfe, 0x6c, 0x8e, 0x57, 0xbc, 0x32, 0xaf, 0xb8, 0x37,
-	0xfb, 0xec, 0x30, 0xdf, 0xed, 0xfd, 0xe4, 0xe9, 0xed, 0xf3, 0xa5, 0x7c, 0xe4, 0xb6, 0x70, 0xfe,
-	0xdb, 0xd0, 0x90, 0xab, 0xb1, 0xdc, 0x54, 0x29, 0x84, 0x72, 0x67, 0x3b, 0xfe, 0x41, 0xbc, 0xc7,
-	0xf6, 0xe9, 0x41, 0xbc, 0xab, 0xed, 0xe8, 0x5a, 0xdb, 0xb5, 0x0a, 0xb5, 0x81, 0x1e, 0xd4, 0xf7,
-	0x33, 0xb8, 0x7a, 0x5d, 0x39, 0xc6, 0x72, 0xe5, 0x18, 0x1f, 0x2b, 0xc7, 0x78, 0x5e, 0x3b, 0xa5,
-	0xe5, 0xda, 0x29, 0xbd, 0xaf, 0x9d, 0xd2, 0xed, 0x39, 0xe3, 0x6a, 0x9a, 0x4c, 0xbc, 0x00, 0x1e,
-	0xf0, 0xb5, 0x4e, 0x0f, 0xa7, 0x84, 0x8b, 0xad, 0x69, 0xd6, 0xc7, 0x8b, 0x4c, 0xa7, 0x1e, 0x23,
-	0x2a, 0x27, 0x55, 0xfd, 0x7b, 0x5c, 0x7c, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xdb, 0xcd, 0x34,
-	0x7d, 0x02, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x6a, 0x13, 0x41,
+	0x14, 0xc6, 0xb3, 0x4d, 0x89, 0x66, 0xaa, 0x55, 0x56, 0x4d, 0x36, 0xdb, 0x76, 0x93, 0xae, 0xd4,
+	0x16, 0xa4, 0xbb, 0x34, 0xfa, 0x02, 0xa6, 0x88, 0x57, 0x11, 0x89, 0x08, 0xe2, 0x85, 0x61, 0xb2,
+	0x3b, 0x4c, 0x16, 0xda, 0x99, 0x65, 0xe7, 0x24, 0x4d, 0x6f, 0xbd, 0x17, 0x04, 0x5f, 0xaa, 0x97,
+	0x05, 0x11, 0xbc, 0x12, 0x49, 0x7c, 0x10, 0xd9, 0x99, 0xcd, 0xfe, 0x89, 0x6b, 0xea, 0x5d, 0x76,
+	0xbe, 0xef, 0xfc, 0xbe, 0x73, 0x66, 0x0e, 0x41, 0x4d, 0x16, 0x8c, 0x82, 0x68, 0xe2, 0x8a, 0x89,
+	0xcf, 0xdd, 0xe9, 0x89, 0x0b, 0x33, 0x27, 0x8c, 0x38, 0x70, 0x7d, 0x5b, 0x09, 0x4e, 0x2c, 0x38,
+	0xd3, 0x13, 0xf3, 0x21, 0xe5, 0x94, 0x4b, 0xc9, 0x8d, 0x7f, 0x29, 0x97, 0xb9, 0x4b, 0x39, 0xa7,
+	0x67, 0xc4, 0xc5, 0x61, 0xe0, 0x62, 0xc6, 0x38, 0x60, 0x08, 0x38, 0x13, 0x89, 0x6a, 0xae, 0xc0,
+	0x05, 0x60, 0x20, 0x4a, 0xb3, 0x3f, 0xa2, 0xed, 0xbe, 0xa0, 0x2f, 0xfd, 0x00, 0xde, 0x4e, 0x7c,
+	0x4e, 0x22, 0xa1, 0x37, 0x50, 0x0d, 0x7b, 0x71, 0xb9, 0xa1, 0x75, 0xb4, 0xa3, 0xfa, 0x20, 0xf9,
+	0xd2, 0x77, 0x51, 0xdd, 0xe3, 0x0c, 0x22, 0xec, 0x81, 0x30, 0x36, 0x3a, 0xd5, 0xa3, 0xfa, 0x20,
+	0x3b, 0x88, 0xab, 0x04, 0x61, 0x3e, 0x89, 0x8c, 0xaa, 0xaa, 0x52, 0x5f, 0xb6, 0x81, 0x1a, 0x45,
+	0xfe, 0x80, 0x88, 0x90, 0x33, 0x41, 0xec, 0x1e, 0xba, 0xdb, 0x17, 0xf4, 0x74, 0x8c, 0x19, 0x25,
+	0x03, 0xce, 0x21, 0x87, 0xd0, 0xf2, 0x08, 0xbd, 0x85, 0x6e, 0x33, 0x72, 0x31, 0x8c, 0x38, 0x07,
+	0x63, 0x43, 0x2a, 0xb7, 0x18, 0xb9, 0x88, 0x4b, 0xec, 0x26, 0x7a, 0x54, 0x60, 0xa4, 0xf0, 0x43,
+	0x09, 0x7f, 0xe1, 0x79, 0x24, 0x84, 0x75, 0xf0, 0x84, 0x90, 0x19, 0x53, 0xc2, 0x31, 0x7a, 0x10,
+	0xa3, 0x31, 0xf3, 0xc8, 0x59, 0x2c, 0xa8, 0x90, 0x7f, 0x72, 0xf6, 0xd0, 0x4e, 0x89, 0x3d, 0xa5,
+	0x11, 0x74, 0xaf, 0x2f, 0xe8, 0xbb, 0xd0, 0xc7, 0x40, 0xde, 0xe0, 0x08, 0x9f, 0x8b, 0xf8, 0x3e,
+	0xf1, 0x04, 0xc6, 0x3c, 0x0a, 0xe0, 0x32, 0x81, 0x65, 0x07, 0xfa, 0x73, 0x54, 0x0b, 0xa5, 0x4f,
+	0x8e, 0xbc, 0xd5, 0x6d, 0x38, 0xc5, 0x45, 0x70, 0x14, 0xa5, 0xb7, 0x79, 0xf5, 0xb3, 0x5d, 0x19,
+	0x24, 0x5e, 0xbb, 0x85, 0x9a, 0x2b, 0x31, 0xcb, 0x0e, 0xba, 0xdf, 0x37, 0x51, 0xb5, 0x2f, 0xa8,
+	0x3e, 0x43, 0x5b, 0xf9, 0xd7, 0xb6, 0x56, 0xb9, 0xc5, 0xd7, 0x32, 0x9f, 0xac, 0xd7, 0xd3, 0x01,
+	0xf7, 0x3f, 0x7d, 0xfb, 0xfd, 0x75, 0x63, 0xc7, 0x6e, 0xb9, 0xf9, 0x65, 0x23, 0x7e, 0x00, 0x43,
+	0x91, 0x44, 0x01, 0x42, 0xb9, 0xd7, 0xde, 0x2b, 0x01, 0x67, 0xb2, 0x79, 0xb0, 0x56, 0x4e, 0x63,
+	0x3b, 0x32, 0xd6, 0xb4, 0x8d, 0x42, 0xac, 0x27, 0x8d, 0x72, 0x63, 0xe2, 0xd4, 0xdc, 0x1a, 0x94,
+	0xa5, 0x66, 0xb2, 0x79, 0xb0, 0x56, 0xbe, 0x21, 0x15, 0x4b, 0xa3, 0x4a, 0xfd, 0xac, 0xa1, 0xfb,
+	0x7f, 0xed, 0xce, 0xe3, 0xb2, 0x99, 0x56, 0x4c, 0xe6, 0xd3, 0xff, 0x30, 0x65, 0x6b, 0x2e, 0x1b,
+	0xd9, 0xb7, 0xdb, 0xc5, 0xf1, 0xa5, 0x5d, 0x36, 0x32, 0x54, 0x57, 0xa1, 0xbf, 0x47, 0x77, 0x0a,
+	0xcb, 0xd7, 0x2e, 0x49, 0xc9, 0x1b, 0xcc, 0xc3, 0x1b, 0x0c, 0xcb, 0x16, 0x7a, 0xaf, 0xae, 0xe6,
+	0x96, 0x76, 0x3d, 0xb7, 0xb4, 0x5f, 0x73, 0x4b, 0xfb, 0xb2, 0xb0, 0x2a, 0xd7, 0x0b, 0xab, 0xf2,
+	0x63, 0x61, 0x55, 0x3e, 0x1c, 0xd3, 0x00, 0xc6, 0x93, 0x91, 0xe3, 0xf1, 0x73, 0xf7, 0xb5, 0x84,
+	0x9d, 0x8e, 0x71, 0xc0, 0x96, 0xad, 0x4e, 0xbb, 0xee, 0x4c, 0xf5, 0x0b, 0x97, 0x21, 0x11, 0xa3,
+	0x9a, 0xfc, 0x43, 0x7a, 0xf6, 0x67, 0x00, 0xa6, 0xc6, 0x2c, 0x20, 0x0b, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -275,7 +557,18 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error)
+	// ChangeRoot proposes a new root. The change takes effect only after the
+	// new root accepts it with "AcceptRoot".
 	ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error)
+	// AcceptRoot completes a pending root change. It must be signed by the
+	// proposed new root after the root change delay has elapsed.
+	AcceptRoot(ctx context.Context, in *MsgAcceptRoot, opts ...grpc.CallOption) (*MsgAcceptRootResponse, error)
+	// CancelRootChange discards a pending root change. It must be signed by the
+	// current root.
+	CancelRootChange(ctx context.Context, in *MsgCancelRootChange, opts ...grpc.CallOption) (*MsgCancelRootChangeResponse, error)
+	// UpdateParams updates the module parameters. The authority is the x/gov
+	// module account unless the module config sets another one.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -304,11 +597,49 @@ func (c *msgClient) ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) AcceptRoot(ctx context.Context, in *MsgAcceptRoot, opts ...grpc.CallOption) (*MsgAcceptRootResponse, error) {
+	out := new(MsgAcceptRootResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/AcceptRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRootChange(ctx context.Context, in *MsgCancelRootChange, opts ...grpc.CallOption) (*MsgCancelRootChangeResponse, error) {
+	out := new(MsgCancelRootChangeResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/CancelRootChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(context.Context, *MsgEditSudoers) (*MsgEditSudoersResponse, error)
+	// ChangeRoot proposes a new root. The change takes effect only after the
+	// new root accepts it with "AcceptRoot".
 	ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error)
+	// AcceptRoot completes a pending root change. It must be signed by the
+	// proposed new root after the root change delay has elapsed.
+	AcceptRoot(context.Context, *MsgAcceptRoot) (*MsgAcceptRootResponse, error)
+	// CancelRootChange discards a pending root change. It must be signed by the
+	// current root.
+	CancelRootChange(context.Context, *MsgCancelRootChange) (*MsgCancelRootChangeResponse, error)
+	// UpdateParams updates the module parameters. The authority is the x/gov
+	// module account unless the module config sets another one.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -321,6 +652,15 @@ func (*UnimplementedMsgServer) EditSudoers(ctx context.Context, req *MsgEditSudo
 func (*UnimplementedMsgServer) ChangeRoot(ctx context.Context, req *MsgChangeRoot) (*MsgChangeRootResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoot not implemented")
 }
+func (*UnimplementedMsgServer) AcceptRoot(ctx context.Context, req *MsgAcceptRoot) (*MsgAcceptRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRoot not implemented")
+}
+func (*UnimplementedMsgServer) CancelRootChange(ctx context.Context, req *MsgCancelRootChange) (*MsgCancelRootChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRootChange not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -362,6 +702,60 @@ func _Msg_ChangeRoot_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcceptRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/AcceptRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptRoot(ctx, req.(*MsgAcceptRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRootChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRootChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRootChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/CancelRootChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRootChange(ctx, req.(*MsgCancelRootChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.sudo.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -374,6 +768,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeRoot",
 			Handler:    _Msg_ChangeRoot_Handler,
 		},
+		{
+			MethodName: "AcceptRoot",
+			Handler:    _Msg_AcceptRoot_Handler,
+		},
+		{
+			MethodName: "CancelRootChange",
+			Handler:    _Msg_CancelRootChange_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/tx.proto",
@@ -508,32 +914,201 @@ func (m *MsgChangeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAcceptRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEditSudoers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAcceptRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRootChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRootChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRootChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRootChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRootChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRootChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEditSudoers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	l = len(m.Sender)
 	if l > 0 {
@@ -577,13 +1152,277 @@ func (m *MsgChangeRootResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgAcceptRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRootChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRootChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSudoers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSudoersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -606,15 +1445,15 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSudoers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -642,11 +1481,11 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -674,9 +1513,109 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -729,7 +1668,7 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -752,10 +1691,10 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSudoersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -779,7 +1718,7 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRootChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -802,10 +1741,10 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRootChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRootChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -840,9 +1779,109 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRootChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRootChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRootChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -870,7 +1909,40 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -893,7 +1965,7 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -916,10 +1988,10 @@ func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -28,6 +28,7 @@ type Keeper struct {
 	accountKeeper       tftypes.AccountKeeper
 	communityPoolKeeper tftypes.CommunityPoolKeeper
 	sudoKeeper          sudokeeper.Keeper
+	evmKeeper           tftypes.EvmKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically,
 	// this should be the x/gov module account.
@@ -42,6 +43,7 @@ func NewKeeper(
 	ak tftypes.AccountKeeper,
 	communityPoolKeeper tftypes.CommunityPoolKeeper,
 	sk sudokeeper.Keeper,
+	evmKeeper tftypes.EvmKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -68,6 +70,7 @@ func NewKeeper(
 		accountKeeper:       ak,
 		communityPoolKeeper: communityPoolKeeper,
 		sudoKeeper:          sk,
+		evmKeeper:           evmKeeper,
 		authority:           authority,
 	}
 }
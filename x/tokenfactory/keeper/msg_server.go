@@ -266,6 +266,9 @@ func (k Keeper) SetDenomMetadata(
 	}
 
 	k.bankKeeper.SetDenomMetaData(ctx, txMsg.Metadata)
+	if err := k.evmKeeper.UpdateFunTokenMetadata(ctx, txMsg.Metadata); err != nil {
+		return resp, err
+	}
 
 	return &types.MsgSetDenomMetadataResponse{}, ctx.EventManager().
 		EmitTypedEvent(&types.EventSetDenomMetadata{
@@ -332,6 +335,9 @@ func (k Keeper) SudoSetDenomMetadata(
 	}
 
 	k.bankKeeper.SetDenomMetaData(ctx, txMsg.Metadata)
+	if err = k.evmKeeper.UpdateFunTokenMetadata(ctx, txMsg.Metadata); err != nil {
+		return resp, err
+	}
 
 	return &types.MsgSudoSetDenomMetadataResponse{}, err
 }
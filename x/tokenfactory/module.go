@@ -227,6 +227,7 @@ type TokenFactoryInputs struct {
 	BankKeeper    types.BankKeeper
 	DistrKeeper   types.CommunityPoolKeeper
 	SudoKeeper    sudokeeper.Keeper
+	EvmKeeper     types.EvmKeeper
 }
 
 type TokenFactoryOutputs struct {
@@ -243,7 +244,7 @@ func ProvideModule(in TokenFactoryInputs) TokenFactoryOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
-	k := keeper.NewKeeper(in.Key, in.Cdc, in.BankKeeper, in.AccountKeeper, in.DistrKeeper, in.SudoKeeper, authority.String())
+	k := keeper.NewKeeper(in.Key, in.Cdc, in.BankKeeper, in.AccountKeeper, in.DistrKeeper, in.SudoKeeper, in.EvmKeeper, authority.String())
 
 	m := NewAppModule(k, in.AccountKeeper)
 
@@ -40,6 +40,12 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
+// EvmKeeper defines the EVM hook that keeps the ERC20 of a Bank Coin with a
+// FunToken mapping in sync with the bank metadata of the coin.
+type EvmKeeper interface {
+	UpdateFunTokenMetadata(ctx sdk.Context, bankMetadata banktypes.Metadata) error
+}
+
 // CommunityPoolKeeper defines the contract needed to be fulfilled for community pool interactions.
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error